@@ -2,10 +2,13 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"path/filepath"
 
 	"connectrpc.com/connect"
@@ -20,6 +23,13 @@ type Client struct {
 	agent         v1connect.AgentServiceClient
 	task          v1connect.TaskServiceClient
 	message       v1connect.MessageServiceClient
+	schedule      v1connect.ScheduleServiceClient
+	webhook       v1connect.WebhookServiceClient
+	notification  v1connect.NotificationServiceClient
+	archive       v1connect.ArchiveServiceClient
+	audit         v1connect.AuditServiceClient
+	secret        v1connect.SecretServiceClient
+	search        v1connect.SearchServiceClient
 }
 
 type ClientOptions struct {
@@ -49,6 +59,18 @@ func NewClient(endpointContext EndpointContext, options ...ClientOption) (*Clien
 		option(&opts)
 	}
 
+	if endpointContext.Token != "" {
+		opts.ConnectOptions = append(opts.ConnectOptions, connect.WithInterceptors(bearerToken(endpointContext.Token)))
+	}
+
+	if endpointContext.TLS != nil {
+		tlsConfig, err := endpointContext.TLS.Config()
+		if err != nil {
+			return nil, err
+		}
+		opts.HTTPClient.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+
 	baseURL := endpointContext.Address
 	if endpointContext.Kind == "unix" {
 		opts.HTTPClient.Transport = &http.Transport{
@@ -69,6 +91,13 @@ func NewClient(endpointContext EndpointContext, options ...ClientOption) (*Clien
 		agent:         v1connect.NewAgentServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 		task:          v1connect.NewTaskServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 		message:       v1connect.NewMessageServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		schedule:      v1connect.NewScheduleServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		webhook:       v1connect.NewWebhookServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		notification:  v1connect.NewNotificationServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		archive:       v1connect.NewArchiveServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		audit:         v1connect.NewAuditServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		secret:        v1connect.NewSecretServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		search:        v1connect.NewSearchServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 	}, nil
 }
 
@@ -92,12 +121,47 @@ func (c *Client) Message() v1connect.MessageServiceClient {
 	return c.message
 }
 
+func (c *Client) Schedule() v1connect.ScheduleServiceClient {
+	return c.schedule
+}
+
+func (c *Client) Webhook() v1connect.WebhookServiceClient {
+	return c.webhook
+}
+
+func (c *Client) Notification() v1connect.NotificationServiceClient {
+	return c.notification
+}
+
+func (c *Client) Archive() v1connect.ArchiveServiceClient {
+	return c.archive
+}
+
+func (c *Client) Audit() v1connect.AuditServiceClient {
+	return c.audit
+}
+
+func (c *Client) Secret() v1connect.SecretServiceClient {
+	return c.secret
+}
+
+func (c *Client) Search() v1connect.SearchServiceClient {
+	return c.search
+}
+
 type MockClient struct {
 	ModelProvider *mocks.MockModelProviderServiceClient
 	Model         *mocks.MockModelServiceClient
 	Agent         *mocks.MockAgentServiceClient
 	Task          *mocks.MockTaskServiceClient
 	Message       *mocks.MockMessageServiceClient
+	Schedule      *mocks.MockScheduleServiceClient
+	Webhook       *mocks.MockWebhookServiceClient
+	Notification  *mocks.MockNotificationServiceClient
+	Archive       *mocks.MockArchiveServiceClient
+	Audit         *mocks.MockAuditServiceClient
+	Secret        *mocks.MockSecretServiceClient
+	Search        *mocks.MockSearchServiceClient
 }
 
 func NewMockClient(ctrl *gomock.Controller) *MockClient {
@@ -107,6 +171,13 @@ func NewMockClient(ctrl *gomock.Controller) *MockClient {
 		Agent:         mocks.NewMockAgentServiceClient(ctrl),
 		Task:          mocks.NewMockTaskServiceClient(ctrl),
 		Message:       mocks.NewMockMessageServiceClient(ctrl),
+		Schedule:      mocks.NewMockScheduleServiceClient(ctrl),
+		Webhook:       mocks.NewMockWebhookServiceClient(ctrl),
+		Notification:  mocks.NewMockNotificationServiceClient(ctrl),
+		Archive:       mocks.NewMockArchiveServiceClient(ctrl),
+		Audit:         mocks.NewMockAuditServiceClient(ctrl),
+		Secret:        mocks.NewMockSecretServiceClient(ctrl),
+		Search:        mocks.NewMockSearchServiceClient(ctrl),
 	}
 }
 
@@ -117,6 +188,13 @@ func (c *MockClient) Client() *Client {
 		agent:         c.Agent,
 		task:          c.Task,
 		message:       c.Message,
+		schedule:      c.Schedule,
+		webhook:       c.Webhook,
+		notification:  c.Notification,
+		archive:       c.Archive,
+		audit:         c.Audit,
+		secret:        c.Secret,
+		search:        c.Search,
 	}
 }
 
@@ -162,6 +240,50 @@ func (c *EndpointContexts) SetCurrent(contextName string) error {
 type EndpointContext struct {
 	Address string `yaml:"address"`
 	Kind    string `yaml:"kind"`
+	// Token is the API token sent to daemons that listen on TCP.
+	Token string     `yaml:"token,omitempty"`
+	TLS   *TLSConfig `yaml:"tls,omitempty"`
+}
+
+// TLSConfig holds the files used to verify a daemon that serves TLS and, if it requires
+// mutual TLS, the client certificate to present.
+type TLSConfig struct {
+	CA   string `yaml:"ca,omitempty"`
+	Cert string `yaml:"cert,omitempty"`
+	Key  string `yaml:"key,omitempty"`
+}
+
+func (c *TLSConfig) Validate() error {
+	if (c.Cert == "") != (c.Key == "") {
+		return fmt.Errorf("tls cert and key must be set together")
+	}
+	return nil
+}
+
+func (c *TLSConfig) Config() (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if c.CA != "" {
+		pem, err := os.ReadFile(c.CA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls ca %s: %w", c.CA, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in tls ca %s", c.CA)
+		}
+		config.RootCAs = pool
+	}
+
+	if c.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(c.Cert, c.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
 }
 
 func (c *EndpointContext) Validate() error {
@@ -181,9 +303,40 @@ func (c *EndpointContext) Validate() error {
 		}
 	}
 
+	if c.TLS != nil {
+		if c.Kind != "http" {
+			return fmt.Errorf("tls is only supported for http contexts")
+		}
+		if err := c.TLS.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// bearerToken adds the API token to the Authorization header of every request.
+type bearerToken string
+
+func (t bearerToken) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set("Authorization", "Bearer "+string(t))
+		return next(ctx, req)
+	}
+}
+
+func (t bearerToken) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set("Authorization", "Bearer "+string(t))
+		return conn
+	}
+}
+
+func (t bearerToken) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
 func Ptr[T any](v T) *T {
 	return &v
 }
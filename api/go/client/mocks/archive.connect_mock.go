@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/archive.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/archive.connect.go -destination=./mocks/archive.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArchiveServiceClient is a mock of ArchiveServiceClient interface.
+type MockArchiveServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockArchiveServiceClientMockRecorder is the mock recorder for MockArchiveServiceClient.
+type MockArchiveServiceClientMockRecorder struct {
+	mock *MockArchiveServiceClient
+}
+
+// NewMockArchiveServiceClient creates a new mock instance.
+func NewMockArchiveServiceClient(ctrl *gomock.Controller) *MockArchiveServiceClient {
+	mock := &MockArchiveServiceClient{ctrl: ctrl}
+	mock.recorder = &MockArchiveServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveServiceClient) EXPECT() *MockArchiveServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ExportArchive mocks base method.
+func (m *MockArchiveServiceClient) ExportArchive(arg0 context.Context, arg1 *connect.Request[v1.ExportArchiveRequest]) (*connect.ServerStreamForClient[v1.ExportArchiveResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportArchive", arg0, arg1)
+	ret0, _ := ret[0].(*connect.ServerStreamForClient[v1.ExportArchiveResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportArchive indicates an expected call of ExportArchive.
+func (mr *MockArchiveServiceClientMockRecorder) ExportArchive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportArchive", reflect.TypeOf((*MockArchiveServiceClient)(nil).ExportArchive), arg0, arg1)
+}
+
+// ImportArchive mocks base method.
+func (m *MockArchiveServiceClient) ImportArchive(arg0 context.Context, arg1 *connect.Request[v1.ImportArchiveRequest]) (*connect.Response[v1.ImportArchiveResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportArchive", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ImportArchiveResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportArchive indicates an expected call of ImportArchive.
+func (mr *MockArchiveServiceClientMockRecorder) ImportArchive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportArchive", reflect.TypeOf((*MockArchiveServiceClient)(nil).ImportArchive), arg0, arg1)
+}
+
+// MockArchiveServiceHandler is a mock of ArchiveServiceHandler interface.
+type MockArchiveServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockArchiveServiceHandlerMockRecorder is the mock recorder for MockArchiveServiceHandler.
+type MockArchiveServiceHandlerMockRecorder struct {
+	mock *MockArchiveServiceHandler
+}
+
+// NewMockArchiveServiceHandler creates a new mock instance.
+func NewMockArchiveServiceHandler(ctrl *gomock.Controller) *MockArchiveServiceHandler {
+	mock := &MockArchiveServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockArchiveServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveServiceHandler) EXPECT() *MockArchiveServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// ExportArchive mocks base method.
+func (m *MockArchiveServiceHandler) ExportArchive(arg0 context.Context, arg1 *connect.Request[v1.ExportArchiveRequest], arg2 *connect.ServerStream[v1.ExportArchiveResponse]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportArchive", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportArchive indicates an expected call of ExportArchive.
+func (mr *MockArchiveServiceHandlerMockRecorder) ExportArchive(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportArchive", reflect.TypeOf((*MockArchiveServiceHandler)(nil).ExportArchive), arg0, arg1, arg2)
+}
+
+// ImportArchive mocks base method.
+func (m *MockArchiveServiceHandler) ImportArchive(arg0 context.Context, arg1 *connect.Request[v1.ImportArchiveRequest]) (*connect.Response[v1.ImportArchiveResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportArchive", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ImportArchiveResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportArchive indicates an expected call of ImportArchive.
+func (mr *MockArchiveServiceHandlerMockRecorder) ImportArchive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportArchive", reflect.TypeOf((*MockArchiveServiceHandler)(nil).ImportArchive), arg0, arg1)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/audit.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/audit.connect.go -destination=./mocks/audit.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditServiceClient is a mock of AuditServiceClient interface.
+type MockAuditServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditServiceClientMockRecorder is the mock recorder for MockAuditServiceClient.
+type MockAuditServiceClientMockRecorder struct {
+	mock *MockAuditServiceClient
+}
+
+// NewMockAuditServiceClient creates a new mock instance.
+func NewMockAuditServiceClient(ctrl *gomock.Controller) *MockAuditServiceClient {
+	mock := &MockAuditServiceClient{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditServiceClient) EXPECT() *MockAuditServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ListEvents mocks base method.
+func (m *MockAuditServiceClient) ListEvents(arg0 context.Context, arg1 *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListEventsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockAuditServiceClientMockRecorder) ListEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockAuditServiceClient)(nil).ListEvents), arg0, arg1)
+}
+
+// MockAuditServiceHandler is a mock of AuditServiceHandler interface.
+type MockAuditServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditServiceHandlerMockRecorder is the mock recorder for MockAuditServiceHandler.
+type MockAuditServiceHandlerMockRecorder struct {
+	mock *MockAuditServiceHandler
+}
+
+// NewMockAuditServiceHandler creates a new mock instance.
+func NewMockAuditServiceHandler(ctrl *gomock.Controller) *MockAuditServiceHandler {
+	mock := &MockAuditServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditServiceHandler) EXPECT() *MockAuditServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// ListEvents mocks base method.
+func (m *MockAuditServiceHandler) ListEvents(arg0 context.Context, arg1 *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListEventsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockAuditServiceHandlerMockRecorder) ListEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockAuditServiceHandler)(nil).ListEvents), arg0, arg1)
+}
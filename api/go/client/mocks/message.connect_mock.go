@@ -102,6 +102,21 @@ func (mr *MockMessageServiceClientMockRecorder) ListMessages(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockMessageServiceClient)(nil).ListMessages), arg0, arg1)
 }
 
+// RegenerateMessage mocks base method.
+func (m *MockMessageServiceClient) RegenerateMessage(arg0 context.Context, arg1 *connect.Request[v1.RegenerateMessageRequest]) (*connect.Response[v1.RegenerateMessageResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateMessage", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RegenerateMessageResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateMessage indicates an expected call of RegenerateMessage.
+func (mr *MockMessageServiceClientMockRecorder) RegenerateMessage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).RegenerateMessage), arg0, arg1)
+}
+
 // UpdateMessage mocks base method.
 func (m *MockMessageServiceClient) UpdateMessage(arg0 context.Context, arg1 *connect.Request[v1.UpdateMessageRequest]) (*connect.Response[v1.UpdateMessageResponse], error) {
 	m.ctrl.T.Helper()
@@ -201,6 +216,21 @@ func (mr *MockMessageServiceHandlerMockRecorder) ListMessages(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockMessageServiceHandler)(nil).ListMessages), arg0, arg1)
 }
 
+// RegenerateMessage mocks base method.
+func (m *MockMessageServiceHandler) RegenerateMessage(arg0 context.Context, arg1 *connect.Request[v1.RegenerateMessageRequest]) (*connect.Response[v1.RegenerateMessageResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateMessage", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RegenerateMessageResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateMessage indicates an expected call of RegenerateMessage.
+func (mr *MockMessageServiceHandlerMockRecorder) RegenerateMessage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateMessage", reflect.TypeOf((*MockMessageServiceHandler)(nil).RegenerateMessage), arg0, arg1)
+}
+
 // UpdateMessage mocks base method.
 func (m *MockMessageServiceHandler) UpdateMessage(arg0 context.Context, arg1 *connect.Request[v1.UpdateMessageRequest]) (*connect.Response[v1.UpdateMessageResponse], error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/notification.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/notification.connect.go -destination=./mocks/notification.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationServiceClient is a mock of NotificationServiceClient interface.
+type MockNotificationServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationServiceClientMockRecorder is the mock recorder for MockNotificationServiceClient.
+type MockNotificationServiceClientMockRecorder struct {
+	mock *MockNotificationServiceClient
+}
+
+// NewMockNotificationServiceClient creates a new mock instance.
+func NewMockNotificationServiceClient(ctrl *gomock.Controller) *MockNotificationServiceClient {
+	mock := &MockNotificationServiceClient{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationServiceClient) EXPECT() *MockNotificationServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateNotificationSink mocks base method.
+func (m *MockNotificationServiceClient) CreateNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.CreateNotificationSinkRequest]) (*connect.Response[v1.CreateNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CreateNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotificationSink indicates an expected call of CreateNotificationSink.
+func (mr *MockNotificationServiceClientMockRecorder) CreateNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationSink", reflect.TypeOf((*MockNotificationServiceClient)(nil).CreateNotificationSink), arg0, arg1)
+}
+
+// DeleteNotificationSink mocks base method.
+func (m *MockNotificationServiceClient) DeleteNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.DeleteNotificationSinkRequest]) (*connect.Response[v1.DeleteNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.DeleteNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotificationSink indicates an expected call of DeleteNotificationSink.
+func (mr *MockNotificationServiceClientMockRecorder) DeleteNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationSink", reflect.TypeOf((*MockNotificationServiceClient)(nil).DeleteNotificationSink), arg0, arg1)
+}
+
+// GetNotificationSink mocks base method.
+func (m *MockNotificationServiceClient) GetNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.GetNotificationSinkRequest]) (*connect.Response[v1.GetNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationSink indicates an expected call of GetNotificationSink.
+func (mr *MockNotificationServiceClientMockRecorder) GetNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationSink", reflect.TypeOf((*MockNotificationServiceClient)(nil).GetNotificationSink), arg0, arg1)
+}
+
+// ListNotificationSinks mocks base method.
+func (m *MockNotificationServiceClient) ListNotificationSinks(arg0 context.Context, arg1 *connect.Request[v1.ListNotificationSinksRequest]) (*connect.Response[v1.ListNotificationSinksResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationSinks", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListNotificationSinksResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationSinks indicates an expected call of ListNotificationSinks.
+func (mr *MockNotificationServiceClientMockRecorder) ListNotificationSinks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationSinks", reflect.TypeOf((*MockNotificationServiceClient)(nil).ListNotificationSinks), arg0, arg1)
+}
+
+// TestNotificationSink mocks base method.
+func (m *MockNotificationServiceClient) TestNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.TestNotificationSinkRequest]) (*connect.Response[v1.TestNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.TestNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestNotificationSink indicates an expected call of TestNotificationSink.
+func (mr *MockNotificationServiceClientMockRecorder) TestNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestNotificationSink", reflect.TypeOf((*MockNotificationServiceClient)(nil).TestNotificationSink), arg0, arg1)
+}
+
+// UpdateNotificationSink mocks base method.
+func (m *MockNotificationServiceClient) UpdateNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.UpdateNotificationSinkRequest]) (*connect.Response[v1.UpdateNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UpdateNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationSink indicates an expected call of UpdateNotificationSink.
+func (mr *MockNotificationServiceClientMockRecorder) UpdateNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationSink", reflect.TypeOf((*MockNotificationServiceClient)(nil).UpdateNotificationSink), arg0, arg1)
+}
+
+// MockNotificationServiceHandler is a mock of NotificationServiceHandler interface.
+type MockNotificationServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationServiceHandlerMockRecorder is the mock recorder for MockNotificationServiceHandler.
+type MockNotificationServiceHandlerMockRecorder struct {
+	mock *MockNotificationServiceHandler
+}
+
+// NewMockNotificationServiceHandler creates a new mock instance.
+func NewMockNotificationServiceHandler(ctrl *gomock.Controller) *MockNotificationServiceHandler {
+	mock := &MockNotificationServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationServiceHandler) EXPECT() *MockNotificationServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateNotificationSink mocks base method.
+func (m *MockNotificationServiceHandler) CreateNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.CreateNotificationSinkRequest]) (*connect.Response[v1.CreateNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CreateNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotificationSink indicates an expected call of CreateNotificationSink.
+func (mr *MockNotificationServiceHandlerMockRecorder) CreateNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationSink", reflect.TypeOf((*MockNotificationServiceHandler)(nil).CreateNotificationSink), arg0, arg1)
+}
+
+// DeleteNotificationSink mocks base method.
+func (m *MockNotificationServiceHandler) DeleteNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.DeleteNotificationSinkRequest]) (*connect.Response[v1.DeleteNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.DeleteNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotificationSink indicates an expected call of DeleteNotificationSink.
+func (mr *MockNotificationServiceHandlerMockRecorder) DeleteNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationSink", reflect.TypeOf((*MockNotificationServiceHandler)(nil).DeleteNotificationSink), arg0, arg1)
+}
+
+// GetNotificationSink mocks base method.
+func (m *MockNotificationServiceHandler) GetNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.GetNotificationSinkRequest]) (*connect.Response[v1.GetNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationSink indicates an expected call of GetNotificationSink.
+func (mr *MockNotificationServiceHandlerMockRecorder) GetNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationSink", reflect.TypeOf((*MockNotificationServiceHandler)(nil).GetNotificationSink), arg0, arg1)
+}
+
+// ListNotificationSinks mocks base method.
+func (m *MockNotificationServiceHandler) ListNotificationSinks(arg0 context.Context, arg1 *connect.Request[v1.ListNotificationSinksRequest]) (*connect.Response[v1.ListNotificationSinksResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationSinks", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListNotificationSinksResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationSinks indicates an expected call of ListNotificationSinks.
+func (mr *MockNotificationServiceHandlerMockRecorder) ListNotificationSinks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationSinks", reflect.TypeOf((*MockNotificationServiceHandler)(nil).ListNotificationSinks), arg0, arg1)
+}
+
+// TestNotificationSink mocks base method.
+func (m *MockNotificationServiceHandler) TestNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.TestNotificationSinkRequest]) (*connect.Response[v1.TestNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.TestNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestNotificationSink indicates an expected call of TestNotificationSink.
+func (mr *MockNotificationServiceHandlerMockRecorder) TestNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestNotificationSink", reflect.TypeOf((*MockNotificationServiceHandler)(nil).TestNotificationSink), arg0, arg1)
+}
+
+// UpdateNotificationSink mocks base method.
+func (m *MockNotificationServiceHandler) UpdateNotificationSink(arg0 context.Context, arg1 *connect.Request[v1.UpdateNotificationSinkRequest]) (*connect.Response[v1.UpdateNotificationSinkResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationSink", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UpdateNotificationSinkResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationSink indicates an expected call of UpdateNotificationSink.
+func (mr *MockNotificationServiceHandlerMockRecorder) UpdateNotificationSink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationSink", reflect.TypeOf((*MockNotificationServiceHandler)(nil).UpdateNotificationSink), arg0, arg1)
+}
@@ -0,0 +1,277 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/schedule.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/schedule.connect.go -destination=./mocks/schedule.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScheduleServiceClient is a mock of ScheduleServiceClient interface.
+type MockScheduleServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleServiceClientMockRecorder is the mock recorder for MockScheduleServiceClient.
+type MockScheduleServiceClientMockRecorder struct {
+	mock *MockScheduleServiceClient
+}
+
+// NewMockScheduleServiceClient creates a new mock instance.
+func NewMockScheduleServiceClient(ctrl *gomock.Controller) *MockScheduleServiceClient {
+	mock := &MockScheduleServiceClient{ctrl: ctrl}
+	mock.recorder = &MockScheduleServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleServiceClient) EXPECT() *MockScheduleServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateSchedule mocks base method.
+func (m *MockScheduleServiceClient) CreateSchedule(arg0 context.Context, arg1 *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CreateScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockScheduleServiceClientMockRecorder) CreateSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockScheduleServiceClient)(nil).CreateSchedule), arg0, arg1)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockScheduleServiceClient) DeleteSchedule(arg0 context.Context, arg1 *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.DeleteScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockScheduleServiceClientMockRecorder) DeleteSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockScheduleServiceClient)(nil).DeleteSchedule), arg0, arg1)
+}
+
+// GetSchedule mocks base method.
+func (m *MockScheduleServiceClient) GetSchedule(arg0 context.Context, arg1 *connect.Request[v1.GetScheduleRequest]) (*connect.Response[v1.GetScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *MockScheduleServiceClientMockRecorder) GetSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockScheduleServiceClient)(nil).GetSchedule), arg0, arg1)
+}
+
+// ListScheduleRuns mocks base method.
+func (m *MockScheduleServiceClient) ListScheduleRuns(arg0 context.Context, arg1 *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduleRuns", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListScheduleRunsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduleRuns indicates an expected call of ListScheduleRuns.
+func (mr *MockScheduleServiceClientMockRecorder) ListScheduleRuns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduleRuns", reflect.TypeOf((*MockScheduleServiceClient)(nil).ListScheduleRuns), arg0, arg1)
+}
+
+// ListSchedules mocks base method.
+func (m *MockScheduleServiceClient) ListSchedules(arg0 context.Context, arg1 *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListSchedulesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockScheduleServiceClientMockRecorder) ListSchedules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockScheduleServiceClient)(nil).ListSchedules), arg0, arg1)
+}
+
+// TriggerSchedule mocks base method.
+func (m *MockScheduleServiceClient) TriggerSchedule(arg0 context.Context, arg1 *connect.Request[v1.TriggerScheduleRequest]) (*connect.Response[v1.TriggerScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.TriggerScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockScheduleServiceClientMockRecorder) TriggerSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockScheduleServiceClient)(nil).TriggerSchedule), arg0, arg1)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockScheduleServiceClient) UpdateSchedule(arg0 context.Context, arg1 *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UpdateScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockScheduleServiceClientMockRecorder) UpdateSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockScheduleServiceClient)(nil).UpdateSchedule), arg0, arg1)
+}
+
+// MockScheduleServiceHandler is a mock of ScheduleServiceHandler interface.
+type MockScheduleServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleServiceHandlerMockRecorder is the mock recorder for MockScheduleServiceHandler.
+type MockScheduleServiceHandlerMockRecorder struct {
+	mock *MockScheduleServiceHandler
+}
+
+// NewMockScheduleServiceHandler creates a new mock instance.
+func NewMockScheduleServiceHandler(ctrl *gomock.Controller) *MockScheduleServiceHandler {
+	mock := &MockScheduleServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockScheduleServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleServiceHandler) EXPECT() *MockScheduleServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateSchedule mocks base method.
+func (m *MockScheduleServiceHandler) CreateSchedule(arg0 context.Context, arg1 *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CreateScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockScheduleServiceHandlerMockRecorder) CreateSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockScheduleServiceHandler)(nil).CreateSchedule), arg0, arg1)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockScheduleServiceHandler) DeleteSchedule(arg0 context.Context, arg1 *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.DeleteScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockScheduleServiceHandlerMockRecorder) DeleteSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockScheduleServiceHandler)(nil).DeleteSchedule), arg0, arg1)
+}
+
+// GetSchedule mocks base method.
+func (m *MockScheduleServiceHandler) GetSchedule(arg0 context.Context, arg1 *connect.Request[v1.GetScheduleRequest]) (*connect.Response[v1.GetScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *MockScheduleServiceHandlerMockRecorder) GetSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockScheduleServiceHandler)(nil).GetSchedule), arg0, arg1)
+}
+
+// ListScheduleRuns mocks base method.
+func (m *MockScheduleServiceHandler) ListScheduleRuns(arg0 context.Context, arg1 *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduleRuns", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListScheduleRunsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduleRuns indicates an expected call of ListScheduleRuns.
+func (mr *MockScheduleServiceHandlerMockRecorder) ListScheduleRuns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduleRuns", reflect.TypeOf((*MockScheduleServiceHandler)(nil).ListScheduleRuns), arg0, arg1)
+}
+
+// ListSchedules mocks base method.
+func (m *MockScheduleServiceHandler) ListSchedules(arg0 context.Context, arg1 *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListSchedulesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockScheduleServiceHandlerMockRecorder) ListSchedules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockScheduleServiceHandler)(nil).ListSchedules), arg0, arg1)
+}
+
+// TriggerSchedule mocks base method.
+func (m *MockScheduleServiceHandler) TriggerSchedule(arg0 context.Context, arg1 *connect.Request[v1.TriggerScheduleRequest]) (*connect.Response[v1.TriggerScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.TriggerScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockScheduleServiceHandlerMockRecorder) TriggerSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockScheduleServiceHandler)(nil).TriggerSchedule), arg0, arg1)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockScheduleServiceHandler) UpdateSchedule(arg0 context.Context, arg1 *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UpdateScheduleResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockScheduleServiceHandlerMockRecorder) UpdateSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockScheduleServiceHandler)(nil).UpdateSchedule), arg0, arg1)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/search.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/search.connect.go -destination=./mocks/search.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSearchServiceClient is a mock of SearchServiceClient interface.
+type MockSearchServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchServiceClientMockRecorder is the mock recorder for MockSearchServiceClient.
+type MockSearchServiceClientMockRecorder struct {
+	mock *MockSearchServiceClient
+}
+
+// NewMockSearchServiceClient creates a new mock instance.
+func NewMockSearchServiceClient(ctrl *gomock.Controller) *MockSearchServiceClient {
+	mock := &MockSearchServiceClient{ctrl: ctrl}
+	mock.recorder = &MockSearchServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchServiceClient) EXPECT() *MockSearchServiceClientMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchServiceClient) Search(arg0 context.Context, arg1 *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.SearchResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchServiceClientMockRecorder) Search(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchServiceClient)(nil).Search), arg0, arg1)
+}
+
+// MockSearchServiceHandler is a mock of SearchServiceHandler interface.
+type MockSearchServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchServiceHandlerMockRecorder is the mock recorder for MockSearchServiceHandler.
+type MockSearchServiceHandlerMockRecorder struct {
+	mock *MockSearchServiceHandler
+}
+
+// NewMockSearchServiceHandler creates a new mock instance.
+func NewMockSearchServiceHandler(ctrl *gomock.Controller) *MockSearchServiceHandler {
+	mock := &MockSearchServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockSearchServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchServiceHandler) EXPECT() *MockSearchServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchServiceHandler) Search(arg0 context.Context, arg1 *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.SearchResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchServiceHandlerMockRecorder) Search(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchServiceHandler)(nil).Search), arg0, arg1)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/secret.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/secret.connect.go -destination=./mocks/secret.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecretServiceClient is a mock of SecretServiceClient interface.
+type MockSecretServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretServiceClientMockRecorder is the mock recorder for MockSecretServiceClient.
+type MockSecretServiceClientMockRecorder struct {
+	mock *MockSecretServiceClient
+}
+
+// NewMockSecretServiceClient creates a new mock instance.
+func NewMockSecretServiceClient(ctrl *gomock.Controller) *MockSecretServiceClient {
+	mock := &MockSecretServiceClient{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretServiceClient) EXPECT() *MockSecretServiceClientMockRecorder {
+	return m.recorder
+}
+
+// RotateEncryptionKey mocks base method.
+func (m *MockSecretServiceClient) RotateEncryptionKey(arg0 context.Context, arg1 *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RotateEncryptionKeyResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateEncryptionKey indicates an expected call of RotateEncryptionKey.
+func (mr *MockSecretServiceClientMockRecorder) RotateEncryptionKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateEncryptionKey", reflect.TypeOf((*MockSecretServiceClient)(nil).RotateEncryptionKey), arg0, arg1)
+}
+
+// MockSecretServiceHandler is a mock of SecretServiceHandler interface.
+type MockSecretServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretServiceHandlerMockRecorder is the mock recorder for MockSecretServiceHandler.
+type MockSecretServiceHandlerMockRecorder struct {
+	mock *MockSecretServiceHandler
+}
+
+// NewMockSecretServiceHandler creates a new mock instance.
+func NewMockSecretServiceHandler(ctrl *gomock.Controller) *MockSecretServiceHandler {
+	mock := &MockSecretServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretServiceHandler) EXPECT() *MockSecretServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// RotateEncryptionKey mocks base method.
+func (m *MockSecretServiceHandler) RotateEncryptionKey(arg0 context.Context, arg1 *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RotateEncryptionKeyResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateEncryptionKey indicates an expected call of RotateEncryptionKey.
+func (mr *MockSecretServiceHandlerMockRecorder) RotateEncryptionKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateEncryptionKey", reflect.TypeOf((*MockSecretServiceHandler)(nil).RotateEncryptionKey), arg0, arg1)
+}
@@ -42,6 +42,36 @@ func (m *MockTaskServiceClient) EXPECT() *MockTaskServiceClientMockRecorder {
 	return m.recorder
 }
 
+// AcceptFileChange mocks base method.
+func (m *MockTaskServiceClient) AcceptFileChange(arg0 context.Context, arg1 *connect.Request[v1.AcceptFileChangeRequest]) (*connect.Response[v1.AcceptFileChangeResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptFileChange", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.AcceptFileChangeResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptFileChange indicates an expected call of AcceptFileChange.
+func (mr *MockTaskServiceClientMockRecorder) AcceptFileChange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptFileChange", reflect.TypeOf((*MockTaskServiceClient)(nil).AcceptFileChange), arg0, arg1)
+}
+
+// CompactTask mocks base method.
+func (m *MockTaskServiceClient) CompactTask(arg0 context.Context, arg1 *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CompactTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactTask indicates an expected call of CompactTask.
+func (mr *MockTaskServiceClientMockRecorder) CompactTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactTask", reflect.TypeOf((*MockTaskServiceClient)(nil).CompactTask), arg0, arg1)
+}
+
 // CreateTask mocks base method.
 func (m *MockTaskServiceClient) CreateTask(arg0 context.Context, arg1 *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -72,6 +102,36 @@ func (mr *MockTaskServiceClientMockRecorder) DeleteTask(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskServiceClient)(nil).DeleteTask), arg0, arg1)
 }
 
+// ExportTranscript mocks base method.
+func (m *MockTaskServiceClient) ExportTranscript(arg0 context.Context, arg1 *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTranscript", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ExportTranscriptResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTranscript indicates an expected call of ExportTranscript.
+func (mr *MockTaskServiceClientMockRecorder) ExportTranscript(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTranscript", reflect.TypeOf((*MockTaskServiceClient)(nil).ExportTranscript), arg0, arg1)
+}
+
+// ForkTask mocks base method.
+func (m *MockTaskServiceClient) ForkTask(arg0 context.Context, arg1 *connect.Request[v1.ForkTaskRequest]) (*connect.Response[v1.ForkTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ForkTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkTask indicates an expected call of ForkTask.
+func (mr *MockTaskServiceClientMockRecorder) ForkTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkTask", reflect.TypeOf((*MockTaskServiceClient)(nil).ForkTask), arg0, arg1)
+}
+
 // GetTask mocks base method.
 func (m *MockTaskServiceClient) GetTask(arg0 context.Context, arg1 *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -87,6 +147,21 @@ func (mr *MockTaskServiceClientMockRecorder) GetTask(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockTaskServiceClient)(nil).GetTask), arg0, arg1)
 }
 
+// ListFileChanges mocks base method.
+func (m *MockTaskServiceClient) ListFileChanges(arg0 context.Context, arg1 *connect.Request[v1.ListFileChangesRequest]) (*connect.Response[v1.ListFileChangesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFileChanges", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListFileChangesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFileChanges indicates an expected call of ListFileChanges.
+func (mr *MockTaskServiceClientMockRecorder) ListFileChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFileChanges", reflect.TypeOf((*MockTaskServiceClient)(nil).ListFileChanges), arg0, arg1)
+}
+
 // ListTasks mocks base method.
 func (m *MockTaskServiceClient) ListTasks(arg0 context.Context, arg1 *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
 	m.ctrl.T.Helper()
@@ -102,6 +177,51 @@ func (mr *MockTaskServiceClientMockRecorder) ListTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskServiceClient)(nil).ListTasks), arg0, arg1)
 }
 
+// ListWorkspaceFiles mocks base method.
+func (m *MockTaskServiceClient) ListWorkspaceFiles(arg0 context.Context, arg1 *connect.Request[v1.ListWorkspaceFilesRequest]) (*connect.Response[v1.ListWorkspaceFilesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspaceFiles", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListWorkspaceFilesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkspaceFiles indicates an expected call of ListWorkspaceFiles.
+func (mr *MockTaskServiceClientMockRecorder) ListWorkspaceFiles(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaceFiles", reflect.TypeOf((*MockTaskServiceClient)(nil).ListWorkspaceFiles), arg0, arg1)
+}
+
+// RejectFileChange mocks base method.
+func (m *MockTaskServiceClient) RejectFileChange(arg0 context.Context, arg1 *connect.Request[v1.RejectFileChangeRequest]) (*connect.Response[v1.RejectFileChangeResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectFileChange", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RejectFileChangeResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectFileChange indicates an expected call of RejectFileChange.
+func (mr *MockTaskServiceClientMockRecorder) RejectFileChange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectFileChange", reflect.TypeOf((*MockTaskServiceClient)(nil).RejectFileChange), arg0, arg1)
+}
+
+// SearchWorkspaceSymbols mocks base method.
+func (m *MockTaskServiceClient) SearchWorkspaceSymbols(arg0 context.Context, arg1 *connect.Request[v1.SearchWorkspaceSymbolsRequest]) (*connect.Response[v1.SearchWorkspaceSymbolsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchWorkspaceSymbols", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.SearchWorkspaceSymbolsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchWorkspaceSymbols indicates an expected call of SearchWorkspaceSymbols.
+func (mr *MockTaskServiceClientMockRecorder) SearchWorkspaceSymbols(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchWorkspaceSymbols", reflect.TypeOf((*MockTaskServiceClient)(nil).SearchWorkspaceSymbols), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockTaskServiceClient) Subscribe(arg0 context.Context, arg1 *connect.Request[v1.SubscribeRequest]) (*connect.ServerStreamForClient[v1.SubscribeResponse], error) {
 	m.ctrl.T.Helper()
@@ -171,6 +291,36 @@ func (m *MockTaskServiceHandler) EXPECT() *MockTaskServiceHandlerMockRecorder {
 	return m.recorder
 }
 
+// AcceptFileChange mocks base method.
+func (m *MockTaskServiceHandler) AcceptFileChange(arg0 context.Context, arg1 *connect.Request[v1.AcceptFileChangeRequest]) (*connect.Response[v1.AcceptFileChangeResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptFileChange", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.AcceptFileChangeResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptFileChange indicates an expected call of AcceptFileChange.
+func (mr *MockTaskServiceHandlerMockRecorder) AcceptFileChange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptFileChange", reflect.TypeOf((*MockTaskServiceHandler)(nil).AcceptFileChange), arg0, arg1)
+}
+
+// CompactTask mocks base method.
+func (m *MockTaskServiceHandler) CompactTask(arg0 context.Context, arg1 *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CompactTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactTask indicates an expected call of CompactTask.
+func (mr *MockTaskServiceHandlerMockRecorder) CompactTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).CompactTask), arg0, arg1)
+}
+
 // CreateTask mocks base method.
 func (m *MockTaskServiceHandler) CreateTask(arg0 context.Context, arg1 *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -201,6 +351,36 @@ func (mr *MockTaskServiceHandlerMockRecorder) DeleteTask(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).DeleteTask), arg0, arg1)
 }
 
+// ExportTranscript mocks base method.
+func (m *MockTaskServiceHandler) ExportTranscript(arg0 context.Context, arg1 *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTranscript", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ExportTranscriptResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTranscript indicates an expected call of ExportTranscript.
+func (mr *MockTaskServiceHandlerMockRecorder) ExportTranscript(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTranscript", reflect.TypeOf((*MockTaskServiceHandler)(nil).ExportTranscript), arg0, arg1)
+}
+
+// ForkTask mocks base method.
+func (m *MockTaskServiceHandler) ForkTask(arg0 context.Context, arg1 *connect.Request[v1.ForkTaskRequest]) (*connect.Response[v1.ForkTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ForkTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkTask indicates an expected call of ForkTask.
+func (mr *MockTaskServiceHandlerMockRecorder) ForkTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).ForkTask), arg0, arg1)
+}
+
 // GetTask mocks base method.
 func (m *MockTaskServiceHandler) GetTask(arg0 context.Context, arg1 *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -216,6 +396,21 @@ func (mr *MockTaskServiceHandlerMockRecorder) GetTask(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).GetTask), arg0, arg1)
 }
 
+// ListFileChanges mocks base method.
+func (m *MockTaskServiceHandler) ListFileChanges(arg0 context.Context, arg1 *connect.Request[v1.ListFileChangesRequest]) (*connect.Response[v1.ListFileChangesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFileChanges", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListFileChangesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFileChanges indicates an expected call of ListFileChanges.
+func (mr *MockTaskServiceHandlerMockRecorder) ListFileChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFileChanges", reflect.TypeOf((*MockTaskServiceHandler)(nil).ListFileChanges), arg0, arg1)
+}
+
 // ListTasks mocks base method.
 func (m *MockTaskServiceHandler) ListTasks(arg0 context.Context, arg1 *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
 	m.ctrl.T.Helper()
@@ -231,6 +426,51 @@ func (mr *MockTaskServiceHandlerMockRecorder) ListTasks(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskServiceHandler)(nil).ListTasks), arg0, arg1)
 }
 
+// ListWorkspaceFiles mocks base method.
+func (m *MockTaskServiceHandler) ListWorkspaceFiles(arg0 context.Context, arg1 *connect.Request[v1.ListWorkspaceFilesRequest]) (*connect.Response[v1.ListWorkspaceFilesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspaceFiles", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListWorkspaceFilesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkspaceFiles indicates an expected call of ListWorkspaceFiles.
+func (mr *MockTaskServiceHandlerMockRecorder) ListWorkspaceFiles(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaceFiles", reflect.TypeOf((*MockTaskServiceHandler)(nil).ListWorkspaceFiles), arg0, arg1)
+}
+
+// RejectFileChange mocks base method.
+func (m *MockTaskServiceHandler) RejectFileChange(arg0 context.Context, arg1 *connect.Request[v1.RejectFileChangeRequest]) (*connect.Response[v1.RejectFileChangeResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectFileChange", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RejectFileChangeResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectFileChange indicates an expected call of RejectFileChange.
+func (mr *MockTaskServiceHandlerMockRecorder) RejectFileChange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectFileChange", reflect.TypeOf((*MockTaskServiceHandler)(nil).RejectFileChange), arg0, arg1)
+}
+
+// SearchWorkspaceSymbols mocks base method.
+func (m *MockTaskServiceHandler) SearchWorkspaceSymbols(arg0 context.Context, arg1 *connect.Request[v1.SearchWorkspaceSymbolsRequest]) (*connect.Response[v1.SearchWorkspaceSymbolsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchWorkspaceSymbols", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.SearchWorkspaceSymbolsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchWorkspaceSymbols indicates an expected call of SearchWorkspaceSymbols.
+func (mr *MockTaskServiceHandlerMockRecorder) SearchWorkspaceSymbols(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchWorkspaceSymbols", reflect.TypeOf((*MockTaskServiceHandler)(nil).SearchWorkspaceSymbols), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockTaskServiceHandler) Subscribe(arg0 context.Context, arg1 *connect.Request[v1.SubscribeRequest], arg2 *connect.ServerStream[v1.SubscribeResponse]) error {
 	m.ctrl.T.Helper()
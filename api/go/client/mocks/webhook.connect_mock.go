@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/webhook.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/webhook.connect.go -destination=./mocks/webhook.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookServiceClient is a mock of WebhookServiceClient interface.
+type MockWebhookServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookServiceClientMockRecorder is the mock recorder for MockWebhookServiceClient.
+type MockWebhookServiceClientMockRecorder struct {
+	mock *MockWebhookServiceClient
+}
+
+// NewMockWebhookServiceClient creates a new mock instance.
+func NewMockWebhookServiceClient(ctrl *gomock.Controller) *MockWebhookServiceClient {
+	mock := &MockWebhookServiceClient{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookServiceClient) EXPECT() *MockWebhookServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhookTrigger mocks base method.
+func (m *MockWebhookServiceClient) CreateWebhookTrigger(arg0 context.Context, arg1 *connect.Request[v1.CreateWebhookTriggerRequest]) (*connect.Response[v1.CreateWebhookTriggerResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookTrigger", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CreateWebhookTriggerResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookTrigger indicates an expected call of CreateWebhookTrigger.
+func (mr *MockWebhookServiceClientMockRecorder) CreateWebhookTrigger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookTrigger", reflect.TypeOf((*MockWebhookServiceClient)(nil).CreateWebhookTrigger), arg0, arg1)
+}
+
+// DeleteWebhookTrigger mocks base method.
+func (m *MockWebhookServiceClient) DeleteWebhookTrigger(arg0 context.Context, arg1 *connect.Request[v1.DeleteWebhookTriggerRequest]) (*connect.Response[v1.DeleteWebhookTriggerResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookTrigger", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.DeleteWebhookTriggerResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookTrigger indicates an expected call of DeleteWebhookTrigger.
+func (mr *MockWebhookServiceClientMockRecorder) DeleteWebhookTrigger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookTrigger", reflect.TypeOf((*MockWebhookServiceClient)(nil).DeleteWebhookTrigger), arg0, arg1)
+}
+
+// GetWebhookTrigger mocks base method.
+func (m *MockWebhookServiceClient) GetWebhookTrigger(arg0 context.Context, arg1 *connect.Request[v1.GetWebhookTriggerRequest]) (*connect.Response[v1.GetWebhookTriggerResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookTrigger", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetWebhookTriggerResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookTrigger indicates an expected call of GetWebhookTrigger.
+func (mr *MockWebhookServiceClientMockRecorder) GetWebhookTrigger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookTrigger", reflect.TypeOf((*MockWebhookServiceClient)(nil).GetWebhookTrigger), arg0, arg1)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockWebhookServiceClient) ListWebhookDeliveries(arg0 context.Context, arg1 *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListWebhookDeliveriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockWebhookServiceClientMockRecorder) ListWebhookDeliveries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockWebhookServiceClient)(nil).ListWebhookDeliveries), arg0, arg1)
+}
+
+// ListWebhookTriggers mocks base method.
+func (m *MockWebhookServiceClient) ListWebhookTriggers(arg0 context.Context, arg1 *connect.Request[v1.ListWebhookTriggersRequest]) (*connect.Response[v1.ListWebhookTriggersResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookTriggers", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListWebhookTriggersResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookTriggers indicates an expected call of ListWebhookTriggers.
+func (mr *MockWebhookServiceClientMockRecorder) ListWebhookTriggers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookTriggers", reflect.TypeOf((*MockWebhookServiceClient)(nil).ListWebhookTriggers), arg0, arg1)
+}
+
+// UpdateWebhookTrigger mocks base method.
+func (m *MockWebhookServiceClient) UpdateWebhookTrigger(arg0 context.Context, arg1 *connect.Request[v1.UpdateWebhookTriggerRequest]) (*connect.Response[v1.UpdateWebhookTriggerResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookTrigger", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UpdateWebhookTriggerResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookTrigger indicates an expected call of UpdateWebhookTrigger.
+func (mr *MockWebhookServiceClientMockRecorder) UpdateWebhookTrigger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookTrigger", reflect.TypeOf((*MockWebhookServiceClient)(nil).UpdateWebhookTrigger), arg0, arg1)
+}
+
+// MockWebhookServiceHandler is a mock of WebhookServiceHandler interface.
+type MockWebhookServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookServiceHandlerMockRecorder is the mock recorder for MockWebhookServiceHandler.
+type MockWebhookServiceHandlerMockRecorder struct {
+	mock *MockWebhookServiceHandler
+}
+
+// NewMockWebhookServiceHandler creates a new mock instance.
+func NewMockWebhookServiceHandler(ctrl *gomock.Controller) *MockWebhookServiceHandler {
+	mock := &MockWebhookServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookServiceHandler) EXPECT() *MockWebhookServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhookTrigger mocks base method.
+func (m *MockWebhookServiceHandler) CreateWebhookTrigger(arg0 context.Context, arg1 *connect.Request[v1.CreateWebhookTriggerRequest]) (*connect.Response[v1.CreateWebhookTriggerResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookTrigger", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CreateWebhookTriggerResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookTrigger indicates an expected call of CreateWebhookTrigger.
+func (mr *MockWebhookServiceHandlerMockRecorder) CreateWebhookTrigger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookTrigger", reflect.TypeOf((*MockWebhookServiceHandler)(nil).CreateWebhookTrigger), arg0, arg1)
+}
+
+// DeleteWebhookTrigger mocks base method.
+func (m *MockWebhookServiceHandler) DeleteWebhookTrigger(arg0 context.Context, arg1 *connect.Request[v1.DeleteWebhookTriggerRequest]) (*connect.Response[v1.DeleteWebhookTriggerResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookTrigger", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.DeleteWebhookTriggerResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookTrigger indicates an expected call of DeleteWebhookTrigger.
+func (mr *MockWebhookServiceHandlerMockRecorder) DeleteWebhookTrigger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookTrigger", reflect.TypeOf((*MockWebhookServiceHandler)(nil).DeleteWebhookTrigger), arg0, arg1)
+}
+
+// GetWebhookTrigger mocks base method.
+func (m *MockWebhookServiceHandler) GetWebhookTrigger(arg0 context.Context, arg1 *connect.Request[v1.GetWebhookTriggerRequest]) (*connect.Response[v1.GetWebhookTriggerResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookTrigger", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetWebhookTriggerResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookTrigger indicates an expected call of GetWebhookTrigger.
+func (mr *MockWebhookServiceHandlerMockRecorder) GetWebhookTrigger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookTrigger", reflect.TypeOf((*MockWebhookServiceHandler)(nil).GetWebhookTrigger), arg0, arg1)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockWebhookServiceHandler) ListWebhookDeliveries(arg0 context.Context, arg1 *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListWebhookDeliveriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockWebhookServiceHandlerMockRecorder) ListWebhookDeliveries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockWebhookServiceHandler)(nil).ListWebhookDeliveries), arg0, arg1)
+}
+
+// ListWebhookTriggers mocks base method.
+func (m *MockWebhookServiceHandler) ListWebhookTriggers(arg0 context.Context, arg1 *connect.Request[v1.ListWebhookTriggersRequest]) (*connect.Response[v1.ListWebhookTriggersResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookTriggers", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListWebhookTriggersResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookTriggers indicates an expected call of ListWebhookTriggers.
+func (mr *MockWebhookServiceHandlerMockRecorder) ListWebhookTriggers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookTriggers", reflect.TypeOf((*MockWebhookServiceHandler)(nil).ListWebhookTriggers), arg0, arg1)
+}
+
+// UpdateWebhookTrigger mocks base method.
+func (m *MockWebhookServiceHandler) UpdateWebhookTrigger(arg0 context.Context, arg1 *connect.Request[v1.UpdateWebhookTriggerRequest]) (*connect.Response[v1.UpdateWebhookTriggerResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookTrigger", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UpdateWebhookTriggerResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookTrigger indicates an expected call of UpdateWebhookTrigger.
+func (mr *MockWebhookServiceHandlerMockRecorder) UpdateWebhookTrigger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookTrigger", reflect.TypeOf((*MockWebhookServiceHandler)(nil).UpdateWebhookTrigger), arg0, arg1)
+}
@@ -90,7 +90,12 @@ type AgentMetadata struct {
 	// created_at is the timestamp when the agent was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// updated_at is the timestamp when the agent was last modified.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// owner is the user that created the agent. It is empty for agents created over the
+	// Unix socket, which are visible to every user.
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// team is the team of the owner. Members of the team can read the agent but not change it.
+	Team          string `protobuf:"bytes,5,opt,name=team,proto3" json:"team,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -146,6 +151,20 @@ func (x *AgentMetadata) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AgentMetadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *AgentMetadata) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
 // AgentSpec defines the user-configurable specification of an agent.
 type AgentSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -156,7 +175,10 @@ type AgentSpec struct {
 	// instructions define the agent's behavior and capabilities (1-10000 characters).
 	Instructions string `protobuf:"bytes,3,opt,name=instructions,proto3" json:"instructions,omitempty"`
 	// model_id references the AI model that powers this agent (UUID format).
-	ModelId       string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	ModelId string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// tools are the names of the custom tools the agent can use in addition to the built-in
+	// tools. Custom tools are defined by the plugins in the tool directory of the daemon.
+	Tools         []string `protobuf:"bytes,5,rep,name=tools,proto3" json:"tools,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -219,6 +241,59 @@ func (x *AgentSpec) GetModelId() string {
 	return ""
 }
 
+func (x *AgentSpec) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+// ToolList is a list of custom tools. It distinguishes an empty list from an absent one.
+type ToolList struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// names are the names of the custom tools.
+	Names         []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolList) Reset() {
+	*x = ToolList{}
+	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolList) ProtoMessage() {}
+
+func (x *ToolList) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolList.ProtoReflect.Descriptor instead.
+func (*ToolList) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ToolList) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
 // CreateAgentRequest contains the parameters needed to create a new agent.
 type CreateAgentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -229,14 +304,16 @@ type CreateAgentRequest struct {
 	// instructions define the agent's behavior and capabilities (1-65536 characters).
 	Instructions string `protobuf:"bytes,3,opt,name=instructions,proto3" json:"instructions,omitempty"`
 	// model_id references the AI model that will power this agent (UUID format).
-	ModelId       string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	ModelId string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// tools are the names of the custom tools the agent can use (max 64, optional).
+	Tools         []string `protobuf:"bytes,5,rep,name=tools,proto3" json:"tools,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateAgentRequest) Reset() {
 	*x = CreateAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	mi := &file_construct_v1_agent_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +325,7 @@ func (x *CreateAgentRequest) String() string {
 func (*CreateAgentRequest) ProtoMessage() {}
 
 func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	mi := &file_construct_v1_agent_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +338,7 @@ func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAgentRequest.ProtoReflect.Descriptor instead.
 func (*CreateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{3}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateAgentRequest) GetName() string {
@@ -292,6 +369,13 @@ func (x *CreateAgentRequest) GetModelId() string {
 	return ""
 }
 
+func (x *CreateAgentRequest) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
 // CreateAgentResponse contains the newly created agent.
 type CreateAgentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -303,7 +387,7 @@ type CreateAgentResponse struct {
 
 func (x *CreateAgentResponse) Reset() {
 	*x = CreateAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	mi := &file_construct_v1_agent_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +399,7 @@ func (x *CreateAgentResponse) String() string {
 func (*CreateAgentResponse) ProtoMessage() {}
 
 func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	mi := &file_construct_v1_agent_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +412,7 @@ func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAgentResponse.ProtoReflect.Descriptor instead.
 func (*CreateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{4}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateAgentResponse) GetAgent() *Agent {
@@ -349,7 +433,7 @@ type GetAgentRequest struct {
 
 func (x *GetAgentRequest) Reset() {
 	*x = GetAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	mi := &file_construct_v1_agent_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +445,7 @@ func (x *GetAgentRequest) String() string {
 func (*GetAgentRequest) ProtoMessage() {}
 
 func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	mi := &file_construct_v1_agent_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +458,7 @@ func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentRequest.ProtoReflect.Descriptor instead.
 func (*GetAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{5}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetAgentRequest) GetId() string {
@@ -395,7 +479,7 @@ type GetAgentResponse struct {
 
 func (x *GetAgentResponse) Reset() {
 	*x = GetAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[6]
+	mi := &file_construct_v1_agent_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +491,7 @@ func (x *GetAgentResponse) String() string {
 func (*GetAgentResponse) ProtoMessage() {}
 
 func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[6]
+	mi := &file_construct_v1_agent_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +504,7 @@ func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentResponse.ProtoReflect.Descriptor instead.
 func (*GetAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{6}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetAgentResponse) GetAgent() *Agent {
@@ -449,7 +533,7 @@ type ListAgentsRequest struct {
 
 func (x *ListAgentsRequest) Reset() {
 	*x = ListAgentsRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[7]
+	mi := &file_construct_v1_agent_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +545,7 @@ func (x *ListAgentsRequest) String() string {
 func (*ListAgentsRequest) ProtoMessage() {}
 
 func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[7]
+	mi := &file_construct_v1_agent_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +558,7 @@ func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
 func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListAgentsRequest) GetFilter() *ListAgentsRequest_Filter {
@@ -525,7 +609,7 @@ type ListAgentsResponse struct {
 
 func (x *ListAgentsResponse) Reset() {
 	*x = ListAgentsResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[8]
+	mi := &file_construct_v1_agent_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -537,7 +621,7 @@ func (x *ListAgentsResponse) String() string {
 func (*ListAgentsResponse) ProtoMessage() {}
 
 func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[8]
+	mi := &file_construct_v1_agent_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +634,7 @@ func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
 func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{8}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListAgentsResponse) GetAgents() []*Agent {
@@ -579,14 +663,17 @@ type UpdateAgentRequest struct {
 	// instructions are the new instructions for the agent (1-65536 characters, optional).
 	Instructions *string `protobuf:"bytes,4,opt,name=instructions,proto3,oneof" json:"instructions,omitempty"`
 	// model_id is the new model reference for the agent (UUID format, optional).
-	ModelId       *string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
+	ModelId *string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
+	// tools replaces the custom tools of the agent if set. An empty list removes all custom
+	// tools (optional).
+	Tools         *ToolList `protobuf:"bytes,6,opt,name=tools,proto3" json:"tools,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateAgentRequest) Reset() {
 	*x = UpdateAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[9]
+	mi := &file_construct_v1_agent_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +685,7 @@ func (x *UpdateAgentRequest) String() string {
 func (*UpdateAgentRequest) ProtoMessage() {}
 
 func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[9]
+	mi := &file_construct_v1_agent_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +698,7 @@ func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{9}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateAgentRequest) GetId() string {
@@ -649,6 +736,13 @@ func (x *UpdateAgentRequest) GetModelId() string {
 	return ""
 }
 
+func (x *UpdateAgentRequest) GetTools() *ToolList {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
 // UpdateAgentResponse contains the updated agent.
 type UpdateAgentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -660,7 +754,7 @@ type UpdateAgentResponse struct {
 
 func (x *UpdateAgentResponse) Reset() {
 	*x = UpdateAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[10]
+	mi := &file_construct_v1_agent_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +766,7 @@ func (x *UpdateAgentResponse) String() string {
 func (*UpdateAgentResponse) ProtoMessage() {}
 
 func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[10]
+	mi := &file_construct_v1_agent_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +779,7 @@ func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{10}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateAgentResponse) GetAgent() *Agent {
@@ -706,7 +800,7 @@ type DeleteAgentRequest struct {
 
 func (x *DeleteAgentRequest) Reset() {
 	*x = DeleteAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[11]
+	mi := &file_construct_v1_agent_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -718,7 +812,7 @@ func (x *DeleteAgentRequest) String() string {
 func (*DeleteAgentRequest) ProtoMessage() {}
 
 func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[11]
+	mi := &file_construct_v1_agent_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +825,7 @@ func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{11}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteAgentRequest) GetId() string {
@@ -750,7 +844,7 @@ type DeleteAgentResponse struct {
 
 func (x *DeleteAgentResponse) Reset() {
 	*x = DeleteAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[12]
+	mi := &file_construct_v1_agent_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +856,7 @@ func (x *DeleteAgentResponse) String() string {
 func (*DeleteAgentResponse) ProtoMessage() {}
 
 func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[12]
+	mi := &file_construct_v1_agent_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +869,7 @@ func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{12}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{13}
 }
 
 // Filter specifies criteria for narrowing the list of returned agents.
@@ -789,7 +883,7 @@ type ListAgentsRequest_Filter struct {
 
 func (x *ListAgentsRequest_Filter) Reset() {
 	*x = ListAgentsRequest_Filter{}
-	mi := &file_construct_v1_agent_proto_msgTypes[13]
+	mi := &file_construct_v1_agent_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +895,7 @@ func (x *ListAgentsRequest_Filter) String() string {
 func (*ListAgentsRequest_Filter) ProtoMessage() {}
 
 func (x *ListAgentsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[13]
+	mi := &file_construct_v1_agent_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +908,7 @@ func (x *ListAgentsRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListAgentsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7, 0}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *ListAgentsRequest_Filter) GetNames() []string {
@@ -831,25 +925,34 @@ const file_construct_v1_agent_proto_rawDesc = "" +
 	"\x18construct/v1/agent.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"m\n" +
 	"\x05Agent\x127\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1b.construct.v1.AgentMetadataR\bmetadata\x12+\n" +
-	"\x04spec\x18\x02 \x01(\v2\x17.construct.v1.AgentSpecR\x04spec\"\xaf\x01\n" +
+	"\x04spec\x18\x02 \x01(\v2\x17.construct.v1.AgentSpecR\x04spec\"\xd9\x01\n" +
 	"\rAgentMetadata\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xad\x01\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04team\x18\x05 \x01(\tR\x04team\"\xcf\x01\n" +
 	"\tAgentSpec\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
 	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12/\n" +
 	"\finstructions\x18\x03 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\finstructions\x12#\n" +
-	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\"\xb6\x01\n" +
+	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\x12 \n" +
+	"\x05tools\x18\x05 \x03(\tB\n" +
+	"\xbaH\a\x92\x01\x04\x10@\x18\x01R\x05tools\",\n" +
+	"\bToolList\x12 \n" +
+	"\x05names\x18\x01 \x03(\tB\n" +
+	"\xbaH\a\x92\x01\x04\x10@\x18\x01R\x05names\"\xd8\x01\n" +
 	"\x12CreateAgentRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
 	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12/\n" +
 	"\finstructions\x18\x03 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\finstructions\x12#\n" +
-	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\"H\n" +
+	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\x12 \n" +
+	"\x05tools\x18\x05 \x03(\tB\n" +
+	"\xbaH\a\x92\x01\x04\x10@\x18\x01R\x05tools\"H\n" +
 	"\x13CreateAgentResponse\x121\n" +
 	"\x05agent\x18\x01 \x01(\v2\x13.construct.v1.AgentB\x06\xbaH\x03\xc8\x01\x01R\x05agent\"+\n" +
 	"\x0fGetAgentRequest\x12\x18\n" +
@@ -873,14 +976,15 @@ const file_construct_v1_agent_proto_rawDesc = "" +
 	"\v_sort_order\"i\n" +
 	"\x12ListAgentsResponse\x12+\n" +
 	"\x06agents\x18\x01 \x03(\v2\x13.construct.v1.AgentR\x06agents\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9b\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc9\x02\n" +
 	"\x12UpdateAgentRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\vdescription\x88\x01\x01\x124\n" +
 	"\finstructions\x18\x04 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04H\x02R\finstructions\x88\x01\x01\x12(\n" +
-	"\bmodel_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\amodelId\x88\x01\x01B\a\n" +
+	"\bmodel_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\amodelId\x88\x01\x01\x12,\n" +
+	"\x05tools\x18\x06 \x01(\v2\x16.construct.v1.ToolListR\x05toolsB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x0f\n" +
 	"\r_instructionsB\v\n" +
@@ -910,53 +1014,55 @@ func file_construct_v1_agent_proto_rawDescGZIP() []byte {
 	return file_construct_v1_agent_proto_rawDescData
 }
 
-var file_construct_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_construct_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_construct_v1_agent_proto_goTypes = []any{
 	(*Agent)(nil),                    // 0: construct.v1.Agent
 	(*AgentMetadata)(nil),            // 1: construct.v1.AgentMetadata
 	(*AgentSpec)(nil),                // 2: construct.v1.AgentSpec
-	(*CreateAgentRequest)(nil),       // 3: construct.v1.CreateAgentRequest
-	(*CreateAgentResponse)(nil),      // 4: construct.v1.CreateAgentResponse
-	(*GetAgentRequest)(nil),          // 5: construct.v1.GetAgentRequest
-	(*GetAgentResponse)(nil),         // 6: construct.v1.GetAgentResponse
-	(*ListAgentsRequest)(nil),        // 7: construct.v1.ListAgentsRequest
-	(*ListAgentsResponse)(nil),       // 8: construct.v1.ListAgentsResponse
-	(*UpdateAgentRequest)(nil),       // 9: construct.v1.UpdateAgentRequest
-	(*UpdateAgentResponse)(nil),      // 10: construct.v1.UpdateAgentResponse
-	(*DeleteAgentRequest)(nil),       // 11: construct.v1.DeleteAgentRequest
-	(*DeleteAgentResponse)(nil),      // 12: construct.v1.DeleteAgentResponse
-	(*ListAgentsRequest_Filter)(nil), // 13: construct.v1.ListAgentsRequest.Filter
-	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
-	(SortField)(0),                   // 15: construct.v1.SortField
-	(SortOrder)(0),                   // 16: construct.v1.SortOrder
+	(*ToolList)(nil),                 // 3: construct.v1.ToolList
+	(*CreateAgentRequest)(nil),       // 4: construct.v1.CreateAgentRequest
+	(*CreateAgentResponse)(nil),      // 5: construct.v1.CreateAgentResponse
+	(*GetAgentRequest)(nil),          // 6: construct.v1.GetAgentRequest
+	(*GetAgentResponse)(nil),         // 7: construct.v1.GetAgentResponse
+	(*ListAgentsRequest)(nil),        // 8: construct.v1.ListAgentsRequest
+	(*ListAgentsResponse)(nil),       // 9: construct.v1.ListAgentsResponse
+	(*UpdateAgentRequest)(nil),       // 10: construct.v1.UpdateAgentRequest
+	(*UpdateAgentResponse)(nil),      // 11: construct.v1.UpdateAgentResponse
+	(*DeleteAgentRequest)(nil),       // 12: construct.v1.DeleteAgentRequest
+	(*DeleteAgentResponse)(nil),      // 13: construct.v1.DeleteAgentResponse
+	(*ListAgentsRequest_Filter)(nil), // 14: construct.v1.ListAgentsRequest.Filter
+	(*timestamppb.Timestamp)(nil),    // 15: google.protobuf.Timestamp
+	(SortField)(0),                   // 16: construct.v1.SortField
+	(SortOrder)(0),                   // 17: construct.v1.SortOrder
 }
 var file_construct_v1_agent_proto_depIdxs = []int32{
 	1,  // 0: construct.v1.Agent.metadata:type_name -> construct.v1.AgentMetadata
 	2,  // 1: construct.v1.Agent.spec:type_name -> construct.v1.AgentSpec
-	14, // 2: construct.v1.AgentMetadata.created_at:type_name -> google.protobuf.Timestamp
-	14, // 3: construct.v1.AgentMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 2: construct.v1.AgentMetadata.created_at:type_name -> google.protobuf.Timestamp
+	15, // 3: construct.v1.AgentMetadata.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 4: construct.v1.CreateAgentResponse.agent:type_name -> construct.v1.Agent
 	0,  // 5: construct.v1.GetAgentResponse.agent:type_name -> construct.v1.Agent
-	13, // 6: construct.v1.ListAgentsRequest.filter:type_name -> construct.v1.ListAgentsRequest.Filter
-	15, // 7: construct.v1.ListAgentsRequest.sort_field:type_name -> construct.v1.SortField
-	16, // 8: construct.v1.ListAgentsRequest.sort_order:type_name -> construct.v1.SortOrder
+	14, // 6: construct.v1.ListAgentsRequest.filter:type_name -> construct.v1.ListAgentsRequest.Filter
+	16, // 7: construct.v1.ListAgentsRequest.sort_field:type_name -> construct.v1.SortField
+	17, // 8: construct.v1.ListAgentsRequest.sort_order:type_name -> construct.v1.SortOrder
 	0,  // 9: construct.v1.ListAgentsResponse.agents:type_name -> construct.v1.Agent
-	0,  // 10: construct.v1.UpdateAgentResponse.agent:type_name -> construct.v1.Agent
-	3,  // 11: construct.v1.AgentService.CreateAgent:input_type -> construct.v1.CreateAgentRequest
-	5,  // 12: construct.v1.AgentService.GetAgent:input_type -> construct.v1.GetAgentRequest
-	7,  // 13: construct.v1.AgentService.ListAgents:input_type -> construct.v1.ListAgentsRequest
-	9,  // 14: construct.v1.AgentService.UpdateAgent:input_type -> construct.v1.UpdateAgentRequest
-	11, // 15: construct.v1.AgentService.DeleteAgent:input_type -> construct.v1.DeleteAgentRequest
-	4,  // 16: construct.v1.AgentService.CreateAgent:output_type -> construct.v1.CreateAgentResponse
-	6,  // 17: construct.v1.AgentService.GetAgent:output_type -> construct.v1.GetAgentResponse
-	8,  // 18: construct.v1.AgentService.ListAgents:output_type -> construct.v1.ListAgentsResponse
-	10, // 19: construct.v1.AgentService.UpdateAgent:output_type -> construct.v1.UpdateAgentResponse
-	12, // 20: construct.v1.AgentService.DeleteAgent:output_type -> construct.v1.DeleteAgentResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	3,  // 10: construct.v1.UpdateAgentRequest.tools:type_name -> construct.v1.ToolList
+	0,  // 11: construct.v1.UpdateAgentResponse.agent:type_name -> construct.v1.Agent
+	4,  // 12: construct.v1.AgentService.CreateAgent:input_type -> construct.v1.CreateAgentRequest
+	6,  // 13: construct.v1.AgentService.GetAgent:input_type -> construct.v1.GetAgentRequest
+	8,  // 14: construct.v1.AgentService.ListAgents:input_type -> construct.v1.ListAgentsRequest
+	10, // 15: construct.v1.AgentService.UpdateAgent:input_type -> construct.v1.UpdateAgentRequest
+	12, // 16: construct.v1.AgentService.DeleteAgent:input_type -> construct.v1.DeleteAgentRequest
+	5,  // 17: construct.v1.AgentService.CreateAgent:output_type -> construct.v1.CreateAgentResponse
+	7,  // 18: construct.v1.AgentService.GetAgent:output_type -> construct.v1.GetAgentResponse
+	9,  // 19: construct.v1.AgentService.ListAgents:output_type -> construct.v1.ListAgentsResponse
+	11, // 20: construct.v1.AgentService.UpdateAgent:output_type -> construct.v1.UpdateAgentResponse
+	13, // 21: construct.v1.AgentService.DeleteAgent:output_type -> construct.v1.DeleteAgentResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_agent_proto_init() }
@@ -965,15 +1071,15 @@ func file_construct_v1_agent_proto_init() {
 		return
 	}
 	file_construct_v1_common_proto_init()
-	file_construct_v1_agent_proto_msgTypes[7].OneofWrappers = []any{}
-	file_construct_v1_agent_proto_msgTypes[9].OneofWrappers = []any{}
+	file_construct_v1_agent_proto_msgTypes[8].OneofWrappers = []any{}
+	file_construct_v1_agent_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_agent_proto_rawDesc), len(file_construct_v1_agent_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,591 @@
+// Archive API provides operations for moving data between Construct daemons.
+// An archive is a gzip compressed tar file with a manifest and one JSON Lines file per resource.
+// It holds model providers, models, agents, tasks and messages.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/archive.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ArchiveSecrets decides how the credentials of model providers are stored in an archive.
+type ArchiveSecrets int32
+
+const (
+	// ARCHIVE_SECRETS_UNSPECIFIED indicates an unset value. The server excludes secrets.
+	ArchiveSecrets_ARCHIVE_SECRETS_UNSPECIFIED ArchiveSecrets = 0
+	// ARCHIVE_SECRETS_EXCLUDED leaves credentials out of the archive.
+	ArchiveSecrets_ARCHIVE_SECRETS_EXCLUDED ArchiveSecrets = 1
+	// ARCHIVE_SECRETS_ENCRYPTED encrypts credentials with a key derived from a passphrase.
+	ArchiveSecrets_ARCHIVE_SECRETS_ENCRYPTED ArchiveSecrets = 2
+)
+
+// Enum value maps for ArchiveSecrets.
+var (
+	ArchiveSecrets_name = map[int32]string{
+		0: "ARCHIVE_SECRETS_UNSPECIFIED",
+		1: "ARCHIVE_SECRETS_EXCLUDED",
+		2: "ARCHIVE_SECRETS_ENCRYPTED",
+	}
+	ArchiveSecrets_value = map[string]int32{
+		"ARCHIVE_SECRETS_UNSPECIFIED": 0,
+		"ARCHIVE_SECRETS_EXCLUDED":    1,
+		"ARCHIVE_SECRETS_ENCRYPTED":   2,
+	}
+)
+
+func (x ArchiveSecrets) Enum() *ArchiveSecrets {
+	p := new(ArchiveSecrets)
+	*p = x
+	return p
+}
+
+func (x ArchiveSecrets) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveSecrets) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_archive_proto_enumTypes[0].Descriptor()
+}
+
+func (ArchiveSecrets) Type() protoreflect.EnumType {
+	return &file_construct_v1_archive_proto_enumTypes[0]
+}
+
+func (x ArchiveSecrets) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveSecrets.Descriptor instead.
+func (ArchiveSecrets) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_archive_proto_rawDescGZIP(), []int{0}
+}
+
+// ImportConflictStrategy decides what happens to records that conflict with existing resources.
+// Tasks and messages conflict by ID. Model providers, models and agents also conflict by name.
+type ImportConflictStrategy int32
+
+const (
+	// IMPORT_CONFLICT_STRATEGY_UNSPECIFIED indicates an unset value. The server skips conflicts.
+	ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_UNSPECIFIED ImportConflictStrategy = 0
+	// IMPORT_CONFLICT_STRATEGY_SKIP keeps the existing resource.
+	ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_SKIP ImportConflictStrategy = 1
+	// IMPORT_CONFLICT_STRATEGY_OVERWRITE replaces the existing resource with the record.
+	ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_OVERWRITE ImportConflictStrategy = 2
+	// IMPORT_CONFLICT_STRATEGY_REMAP imports conflicting tasks and messages under new IDs.
+	// Model providers, models and agents are matched to the existing resources.
+	ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_REMAP ImportConflictStrategy = 3
+)
+
+// Enum value maps for ImportConflictStrategy.
+var (
+	ImportConflictStrategy_name = map[int32]string{
+		0: "IMPORT_CONFLICT_STRATEGY_UNSPECIFIED",
+		1: "IMPORT_CONFLICT_STRATEGY_SKIP",
+		2: "IMPORT_CONFLICT_STRATEGY_OVERWRITE",
+		3: "IMPORT_CONFLICT_STRATEGY_REMAP",
+	}
+	ImportConflictStrategy_value = map[string]int32{
+		"IMPORT_CONFLICT_STRATEGY_UNSPECIFIED": 0,
+		"IMPORT_CONFLICT_STRATEGY_SKIP":        1,
+		"IMPORT_CONFLICT_STRATEGY_OVERWRITE":   2,
+		"IMPORT_CONFLICT_STRATEGY_REMAP":       3,
+	}
+)
+
+func (x ImportConflictStrategy) Enum() *ImportConflictStrategy {
+	p := new(ImportConflictStrategy)
+	*p = x
+	return p
+}
+
+func (x ImportConflictStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportConflictStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_archive_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportConflictStrategy) Type() protoreflect.EnumType {
+	return &file_construct_v1_archive_proto_enumTypes[1]
+}
+
+func (x ImportConflictStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportConflictStrategy.Descriptor instead.
+func (ImportConflictStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_archive_proto_rawDescGZIP(), []int{1}
+}
+
+// ExportArchiveRequest selects the content of an archive.
+type ExportArchiveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// created_after limits the export to tasks created at or after this time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// created_before limits the export to tasks created before this time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// workspace limits the export to tasks whose project directory is the workspace or lies within it.
+	Workspace *string `protobuf:"bytes,3,opt,name=workspace,proto3,oneof" json:"workspace,omitempty"`
+	// secrets decides how the credentials of model providers are exported.
+	Secrets ArchiveSecrets `protobuf:"varint,4,opt,name=secrets,proto3,enum=construct.v1.ArchiveSecrets" json:"secrets,omitempty"`
+	// passphrase encrypts the credentials of model providers with ARCHIVE_SECRETS_ENCRYPTED.
+	Passphrase    string `protobuf:"bytes,5,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArchiveRequest) Reset() {
+	*x = ExportArchiveRequest{}
+	mi := &file_construct_v1_archive_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArchiveRequest) ProtoMessage() {}
+
+func (x *ExportArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_archive_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArchiveRequest.ProtoReflect.Descriptor instead.
+func (*ExportArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_archive_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportArchiveRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ExportArchiveRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ExportArchiveRequest) GetWorkspace() string {
+	if x != nil && x.Workspace != nil {
+		return *x.Workspace
+	}
+	return ""
+}
+
+func (x *ExportArchiveRequest) GetSecrets() ArchiveSecrets {
+	if x != nil {
+		return x.Secrets
+	}
+	return ArchiveSecrets_ARCHIVE_SECRETS_UNSPECIFIED
+}
+
+func (x *ExportArchiveRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+// ExportArchiveResponse carries the next chunk of the archive.
+type ExportArchiveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chunk is the next part of the archive.
+	Chunk         []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArchiveResponse) Reset() {
+	*x = ExportArchiveResponse{}
+	mi := &file_construct_v1_archive_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArchiveResponse) ProtoMessage() {}
+
+func (x *ExportArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_archive_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArchiveResponse.ProtoReflect.Descriptor instead.
+func (*ExportArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_archive_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportArchiveResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// ImportArchiveRequest carries an archive and the options of its import.
+type ImportArchiveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// archive is the content of the archive file.
+	Archive []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	// conflict_strategy decides what happens to records that conflict with existing resources.
+	ConflictStrategy ImportConflictStrategy `protobuf:"varint,2,opt,name=conflict_strategy,json=conflictStrategy,proto3,enum=construct.v1.ImportConflictStrategy" json:"conflict_strategy,omitempty"`
+	// passphrase decrypts the credentials of model providers if the archive contains them.
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// dry_run reports the outcome of the import without changing any data.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportArchiveRequest) Reset() {
+	*x = ImportArchiveRequest{}
+	mi := &file_construct_v1_archive_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArchiveRequest) ProtoMessage() {}
+
+func (x *ImportArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_archive_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArchiveRequest.ProtoReflect.Descriptor instead.
+func (*ImportArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_archive_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportArchiveRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ImportArchiveRequest) GetConflictStrategy() ImportConflictStrategy {
+	if x != nil {
+		return x.ConflictStrategy
+	}
+	return ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_UNSPECIFIED
+}
+
+func (x *ImportArchiveRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *ImportArchiveRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportArchiveResponse reports the outcome of an import.
+type ImportArchiveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results counts what happened to the records of each resource, in import order.
+	Results []*ImportResourceResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// warnings lists records that were imported incompletely or skipped.
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportArchiveResponse) Reset() {
+	*x = ImportArchiveResponse{}
+	mi := &file_construct_v1_archive_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArchiveResponse) ProtoMessage() {}
+
+func (x *ImportArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_archive_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArchiveResponse.ProtoReflect.Descriptor instead.
+func (*ImportArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_archive_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportArchiveResponse) GetResults() []*ImportResourceResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportArchiveResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// ImportResourceResult counts what happened to the records of a resource.
+type ImportResourceResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resource is the name of the resource, e.g. "tasks".
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// created is the number of records that were created.
+	Created int64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// updated is the number of existing resources that were overwritten.
+	Updated int64 `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	// skipped is the number of records that were skipped in favor of existing resources.
+	Skipped int64 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// remapped is the number of records that were created under a new ID.
+	Remapped      int64 `protobuf:"varint,5,opt,name=remapped,proto3" json:"remapped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResourceResult) Reset() {
+	*x = ImportResourceResult{}
+	mi := &file_construct_v1_archive_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResourceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResourceResult) ProtoMessage() {}
+
+func (x *ImportResourceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_archive_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResourceResult.ProtoReflect.Descriptor instead.
+func (*ImportResourceResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_archive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportResourceResult) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ImportResourceResult) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportResourceResult) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportResourceResult) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportResourceResult) GetRemapped() int64 {
+	if x != nil {
+		return x.Remapped
+	}
+	return 0
+}
+
+var File_construct_v1_archive_proto protoreflect.FileDescriptor
+
+const file_construct_v1_archive_proto_rawDesc = "" +
+	"\n" +
+	"\x1aconstruct/v1/archive.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xad\x02\n" +
+	"\x14ExportArchiveRequest\x12?\n" +
+	"\rcreated_after\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12!\n" +
+	"\tworkspace\x18\x03 \x01(\tH\x00R\tworkspace\x88\x01\x01\x12@\n" +
+	"\asecrets\x18\x04 \x01(\x0e2\x1c.construct.v1.ArchiveSecretsB\b\xbaH\x05\x82\x01\x02\x10\x01R\asecrets\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x05 \x01(\tR\n" +
+	"passphraseB\f\n" +
+	"\n" +
+	"_workspace\"-\n" +
+	"\x15ExportArchiveResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\xcf\x01\n" +
+	"\x14ImportArchiveRequest\x12!\n" +
+	"\aarchive\x18\x01 \x01(\fB\a\xbaH\x04z\x02\x10\x01R\aarchive\x12[\n" +
+	"\x11conflict_strategy\x18\x02 \x01(\x0e2$.construct.v1.ImportConflictStrategyB\b\xbaH\x05\x82\x01\x02\x10\x01R\x10conflictStrategy\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x03 \x01(\tR\n" +
+	"passphrase\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"q\n" +
+	"\x15ImportArchiveResponse\x12<\n" +
+	"\aresults\x18\x01 \x03(\v2\".construct.v1.ImportResourceResultR\aresults\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\x9c\x01\n" +
+	"\x14ImportResourceResult\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x03R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x03R\aupdated\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x03R\askipped\x12\x1a\n" +
+	"\bremapped\x18\x05 \x01(\x03R\bremapped*n\n" +
+	"\x0eArchiveSecrets\x12\x1f\n" +
+	"\x1bARCHIVE_SECRETS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ARCHIVE_SECRETS_EXCLUDED\x10\x01\x12\x1d\n" +
+	"\x19ARCHIVE_SECRETS_ENCRYPTED\x10\x02*\xb1\x01\n" +
+	"\x16ImportConflictStrategy\x12(\n" +
+	"$IMPORT_CONFLICT_STRATEGY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dIMPORT_CONFLICT_STRATEGY_SKIP\x10\x01\x12&\n" +
+	"\"IMPORT_CONFLICT_STRATEGY_OVERWRITE\x10\x02\x12\"\n" +
+	"\x1eIMPORT_CONFLICT_STRATEGY_REMAP\x10\x032\xcd\x01\n" +
+	"\x0eArchiveService\x12_\n" +
+	"\rExportArchive\x12\".construct.v1.ExportArchiveRequest\x1a#.construct.v1.ExportArchiveResponse\"\x03\x90\x02\x010\x01\x12Z\n" +
+	"\rImportArchive\x12\".construct.v1.ImportArchiveRequest\x1a#.construct.v1.ImportArchiveResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_archive_proto_rawDescOnce sync.Once
+	file_construct_v1_archive_proto_rawDescData []byte
+)
+
+func file_construct_v1_archive_proto_rawDescGZIP() []byte {
+	file_construct_v1_archive_proto_rawDescOnce.Do(func() {
+		file_construct_v1_archive_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_archive_proto_rawDesc), len(file_construct_v1_archive_proto_rawDesc)))
+	})
+	return file_construct_v1_archive_proto_rawDescData
+}
+
+var file_construct_v1_archive_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_construct_v1_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_construct_v1_archive_proto_goTypes = []any{
+	(ArchiveSecrets)(0),           // 0: construct.v1.ArchiveSecrets
+	(ImportConflictStrategy)(0),   // 1: construct.v1.ImportConflictStrategy
+	(*ExportArchiveRequest)(nil),  // 2: construct.v1.ExportArchiveRequest
+	(*ExportArchiveResponse)(nil), // 3: construct.v1.ExportArchiveResponse
+	(*ImportArchiveRequest)(nil),  // 4: construct.v1.ImportArchiveRequest
+	(*ImportArchiveResponse)(nil), // 5: construct.v1.ImportArchiveResponse
+	(*ImportResourceResult)(nil),  // 6: construct.v1.ImportResourceResult
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_construct_v1_archive_proto_depIdxs = []int32{
+	7, // 0: construct.v1.ExportArchiveRequest.created_after:type_name -> google.protobuf.Timestamp
+	7, // 1: construct.v1.ExportArchiveRequest.created_before:type_name -> google.protobuf.Timestamp
+	0, // 2: construct.v1.ExportArchiveRequest.secrets:type_name -> construct.v1.ArchiveSecrets
+	1, // 3: construct.v1.ImportArchiveRequest.conflict_strategy:type_name -> construct.v1.ImportConflictStrategy
+	6, // 4: construct.v1.ImportArchiveResponse.results:type_name -> construct.v1.ImportResourceResult
+	2, // 5: construct.v1.ArchiveService.ExportArchive:input_type -> construct.v1.ExportArchiveRequest
+	4, // 6: construct.v1.ArchiveService.ImportArchive:input_type -> construct.v1.ImportArchiveRequest
+	3, // 7: construct.v1.ArchiveService.ExportArchive:output_type -> construct.v1.ExportArchiveResponse
+	5, // 8: construct.v1.ArchiveService.ImportArchive:output_type -> construct.v1.ImportArchiveResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_archive_proto_init() }
+func file_construct_v1_archive_proto_init() {
+	if File_construct_v1_archive_proto != nil {
+		return
+	}
+	file_construct_v1_archive_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_archive_proto_rawDesc), len(file_construct_v1_archive_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_archive_proto_goTypes,
+		DependencyIndexes: file_construct_v1_archive_proto_depIdxs,
+		EnumInfos:         file_construct_v1_archive_proto_enumTypes,
+		MessageInfos:      file_construct_v1_archive_proto_msgTypes,
+	}.Build()
+	File_construct_v1_archive_proto = out.File
+	file_construct_v1_archive_proto_goTypes = nil
+	file_construct_v1_archive_proto_depIdxs = nil
+}
@@ -0,0 +1,615 @@
+// Audit API provides read access to the audit log of Construct.
+// The audit log records every side-effecting tool call of an agent and every API call that
+// changes state. It is append-only and every event carries the hash of its predecessor.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/audit.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditEventKind distinguishes tool calls of agents from API calls.
+type AuditEventKind int32
+
+const (
+	// AUDIT_EVENT_KIND_UNSPECIFIED indicates an unset value.
+	AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED AuditEventKind = 0
+	// AUDIT_EVENT_KIND_TOOL_CALL is a tool call of an agent that changed the workspace.
+	AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL AuditEventKind = 1
+	// AUDIT_EVENT_KIND_API_CALL is an API call that changed state.
+	AuditEventKind_AUDIT_EVENT_KIND_API_CALL AuditEventKind = 2
+)
+
+// Enum value maps for AuditEventKind.
+var (
+	AuditEventKind_name = map[int32]string{
+		0: "AUDIT_EVENT_KIND_UNSPECIFIED",
+		1: "AUDIT_EVENT_KIND_TOOL_CALL",
+		2: "AUDIT_EVENT_KIND_API_CALL",
+	}
+	AuditEventKind_value = map[string]int32{
+		"AUDIT_EVENT_KIND_UNSPECIFIED": 0,
+		"AUDIT_EVENT_KIND_TOOL_CALL":   1,
+		"AUDIT_EVENT_KIND_API_CALL":    2,
+	}
+)
+
+func (x AuditEventKind) Enum() *AuditEventKind {
+	p := new(AuditEventKind)
+	*p = x
+	return p
+}
+
+func (x AuditEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_audit_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditEventKind) Type() protoreflect.EnumType {
+	return &file_construct_v1_audit_proto_enumTypes[0]
+}
+
+func (x AuditEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEventKind.Descriptor instead.
+func (AuditEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+// AuditEvent is an entry of the audit log.
+type AuditEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the event (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is the position of the event in the log, starting at 1 without gaps.
+	Sequence int64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// time is the timestamp when the event was recorded.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// kind distinguishes tool calls from API calls.
+	Kind AuditEventKind `protobuf:"varint,4,opt,name=kind,proto3,enum=construct.v1.AuditEventKind" json:"kind,omitempty"`
+	// actor is the user the action was made on behalf of. It is empty for actions made
+	// over the Unix socket.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// action is the name of the tool or the procedure of the API call.
+	Action string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	// resource is the ID of the resource an API call acted on, if any.
+	Resource string `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	// task_id is the task a tool call was made in or an API call acted on (UUID format).
+	TaskId *string `protobuf:"bytes,8,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	// workspace is the project directory of the task of a tool call.
+	Workspace string `protobuf:"bytes,9,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	// command is the command line run by execute_command.
+	Command string `protobuf:"bytes,10,opt,name=command,proto3" json:"command,omitempty"`
+	// path is the file written by create_file or edit_file.
+	Path string `protobuf:"bytes,11,opt,name=path,proto3" json:"path,omitempty"`
+	// diff_hash is the hex encoded SHA-256 hash of the diff of edit_file, the content
+	// written by create_file or the JSON encoded arguments of a custom tool.
+	DiffHash string `protobuf:"bytes,12,opt,name=diff_hash,json=diffHash,proto3" json:"diff_hash,omitempty"`
+	// exit_code is the exit code of the command run by execute_command.
+	ExitCode *int32 `protobuf:"varint,13,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	// duration_ms is the duration of the action in milliseconds.
+	DurationMs int64 `protobuf:"varint,14,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// error is the error of the action if it failed.
+	Error string `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	// previous_hash is the hex encoded hash of the preceding event. It is empty for the first event.
+	PreviousHash string `protobuf:"bytes,16,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+	// hash is the hex encoded SHA-256 hash of the content of the event and previous_hash.
+	Hash          string `protobuf:"bytes,17,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_construct_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetKind() AuditEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDiffHash() string {
+	if x != nil {
+		return x.DiffHash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPreviousHash() string {
+	if x != nil {
+		return x.PreviousHash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// ListEventsRequest specifies filtering criteria for listing audit events.
+type ListEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter *ListEventsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// after_sequence limits the events to those appended after the event with this sequence.
+	AfterSequence int64 `protobuf:"varint,2,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	// page_size limits the number of events returned (1-1000).
+	PageSize *int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// sort_order orders the events by sequence. Events are returned oldest first by default.
+	SortOrder     *SortOrder `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=construct.v1.SortOrder,oneof" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_construct_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListEventsRequest) GetFilter() *ListEventsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetSortOrder() SortOrder {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+// ListEventsResponse contains the events matching the request criteria.
+type ListEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events is the list of events in the requested order.
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_construct_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Filter specifies criteria for narrowing the list of returned events.
+type ListEventsRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// actor filters events by the user the action was made on behalf of.
+	Actor *string `protobuf:"bytes,1,opt,name=actor,proto3,oneof" json:"actor,omitempty"`
+	// kind filters events by their kind.
+	Kind *AuditEventKind `protobuf:"varint,2,opt,name=kind,proto3,enum=construct.v1.AuditEventKind,oneof" json:"kind,omitempty"`
+	// task_id filters events by their task (UUID format).
+	TaskId *string `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	// action filters events by the name of the tool or the procedure.
+	Action *string `protobuf:"bytes,4,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	// created_after limits the events to those recorded at or after this time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// created_before limits the events to those recorded before this time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest_Filter) Reset() {
+	*x = ListEventsRequest_Filter{}
+	mi := &file_construct_v1_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest_Filter) ProtoMessage() {}
+
+func (x *ListEventsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ListEventsRequest_Filter) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *ListEventsRequest_Filter) GetKind() AuditEventKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *ListEventsRequest_Filter) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest_Filter) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *ListEventsRequest_Filter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListEventsRequest_Filter) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+var File_construct_v1_audit_proto protoreflect.FileDescriptor
+
+const file_construct_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x18construct/v1/audit.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19construct/v1/common.proto\"\xa1\x04\n" +
+	"\n" +
+	"AuditEvent\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x120\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1c.construct.v1.AuditEventKindR\x04kind\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x1a\n" +
+	"\bresource\x18\a \x01(\tR\bresource\x12\x1c\n" +
+	"\atask_id\x18\b \x01(\tH\x00R\x06taskId\x88\x01\x01\x12\x1c\n" +
+	"\tworkspace\x18\t \x01(\tR\tworkspace\x12\x18\n" +
+	"\acommand\x18\n" +
+	" \x01(\tR\acommand\x12\x12\n" +
+	"\x04path\x18\v \x01(\tR\x04path\x12\x1b\n" +
+	"\tdiff_hash\x18\f \x01(\tR\bdiffHash\x12 \n" +
+	"\texit_code\x18\r \x01(\x05H\x01R\bexitCode\x88\x01\x01\x12\x1f\n" +
+	"\vduration_ms\x18\x0e \x01(\x03R\n" +
+	"durationMs\x12\x14\n" +
+	"\x05error\x18\x0f \x01(\tR\x05error\x12#\n" +
+	"\rprevious_hash\x18\x10 \x01(\tR\fpreviousHash\x12\x12\n" +
+	"\x04hash\x18\x11 \x01(\tR\x04hashB\n" +
+	"\n" +
+	"\b_task_idB\f\n" +
+	"\n" +
+	"_exit_code\"\xef\x04\n" +
+	"\x11ListEventsRequest\x12>\n" +
+	"\x06filter\x18\x01 \x01(\v2&.construct.v1.ListEventsRequest.FilterR\x06filter\x12.\n" +
+	"\x0eafter_sequence\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\rafterSequence\x12,\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x01H\x00R\bpageSize\x88\x01\x01\x12E\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x0e2\x17.construct.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\tsortOrder\x88\x01\x01\x1a\xd7\x02\n" +
+	"\x06Filter\x12\x19\n" +
+	"\x05actor\x18\x01 \x01(\tH\x00R\x05actor\x88\x01\x01\x12?\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1c.construct.v1.AuditEventKindB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\x04kind\x88\x01\x01\x12&\n" +
+	"\atask_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\x06taskId\x88\x01\x01\x12\x1b\n" +
+	"\x06action\x18\x04 \x01(\tH\x03R\x06action\x88\x01\x01\x12?\n" +
+	"\rcreated_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBeforeB\b\n" +
+	"\x06_actorB\a\n" +
+	"\x05_kindB\n" +
+	"\n" +
+	"\b_task_idB\t\n" +
+	"\a_actionB\f\n" +
+	"\n" +
+	"_page_sizeB\r\n" +
+	"\v_sort_order\"F\n" +
+	"\x12ListEventsResponse\x120\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.construct.v1.AuditEventR\x06events*q\n" +
+	"\x0eAuditEventKind\x12 \n" +
+	"\x1cAUDIT_EVENT_KIND_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aAUDIT_EVENT_KIND_TOOL_CALL\x10\x01\x12\x1d\n" +
+	"\x19AUDIT_EVENT_KIND_API_CALL\x10\x022d\n" +
+	"\fAuditService\x12T\n" +
+	"\n" +
+	"ListEvents\x12\x1f.construct.v1.ListEventsRequest\x1a .construct.v1.ListEventsResponse\"\x03\x90\x02\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_audit_proto_rawDescOnce sync.Once
+	file_construct_v1_audit_proto_rawDescData []byte
+)
+
+func file_construct_v1_audit_proto_rawDescGZIP() []byte {
+	file_construct_v1_audit_proto_rawDescOnce.Do(func() {
+		file_construct_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_audit_proto_rawDesc), len(file_construct_v1_audit_proto_rawDesc)))
+	})
+	return file_construct_v1_audit_proto_rawDescData
+}
+
+var file_construct_v1_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_construct_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_construct_v1_audit_proto_goTypes = []any{
+	(AuditEventKind)(0),              // 0: construct.v1.AuditEventKind
+	(*AuditEvent)(nil),               // 1: construct.v1.AuditEvent
+	(*ListEventsRequest)(nil),        // 2: construct.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),       // 3: construct.v1.ListEventsResponse
+	(*ListEventsRequest_Filter)(nil), // 4: construct.v1.ListEventsRequest.Filter
+	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
+	(SortOrder)(0),                   // 6: construct.v1.SortOrder
+}
+var file_construct_v1_audit_proto_depIdxs = []int32{
+	5, // 0: construct.v1.AuditEvent.time:type_name -> google.protobuf.Timestamp
+	0, // 1: construct.v1.AuditEvent.kind:type_name -> construct.v1.AuditEventKind
+	4, // 2: construct.v1.ListEventsRequest.filter:type_name -> construct.v1.ListEventsRequest.Filter
+	6, // 3: construct.v1.ListEventsRequest.sort_order:type_name -> construct.v1.SortOrder
+	1, // 4: construct.v1.ListEventsResponse.events:type_name -> construct.v1.AuditEvent
+	0, // 5: construct.v1.ListEventsRequest.Filter.kind:type_name -> construct.v1.AuditEventKind
+	5, // 6: construct.v1.ListEventsRequest.Filter.created_after:type_name -> google.protobuf.Timestamp
+	5, // 7: construct.v1.ListEventsRequest.Filter.created_before:type_name -> google.protobuf.Timestamp
+	2, // 8: construct.v1.AuditService.ListEvents:input_type -> construct.v1.ListEventsRequest
+	3, // 9: construct.v1.AuditService.ListEvents:output_type -> construct.v1.ListEventsResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_audit_proto_init() }
+func file_construct_v1_audit_proto_init() {
+	if File_construct_v1_audit_proto != nil {
+		return
+	}
+	file_construct_v1_common_proto_init()
+	file_construct_v1_audit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_construct_v1_audit_proto_msgTypes[1].OneofWrappers = []any{}
+	file_construct_v1_audit_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_audit_proto_rawDesc), len(file_construct_v1_audit_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_audit_proto_goTypes,
+		DependencyIndexes: file_construct_v1_audit_proto_depIdxs,
+		EnumInfos:         file_construct_v1_audit_proto_enumTypes,
+		MessageInfos:      file_construct_v1_audit_proto_msgTypes,
+	}.Build()
+	File_construct_v1_audit_proto = out.File
+	file_construct_v1_audit_proto_goTypes = nil
+	file_construct_v1_audit_proto_depIdxs = nil
+}
@@ -30,6 +30,8 @@ const (
 	SortField_SORT_FIELD_CREATED_AT SortField = 1
 	// SORT_FIELD_UPDATED_AT sorts results by last modification timestamp.
 	SortField_SORT_FIELD_UPDATED_AT SortField = 2
+	// SORT_FIELD_COST sorts results by their cost, only supported for tasks.
+	SortField_SORT_FIELD_COST SortField = 3
 )
 
 // Enum value maps for SortField.
@@ -38,11 +40,13 @@ var (
 		0: "SORT_FIELD_UNSPECIFIED",
 		1: "SORT_FIELD_CREATED_AT",
 		2: "SORT_FIELD_UPDATED_AT",
+		3: "SORT_FIELD_COST",
 	}
 	SortField_value = map[string]int32{
 		"SORT_FIELD_UNSPECIFIED": 0,
 		"SORT_FIELD_CREATED_AT":  1,
 		"SORT_FIELD_UPDATED_AT":  2,
+		"SORT_FIELD_COST":        3,
 	}
 )
 
@@ -197,11 +201,12 @@ var File_construct_v1_common_proto protoreflect.FileDescriptor
 
 const file_construct_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x19construct/v1/common.proto\x12\fconstruct.v1*]\n" +
+	"\x19construct/v1/common.proto\x12\fconstruct.v1*r\n" +
 	"\tSortField\x12\x1a\n" +
 	"\x16SORT_FIELD_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15SORT_FIELD_CREATED_AT\x10\x01\x12\x19\n" +
-	"\x15SORT_FIELD_UPDATED_AT\x10\x02*P\n" +
+	"\x15SORT_FIELD_UPDATED_AT\x10\x02\x12\x13\n" +
+	"\x0fSORT_FIELD_COST\x10\x03*P\n" +
 	"\tSortOrder\x12\x1a\n" +
 	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
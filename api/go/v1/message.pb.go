@@ -418,6 +418,7 @@ type MessagePart struct {
 	//	*MessagePart_ToolCall
 	//	*MessagePart_ToolResult
 	//	*MessagePart_Error_
+	//	*MessagePart_FileAttachment_
 	Data          isMessagePart_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -496,6 +497,15 @@ func (x *MessagePart) GetError() *MessagePart_Error {
 	return nil
 }
 
+func (x *MessagePart) GetFileAttachment() *MessagePart_FileAttachment {
+	if x != nil {
+		if x, ok := x.Data.(*MessagePart_FileAttachment_); ok {
+			return x.FileAttachment
+		}
+	}
+	return nil
+}
+
 type isMessagePart_Data interface {
 	isMessagePart_Data()
 }
@@ -519,6 +529,11 @@ type MessagePart_Error_ struct {
 	Error *MessagePart_Error `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
 }
 
+type MessagePart_FileAttachment_ struct {
+	// file_attachment contains a file of the workspace that the user attached to the message.
+	FileAttachment *MessagePart_FileAttachment `protobuf:"bytes,5,opt,name=file_attachment,json=fileAttachment,proto3,oneof"`
+}
+
 func (*MessagePart_Text_) isMessagePart_Data() {}
 
 func (*MessagePart_ToolCall) isMessagePart_Data() {}
@@ -527,6 +542,8 @@ func (*MessagePart_ToolResult) isMessagePart_Data() {}
 
 func (*MessagePart_Error_) isMessagePart_Data() {}
 
+func (*MessagePart_FileAttachment_) isMessagePart_Data() {}
+
 // MessageUsage tracks resource consumption and associated costs for generating a message.
 type MessageUsage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1123,6 +1140,116 @@ func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
 	return file_construct_v1_message_proto_rawDescGZIP(), []int{15}
 }
 
+// RegenerateMessageRequest specifies the user message to regenerate the conversation from.
+type RegenerateMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the user message (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// content replaces the content of the message. The content is kept if empty.
+	Content       []*MessagePart `protobuf:"bytes,2,rep,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateMessageRequest) Reset() {
+	*x = RegenerateMessageRequest{}
+	mi := &file_construct_v1_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateMessageRequest) ProtoMessage() {}
+
+func (x *RegenerateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateMessageRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RegenerateMessageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegenerateMessageRequest) GetContent() []*MessagePart {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// RegenerateMessageResponse contains the message and the number of removed messages.
+type RegenerateMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// message is the message the agent will respond to.
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// removed_messages is the number of messages after the message that were deleted.
+	RemovedMessages int32 `protobuf:"varint,2,opt,name=removed_messages,json=removedMessages,proto3" json:"removed_messages,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RegenerateMessageResponse) Reset() {
+	*x = RegenerateMessageResponse{}
+	mi := &file_construct_v1_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateMessageResponse) ProtoMessage() {}
+
+func (x *RegenerateMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateMessageResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateMessageResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegenerateMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *RegenerateMessageResponse) GetRemovedMessages() int32 {
+	if x != nil {
+		return x.RemovedMessages
+	}
+	return 0
+}
+
 type ToolCall struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1141,6 +1268,8 @@ type ToolCall struct {
 	//	*ToolCall_SubmitReport
 	//	*ToolCall_CodeInterpreter
 	//	*ToolCall_Fetch
+	//	*ToolCall_Remember
+	//	*ToolCall_Custom
 	Input         isToolCall_Input `protobuf_oneof:"Input"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1148,7 +1277,7 @@ type ToolCall struct {
 
 func (x *ToolCall) Reset() {
 	*x = ToolCall{}
-	mi := &file_construct_v1_message_proto_msgTypes[16]
+	mi := &file_construct_v1_message_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1160,7 +1289,7 @@ func (x *ToolCall) String() string {
 func (*ToolCall) ProtoMessage() {}
 
 func (x *ToolCall) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[16]
+	mi := &file_construct_v1_message_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,7 +1302,7 @@ func (x *ToolCall) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall.ProtoReflect.Descriptor instead.
 func (*ToolCall) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ToolCall) GetId() string {
@@ -1305,6 +1434,24 @@ func (x *ToolCall) GetFetch() *ToolCall_FetchInput {
 	return nil
 }
 
+func (x *ToolCall) GetRemember() *ToolCall_RememberInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_Remember); ok {
+			return x.Remember
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetCustom() *ToolCall_CustomInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_Custom); ok {
+			return x.Custom
+		}
+	}
+	return nil
+}
+
 type isToolCall_Input interface {
 	isToolCall_Input()
 }
@@ -1357,6 +1504,14 @@ type ToolCall_Fetch struct {
 	Fetch *ToolCall_FetchInput `protobuf:"bytes,14,opt,name=fetch,proto3,oneof"`
 }
 
+type ToolCall_Remember struct {
+	Remember *ToolCall_RememberInput `protobuf:"bytes,15,opt,name=remember,proto3,oneof"`
+}
+
+type ToolCall_Custom struct {
+	Custom *ToolCall_CustomInput `protobuf:"bytes,16,opt,name=custom,proto3,oneof"`
+}
+
 func (*ToolCall_CreateFile) isToolCall_Input() {}
 
 func (*ToolCall_EditFile) isToolCall_Input() {}
@@ -1381,6 +1536,10 @@ func (*ToolCall_CodeInterpreter) isToolCall_Input() {}
 
 func (*ToolCall_Fetch) isToolCall_Input() {}
 
+func (*ToolCall_Remember) isToolCall_Input() {}
+
+func (*ToolCall_Custom) isToolCall_Input() {}
+
 type ToolResult struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1397,6 +1556,8 @@ type ToolResult struct {
 	//	*ToolResult_SubmitReport
 	//	*ToolResult_CodeInterpreter
 	//	*ToolResult_Fetch
+	//	*ToolResult_Remember
+	//	*ToolResult_Custom
 	Result        isToolResult_Result `protobuf_oneof:"result"`
 	Error         *ToolError          `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1405,7 +1566,7 @@ type ToolResult struct {
 
 func (x *ToolResult) Reset() {
 	*x = ToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[17]
+	mi := &file_construct_v1_message_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1417,7 +1578,7 @@ func (x *ToolResult) String() string {
 func (*ToolResult) ProtoMessage() {}
 
 func (x *ToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[17]
+	mi := &file_construct_v1_message_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1430,7 +1591,7 @@ func (x *ToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult.ProtoReflect.Descriptor instead.
 func (*ToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ToolResult) GetId() string {
@@ -1544,6 +1705,24 @@ func (x *ToolResult) GetFetch() *ToolResult_FetchResult {
 	return nil
 }
 
+func (x *ToolResult) GetRemember() *ToolResult_RememberResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_Remember); ok {
+			return x.Remember
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetCustom() *ToolResult_CustomResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_Custom); ok {
+			return x.Custom
+		}
+	}
+	return nil
+}
+
 func (x *ToolResult) GetError() *ToolError {
 	if x != nil {
 		return x.Error
@@ -1595,6 +1774,14 @@ type ToolResult_Fetch struct {
 	Fetch *ToolResult_FetchResult `protobuf:"bytes,14,opt,name=fetch,proto3,oneof"`
 }
 
+type ToolResult_Remember struct {
+	Remember *ToolResult_RememberResult `protobuf:"bytes,15,opt,name=remember,proto3,oneof"`
+}
+
+type ToolResult_Custom struct {
+	Custom *ToolResult_CustomResult `protobuf:"bytes,16,opt,name=custom,proto3,oneof"`
+}
+
 func (*ToolResult_CreateFile) isToolResult_Result() {}
 
 func (*ToolResult_EditFile) isToolResult_Result() {}
@@ -1615,6 +1802,10 @@ func (*ToolResult_CodeInterpreter) isToolResult_Result() {}
 
 func (*ToolResult_Fetch) isToolResult_Result() {}
 
+func (*ToolResult_Remember) isToolResult_Result() {}
+
+func (*ToolResult_Custom) isToolResult_Result() {}
+
 type CreateFileToolResult struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
 	Input         *CreateFileToolResult_Input `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
@@ -1625,7 +1816,7 @@ type CreateFileToolResult struct {
 
 func (x *CreateFileToolResult) Reset() {
 	*x = CreateFileToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[18]
+	mi := &file_construct_v1_message_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1637,7 +1828,7 @@ func (x *CreateFileToolResult) String() string {
 func (*CreateFileToolResult) ProtoMessage() {}
 
 func (x *CreateFileToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[18]
+	mi := &file_construct_v1_message_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +1841,7 @@ func (x *CreateFileToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFileToolResult.ProtoReflect.Descriptor instead.
 func (*CreateFileToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{18}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateFileToolResult) GetInput() *CreateFileToolResult_Input {
@@ -1677,7 +1868,7 @@ type EditFileToolResult struct {
 
 func (x *EditFileToolResult) Reset() {
 	*x = EditFileToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[19]
+	mi := &file_construct_v1_message_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1689,7 +1880,7 @@ func (x *EditFileToolResult) String() string {
 func (*EditFileToolResult) ProtoMessage() {}
 
 func (x *EditFileToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[19]
+	mi := &file_construct_v1_message_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1702,7 +1893,7 @@ func (x *EditFileToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditFileToolResult.ProtoReflect.Descriptor instead.
 func (*EditFileToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{19}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *EditFileToolResult) GetFilePath() string {
@@ -1730,7 +1921,7 @@ type ExecuteCommandToolResult struct {
 
 func (x *ExecuteCommandToolResult) Reset() {
 	*x = ExecuteCommandToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[20]
+	mi := &file_construct_v1_message_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1742,7 +1933,7 @@ func (x *ExecuteCommandToolResult) String() string {
 func (*ExecuteCommandToolResult) ProtoMessage() {}
 
 func (x *ExecuteCommandToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[20]
+	mi := &file_construct_v1_message_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,7 +1946,7 @@ func (x *ExecuteCommandToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteCommandToolResult.ProtoReflect.Descriptor instead.
 func (*ExecuteCommandToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{20}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ExecuteCommandToolResult) GetCommand() string {
@@ -1788,7 +1979,7 @@ type FindFileToolResult struct {
 
 func (x *FindFileToolResult) Reset() {
 	*x = FindFileToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[21]
+	mi := &file_construct_v1_message_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1800,7 +1991,7 @@ func (x *FindFileToolResult) String() string {
 func (*FindFileToolResult) ProtoMessage() {}
 
 func (x *FindFileToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[21]
+	mi := &file_construct_v1_message_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1813,7 +2004,7 @@ func (x *FindFileToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindFileToolResult.ProtoReflect.Descriptor instead.
 func (*FindFileToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{21}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *FindFileToolResult) GetFilePath() string {
@@ -1833,7 +2024,7 @@ type GrepToolResult struct {
 
 func (x *GrepToolResult) Reset() {
 	*x = GrepToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[22]
+	mi := &file_construct_v1_message_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1845,7 +2036,7 @@ func (x *GrepToolResult) String() string {
 func (*GrepToolResult) ProtoMessage() {}
 
 func (x *GrepToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[22]
+	mi := &file_construct_v1_message_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,7 +2049,7 @@ func (x *GrepToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrepToolResult.ProtoReflect.Descriptor instead.
 func (*GrepToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{22}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GrepToolResult) GetFilePath() string {
@@ -1883,7 +2074,7 @@ type HandoffToolResult struct {
 
 func (x *HandoffToolResult) Reset() {
 	*x = HandoffToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[23]
+	mi := &file_construct_v1_message_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1895,7 +2086,7 @@ func (x *HandoffToolResult) String() string {
 func (*HandoffToolResult) ProtoMessage() {}
 
 func (x *HandoffToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[23]
+	mi := &file_construct_v1_message_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,7 +2099,7 @@ func (x *HandoffToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandoffToolResult.ProtoReflect.Descriptor instead.
 func (*HandoffToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{23}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{25}
 }
 
 type ListFilesToolResult struct {
@@ -1919,7 +2110,7 @@ type ListFilesToolResult struct {
 
 func (x *ListFilesToolResult) Reset() {
 	*x = ListFilesToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[24]
+	mi := &file_construct_v1_message_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1931,7 +2122,7 @@ func (x *ListFilesToolResult) String() string {
 func (*ListFilesToolResult) ProtoMessage() {}
 
 func (x *ListFilesToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[24]
+	mi := &file_construct_v1_message_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1944,7 +2135,7 @@ func (x *ListFilesToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFilesToolResult.ProtoReflect.Descriptor instead.
 func (*ListFilesToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{24}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{26}
 }
 
 type ReadFileToolResult struct {
@@ -1955,7 +2146,7 @@ type ReadFileToolResult struct {
 
 func (x *ReadFileToolResult) Reset() {
 	*x = ReadFileToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[25]
+	mi := &file_construct_v1_message_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1967,7 +2158,7 @@ func (x *ReadFileToolResult) String() string {
 func (*ReadFileToolResult) ProtoMessage() {}
 
 func (x *ReadFileToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[25]
+	mi := &file_construct_v1_message_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1980,7 +2171,7 @@ func (x *ReadFileToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadFileToolResult.ProtoReflect.Descriptor instead.
 func (*ReadFileToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{25}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{27}
 }
 
 type SubmitReport struct {
@@ -1995,7 +2186,7 @@ type SubmitReport struct {
 
 func (x *SubmitReport) Reset() {
 	*x = SubmitReport{}
-	mi := &file_construct_v1_message_proto_msgTypes[26]
+	mi := &file_construct_v1_message_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2007,7 +2198,7 @@ func (x *SubmitReport) String() string {
 func (*SubmitReport) ProtoMessage() {}
 
 func (x *SubmitReport) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[26]
+	mi := &file_construct_v1_message_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,7 +2211,7 @@ func (x *SubmitReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitReport.ProtoReflect.Descriptor instead.
 func (*SubmitReport) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{26}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SubmitReport) GetSummary() string {
@@ -2061,7 +2252,7 @@ type ToolError struct {
 
 func (x *ToolError) Reset() {
 	*x = ToolError{}
-	mi := &file_construct_v1_message_proto_msgTypes[27]
+	mi := &file_construct_v1_message_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2073,7 +2264,7 @@ func (x *ToolError) String() string {
 func (*ToolError) ProtoMessage() {}
 
 func (x *ToolError) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[27]
+	mi := &file_construct_v1_message_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2086,7 +2277,7 @@ func (x *ToolError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolError.ProtoReflect.Descriptor instead.
 func (*ToolError) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{27}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ToolError) GetMessage() string {
@@ -2112,7 +2303,7 @@ type MessagePart_Text struct {
 
 func (x *MessagePart_Text) Reset() {
 	*x = MessagePart_Text{}
-	mi := &file_construct_v1_message_proto_msgTypes[28]
+	mi := &file_construct_v1_message_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2124,7 +2315,7 @@ func (x *MessagePart_Text) String() string {
 func (*MessagePart_Text) ProtoMessage() {}
 
 func (x *MessagePart_Text) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[28]
+	mi := &file_construct_v1_message_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,7 +2347,7 @@ type MessagePart_Error struct {
 
 func (x *MessagePart_Error) Reset() {
 	*x = MessagePart_Error{}
-	mi := &file_construct_v1_message_proto_msgTypes[29]
+	mi := &file_construct_v1_message_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2168,7 +2359,7 @@ func (x *MessagePart_Error) String() string {
 func (*MessagePart_Error) ProtoMessage() {}
 
 func (x *MessagePart_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[29]
+	mi := &file_construct_v1_message_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2191,6 +2382,81 @@ func (x *MessagePart_Error) GetMessage() string {
 	return ""
 }
 
+// FileAttachment attaches the content of a file in the workspace of the task, or of a range
+// of its lines, to a message.
+type MessagePart_FileAttachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// path is the path of the file, relative to the workspace of the task or absolute within it.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// start_line is the first attached line, starting at 1. If unset, the file is attached from its first line.
+	StartLine uint32 `protobuf:"varint,2,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	// end_line is the last attached line, inclusive. If unset, the file is attached up to its last line.
+	EndLine uint32 `protobuf:"varint,3,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+	// content is the attached content. It is read by the server when the message is created and
+	// ignored in requests.
+	Content       string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessagePart_FileAttachment) Reset() {
+	*x = MessagePart_FileAttachment{}
+	mi := &file_construct_v1_message_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagePart_FileAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagePart_FileAttachment) ProtoMessage() {}
+
+func (x *MessagePart_FileAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagePart_FileAttachment.ProtoReflect.Descriptor instead.
+func (*MessagePart_FileAttachment) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *MessagePart_FileAttachment) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MessagePart_FileAttachment) GetStartLine() uint32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *MessagePart_FileAttachment) GetEndLine() uint32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+func (x *MessagePart_FileAttachment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
 // Filter specifies criteria for narrowing the list of returned messages.
 type ListMessagesRequest_Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2206,7 +2472,7 @@ type ListMessagesRequest_Filter struct {
 
 func (x *ListMessagesRequest_Filter) Reset() {
 	*x = ListMessagesRequest_Filter{}
-	mi := &file_construct_v1_message_proto_msgTypes[30]
+	mi := &file_construct_v1_message_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2218,7 +2484,7 @@ func (x *ListMessagesRequest_Filter) String() string {
 func (*ListMessagesRequest_Filter) ProtoMessage() {}
 
 func (x *ListMessagesRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[30]
+	mi := &file_construct_v1_message_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2264,7 +2530,7 @@ type ToolCall_CodeInterpreterInput struct {
 
 func (x *ToolCall_CodeInterpreterInput) Reset() {
 	*x = ToolCall_CodeInterpreterInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[31]
+	mi := &file_construct_v1_message_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2276,7 +2542,7 @@ func (x *ToolCall_CodeInterpreterInput) String() string {
 func (*ToolCall_CodeInterpreterInput) ProtoMessage() {}
 
 func (x *ToolCall_CodeInterpreterInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[31]
+	mi := &file_construct_v1_message_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2289,7 +2555,7 @@ func (x *ToolCall_CodeInterpreterInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_CodeInterpreterInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_CodeInterpreterInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *ToolCall_CodeInterpreterInput) GetCode() string {
@@ -2309,7 +2575,7 @@ type ToolCall_CreateFileInput struct {
 
 func (x *ToolCall_CreateFileInput) Reset() {
 	*x = ToolCall_CreateFileInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[32]
+	mi := &file_construct_v1_message_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2321,7 +2587,7 @@ func (x *ToolCall_CreateFileInput) String() string {
 func (*ToolCall_CreateFileInput) ProtoMessage() {}
 
 func (x *ToolCall_CreateFileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[32]
+	mi := &file_construct_v1_message_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2334,7 +2600,7 @@ func (x *ToolCall_CreateFileInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_CreateFileInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_CreateFileInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 1}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 1}
 }
 
 func (x *ToolCall_CreateFileInput) GetPath() string {
@@ -2361,7 +2627,7 @@ type ToolCall_EditFileInput struct {
 
 func (x *ToolCall_EditFileInput) Reset() {
 	*x = ToolCall_EditFileInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[33]
+	mi := &file_construct_v1_message_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2373,7 +2639,7 @@ func (x *ToolCall_EditFileInput) String() string {
 func (*ToolCall_EditFileInput) ProtoMessage() {}
 
 func (x *ToolCall_EditFileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[33]
+	mi := &file_construct_v1_message_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2386,7 +2652,7 @@ func (x *ToolCall_EditFileInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_EditFileInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_EditFileInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 2}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 2}
 }
 
 func (x *ToolCall_EditFileInput) GetPath() string {
@@ -2412,7 +2678,7 @@ type ToolCall_ExecuteCommandInput struct {
 
 func (x *ToolCall_ExecuteCommandInput) Reset() {
 	*x = ToolCall_ExecuteCommandInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[34]
+	mi := &file_construct_v1_message_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2424,7 +2690,7 @@ func (x *ToolCall_ExecuteCommandInput) String() string {
 func (*ToolCall_ExecuteCommandInput) ProtoMessage() {}
 
 func (x *ToolCall_ExecuteCommandInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[34]
+	mi := &file_construct_v1_message_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2437,7 +2703,7 @@ func (x *ToolCall_ExecuteCommandInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_ExecuteCommandInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_ExecuteCommandInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 3}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 3}
 }
 
 func (x *ToolCall_ExecuteCommandInput) GetCommand() string {
@@ -2459,7 +2725,7 @@ type ToolCall_FindFileInput struct {
 
 func (x *ToolCall_FindFileInput) Reset() {
 	*x = ToolCall_FindFileInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[35]
+	mi := &file_construct_v1_message_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2471,7 +2737,7 @@ func (x *ToolCall_FindFileInput) String() string {
 func (*ToolCall_FindFileInput) ProtoMessage() {}
 
 func (x *ToolCall_FindFileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[35]
+	mi := &file_construct_v1_message_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2484,7 +2750,7 @@ func (x *ToolCall_FindFileInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_FindFileInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_FindFileInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 4}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 4}
 }
 
 func (x *ToolCall_FindFileInput) GetPattern() string {
@@ -2529,7 +2795,7 @@ type ToolCall_GrepInput struct {
 
 func (x *ToolCall_GrepInput) Reset() {
 	*x = ToolCall_GrepInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[36]
+	mi := &file_construct_v1_message_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2541,7 +2807,7 @@ func (x *ToolCall_GrepInput) String() string {
 func (*ToolCall_GrepInput) ProtoMessage() {}
 
 func (x *ToolCall_GrepInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[36]
+	mi := &file_construct_v1_message_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2554,7 +2820,7 @@ func (x *ToolCall_GrepInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_GrepInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_GrepInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 5}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 5}
 }
 
 func (x *ToolCall_GrepInput) GetQuery() string {
@@ -2609,7 +2875,7 @@ type ToolCall_HandoffInput struct {
 
 func (x *ToolCall_HandoffInput) Reset() {
 	*x = ToolCall_HandoffInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[37]
+	mi := &file_construct_v1_message_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2621,7 +2887,7 @@ func (x *ToolCall_HandoffInput) String() string {
 func (*ToolCall_HandoffInput) ProtoMessage() {}
 
 func (x *ToolCall_HandoffInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[37]
+	mi := &file_construct_v1_message_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2634,7 +2900,7 @@ func (x *ToolCall_HandoffInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_HandoffInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_HandoffInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 6}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 6}
 }
 
 func (x *ToolCall_HandoffInput) GetRequestedAgent() string {
@@ -2661,7 +2927,7 @@ type ToolCall_AskUserInput struct {
 
 func (x *ToolCall_AskUserInput) Reset() {
 	*x = ToolCall_AskUserInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[38]
+	mi := &file_construct_v1_message_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2673,7 +2939,7 @@ func (x *ToolCall_AskUserInput) String() string {
 func (*ToolCall_AskUserInput) ProtoMessage() {}
 
 func (x *ToolCall_AskUserInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[38]
+	mi := &file_construct_v1_message_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2686,7 +2952,7 @@ func (x *ToolCall_AskUserInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_AskUserInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_AskUserInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 7}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 7}
 }
 
 func (x *ToolCall_AskUserInput) GetQuestion() string {
@@ -2713,7 +2979,7 @@ type ToolCall_ListFilesInput struct {
 
 func (x *ToolCall_ListFilesInput) Reset() {
 	*x = ToolCall_ListFilesInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[39]
+	mi := &file_construct_v1_message_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2725,7 +2991,7 @@ func (x *ToolCall_ListFilesInput) String() string {
 func (*ToolCall_ListFilesInput) ProtoMessage() {}
 
 func (x *ToolCall_ListFilesInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[39]
+	mi := &file_construct_v1_message_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2738,7 +3004,7 @@ func (x *ToolCall_ListFilesInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_ListFilesInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_ListFilesInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 8}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 8}
 }
 
 func (x *ToolCall_ListFilesInput) GetPath() string {
@@ -2766,7 +3032,7 @@ type ToolCall_ReadFileInput struct {
 
 func (x *ToolCall_ReadFileInput) Reset() {
 	*x = ToolCall_ReadFileInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[40]
+	mi := &file_construct_v1_message_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2778,7 +3044,7 @@ func (x *ToolCall_ReadFileInput) String() string {
 func (*ToolCall_ReadFileInput) ProtoMessage() {}
 
 func (x *ToolCall_ReadFileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[40]
+	mi := &file_construct_v1_message_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +3057,7 @@ func (x *ToolCall_ReadFileInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_ReadFileInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_ReadFileInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 9}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 9}
 }
 
 func (x *ToolCall_ReadFileInput) GetPath() string {
@@ -2827,7 +3093,7 @@ type ToolCall_SubmitReportInput struct {
 
 func (x *ToolCall_SubmitReportInput) Reset() {
 	*x = ToolCall_SubmitReportInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[41]
+	mi := &file_construct_v1_message_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2839,7 +3105,7 @@ func (x *ToolCall_SubmitReportInput) String() string {
 func (*ToolCall_SubmitReportInput) ProtoMessage() {}
 
 func (x *ToolCall_SubmitReportInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[41]
+	mi := &file_construct_v1_message_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,7 +3118,7 @@ func (x *ToolCall_SubmitReportInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_SubmitReportInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_SubmitReportInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 10}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 10}
 }
 
 func (x *ToolCall_SubmitReportInput) GetSummary() string {
@@ -2894,7 +3160,7 @@ type ToolCall_FetchInput struct {
 
 func (x *ToolCall_FetchInput) Reset() {
 	*x = ToolCall_FetchInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[42]
+	mi := &file_construct_v1_message_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2906,7 +3172,7 @@ func (x *ToolCall_FetchInput) String() string {
 func (*ToolCall_FetchInput) ProtoMessage() {}
 
 func (x *ToolCall_FetchInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[42]
+	mi := &file_construct_v1_message_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2919,7 +3185,7 @@ func (x *ToolCall_FetchInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_FetchInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_FetchInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 11}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 11}
 }
 
 func (x *ToolCall_FetchInput) GetUrl() string {
@@ -2943,6 +3209,96 @@ func (x *ToolCall_FetchInput) GetTimeout() int32 {
 	return 0
 }
 
+type ToolCall_RememberInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Learning      string                 `protobuf:"bytes,1,opt,name=learning,proto3" json:"learning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall_RememberInput) Reset() {
+	*x = ToolCall_RememberInput{}
+	mi := &file_construct_v1_message_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCall_RememberInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall_RememberInput) ProtoMessage() {}
+
+func (x *ToolCall_RememberInput) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCall_RememberInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_RememberInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 12}
+}
+
+func (x *ToolCall_RememberInput) GetLearning() string {
+	if x != nil {
+		return x.Learning
+	}
+	return ""
+}
+
+// CustomInput is the input of a custom tool that is defined by a plugin.
+type ToolCall_CustomInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// arguments is the JSON encoded argument of the call.
+	Arguments     string `protobuf:"bytes,1,opt,name=arguments,proto3" json:"arguments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall_CustomInput) Reset() {
+	*x = ToolCall_CustomInput{}
+	mi := &file_construct_v1_message_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCall_CustomInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall_CustomInput) ProtoMessage() {}
+
+func (x *ToolCall_CustomInput) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCall_CustomInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_CustomInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 13}
+}
+
+func (x *ToolCall_CustomInput) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
 type ToolCall_EditFileInput_DiffPair struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Old           string                 `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
@@ -2953,7 +3309,7 @@ type ToolCall_EditFileInput_DiffPair struct {
 
 func (x *ToolCall_EditFileInput_DiffPair) Reset() {
 	*x = ToolCall_EditFileInput_DiffPair{}
-	mi := &file_construct_v1_message_proto_msgTypes[43]
+	mi := &file_construct_v1_message_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2965,7 +3321,7 @@ func (x *ToolCall_EditFileInput_DiffPair) String() string {
 func (*ToolCall_EditFileInput_DiffPair) ProtoMessage() {}
 
 func (x *ToolCall_EditFileInput_DiffPair) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[43]
+	mi := &file_construct_v1_message_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2978,7 +3334,7 @@ func (x *ToolCall_EditFileInput_DiffPair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_EditFileInput_DiffPair.ProtoReflect.Descriptor instead.
 func (*ToolCall_EditFileInput_DiffPair) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 2, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 2, 0}
 }
 
 func (x *ToolCall_EditFileInput_DiffPair) GetOld() string {
@@ -3004,7 +3360,7 @@ type ToolResult_CodeInterpreterResult struct {
 
 func (x *ToolResult_CodeInterpreterResult) Reset() {
 	*x = ToolResult_CodeInterpreterResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[45]
+	mi := &file_construct_v1_message_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3016,7 +3372,7 @@ func (x *ToolResult_CodeInterpreterResult) String() string {
 func (*ToolResult_CodeInterpreterResult) ProtoMessage() {}
 
 func (x *ToolResult_CodeInterpreterResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[45]
+	mi := &file_construct_v1_message_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3029,7 +3385,7 @@ func (x *ToolResult_CodeInterpreterResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_CodeInterpreterResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_CodeInterpreterResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *ToolResult_CodeInterpreterResult) GetOutput() string {
@@ -3048,7 +3404,7 @@ type ToolResult_CreateFileResult struct {
 
 func (x *ToolResult_CreateFileResult) Reset() {
 	*x = ToolResult_CreateFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[46]
+	mi := &file_construct_v1_message_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3060,7 +3416,7 @@ func (x *ToolResult_CreateFileResult) String() string {
 func (*ToolResult_CreateFileResult) ProtoMessage() {}
 
 func (x *ToolResult_CreateFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[46]
+	mi := &file_construct_v1_message_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3073,7 +3429,7 @@ func (x *ToolResult_CreateFileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_CreateFileResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_CreateFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 1}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 1}
 }
 
 func (x *ToolResult_CreateFileResult) GetOverwritten() bool {
@@ -3093,7 +3449,7 @@ type ToolResult_EditFileResult struct {
 
 func (x *ToolResult_EditFileResult) Reset() {
 	*x = ToolResult_EditFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[47]
+	mi := &file_construct_v1_message_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3105,7 +3461,7 @@ func (x *ToolResult_EditFileResult) String() string {
 func (*ToolResult_EditFileResult) ProtoMessage() {}
 
 func (x *ToolResult_EditFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[47]
+	mi := &file_construct_v1_message_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3118,7 +3474,7 @@ func (x *ToolResult_EditFileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_EditFileResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_EditFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 2}
 }
 
 func (x *ToolResult_EditFileResult) GetPath() string {
@@ -3147,7 +3503,7 @@ type ToolResult_ExecuteCommandResult struct {
 
 func (x *ToolResult_ExecuteCommandResult) Reset() {
 	*x = ToolResult_ExecuteCommandResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[48]
+	mi := &file_construct_v1_message_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3159,7 +3515,7 @@ func (x *ToolResult_ExecuteCommandResult) String() string {
 func (*ToolResult_ExecuteCommandResult) ProtoMessage() {}
 
 func (x *ToolResult_ExecuteCommandResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[48]
+	mi := &file_construct_v1_message_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3172,7 +3528,7 @@ func (x *ToolResult_ExecuteCommandResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_ExecuteCommandResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_ExecuteCommandResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 3}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 3}
 }
 
 func (x *ToolResult_ExecuteCommandResult) GetStdout() string {
@@ -3214,7 +3570,7 @@ type ToolResult_FindFileResult struct {
 
 func (x *ToolResult_FindFileResult) Reset() {
 	*x = ToolResult_FindFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[49]
+	mi := &file_construct_v1_message_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3226,7 +3582,7 @@ func (x *ToolResult_FindFileResult) String() string {
 func (*ToolResult_FindFileResult) ProtoMessage() {}
 
 func (x *ToolResult_FindFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[49]
+	mi := &file_construct_v1_message_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3239,7 +3595,7 @@ func (x *ToolResult_FindFileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_FindFileResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_FindFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 4}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 4}
 }
 
 func (x *ToolResult_FindFileResult) GetFiles() []string {
@@ -3275,7 +3631,7 @@ type ToolResult_GrepResult struct {
 
 func (x *ToolResult_GrepResult) Reset() {
 	*x = ToolResult_GrepResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[50]
+	mi := &file_construct_v1_message_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3287,7 +3643,7 @@ func (x *ToolResult_GrepResult) String() string {
 func (*ToolResult_GrepResult) ProtoMessage() {}
 
 func (x *ToolResult_GrepResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[50]
+	mi := &file_construct_v1_message_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3300,7 +3656,7 @@ func (x *ToolResult_GrepResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_GrepResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_GrepResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 5}
 }
 
 func (x *ToolResult_GrepResult) GetMatches() []*ToolResult_GrepResult_GrepMatch {
@@ -3341,7 +3697,7 @@ type ToolResult_ListFilesResult struct {
 
 func (x *ToolResult_ListFilesResult) Reset() {
 	*x = ToolResult_ListFilesResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[51]
+	mi := &file_construct_v1_message_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3353,7 +3709,7 @@ func (x *ToolResult_ListFilesResult) String() string {
 func (*ToolResult_ListFilesResult) ProtoMessage() {}
 
 func (x *ToolResult_ListFilesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[51]
+	mi := &file_construct_v1_message_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3366,7 +3722,7 @@ func (x *ToolResult_ListFilesResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_ListFilesResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_ListFilesResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 6}
 }
 
 func (x *ToolResult_ListFilesResult) GetPath() string {
@@ -3393,7 +3749,7 @@ type ToolResult_ReadFileResult struct {
 
 func (x *ToolResult_ReadFileResult) Reset() {
 	*x = ToolResult_ReadFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[52]
+	mi := &file_construct_v1_message_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3405,7 +3761,7 @@ func (x *ToolResult_ReadFileResult) String() string {
 func (*ToolResult_ReadFileResult) ProtoMessage() {}
 
 func (x *ToolResult_ReadFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[52]
+	mi := &file_construct_v1_message_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3418,7 +3774,7 @@ func (x *ToolResult_ReadFileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_ReadFileResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_ReadFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 7}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 7}
 }
 
 func (x *ToolResult_ReadFileResult) GetPath() string {
@@ -3447,7 +3803,7 @@ type ToolResult_SubmitReportResult struct {
 
 func (x *ToolResult_SubmitReportResult) Reset() {
 	*x = ToolResult_SubmitReportResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[53]
+	mi := &file_construct_v1_message_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3459,7 +3815,7 @@ func (x *ToolResult_SubmitReportResult) String() string {
 func (*ToolResult_SubmitReportResult) ProtoMessage() {}
 
 func (x *ToolResult_SubmitReportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[53]
+	mi := &file_construct_v1_message_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3472,7 +3828,7 @@ func (x *ToolResult_SubmitReportResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_SubmitReportResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_SubmitReportResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 8}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 8}
 }
 
 func (x *ToolResult_SubmitReportResult) GetSummary() string {
@@ -3517,7 +3873,7 @@ type ToolResult_FetchResult struct {
 
 func (x *ToolResult_FetchResult) Reset() {
 	*x = ToolResult_FetchResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[54]
+	mi := &file_construct_v1_message_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3529,7 +3885,7 @@ func (x *ToolResult_FetchResult) String() string {
 func (*ToolResult_FetchResult) ProtoMessage() {}
 
 func (x *ToolResult_FetchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[54]
+	mi := &file_construct_v1_message_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3542,7 +3898,7 @@ func (x *ToolResult_FetchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_FetchResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_FetchResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 9}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 9}
 }
 
 func (x *ToolResult_FetchResult) GetUrl() string {
@@ -3587,6 +3943,120 @@ func (x *ToolResult_FetchResult) GetTruncated() bool {
 	return false
 }
 
+type ToolResult_RememberResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Learning      string                 `protobuf:"bytes,2,opt,name=learning,proto3" json:"learning,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Entries       int32                  `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_RememberResult) Reset() {
+	*x = ToolResult_RememberResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_RememberResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_RememberResult) ProtoMessage() {}
+
+func (x *ToolResult_RememberResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_RememberResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_RememberResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 10}
+}
+
+func (x *ToolResult_RememberResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ToolResult_RememberResult) GetLearning() string {
+	if x != nil {
+		return x.Learning
+	}
+	return ""
+}
+
+func (x *ToolResult_RememberResult) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *ToolResult_RememberResult) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+// CustomResult is the result of a custom tool that is defined by a plugin.
+type ToolResult_CustomResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// output is the JSON encoded return value of the call.
+	Output        string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_CustomResult) Reset() {
+	*x = ToolResult_CustomResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_CustomResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_CustomResult) ProtoMessage() {}
+
+func (x *ToolResult_CustomResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_CustomResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_CustomResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 11}
+}
+
+func (x *ToolResult_CustomResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
 type ToolResult_EditFileResult_PatchInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
@@ -3598,7 +4068,7 @@ type ToolResult_EditFileResult_PatchInfo struct {
 
 func (x *ToolResult_EditFileResult_PatchInfo) Reset() {
 	*x = ToolResult_EditFileResult_PatchInfo{}
-	mi := &file_construct_v1_message_proto_msgTypes[55]
+	mi := &file_construct_v1_message_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3610,7 +4080,7 @@ func (x *ToolResult_EditFileResult_PatchInfo) String() string {
 func (*ToolResult_EditFileResult_PatchInfo) ProtoMessage() {}
 
 func (x *ToolResult_EditFileResult_PatchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[55]
+	mi := &file_construct_v1_message_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3623,7 +4093,7 @@ func (x *ToolResult_EditFileResult_PatchInfo) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ToolResult_EditFileResult_PatchInfo.ProtoReflect.Descriptor instead.
 func (*ToolResult_EditFileResult_PatchInfo) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 2, 0}
 }
 
 func (x *ToolResult_EditFileResult_PatchInfo) GetPatch() string {
@@ -3657,7 +4127,7 @@ type ToolResult_GrepResult_GrepMatch struct {
 
 func (x *ToolResult_GrepResult_GrepMatch) Reset() {
 	*x = ToolResult_GrepResult_GrepMatch{}
-	mi := &file_construct_v1_message_proto_msgTypes[56]
+	mi := &file_construct_v1_message_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3669,7 +4139,7 @@ func (x *ToolResult_GrepResult_GrepMatch) String() string {
 func (*ToolResult_GrepResult_GrepMatch) ProtoMessage() {}
 
 func (x *ToolResult_GrepResult_GrepMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[56]
+	mi := &file_construct_v1_message_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3682,7 +4152,7 @@ func (x *ToolResult_GrepResult_GrepMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_GrepResult_GrepMatch.ProtoReflect.Descriptor instead.
 func (*ToolResult_GrepResult_GrepMatch) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 5, 0}
 }
 
 func (x *ToolResult_GrepResult_GrepMatch) GetFilePath() string {
@@ -3710,7 +4180,7 @@ type ToolResult_ListFilesResult_DirectoryEntry struct {
 
 func (x *ToolResult_ListFilesResult_DirectoryEntry) Reset() {
 	*x = ToolResult_ListFilesResult_DirectoryEntry{}
-	mi := &file_construct_v1_message_proto_msgTypes[57]
+	mi := &file_construct_v1_message_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3722,7 +4192,7 @@ func (x *ToolResult_ListFilesResult_DirectoryEntry) String() string {
 func (*ToolResult_ListFilesResult_DirectoryEntry) ProtoMessage() {}
 
 func (x *ToolResult_ListFilesResult_DirectoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[57]
+	mi := &file_construct_v1_message_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3735,7 +4205,7 @@ func (x *ToolResult_ListFilesResult_DirectoryEntry) ProtoReflect() protoreflect.
 
 // Deprecated: Use ToolResult_ListFilesResult_DirectoryEntry.ProtoReflect.Descriptor instead.
 func (*ToolResult_ListFilesResult_DirectoryEntry) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 6, 0}
 }
 
 func (x *ToolResult_ListFilesResult_DirectoryEntry) GetName() string {
@@ -3769,7 +4239,7 @@ type CreateFileToolResult_Input struct {
 
 func (x *CreateFileToolResult_Input) Reset() {
 	*x = CreateFileToolResult_Input{}
-	mi := &file_construct_v1_message_proto_msgTypes[58]
+	mi := &file_construct_v1_message_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3781,7 +4251,7 @@ func (x *CreateFileToolResult_Input) String() string {
 func (*CreateFileToolResult_Input) ProtoMessage() {}
 
 func (x *CreateFileToolResult_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[58]
+	mi := &file_construct_v1_message_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3794,7 +4264,7 @@ func (x *CreateFileToolResult_Input) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFileToolResult_Input.ProtoReflect.Descriptor instead.
 func (*CreateFileToolResult_Input) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *CreateFileToolResult_Input) GetFilePath() string {
@@ -3837,17 +4307,25 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\rMessageStatus\x120\n" +
 	"\x05usage\x18\x01 \x01(\v2\x1a.construct.v1.MessageUsageR\x05usage\x12@\n" +
 	"\rcontent_state\x18\x02 \x01(\x0e2\x1b.construct.v1.ContentStatusR\fcontentState\x12*\n" +
-	"\x11is_final_response\x18\x03 \x01(\bR\x0fisFinalResponse\"\xca\x02\n" +
+	"\x11is_final_response\x18\x03 \x01(\bR\x0fisFinalResponse\"\xa6\x04\n" +
 	"\vMessagePart\x124\n" +
 	"\x04text\x18\x01 \x01(\v2\x1e.construct.v1.MessagePart.TextH\x00R\x04text\x125\n" +
 	"\ttool_call\x18\x02 \x01(\v2\x16.construct.v1.ToolCallH\x00R\btoolCall\x12;\n" +
 	"\vtool_result\x18\x03 \x01(\v2\x18.construct.v1.ToolResultH\x00R\n" +
 	"toolResult\x127\n" +
-	"\x05error\x18\x04 \x01(\v2\x1f.construct.v1.MessagePart.ErrorH\x00R\x05error\x1a-\n" +
+	"\x05error\x18\x04 \x01(\v2\x1f.construct.v1.MessagePart.ErrorH\x00R\x05error\x12S\n" +
+	"\x0ffile_attachment\x18\x05 \x01(\v2(.construct.v1.MessagePart.FileAttachmentH\x00R\x0efileAttachment\x1a-\n" +
 	"\x04Text\x12%\n" +
 	"\acontent\x18\x01 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\acontent\x1a!\n" +
 	"\x05Error\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessageB\x06\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x1a\x84\x01\n" +
+	"\x0eFileAttachment\x12\x1e\n" +
+	"\x04path\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80 R\x04path\x12\x1d\n" +
+	"\n" +
+	"start_line\x18\x02 \x01(\rR\tstartLine\x12\x19\n" +
+	"\bend_line\x18\x03 \x01(\rR\aendLine\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontentB\x06\n" +
 	"\x04data\"\xc4\x01\n" +
 	"\fMessageUsage\x12!\n" +
 	"\finput_tokens\x18\x01 \x01(\x03R\vinputTokens\x12#\n" +
@@ -3900,7 +4378,13 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\v2\x15.construct.v1.MessageB\x06\xbaH\x03\xc8\x01\x01R\amessage\"0\n" +
 	"\x14DeleteMessageRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x17\n" +
-	"\x15DeleteMessageResponse\"\xd6\x11\n" +
+	"\x15DeleteMessageResponse\"s\n" +
+	"\x18RegenerateMessageRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12=\n" +
+	"\acontent\x18\x02 \x03(\v2\x19.construct.v1.MessagePartB\b\xbaH\x05\x92\x01\x02\x10\x19R\acontent\"\x7f\n" +
+	"\x19RegenerateMessageResponse\x127\n" +
+	"\amessage\x18\x01 \x01(\v2\x15.construct.v1.MessageB\x06\xbaH\x03\xc8\x01\x01R\amessage\x12)\n" +
+	"\x10removed_messages\x18\x02 \x01(\x05R\x0fremovedMessages\"\xb2\x13\n" +
 	"\bToolCall\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\ttool_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\btoolName\x12I\n" +
@@ -3918,7 +4402,9 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\tread_file\x18\v \x01(\v2$.construct.v1.ToolCall.ReadFileInputH\x00R\breadFile\x12O\n" +
 	"\rsubmit_report\x18\f \x01(\v2(.construct.v1.ToolCall.SubmitReportInputH\x00R\fsubmitReport\x12X\n" +
 	"\x10code_interpreter\x18\r \x01(\v2+.construct.v1.ToolCall.CodeInterpreterInputH\x00R\x0fcodeInterpreter\x129\n" +
-	"\x05fetch\x18\x0e \x01(\v2!.construct.v1.ToolCall.FetchInputH\x00R\x05fetch\x1a*\n" +
+	"\x05fetch\x18\x0e \x01(\v2!.construct.v1.ToolCall.FetchInputH\x00R\x05fetch\x12B\n" +
+	"\bremember\x18\x0f \x01(\v2$.construct.v1.ToolCall.RememberInputH\x00R\bremember\x12<\n" +
+	"\x06custom\x18\x10 \x01(\v2\".construct.v1.ToolCall.CustomInputH\x00R\x06custom\x1a*\n" +
 	"\x14CodeInterpreterInput\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x1a?\n" +
 	"\x0fCreateFileInput\x12\x12\n" +
@@ -3973,8 +4459,12 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\atimeout\x18\x03 \x01(\x05R\atimeout\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
-	"\x05Input\"\x81\x12\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a+\n" +
+	"\rRememberInput\x12\x1a\n" +
+	"\blearning\x18\x01 \x01(\tR\blearning\x1a+\n" +
+	"\vCustomInput\x12\x1c\n" +
+	"\targuments\x18\x01 \x01(\tR\targumentsB\a\n" +
+	"\x05Input\"\xab\x14\n" +
 	"\n" +
 	"ToolResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
@@ -3991,7 +4481,9 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\rsubmit_report\x18\n" +
 	" \x01(\v2+.construct.v1.ToolResult.SubmitReportResultH\x00R\fsubmitReport\x12[\n" +
 	"\x10code_interpreter\x18\v \x01(\v2..construct.v1.ToolResult.CodeInterpreterResultH\x00R\x0fcodeInterpreter\x12<\n" +
-	"\x05fetch\x18\x0e \x01(\v2$.construct.v1.ToolResult.FetchResultH\x00R\x05fetch\x12-\n" +
+	"\x05fetch\x18\x0e \x01(\v2$.construct.v1.ToolResult.FetchResultH\x00R\x05fetch\x12E\n" +
+	"\bremember\x18\x0f \x01(\v2'.construct.v1.ToolResult.RememberResultH\x00R\bremember\x12?\n" +
+	"\x06custom\x18\x10 \x01(\v2%.construct.v1.ToolResult.CustomResultH\x00R\x06custom\x12-\n" +
 	"\x05error\x18\r \x01(\v2\x17.construct.v1.ToolErrorR\x05error\x1a/\n" +
 	"\x15CodeInterpreterResult\x12\x16\n" +
 	"\x06output\x18\x01 \x01(\tR\x06output\x1a4\n" +
@@ -4047,7 +4539,14 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12!\n" +
 	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1b\n" +
 	"\tbyte_size\x18\x05 \x01(\x03R\bbyteSize\x12\x1c\n" +
-	"\ttruncated\x18\x06 \x01(\bR\ttruncatedB\b\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated\x1ax\n" +
+	"\x0eRememberResult\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\blearning\x18\x02 \x01(\tR\blearning\x12\x1c\n" +
+	"\tduplicate\x18\x03 \x01(\bR\tduplicate\x12\x18\n" +
+	"\aentries\x18\x04 \x01(\x05R\aentries\x1a&\n" +
+	"\fCustomResult\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06outputB\b\n" +
 	"\x06result\"\xc1\x01\n" +
 	"\x14CreateFileToolResult\x12>\n" +
 	"\x05input\x18\x01 \x01(\v2(.construct.v1.CreateFileToolResult.InputR\x05input\x12 \n" +
@@ -4090,14 +4589,15 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\x18MESSAGE_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11MESSAGE_ROLE_USER\x10\x01\x12\x1a\n" +
 	"\x16MESSAGE_ROLE_ASSISTANT\x10\x02\x12\x17\n" +
-	"\x13MESSAGE_ROLE_SYSTEM\x10\x032\xd6\x03\n" +
+	"\x13MESSAGE_ROLE_SYSTEM\x10\x032\xbe\x04\n" +
 	"\x0eMessageService\x12Z\n" +
 	"\rCreateMessage\x12\".construct.v1.CreateMessageRequest\x1a#.construct.v1.CreateMessageResponse\"\x00\x12T\n" +
 	"\n" +
 	"GetMessage\x12\x1f.construct.v1.GetMessageRequest\x1a .construct.v1.GetMessageResponse\"\x03\x90\x02\x01\x12Z\n" +
 	"\fListMessages\x12!.construct.v1.ListMessagesRequest\x1a\".construct.v1.ListMessagesResponse\"\x03\x90\x02\x01\x12Z\n" +
 	"\rUpdateMessage\x12\".construct.v1.UpdateMessageRequest\x1a#.construct.v1.UpdateMessageResponse\"\x00\x12Z\n" +
-	"\rDeleteMessage\x12\".construct.v1.DeleteMessageRequest\x1a#.construct.v1.DeleteMessageResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+	"\rDeleteMessage\x12\".construct.v1.DeleteMessageRequest\x1a#.construct.v1.DeleteMessageResponse\"\x00\x12f\n" +
+	"\x11RegenerateMessage\x12&.construct.v1.RegenerateMessageRequest\x1a'.construct.v1.RegenerateMessageResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
 
 var (
 	file_construct_v1_message_proto_rawDescOnce sync.Once
@@ -4112,7 +4612,7 @@ func file_construct_v1_message_proto_rawDescGZIP() []byte {
 }
 
 var file_construct_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_construct_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_construct_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_construct_v1_message_proto_goTypes = []any{
 	(ContentStatus)(0),                                // 0: construct.v1.ContentStatus
 	(MessageRole)(0),                                  // 1: construct.v1.MessageRole
@@ -4132,123 +4632,139 @@ var file_construct_v1_message_proto_goTypes = []any{
 	(*UpdateMessageResponse)(nil),                     // 15: construct.v1.UpdateMessageResponse
 	(*DeleteMessageRequest)(nil),                      // 16: construct.v1.DeleteMessageRequest
 	(*DeleteMessageResponse)(nil),                     // 17: construct.v1.DeleteMessageResponse
-	(*ToolCall)(nil),                                  // 18: construct.v1.ToolCall
-	(*ToolResult)(nil),                                // 19: construct.v1.ToolResult
-	(*CreateFileToolResult)(nil),                      // 20: construct.v1.CreateFileToolResult
-	(*EditFileToolResult)(nil),                        // 21: construct.v1.EditFileToolResult
-	(*ExecuteCommandToolResult)(nil),                  // 22: construct.v1.ExecuteCommandToolResult
-	(*FindFileToolResult)(nil),                        // 23: construct.v1.FindFileToolResult
-	(*GrepToolResult)(nil),                            // 24: construct.v1.GrepToolResult
-	(*HandoffToolResult)(nil),                         // 25: construct.v1.HandoffToolResult
-	(*ListFilesToolResult)(nil),                       // 26: construct.v1.ListFilesToolResult
-	(*ReadFileToolResult)(nil),                        // 27: construct.v1.ReadFileToolResult
-	(*SubmitReport)(nil),                              // 28: construct.v1.SubmitReport
-	(*ToolError)(nil),                                 // 29: construct.v1.ToolError
-	(*MessagePart_Text)(nil),                          // 30: construct.v1.MessagePart.Text
-	(*MessagePart_Error)(nil),                         // 31: construct.v1.MessagePart.Error
-	(*ListMessagesRequest_Filter)(nil),                // 32: construct.v1.ListMessagesRequest.Filter
-	(*ToolCall_CodeInterpreterInput)(nil),             // 33: construct.v1.ToolCall.CodeInterpreterInput
-	(*ToolCall_CreateFileInput)(nil),                  // 34: construct.v1.ToolCall.CreateFileInput
-	(*ToolCall_EditFileInput)(nil),                    // 35: construct.v1.ToolCall.EditFileInput
-	(*ToolCall_ExecuteCommandInput)(nil),              // 36: construct.v1.ToolCall.ExecuteCommandInput
-	(*ToolCall_FindFileInput)(nil),                    // 37: construct.v1.ToolCall.FindFileInput
-	(*ToolCall_GrepInput)(nil),                        // 38: construct.v1.ToolCall.GrepInput
-	(*ToolCall_HandoffInput)(nil),                     // 39: construct.v1.ToolCall.HandoffInput
-	(*ToolCall_AskUserInput)(nil),                     // 40: construct.v1.ToolCall.AskUserInput
-	(*ToolCall_ListFilesInput)(nil),                   // 41: construct.v1.ToolCall.ListFilesInput
-	(*ToolCall_ReadFileInput)(nil),                    // 42: construct.v1.ToolCall.ReadFileInput
-	(*ToolCall_SubmitReportInput)(nil),                // 43: construct.v1.ToolCall.SubmitReportInput
-	(*ToolCall_FetchInput)(nil),                       // 44: construct.v1.ToolCall.FetchInput
-	(*ToolCall_EditFileInput_DiffPair)(nil),           // 45: construct.v1.ToolCall.EditFileInput.DiffPair
-	nil,                                               // 46: construct.v1.ToolCall.FetchInput.HeadersEntry
-	(*ToolResult_CodeInterpreterResult)(nil),          // 47: construct.v1.ToolResult.CodeInterpreterResult
-	(*ToolResult_CreateFileResult)(nil),               // 48: construct.v1.ToolResult.CreateFileResult
-	(*ToolResult_EditFileResult)(nil),                 // 49: construct.v1.ToolResult.EditFileResult
-	(*ToolResult_ExecuteCommandResult)(nil),           // 50: construct.v1.ToolResult.ExecuteCommandResult
-	(*ToolResult_FindFileResult)(nil),                 // 51: construct.v1.ToolResult.FindFileResult
-	(*ToolResult_GrepResult)(nil),                     // 52: construct.v1.ToolResult.GrepResult
-	(*ToolResult_ListFilesResult)(nil),                // 53: construct.v1.ToolResult.ListFilesResult
-	(*ToolResult_ReadFileResult)(nil),                 // 54: construct.v1.ToolResult.ReadFileResult
-	(*ToolResult_SubmitReportResult)(nil),             // 55: construct.v1.ToolResult.SubmitReportResult
-	(*ToolResult_FetchResult)(nil),                    // 56: construct.v1.ToolResult.FetchResult
-	(*ToolResult_EditFileResult_PatchInfo)(nil),       // 57: construct.v1.ToolResult.EditFileResult.PatchInfo
-	(*ToolResult_GrepResult_GrepMatch)(nil),           // 58: construct.v1.ToolResult.GrepResult.GrepMatch
-	(*ToolResult_ListFilesResult_DirectoryEntry)(nil), // 59: construct.v1.ToolResult.ListFilesResult.DirectoryEntry
-	(*CreateFileToolResult_Input)(nil),                // 60: construct.v1.CreateFileToolResult.Input
-	nil,                                               // 61: construct.v1.ToolError.DetailsEntry
-	(*timestamppb.Timestamp)(nil),                     // 62: google.protobuf.Timestamp
-	(SortField)(0),                                    // 63: construct.v1.SortField
-	(SortOrder)(0),                                    // 64: construct.v1.SortOrder
+	(*RegenerateMessageRequest)(nil),                  // 18: construct.v1.RegenerateMessageRequest
+	(*RegenerateMessageResponse)(nil),                 // 19: construct.v1.RegenerateMessageResponse
+	(*ToolCall)(nil),                                  // 20: construct.v1.ToolCall
+	(*ToolResult)(nil),                                // 21: construct.v1.ToolResult
+	(*CreateFileToolResult)(nil),                      // 22: construct.v1.CreateFileToolResult
+	(*EditFileToolResult)(nil),                        // 23: construct.v1.EditFileToolResult
+	(*ExecuteCommandToolResult)(nil),                  // 24: construct.v1.ExecuteCommandToolResult
+	(*FindFileToolResult)(nil),                        // 25: construct.v1.FindFileToolResult
+	(*GrepToolResult)(nil),                            // 26: construct.v1.GrepToolResult
+	(*HandoffToolResult)(nil),                         // 27: construct.v1.HandoffToolResult
+	(*ListFilesToolResult)(nil),                       // 28: construct.v1.ListFilesToolResult
+	(*ReadFileToolResult)(nil),                        // 29: construct.v1.ReadFileToolResult
+	(*SubmitReport)(nil),                              // 30: construct.v1.SubmitReport
+	(*ToolError)(nil),                                 // 31: construct.v1.ToolError
+	(*MessagePart_Text)(nil),                          // 32: construct.v1.MessagePart.Text
+	(*MessagePart_Error)(nil),                         // 33: construct.v1.MessagePart.Error
+	(*MessagePart_FileAttachment)(nil),                // 34: construct.v1.MessagePart.FileAttachment
+	(*ListMessagesRequest_Filter)(nil),                // 35: construct.v1.ListMessagesRequest.Filter
+	(*ToolCall_CodeInterpreterInput)(nil),             // 36: construct.v1.ToolCall.CodeInterpreterInput
+	(*ToolCall_CreateFileInput)(nil),                  // 37: construct.v1.ToolCall.CreateFileInput
+	(*ToolCall_EditFileInput)(nil),                    // 38: construct.v1.ToolCall.EditFileInput
+	(*ToolCall_ExecuteCommandInput)(nil),              // 39: construct.v1.ToolCall.ExecuteCommandInput
+	(*ToolCall_FindFileInput)(nil),                    // 40: construct.v1.ToolCall.FindFileInput
+	(*ToolCall_GrepInput)(nil),                        // 41: construct.v1.ToolCall.GrepInput
+	(*ToolCall_HandoffInput)(nil),                     // 42: construct.v1.ToolCall.HandoffInput
+	(*ToolCall_AskUserInput)(nil),                     // 43: construct.v1.ToolCall.AskUserInput
+	(*ToolCall_ListFilesInput)(nil),                   // 44: construct.v1.ToolCall.ListFilesInput
+	(*ToolCall_ReadFileInput)(nil),                    // 45: construct.v1.ToolCall.ReadFileInput
+	(*ToolCall_SubmitReportInput)(nil),                // 46: construct.v1.ToolCall.SubmitReportInput
+	(*ToolCall_FetchInput)(nil),                       // 47: construct.v1.ToolCall.FetchInput
+	(*ToolCall_RememberInput)(nil),                    // 48: construct.v1.ToolCall.RememberInput
+	(*ToolCall_CustomInput)(nil),                      // 49: construct.v1.ToolCall.CustomInput
+	(*ToolCall_EditFileInput_DiffPair)(nil),           // 50: construct.v1.ToolCall.EditFileInput.DiffPair
+	nil,                                               // 51: construct.v1.ToolCall.FetchInput.HeadersEntry
+	(*ToolResult_CodeInterpreterResult)(nil),          // 52: construct.v1.ToolResult.CodeInterpreterResult
+	(*ToolResult_CreateFileResult)(nil),               // 53: construct.v1.ToolResult.CreateFileResult
+	(*ToolResult_EditFileResult)(nil),                 // 54: construct.v1.ToolResult.EditFileResult
+	(*ToolResult_ExecuteCommandResult)(nil),           // 55: construct.v1.ToolResult.ExecuteCommandResult
+	(*ToolResult_FindFileResult)(nil),                 // 56: construct.v1.ToolResult.FindFileResult
+	(*ToolResult_GrepResult)(nil),                     // 57: construct.v1.ToolResult.GrepResult
+	(*ToolResult_ListFilesResult)(nil),                // 58: construct.v1.ToolResult.ListFilesResult
+	(*ToolResult_ReadFileResult)(nil),                 // 59: construct.v1.ToolResult.ReadFileResult
+	(*ToolResult_SubmitReportResult)(nil),             // 60: construct.v1.ToolResult.SubmitReportResult
+	(*ToolResult_FetchResult)(nil),                    // 61: construct.v1.ToolResult.FetchResult
+	(*ToolResult_RememberResult)(nil),                 // 62: construct.v1.ToolResult.RememberResult
+	(*ToolResult_CustomResult)(nil),                   // 63: construct.v1.ToolResult.CustomResult
+	(*ToolResult_EditFileResult_PatchInfo)(nil),       // 64: construct.v1.ToolResult.EditFileResult.PatchInfo
+	(*ToolResult_GrepResult_GrepMatch)(nil),           // 65: construct.v1.ToolResult.GrepResult.GrepMatch
+	(*ToolResult_ListFilesResult_DirectoryEntry)(nil), // 66: construct.v1.ToolResult.ListFilesResult.DirectoryEntry
+	(*CreateFileToolResult_Input)(nil),                // 67: construct.v1.CreateFileToolResult.Input
+	nil,                                               // 68: construct.v1.ToolError.DetailsEntry
+	(*timestamppb.Timestamp)(nil),                     // 69: google.protobuf.Timestamp
+	(SortField)(0),                                    // 70: construct.v1.SortField
+	(SortOrder)(0),                                    // 71: construct.v1.SortOrder
 }
 var file_construct_v1_message_proto_depIdxs = []int32{
 	3,  // 0: construct.v1.Message.metadata:type_name -> construct.v1.MessageMetadata
 	4,  // 1: construct.v1.Message.spec:type_name -> construct.v1.MessageSpec
 	5,  // 2: construct.v1.Message.status:type_name -> construct.v1.MessageStatus
-	62, // 3: construct.v1.MessageMetadata.created_at:type_name -> google.protobuf.Timestamp
-	62, // 4: construct.v1.MessageMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	69, // 3: construct.v1.MessageMetadata.created_at:type_name -> google.protobuf.Timestamp
+	69, // 4: construct.v1.MessageMetadata.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 5: construct.v1.MessageMetadata.role:type_name -> construct.v1.MessageRole
 	6,  // 6: construct.v1.MessageSpec.content:type_name -> construct.v1.MessagePart
 	7,  // 7: construct.v1.MessageStatus.usage:type_name -> construct.v1.MessageUsage
 	0,  // 8: construct.v1.MessageStatus.content_state:type_name -> construct.v1.ContentStatus
-	30, // 9: construct.v1.MessagePart.text:type_name -> construct.v1.MessagePart.Text
-	18, // 10: construct.v1.MessagePart.tool_call:type_name -> construct.v1.ToolCall
-	19, // 11: construct.v1.MessagePart.tool_result:type_name -> construct.v1.ToolResult
-	31, // 12: construct.v1.MessagePart.error:type_name -> construct.v1.MessagePart.Error
-	6,  // 13: construct.v1.CreateMessageRequest.content:type_name -> construct.v1.MessagePart
-	2,  // 14: construct.v1.CreateMessageResponse.message:type_name -> construct.v1.Message
-	2,  // 15: construct.v1.GetMessageResponse.message:type_name -> construct.v1.Message
-	32, // 16: construct.v1.ListMessagesRequest.filter:type_name -> construct.v1.ListMessagesRequest.Filter
-	63, // 17: construct.v1.ListMessagesRequest.sort_field:type_name -> construct.v1.SortField
-	64, // 18: construct.v1.ListMessagesRequest.sort_order:type_name -> construct.v1.SortOrder
-	2,  // 19: construct.v1.ListMessagesResponse.messages:type_name -> construct.v1.Message
-	6,  // 20: construct.v1.UpdateMessageRequest.content:type_name -> construct.v1.MessagePart
-	2,  // 21: construct.v1.UpdateMessageResponse.message:type_name -> construct.v1.Message
-	34, // 22: construct.v1.ToolCall.create_file:type_name -> construct.v1.ToolCall.CreateFileInput
-	35, // 23: construct.v1.ToolCall.edit_file:type_name -> construct.v1.ToolCall.EditFileInput
-	36, // 24: construct.v1.ToolCall.execute_command:type_name -> construct.v1.ToolCall.ExecuteCommandInput
-	37, // 25: construct.v1.ToolCall.find_file:type_name -> construct.v1.ToolCall.FindFileInput
-	38, // 26: construct.v1.ToolCall.grep:type_name -> construct.v1.ToolCall.GrepInput
-	39, // 27: construct.v1.ToolCall.handoff:type_name -> construct.v1.ToolCall.HandoffInput
-	40, // 28: construct.v1.ToolCall.ask_user:type_name -> construct.v1.ToolCall.AskUserInput
-	41, // 29: construct.v1.ToolCall.list_files:type_name -> construct.v1.ToolCall.ListFilesInput
-	42, // 30: construct.v1.ToolCall.read_file:type_name -> construct.v1.ToolCall.ReadFileInput
-	43, // 31: construct.v1.ToolCall.submit_report:type_name -> construct.v1.ToolCall.SubmitReportInput
-	33, // 32: construct.v1.ToolCall.code_interpreter:type_name -> construct.v1.ToolCall.CodeInterpreterInput
-	44, // 33: construct.v1.ToolCall.fetch:type_name -> construct.v1.ToolCall.FetchInput
-	48, // 34: construct.v1.ToolResult.create_file:type_name -> construct.v1.ToolResult.CreateFileResult
-	49, // 35: construct.v1.ToolResult.edit_file:type_name -> construct.v1.ToolResult.EditFileResult
-	50, // 36: construct.v1.ToolResult.execute_command:type_name -> construct.v1.ToolResult.ExecuteCommandResult
-	51, // 37: construct.v1.ToolResult.find_file:type_name -> construct.v1.ToolResult.FindFileResult
-	52, // 38: construct.v1.ToolResult.grep:type_name -> construct.v1.ToolResult.GrepResult
-	53, // 39: construct.v1.ToolResult.list_files:type_name -> construct.v1.ToolResult.ListFilesResult
-	54, // 40: construct.v1.ToolResult.read_file:type_name -> construct.v1.ToolResult.ReadFileResult
-	55, // 41: construct.v1.ToolResult.submit_report:type_name -> construct.v1.ToolResult.SubmitReportResult
-	47, // 42: construct.v1.ToolResult.code_interpreter:type_name -> construct.v1.ToolResult.CodeInterpreterResult
-	56, // 43: construct.v1.ToolResult.fetch:type_name -> construct.v1.ToolResult.FetchResult
-	29, // 44: construct.v1.ToolResult.error:type_name -> construct.v1.ToolError
-	60, // 45: construct.v1.CreateFileToolResult.input:type_name -> construct.v1.CreateFileToolResult.Input
-	61, // 46: construct.v1.ToolError.details:type_name -> construct.v1.ToolError.DetailsEntry
-	1,  // 47: construct.v1.ListMessagesRequest.Filter.roles:type_name -> construct.v1.MessageRole
-	45, // 48: construct.v1.ToolCall.EditFileInput.diffs:type_name -> construct.v1.ToolCall.EditFileInput.DiffPair
-	46, // 49: construct.v1.ToolCall.FetchInput.headers:type_name -> construct.v1.ToolCall.FetchInput.HeadersEntry
-	57, // 50: construct.v1.ToolResult.EditFileResult.patch_info:type_name -> construct.v1.ToolResult.EditFileResult.PatchInfo
-	58, // 51: construct.v1.ToolResult.GrepResult.matches:type_name -> construct.v1.ToolResult.GrepResult.GrepMatch
-	59, // 52: construct.v1.ToolResult.ListFilesResult.entries:type_name -> construct.v1.ToolResult.ListFilesResult.DirectoryEntry
-	8,  // 53: construct.v1.MessageService.CreateMessage:input_type -> construct.v1.CreateMessageRequest
-	10, // 54: construct.v1.MessageService.GetMessage:input_type -> construct.v1.GetMessageRequest
-	12, // 55: construct.v1.MessageService.ListMessages:input_type -> construct.v1.ListMessagesRequest
-	14, // 56: construct.v1.MessageService.UpdateMessage:input_type -> construct.v1.UpdateMessageRequest
-	16, // 57: construct.v1.MessageService.DeleteMessage:input_type -> construct.v1.DeleteMessageRequest
-	9,  // 58: construct.v1.MessageService.CreateMessage:output_type -> construct.v1.CreateMessageResponse
-	11, // 59: construct.v1.MessageService.GetMessage:output_type -> construct.v1.GetMessageResponse
-	13, // 60: construct.v1.MessageService.ListMessages:output_type -> construct.v1.ListMessagesResponse
-	15, // 61: construct.v1.MessageService.UpdateMessage:output_type -> construct.v1.UpdateMessageResponse
-	17, // 62: construct.v1.MessageService.DeleteMessage:output_type -> construct.v1.DeleteMessageResponse
-	58, // [58:63] is the sub-list for method output_type
-	53, // [53:58] is the sub-list for method input_type
-	53, // [53:53] is the sub-list for extension type_name
-	53, // [53:53] is the sub-list for extension extendee
-	0,  // [0:53] is the sub-list for field type_name
+	32, // 9: construct.v1.MessagePart.text:type_name -> construct.v1.MessagePart.Text
+	20, // 10: construct.v1.MessagePart.tool_call:type_name -> construct.v1.ToolCall
+	21, // 11: construct.v1.MessagePart.tool_result:type_name -> construct.v1.ToolResult
+	33, // 12: construct.v1.MessagePart.error:type_name -> construct.v1.MessagePart.Error
+	34, // 13: construct.v1.MessagePart.file_attachment:type_name -> construct.v1.MessagePart.FileAttachment
+	6,  // 14: construct.v1.CreateMessageRequest.content:type_name -> construct.v1.MessagePart
+	2,  // 15: construct.v1.CreateMessageResponse.message:type_name -> construct.v1.Message
+	2,  // 16: construct.v1.GetMessageResponse.message:type_name -> construct.v1.Message
+	35, // 17: construct.v1.ListMessagesRequest.filter:type_name -> construct.v1.ListMessagesRequest.Filter
+	70, // 18: construct.v1.ListMessagesRequest.sort_field:type_name -> construct.v1.SortField
+	71, // 19: construct.v1.ListMessagesRequest.sort_order:type_name -> construct.v1.SortOrder
+	2,  // 20: construct.v1.ListMessagesResponse.messages:type_name -> construct.v1.Message
+	6,  // 21: construct.v1.UpdateMessageRequest.content:type_name -> construct.v1.MessagePart
+	2,  // 22: construct.v1.UpdateMessageResponse.message:type_name -> construct.v1.Message
+	6,  // 23: construct.v1.RegenerateMessageRequest.content:type_name -> construct.v1.MessagePart
+	2,  // 24: construct.v1.RegenerateMessageResponse.message:type_name -> construct.v1.Message
+	37, // 25: construct.v1.ToolCall.create_file:type_name -> construct.v1.ToolCall.CreateFileInput
+	38, // 26: construct.v1.ToolCall.edit_file:type_name -> construct.v1.ToolCall.EditFileInput
+	39, // 27: construct.v1.ToolCall.execute_command:type_name -> construct.v1.ToolCall.ExecuteCommandInput
+	40, // 28: construct.v1.ToolCall.find_file:type_name -> construct.v1.ToolCall.FindFileInput
+	41, // 29: construct.v1.ToolCall.grep:type_name -> construct.v1.ToolCall.GrepInput
+	42, // 30: construct.v1.ToolCall.handoff:type_name -> construct.v1.ToolCall.HandoffInput
+	43, // 31: construct.v1.ToolCall.ask_user:type_name -> construct.v1.ToolCall.AskUserInput
+	44, // 32: construct.v1.ToolCall.list_files:type_name -> construct.v1.ToolCall.ListFilesInput
+	45, // 33: construct.v1.ToolCall.read_file:type_name -> construct.v1.ToolCall.ReadFileInput
+	46, // 34: construct.v1.ToolCall.submit_report:type_name -> construct.v1.ToolCall.SubmitReportInput
+	36, // 35: construct.v1.ToolCall.code_interpreter:type_name -> construct.v1.ToolCall.CodeInterpreterInput
+	47, // 36: construct.v1.ToolCall.fetch:type_name -> construct.v1.ToolCall.FetchInput
+	48, // 37: construct.v1.ToolCall.remember:type_name -> construct.v1.ToolCall.RememberInput
+	49, // 38: construct.v1.ToolCall.custom:type_name -> construct.v1.ToolCall.CustomInput
+	53, // 39: construct.v1.ToolResult.create_file:type_name -> construct.v1.ToolResult.CreateFileResult
+	54, // 40: construct.v1.ToolResult.edit_file:type_name -> construct.v1.ToolResult.EditFileResult
+	55, // 41: construct.v1.ToolResult.execute_command:type_name -> construct.v1.ToolResult.ExecuteCommandResult
+	56, // 42: construct.v1.ToolResult.find_file:type_name -> construct.v1.ToolResult.FindFileResult
+	57, // 43: construct.v1.ToolResult.grep:type_name -> construct.v1.ToolResult.GrepResult
+	58, // 44: construct.v1.ToolResult.list_files:type_name -> construct.v1.ToolResult.ListFilesResult
+	59, // 45: construct.v1.ToolResult.read_file:type_name -> construct.v1.ToolResult.ReadFileResult
+	60, // 46: construct.v1.ToolResult.submit_report:type_name -> construct.v1.ToolResult.SubmitReportResult
+	52, // 47: construct.v1.ToolResult.code_interpreter:type_name -> construct.v1.ToolResult.CodeInterpreterResult
+	61, // 48: construct.v1.ToolResult.fetch:type_name -> construct.v1.ToolResult.FetchResult
+	62, // 49: construct.v1.ToolResult.remember:type_name -> construct.v1.ToolResult.RememberResult
+	63, // 50: construct.v1.ToolResult.custom:type_name -> construct.v1.ToolResult.CustomResult
+	31, // 51: construct.v1.ToolResult.error:type_name -> construct.v1.ToolError
+	67, // 52: construct.v1.CreateFileToolResult.input:type_name -> construct.v1.CreateFileToolResult.Input
+	68, // 53: construct.v1.ToolError.details:type_name -> construct.v1.ToolError.DetailsEntry
+	1,  // 54: construct.v1.ListMessagesRequest.Filter.roles:type_name -> construct.v1.MessageRole
+	50, // 55: construct.v1.ToolCall.EditFileInput.diffs:type_name -> construct.v1.ToolCall.EditFileInput.DiffPair
+	51, // 56: construct.v1.ToolCall.FetchInput.headers:type_name -> construct.v1.ToolCall.FetchInput.HeadersEntry
+	64, // 57: construct.v1.ToolResult.EditFileResult.patch_info:type_name -> construct.v1.ToolResult.EditFileResult.PatchInfo
+	65, // 58: construct.v1.ToolResult.GrepResult.matches:type_name -> construct.v1.ToolResult.GrepResult.GrepMatch
+	66, // 59: construct.v1.ToolResult.ListFilesResult.entries:type_name -> construct.v1.ToolResult.ListFilesResult.DirectoryEntry
+	8,  // 60: construct.v1.MessageService.CreateMessage:input_type -> construct.v1.CreateMessageRequest
+	10, // 61: construct.v1.MessageService.GetMessage:input_type -> construct.v1.GetMessageRequest
+	12, // 62: construct.v1.MessageService.ListMessages:input_type -> construct.v1.ListMessagesRequest
+	14, // 63: construct.v1.MessageService.UpdateMessage:input_type -> construct.v1.UpdateMessageRequest
+	16, // 64: construct.v1.MessageService.DeleteMessage:input_type -> construct.v1.DeleteMessageRequest
+	18, // 65: construct.v1.MessageService.RegenerateMessage:input_type -> construct.v1.RegenerateMessageRequest
+	9,  // 66: construct.v1.MessageService.CreateMessage:output_type -> construct.v1.CreateMessageResponse
+	11, // 67: construct.v1.MessageService.GetMessage:output_type -> construct.v1.GetMessageResponse
+	13, // 68: construct.v1.MessageService.ListMessages:output_type -> construct.v1.ListMessagesResponse
+	15, // 69: construct.v1.MessageService.UpdateMessage:output_type -> construct.v1.UpdateMessageResponse
+	17, // 70: construct.v1.MessageService.DeleteMessage:output_type -> construct.v1.DeleteMessageResponse
+	19, // 71: construct.v1.MessageService.RegenerateMessage:output_type -> construct.v1.RegenerateMessageResponse
+	66, // [66:72] is the sub-list for method output_type
+	60, // [60:66] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_message_proto_init() }
@@ -4263,9 +4779,10 @@ func file_construct_v1_message_proto_init() {
 		(*MessagePart_ToolCall)(nil),
 		(*MessagePart_ToolResult)(nil),
 		(*MessagePart_Error_)(nil),
+		(*MessagePart_FileAttachment_)(nil),
 	}
 	file_construct_v1_message_proto_msgTypes[10].OneofWrappers = []any{}
-	file_construct_v1_message_proto_msgTypes[16].OneofWrappers = []any{
+	file_construct_v1_message_proto_msgTypes[18].OneofWrappers = []any{
 		(*ToolCall_CreateFile)(nil),
 		(*ToolCall_EditFile)(nil),
 		(*ToolCall_ExecuteCommand)(nil),
@@ -4278,8 +4795,10 @@ func file_construct_v1_message_proto_init() {
 		(*ToolCall_SubmitReport)(nil),
 		(*ToolCall_CodeInterpreter)(nil),
 		(*ToolCall_Fetch)(nil),
+		(*ToolCall_Remember)(nil),
+		(*ToolCall_Custom)(nil),
 	}
-	file_construct_v1_message_proto_msgTypes[17].OneofWrappers = []any{
+	file_construct_v1_message_proto_msgTypes[19].OneofWrappers = []any{
 		(*ToolResult_CreateFile)(nil),
 		(*ToolResult_EditFile)(nil),
 		(*ToolResult_ExecuteCommand)(nil),
@@ -4290,15 +4809,17 @@ func file_construct_v1_message_proto_init() {
 		(*ToolResult_SubmitReport)(nil),
 		(*ToolResult_CodeInterpreter)(nil),
 		(*ToolResult_Fetch)(nil),
+		(*ToolResult_Remember)(nil),
+		(*ToolResult_Custom)(nil),
 	}
-	file_construct_v1_message_proto_msgTypes[30].OneofWrappers = []any{}
+	file_construct_v1_message_proto_msgTypes[33].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_message_proto_rawDesc), len(file_construct_v1_message_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   60,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
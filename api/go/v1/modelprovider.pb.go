@@ -240,7 +240,13 @@ type ModelProviderMetadata struct {
 	// updated_at is the timestamp when the model provider was last modified.
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// provider_type specifies which AI service this provider represents.
-	ProviderType  ModelProviderType `protobuf:"varint,4,opt,name=provider_type,json=providerType,proto3,enum=construct.v1.ModelProviderType" json:"provider_type,omitempty"`
+	ProviderType ModelProviderType `protobuf:"varint,4,opt,name=provider_type,json=providerType,proto3,enum=construct.v1.ModelProviderType" json:"provider_type,omitempty"`
+	// owner is the user that created the model provider. It is empty for model providers
+	// created over the Unix socket, which are visible to every user.
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// team is the team of the owner. Members of the team can read the model provider but not
+	// change it.
+	Team          string `protobuf:"bytes,6,opt,name=team,proto3" json:"team,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -303,6 +309,20 @@ func (x *ModelProviderMetadata) GetProviderType() ModelProviderType {
 	return ModelProviderType_MODEL_PROVIDER_TYPE_UNSPECIFIED
 }
 
+func (x *ModelProviderMetadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ModelProviderMetadata) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
 // ModelProviderSpec defines the user-configurable specification of a model provider.
 type ModelProviderSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -938,14 +958,16 @@ const file_construct_v1_modelprovider_proto_rawDesc = "" +
 	"\x0eauthenticationB\x06\n" +
 	"\x04_url\"i\n" +
 	"\x1bCreateModelProviderResponse\x12J\n" +
-	"\x0emodel_provider\x18\x01 \x01(\v2\x1b.construct.v1.ModelProviderB\x06\xbaH\x03\xc8\x01\x01R\rmodelProvider\"\x87\x02\n" +
+	"\x0emodel_provider\x18\x01 \x01(\v2\x1b.construct.v1.ModelProviderB\x06\xbaH\x03\xc8\x01\x01R\rmodelProvider\"\xb1\x02\n" +
 	"\x15ModelProviderMetadata\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\x12N\n" +
-	"\rprovider_type\x18\x04 \x01(\x0e2\x1f.construct.v1.ModelProviderTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\fproviderType\"U\n" +
+	"\rprovider_type\x18\x04 \x01(\x0e2\x1f.construct.v1.ModelProviderTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\fproviderType\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04team\x18\x06 \x01(\tR\x04team\"U\n" +
 	"\x11ModelProviderSpec\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
@@ -0,0 +1,1487 @@
+// Notification API provides operations for managing outbound notification sinks within Construct.
+// A notification sink is subscribed to the task events of the daemon and notifies the user
+// through a webhook, a desktop notification or an email when one of its rules matches.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/notification.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NotificationSinkKind decides how notifications are delivered.
+type NotificationSinkKind int32
+
+const (
+	// NOTIFICATION_SINK_KIND_UNSPECIFIED indicates an unset kind.
+	NotificationSinkKind_NOTIFICATION_SINK_KIND_UNSPECIFIED NotificationSinkKind = 0
+	// NOTIFICATION_SINK_KIND_WEBHOOK posts a JSON document to a URL.
+	NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK NotificationSinkKind = 1
+	// NOTIFICATION_SINK_KIND_DESKTOP shows a desktop notification through notify-send.
+	NotificationSinkKind_NOTIFICATION_SINK_KIND_DESKTOP NotificationSinkKind = 2
+	// NOTIFICATION_SINK_KIND_EMAIL sends an email through an SMTP server.
+	NotificationSinkKind_NOTIFICATION_SINK_KIND_EMAIL NotificationSinkKind = 3
+)
+
+// Enum value maps for NotificationSinkKind.
+var (
+	NotificationSinkKind_name = map[int32]string{
+		0: "NOTIFICATION_SINK_KIND_UNSPECIFIED",
+		1: "NOTIFICATION_SINK_KIND_WEBHOOK",
+		2: "NOTIFICATION_SINK_KIND_DESKTOP",
+		3: "NOTIFICATION_SINK_KIND_EMAIL",
+	}
+	NotificationSinkKind_value = map[string]int32{
+		"NOTIFICATION_SINK_KIND_UNSPECIFIED": 0,
+		"NOTIFICATION_SINK_KIND_WEBHOOK":     1,
+		"NOTIFICATION_SINK_KIND_DESKTOP":     2,
+		"NOTIFICATION_SINK_KIND_EMAIL":       3,
+	}
+)
+
+func (x NotificationSinkKind) Enum() *NotificationSinkKind {
+	p := new(NotificationSinkKind)
+	*p = x
+	return p
+}
+
+func (x NotificationSinkKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationSinkKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationSinkKind) Type() protoreflect.EnumType {
+	return &file_construct_v1_notification_proto_enumTypes[0]
+}
+
+func (x NotificationSinkKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationSinkKind.Descriptor instead.
+func (NotificationSinkKind) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+// NotificationEvent is a task event a sink can be notified about.
+type NotificationEvent int32
+
+const (
+	// NOTIFICATION_EVENT_UNSPECIFIED indicates an unknown event.
+	NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED NotificationEvent = 0
+	// NOTIFICATION_EVENT_TASK_COMPLETED fires when the agent gave its final response.
+	NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED NotificationEvent = 1
+	// NOTIFICATION_EVENT_TASK_SUSPENDED fires when a task is suspended.
+	NotificationEvent_NOTIFICATION_EVENT_TASK_SUSPENDED NotificationEvent = 2
+	// NOTIFICATION_EVENT_TASK_FAILED fires when the execution of a task failed.
+	NotificationEvent_NOTIFICATION_EVENT_TASK_FAILED NotificationEvent = 3
+	// NOTIFICATION_EVENT_INPUT_REQUESTED fires when the agent asks the user a question.
+	NotificationEvent_NOTIFICATION_EVENT_INPUT_REQUESTED NotificationEvent = 4
+	// NOTIFICATION_EVENT_BUDGET_EXCEEDED fires once when the cost of a task crosses the budget.
+	NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED NotificationEvent = 5
+)
+
+// Enum value maps for NotificationEvent.
+var (
+	NotificationEvent_name = map[int32]string{
+		0: "NOTIFICATION_EVENT_UNSPECIFIED",
+		1: "NOTIFICATION_EVENT_TASK_COMPLETED",
+		2: "NOTIFICATION_EVENT_TASK_SUSPENDED",
+		3: "NOTIFICATION_EVENT_TASK_FAILED",
+		4: "NOTIFICATION_EVENT_INPUT_REQUESTED",
+		5: "NOTIFICATION_EVENT_BUDGET_EXCEEDED",
+	}
+	NotificationEvent_value = map[string]int32{
+		"NOTIFICATION_EVENT_UNSPECIFIED":     0,
+		"NOTIFICATION_EVENT_TASK_COMPLETED":  1,
+		"NOTIFICATION_EVENT_TASK_SUSPENDED":  2,
+		"NOTIFICATION_EVENT_TASK_FAILED":     3,
+		"NOTIFICATION_EVENT_INPUT_REQUESTED": 4,
+		"NOTIFICATION_EVENT_BUDGET_EXCEEDED": 5,
+	}
+)
+
+func (x NotificationEvent) Enum() *NotificationEvent {
+	p := new(NotificationEvent)
+	*p = x
+	return p
+}
+
+func (x NotificationEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationEvent) Type() protoreflect.EnumType {
+	return &file_construct_v1_notification_proto_enumTypes[1]
+}
+
+func (x NotificationEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationEvent.Descriptor instead.
+func (NotificationEvent) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+// NotificationSink represents a complete notification sink entity with metadata and specification.
+type NotificationSink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metadata contains system-managed and immutable information about the sink.
+	Metadata *NotificationSinkMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// spec contains the user-configurable specification of the sink.
+	Spec          *NotificationSinkSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSink) Reset() {
+	*x = NotificationSink{}
+	mi := &file_construct_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSink) ProtoMessage() {}
+
+func (x *NotificationSink) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSink.ProtoReflect.Descriptor instead.
+func (*NotificationSink) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationSink) GetMetadata() *NotificationSinkMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *NotificationSink) GetSpec() *NotificationSinkSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+// NotificationSinkMetadata contains system-managed, immutable information about a notification sink.
+type NotificationSinkMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier for the sink (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is the timestamp when the sink was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the sink was last modified.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSinkMetadata) Reset() {
+	*x = NotificationSinkMetadata{}
+	mi := &file_construct_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSinkMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSinkMetadata) ProtoMessage() {}
+
+func (x *NotificationSinkMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSinkMetadata.ProtoReflect.Descriptor instead.
+func (*NotificationSinkMetadata) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationSinkMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationSinkMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NotificationSinkMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// NotificationSinkSpec defines the user-configurable specification of a notification sink.
+type NotificationSinkSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the sink.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description is a human-readable explanation of the sink's purpose.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// kind decides how notifications are delivered.
+	Kind NotificationSinkKind `protobuf:"varint,3,opt,name=kind,proto3,enum=construct.v1.NotificationSinkKind" json:"kind,omitempty"`
+	// events are the task events the sink is notified about.
+	Events []NotificationEvent `protobuf:"varint,4,rep,packed,name=events,proto3,enum=construct.v1.NotificationEvent" json:"events,omitempty"`
+	// agent_id restricts the sink to tasks of the agent (UUID format, all agents if unset).
+	AgentId *string `protobuf:"bytes,5,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// budget is the task cost in USD at which the budget_exceeded event fires.
+	Budget *float64 `protobuf:"fixed64,6,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	// url is the endpoint webhook sinks post to.
+	Url string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	// email configures the SMTP server and the recipients of email sinks.
+	Email *NotificationEmailConfig `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
+	// title_template is a Go text/template rendered into the title of every notification.
+	TitleTemplate string `protobuf:"bytes,9,opt,name=title_template,json=titleTemplate,proto3" json:"title_template,omitempty"`
+	// body_template is a Go text/template rendered into the body of every notification.
+	BodyTemplate string `protobuf:"bytes,10,opt,name=body_template,json=bodyTemplate,proto3" json:"body_template,omitempty"`
+	// enabled indicates whether the sink receives notifications.
+	Enabled       bool `protobuf:"varint,11,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSinkSpec) Reset() {
+	*x = NotificationSinkSpec{}
+	mi := &file_construct_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSinkSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSinkSpec) ProtoMessage() {}
+
+func (x *NotificationSinkSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSinkSpec.ProtoReflect.Descriptor instead.
+func (*NotificationSinkSpec) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotificationSinkSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NotificationSinkSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NotificationSinkSpec) GetKind() NotificationSinkKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationSinkKind_NOTIFICATION_SINK_KIND_UNSPECIFIED
+}
+
+func (x *NotificationSinkSpec) GetEvents() []NotificationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *NotificationSinkSpec) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *NotificationSinkSpec) GetBudget() float64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *NotificationSinkSpec) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NotificationSinkSpec) GetEmail() *NotificationEmailConfig {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *NotificationSinkSpec) GetTitleTemplate() string {
+	if x != nil {
+		return x.TitleTemplate
+	}
+	return ""
+}
+
+func (x *NotificationSinkSpec) GetBodyTemplate() string {
+	if x != nil {
+		return x.BodyTemplate
+	}
+	return ""
+}
+
+func (x *NotificationSinkSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// NotificationEmailConfig configures the delivery of email notifications.
+type NotificationEmailConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// smtp_address is the host:port of the SMTP server.
+	SmtpAddress string `protobuf:"bytes,1,opt,name=smtp_address,json=smtpAddress,proto3" json:"smtp_address,omitempty"`
+	// username is used to authenticate against the SMTP server. The password is the sink's secret.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// from is the sender address.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to are the recipient addresses.
+	To            []string `protobuf:"bytes,4,rep,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationEmailConfig) Reset() {
+	*x = NotificationEmailConfig{}
+	mi := &file_construct_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationEmailConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEmailConfig) ProtoMessage() {}
+
+func (x *NotificationEmailConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEmailConfig.ProtoReflect.Descriptor instead.
+func (*NotificationEmailConfig) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NotificationEmailConfig) GetSmtpAddress() string {
+	if x != nil {
+		return x.SmtpAddress
+	}
+	return ""
+}
+
+func (x *NotificationEmailConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *NotificationEmailConfig) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *NotificationEmailConfig) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// CreateNotificationSinkRequest contains the parameters needed to create a new notification sink.
+type CreateNotificationSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the sink.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description is a human-readable explanation of the sink's purpose.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// kind decides how notifications are delivered.
+	Kind NotificationSinkKind `protobuf:"varint,3,opt,name=kind,proto3,enum=construct.v1.NotificationSinkKind" json:"kind,omitempty"`
+	// events are the task events the sink is notified about (at least one).
+	Events []NotificationEvent `protobuf:"varint,4,rep,packed,name=events,proto3,enum=construct.v1.NotificationEvent" json:"events,omitempty"`
+	// agent_id restricts the sink to tasks of the agent (UUID format, optional).
+	AgentId *string `protobuf:"bytes,5,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// budget is the task cost in USD at which the budget_exceeded event fires.
+	Budget *float64 `protobuf:"fixed64,6,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	// url is the endpoint webhook sinks post to (required for webhook sinks).
+	Url string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	// email configures email sinks (required for email sinks).
+	Email *NotificationEmailConfig `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
+	// secret signs webhook notifications or authenticates against the SMTP server.
+	Secret string `protobuf:"bytes,9,opt,name=secret,proto3" json:"secret,omitempty"`
+	// title_template is the template of the notification title. A default is used if empty.
+	TitleTemplate string `protobuf:"bytes,10,opt,name=title_template,json=titleTemplate,proto3" json:"title_template,omitempty"`
+	// body_template is the template of the notification body. A default is used if empty.
+	BodyTemplate  string `protobuf:"bytes,11,opt,name=body_template,json=bodyTemplate,proto3" json:"body_template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationSinkRequest) Reset() {
+	*x = CreateNotificationSinkRequest{}
+	mi := &file_construct_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationSinkRequest) ProtoMessage() {}
+
+func (x *CreateNotificationSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationSinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotificationSinkRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateNotificationSinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNotificationSinkRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateNotificationSinkRequest) GetKind() NotificationSinkKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationSinkKind_NOTIFICATION_SINK_KIND_UNSPECIFIED
+}
+
+func (x *CreateNotificationSinkRequest) GetEvents() []NotificationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateNotificationSinkRequest) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *CreateNotificationSinkRequest) GetBudget() float64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *CreateNotificationSinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateNotificationSinkRequest) GetEmail() *NotificationEmailConfig {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *CreateNotificationSinkRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateNotificationSinkRequest) GetTitleTemplate() string {
+	if x != nil {
+		return x.TitleTemplate
+	}
+	return ""
+}
+
+func (x *CreateNotificationSinkRequest) GetBodyTemplate() string {
+	if x != nil {
+		return x.BodyTemplate
+	}
+	return ""
+}
+
+// CreateNotificationSinkResponse contains the newly created notification sink.
+type CreateNotificationSinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sink is the newly created notification sink.
+	Sink          *NotificationSink `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationSinkResponse) Reset() {
+	*x = CreateNotificationSinkResponse{}
+	mi := &file_construct_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationSinkResponse) ProtoMessage() {}
+
+func (x *CreateNotificationSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationSinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotificationSinkResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateNotificationSinkResponse) GetSink() *NotificationSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// GetNotificationSinkRequest specifies which notification sink to retrieve.
+type GetNotificationSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the sink to retrieve (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationSinkRequest) Reset() {
+	*x = GetNotificationSinkRequest{}
+	mi := &file_construct_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSinkRequest) ProtoMessage() {}
+
+func (x *GetNotificationSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSinkRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationSinkRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetNotificationSinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetNotificationSinkResponse contains the requested notification sink.
+type GetNotificationSinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sink is the requested notification sink.
+	Sink          *NotificationSink `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationSinkResponse) Reset() {
+	*x = GetNotificationSinkResponse{}
+	mi := &file_construct_v1_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSinkResponse) ProtoMessage() {}
+
+func (x *GetNotificationSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSinkResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationSinkResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetNotificationSinkResponse) GetSink() *NotificationSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// ListNotificationSinksRequest specifies parameters for listing notification sinks with optional filtering.
+type ListNotificationSinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter *ListNotificationSinksRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size limits the number of sinks returned per page (1-100).
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// page_token is used for pagination to retrieve subsequent pages.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationSinksRequest) Reset() {
+	*x = ListNotificationSinksRequest{}
+	mi := &file_construct_v1_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationSinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationSinksRequest) ProtoMessage() {}
+
+func (x *ListNotificationSinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationSinksRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationSinksRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListNotificationSinksRequest) GetFilter() *ListNotificationSinksRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListNotificationSinksRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationSinksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListNotificationSinksResponse contains the list of notification sinks matching the request criteria.
+type ListNotificationSinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sinks is the list of notification sinks matching the filter criteria.
+	Sinks []*NotificationSink `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	// next_page_token is used to retrieve the next page of results (empty if no more pages).
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationSinksResponse) Reset() {
+	*x = ListNotificationSinksResponse{}
+	mi := &file_construct_v1_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationSinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationSinksResponse) ProtoMessage() {}
+
+func (x *ListNotificationSinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationSinksResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationSinksResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListNotificationSinksResponse) GetSinks() []*NotificationSink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+func (x *ListNotificationSinksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateNotificationSinkRequest specifies which notification sink to update and the new values for its fields.
+type UpdateNotificationSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the sink to update (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// description is the new description (optional).
+	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// events replaces the events the sink is notified about if not empty.
+	Events []NotificationEvent `protobuf:"varint,3,rep,packed,name=events,proto3,enum=construct.v1.NotificationEvent" json:"events,omitempty"`
+	// agent_id is the new agent the sink is restricted to (UUID format, optional).
+	AgentId *string `protobuf:"bytes,4,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// budget is the new budget in USD (optional).
+	Budget *float64 `protobuf:"fixed64,5,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	// url is the new webhook URL (optional).
+	Url *string `protobuf:"bytes,6,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// secret replaces the secret of the sink (optional).
+	Secret *string `protobuf:"bytes,7,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	// title_template is the new title template (optional).
+	TitleTemplate *string `protobuf:"bytes,8,opt,name=title_template,json=titleTemplate,proto3,oneof" json:"title_template,omitempty"`
+	// body_template is the new body template (optional).
+	BodyTemplate *string `protobuf:"bytes,9,opt,name=body_template,json=bodyTemplate,proto3,oneof" json:"body_template,omitempty"`
+	// enabled disables (false) or enables (true) the sink (optional).
+	Enabled       *bool `protobuf:"varint,10,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationSinkRequest) Reset() {
+	*x = UpdateNotificationSinkRequest{}
+	mi := &file_construct_v1_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSinkRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationSinkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSinkRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateNotificationSinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateNotificationSinkRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateNotificationSinkRequest) GetEvents() []NotificationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UpdateNotificationSinkRequest) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *UpdateNotificationSinkRequest) GetBudget() float64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *UpdateNotificationSinkRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateNotificationSinkRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateNotificationSinkRequest) GetTitleTemplate() string {
+	if x != nil && x.TitleTemplate != nil {
+		return *x.TitleTemplate
+	}
+	return ""
+}
+
+func (x *UpdateNotificationSinkRequest) GetBodyTemplate() string {
+	if x != nil && x.BodyTemplate != nil {
+		return *x.BodyTemplate
+	}
+	return ""
+}
+
+func (x *UpdateNotificationSinkRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// UpdateNotificationSinkResponse contains the updated notification sink.
+type UpdateNotificationSinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sink is the updated notification sink.
+	Sink          *NotificationSink `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationSinkResponse) Reset() {
+	*x = UpdateNotificationSinkResponse{}
+	mi := &file_construct_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSinkResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationSinkResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSinkResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateNotificationSinkResponse) GetSink() *NotificationSink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// DeleteNotificationSinkRequest specifies which notification sink to delete.
+type DeleteNotificationSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the sink to delete (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationSinkRequest) Reset() {
+	*x = DeleteNotificationSinkRequest{}
+	mi := &file_construct_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationSinkRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationSinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationSinkRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteNotificationSinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteNotificationSinkResponse confirms the notification sink deletion (empty response).
+type DeleteNotificationSinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationSinkResponse) Reset() {
+	*x = DeleteNotificationSinkResponse{}
+	mi := &file_construct_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationSinkResponse) ProtoMessage() {}
+
+func (x *DeleteNotificationSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationSinkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationSinkResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+// TestNotificationSinkRequest specifies which notification sink to test.
+type TestNotificationSinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the sink to test (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestNotificationSinkRequest) Reset() {
+	*x = TestNotificationSinkRequest{}
+	mi := &file_construct_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestNotificationSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestNotificationSinkRequest) ProtoMessage() {}
+
+func (x *TestNotificationSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestNotificationSinkRequest.ProtoReflect.Descriptor instead.
+func (*TestNotificationSinkRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TestNotificationSinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TestNotificationSinkResponse confirms that the test notification was delivered (empty response).
+type TestNotificationSinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestNotificationSinkResponse) Reset() {
+	*x = TestNotificationSinkResponse{}
+	mi := &file_construct_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestNotificationSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestNotificationSinkResponse) ProtoMessage() {}
+
+func (x *TestNotificationSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestNotificationSinkResponse.ProtoReflect.Descriptor instead.
+func (*TestNotificationSinkResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
+// Filter specifies criteria for narrowing the list of returned notification sinks.
+type ListNotificationSinksRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// names filters sinks by name.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// enabled filters sinks by whether they receive notifications.
+	Enabled       *bool `protobuf:"varint,2,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationSinksRequest_Filter) Reset() {
+	*x = ListNotificationSinksRequest_Filter{}
+	mi := &file_construct_v1_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationSinksRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationSinksRequest_Filter) ProtoMessage() {}
+
+func (x *ListNotificationSinksRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationSinksRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListNotificationSinksRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_notification_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *ListNotificationSinksRequest_Filter) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ListNotificationSinksRequest_Filter) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+var File_construct_v1_notification_proto protoreflect.FileDescriptor
+
+const file_construct_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	"\x1fconstruct/v1/notification.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x01\n" +
+	"\x10NotificationSink\x12B\n" +
+	"\bmetadata\x18\x01 \x01(\v2&.construct.v1.NotificationSinkMetadataR\bmetadata\x126\n" +
+	"\x04spec\x18\x02 \x01(\v2\".construct.v1.NotificationSinkSpecR\x04spec\"\xba\x01\n" +
+	"\x18NotificationSinkMetadata\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\x93\x04\n" +
+	"\x14NotificationSinkSpec\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18\xff\x012\x10^[a-zA-Z0-9_-]+$R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12@\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\".construct.v1.NotificationSinkKindB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04kind\x127\n" +
+	"\x06events\x18\x04 \x03(\x0e2\x1f.construct.v1.NotificationEventR\x06events\x12(\n" +
+	"\bagent_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12+\n" +
+	"\x06budget\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\x06budget\x88\x01\x01\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12;\n" +
+	"\x05email\x18\b \x01(\v2%.construct.v1.NotificationEmailConfigR\x05email\x12%\n" +
+	"\x0etitle_template\x18\t \x01(\tR\rtitleTemplate\x12#\n" +
+	"\rbody_template\x18\n" +
+	" \x01(\tR\fbodyTemplate\x12\x18\n" +
+	"\aenabled\x18\v \x01(\bR\aenabledB\v\n" +
+	"\t_agent_idB\t\n" +
+	"\a_budget\"|\n" +
+	"\x17NotificationEmailConfig\x12!\n" +
+	"\fsmtp_address\x18\x01 \x01(\tR\vsmtpAddress\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x03(\tR\x02to\"\xa6\x04\n" +
+	"\x1dCreateNotificationSinkRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18\xff\x012\x10^[a-zA-Z0-9_-]+$R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12B\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\".construct.v1.NotificationSinkKindB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04kind\x12A\n" +
+	"\x06events\x18\x04 \x03(\x0e2\x1f.construct.v1.NotificationEventB\b\xbaH\x05\x92\x01\x02\b\x01R\x06events\x12(\n" +
+	"\bagent_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12+\n" +
+	"\x06budget\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\x06budget\x88\x01\x01\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12;\n" +
+	"\x05email\x18\b \x01(\v2%.construct.v1.NotificationEmailConfigR\x05email\x12\x16\n" +
+	"\x06secret\x18\t \x01(\tR\x06secret\x12%\n" +
+	"\x0etitle_template\x18\n" +
+	" \x01(\tR\rtitleTemplate\x12#\n" +
+	"\rbody_template\x18\v \x01(\tR\fbodyTemplateB\v\n" +
+	"\t_agent_idB\t\n" +
+	"\a_budget\"\\\n" +
+	"\x1eCreateNotificationSinkResponse\x12:\n" +
+	"\x04sink\x18\x01 \x01(\v2\x1e.construct.v1.NotificationSinkB\x06\xbaH\x03\xc8\x01\x01R\x04sink\"6\n" +
+	"\x1aGetNotificationSinkRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"Y\n" +
+	"\x1bGetNotificationSinkResponse\x12:\n" +
+	"\x04sink\x18\x01 \x01(\v2\x1e.construct.v1.NotificationSinkB\x06\xbaH\x03\xc8\x01\x01R\x04sink\"\x98\x02\n" +
+	"\x1cListNotificationSinksRequest\x12I\n" +
+	"\x06filter\x18\x01 \x01(\v21.construct.v1.ListNotificationSinksRequest.FilterR\x06filter\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tpageToken\x1aI\n" +
+	"\x06Filter\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bH\x00R\aenabled\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabledB\f\n" +
+	"\n" +
+	"_page_size\"}\n" +
+	"\x1dListNotificationSinksResponse\x124\n" +
+	"\x05sinks\x18\x01 \x03(\v2\x1e.construct.v1.NotificationSinkR\x05sinks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x04\n" +
+	"\x1dUpdateNotificationSinkRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12/\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01\x127\n" +
+	"\x06events\x18\x03 \x03(\x0e2\x1f.construct.v1.NotificationEventR\x06events\x12(\n" +
+	"\bagent_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\aagentId\x88\x01\x01\x12+\n" +
+	"\x06budget\x18\x05 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x02R\x06budget\x88\x01\x01\x12\x15\n" +
+	"\x03url\x18\x06 \x01(\tH\x03R\x03url\x88\x01\x01\x12\x1b\n" +
+	"\x06secret\x18\a \x01(\tH\x04R\x06secret\x88\x01\x01\x12*\n" +
+	"\x0etitle_template\x18\b \x01(\tH\x05R\rtitleTemplate\x88\x01\x01\x12(\n" +
+	"\rbody_template\x18\t \x01(\tH\x06R\fbodyTemplate\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bH\aR\aenabled\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_agent_idB\t\n" +
+	"\a_budgetB\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_secretB\x11\n" +
+	"\x0f_title_templateB\x10\n" +
+	"\x0e_body_templateB\n" +
+	"\n" +
+	"\b_enabled\"\\\n" +
+	"\x1eUpdateNotificationSinkResponse\x12:\n" +
+	"\x04sink\x18\x01 \x01(\v2\x1e.construct.v1.NotificationSinkB\x06\xbaH\x03\xc8\x01\x01R\x04sink\"9\n" +
+	"\x1dDeleteNotificationSinkRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\" \n" +
+	"\x1eDeleteNotificationSinkResponse\"7\n" +
+	"\x1bTestNotificationSinkRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x1e\n" +
+	"\x1cTestNotificationSinkResponse*\xa8\x01\n" +
+	"\x14NotificationSinkKind\x12&\n" +
+	"\"NOTIFICATION_SINK_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eNOTIFICATION_SINK_KIND_WEBHOOK\x10\x01\x12\"\n" +
+	"\x1eNOTIFICATION_SINK_KIND_DESKTOP\x10\x02\x12 \n" +
+	"\x1cNOTIFICATION_SINK_KIND_EMAIL\x10\x03*\xf9\x01\n" +
+	"\x11NotificationEvent\x12\"\n" +
+	"\x1eNOTIFICATION_EVENT_UNSPECIFIED\x10\x00\x12%\n" +
+	"!NOTIFICATION_EVENT_TASK_COMPLETED\x10\x01\x12%\n" +
+	"!NOTIFICATION_EVENT_TASK_SUSPENDED\x10\x02\x12\"\n" +
+	"\x1eNOTIFICATION_EVENT_TASK_FAILED\x10\x03\x12&\n" +
+	"\"NOTIFICATION_EVENT_INPUT_REQUESTED\x10\x04\x12&\n" +
+	"\"NOTIFICATION_EVENT_BUDGET_EXCEEDED\x10\x052\xd3\x05\n" +
+	"\x13NotificationService\x12u\n" +
+	"\x16CreateNotificationSink\x12+.construct.v1.CreateNotificationSinkRequest\x1a,.construct.v1.CreateNotificationSinkResponse\"\x00\x12o\n" +
+	"\x13GetNotificationSink\x12(.construct.v1.GetNotificationSinkRequest\x1a).construct.v1.GetNotificationSinkResponse\"\x03\x90\x02\x01\x12u\n" +
+	"\x15ListNotificationSinks\x12*.construct.v1.ListNotificationSinksRequest\x1a+.construct.v1.ListNotificationSinksResponse\"\x03\x90\x02\x01\x12u\n" +
+	"\x16UpdateNotificationSink\x12+.construct.v1.UpdateNotificationSinkRequest\x1a,.construct.v1.UpdateNotificationSinkResponse\"\x00\x12u\n" +
+	"\x16DeleteNotificationSink\x12+.construct.v1.DeleteNotificationSinkRequest\x1a,.construct.v1.DeleteNotificationSinkResponse\"\x00\x12o\n" +
+	"\x14TestNotificationSink\x12).construct.v1.TestNotificationSinkRequest\x1a*.construct.v1.TestNotificationSinkResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_notification_proto_rawDescOnce sync.Once
+	file_construct_v1_notification_proto_rawDescData []byte
+)
+
+func file_construct_v1_notification_proto_rawDescGZIP() []byte {
+	file_construct_v1_notification_proto_rawDescOnce.Do(func() {
+		file_construct_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_notification_proto_rawDesc), len(file_construct_v1_notification_proto_rawDesc)))
+	})
+	return file_construct_v1_notification_proto_rawDescData
+}
+
+var file_construct_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_construct_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_construct_v1_notification_proto_goTypes = []any{
+	(NotificationSinkKind)(0),                   // 0: construct.v1.NotificationSinkKind
+	(NotificationEvent)(0),                      // 1: construct.v1.NotificationEvent
+	(*NotificationSink)(nil),                    // 2: construct.v1.NotificationSink
+	(*NotificationSinkMetadata)(nil),            // 3: construct.v1.NotificationSinkMetadata
+	(*NotificationSinkSpec)(nil),                // 4: construct.v1.NotificationSinkSpec
+	(*NotificationEmailConfig)(nil),             // 5: construct.v1.NotificationEmailConfig
+	(*CreateNotificationSinkRequest)(nil),       // 6: construct.v1.CreateNotificationSinkRequest
+	(*CreateNotificationSinkResponse)(nil),      // 7: construct.v1.CreateNotificationSinkResponse
+	(*GetNotificationSinkRequest)(nil),          // 8: construct.v1.GetNotificationSinkRequest
+	(*GetNotificationSinkResponse)(nil),         // 9: construct.v1.GetNotificationSinkResponse
+	(*ListNotificationSinksRequest)(nil),        // 10: construct.v1.ListNotificationSinksRequest
+	(*ListNotificationSinksResponse)(nil),       // 11: construct.v1.ListNotificationSinksResponse
+	(*UpdateNotificationSinkRequest)(nil),       // 12: construct.v1.UpdateNotificationSinkRequest
+	(*UpdateNotificationSinkResponse)(nil),      // 13: construct.v1.UpdateNotificationSinkResponse
+	(*DeleteNotificationSinkRequest)(nil),       // 14: construct.v1.DeleteNotificationSinkRequest
+	(*DeleteNotificationSinkResponse)(nil),      // 15: construct.v1.DeleteNotificationSinkResponse
+	(*TestNotificationSinkRequest)(nil),         // 16: construct.v1.TestNotificationSinkRequest
+	(*TestNotificationSinkResponse)(nil),        // 17: construct.v1.TestNotificationSinkResponse
+	(*ListNotificationSinksRequest_Filter)(nil), // 18: construct.v1.ListNotificationSinksRequest.Filter
+	(*timestamppb.Timestamp)(nil),               // 19: google.protobuf.Timestamp
+}
+var file_construct_v1_notification_proto_depIdxs = []int32{
+	3,  // 0: construct.v1.NotificationSink.metadata:type_name -> construct.v1.NotificationSinkMetadata
+	4,  // 1: construct.v1.NotificationSink.spec:type_name -> construct.v1.NotificationSinkSpec
+	19, // 2: construct.v1.NotificationSinkMetadata.created_at:type_name -> google.protobuf.Timestamp
+	19, // 3: construct.v1.NotificationSinkMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: construct.v1.NotificationSinkSpec.kind:type_name -> construct.v1.NotificationSinkKind
+	1,  // 5: construct.v1.NotificationSinkSpec.events:type_name -> construct.v1.NotificationEvent
+	5,  // 6: construct.v1.NotificationSinkSpec.email:type_name -> construct.v1.NotificationEmailConfig
+	0,  // 7: construct.v1.CreateNotificationSinkRequest.kind:type_name -> construct.v1.NotificationSinkKind
+	1,  // 8: construct.v1.CreateNotificationSinkRequest.events:type_name -> construct.v1.NotificationEvent
+	5,  // 9: construct.v1.CreateNotificationSinkRequest.email:type_name -> construct.v1.NotificationEmailConfig
+	2,  // 10: construct.v1.CreateNotificationSinkResponse.sink:type_name -> construct.v1.NotificationSink
+	2,  // 11: construct.v1.GetNotificationSinkResponse.sink:type_name -> construct.v1.NotificationSink
+	18, // 12: construct.v1.ListNotificationSinksRequest.filter:type_name -> construct.v1.ListNotificationSinksRequest.Filter
+	2,  // 13: construct.v1.ListNotificationSinksResponse.sinks:type_name -> construct.v1.NotificationSink
+	1,  // 14: construct.v1.UpdateNotificationSinkRequest.events:type_name -> construct.v1.NotificationEvent
+	2,  // 15: construct.v1.UpdateNotificationSinkResponse.sink:type_name -> construct.v1.NotificationSink
+	6,  // 16: construct.v1.NotificationService.CreateNotificationSink:input_type -> construct.v1.CreateNotificationSinkRequest
+	8,  // 17: construct.v1.NotificationService.GetNotificationSink:input_type -> construct.v1.GetNotificationSinkRequest
+	10, // 18: construct.v1.NotificationService.ListNotificationSinks:input_type -> construct.v1.ListNotificationSinksRequest
+	12, // 19: construct.v1.NotificationService.UpdateNotificationSink:input_type -> construct.v1.UpdateNotificationSinkRequest
+	14, // 20: construct.v1.NotificationService.DeleteNotificationSink:input_type -> construct.v1.DeleteNotificationSinkRequest
+	16, // 21: construct.v1.NotificationService.TestNotificationSink:input_type -> construct.v1.TestNotificationSinkRequest
+	7,  // 22: construct.v1.NotificationService.CreateNotificationSink:output_type -> construct.v1.CreateNotificationSinkResponse
+	9,  // 23: construct.v1.NotificationService.GetNotificationSink:output_type -> construct.v1.GetNotificationSinkResponse
+	11, // 24: construct.v1.NotificationService.ListNotificationSinks:output_type -> construct.v1.ListNotificationSinksResponse
+	13, // 25: construct.v1.NotificationService.UpdateNotificationSink:output_type -> construct.v1.UpdateNotificationSinkResponse
+	15, // 26: construct.v1.NotificationService.DeleteNotificationSink:output_type -> construct.v1.DeleteNotificationSinkResponse
+	17, // 27: construct.v1.NotificationService.TestNotificationSink:output_type -> construct.v1.TestNotificationSinkResponse
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_notification_proto_init() }
+func file_construct_v1_notification_proto_init() {
+	if File_construct_v1_notification_proto != nil {
+		return
+	}
+	file_construct_v1_notification_proto_msgTypes[2].OneofWrappers = []any{}
+	file_construct_v1_notification_proto_msgTypes[4].OneofWrappers = []any{}
+	file_construct_v1_notification_proto_msgTypes[8].OneofWrappers = []any{}
+	file_construct_v1_notification_proto_msgTypes[10].OneofWrappers = []any{}
+	file_construct_v1_notification_proto_msgTypes[16].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_notification_proto_rawDesc), len(file_construct_v1_notification_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_notification_proto_goTypes,
+		DependencyIndexes: file_construct_v1_notification_proto_depIdxs,
+		EnumInfos:         file_construct_v1_notification_proto_enumTypes,
+		MessageInfos:      file_construct_v1_notification_proto_msgTypes,
+	}.Build()
+	File_construct_v1_notification_proto = out.File
+	file_construct_v1_notification_proto_goTypes = nil
+	file_construct_v1_notification_proto_depIdxs = nil
+}
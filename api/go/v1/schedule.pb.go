@@ -0,0 +1,1769 @@
+// Schedule API provides operations for managing recurring tasks within Construct.
+// A schedule creates a new task from a prompt template every time its cron expression fires
+// and keeps a history of the runs it has started.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/schedule.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScheduleOverlapPolicy decides what happens when a run is due while the previous one is still in progress.
+type ScheduleOverlapPolicy int32
+
+const (
+	// SCHEDULE_OVERLAP_POLICY_UNSPECIFIED indicates an unset policy. The server defaults to skip.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED ScheduleOverlapPolicy = 0
+	// SCHEDULE_OVERLAP_POLICY_ALLOW starts a new task alongside the running one.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW ScheduleOverlapPolicy = 1
+	// SCHEDULE_OVERLAP_POLICY_SKIP does not start a new task and records the run as skipped.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP ScheduleOverlapPolicy = 2
+	// SCHEDULE_OVERLAP_POLICY_REPLACE suspends the running task and starts a new one.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_REPLACE ScheduleOverlapPolicy = 3
+)
+
+// Enum value maps for ScheduleOverlapPolicy.
+var (
+	ScheduleOverlapPolicy_name = map[int32]string{
+		0: "SCHEDULE_OVERLAP_POLICY_UNSPECIFIED",
+		1: "SCHEDULE_OVERLAP_POLICY_ALLOW",
+		2: "SCHEDULE_OVERLAP_POLICY_SKIP",
+		3: "SCHEDULE_OVERLAP_POLICY_REPLACE",
+	}
+	ScheduleOverlapPolicy_value = map[string]int32{
+		"SCHEDULE_OVERLAP_POLICY_UNSPECIFIED": 0,
+		"SCHEDULE_OVERLAP_POLICY_ALLOW":       1,
+		"SCHEDULE_OVERLAP_POLICY_SKIP":        2,
+		"SCHEDULE_OVERLAP_POLICY_REPLACE":     3,
+	}
+)
+
+func (x ScheduleOverlapPolicy) Enum() *ScheduleOverlapPolicy {
+	p := new(ScheduleOverlapPolicy)
+	*p = x
+	return p
+}
+
+func (x ScheduleOverlapPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleOverlapPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduleOverlapPolicy) Type() protoreflect.EnumType {
+	return &file_construct_v1_schedule_proto_enumTypes[0]
+}
+
+func (x ScheduleOverlapPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleOverlapPolicy.Descriptor instead.
+func (ScheduleOverlapPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+// ScheduleCatchUpPolicy decides what happens with runs that were missed while the daemon was not running.
+type ScheduleCatchUpPolicy int32
+
+const (
+	// SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED indicates an unset policy. The server defaults to run once.
+	ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED ScheduleCatchUpPolicy = 0
+	// SCHEDULE_CATCH_UP_POLICY_SKIP drops all missed runs.
+	ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_SKIP ScheduleCatchUpPolicy = 1
+	// SCHEDULE_CATCH_UP_POLICY_RUN_ONCE starts a single run for all missed runs.
+	ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE ScheduleCatchUpPolicy = 2
+	// SCHEDULE_CATCH_UP_POLICY_RUN_ALL starts one run for every missed run.
+	ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ALL ScheduleCatchUpPolicy = 3
+)
+
+// Enum value maps for ScheduleCatchUpPolicy.
+var (
+	ScheduleCatchUpPolicy_name = map[int32]string{
+		0: "SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED",
+		1: "SCHEDULE_CATCH_UP_POLICY_SKIP",
+		2: "SCHEDULE_CATCH_UP_POLICY_RUN_ONCE",
+		3: "SCHEDULE_CATCH_UP_POLICY_RUN_ALL",
+	}
+	ScheduleCatchUpPolicy_value = map[string]int32{
+		"SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED": 0,
+		"SCHEDULE_CATCH_UP_POLICY_SKIP":        1,
+		"SCHEDULE_CATCH_UP_POLICY_RUN_ONCE":    2,
+		"SCHEDULE_CATCH_UP_POLICY_RUN_ALL":     3,
+	}
+)
+
+func (x ScheduleCatchUpPolicy) Enum() *ScheduleCatchUpPolicy {
+	p := new(ScheduleCatchUpPolicy)
+	*p = x
+	return p
+}
+
+func (x ScheduleCatchUpPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleCatchUpPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_schedule_proto_enumTypes[1].Descriptor()
+}
+
+func (ScheduleCatchUpPolicy) Type() protoreflect.EnumType {
+	return &file_construct_v1_schedule_proto_enumTypes[1]
+}
+
+func (x ScheduleCatchUpPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleCatchUpPolicy.Descriptor instead.
+func (ScheduleCatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+// ScheduleRunTrigger describes why a run was started.
+type ScheduleRunTrigger int32
+
+const (
+	// SCHEDULE_RUN_TRIGGER_UNSPECIFIED indicates an unknown trigger.
+	ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_UNSPECIFIED ScheduleRunTrigger = 0
+	// SCHEDULE_RUN_TRIGGER_SCHEDULED indicates the run was started by the cron expression.
+	ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_SCHEDULED ScheduleRunTrigger = 1
+	// SCHEDULE_RUN_TRIGGER_CATCH_UP indicates the run replaces a run missed while the daemon was down.
+	ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_CATCH_UP ScheduleRunTrigger = 2
+	// SCHEDULE_RUN_TRIGGER_MANUAL indicates the run was started by TriggerSchedule.
+	ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_MANUAL ScheduleRunTrigger = 3
+)
+
+// Enum value maps for ScheduleRunTrigger.
+var (
+	ScheduleRunTrigger_name = map[int32]string{
+		0: "SCHEDULE_RUN_TRIGGER_UNSPECIFIED",
+		1: "SCHEDULE_RUN_TRIGGER_SCHEDULED",
+		2: "SCHEDULE_RUN_TRIGGER_CATCH_UP",
+		3: "SCHEDULE_RUN_TRIGGER_MANUAL",
+	}
+	ScheduleRunTrigger_value = map[string]int32{
+		"SCHEDULE_RUN_TRIGGER_UNSPECIFIED": 0,
+		"SCHEDULE_RUN_TRIGGER_SCHEDULED":   1,
+		"SCHEDULE_RUN_TRIGGER_CATCH_UP":    2,
+		"SCHEDULE_RUN_TRIGGER_MANUAL":      3,
+	}
+)
+
+func (x ScheduleRunTrigger) Enum() *ScheduleRunTrigger {
+	p := new(ScheduleRunTrigger)
+	*p = x
+	return p
+}
+
+func (x ScheduleRunTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleRunTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_schedule_proto_enumTypes[2].Descriptor()
+}
+
+func (ScheduleRunTrigger) Type() protoreflect.EnumType {
+	return &file_construct_v1_schedule_proto_enumTypes[2]
+}
+
+func (x ScheduleRunTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleRunTrigger.Descriptor instead.
+func (ScheduleRunTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+// ScheduleRunStatus is the outcome of a run.
+type ScheduleRunStatus int32
+
+const (
+	// SCHEDULE_RUN_STATUS_UNSPECIFIED indicates an unknown status.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED ScheduleRunStatus = 0
+	// SCHEDULE_RUN_STATUS_STARTED indicates a task was started and has not finished yet.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED ScheduleRunStatus = 1
+	// SCHEDULE_RUN_STATUS_SKIPPED indicates no task was started because of the overlap policy.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED ScheduleRunStatus = 2
+	// SCHEDULE_RUN_STATUS_FAILED indicates the task could not be started or failed.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_FAILED ScheduleRunStatus = 3
+	// SCHEDULE_RUN_STATUS_COMPLETED indicates the agent of the task gave its final response.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_COMPLETED ScheduleRunStatus = 4
+)
+
+// Enum value maps for ScheduleRunStatus.
+var (
+	ScheduleRunStatus_name = map[int32]string{
+		0: "SCHEDULE_RUN_STATUS_UNSPECIFIED",
+		1: "SCHEDULE_RUN_STATUS_STARTED",
+		2: "SCHEDULE_RUN_STATUS_SKIPPED",
+		3: "SCHEDULE_RUN_STATUS_FAILED",
+		4: "SCHEDULE_RUN_STATUS_COMPLETED",
+	}
+	ScheduleRunStatus_value = map[string]int32{
+		"SCHEDULE_RUN_STATUS_UNSPECIFIED": 0,
+		"SCHEDULE_RUN_STATUS_STARTED":     1,
+		"SCHEDULE_RUN_STATUS_SKIPPED":     2,
+		"SCHEDULE_RUN_STATUS_FAILED":      3,
+		"SCHEDULE_RUN_STATUS_COMPLETED":   4,
+	}
+)
+
+func (x ScheduleRunStatus) Enum() *ScheduleRunStatus {
+	p := new(ScheduleRunStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduleRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_schedule_proto_enumTypes[3].Descriptor()
+}
+
+func (ScheduleRunStatus) Type() protoreflect.EnumType {
+	return &file_construct_v1_schedule_proto_enumTypes[3]
+}
+
+func (x ScheduleRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleRunStatus.Descriptor instead.
+func (ScheduleRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+// Schedule represents a complete schedule entity with metadata, specification, and status.
+type Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metadata contains system-managed and immutable information about the schedule.
+	Metadata *ScheduleMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// spec contains the user-configurable specification of the schedule.
+	Spec *ScheduleSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// status contains the observed state of the schedule, managed by the system.
+	Status        *ScheduleStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Schedule) GetMetadata() *ScheduleMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Schedule) GetSpec() *ScheduleSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Schedule) GetStatus() *ScheduleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// ScheduleMetadata contains system-managed, immutable information about a schedule.
+type ScheduleMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier for the schedule (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is the timestamp when the schedule was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the schedule was last modified.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMetadata) Reset() {
+	*x = ScheduleMetadata{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMetadata) ProtoMessage() {}
+
+func (x *ScheduleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMetadata.ProtoReflect.Descriptor instead.
+func (*ScheduleMetadata) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduleMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ScheduleSpec defines the user-configurable specification of a schedule.
+type ScheduleSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the schedule.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cron_expression describes when the schedule fires. Standard five field expressions,
+	// descriptors like @daily and a CRON_TZ= prefix are supported.
+	CronExpression string `protobuf:"bytes,2,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// agent_id references the agent that executes the tasks started by this schedule (UUID format).
+	AgentId string `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// workspace is the file system path where the tasks will be executed.
+	Workspace string `protobuf:"bytes,4,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	// prompt_template is a Go text/template rendered into the first user message of every run.
+	PromptTemplate string `protobuf:"bytes,5,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	// overlap_policy decides what happens when a run is due while the previous one is still in progress.
+	OverlapPolicy ScheduleOverlapPolicy `protobuf:"varint,6,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=construct.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	// catch_up_policy decides what happens with runs that were missed while the daemon was not running.
+	CatchUpPolicy ScheduleCatchUpPolicy `protobuf:"varint,7,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=construct.v1.ScheduleCatchUpPolicy" json:"catch_up_policy,omitempty"`
+	// enabled indicates whether the schedule fires. Paused schedules can still be triggered manually.
+	Enabled       bool `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleSpec) Reset() {
+	*x = ScheduleSpec{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSpec) ProtoMessage() {}
+
+func (x *ScheduleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSpec.ProtoReflect.Descriptor instead.
+func (*ScheduleSpec) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduleSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetOverlapPolicy() ScheduleOverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+}
+
+func (x *ScheduleSpec) GetCatchUpPolicy() ScheduleCatchUpPolicy {
+	if x != nil {
+		return x.CatchUpPolicy
+	}
+	return ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func (x *ScheduleSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// ScheduleStatus contains the observed state of the schedule.
+type ScheduleStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// last_run_time is the time of the most recent run (unset if the schedule has never run).
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// next_run_time is the time the schedule fires next (unset if the schedule is paused).
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleStatus) Reset() {
+	*x = ScheduleStatus{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleStatus) ProtoMessage() {}
+
+func (x *ScheduleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleStatus.ProtoReflect.Descriptor instead.
+func (*ScheduleStatus) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScheduleStatus) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *ScheduleStatus) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+// ScheduleRun is a single entry in the run history of a schedule.
+type ScheduleRun struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier for the run (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// schedule_id references the schedule this run belongs to (UUID format).
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// task_id references the task started by this run (unset if no task was started).
+	TaskId *string `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	// trigger describes why the run was started.
+	Trigger ScheduleRunTrigger `protobuf:"varint,4,opt,name=trigger,proto3,enum=construct.v1.ScheduleRunTrigger" json:"trigger,omitempty"`
+	// status is the outcome of the run.
+	Status ScheduleRunStatus `protobuf:"varint,5,opt,name=status,proto3,enum=construct.v1.ScheduleRunStatus" json:"status,omitempty"`
+	// scheduled_time is the time the run was due.
+	ScheduledTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	// created_at is the time the run was recorded.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// error describes why the run failed or was skipped.
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRun) Reset() {
+	*x = ScheduleRun{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRun) ProtoMessage() {}
+
+func (x *ScheduleRun) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRun.ProtoReflect.Descriptor instead.
+func (*ScheduleRun) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ScheduleRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetTrigger() ScheduleRunTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_UNSPECIFIED
+}
+
+func (x *ScheduleRun) GetStatus() ScheduleRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduleRun) GetScheduledTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CreateScheduleRequest contains the parameters needed to create a new schedule.
+type CreateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the schedule.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cron_expression describes when the schedule fires.
+	CronExpression string `protobuf:"bytes,2,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// agent_id references the agent that executes the tasks (UUID format).
+	AgentId string `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// workspace is the file system path where the tasks will be executed.
+	Workspace string `protobuf:"bytes,4,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	// prompt_template is a Go text/template rendered into the first user message of every run.
+	PromptTemplate string `protobuf:"bytes,5,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	// overlap_policy decides what happens when a run is due while the previous one is still in progress.
+	OverlapPolicy ScheduleOverlapPolicy `protobuf:"varint,6,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=construct.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	// catch_up_policy decides what happens with runs that were missed while the daemon was not running.
+	CatchUpPolicy ScheduleCatchUpPolicy `protobuf:"varint,7,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=construct.v1.ScheduleCatchUpPolicy" json:"catch_up_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetOverlapPolicy() ScheduleOverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+}
+
+func (x *CreateScheduleRequest) GetCatchUpPolicy() ScheduleCatchUpPolicy {
+	if x != nil {
+		return x.CatchUpPolicy
+	}
+	return ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+// CreateScheduleResponse contains the newly created schedule.
+type CreateScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule is the newly created schedule.
+	Schedule      *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleResponse) Reset() {
+	*x = CreateScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleResponse) ProtoMessage() {}
+
+func (x *CreateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// GetScheduleRequest specifies which schedule to retrieve.
+type GetScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the schedule to retrieve (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleRequest) ProtoMessage() {}
+
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetScheduleResponse contains the requested schedule.
+type GetScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule is the requested schedule.
+	Schedule      *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleResponse) Reset() {
+	*x = GetScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleResponse) ProtoMessage() {}
+
+func (x *GetScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// ListSchedulesRequest specifies parameters for listing schedules with optional filtering.
+type ListSchedulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter *ListSchedulesRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size limits the number of schedules returned per page (1-100).
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// page_token is used for pagination to retrieve subsequent pages.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSchedulesRequest) GetFilter() *ListSchedulesRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListSchedulesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListSchedulesResponse contains the list of schedules matching the request criteria.
+type ListSchedulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedules is the list of schedules matching the filter criteria.
+	Schedules []*Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	// next_page_token is used to retrieve the next page of results (empty if no more pages).
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ListSchedulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateScheduleRequest specifies which schedule to update and the new values for its fields.
+type UpdateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the schedule to update (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// cron_expression is the new cron expression (optional).
+	CronExpression *string `protobuf:"bytes,2,opt,name=cron_expression,json=cronExpression,proto3,oneof" json:"cron_expression,omitempty"`
+	// agent_id is the new agent executing the tasks (UUID format, optional).
+	AgentId *string `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// workspace is the new workspace of the tasks (optional).
+	Workspace *string `protobuf:"bytes,4,opt,name=workspace,proto3,oneof" json:"workspace,omitempty"`
+	// prompt_template is the new prompt template (optional).
+	PromptTemplate *string `protobuf:"bytes,5,opt,name=prompt_template,json=promptTemplate,proto3,oneof" json:"prompt_template,omitempty"`
+	// overlap_policy is the new overlap policy (optional).
+	OverlapPolicy *ScheduleOverlapPolicy `protobuf:"varint,6,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=construct.v1.ScheduleOverlapPolicy,oneof" json:"overlap_policy,omitempty"`
+	// catch_up_policy is the new catch-up policy (optional).
+	CatchUpPolicy *ScheduleCatchUpPolicy `protobuf:"varint,7,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=construct.v1.ScheduleCatchUpPolicy,oneof" json:"catch_up_policy,omitempty"`
+	// enabled pauses (false) or resumes (true) the schedule (optional).
+	Enabled       *bool `protobuf:"varint,8,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetCronExpression() string {
+	if x != nil && x.CronExpression != nil {
+		return *x.CronExpression
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetWorkspace() string {
+	if x != nil && x.Workspace != nil {
+		return *x.Workspace
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetPromptTemplate() string {
+	if x != nil && x.PromptTemplate != nil {
+		return *x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetOverlapPolicy() ScheduleOverlapPolicy {
+	if x != nil && x.OverlapPolicy != nil {
+		return *x.OverlapPolicy
+	}
+	return ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateScheduleRequest) GetCatchUpPolicy() ScheduleCatchUpPolicy {
+	if x != nil && x.CatchUpPolicy != nil {
+		return *x.CatchUpPolicy
+	}
+	return ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateScheduleRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// UpdateScheduleResponse contains the updated schedule.
+type UpdateScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule is the updated schedule.
+	Schedule      *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleResponse) Reset() {
+	*x = UpdateScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// DeleteScheduleRequest specifies which schedule to delete.
+type DeleteScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the schedule to delete (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteScheduleResponse confirms the schedule deletion (empty response).
+type DeleteScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{14}
+}
+
+// TriggerScheduleRequest specifies which schedule to run immediately.
+type TriggerScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the schedule to trigger (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerScheduleRequest) Reset() {
+	*x = TriggerScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerScheduleRequest) ProtoMessage() {}
+
+func (x *TriggerScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerScheduleRequest.ProtoReflect.Descriptor instead.
+func (*TriggerScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TriggerScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TriggerScheduleResponse contains the run started by the trigger.
+type TriggerScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// run is the recorded run. Depending on the overlap policy it may have been skipped.
+	Run           *ScheduleRun `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerScheduleResponse) Reset() {
+	*x = TriggerScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerScheduleResponse) ProtoMessage() {}
+
+func (x *TriggerScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerScheduleResponse.ProtoReflect.Descriptor instead.
+func (*TriggerScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TriggerScheduleResponse) GetRun() *ScheduleRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+// ListScheduleRunsRequest specifies which run history to retrieve.
+type ListScheduleRunsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule_id is the unique identifier of the schedule (UUID format).
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// page_size limits the number of runs returned (1-100).
+	PageSize      *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleRunsRequest) Reset() {
+	*x = ListScheduleRunsRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleRunsRequest) ProtoMessage() {}
+
+func (x *ListScheduleRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleRunsRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListScheduleRunsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ListScheduleRunsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// ListScheduleRunsResponse contains the run history of the schedule.
+type ListScheduleRunsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// runs is the list of runs, newest first.
+	Runs          []*ScheduleRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleRunsResponse) Reset() {
+	*x = ListScheduleRunsResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleRunsResponse) ProtoMessage() {}
+
+func (x *ListScheduleRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleRunsResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListScheduleRunsResponse) GetRuns() []*ScheduleRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// Filter specifies criteria for narrowing the list of returned schedules.
+type ListSchedulesRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// names filters schedules by name.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// agent_id filters schedules by the agent executing their tasks (UUID format, optional).
+	AgentId *string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// enabled filters schedules by whether they are enabled or paused.
+	Enabled       *bool `protobuf:"varint,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest_Filter) Reset() {
+	*x = ListSchedulesRequest_Filter{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest_Filter) ProtoMessage() {}
+
+func (x *ListSchedulesRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListSchedulesRequest_Filter) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ListSchedulesRequest_Filter) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest_Filter) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+var File_construct_v1_schedule_proto protoreflect.FileDescriptor
+
+const file_construct_v1_schedule_proto_rawDesc = "" +
+	"\n" +
+	"\x1bconstruct/v1/schedule.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x01\n" +
+	"\bSchedule\x12:\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1e.construct.v1.ScheduleMetadataR\bmetadata\x12.\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1a.construct.v1.ScheduleSpecR\x04spec\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.construct.v1.ScheduleStatusR\x06status\"\xb2\x01\n" +
+	"\x10ScheduleMetadata\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\x9c\x03\n" +
+	"\fScheduleSpec\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x120\n" +
+	"\x0fcron_expression\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0ecronExpression\x12#\n" +
+	"\bagent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aagentId\x12\x1c\n" +
+	"\tworkspace\x18\x04 \x01(\tR\tworkspace\x120\n" +
+	"\x0fprompt_template\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0epromptTemplate\x12T\n" +
+	"\x0eoverlap_policy\x18\x06 \x01(\x0e2#.construct.v1.ScheduleOverlapPolicyB\b\xbaH\x05\x82\x01\x02\x10\x01R\roverlapPolicy\x12U\n" +
+	"\x0fcatch_up_policy\x18\a \x01(\x0e2#.construct.v1.ScheduleCatchUpPolicyB\b\xbaH\x05\x82\x01\x02\x10\x01R\rcatchUpPolicy\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\"\x90\x01\n" +
+	"\x0eScheduleStatus\x12>\n" +
+	"\rlast_run_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vlastRunTime\x12>\n" +
+	"\rnext_run_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vnextRunTime\"\xb3\x03\n" +
+	"\vScheduleRun\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12)\n" +
+	"\vschedule_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"scheduleId\x12&\n" +
+	"\atask_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06taskId\x88\x01\x01\x12D\n" +
+	"\atrigger\x18\x04 \x01(\x0e2 .construct.v1.ScheduleRunTriggerB\b\xbaH\x05\x82\x01\x02\x10\x01R\atrigger\x12A\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1f.construct.v1.ScheduleRunStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12I\n" +
+	"\x0escheduled_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\rscheduledTime\x12A\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05errorB\n" +
+	"\n" +
+	"\b_task_id\"\x8b\x03\n" +
+	"\x15CreateScheduleRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x120\n" +
+	"\x0fcron_expression\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0ecronExpression\x12#\n" +
+	"\bagent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aagentId\x12\x1c\n" +
+	"\tworkspace\x18\x04 \x01(\tR\tworkspace\x120\n" +
+	"\x0fprompt_template\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0epromptTemplate\x12T\n" +
+	"\x0eoverlap_policy\x18\x06 \x01(\x0e2#.construct.v1.ScheduleOverlapPolicyB\b\xbaH\x05\x82\x01\x02\x10\x01R\roverlapPolicy\x12U\n" +
+	"\x0fcatch_up_policy\x18\a \x01(\x0e2#.construct.v1.ScheduleCatchUpPolicyB\b\xbaH\x05\x82\x01\x02\x10\x01R\rcatchUpPolicy\"T\n" +
+	"\x16CreateScheduleResponse\x12:\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.construct.v1.ScheduleB\x06\xbaH\x03\xc8\x01\x01R\bschedule\".\n" +
+	"\x12GetScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"Q\n" +
+	"\x13GetScheduleResponse\x12:\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.construct.v1.ScheduleB\x06\xbaH\x03\xc8\x01\x01R\bschedule\"\xc0\x02\n" +
+	"\x14ListSchedulesRequest\x12A\n" +
+	"\x06filter\x18\x01 \x01(\v2).construct.v1.ListSchedulesRequest.FilterR\x06filter\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tpageToken\x1a\x80\x01\n" +
+	"\x06Filter\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12(\n" +
+	"\bagent_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x03 \x01(\bH\x01R\aenabled\x88\x01\x01B\v\n" +
+	"\t_agent_idB\n" +
+	"\n" +
+	"\b_enabledB\f\n" +
+	"\n" +
+	"_page_size\"u\n" +
+	"\x15ListSchedulesResponse\x124\n" +
+	"\tschedules\x18\x01 \x03(\v2\x16.construct.v1.ScheduleR\tschedules\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb8\x04\n" +
+	"\x15UpdateScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x125\n" +
+	"\x0fcron_expression\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x0ecronExpression\x88\x01\x01\x12(\n" +
+	"\bagent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\aagentId\x88\x01\x01\x12!\n" +
+	"\tworkspace\x18\x04 \x01(\tH\x02R\tworkspace\x88\x01\x01\x125\n" +
+	"\x0fprompt_template\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x03R\x0epromptTemplate\x88\x01\x01\x12Y\n" +
+	"\x0eoverlap_policy\x18\x06 \x01(\x0e2#.construct.v1.ScheduleOverlapPolicyB\b\xbaH\x05\x82\x01\x02\x10\x01H\x04R\roverlapPolicy\x88\x01\x01\x12Z\n" +
+	"\x0fcatch_up_policy\x18\a \x01(\x0e2#.construct.v1.ScheduleCatchUpPolicyB\b\xbaH\x05\x82\x01\x02\x10\x01H\x05R\rcatchUpPolicy\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\b \x01(\bH\x06R\aenabled\x88\x01\x01B\x12\n" +
+	"\x10_cron_expressionB\v\n" +
+	"\t_agent_idB\f\n" +
+	"\n" +
+	"_workspaceB\x12\n" +
+	"\x10_prompt_templateB\x11\n" +
+	"\x0f_overlap_policyB\x12\n" +
+	"\x10_catch_up_policyB\n" +
+	"\n" +
+	"\b_enabled\"T\n" +
+	"\x16UpdateScheduleResponse\x12:\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.construct.v1.ScheduleB\x06\xbaH\x03\xc8\x01\x01R\bschedule\"1\n" +
+	"\x15DeleteScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x18\n" +
+	"\x16DeleteScheduleResponse\"2\n" +
+	"\x16TriggerScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"N\n" +
+	"\x17TriggerScheduleResponse\x123\n" +
+	"\x03run\x18\x01 \x01(\v2\x19.construct.v1.ScheduleRunB\x06\xbaH\x03\xc8\x01\x01R\x03run\"\x7f\n" +
+	"\x17ListScheduleRunsRequest\x12)\n" +
+	"\vschedule_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"scheduleId\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_page_size\"I\n" +
+	"\x18ListScheduleRunsResponse\x12-\n" +
+	"\x04runs\x18\x01 \x03(\v2\x19.construct.v1.ScheduleRunR\x04runs*\xaa\x01\n" +
+	"\x15ScheduleOverlapPolicy\x12'\n" +
+	"#SCHEDULE_OVERLAP_POLICY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSCHEDULE_OVERLAP_POLICY_ALLOW\x10\x01\x12 \n" +
+	"\x1cSCHEDULE_OVERLAP_POLICY_SKIP\x10\x02\x12#\n" +
+	"\x1fSCHEDULE_OVERLAP_POLICY_REPLACE\x10\x03*\xb1\x01\n" +
+	"\x15ScheduleCatchUpPolicy\x12(\n" +
+	"$SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSCHEDULE_CATCH_UP_POLICY_SKIP\x10\x01\x12%\n" +
+	"!SCHEDULE_CATCH_UP_POLICY_RUN_ONCE\x10\x02\x12$\n" +
+	" SCHEDULE_CATCH_UP_POLICY_RUN_ALL\x10\x03*\xa2\x01\n" +
+	"\x12ScheduleRunTrigger\x12$\n" +
+	" SCHEDULE_RUN_TRIGGER_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eSCHEDULE_RUN_TRIGGER_SCHEDULED\x10\x01\x12!\n" +
+	"\x1dSCHEDULE_RUN_TRIGGER_CATCH_UP\x10\x02\x12\x1f\n" +
+	"\x1bSCHEDULE_RUN_TRIGGER_MANUAL\x10\x03*\xbd\x01\n" +
+	"\x11ScheduleRunStatus\x12#\n" +
+	"\x1fSCHEDULE_RUN_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSCHEDULE_RUN_STATUS_STARTED\x10\x01\x12\x1f\n" +
+	"\x1bSCHEDULE_RUN_STATUS_SKIPPED\x10\x02\x12\x1e\n" +
+	"\x1aSCHEDULE_RUN_STATUS_FAILED\x10\x03\x12!\n" +
+	"\x1dSCHEDULE_RUN_STATUS_COMPLETED\x10\x042\xb0\x05\n" +
+	"\x0fScheduleService\x12]\n" +
+	"\x0eCreateSchedule\x12#.construct.v1.CreateScheduleRequest\x1a$.construct.v1.CreateScheduleResponse\"\x00\x12W\n" +
+	"\vGetSchedule\x12 .construct.v1.GetScheduleRequest\x1a!.construct.v1.GetScheduleResponse\"\x03\x90\x02\x01\x12]\n" +
+	"\rListSchedules\x12\".construct.v1.ListSchedulesRequest\x1a#.construct.v1.ListSchedulesResponse\"\x03\x90\x02\x01\x12]\n" +
+	"\x0eUpdateSchedule\x12#.construct.v1.UpdateScheduleRequest\x1a$.construct.v1.UpdateScheduleResponse\"\x00\x12]\n" +
+	"\x0eDeleteSchedule\x12#.construct.v1.DeleteScheduleRequest\x1a$.construct.v1.DeleteScheduleResponse\"\x00\x12`\n" +
+	"\x0fTriggerSchedule\x12$.construct.v1.TriggerScheduleRequest\x1a%.construct.v1.TriggerScheduleResponse\"\x00\x12f\n" +
+	"\x10ListScheduleRuns\x12%.construct.v1.ListScheduleRunsRequest\x1a&.construct.v1.ListScheduleRunsResponse\"\x03\x90\x02\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_schedule_proto_rawDescOnce sync.Once
+	file_construct_v1_schedule_proto_rawDescData []byte
+)
+
+func file_construct_v1_schedule_proto_rawDescGZIP() []byte {
+	file_construct_v1_schedule_proto_rawDescOnce.Do(func() {
+		file_construct_v1_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_schedule_proto_rawDesc), len(file_construct_v1_schedule_proto_rawDesc)))
+	})
+	return file_construct_v1_schedule_proto_rawDescData
+}
+
+var file_construct_v1_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_construct_v1_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_construct_v1_schedule_proto_goTypes = []any{
+	(ScheduleOverlapPolicy)(0),          // 0: construct.v1.ScheduleOverlapPolicy
+	(ScheduleCatchUpPolicy)(0),          // 1: construct.v1.ScheduleCatchUpPolicy
+	(ScheduleRunTrigger)(0),             // 2: construct.v1.ScheduleRunTrigger
+	(ScheduleRunStatus)(0),              // 3: construct.v1.ScheduleRunStatus
+	(*Schedule)(nil),                    // 4: construct.v1.Schedule
+	(*ScheduleMetadata)(nil),            // 5: construct.v1.ScheduleMetadata
+	(*ScheduleSpec)(nil),                // 6: construct.v1.ScheduleSpec
+	(*ScheduleStatus)(nil),              // 7: construct.v1.ScheduleStatus
+	(*ScheduleRun)(nil),                 // 8: construct.v1.ScheduleRun
+	(*CreateScheduleRequest)(nil),       // 9: construct.v1.CreateScheduleRequest
+	(*CreateScheduleResponse)(nil),      // 10: construct.v1.CreateScheduleResponse
+	(*GetScheduleRequest)(nil),          // 11: construct.v1.GetScheduleRequest
+	(*GetScheduleResponse)(nil),         // 12: construct.v1.GetScheduleResponse
+	(*ListSchedulesRequest)(nil),        // 13: construct.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),       // 14: construct.v1.ListSchedulesResponse
+	(*UpdateScheduleRequest)(nil),       // 15: construct.v1.UpdateScheduleRequest
+	(*UpdateScheduleResponse)(nil),      // 16: construct.v1.UpdateScheduleResponse
+	(*DeleteScheduleRequest)(nil),       // 17: construct.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),      // 18: construct.v1.DeleteScheduleResponse
+	(*TriggerScheduleRequest)(nil),      // 19: construct.v1.TriggerScheduleRequest
+	(*TriggerScheduleResponse)(nil),     // 20: construct.v1.TriggerScheduleResponse
+	(*ListScheduleRunsRequest)(nil),     // 21: construct.v1.ListScheduleRunsRequest
+	(*ListScheduleRunsResponse)(nil),    // 22: construct.v1.ListScheduleRunsResponse
+	(*ListSchedulesRequest_Filter)(nil), // 23: construct.v1.ListSchedulesRequest.Filter
+	(*timestamppb.Timestamp)(nil),       // 24: google.protobuf.Timestamp
+}
+var file_construct_v1_schedule_proto_depIdxs = []int32{
+	5,  // 0: construct.v1.Schedule.metadata:type_name -> construct.v1.ScheduleMetadata
+	6,  // 1: construct.v1.Schedule.spec:type_name -> construct.v1.ScheduleSpec
+	7,  // 2: construct.v1.Schedule.status:type_name -> construct.v1.ScheduleStatus
+	24, // 3: construct.v1.ScheduleMetadata.created_at:type_name -> google.protobuf.Timestamp
+	24, // 4: construct.v1.ScheduleMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: construct.v1.ScheduleSpec.overlap_policy:type_name -> construct.v1.ScheduleOverlapPolicy
+	1,  // 6: construct.v1.ScheduleSpec.catch_up_policy:type_name -> construct.v1.ScheduleCatchUpPolicy
+	24, // 7: construct.v1.ScheduleStatus.last_run_time:type_name -> google.protobuf.Timestamp
+	24, // 8: construct.v1.ScheduleStatus.next_run_time:type_name -> google.protobuf.Timestamp
+	2,  // 9: construct.v1.ScheduleRun.trigger:type_name -> construct.v1.ScheduleRunTrigger
+	3,  // 10: construct.v1.ScheduleRun.status:type_name -> construct.v1.ScheduleRunStatus
+	24, // 11: construct.v1.ScheduleRun.scheduled_time:type_name -> google.protobuf.Timestamp
+	24, // 12: construct.v1.ScheduleRun.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 13: construct.v1.CreateScheduleRequest.overlap_policy:type_name -> construct.v1.ScheduleOverlapPolicy
+	1,  // 14: construct.v1.CreateScheduleRequest.catch_up_policy:type_name -> construct.v1.ScheduleCatchUpPolicy
+	4,  // 15: construct.v1.CreateScheduleResponse.schedule:type_name -> construct.v1.Schedule
+	4,  // 16: construct.v1.GetScheduleResponse.schedule:type_name -> construct.v1.Schedule
+	23, // 17: construct.v1.ListSchedulesRequest.filter:type_name -> construct.v1.ListSchedulesRequest.Filter
+	4,  // 18: construct.v1.ListSchedulesResponse.schedules:type_name -> construct.v1.Schedule
+	0,  // 19: construct.v1.UpdateScheduleRequest.overlap_policy:type_name -> construct.v1.ScheduleOverlapPolicy
+	1,  // 20: construct.v1.UpdateScheduleRequest.catch_up_policy:type_name -> construct.v1.ScheduleCatchUpPolicy
+	4,  // 21: construct.v1.UpdateScheduleResponse.schedule:type_name -> construct.v1.Schedule
+	8,  // 22: construct.v1.TriggerScheduleResponse.run:type_name -> construct.v1.ScheduleRun
+	8,  // 23: construct.v1.ListScheduleRunsResponse.runs:type_name -> construct.v1.ScheduleRun
+	9,  // 24: construct.v1.ScheduleService.CreateSchedule:input_type -> construct.v1.CreateScheduleRequest
+	11, // 25: construct.v1.ScheduleService.GetSchedule:input_type -> construct.v1.GetScheduleRequest
+	13, // 26: construct.v1.ScheduleService.ListSchedules:input_type -> construct.v1.ListSchedulesRequest
+	15, // 27: construct.v1.ScheduleService.UpdateSchedule:input_type -> construct.v1.UpdateScheduleRequest
+	17, // 28: construct.v1.ScheduleService.DeleteSchedule:input_type -> construct.v1.DeleteScheduleRequest
+	19, // 29: construct.v1.ScheduleService.TriggerSchedule:input_type -> construct.v1.TriggerScheduleRequest
+	21, // 30: construct.v1.ScheduleService.ListScheduleRuns:input_type -> construct.v1.ListScheduleRunsRequest
+	10, // 31: construct.v1.ScheduleService.CreateSchedule:output_type -> construct.v1.CreateScheduleResponse
+	12, // 32: construct.v1.ScheduleService.GetSchedule:output_type -> construct.v1.GetScheduleResponse
+	14, // 33: construct.v1.ScheduleService.ListSchedules:output_type -> construct.v1.ListSchedulesResponse
+	16, // 34: construct.v1.ScheduleService.UpdateSchedule:output_type -> construct.v1.UpdateScheduleResponse
+	18, // 35: construct.v1.ScheduleService.DeleteSchedule:output_type -> construct.v1.DeleteScheduleResponse
+	20, // 36: construct.v1.ScheduleService.TriggerSchedule:output_type -> construct.v1.TriggerScheduleResponse
+	22, // 37: construct.v1.ScheduleService.ListScheduleRuns:output_type -> construct.v1.ListScheduleRunsResponse
+	31, // [31:38] is the sub-list for method output_type
+	24, // [24:31] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_schedule_proto_init() }
+func file_construct_v1_schedule_proto_init() {
+	if File_construct_v1_schedule_proto != nil {
+		return
+	}
+	file_construct_v1_schedule_proto_msgTypes[4].OneofWrappers = []any{}
+	file_construct_v1_schedule_proto_msgTypes[9].OneofWrappers = []any{}
+	file_construct_v1_schedule_proto_msgTypes[11].OneofWrappers = []any{}
+	file_construct_v1_schedule_proto_msgTypes[17].OneofWrappers = []any{}
+	file_construct_v1_schedule_proto_msgTypes[19].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_schedule_proto_rawDesc), len(file_construct_v1_schedule_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_schedule_proto_goTypes,
+		DependencyIndexes: file_construct_v1_schedule_proto_depIdxs,
+		EnumInfos:         file_construct_v1_schedule_proto_enumTypes,
+		MessageInfos:      file_construct_v1_schedule_proto_msgTypes,
+	}.Build()
+	File_construct_v1_schedule_proto = out.File
+	file_construct_v1_schedule_proto_goTypes = nil
+	file_construct_v1_schedule_proto_depIdxs = nil
+}
@@ -0,0 +1,502 @@
+// Search API provides full-text search across the history of all tasks.
+// The text of messages, the inputs of tool calls and the descriptions of tasks are indexed.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/search.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchMatchKind describes where the words of a query were found.
+type SearchMatchKind int32
+
+const (
+	// SEARCH_MATCH_KIND_UNSPECIFIED indicates an unset value.
+	SearchMatchKind_SEARCH_MATCH_KIND_UNSPECIFIED SearchMatchKind = 0
+	// SEARCH_MATCH_KIND_TITLE is a match in the description of a task.
+	SearchMatchKind_SEARCH_MATCH_KIND_TITLE SearchMatchKind = 1
+	// SEARCH_MATCH_KIND_MESSAGE is a match in the text of a message.
+	SearchMatchKind_SEARCH_MATCH_KIND_MESSAGE SearchMatchKind = 2
+	// SEARCH_MATCH_KIND_TOOL_CALL is a match in the input of a tool call.
+	SearchMatchKind_SEARCH_MATCH_KIND_TOOL_CALL SearchMatchKind = 3
+)
+
+// Enum value maps for SearchMatchKind.
+var (
+	SearchMatchKind_name = map[int32]string{
+		0: "SEARCH_MATCH_KIND_UNSPECIFIED",
+		1: "SEARCH_MATCH_KIND_TITLE",
+		2: "SEARCH_MATCH_KIND_MESSAGE",
+		3: "SEARCH_MATCH_KIND_TOOL_CALL",
+	}
+	SearchMatchKind_value = map[string]int32{
+		"SEARCH_MATCH_KIND_UNSPECIFIED": 0,
+		"SEARCH_MATCH_KIND_TITLE":       1,
+		"SEARCH_MATCH_KIND_MESSAGE":     2,
+		"SEARCH_MATCH_KIND_TOOL_CALL":   3,
+	}
+)
+
+func (x SearchMatchKind) Enum() *SearchMatchKind {
+	p := new(SearchMatchKind)
+	*p = x
+	return p
+}
+
+func (x SearchMatchKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchMatchKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchMatchKind) Type() protoreflect.EnumType {
+	return &file_construct_v1_search_proto_enumTypes[0]
+}
+
+func (x SearchMatchKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchMatchKind.Descriptor instead.
+func (SearchMatchKind) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+// SearchRequest contains the query and the number of results to return.
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is the text to search for. Every word of the query has to appear at the start
+	// of a word in a match, case-insensitively.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// page_size limits the number of results (1-100, default 20).
+	PageSize      *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_construct_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// SearchResponse contains the matches of a query, newest first.
+type SearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results is the list of matches.
+	Results       []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_construct_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SearchResult is a task or a message that matches a query.
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the task of the match (UUID format).
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// task_description is the description of the task.
+	TaskDescription string `protobuf:"bytes,2,opt,name=task_description,json=taskDescription,proto3" json:"task_description,omitempty"`
+	// message_id is the message of the match, unset for matches in the description (UUID format).
+	MessageId *string `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3,oneof" json:"message_id,omitempty"`
+	// role is the author of the message, unset for matches in the description.
+	Role *MessageRole `protobuf:"varint,4,opt,name=role,proto3,enum=construct.v1.MessageRole,oneof" json:"role,omitempty"`
+	// kind describes where the query was found.
+	Kind SearchMatchKind `protobuf:"varint,5,opt,name=kind,proto3,enum=construct.v1.SearchMatchKind" json:"kind,omitempty"`
+	// snippet is an excerpt of the text around the match.
+	Snippet *SearchSnippet `protobuf:"bytes,6,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// time is when the message was created or the task was last updated.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_construct_v1_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTaskDescription() string {
+	if x != nil {
+		return x.TaskDescription
+	}
+	return ""
+}
+
+func (x *SearchResult) GetMessageId() string {
+	if x != nil && x.MessageId != nil {
+		return *x.MessageId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetRole() MessageRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return MessageRole_MESSAGE_ROLE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetKind() SearchMatchKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SearchMatchKind_SEARCH_MATCH_KIND_UNSPECIFIED
+}
+
+func (x *SearchResult) GetSnippet() *SearchSnippet {
+	if x != nil {
+		return x.Snippet
+	}
+	return nil
+}
+
+func (x *SearchResult) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// SearchSnippet is an excerpt of a matching text together with the matched words.
+type SearchSnippet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// text is the excerpt, with an ellipsis where it was shortened.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// highlights are the byte ranges of text that match words of the query, in order.
+	Highlights    []*SearchHighlight `protobuf:"bytes,2,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSnippet) Reset() {
+	*x = SearchSnippet{}
+	mi := &file_construct_v1_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSnippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSnippet) ProtoMessage() {}
+
+func (x *SearchSnippet) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSnippet.ProtoReflect.Descriptor instead.
+func (*SearchSnippet) Descriptor() ([]byte, []int) {
+	return file_construct_v1_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchSnippet) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchSnippet) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// SearchHighlight is the byte range [start, end) of a matched word in a snippet.
+type SearchHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_construct_v1_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_construct_v1_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchHighlight) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SearchHighlight) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+var File_construct_v1_search_proto protoreflect.FileDescriptor
+
+const file_construct_v1_search_proto_rawDesc = "" +
+	"\n" +
+	"\x19construct/v1/search.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1aconstruct/v1/message.proto\"l\n" +
+	"\rSearchRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x05query\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_page_size\"F\n" +
+	"\x0eSearchResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.construct.v1.SearchResultR\aresults\"\xf0\x02\n" +
+	"\fSearchResult\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12)\n" +
+	"\x10task_description\x18\x02 \x01(\tR\x0ftaskDescription\x12,\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\tmessageId\x88\x01\x01\x122\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x19.construct.v1.MessageRoleH\x01R\x04role\x88\x01\x01\x121\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x1d.construct.v1.SearchMatchKindR\x04kind\x125\n" +
+	"\asnippet\x18\x06 \x01(\v2\x1b.construct.v1.SearchSnippetR\asnippet\x12.\n" +
+	"\x04time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04timeB\r\n" +
+	"\v_message_idB\a\n" +
+	"\x05_role\"b\n" +
+	"\rSearchSnippet\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12=\n" +
+	"\n" +
+	"highlights\x18\x02 \x03(\v2\x1d.construct.v1.SearchHighlightR\n" +
+	"highlights\"9\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end*\x91\x01\n" +
+	"\x0fSearchMatchKind\x12!\n" +
+	"\x1dSEARCH_MATCH_KIND_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SEARCH_MATCH_KIND_TITLE\x10\x01\x12\x1d\n" +
+	"\x19SEARCH_MATCH_KIND_MESSAGE\x10\x02\x12\x1f\n" +
+	"\x1bSEARCH_MATCH_KIND_TOOL_CALL\x10\x032Y\n" +
+	"\rSearchService\x12H\n" +
+	"\x06Search\x12\x1b.construct.v1.SearchRequest\x1a\x1c.construct.v1.SearchResponse\"\x03\x90\x02\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_search_proto_rawDescOnce sync.Once
+	file_construct_v1_search_proto_rawDescData []byte
+)
+
+func file_construct_v1_search_proto_rawDescGZIP() []byte {
+	file_construct_v1_search_proto_rawDescOnce.Do(func() {
+		file_construct_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_search_proto_rawDesc), len(file_construct_v1_search_proto_rawDesc)))
+	})
+	return file_construct_v1_search_proto_rawDescData
+}
+
+var file_construct_v1_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_construct_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_construct_v1_search_proto_goTypes = []any{
+	(SearchMatchKind)(0),          // 0: construct.v1.SearchMatchKind
+	(*SearchRequest)(nil),         // 1: construct.v1.SearchRequest
+	(*SearchResponse)(nil),        // 2: construct.v1.SearchResponse
+	(*SearchResult)(nil),          // 3: construct.v1.SearchResult
+	(*SearchSnippet)(nil),         // 4: construct.v1.SearchSnippet
+	(*SearchHighlight)(nil),       // 5: construct.v1.SearchHighlight
+	(MessageRole)(0),              // 6: construct.v1.MessageRole
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_construct_v1_search_proto_depIdxs = []int32{
+	3, // 0: construct.v1.SearchResponse.results:type_name -> construct.v1.SearchResult
+	6, // 1: construct.v1.SearchResult.role:type_name -> construct.v1.MessageRole
+	0, // 2: construct.v1.SearchResult.kind:type_name -> construct.v1.SearchMatchKind
+	4, // 3: construct.v1.SearchResult.snippet:type_name -> construct.v1.SearchSnippet
+	7, // 4: construct.v1.SearchResult.time:type_name -> google.protobuf.Timestamp
+	5, // 5: construct.v1.SearchSnippet.highlights:type_name -> construct.v1.SearchHighlight
+	1, // 6: construct.v1.SearchService.Search:input_type -> construct.v1.SearchRequest
+	2, // 7: construct.v1.SearchService.Search:output_type -> construct.v1.SearchResponse
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_search_proto_init() }
+func file_construct_v1_search_proto_init() {
+	if File_construct_v1_search_proto != nil {
+		return
+	}
+	file_construct_v1_message_proto_init()
+	file_construct_v1_search_proto_msgTypes[0].OneofWrappers = []any{}
+	file_construct_v1_search_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_search_proto_rawDesc), len(file_construct_v1_search_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_search_proto_goTypes,
+		DependencyIndexes: file_construct_v1_search_proto_depIdxs,
+		EnumInfos:         file_construct_v1_search_proto_enumTypes,
+		MessageInfos:      file_construct_v1_search_proto_msgTypes,
+	}.Build()
+	File_construct_v1_search_proto = out.File
+	file_construct_v1_search_proto_goTypes = nil
+	file_construct_v1_search_proto_depIdxs = nil
+}
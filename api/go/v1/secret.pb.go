@@ -0,0 +1,233 @@
+// Secret API provides administrative operations for the credentials stored by Construct.
+// The credentials of model providers, webhook triggers and notification sinks are encrypted
+// with a keyset that is kept by the secret provider of the daemon.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/secret.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RotateEncryptionKeyRequest specifies how the encryption key is rotated.
+type RotateEncryptionKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dry_run checks that every credential can be re-encrypted without changing the
+	// keyset or the database.
+	DryRun        bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyRequest) Reset() {
+	*x = RotateEncryptionKeyRequest{}
+	mi := &file_construct_v1_secret_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_secret_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_secret_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RotateEncryptionKeyRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// RotateEncryptionKeyResponse describes the outcome of a rotation.
+type RotateEncryptionKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// primary_key_id is the ID of the new primary key. In a dry run it is the ID the key
+	// would have had.
+	PrimaryKeyId uint32 `protobuf:"varint,1,opt,name=primary_key_id,json=primaryKeyId,proto3" json:"primary_key_id,omitempty"`
+	// disabled_key_ids are the IDs of the keys that were disabled.
+	DisabledKeyIds []uint32 `protobuf:"varint,2,rep,packed,name=disabled_key_ids,json=disabledKeyIds,proto3" json:"disabled_key_ids,omitempty"`
+	// model_providers is the number of re-encrypted model provider credentials.
+	ModelProviders int32 `protobuf:"varint,3,opt,name=model_providers,json=modelProviders,proto3" json:"model_providers,omitempty"`
+	// webhook_triggers is the number of re-encrypted webhook trigger secrets.
+	WebhookTriggers int32 `protobuf:"varint,4,opt,name=webhook_triggers,json=webhookTriggers,proto3" json:"webhook_triggers,omitempty"`
+	// notification_sinks is the number of re-encrypted notification sink secrets.
+	NotificationSinks int32 `protobuf:"varint,5,opt,name=notification_sinks,json=notificationSinks,proto3" json:"notification_sinks,omitempty"`
+	// dry_run is set if nothing was changed.
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyResponse) Reset() {
+	*x = RotateEncryptionKeyResponse{}
+	mi := &file_construct_v1_secret_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyResponse) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_secret_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_secret_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RotateEncryptionKeyResponse) GetPrimaryKeyId() uint32 {
+	if x != nil {
+		return x.PrimaryKeyId
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetDisabledKeyIds() []uint32 {
+	if x != nil {
+		return x.DisabledKeyIds
+	}
+	return nil
+}
+
+func (x *RotateEncryptionKeyResponse) GetModelProviders() int32 {
+	if x != nil {
+		return x.ModelProviders
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetWebhookTriggers() int32 {
+	if x != nil {
+		return x.WebhookTriggers
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetNotificationSinks() int32 {
+	if x != nil {
+		return x.NotificationSinks
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+var File_construct_v1_secret_proto protoreflect.FileDescriptor
+
+const file_construct_v1_secret_proto_rawDesc = "" +
+	"\n" +
+	"\x19construct/v1/secret.proto\x12\fconstruct.v1\"5\n" +
+	"\x1aRotateEncryptionKeyRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\x89\x02\n" +
+	"\x1bRotateEncryptionKeyResponse\x12$\n" +
+	"\x0eprimary_key_id\x18\x01 \x01(\rR\fprimaryKeyId\x12(\n" +
+	"\x10disabled_key_ids\x18\x02 \x03(\rR\x0edisabledKeyIds\x12'\n" +
+	"\x0fmodel_providers\x18\x03 \x01(\x05R\x0emodelProviders\x12)\n" +
+	"\x10webhook_triggers\x18\x04 \x01(\x05R\x0fwebhookTriggers\x12-\n" +
+	"\x12notification_sinks\x18\x05 \x01(\x05R\x11notificationSinks\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun2}\n" +
+	"\rSecretService\x12l\n" +
+	"\x13RotateEncryptionKey\x12(.construct.v1.RotateEncryptionKeyRequest\x1a).construct.v1.RotateEncryptionKeyResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_secret_proto_rawDescOnce sync.Once
+	file_construct_v1_secret_proto_rawDescData []byte
+)
+
+func file_construct_v1_secret_proto_rawDescGZIP() []byte {
+	file_construct_v1_secret_proto_rawDescOnce.Do(func() {
+		file_construct_v1_secret_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_secret_proto_rawDesc), len(file_construct_v1_secret_proto_rawDesc)))
+	})
+	return file_construct_v1_secret_proto_rawDescData
+}
+
+var file_construct_v1_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_construct_v1_secret_proto_goTypes = []any{
+	(*RotateEncryptionKeyRequest)(nil),  // 0: construct.v1.RotateEncryptionKeyRequest
+	(*RotateEncryptionKeyResponse)(nil), // 1: construct.v1.RotateEncryptionKeyResponse
+}
+var file_construct_v1_secret_proto_depIdxs = []int32{
+	0, // 0: construct.v1.SecretService.RotateEncryptionKey:input_type -> construct.v1.RotateEncryptionKeyRequest
+	1, // 1: construct.v1.SecretService.RotateEncryptionKey:output_type -> construct.v1.RotateEncryptionKeyResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_secret_proto_init() }
+func file_construct_v1_secret_proto_init() {
+	if File_construct_v1_secret_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_secret_proto_rawDesc), len(file_construct_v1_secret_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_secret_proto_goTypes,
+		DependencyIndexes: file_construct_v1_secret_proto_depIdxs,
+		MessageInfos:      file_construct_v1_secret_proto_msgTypes,
+	}.Build()
+	File_construct_v1_secret_proto = out.File
+	file_construct_v1_secret_proto_goTypes = nil
+	file_construct_v1_secret_proto_depIdxs = nil
+}
@@ -84,6 +84,122 @@ func (TaskPhase) EnumDescriptor() ([]byte, []int) {
 	return file_construct_v1_task_proto_rawDescGZIP(), []int{0}
 }
 
+// TranscriptFormat is the document format of a task transcript.
+type TranscriptFormat int32
+
+const (
+	// TRANSCRIPT_FORMAT_UNSPECIFIED indicates an unset value. The server renders Markdown.
+	TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED TranscriptFormat = 0
+	// TRANSCRIPT_FORMAT_MARKDOWN renders the transcript as Markdown.
+	TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN TranscriptFormat = 1
+	// TRANSCRIPT_FORMAT_HTML renders the transcript as a self-contained HTML page.
+	TranscriptFormat_TRANSCRIPT_FORMAT_HTML TranscriptFormat = 2
+	// TRANSCRIPT_FORMAT_JSON renders the transcript as JSON.
+	TranscriptFormat_TRANSCRIPT_FORMAT_JSON TranscriptFormat = 3
+)
+
+// Enum value maps for TranscriptFormat.
+var (
+	TranscriptFormat_name = map[int32]string{
+		0: "TRANSCRIPT_FORMAT_UNSPECIFIED",
+		1: "TRANSCRIPT_FORMAT_MARKDOWN",
+		2: "TRANSCRIPT_FORMAT_HTML",
+		3: "TRANSCRIPT_FORMAT_JSON",
+	}
+	TranscriptFormat_value = map[string]int32{
+		"TRANSCRIPT_FORMAT_UNSPECIFIED": 0,
+		"TRANSCRIPT_FORMAT_MARKDOWN":    1,
+		"TRANSCRIPT_FORMAT_HTML":        2,
+		"TRANSCRIPT_FORMAT_JSON":        3,
+	}
+)
+
+func (x TranscriptFormat) Enum() *TranscriptFormat {
+	p := new(TranscriptFormat)
+	*p = x
+	return p
+}
+
+func (x TranscriptFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TranscriptFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (TranscriptFormat) Type() protoreflect.EnumType {
+	return &file_construct_v1_task_proto_enumTypes[1]
+}
+
+func (x TranscriptFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TranscriptFormat.Descriptor instead.
+func (TranscriptFormat) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
+// FileChangeStatus is the state of the review of a file change.
+type FileChangeStatus int32
+
+const (
+	// FILE_CHANGE_STATUS_UNSPECIFIED indicates an unknown or unset status.
+	FileChangeStatus_FILE_CHANGE_STATUS_UNSPECIFIED FileChangeStatus = 0
+	// FILE_CHANGE_STATUS_PENDING indicates that the change waits for review and is not written to the
+	// workspace yet.
+	FileChangeStatus_FILE_CHANGE_STATUS_PENDING FileChangeStatus = 1
+	// FILE_CHANGE_STATUS_ACCEPTED indicates that the user accepted the change and it was written to the
+	// workspace.
+	FileChangeStatus_FILE_CHANGE_STATUS_ACCEPTED FileChangeStatus = 2
+	// FILE_CHANGE_STATUS_REJECTED indicates that the change was discarded.
+	FileChangeStatus_FILE_CHANGE_STATUS_REJECTED FileChangeStatus = 3
+)
+
+// Enum value maps for FileChangeStatus.
+var (
+	FileChangeStatus_name = map[int32]string{
+		0: "FILE_CHANGE_STATUS_UNSPECIFIED",
+		1: "FILE_CHANGE_STATUS_PENDING",
+		2: "FILE_CHANGE_STATUS_ACCEPTED",
+		3: "FILE_CHANGE_STATUS_REJECTED",
+	}
+	FileChangeStatus_value = map[string]int32{
+		"FILE_CHANGE_STATUS_UNSPECIFIED": 0,
+		"FILE_CHANGE_STATUS_PENDING":     1,
+		"FILE_CHANGE_STATUS_ACCEPTED":    2,
+		"FILE_CHANGE_STATUS_REJECTED":    3,
+	}
+)
+
+func (x FileChangeStatus) Enum() *FileChangeStatus {
+	p := new(FileChangeStatus)
+	*p = x
+	return p
+}
+
+func (x FileChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_task_proto_enumTypes[2].Descriptor()
+}
+
+func (FileChangeStatus) Type() protoreflect.EnumType {
+	return &file_construct_v1_task_proto_enumTypes[2]
+}
+
+func (x FileChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileChangeStatus.Descriptor instead.
+func (FileChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
 // Task represents a complete task entity with metadata, specification, and status.
 type Task struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -156,9 +272,19 @@ type TaskMetadata struct {
 	// created_at is the timestamp when the task was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// updated_at is the timestamp when the task was last modified.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// owner is the user that created the task. It is empty for tasks created over the
+	// Unix socket, which are visible to every user.
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// team is the team of the owner. Members of the team can read the task but not change it.
+	Team string `protobuf:"bytes,5,opt,name=team,proto3" json:"team,omitempty"`
+	// forked_from_task_id is the task this task was forked from. It is unset for tasks that were not
+	// forked, and once the original task is deleted.
+	ForkedFromTaskId *string `protobuf:"bytes,6,opt,name=forked_from_task_id,json=forkedFromTaskId,proto3,oneof" json:"forked_from_task_id,omitempty"`
+	// forked_from_message_id is the last message of the original task that was copied into this task.
+	ForkedFromMessageId *string `protobuf:"bytes,7,opt,name=forked_from_message_id,json=forkedFromMessageId,proto3,oneof" json:"forked_from_message_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *TaskMetadata) Reset() {
@@ -212,6 +338,34 @@ func (x *TaskMetadata) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TaskMetadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *TaskMetadata) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *TaskMetadata) GetForkedFromTaskId() string {
+	if x != nil && x.ForkedFromTaskId != nil {
+		return *x.ForkedFromTaskId
+	}
+	return ""
+}
+
+func (x *TaskMetadata) GetForkedFromMessageId() string {
+	if x != nil && x.ForkedFromMessageId != nil {
+		return *x.ForkedFromMessageId
+	}
+	return ""
+}
+
 // TaskSpec defines the user-configurable specification of a task.
 type TaskSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -222,7 +376,12 @@ type TaskSpec struct {
 	// phase is the desired operational state of the task.
 	DesiredPhase TaskPhase `protobuf:"varint,3,opt,name=desired_phase,json=desiredPhase,proto3,enum=construct.v1.TaskPhase" json:"desired_phase,omitempty"`
 	// description is a brief description of the task.
-	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// review_edits stages the files that the agent creates or edits for the review of the user.
+	ReviewEdits bool `protobuf:"varint,5,opt,name=review_edits,json=reviewEdits,proto3" json:"review_edits,omitempty"`
+	// labels are free-form key/value pairs to organize tasks, e.g. env=ci. Keys have 1-63
+	// characters and values 1-255 characters, both from letters, digits, '.', '_', '-' and '/'.
+	Labels        map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -285,6 +444,20 @@ func (x *TaskSpec) GetDescription() string {
 	return ""
 }
 
+func (x *TaskSpec) GetReviewEdits() bool {
+	if x != nil {
+		return x.ReviewEdits
+	}
+	return false
+}
+
+func (x *TaskSpec) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 // TaskStatus contains the observed state and usage information of the task.
 type TaskStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -457,7 +630,11 @@ type CreateTaskRequest struct {
 	// project_directory is the file system path where the task will be executed.
 	ProjectDirectory string `protobuf:"bytes,2,opt,name=project_directory,json=projectDirectory,proto3" json:"project_directory,omitempty"`
 	// description is a brief description of the task.
-	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// review_edits stages the files that the agent creates or edits for the review of the user.
+	ReviewEdits bool `protobuf:"varint,4,opt,name=review_edits,json=reviewEdits,proto3" json:"review_edits,omitempty"`
+	// labels are free-form key/value pairs to organize the task, e.g. env=ci (up to 32).
+	Labels        map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -513,6 +690,20 @@ func (x *CreateTaskRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetReviewEdits() bool {
+	if x != nil {
+		return x.ReviewEdits
+	}
+	return false
+}
+
+func (x *CreateTaskRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 // CreateTaskResponse contains the newly created task.
 type CreateTaskResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -794,7 +985,12 @@ type UpdateTaskRequest struct {
 	// id is the unique identifier of the task to update (UUID format).
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// agent_id is the new agent assignment for the task (UUID format, optional).
-	AgentId       *string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	AgentId *string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// review_edits turns the review of the files that the agent changes on or off. Changes that
+	// are already staged stay pending when it is turned off.
+	ReviewEdits *bool `protobuf:"varint,3,opt,name=review_edits,json=reviewEdits,proto3,oneof" json:"review_edits,omitempty"`
+	// labels are merged into the labels of the task. A label with an empty value is removed.
+	Labels        map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -843,6 +1039,20 @@ func (x *UpdateTaskRequest) GetAgentId() string {
 	return ""
 }
 
+func (x *UpdateTaskRequest) GetReviewEdits() bool {
+	if x != nil && x.ReviewEdits != nil {
+		return *x.ReviewEdits
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 // UpdateTaskResponse contains the updated task.
 type UpdateTaskResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1233,36 +1443,86 @@ func (*SuspendTaskResponse) Descriptor() ([]byte, []int) {
 	return file_construct_v1_task_proto_rawDescGZIP(), []int{19}
 }
 
-// Filter specifies criteria for narrowing the list of returned tasks.
-type ListTasksRequest_Filter struct {
+// ExportTranscriptRequest specifies which task to export and in which format.
+type ExportTranscriptRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// agent_id filters tasks by the agent assigned to execute them (UUID format, optional).
-	AgentId *string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
-	// prefix filters tasks by the prefix of the task id.
-	TaskIdPrefix *string `protobuf:"bytes,2,opt,name=task_id_prefix,json=taskIdPrefix,proto3,oneof" json:"task_id_prefix,omitempty"`
-	// has_messages filters tasks by whether they have any messages.
-	// - if set to true: only tasks with at least one message
-	// - if set to false: only tasks with zero messages
-	// - if unset: no filtering by message presence
-	HasMessages   *bool `protobuf:"varint,3,opt,name=has_messages,json=hasMessages,proto3,oneof" json:"has_messages,omitempty"`
+	// id is the unique identifier of the task to export (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// format is the document format of the transcript.
+	Format        TranscriptFormat `protobuf:"varint,2,opt,name=format,proto3,enum=construct.v1.TranscriptFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest_Filter) Reset() {
-	*x = ListTasksRequest_Filter{}
+func (x *ExportTranscriptRequest) Reset() {
+	*x = ExportTranscriptRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTranscriptRequest) ProtoMessage() {}
+
+func (x *ExportTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*ExportTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExportTranscriptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportTranscriptRequest) GetFormat() TranscriptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED
+}
+
+// ExportTranscriptResponse contains the rendered transcript.
+type ExportTranscriptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// content is the rendered transcript.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// content_type is the media type of the content, e.g. "text/markdown".
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTranscriptResponse) Reset() {
+	*x = ExportTranscriptResponse{}
 	mi := &file_construct_v1_task_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest_Filter) String() string {
+func (x *ExportTranscriptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest_Filter) ProtoMessage() {}
+func (*ExportTranscriptResponse) ProtoMessage() {}
 
-func (x *ListTasksRequest_Filter) ProtoReflect() protoreflect.Message {
+func (x *ExportTranscriptResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_task_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1274,114 +1534,1280 @@ func (x *ListTasksRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest_Filter.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_construct_v1_task_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ExportTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*ExportTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListTasksRequest_Filter) GetAgentId() string {
-	if x != nil && x.AgentId != nil {
-		return *x.AgentId
+func (x *ExportTranscriptResponse) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-func (x *ListTasksRequest_Filter) GetTaskIdPrefix() string {
-	if x != nil && x.TaskIdPrefix != nil {
-		return *x.TaskIdPrefix
+func (x *ExportTranscriptResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *ListTasksRequest_Filter) GetHasMessages() bool {
-	if x != nil && x.HasMessages != nil {
-		return *x.HasMessages
+// CompactTaskRequest specifies which task to compact.
+type CompactTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the task to compact (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompactTaskRequest) Reset() {
+	*x = CompactTaskRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactTaskRequest) ProtoMessage() {}
+
+func (x *CompactTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-var File_construct_v1_task_proto protoreflect.FileDescriptor
+// Deprecated: Use CompactTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompactTaskRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{22}
+}
 
-const file_construct_v1_task_proto_rawDesc = "" +
-	"\n" +
-	"\x17construct/v1/task.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x1aconstruct/v1/message.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x01\n" +
-	"\x04Task\x126\n" +
-	"\bmetadata\x18\x01 \x01(\v2\x1a.construct.v1.TaskMetadataR\bmetadata\x12*\n" +
-	"\x04spec\x18\x02 \x01(\v2\x16.construct.v1.TaskSpecR\x04spec\x120\n" +
-	"\x06status\x18\x03 \x01(\v2\x18.construct.v1.TaskStatusR\x06status\"\xae\x01\n" +
-	"\fTaskMetadata\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
-	"\n" +
-	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
-	"\n" +
-	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xdb\x01\n" +
-	"\bTaskSpec\x12(\n" +
-	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12$\n" +
-	"\tworkspace\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tworkspace\x12F\n" +
-	"\rdesired_phase\x18\x03 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\fdesiredPhase\x12*\n" +
-	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescriptionB\v\n" +
-	"\t_agent_id\"\xad\x01\n" +
-	"\n" +
-	"TaskStatus\x12-\n" +
-	"\x05usage\x18\x01 \x01(\v2\x17.construct.v1.TaskUsageR\x05usage\x127\n" +
-	"\x05phase\x18\x02 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05phase\x12\x12\n" +
-	"\x04turn\x18\x03 \x01(\x03R\x04turn\x12#\n" +
-	"\rmessage_count\x18\x04 \x01(\x03R\fmessageCount\"\xc2\x02\n" +
-	"\tTaskUsage\x12!\n" +
-	"\finput_tokens\x18\x01 \x01(\x03R\vinputTokens\x12#\n" +
-	"\routput_tokens\x18\x02 \x01(\x03R\foutputTokens\x12,\n" +
-	"\x12cache_write_tokens\x18\x03 \x01(\x03R\x10cacheWriteTokens\x12*\n" +
-	"\x11cache_read_tokens\x18\x04 \x01(\x03R\x0fcacheReadTokens\x12\x12\n" +
-	"\x04cost\x18\x05 \x01(\x01R\x04cost\x12B\n" +
-	"\ttool_uses\x18\x06 \x03(\v2%.construct.v1.TaskUsage.ToolUsesEntryR\btoolUses\x1a;\n" +
-	"\rToolUsesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x99\x01\n" +
-	"\x11CreateTaskRequest\x12#\n" +
-	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aagentId\x123\n" +
-	"\x11project_directory\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x10projectDirectory\x12*\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\"D\n" +
-	"\x12CreateTaskResponse\x12.\n" +
-	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"*\n" +
-	"\x0eGetTaskRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"A\n" +
-	"\x0fGetTaskResponse\x12.\n" +
-	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"\x9a\x04\n" +
-	"\x10ListTasksRequest\x12=\n" +
-	"\x06filter\x18\x01 \x01(\v2%.construct.v1.ListTasksRequest.FilterR\x06filter\x12+\n" +
-	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01\x12'\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tpageToken\x12E\n" +
-	"\n" +
-	"sort_field\x18\x04 \x01(\x0e2\x17.construct.v1.SortFieldB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\tsortField\x88\x01\x01\x12E\n" +
-	"\n" +
-	"sort_order\x18\x05 \x01(\x0e2\x17.construct.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\tsortOrder\x88\x01\x01\x1a\xb6\x01\n" +
-	"\x06Filter\x12(\n" +
-	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12)\n" +
-	"\x0etask_id_prefix\x18\x02 \x01(\tH\x01R\ftaskIdPrefix\x88\x01\x01\x12&\n" +
-	"\fhas_messages\x18\x03 \x01(\bH\x02R\vhasMessages\x88\x01\x01B\v\n" +
-	"\t_agent_idB\x11\n" +
-	"\x0f_task_id_prefixB\x0f\n" +
-	"\r_has_messagesB\f\n" +
-	"\n" +
-	"_page_sizeB\r\n" +
-	"\v_sort_fieldB\r\n" +
-	"\v_sort_order\"e\n" +
-	"\x11ListTasksResponse\x12(\n" +
-	"\x05tasks\x18\x01 \x03(\v2\x12.construct.v1.TaskR\x05tasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
-	"\x11UpdateTaskRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12(\n" +
-	"\bagent_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01B\v\n" +
-	"\t_agent_id\"D\n" +
-	"\x12UpdateTaskResponse\x12.\n" +
-	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"-\n" +
-	"\x11DeleteTaskRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
-	"\x12DeleteTaskResponse\"5\n" +
-	"\x10SubscribeRequest\x12!\n" +
-	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"p\n" +
-	"\tTaskEvent\x12!\n" +
+func (x *CompactTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CompactTaskResponse contains the summary that replaced the conversation.
+type CompactTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// summary is the summary of the conversation that the task continues from.
+	Summary string `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	// compacted_messages is the number of messages that were replaced by the summary.
+	CompactedMessages int32 `protobuf:"varint,2,opt,name=compacted_messages,json=compactedMessages,proto3" json:"compacted_messages,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CompactTaskResponse) Reset() {
+	*x = CompactTaskResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactTaskResponse) ProtoMessage() {}
+
+func (x *CompactTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompactTaskResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CompactTaskResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CompactTaskResponse) GetCompactedMessages() int32 {
+	if x != nil {
+		return x.CompactedMessages
+	}
+	return 0
+}
+
+// ListWorkspaceFilesRequest specifies the task and the query to match file paths against.
+type ListWorkspaceFilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the unique identifier of the task whose workspace is listed (UUID format).
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// query is matched against the paths of the files. An empty query lists all files.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// limit is the maximum number of paths returned (1-200). Defaults to 50.
+	Limit         *int32 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceFilesRequest) Reset() {
+	*x = ListWorkspaceFilesRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceFilesRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceFilesRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListWorkspaceFilesRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListWorkspaceFilesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListWorkspaceFilesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// ListWorkspaceFilesResponse contains the matching files, best match first.
+type ListWorkspaceFilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// paths are relative to the workspace of the task and use forward slashes.
+	Paths         []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceFilesResponse) Reset() {
+	*x = ListWorkspaceFilesResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceFilesResponse) ProtoMessage() {}
+
+func (x *ListWorkspaceFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceFilesResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListWorkspaceFilesResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// SearchWorkspaceSymbolsRequest specifies the task and the query to match symbol names against.
+type SearchWorkspaceSymbolsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the unique identifier of the task whose workspace is searched (UUID format).
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// query is matched against the names of the symbols.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// limit is the maximum number of symbols returned (1-200). Defaults to 50.
+	Limit         *int32 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWorkspaceSymbolsRequest) Reset() {
+	*x = SearchWorkspaceSymbolsRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWorkspaceSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorkspaceSymbolsRequest) ProtoMessage() {}
+
+func (x *SearchWorkspaceSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWorkspaceSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*SearchWorkspaceSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchWorkspaceSymbolsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SearchWorkspaceSymbolsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchWorkspaceSymbolsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// SearchWorkspaceSymbolsResponse contains the matching symbols, best match first.
+type SearchWorkspaceSymbolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []*WorkspaceSymbol     `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWorkspaceSymbolsResponse) Reset() {
+	*x = SearchWorkspaceSymbolsResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWorkspaceSymbolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorkspaceSymbolsResponse) ProtoMessage() {}
+
+func (x *SearchWorkspaceSymbolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWorkspaceSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*SearchWorkspaceSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchWorkspaceSymbolsResponse) GetSymbols() []*WorkspaceSymbol {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+// WorkspaceSymbol is the definition of a function, type or class in the workspace of a task.
+type WorkspaceSymbol struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the symbol.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kind is the kind of the definition, such as function, type or class.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// path is the file of the definition, relative to the workspace of the task.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// line is the line of the definition, starting at 1.
+	Line uint32 `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
+	// end_line is the estimated last line of the definition.
+	EndLine       uint32 `protobuf:"varint,5,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSymbol) Reset() {
+	*x = WorkspaceSymbol{}
+	mi := &file_construct_v1_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSymbol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSymbol) ProtoMessage() {}
+
+func (x *WorkspaceSymbol) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSymbol.ProtoReflect.Descriptor instead.
+func (*WorkspaceSymbol) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WorkspaceSymbol) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceSymbol) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WorkspaceSymbol) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WorkspaceSymbol) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *WorkspaceSymbol) GetEndLine() uint32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+// FileChange is a change of the agent to a file that is staged for the review of the user. All
+// edits of a task to a file are combined into one pending change.
+type FileChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the change (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// task_id is the task whose agent made the change (UUID format).
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// path is the absolute path of the changed file.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// status is the state of the review of the change.
+	Status FileChangeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=construct.v1.FileChangeStatus" json:"status,omitempty"`
+	// patch is the unified diff from the content before the first edit to the content after the last edit.
+	Patch string `protobuf:"bytes,5,opt,name=patch,proto3" json:"patch,omitempty"`
+	// lines_added is the number of lines the change adds.
+	LinesAdded int32 `protobuf:"varint,6,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	// lines_removed is the number of lines the change removes.
+	LinesRemoved int32 `protobuf:"varint,7,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	// created is set if the file did not exist before the change.
+	Created bool `protobuf:"varint,8,opt,name=created,proto3" json:"created,omitempty"`
+	// reason is why the user rejected the change.
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	// created_at is when the file was first changed.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is when the change was last edited or reviewed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileChange) Reset() {
+	*x = FileChange{}
+	mi := &file_construct_v1_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileChange) ProtoMessage() {}
+
+func (x *FileChange) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileChange.ProtoReflect.Descriptor instead.
+func (*FileChange) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FileChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FileChange) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *FileChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileChange) GetStatus() FileChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FileChangeStatus_FILE_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *FileChange) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *FileChange) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *FileChange) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+func (x *FileChange) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *FileChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FileChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FileChange) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ListFileChangesRequest specifies the task and the status of the changes to list.
+type ListFileChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the unique identifier of the task (UUID format).
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// status limits the changes to those with this status. All changes are listed if unset.
+	Status        *FileChangeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=construct.v1.FileChangeStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFileChangesRequest) Reset() {
+	*x = ListFileChangesRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileChangesRequest) ProtoMessage() {}
+
+func (x *ListFileChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListFileChangesRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListFileChangesRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListFileChangesRequest) GetStatus() FileChangeStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return FileChangeStatus_FILE_CHANGE_STATUS_UNSPECIFIED
+}
+
+// ListFileChangesResponse contains the changes of the task, oldest first.
+type ListFileChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*FileChange          `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFileChangesResponse) Reset() {
+	*x = ListFileChangesResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileChangesResponse) ProtoMessage() {}
+
+func (x *ListFileChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListFileChangesResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListFileChangesResponse) GetChanges() []*FileChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// AcceptFileChangeRequest specifies the change to write.
+type AcceptFileChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the change (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// force writes the change even if the file was changed in the workspace since the agent first
+	// edited it.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFileChangeRequest) Reset() {
+	*x = AcceptFileChangeRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFileChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFileChangeRequest) ProtoMessage() {}
+
+func (x *AcceptFileChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFileChangeRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFileChangeRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AcceptFileChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcceptFileChangeRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// AcceptFileChangeResponse contains the accepted change.
+type AcceptFileChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *FileChange            `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFileChangeResponse) Reset() {
+	*x = AcceptFileChangeResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFileChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFileChangeResponse) ProtoMessage() {}
+
+func (x *AcceptFileChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFileChangeResponse.ProtoReflect.Descriptor instead.
+func (*AcceptFileChangeResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AcceptFileChangeResponse) GetChange() *FileChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// RejectFileChangeRequest specifies the change to discard and why.
+type RejectFileChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the change (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// reason tells the agent why the change was rejected.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFileChangeRequest) Reset() {
+	*x = RejectFileChangeRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFileChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFileChangeRequest) ProtoMessage() {}
+
+func (x *RejectFileChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFileChangeRequest.ProtoReflect.Descriptor instead.
+func (*RejectFileChangeRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RejectFileChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectFileChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RejectFileChangeResponse contains the rejected change.
+type RejectFileChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *FileChange            `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFileChangeResponse) Reset() {
+	*x = RejectFileChangeResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFileChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFileChangeResponse) ProtoMessage() {}
+
+func (x *RejectFileChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFileChangeResponse.ProtoReflect.Descriptor instead.
+func (*RejectFileChangeResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RejectFileChangeResponse) GetChange() *FileChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// ForkTaskRequest contains the task to fork and the message to fork it from.
+type ForkTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the task to fork (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// message_id is the last message that is copied into the new task (UUID format). All messages
+	// are copied if unset.
+	MessageId *string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3,oneof" json:"message_id,omitempty"`
+	// description is the description of the new task. It defaults to the description of the
+	// original task.
+	Description   *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForkTaskRequest) Reset() {
+	*x = ForkTaskRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkTaskRequest) ProtoMessage() {}
+
+func (x *ForkTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkTaskRequest.ProtoReflect.Descriptor instead.
+func (*ForkTaskRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ForkTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForkTaskRequest) GetMessageId() string {
+	if x != nil && x.MessageId != nil {
+		return *x.MessageId
+	}
+	return ""
+}
+
+func (x *ForkTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// ForkTaskResponse contains the new task.
+type ForkTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task is the task that was forked off.
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// copied_messages is the number of messages that were copied into the new task.
+	CopiedMessages int32 `protobuf:"varint,2,opt,name=copied_messages,json=copiedMessages,proto3" json:"copied_messages,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ForkTaskResponse) Reset() {
+	*x = ForkTaskResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkTaskResponse) ProtoMessage() {}
+
+func (x *ForkTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkTaskResponse.ProtoReflect.Descriptor instead.
+func (*ForkTaskResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ForkTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *ForkTaskResponse) GetCopiedMessages() int32 {
+	if x != nil {
+		return x.CopiedMessages
+	}
+	return 0
+}
+
+// Filter specifies criteria for narrowing the list of returned tasks.
+type ListTasksRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// agent_id filters tasks by the agent assigned to execute them (UUID format, optional).
+	AgentId *string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// prefix filters tasks by the prefix of the task id.
+	TaskIdPrefix *string `protobuf:"bytes,2,opt,name=task_id_prefix,json=taskIdPrefix,proto3,oneof" json:"task_id_prefix,omitempty"`
+	// has_messages filters tasks by whether they have any messages.
+	// - if set to true: only tasks with at least one message
+	// - if set to false: only tasks with zero messages
+	// - if unset: no filtering by message presence
+	HasMessages *bool `protobuf:"varint,3,opt,name=has_messages,json=hasMessages,proto3,oneof" json:"has_messages,omitempty"`
+	// owner filters tasks by the user that created them, e.g. to attribute usage.
+	Owner *string `protobuf:"bytes,4,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	// forked_from_task_id filters tasks by the task they were forked from (UUID format, optional).
+	ForkedFromTaskId *string `protobuf:"bytes,5,opt,name=forked_from_task_id,json=forkedFromTaskId,proto3,oneof" json:"forked_from_task_id,omitempty"`
+	// label_selector filters tasks by their labels. It is a comma-separated list of requirements
+	// that all have to match:
+	// - key=value: the label is set to value
+	// - key!=value: the label is not set or set to a different value
+	// - key: the label is set
+	// - !key: the label is not set
+	LabelSelector *string `protobuf:"bytes,6,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"`
+	// workspace_prefix filters tasks by the prefix of their workspace directory.
+	WorkspacePrefix *string `protobuf:"bytes,7,opt,name=workspace_prefix,json=workspacePrefix,proto3,oneof" json:"workspace_prefix,omitempty"`
+	// phases filters tasks by their current phase. Tasks in any of the phases match.
+	Phases []TaskPhase `protobuf:"varint,8,rep,packed,name=phases,proto3,enum=construct.v1.TaskPhase" json:"phases,omitempty"`
+	// created_after filters tasks created at or after this time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// created_before filters tasks created before this time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// updated_after filters tasks updated at or after this time.
+	UpdatedAfter *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	// updated_before filters tasks updated before this time.
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// min_cost filters tasks that cost at least this amount.
+	MinCost *float64 `protobuf:"fixed64,13,opt,name=min_cost,json=minCost,proto3,oneof" json:"min_cost,omitempty"`
+	// max_cost filters tasks that cost at most this amount.
+	MaxCost       *float64 `protobuf:"fixed64,14,opt,name=max_cost,json=maxCost,proto3,oneof" json:"max_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest_Filter) Reset() {
+	*x = ListTasksRequest_Filter{}
+	mi := &file_construct_v1_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest_Filter) ProtoMessage() {}
+
+func (x *ListTasksRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListTasksRequest_Filter) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest_Filter) GetTaskIdPrefix() string {
+	if x != nil && x.TaskIdPrefix != nil {
+		return *x.TaskIdPrefix
+	}
+	return ""
+}
+
+func (x *ListTasksRequest_Filter) GetHasMessages() bool {
+	if x != nil && x.HasMessages != nil {
+		return *x.HasMessages
+	}
+	return false
+}
+
+func (x *ListTasksRequest_Filter) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *ListTasksRequest_Filter) GetForkedFromTaskId() string {
+	if x != nil && x.ForkedFromTaskId != nil {
+		return *x.ForkedFromTaskId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest_Filter) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListTasksRequest_Filter) GetWorkspacePrefix() string {
+	if x != nil && x.WorkspacePrefix != nil {
+		return *x.WorkspacePrefix
+	}
+	return ""
+}
+
+func (x *ListTasksRequest_Filter) GetPhases() []TaskPhase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *ListTasksRequest_Filter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListTasksRequest_Filter) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListTasksRequest_Filter) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *ListTasksRequest_Filter) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *ListTasksRequest_Filter) GetMinCost() float64 {
+	if x != nil && x.MinCost != nil {
+		return *x.MinCost
+	}
+	return 0
+}
+
+func (x *ListTasksRequest_Filter) GetMaxCost() float64 {
+	if x != nil && x.MaxCost != nil {
+		return *x.MaxCost
+	}
+	return 0
+}
+
+var File_construct_v1_task_proto protoreflect.FileDescriptor
+
+const file_construct_v1_task_proto_rawDesc = "" +
+	"\n" +
+	"\x17construct/v1/task.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x1aconstruct/v1/message.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x01\n" +
+	"\x04Task\x126\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1a.construct.v1.TaskMetadataR\bmetadata\x12*\n" +
+	"\x04spec\x18\x02 \x01(\v2\x16.construct.v1.TaskSpecR\x04spec\x120\n" +
+	"\x06status\x18\x03 \x01(\v2\x18.construct.v1.TaskStatusR\x06status\"\x8d\x03\n" +
+	"\fTaskMetadata\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04team\x18\x05 \x01(\tR\x04team\x12<\n" +
+	"\x13forked_from_task_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x10forkedFromTaskId\x88\x01\x01\x12B\n" +
+	"\x16forked_from_message_id\x18\a \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\x13forkedFromMessageId\x88\x01\x01B\x16\n" +
+	"\x14_forked_from_task_idB\x19\n" +
+	"\x17_forked_from_message_id\"\xf5\x02\n" +
+	"\bTaskSpec\x12(\n" +
+	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12$\n" +
+	"\tworkspace\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tworkspace\x12F\n" +
+	"\rdesired_phase\x18\x03 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\fdesiredPhase\x12*\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12!\n" +
+	"\freview_edits\x18\x05 \x01(\bR\vreviewEdits\x12:\n" +
+	"\x06labels\x18\x06 \x03(\v2\".construct.v1.TaskSpec.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
+	"\t_agent_id\"\xad\x01\n" +
+	"\n" +
+	"TaskStatus\x12-\n" +
+	"\x05usage\x18\x01 \x01(\v2\x17.construct.v1.TaskUsageR\x05usage\x127\n" +
+	"\x05phase\x18\x02 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05phase\x12\x12\n" +
+	"\x04turn\x18\x03 \x01(\x03R\x04turn\x12#\n" +
+	"\rmessage_count\x18\x04 \x01(\x03R\fmessageCount\"\xc2\x02\n" +
+	"\tTaskUsage\x12!\n" +
+	"\finput_tokens\x18\x01 \x01(\x03R\vinputTokens\x12#\n" +
+	"\routput_tokens\x18\x02 \x01(\x03R\foutputTokens\x12,\n" +
+	"\x12cache_write_tokens\x18\x03 \x01(\x03R\x10cacheWriteTokens\x12*\n" +
+	"\x11cache_read_tokens\x18\x04 \x01(\x03R\x0fcacheReadTokens\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\x01R\x04cost\x12B\n" +
+	"\ttool_uses\x18\x06 \x03(\v2%.construct.v1.TaskUsage.ToolUsesEntryR\btoolUses\x1a;\n" +
+	"\rToolUsesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xc6\x02\n" +
+	"\x11CreateTaskRequest\x12#\n" +
+	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aagentId\x123\n" +
+	"\x11project_directory\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x10projectDirectory\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12!\n" +
+	"\freview_edits\x18\x04 \x01(\bR\vreviewEdits\x12M\n" +
+	"\x06labels\x18\x05 \x03(\v2+.construct.v1.CreateTaskRequest.LabelsEntryB\b\xbaH\x05\x9a\x01\x02\x10 R\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
+	"\x12CreateTaskResponse\x12.\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"*\n" +
+	"\x0eGetTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"A\n" +
+	"\x0fGetTaskResponse\x12.\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"\xe5\t\n" +
+	"\x10ListTasksRequest\x12=\n" +
+	"\x06filter\x18\x01 \x01(\v2%.construct.v1.ListTasksRequest.FilterR\x06filter\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tpageToken\x12E\n" +
+	"\n" +
+	"sort_field\x18\x04 \x01(\x0e2\x17.construct.v1.SortFieldB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\tsortField\x88\x01\x01\x12E\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x0e2\x17.construct.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\tsortOrder\x88\x01\x01\x1a\x81\a\n" +
+	"\x06Filter\x12(\n" +
+	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12)\n" +
+	"\x0etask_id_prefix\x18\x02 \x01(\tH\x01R\ftaskIdPrefix\x88\x01\x01\x12&\n" +
+	"\fhas_messages\x18\x03 \x01(\bH\x02R\vhasMessages\x88\x01\x01\x12\x19\n" +
+	"\x05owner\x18\x04 \x01(\tH\x03R\x05owner\x88\x01\x01\x12<\n" +
+	"\x13forked_from_task_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x04R\x10forkedFromTaskId\x88\x01\x01\x124\n" +
+	"\x0elabel_selector\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x05R\rlabelSelector\x88\x01\x01\x12.\n" +
+	"\x10workspace_prefix\x18\a \x01(\tH\x06R\x0fworkspacePrefix\x88\x01\x01\x12>\n" +
+	"\x06phases\x18\b \x03(\x0e2\x17.construct.v1.TaskPhaseB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\x06phases\x12?\n" +
+	"\rcreated_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12?\n" +
+	"\rupdated_after\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedAfter\x12A\n" +
+	"\x0eupdated_before\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBefore\x12.\n" +
+	"\bmin_cost\x18\r \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\aR\aminCost\x88\x01\x01\x12.\n" +
+	"\bmax_cost\x18\x0e \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\bR\amaxCost\x88\x01\x01B\v\n" +
+	"\t_agent_idB\x11\n" +
+	"\x0f_task_id_prefixB\x0f\n" +
+	"\r_has_messagesB\b\n" +
+	"\x06_ownerB\x16\n" +
+	"\x14_forked_from_task_idB\x11\n" +
+	"\x0f_label_selectorB\x13\n" +
+	"\x11_workspace_prefixB\v\n" +
+	"\t_min_costB\v\n" +
+	"\t_max_costB\f\n" +
+	"\n" +
+	"_page_sizeB\r\n" +
+	"\v_sort_fieldB\r\n" +
+	"\v_sort_order\"e\n" +
+	"\x11ListTasksResponse\x12(\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x12.construct.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa7\x02\n" +
+	"\x11UpdateTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12(\n" +
+	"\bagent_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12&\n" +
+	"\freview_edits\x18\x03 \x01(\bH\x01R\vreviewEdits\x88\x01\x01\x12M\n" +
+	"\x06labels\x18\x04 \x03(\v2+.construct.v1.UpdateTaskRequest.LabelsEntryB\b\xbaH\x05\x9a\x01\x02\x10 R\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
+	"\t_agent_idB\x0f\n" +
+	"\r_review_edits\"D\n" +
+	"\x12UpdateTaskResponse\x12.\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"-\n" +
+	"\x11DeleteTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
+	"\x12DeleteTaskResponse\"5\n" +
+	"\x10SubscribeRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"p\n" +
+	"\tTaskEvent\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12@\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\ttimestamp\"\x89\x01\n" +
 	"\x11SubscribeResponse\x121\n" +
@@ -1391,12 +2817,100 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\x05event\"7\n" +
 	"\x12SuspendTaskRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"\x15\n" +
-	"\x13SuspendTaskResponse*r\n" +
+	"\x13SuspendTaskResponse\"u\n" +
+	"\x17ExportTranscriptRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12@\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1e.construct.v1.TranscriptFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06format\"W\n" +
+	"\x18ExportTranscriptResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\".\n" +
+	"\x12CompactTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"^\n" +
+	"\x13CompactTaskResponse\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12-\n" +
+	"\x12compacted_messages\x18\x02 \x01(\x05R\x11compactedMessages\"\x8f\x01\n" +
+	"\x19ListWorkspaceFilesRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12\x1e\n" +
+	"\x05query\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x05query\x12%\n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x01H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"2\n" +
+	"\x1aListWorkspaceFilesResponse\x12\x14\n" +
+	"\x05paths\x18\x01 \x03(\tR\x05paths\"\x95\x01\n" +
+	"\x1dSearchWorkspaceSymbolsRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12 \n" +
+	"\x05query\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05query\x12%\n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x01H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"Y\n" +
+	"\x1eSearchWorkspaceSymbolsResponse\x127\n" +
+	"\asymbols\x18\x01 \x03(\v2\x1d.construct.v1.WorkspaceSymbolR\asymbols\"|\n" +
+	"\x0fWorkspaceSymbol\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x12\n" +
+	"\x04line\x18\x04 \x01(\rR\x04line\x12\x19\n" +
+	"\bend_line\x18\x05 \x01(\rR\aendLine\"\x85\x03\n" +
+	"\n" +
+	"FileChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x126\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.construct.v1.FileChangeStatusR\x06status\x12\x14\n" +
+	"\x05patch\x18\x05 \x01(\tR\x05patch\x12\x1f\n" +
+	"\vlines_added\x18\x06 \x01(\x05R\n" +
+	"linesAdded\x12#\n" +
+	"\rlines_removed\x18\a \x01(\x05R\flinesRemoved\x12\x18\n" +
+	"\acreated\x18\b \x01(\bR\acreated\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8d\x01\n" +
+	"\x16ListFileChangesRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12E\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.construct.v1.FileChangeStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"M\n" +
+	"\x17ListFileChangesResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.construct.v1.FileChangeR\achanges\"I\n" +
+	"\x17AcceptFileChangeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"L\n" +
+	"\x18AcceptFileChangeResponse\x120\n" +
+	"\x06change\x18\x01 \x01(\v2\x18.construct.v1.FileChangeR\x06change\"b\n" +
+	"\x17RejectFileChangeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x06reasonJ\x04\b\x03\x10\x04R\x05force\"L\n" +
+	"\x18RejectFileChangeResponse\x120\n" +
+	"\x06change\x18\x01 \x01(\v2\x18.construct.v1.FileChangeR\x06change\"\xa9\x01\n" +
+	"\x0fForkTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\tmessageId\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x01R\vdescription\x88\x01\x01B\r\n" +
+	"\v_message_idB\x0e\n" +
+	"\f_description\"k\n" +
+	"\x10ForkTaskResponse\x12.\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\x12'\n" +
+	"\x0fcopied_messages\x18\x02 \x01(\x05R\x0ecopiedMessages*r\n" +
 	"\tTaskPhase\x12\x1a\n" +
 	"\x16TASK_PHASE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13TASK_PHASE_AWAITING\x10\x01\x12\x16\n" +
 	"\x12TASK_PHASE_RUNNING\x10\x02\x12\x18\n" +
-	"\x14TASK_PHASE_SUSPENDED\x10\x032\xce\x04\n" +
+	"\x14TASK_PHASE_SUSPENDED\x10\x03*\x8d\x01\n" +
+	"\x10TranscriptFormat\x12!\n" +
+	"\x1dTRANSCRIPT_FORMAT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTRANSCRIPT_FORMAT_MARKDOWN\x10\x01\x12\x1a\n" +
+	"\x16TRANSCRIPT_FORMAT_HTML\x10\x02\x12\x1a\n" +
+	"\x16TRANSCRIPT_FORMAT_JSON\x10\x03*\x98\x01\n" +
+	"\x10FileChangeStatus\x12\"\n" +
+	"\x1eFILE_CHANGE_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aFILE_CHANGE_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bFILE_CHANGE_STATUS_ACCEPTED\x10\x02\x12\x1f\n" +
+	"\x1bFILE_CHANGE_STATUS_REJECTED\x10\x032\xf3\n" +
+	"\n" +
 	"\vTaskService\x12Q\n" +
 	"\n" +
 	"CreateTask\x12\x1f.construct.v1.CreateTaskRequest\x1a .construct.v1.CreateTaskResponse\"\x00\x12K\n" +
@@ -1405,9 +2919,17 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"UpdateTask\x12\x1f.construct.v1.UpdateTaskRequest\x1a .construct.v1.UpdateTaskResponse\"\x00\x12Q\n" +
 	"\n" +
-	"DeleteTask\x12\x1f.construct.v1.DeleteTaskRequest\x1a .construct.v1.DeleteTaskResponse\"\x00\x12P\n" +
-	"\tSubscribe\x12\x1e.construct.v1.SubscribeRequest\x1a\x1f.construct.v1.SubscribeResponse\"\x000\x01\x12T\n" +
-	"\vSuspendTask\x12 .construct.v1.SuspendTaskRequest\x1a!.construct.v1.SuspendTaskResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+	"DeleteTask\x12\x1f.construct.v1.DeleteTaskRequest\x1a .construct.v1.DeleteTaskResponse\"\x00\x12S\n" +
+	"\tSubscribe\x12\x1e.construct.v1.SubscribeRequest\x1a\x1f.construct.v1.SubscribeResponse\"\x03\x90\x02\x010\x01\x12T\n" +
+	"\vSuspendTask\x12 .construct.v1.SuspendTaskRequest\x1a!.construct.v1.SuspendTaskResponse\"\x00\x12f\n" +
+	"\x10ExportTranscript\x12%.construct.v1.ExportTranscriptRequest\x1a&.construct.v1.ExportTranscriptResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\vCompactTask\x12 .construct.v1.CompactTaskRequest\x1a!.construct.v1.CompactTaskResponse\"\x00\x12l\n" +
+	"\x12ListWorkspaceFiles\x12'.construct.v1.ListWorkspaceFilesRequest\x1a(.construct.v1.ListWorkspaceFilesResponse\"\x03\x90\x02\x01\x12x\n" +
+	"\x16SearchWorkspaceSymbols\x12+.construct.v1.SearchWorkspaceSymbolsRequest\x1a,.construct.v1.SearchWorkspaceSymbolsResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\x0fListFileChanges\x12$.construct.v1.ListFileChangesRequest\x1a%.construct.v1.ListFileChangesResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\x10AcceptFileChange\x12%.construct.v1.AcceptFileChangeRequest\x1a&.construct.v1.AcceptFileChangeResponse\"\x00\x12c\n" +
+	"\x10RejectFileChange\x12%.construct.v1.RejectFileChangeRequest\x1a&.construct.v1.RejectFileChangeResponse\"\x00\x12K\n" +
+	"\bForkTask\x12\x1d.construct.v1.ForkTaskRequest\x1a\x1e.construct.v1.ForkTaskResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
 
 var (
 	file_construct_v1_task_proto_rawDescOnce sync.Once
@@ -1421,76 +2943,133 @@ func file_construct_v1_task_proto_rawDescGZIP() []byte {
 	return file_construct_v1_task_proto_rawDescData
 }
 
-var file_construct_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_construct_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_construct_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_construct_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_construct_v1_task_proto_goTypes = []any{
-	(TaskPhase)(0),                  // 0: construct.v1.TaskPhase
-	(*Task)(nil),                    // 1: construct.v1.Task
-	(*TaskMetadata)(nil),            // 2: construct.v1.TaskMetadata
-	(*TaskSpec)(nil),                // 3: construct.v1.TaskSpec
-	(*TaskStatus)(nil),              // 4: construct.v1.TaskStatus
-	(*TaskUsage)(nil),               // 5: construct.v1.TaskUsage
-	(*CreateTaskRequest)(nil),       // 6: construct.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),      // 7: construct.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),          // 8: construct.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),         // 9: construct.v1.GetTaskResponse
-	(*ListTasksRequest)(nil),        // 10: construct.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),       // 11: construct.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),       // 12: construct.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),      // 13: construct.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),       // 14: construct.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),      // 15: construct.v1.DeleteTaskResponse
-	(*SubscribeRequest)(nil),        // 16: construct.v1.SubscribeRequest
-	(*TaskEvent)(nil),               // 17: construct.v1.TaskEvent
-	(*SubscribeResponse)(nil),       // 18: construct.v1.SubscribeResponse
-	(*SuspendTaskRequest)(nil),      // 19: construct.v1.SuspendTaskRequest
-	(*SuspendTaskResponse)(nil),     // 20: construct.v1.SuspendTaskResponse
-	nil,                             // 21: construct.v1.TaskUsage.ToolUsesEntry
-	(*ListTasksRequest_Filter)(nil), // 22: construct.v1.ListTasksRequest.Filter
-	(*timestamppb.Timestamp)(nil),   // 23: google.protobuf.Timestamp
-	(SortField)(0),                  // 24: construct.v1.SortField
-	(SortOrder)(0),                  // 25: construct.v1.SortOrder
-	(*Message)(nil),                 // 26: construct.v1.Message
+	(TaskPhase)(0),                         // 0: construct.v1.TaskPhase
+	(TranscriptFormat)(0),                  // 1: construct.v1.TranscriptFormat
+	(FileChangeStatus)(0),                  // 2: construct.v1.FileChangeStatus
+	(*Task)(nil),                           // 3: construct.v1.Task
+	(*TaskMetadata)(nil),                   // 4: construct.v1.TaskMetadata
+	(*TaskSpec)(nil),                       // 5: construct.v1.TaskSpec
+	(*TaskStatus)(nil),                     // 6: construct.v1.TaskStatus
+	(*TaskUsage)(nil),                      // 7: construct.v1.TaskUsage
+	(*CreateTaskRequest)(nil),              // 8: construct.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),             // 9: construct.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),                 // 10: construct.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),                // 11: construct.v1.GetTaskResponse
+	(*ListTasksRequest)(nil),               // 12: construct.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),              // 13: construct.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),              // 14: construct.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),             // 15: construct.v1.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),              // 16: construct.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),             // 17: construct.v1.DeleteTaskResponse
+	(*SubscribeRequest)(nil),               // 18: construct.v1.SubscribeRequest
+	(*TaskEvent)(nil),                      // 19: construct.v1.TaskEvent
+	(*SubscribeResponse)(nil),              // 20: construct.v1.SubscribeResponse
+	(*SuspendTaskRequest)(nil),             // 21: construct.v1.SuspendTaskRequest
+	(*SuspendTaskResponse)(nil),            // 22: construct.v1.SuspendTaskResponse
+	(*ExportTranscriptRequest)(nil),        // 23: construct.v1.ExportTranscriptRequest
+	(*ExportTranscriptResponse)(nil),       // 24: construct.v1.ExportTranscriptResponse
+	(*CompactTaskRequest)(nil),             // 25: construct.v1.CompactTaskRequest
+	(*CompactTaskResponse)(nil),            // 26: construct.v1.CompactTaskResponse
+	(*ListWorkspaceFilesRequest)(nil),      // 27: construct.v1.ListWorkspaceFilesRequest
+	(*ListWorkspaceFilesResponse)(nil),     // 28: construct.v1.ListWorkspaceFilesResponse
+	(*SearchWorkspaceSymbolsRequest)(nil),  // 29: construct.v1.SearchWorkspaceSymbolsRequest
+	(*SearchWorkspaceSymbolsResponse)(nil), // 30: construct.v1.SearchWorkspaceSymbolsResponse
+	(*WorkspaceSymbol)(nil),                // 31: construct.v1.WorkspaceSymbol
+	(*FileChange)(nil),                     // 32: construct.v1.FileChange
+	(*ListFileChangesRequest)(nil),         // 33: construct.v1.ListFileChangesRequest
+	(*ListFileChangesResponse)(nil),        // 34: construct.v1.ListFileChangesResponse
+	(*AcceptFileChangeRequest)(nil),        // 35: construct.v1.AcceptFileChangeRequest
+	(*AcceptFileChangeResponse)(nil),       // 36: construct.v1.AcceptFileChangeResponse
+	(*RejectFileChangeRequest)(nil),        // 37: construct.v1.RejectFileChangeRequest
+	(*RejectFileChangeResponse)(nil),       // 38: construct.v1.RejectFileChangeResponse
+	(*ForkTaskRequest)(nil),                // 39: construct.v1.ForkTaskRequest
+	(*ForkTaskResponse)(nil),               // 40: construct.v1.ForkTaskResponse
+	nil,                                    // 41: construct.v1.TaskSpec.LabelsEntry
+	nil,                                    // 42: construct.v1.TaskUsage.ToolUsesEntry
+	nil,                                    // 43: construct.v1.CreateTaskRequest.LabelsEntry
+	(*ListTasksRequest_Filter)(nil),        // 44: construct.v1.ListTasksRequest.Filter
+	nil,                                    // 45: construct.v1.UpdateTaskRequest.LabelsEntry
+	(*timestamppb.Timestamp)(nil),          // 46: google.protobuf.Timestamp
+	(SortField)(0),                         // 47: construct.v1.SortField
+	(SortOrder)(0),                         // 48: construct.v1.SortOrder
+	(*Message)(nil),                        // 49: construct.v1.Message
 }
 var file_construct_v1_task_proto_depIdxs = []int32{
-	2,  // 0: construct.v1.Task.metadata:type_name -> construct.v1.TaskMetadata
-	3,  // 1: construct.v1.Task.spec:type_name -> construct.v1.TaskSpec
-	4,  // 2: construct.v1.Task.status:type_name -> construct.v1.TaskStatus
-	23, // 3: construct.v1.TaskMetadata.created_at:type_name -> google.protobuf.Timestamp
-	23, // 4: construct.v1.TaskMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 0: construct.v1.Task.metadata:type_name -> construct.v1.TaskMetadata
+	5,  // 1: construct.v1.Task.spec:type_name -> construct.v1.TaskSpec
+	6,  // 2: construct.v1.Task.status:type_name -> construct.v1.TaskStatus
+	46, // 3: construct.v1.TaskMetadata.created_at:type_name -> google.protobuf.Timestamp
+	46, // 4: construct.v1.TaskMetadata.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: construct.v1.TaskSpec.desired_phase:type_name -> construct.v1.TaskPhase
-	5,  // 6: construct.v1.TaskStatus.usage:type_name -> construct.v1.TaskUsage
-	0,  // 7: construct.v1.TaskStatus.phase:type_name -> construct.v1.TaskPhase
-	21, // 8: construct.v1.TaskUsage.tool_uses:type_name -> construct.v1.TaskUsage.ToolUsesEntry
-	1,  // 9: construct.v1.CreateTaskResponse.task:type_name -> construct.v1.Task
-	1,  // 10: construct.v1.GetTaskResponse.task:type_name -> construct.v1.Task
-	22, // 11: construct.v1.ListTasksRequest.filter:type_name -> construct.v1.ListTasksRequest.Filter
-	24, // 12: construct.v1.ListTasksRequest.sort_field:type_name -> construct.v1.SortField
-	25, // 13: construct.v1.ListTasksRequest.sort_order:type_name -> construct.v1.SortOrder
-	1,  // 14: construct.v1.ListTasksResponse.tasks:type_name -> construct.v1.Task
-	1,  // 15: construct.v1.UpdateTaskResponse.task:type_name -> construct.v1.Task
-	23, // 16: construct.v1.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
-	26, // 17: construct.v1.SubscribeResponse.message:type_name -> construct.v1.Message
-	17, // 18: construct.v1.SubscribeResponse.task_event:type_name -> construct.v1.TaskEvent
-	6,  // 19: construct.v1.TaskService.CreateTask:input_type -> construct.v1.CreateTaskRequest
-	8,  // 20: construct.v1.TaskService.GetTask:input_type -> construct.v1.GetTaskRequest
-	10, // 21: construct.v1.TaskService.ListTasks:input_type -> construct.v1.ListTasksRequest
-	12, // 22: construct.v1.TaskService.UpdateTask:input_type -> construct.v1.UpdateTaskRequest
-	14, // 23: construct.v1.TaskService.DeleteTask:input_type -> construct.v1.DeleteTaskRequest
-	16, // 24: construct.v1.TaskService.Subscribe:input_type -> construct.v1.SubscribeRequest
-	19, // 25: construct.v1.TaskService.SuspendTask:input_type -> construct.v1.SuspendTaskRequest
-	7,  // 26: construct.v1.TaskService.CreateTask:output_type -> construct.v1.CreateTaskResponse
-	9,  // 27: construct.v1.TaskService.GetTask:output_type -> construct.v1.GetTaskResponse
-	11, // 28: construct.v1.TaskService.ListTasks:output_type -> construct.v1.ListTasksResponse
-	13, // 29: construct.v1.TaskService.UpdateTask:output_type -> construct.v1.UpdateTaskResponse
-	15, // 30: construct.v1.TaskService.DeleteTask:output_type -> construct.v1.DeleteTaskResponse
-	18, // 31: construct.v1.TaskService.Subscribe:output_type -> construct.v1.SubscribeResponse
-	20, // 32: construct.v1.TaskService.SuspendTask:output_type -> construct.v1.SuspendTaskResponse
-	26, // [26:33] is the sub-list for method output_type
-	19, // [19:26] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	41, // 6: construct.v1.TaskSpec.labels:type_name -> construct.v1.TaskSpec.LabelsEntry
+	7,  // 7: construct.v1.TaskStatus.usage:type_name -> construct.v1.TaskUsage
+	0,  // 8: construct.v1.TaskStatus.phase:type_name -> construct.v1.TaskPhase
+	42, // 9: construct.v1.TaskUsage.tool_uses:type_name -> construct.v1.TaskUsage.ToolUsesEntry
+	43, // 10: construct.v1.CreateTaskRequest.labels:type_name -> construct.v1.CreateTaskRequest.LabelsEntry
+	3,  // 11: construct.v1.CreateTaskResponse.task:type_name -> construct.v1.Task
+	3,  // 12: construct.v1.GetTaskResponse.task:type_name -> construct.v1.Task
+	44, // 13: construct.v1.ListTasksRequest.filter:type_name -> construct.v1.ListTasksRequest.Filter
+	47, // 14: construct.v1.ListTasksRequest.sort_field:type_name -> construct.v1.SortField
+	48, // 15: construct.v1.ListTasksRequest.sort_order:type_name -> construct.v1.SortOrder
+	3,  // 16: construct.v1.ListTasksResponse.tasks:type_name -> construct.v1.Task
+	45, // 17: construct.v1.UpdateTaskRequest.labels:type_name -> construct.v1.UpdateTaskRequest.LabelsEntry
+	3,  // 18: construct.v1.UpdateTaskResponse.task:type_name -> construct.v1.Task
+	46, // 19: construct.v1.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
+	49, // 20: construct.v1.SubscribeResponse.message:type_name -> construct.v1.Message
+	19, // 21: construct.v1.SubscribeResponse.task_event:type_name -> construct.v1.TaskEvent
+	1,  // 22: construct.v1.ExportTranscriptRequest.format:type_name -> construct.v1.TranscriptFormat
+	31, // 23: construct.v1.SearchWorkspaceSymbolsResponse.symbols:type_name -> construct.v1.WorkspaceSymbol
+	2,  // 24: construct.v1.FileChange.status:type_name -> construct.v1.FileChangeStatus
+	46, // 25: construct.v1.FileChange.created_at:type_name -> google.protobuf.Timestamp
+	46, // 26: construct.v1.FileChange.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 27: construct.v1.ListFileChangesRequest.status:type_name -> construct.v1.FileChangeStatus
+	32, // 28: construct.v1.ListFileChangesResponse.changes:type_name -> construct.v1.FileChange
+	32, // 29: construct.v1.AcceptFileChangeResponse.change:type_name -> construct.v1.FileChange
+	32, // 30: construct.v1.RejectFileChangeResponse.change:type_name -> construct.v1.FileChange
+	3,  // 31: construct.v1.ForkTaskResponse.task:type_name -> construct.v1.Task
+	0,  // 32: construct.v1.ListTasksRequest.Filter.phases:type_name -> construct.v1.TaskPhase
+	46, // 33: construct.v1.ListTasksRequest.Filter.created_after:type_name -> google.protobuf.Timestamp
+	46, // 34: construct.v1.ListTasksRequest.Filter.created_before:type_name -> google.protobuf.Timestamp
+	46, // 35: construct.v1.ListTasksRequest.Filter.updated_after:type_name -> google.protobuf.Timestamp
+	46, // 36: construct.v1.ListTasksRequest.Filter.updated_before:type_name -> google.protobuf.Timestamp
+	8,  // 37: construct.v1.TaskService.CreateTask:input_type -> construct.v1.CreateTaskRequest
+	10, // 38: construct.v1.TaskService.GetTask:input_type -> construct.v1.GetTaskRequest
+	12, // 39: construct.v1.TaskService.ListTasks:input_type -> construct.v1.ListTasksRequest
+	14, // 40: construct.v1.TaskService.UpdateTask:input_type -> construct.v1.UpdateTaskRequest
+	16, // 41: construct.v1.TaskService.DeleteTask:input_type -> construct.v1.DeleteTaskRequest
+	18, // 42: construct.v1.TaskService.Subscribe:input_type -> construct.v1.SubscribeRequest
+	21, // 43: construct.v1.TaskService.SuspendTask:input_type -> construct.v1.SuspendTaskRequest
+	23, // 44: construct.v1.TaskService.ExportTranscript:input_type -> construct.v1.ExportTranscriptRequest
+	25, // 45: construct.v1.TaskService.CompactTask:input_type -> construct.v1.CompactTaskRequest
+	27, // 46: construct.v1.TaskService.ListWorkspaceFiles:input_type -> construct.v1.ListWorkspaceFilesRequest
+	29, // 47: construct.v1.TaskService.SearchWorkspaceSymbols:input_type -> construct.v1.SearchWorkspaceSymbolsRequest
+	33, // 48: construct.v1.TaskService.ListFileChanges:input_type -> construct.v1.ListFileChangesRequest
+	35, // 49: construct.v1.TaskService.AcceptFileChange:input_type -> construct.v1.AcceptFileChangeRequest
+	37, // 50: construct.v1.TaskService.RejectFileChange:input_type -> construct.v1.RejectFileChangeRequest
+	39, // 51: construct.v1.TaskService.ForkTask:input_type -> construct.v1.ForkTaskRequest
+	9,  // 52: construct.v1.TaskService.CreateTask:output_type -> construct.v1.CreateTaskResponse
+	11, // 53: construct.v1.TaskService.GetTask:output_type -> construct.v1.GetTaskResponse
+	13, // 54: construct.v1.TaskService.ListTasks:output_type -> construct.v1.ListTasksResponse
+	15, // 55: construct.v1.TaskService.UpdateTask:output_type -> construct.v1.UpdateTaskResponse
+	17, // 56: construct.v1.TaskService.DeleteTask:output_type -> construct.v1.DeleteTaskResponse
+	20, // 57: construct.v1.TaskService.Subscribe:output_type -> construct.v1.SubscribeResponse
+	22, // 58: construct.v1.TaskService.SuspendTask:output_type -> construct.v1.SuspendTaskResponse
+	24, // 59: construct.v1.TaskService.ExportTranscript:output_type -> construct.v1.ExportTranscriptResponse
+	26, // 60: construct.v1.TaskService.CompactTask:output_type -> construct.v1.CompactTaskResponse
+	28, // 61: construct.v1.TaskService.ListWorkspaceFiles:output_type -> construct.v1.ListWorkspaceFilesResponse
+	30, // 62: construct.v1.TaskService.SearchWorkspaceSymbols:output_type -> construct.v1.SearchWorkspaceSymbolsResponse
+	34, // 63: construct.v1.TaskService.ListFileChanges:output_type -> construct.v1.ListFileChangesResponse
+	36, // 64: construct.v1.TaskService.AcceptFileChange:output_type -> construct.v1.AcceptFileChangeResponse
+	38, // 65: construct.v1.TaskService.RejectFileChange:output_type -> construct.v1.RejectFileChangeResponse
+	40, // 66: construct.v1.TaskService.ForkTask:output_type -> construct.v1.ForkTaskResponse
+	52, // [52:67] is the sub-list for method output_type
+	37, // [37:52] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_task_proto_init() }
@@ -1500,6 +3079,7 @@ func file_construct_v1_task_proto_init() {
 	}
 	file_construct_v1_common_proto_init()
 	file_construct_v1_message_proto_init()
+	file_construct_v1_task_proto_msgTypes[1].OneofWrappers = []any{}
 	file_construct_v1_task_proto_msgTypes[2].OneofWrappers = []any{}
 	file_construct_v1_task_proto_msgTypes[9].OneofWrappers = []any{}
 	file_construct_v1_task_proto_msgTypes[11].OneofWrappers = []any{}
@@ -1507,14 +3087,18 @@ func file_construct_v1_task_proto_init() {
 		(*SubscribeResponse_Message)(nil),
 		(*SubscribeResponse_TaskEvent)(nil),
 	}
-	file_construct_v1_task_proto_msgTypes[21].OneofWrappers = []any{}
+	file_construct_v1_task_proto_msgTypes[24].OneofWrappers = []any{}
+	file_construct_v1_task_proto_msgTypes[26].OneofWrappers = []any{}
+	file_construct_v1_task_proto_msgTypes[30].OneofWrappers = []any{}
+	file_construct_v1_task_proto_msgTypes[36].OneofWrappers = []any{}
+	file_construct_v1_task_proto_msgTypes[41].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_task_proto_rawDesc), len(file_construct_v1_task_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      3,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
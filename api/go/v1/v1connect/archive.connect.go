@@ -0,0 +1,150 @@
+// Archive API provides operations for moving data between Construct daemons.
+// An archive is a gzip compressed tar file with a manifest and one JSON Lines file per resource.
+// It holds model providers, models, agents, tasks and messages.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: construct/v1/archive.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/furisto/construct/api/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ArchiveServiceName is the fully-qualified name of the ArchiveService service.
+	ArchiveServiceName = "construct.v1.ArchiveService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ArchiveServiceExportArchiveProcedure is the fully-qualified name of the ArchiveService's
+	// ExportArchive RPC.
+	ArchiveServiceExportArchiveProcedure = "/construct.v1.ArchiveService/ExportArchive"
+	// ArchiveServiceImportArchiveProcedure is the fully-qualified name of the ArchiveService's
+	// ImportArchive RPC.
+	ArchiveServiceImportArchiveProcedure = "/construct.v1.ArchiveService/ImportArchive"
+)
+
+// ArchiveServiceClient is a client for the construct.v1.ArchiveService service.
+type ArchiveServiceClient interface {
+	// ExportArchive writes model providers, models, agents and the selected tasks with their
+	// messages into an archive. The archive is streamed in chunks.
+	ExportArchive(context.Context, *connect.Request[v1.ExportArchiveRequest]) (*connect.ServerStreamForClient[v1.ExportArchiveResponse], error)
+	// ImportArchive writes the content of an archive in a single transaction.
+	ImportArchive(context.Context, *connect.Request[v1.ImportArchiveRequest]) (*connect.Response[v1.ImportArchiveResponse], error)
+}
+
+// NewArchiveServiceClient constructs a client for the construct.v1.ArchiveService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewArchiveServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ArchiveServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	archiveServiceMethods := v1.File_construct_v1_archive_proto.Services().ByName("ArchiveService").Methods()
+	return &archiveServiceClient{
+		exportArchive: connect.NewClient[v1.ExportArchiveRequest, v1.ExportArchiveResponse](
+			httpClient,
+			baseURL+ArchiveServiceExportArchiveProcedure,
+			connect.WithSchema(archiveServiceMethods.ByName("ExportArchive")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		importArchive: connect.NewClient[v1.ImportArchiveRequest, v1.ImportArchiveResponse](
+			httpClient,
+			baseURL+ArchiveServiceImportArchiveProcedure,
+			connect.WithSchema(archiveServiceMethods.ByName("ImportArchive")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// archiveServiceClient implements ArchiveServiceClient.
+type archiveServiceClient struct {
+	exportArchive *connect.Client[v1.ExportArchiveRequest, v1.ExportArchiveResponse]
+	importArchive *connect.Client[v1.ImportArchiveRequest, v1.ImportArchiveResponse]
+}
+
+// ExportArchive calls construct.v1.ArchiveService.ExportArchive.
+func (c *archiveServiceClient) ExportArchive(ctx context.Context, req *connect.Request[v1.ExportArchiveRequest]) (*connect.ServerStreamForClient[v1.ExportArchiveResponse], error) {
+	return c.exportArchive.CallServerStream(ctx, req)
+}
+
+// ImportArchive calls construct.v1.ArchiveService.ImportArchive.
+func (c *archiveServiceClient) ImportArchive(ctx context.Context, req *connect.Request[v1.ImportArchiveRequest]) (*connect.Response[v1.ImportArchiveResponse], error) {
+	return c.importArchive.CallUnary(ctx, req)
+}
+
+// ArchiveServiceHandler is an implementation of the construct.v1.ArchiveService service.
+type ArchiveServiceHandler interface {
+	// ExportArchive writes model providers, models, agents and the selected tasks with their
+	// messages into an archive. The archive is streamed in chunks.
+	ExportArchive(context.Context, *connect.Request[v1.ExportArchiveRequest], *connect.ServerStream[v1.ExportArchiveResponse]) error
+	// ImportArchive writes the content of an archive in a single transaction.
+	ImportArchive(context.Context, *connect.Request[v1.ImportArchiveRequest]) (*connect.Response[v1.ImportArchiveResponse], error)
+}
+
+// NewArchiveServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewArchiveServiceHandler(svc ArchiveServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	archiveServiceMethods := v1.File_construct_v1_archive_proto.Services().ByName("ArchiveService").Methods()
+	archiveServiceExportArchiveHandler := connect.NewServerStreamHandler(
+		ArchiveServiceExportArchiveProcedure,
+		svc.ExportArchive,
+		connect.WithSchema(archiveServiceMethods.ByName("ExportArchive")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceImportArchiveHandler := connect.NewUnaryHandler(
+		ArchiveServiceImportArchiveProcedure,
+		svc.ImportArchive,
+		connect.WithSchema(archiveServiceMethods.ByName("ImportArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/construct.v1.ArchiveService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ArchiveServiceExportArchiveProcedure:
+			archiveServiceExportArchiveHandler.ServeHTTP(w, r)
+		case ArchiveServiceImportArchiveProcedure:
+			archiveServiceImportArchiveHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedArchiveServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedArchiveServiceHandler struct{}
+
+func (UnimplementedArchiveServiceHandler) ExportArchive(context.Context, *connect.Request[v1.ExportArchiveRequest], *connect.ServerStream[v1.ExportArchiveResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ArchiveService.ExportArchive is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) ImportArchive(context.Context, *connect.Request[v1.ImportArchiveRequest]) (*connect.Response[v1.ImportArchiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ArchiveService.ImportArchive is not implemented"))
+}
@@ -52,6 +52,9 @@ const (
 	// MessageServiceDeleteMessageProcedure is the fully-qualified name of the MessageService's
 	// DeleteMessage RPC.
 	MessageServiceDeleteMessageProcedure = "/construct.v1.MessageService/DeleteMessage"
+	// MessageServiceRegenerateMessageProcedure is the fully-qualified name of the MessageService's
+	// RegenerateMessage RPC.
+	MessageServiceRegenerateMessageProcedure = "/construct.v1.MessageService/RegenerateMessage"
 )
 
 // MessageServiceClient is a client for the construct.v1.MessageService service.
@@ -66,6 +69,9 @@ type MessageServiceClient interface {
 	UpdateMessage(context.Context, *connect.Request[v1.UpdateMessageRequest]) (*connect.Response[v1.UpdateMessageResponse], error)
 	// DeleteMessage removes a message from the system.
 	DeleteMessage(context.Context, *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error)
+	// RegenerateMessage removes all messages after a user message, optionally replaces
+	// its content and marks it as unprocessed so that the agent responds to it again.
+	RegenerateMessage(context.Context, *connect.Request[v1.RegenerateMessageRequest]) (*connect.Response[v1.RegenerateMessageResponse], error)
 }
 
 // NewMessageServiceClient constructs a client for the construct.v1.MessageService service. By
@@ -111,16 +117,23 @@ func NewMessageServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(messageServiceMethods.ByName("DeleteMessage")),
 			connect.WithClientOptions(opts...),
 		),
+		regenerateMessage: connect.NewClient[v1.RegenerateMessageRequest, v1.RegenerateMessageResponse](
+			httpClient,
+			baseURL+MessageServiceRegenerateMessageProcedure,
+			connect.WithSchema(messageServiceMethods.ByName("RegenerateMessage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // messageServiceClient implements MessageServiceClient.
 type messageServiceClient struct {
-	createMessage *connect.Client[v1.CreateMessageRequest, v1.CreateMessageResponse]
-	getMessage    *connect.Client[v1.GetMessageRequest, v1.GetMessageResponse]
-	listMessages  *connect.Client[v1.ListMessagesRequest, v1.ListMessagesResponse]
-	updateMessage *connect.Client[v1.UpdateMessageRequest, v1.UpdateMessageResponse]
-	deleteMessage *connect.Client[v1.DeleteMessageRequest, v1.DeleteMessageResponse]
+	createMessage     *connect.Client[v1.CreateMessageRequest, v1.CreateMessageResponse]
+	getMessage        *connect.Client[v1.GetMessageRequest, v1.GetMessageResponse]
+	listMessages      *connect.Client[v1.ListMessagesRequest, v1.ListMessagesResponse]
+	updateMessage     *connect.Client[v1.UpdateMessageRequest, v1.UpdateMessageResponse]
+	deleteMessage     *connect.Client[v1.DeleteMessageRequest, v1.DeleteMessageResponse]
+	regenerateMessage *connect.Client[v1.RegenerateMessageRequest, v1.RegenerateMessageResponse]
 }
 
 // CreateMessage calls construct.v1.MessageService.CreateMessage.
@@ -148,6 +161,11 @@ func (c *messageServiceClient) DeleteMessage(ctx context.Context, req *connect.R
 	return c.deleteMessage.CallUnary(ctx, req)
 }
 
+// RegenerateMessage calls construct.v1.MessageService.RegenerateMessage.
+func (c *messageServiceClient) RegenerateMessage(ctx context.Context, req *connect.Request[v1.RegenerateMessageRequest]) (*connect.Response[v1.RegenerateMessageResponse], error) {
+	return c.regenerateMessage.CallUnary(ctx, req)
+}
+
 // MessageServiceHandler is an implementation of the construct.v1.MessageService service.
 type MessageServiceHandler interface {
 	// CreateMessage creates a new message within a task.
@@ -160,6 +178,9 @@ type MessageServiceHandler interface {
 	UpdateMessage(context.Context, *connect.Request[v1.UpdateMessageRequest]) (*connect.Response[v1.UpdateMessageResponse], error)
 	// DeleteMessage removes a message from the system.
 	DeleteMessage(context.Context, *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error)
+	// RegenerateMessage removes all messages after a user message, optionally replaces
+	// its content and marks it as unprocessed so that the agent responds to it again.
+	RegenerateMessage(context.Context, *connect.Request[v1.RegenerateMessageRequest]) (*connect.Response[v1.RegenerateMessageResponse], error)
 }
 
 // NewMessageServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -201,6 +222,12 @@ func NewMessageServiceHandler(svc MessageServiceHandler, opts ...connect.Handler
 		connect.WithSchema(messageServiceMethods.ByName("DeleteMessage")),
 		connect.WithHandlerOptions(opts...),
 	)
+	messageServiceRegenerateMessageHandler := connect.NewUnaryHandler(
+		MessageServiceRegenerateMessageProcedure,
+		svc.RegenerateMessage,
+		connect.WithSchema(messageServiceMethods.ByName("RegenerateMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/construct.v1.MessageService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MessageServiceCreateMessageProcedure:
@@ -213,6 +240,8 @@ func NewMessageServiceHandler(svc MessageServiceHandler, opts ...connect.Handler
 			messageServiceUpdateMessageHandler.ServeHTTP(w, r)
 		case MessageServiceDeleteMessageProcedure:
 			messageServiceDeleteMessageHandler.ServeHTTP(w, r)
+		case MessageServiceRegenerateMessageProcedure:
+			messageServiceRegenerateMessageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -241,3 +270,7 @@ func (UnimplementedMessageServiceHandler) UpdateMessage(context.Context, *connec
 func (UnimplementedMessageServiceHandler) DeleteMessage(context.Context, *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.MessageService.DeleteMessage is not implemented"))
 }
+
+func (UnimplementedMessageServiceHandler) RegenerateMessage(context.Context, *connect.Request[v1.RegenerateMessageRequest]) (*connect.Response[v1.RegenerateMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.MessageService.RegenerateMessage is not implemented"))
+}
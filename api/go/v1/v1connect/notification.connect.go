@@ -0,0 +1,274 @@
+// Notification API provides operations for managing outbound notification sinks within Construct.
+// A notification sink is subscribed to the task events of the daemon and notifies the user
+// through a webhook, a desktop notification or an email when one of its rules matches.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: construct/v1/notification.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/furisto/construct/api/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// NotificationServiceName is the fully-qualified name of the NotificationService service.
+	NotificationServiceName = "construct.v1.NotificationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// NotificationServiceCreateNotificationSinkProcedure is the fully-qualified name of the
+	// NotificationService's CreateNotificationSink RPC.
+	NotificationServiceCreateNotificationSinkProcedure = "/construct.v1.NotificationService/CreateNotificationSink"
+	// NotificationServiceGetNotificationSinkProcedure is the fully-qualified name of the
+	// NotificationService's GetNotificationSink RPC.
+	NotificationServiceGetNotificationSinkProcedure = "/construct.v1.NotificationService/GetNotificationSink"
+	// NotificationServiceListNotificationSinksProcedure is the fully-qualified name of the
+	// NotificationService's ListNotificationSinks RPC.
+	NotificationServiceListNotificationSinksProcedure = "/construct.v1.NotificationService/ListNotificationSinks"
+	// NotificationServiceUpdateNotificationSinkProcedure is the fully-qualified name of the
+	// NotificationService's UpdateNotificationSink RPC.
+	NotificationServiceUpdateNotificationSinkProcedure = "/construct.v1.NotificationService/UpdateNotificationSink"
+	// NotificationServiceDeleteNotificationSinkProcedure is the fully-qualified name of the
+	// NotificationService's DeleteNotificationSink RPC.
+	NotificationServiceDeleteNotificationSinkProcedure = "/construct.v1.NotificationService/DeleteNotificationSink"
+	// NotificationServiceTestNotificationSinkProcedure is the fully-qualified name of the
+	// NotificationService's TestNotificationSink RPC.
+	NotificationServiceTestNotificationSinkProcedure = "/construct.v1.NotificationService/TestNotificationSink"
+)
+
+// NotificationServiceClient is a client for the construct.v1.NotificationService service.
+type NotificationServiceClient interface {
+	// CreateNotificationSink creates a new notification sink.
+	CreateNotificationSink(context.Context, *connect.Request[v1.CreateNotificationSinkRequest]) (*connect.Response[v1.CreateNotificationSinkResponse], error)
+	// GetNotificationSink retrieves a specific notification sink by its unique identifier.
+	GetNotificationSink(context.Context, *connect.Request[v1.GetNotificationSinkRequest]) (*connect.Response[v1.GetNotificationSinkResponse], error)
+	// ListNotificationSinks retrieves a list of notification sinks with optional filtering.
+	ListNotificationSinks(context.Context, *connect.Request[v1.ListNotificationSinksRequest]) (*connect.Response[v1.ListNotificationSinksResponse], error)
+	// UpdateNotificationSink modifies an existing notification sink.
+	UpdateNotificationSink(context.Context, *connect.Request[v1.UpdateNotificationSinkRequest]) (*connect.Response[v1.UpdateNotificationSinkResponse], error)
+	// DeleteNotificationSink removes a notification sink.
+	DeleteNotificationSink(context.Context, *connect.Request[v1.DeleteNotificationSinkRequest]) (*connect.Response[v1.DeleteNotificationSinkResponse], error)
+	// TestNotificationSink sends a test notification to the sink, regardless of its rules.
+	TestNotificationSink(context.Context, *connect.Request[v1.TestNotificationSinkRequest]) (*connect.Response[v1.TestNotificationSinkResponse], error)
+}
+
+// NewNotificationServiceClient constructs a client for the construct.v1.NotificationService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NotificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	notificationServiceMethods := v1.File_construct_v1_notification_proto.Services().ByName("NotificationService").Methods()
+	return &notificationServiceClient{
+		createNotificationSink: connect.NewClient[v1.CreateNotificationSinkRequest, v1.CreateNotificationSinkResponse](
+			httpClient,
+			baseURL+NotificationServiceCreateNotificationSinkProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("CreateNotificationSink")),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationSink: connect.NewClient[v1.GetNotificationSinkRequest, v1.GetNotificationSinkResponse](
+			httpClient,
+			baseURL+NotificationServiceGetNotificationSinkProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("GetNotificationSink")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listNotificationSinks: connect.NewClient[v1.ListNotificationSinksRequest, v1.ListNotificationSinksResponse](
+			httpClient,
+			baseURL+NotificationServiceListNotificationSinksProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ListNotificationSinks")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateNotificationSink: connect.NewClient[v1.UpdateNotificationSinkRequest, v1.UpdateNotificationSinkResponse](
+			httpClient,
+			baseURL+NotificationServiceUpdateNotificationSinkProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationSink")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNotificationSink: connect.NewClient[v1.DeleteNotificationSinkRequest, v1.DeleteNotificationSinkResponse](
+			httpClient,
+			baseURL+NotificationServiceDeleteNotificationSinkProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("DeleteNotificationSink")),
+			connect.WithClientOptions(opts...),
+		),
+		testNotificationSink: connect.NewClient[v1.TestNotificationSinkRequest, v1.TestNotificationSinkResponse](
+			httpClient,
+			baseURL+NotificationServiceTestNotificationSinkProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("TestNotificationSink")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// notificationServiceClient implements NotificationServiceClient.
+type notificationServiceClient struct {
+	createNotificationSink *connect.Client[v1.CreateNotificationSinkRequest, v1.CreateNotificationSinkResponse]
+	getNotificationSink    *connect.Client[v1.GetNotificationSinkRequest, v1.GetNotificationSinkResponse]
+	listNotificationSinks  *connect.Client[v1.ListNotificationSinksRequest, v1.ListNotificationSinksResponse]
+	updateNotificationSink *connect.Client[v1.UpdateNotificationSinkRequest, v1.UpdateNotificationSinkResponse]
+	deleteNotificationSink *connect.Client[v1.DeleteNotificationSinkRequest, v1.DeleteNotificationSinkResponse]
+	testNotificationSink   *connect.Client[v1.TestNotificationSinkRequest, v1.TestNotificationSinkResponse]
+}
+
+// CreateNotificationSink calls construct.v1.NotificationService.CreateNotificationSink.
+func (c *notificationServiceClient) CreateNotificationSink(ctx context.Context, req *connect.Request[v1.CreateNotificationSinkRequest]) (*connect.Response[v1.CreateNotificationSinkResponse], error) {
+	return c.createNotificationSink.CallUnary(ctx, req)
+}
+
+// GetNotificationSink calls construct.v1.NotificationService.GetNotificationSink.
+func (c *notificationServiceClient) GetNotificationSink(ctx context.Context, req *connect.Request[v1.GetNotificationSinkRequest]) (*connect.Response[v1.GetNotificationSinkResponse], error) {
+	return c.getNotificationSink.CallUnary(ctx, req)
+}
+
+// ListNotificationSinks calls construct.v1.NotificationService.ListNotificationSinks.
+func (c *notificationServiceClient) ListNotificationSinks(ctx context.Context, req *connect.Request[v1.ListNotificationSinksRequest]) (*connect.Response[v1.ListNotificationSinksResponse], error) {
+	return c.listNotificationSinks.CallUnary(ctx, req)
+}
+
+// UpdateNotificationSink calls construct.v1.NotificationService.UpdateNotificationSink.
+func (c *notificationServiceClient) UpdateNotificationSink(ctx context.Context, req *connect.Request[v1.UpdateNotificationSinkRequest]) (*connect.Response[v1.UpdateNotificationSinkResponse], error) {
+	return c.updateNotificationSink.CallUnary(ctx, req)
+}
+
+// DeleteNotificationSink calls construct.v1.NotificationService.DeleteNotificationSink.
+func (c *notificationServiceClient) DeleteNotificationSink(ctx context.Context, req *connect.Request[v1.DeleteNotificationSinkRequest]) (*connect.Response[v1.DeleteNotificationSinkResponse], error) {
+	return c.deleteNotificationSink.CallUnary(ctx, req)
+}
+
+// TestNotificationSink calls construct.v1.NotificationService.TestNotificationSink.
+func (c *notificationServiceClient) TestNotificationSink(ctx context.Context, req *connect.Request[v1.TestNotificationSinkRequest]) (*connect.Response[v1.TestNotificationSinkResponse], error) {
+	return c.testNotificationSink.CallUnary(ctx, req)
+}
+
+// NotificationServiceHandler is an implementation of the construct.v1.NotificationService service.
+type NotificationServiceHandler interface {
+	// CreateNotificationSink creates a new notification sink.
+	CreateNotificationSink(context.Context, *connect.Request[v1.CreateNotificationSinkRequest]) (*connect.Response[v1.CreateNotificationSinkResponse], error)
+	// GetNotificationSink retrieves a specific notification sink by its unique identifier.
+	GetNotificationSink(context.Context, *connect.Request[v1.GetNotificationSinkRequest]) (*connect.Response[v1.GetNotificationSinkResponse], error)
+	// ListNotificationSinks retrieves a list of notification sinks with optional filtering.
+	ListNotificationSinks(context.Context, *connect.Request[v1.ListNotificationSinksRequest]) (*connect.Response[v1.ListNotificationSinksResponse], error)
+	// UpdateNotificationSink modifies an existing notification sink.
+	UpdateNotificationSink(context.Context, *connect.Request[v1.UpdateNotificationSinkRequest]) (*connect.Response[v1.UpdateNotificationSinkResponse], error)
+	// DeleteNotificationSink removes a notification sink.
+	DeleteNotificationSink(context.Context, *connect.Request[v1.DeleteNotificationSinkRequest]) (*connect.Response[v1.DeleteNotificationSinkResponse], error)
+	// TestNotificationSink sends a test notification to the sink, regardless of its rules.
+	TestNotificationSink(context.Context, *connect.Request[v1.TestNotificationSinkRequest]) (*connect.Response[v1.TestNotificationSinkResponse], error)
+}
+
+// NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	notificationServiceMethods := v1.File_construct_v1_notification_proto.Services().ByName("NotificationService").Methods()
+	notificationServiceCreateNotificationSinkHandler := connect.NewUnaryHandler(
+		NotificationServiceCreateNotificationSinkProcedure,
+		svc.CreateNotificationSink,
+		connect.WithSchema(notificationServiceMethods.ByName("CreateNotificationSink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceGetNotificationSinkHandler := connect.NewUnaryHandler(
+		NotificationServiceGetNotificationSinkProcedure,
+		svc.GetNotificationSink,
+		connect.WithSchema(notificationServiceMethods.ByName("GetNotificationSink")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceListNotificationSinksHandler := connect.NewUnaryHandler(
+		NotificationServiceListNotificationSinksProcedure,
+		svc.ListNotificationSinks,
+		connect.WithSchema(notificationServiceMethods.ByName("ListNotificationSinks")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceUpdateNotificationSinkHandler := connect.NewUnaryHandler(
+		NotificationServiceUpdateNotificationSinkProcedure,
+		svc.UpdateNotificationSink,
+		connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationSink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceDeleteNotificationSinkHandler := connect.NewUnaryHandler(
+		NotificationServiceDeleteNotificationSinkProcedure,
+		svc.DeleteNotificationSink,
+		connect.WithSchema(notificationServiceMethods.ByName("DeleteNotificationSink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceTestNotificationSinkHandler := connect.NewUnaryHandler(
+		NotificationServiceTestNotificationSinkProcedure,
+		svc.TestNotificationSink,
+		connect.WithSchema(notificationServiceMethods.ByName("TestNotificationSink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/construct.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NotificationServiceCreateNotificationSinkProcedure:
+			notificationServiceCreateNotificationSinkHandler.ServeHTTP(w, r)
+		case NotificationServiceGetNotificationSinkProcedure:
+			notificationServiceGetNotificationSinkHandler.ServeHTTP(w, r)
+		case NotificationServiceListNotificationSinksProcedure:
+			notificationServiceListNotificationSinksHandler.ServeHTTP(w, r)
+		case NotificationServiceUpdateNotificationSinkProcedure:
+			notificationServiceUpdateNotificationSinkHandler.ServeHTTP(w, r)
+		case NotificationServiceDeleteNotificationSinkProcedure:
+			notificationServiceDeleteNotificationSinkHandler.ServeHTTP(w, r)
+		case NotificationServiceTestNotificationSinkProcedure:
+			notificationServiceTestNotificationSinkHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNotificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNotificationServiceHandler struct{}
+
+func (UnimplementedNotificationServiceHandler) CreateNotificationSink(context.Context, *connect.Request[v1.CreateNotificationSinkRequest]) (*connect.Response[v1.CreateNotificationSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.NotificationService.CreateNotificationSink is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) GetNotificationSink(context.Context, *connect.Request[v1.GetNotificationSinkRequest]) (*connect.Response[v1.GetNotificationSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.NotificationService.GetNotificationSink is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) ListNotificationSinks(context.Context, *connect.Request[v1.ListNotificationSinksRequest]) (*connect.Response[v1.ListNotificationSinksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.NotificationService.ListNotificationSinks is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) UpdateNotificationSink(context.Context, *connect.Request[v1.UpdateNotificationSinkRequest]) (*connect.Response[v1.UpdateNotificationSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.NotificationService.UpdateNotificationSink is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) DeleteNotificationSink(context.Context, *connect.Request[v1.DeleteNotificationSinkRequest]) (*connect.Response[v1.DeleteNotificationSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.NotificationService.DeleteNotificationSink is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) TestNotificationSink(context.Context, *connect.Request[v1.TestNotificationSinkRequest]) (*connect.Response[v1.TestNotificationSinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.NotificationService.TestNotificationSink is not implemented"))
+}
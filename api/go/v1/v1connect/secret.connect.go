@@ -0,0 +1,117 @@
+// Secret API provides administrative operations for the credentials stored by Construct.
+// The credentials of model providers, webhook triggers and notification sinks are encrypted
+// with a keyset that is kept by the secret provider of the daemon.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: construct/v1/secret.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/furisto/construct/api/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SecretServiceName is the fully-qualified name of the SecretService service.
+	SecretServiceName = "construct.v1.SecretService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SecretServiceRotateEncryptionKeyProcedure is the fully-qualified name of the SecretService's
+	// RotateEncryptionKey RPC.
+	SecretServiceRotateEncryptionKeyProcedure = "/construct.v1.SecretService/RotateEncryptionKey"
+)
+
+// SecretServiceClient is a client for the construct.v1.SecretService service.
+type SecretServiceClient interface {
+	// RotateEncryptionKey adds a new primary key to the keyset, re-encrypts all stored
+	// credentials with it in a single transaction and then disables the previous keys.
+	RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error)
+}
+
+// NewSecretServiceClient constructs a client for the construct.v1.SecretService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSecretServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SecretServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	secretServiceMethods := v1.File_construct_v1_secret_proto.Services().ByName("SecretService").Methods()
+	return &secretServiceClient{
+		rotateEncryptionKey: connect.NewClient[v1.RotateEncryptionKeyRequest, v1.RotateEncryptionKeyResponse](
+			httpClient,
+			baseURL+SecretServiceRotateEncryptionKeyProcedure,
+			connect.WithSchema(secretServiceMethods.ByName("RotateEncryptionKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// secretServiceClient implements SecretServiceClient.
+type secretServiceClient struct {
+	rotateEncryptionKey *connect.Client[v1.RotateEncryptionKeyRequest, v1.RotateEncryptionKeyResponse]
+}
+
+// RotateEncryptionKey calls construct.v1.SecretService.RotateEncryptionKey.
+func (c *secretServiceClient) RotateEncryptionKey(ctx context.Context, req *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error) {
+	return c.rotateEncryptionKey.CallUnary(ctx, req)
+}
+
+// SecretServiceHandler is an implementation of the construct.v1.SecretService service.
+type SecretServiceHandler interface {
+	// RotateEncryptionKey adds a new primary key to the keyset, re-encrypts all stored
+	// credentials with it in a single transaction and then disables the previous keys.
+	RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error)
+}
+
+// NewSecretServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSecretServiceHandler(svc SecretServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	secretServiceMethods := v1.File_construct_v1_secret_proto.Services().ByName("SecretService").Methods()
+	secretServiceRotateEncryptionKeyHandler := connect.NewUnaryHandler(
+		SecretServiceRotateEncryptionKeyProcedure,
+		svc.RotateEncryptionKey,
+		connect.WithSchema(secretServiceMethods.ByName("RotateEncryptionKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/construct.v1.SecretService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SecretServiceRotateEncryptionKeyProcedure:
+			secretServiceRotateEncryptionKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSecretServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSecretServiceHandler struct{}
+
+func (UnimplementedSecretServiceHandler) RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.SecretService.RotateEncryptionKey is not implemented"))
+}
@@ -51,6 +51,28 @@ const (
 	TaskServiceSubscribeProcedure = "/construct.v1.TaskService/Subscribe"
 	// TaskServiceSuspendTaskProcedure is the fully-qualified name of the TaskService's SuspendTask RPC.
 	TaskServiceSuspendTaskProcedure = "/construct.v1.TaskService/SuspendTask"
+	// TaskServiceExportTranscriptProcedure is the fully-qualified name of the TaskService's
+	// ExportTranscript RPC.
+	TaskServiceExportTranscriptProcedure = "/construct.v1.TaskService/ExportTranscript"
+	// TaskServiceCompactTaskProcedure is the fully-qualified name of the TaskService's CompactTask RPC.
+	TaskServiceCompactTaskProcedure = "/construct.v1.TaskService/CompactTask"
+	// TaskServiceListWorkspaceFilesProcedure is the fully-qualified name of the TaskService's
+	// ListWorkspaceFiles RPC.
+	TaskServiceListWorkspaceFilesProcedure = "/construct.v1.TaskService/ListWorkspaceFiles"
+	// TaskServiceSearchWorkspaceSymbolsProcedure is the fully-qualified name of the TaskService's
+	// SearchWorkspaceSymbols RPC.
+	TaskServiceSearchWorkspaceSymbolsProcedure = "/construct.v1.TaskService/SearchWorkspaceSymbols"
+	// TaskServiceListFileChangesProcedure is the fully-qualified name of the TaskService's
+	// ListFileChanges RPC.
+	TaskServiceListFileChangesProcedure = "/construct.v1.TaskService/ListFileChanges"
+	// TaskServiceAcceptFileChangeProcedure is the fully-qualified name of the TaskService's
+	// AcceptFileChange RPC.
+	TaskServiceAcceptFileChangeProcedure = "/construct.v1.TaskService/AcceptFileChange"
+	// TaskServiceRejectFileChangeProcedure is the fully-qualified name of the TaskService's
+	// RejectFileChange RPC.
+	TaskServiceRejectFileChangeProcedure = "/construct.v1.TaskService/RejectFileChange"
+	// TaskServiceForkTaskProcedure is the fully-qualified name of the TaskService's ForkTask RPC.
+	TaskServiceForkTaskProcedure = "/construct.v1.TaskService/ForkTask"
 )
 
 // TaskServiceClient is a client for the construct.v1.TaskService service.
@@ -69,6 +91,29 @@ type TaskServiceClient interface {
 	Subscribe(context.Context, *connect.Request[v1.SubscribeRequest]) (*connect.ServerStreamForClient[v1.SubscribeResponse], error)
 	// SuspendTask suspends a task.
 	SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error)
+	// ExportTranscript renders the conversation of a task as a document that can be shared.
+	ExportTranscript(context.Context, *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error)
+	// CompactTask replaces the conversation of a task with a summary written by the model of its agent,
+	// to free up the context window. The task must not be running.
+	CompactTask(context.Context, *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error)
+	// ListWorkspaceFiles lists the files in the workspace of a task that fuzzy match a query, so that
+	// clients can attach them to messages even if the daemon runs on another machine.
+	ListWorkspaceFiles(context.Context, *connect.Request[v1.ListWorkspaceFilesRequest]) (*connect.Response[v1.ListWorkspaceFilesResponse], error)
+	// SearchWorkspaceSymbols searches the definitions of functions, types and classes in the workspace
+	// of a task. Definitions are read from a ctags file in the workspace if there is one, otherwise the
+	// source files are scanned.
+	SearchWorkspaceSymbols(context.Context, *connect.Request[v1.SearchWorkspaceSymbolsRequest]) (*connect.Response[v1.SearchWorkspaceSymbolsResponse], error)
+	// ListFileChanges lists the changes to files that are staged for review, oldest first.
+	ListFileChanges(context.Context, *connect.Request[v1.ListFileChangesRequest]) (*connect.Response[v1.ListFileChangesResponse], error)
+	// AcceptFileChange writes a pending change to the file in the workspace.
+	AcceptFileChange(context.Context, *connect.Request[v1.AcceptFileChangeRequest]) (*connect.Response[v1.AcceptFileChangeResponse], error)
+	// RejectFileChange discards a pending change, which leaves the file in the workspace as it is, and
+	// tells the agent that its change was rejected and why.
+	RejectFileChange(context.Context, *connect.Request[v1.RejectFileChangeRequest]) (*connect.Response[v1.RejectFileChangeResponse], error)
+	// ForkTask creates a new task with the same agent and workspace that continues the conversation of
+	// a task from one of its messages. The original task is left unchanged. Files in the workspace are
+	// not restored.
+	ForkTask(context.Context, *connect.Request[v1.ForkTaskRequest]) (*connect.Response[v1.ForkTaskResponse], error)
 }
 
 // NewTaskServiceClient constructs a client for the construct.v1.TaskService service. By default, it
@@ -118,6 +163,7 @@ func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+TaskServiceSubscribeProcedure,
 			connect.WithSchema(taskServiceMethods.ByName("Subscribe")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		suspendTask: connect.NewClient[v1.SuspendTaskRequest, v1.SuspendTaskResponse](
@@ -126,18 +172,78 @@ func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(taskServiceMethods.ByName("SuspendTask")),
 			connect.WithClientOptions(opts...),
 		),
+		exportTranscript: connect.NewClient[v1.ExportTranscriptRequest, v1.ExportTranscriptResponse](
+			httpClient,
+			baseURL+TaskServiceExportTranscriptProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ExportTranscript")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		compactTask: connect.NewClient[v1.CompactTaskRequest, v1.CompactTaskResponse](
+			httpClient,
+			baseURL+TaskServiceCompactTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("CompactTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listWorkspaceFiles: connect.NewClient[v1.ListWorkspaceFilesRequest, v1.ListWorkspaceFilesResponse](
+			httpClient,
+			baseURL+TaskServiceListWorkspaceFilesProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ListWorkspaceFiles")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		searchWorkspaceSymbols: connect.NewClient[v1.SearchWorkspaceSymbolsRequest, v1.SearchWorkspaceSymbolsResponse](
+			httpClient,
+			baseURL+TaskServiceSearchWorkspaceSymbolsProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("SearchWorkspaceSymbols")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listFileChanges: connect.NewClient[v1.ListFileChangesRequest, v1.ListFileChangesResponse](
+			httpClient,
+			baseURL+TaskServiceListFileChangesProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ListFileChanges")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		acceptFileChange: connect.NewClient[v1.AcceptFileChangeRequest, v1.AcceptFileChangeResponse](
+			httpClient,
+			baseURL+TaskServiceAcceptFileChangeProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("AcceptFileChange")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectFileChange: connect.NewClient[v1.RejectFileChangeRequest, v1.RejectFileChangeResponse](
+			httpClient,
+			baseURL+TaskServiceRejectFileChangeProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("RejectFileChange")),
+			connect.WithClientOptions(opts...),
+		),
+		forkTask: connect.NewClient[v1.ForkTaskRequest, v1.ForkTaskResponse](
+			httpClient,
+			baseURL+TaskServiceForkTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ForkTask")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // taskServiceClient implements TaskServiceClient.
 type taskServiceClient struct {
-	createTask  *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
-	getTask     *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
-	listTasks   *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
-	updateTask  *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
-	deleteTask  *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
-	subscribe   *connect.Client[v1.SubscribeRequest, v1.SubscribeResponse]
-	suspendTask *connect.Client[v1.SuspendTaskRequest, v1.SuspendTaskResponse]
+	createTask             *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	getTask                *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	listTasks              *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	updateTask             *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	deleteTask             *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	subscribe              *connect.Client[v1.SubscribeRequest, v1.SubscribeResponse]
+	suspendTask            *connect.Client[v1.SuspendTaskRequest, v1.SuspendTaskResponse]
+	exportTranscript       *connect.Client[v1.ExportTranscriptRequest, v1.ExportTranscriptResponse]
+	compactTask            *connect.Client[v1.CompactTaskRequest, v1.CompactTaskResponse]
+	listWorkspaceFiles     *connect.Client[v1.ListWorkspaceFilesRequest, v1.ListWorkspaceFilesResponse]
+	searchWorkspaceSymbols *connect.Client[v1.SearchWorkspaceSymbolsRequest, v1.SearchWorkspaceSymbolsResponse]
+	listFileChanges        *connect.Client[v1.ListFileChangesRequest, v1.ListFileChangesResponse]
+	acceptFileChange       *connect.Client[v1.AcceptFileChangeRequest, v1.AcceptFileChangeResponse]
+	rejectFileChange       *connect.Client[v1.RejectFileChangeRequest, v1.RejectFileChangeResponse]
+	forkTask               *connect.Client[v1.ForkTaskRequest, v1.ForkTaskResponse]
 }
 
 // CreateTask calls construct.v1.TaskService.CreateTask.
@@ -175,6 +281,46 @@ func (c *taskServiceClient) SuspendTask(ctx context.Context, req *connect.Reques
 	return c.suspendTask.CallUnary(ctx, req)
 }
 
+// ExportTranscript calls construct.v1.TaskService.ExportTranscript.
+func (c *taskServiceClient) ExportTranscript(ctx context.Context, req *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error) {
+	return c.exportTranscript.CallUnary(ctx, req)
+}
+
+// CompactTask calls construct.v1.TaskService.CompactTask.
+func (c *taskServiceClient) CompactTask(ctx context.Context, req *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error) {
+	return c.compactTask.CallUnary(ctx, req)
+}
+
+// ListWorkspaceFiles calls construct.v1.TaskService.ListWorkspaceFiles.
+func (c *taskServiceClient) ListWorkspaceFiles(ctx context.Context, req *connect.Request[v1.ListWorkspaceFilesRequest]) (*connect.Response[v1.ListWorkspaceFilesResponse], error) {
+	return c.listWorkspaceFiles.CallUnary(ctx, req)
+}
+
+// SearchWorkspaceSymbols calls construct.v1.TaskService.SearchWorkspaceSymbols.
+func (c *taskServiceClient) SearchWorkspaceSymbols(ctx context.Context, req *connect.Request[v1.SearchWorkspaceSymbolsRequest]) (*connect.Response[v1.SearchWorkspaceSymbolsResponse], error) {
+	return c.searchWorkspaceSymbols.CallUnary(ctx, req)
+}
+
+// ListFileChanges calls construct.v1.TaskService.ListFileChanges.
+func (c *taskServiceClient) ListFileChanges(ctx context.Context, req *connect.Request[v1.ListFileChangesRequest]) (*connect.Response[v1.ListFileChangesResponse], error) {
+	return c.listFileChanges.CallUnary(ctx, req)
+}
+
+// AcceptFileChange calls construct.v1.TaskService.AcceptFileChange.
+func (c *taskServiceClient) AcceptFileChange(ctx context.Context, req *connect.Request[v1.AcceptFileChangeRequest]) (*connect.Response[v1.AcceptFileChangeResponse], error) {
+	return c.acceptFileChange.CallUnary(ctx, req)
+}
+
+// RejectFileChange calls construct.v1.TaskService.RejectFileChange.
+func (c *taskServiceClient) RejectFileChange(ctx context.Context, req *connect.Request[v1.RejectFileChangeRequest]) (*connect.Response[v1.RejectFileChangeResponse], error) {
+	return c.rejectFileChange.CallUnary(ctx, req)
+}
+
+// ForkTask calls construct.v1.TaskService.ForkTask.
+func (c *taskServiceClient) ForkTask(ctx context.Context, req *connect.Request[v1.ForkTaskRequest]) (*connect.Response[v1.ForkTaskResponse], error) {
+	return c.forkTask.CallUnary(ctx, req)
+}
+
 // TaskServiceHandler is an implementation of the construct.v1.TaskService service.
 type TaskServiceHandler interface {
 	// CreateTask creates a new task for an agent to execute in a specified project directory.
@@ -191,6 +337,29 @@ type TaskServiceHandler interface {
 	Subscribe(context.Context, *connect.Request[v1.SubscribeRequest], *connect.ServerStream[v1.SubscribeResponse]) error
 	// SuspendTask suspends a task.
 	SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error)
+	// ExportTranscript renders the conversation of a task as a document that can be shared.
+	ExportTranscript(context.Context, *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error)
+	// CompactTask replaces the conversation of a task with a summary written by the model of its agent,
+	// to free up the context window. The task must not be running.
+	CompactTask(context.Context, *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error)
+	// ListWorkspaceFiles lists the files in the workspace of a task that fuzzy match a query, so that
+	// clients can attach them to messages even if the daemon runs on another machine.
+	ListWorkspaceFiles(context.Context, *connect.Request[v1.ListWorkspaceFilesRequest]) (*connect.Response[v1.ListWorkspaceFilesResponse], error)
+	// SearchWorkspaceSymbols searches the definitions of functions, types and classes in the workspace
+	// of a task. Definitions are read from a ctags file in the workspace if there is one, otherwise the
+	// source files are scanned.
+	SearchWorkspaceSymbols(context.Context, *connect.Request[v1.SearchWorkspaceSymbolsRequest]) (*connect.Response[v1.SearchWorkspaceSymbolsResponse], error)
+	// ListFileChanges lists the changes to files that are staged for review, oldest first.
+	ListFileChanges(context.Context, *connect.Request[v1.ListFileChangesRequest]) (*connect.Response[v1.ListFileChangesResponse], error)
+	// AcceptFileChange writes a pending change to the file in the workspace.
+	AcceptFileChange(context.Context, *connect.Request[v1.AcceptFileChangeRequest]) (*connect.Response[v1.AcceptFileChangeResponse], error)
+	// RejectFileChange discards a pending change, which leaves the file in the workspace as it is, and
+	// tells the agent that its change was rejected and why.
+	RejectFileChange(context.Context, *connect.Request[v1.RejectFileChangeRequest]) (*connect.Response[v1.RejectFileChangeResponse], error)
+	// ForkTask creates a new task with the same agent and workspace that continues the conversation of
+	// a task from one of its messages. The original task is left unchanged. Files in the workspace are
+	// not restored.
+	ForkTask(context.Context, *connect.Request[v1.ForkTaskRequest]) (*connect.Response[v1.ForkTaskResponse], error)
 }
 
 // NewTaskServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -236,6 +405,7 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 		TaskServiceSubscribeProcedure,
 		svc.Subscribe,
 		connect.WithSchema(taskServiceMethods.ByName("Subscribe")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	taskServiceSuspendTaskHandler := connect.NewUnaryHandler(
@@ -244,6 +414,58 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(taskServiceMethods.ByName("SuspendTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	taskServiceExportTranscriptHandler := connect.NewUnaryHandler(
+		TaskServiceExportTranscriptProcedure,
+		svc.ExportTranscript,
+		connect.WithSchema(taskServiceMethods.ByName("ExportTranscript")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceCompactTaskHandler := connect.NewUnaryHandler(
+		TaskServiceCompactTaskProcedure,
+		svc.CompactTask,
+		connect.WithSchema(taskServiceMethods.ByName("CompactTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceListWorkspaceFilesHandler := connect.NewUnaryHandler(
+		TaskServiceListWorkspaceFilesProcedure,
+		svc.ListWorkspaceFiles,
+		connect.WithSchema(taskServiceMethods.ByName("ListWorkspaceFiles")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceSearchWorkspaceSymbolsHandler := connect.NewUnaryHandler(
+		TaskServiceSearchWorkspaceSymbolsProcedure,
+		svc.SearchWorkspaceSymbols,
+		connect.WithSchema(taskServiceMethods.ByName("SearchWorkspaceSymbols")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceListFileChangesHandler := connect.NewUnaryHandler(
+		TaskServiceListFileChangesProcedure,
+		svc.ListFileChanges,
+		connect.WithSchema(taskServiceMethods.ByName("ListFileChanges")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceAcceptFileChangeHandler := connect.NewUnaryHandler(
+		TaskServiceAcceptFileChangeProcedure,
+		svc.AcceptFileChange,
+		connect.WithSchema(taskServiceMethods.ByName("AcceptFileChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceRejectFileChangeHandler := connect.NewUnaryHandler(
+		TaskServiceRejectFileChangeProcedure,
+		svc.RejectFileChange,
+		connect.WithSchema(taskServiceMethods.ByName("RejectFileChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceForkTaskHandler := connect.NewUnaryHandler(
+		TaskServiceForkTaskProcedure,
+		svc.ForkTask,
+		connect.WithSchema(taskServiceMethods.ByName("ForkTask")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/construct.v1.TaskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TaskServiceCreateTaskProcedure:
@@ -260,6 +482,22 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 			taskServiceSubscribeHandler.ServeHTTP(w, r)
 		case TaskServiceSuspendTaskProcedure:
 			taskServiceSuspendTaskHandler.ServeHTTP(w, r)
+		case TaskServiceExportTranscriptProcedure:
+			taskServiceExportTranscriptHandler.ServeHTTP(w, r)
+		case TaskServiceCompactTaskProcedure:
+			taskServiceCompactTaskHandler.ServeHTTP(w, r)
+		case TaskServiceListWorkspaceFilesProcedure:
+			taskServiceListWorkspaceFilesHandler.ServeHTTP(w, r)
+		case TaskServiceSearchWorkspaceSymbolsProcedure:
+			taskServiceSearchWorkspaceSymbolsHandler.ServeHTTP(w, r)
+		case TaskServiceListFileChangesProcedure:
+			taskServiceListFileChangesHandler.ServeHTTP(w, r)
+		case TaskServiceAcceptFileChangeProcedure:
+			taskServiceAcceptFileChangeHandler.ServeHTTP(w, r)
+		case TaskServiceRejectFileChangeProcedure:
+			taskServiceRejectFileChangeHandler.ServeHTTP(w, r)
+		case TaskServiceForkTaskProcedure:
+			taskServiceForkTaskHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -296,3 +534,35 @@ func (UnimplementedTaskServiceHandler) Subscribe(context.Context, *connect.Reque
 func (UnimplementedTaskServiceHandler) SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.SuspendTask is not implemented"))
 }
+
+func (UnimplementedTaskServiceHandler) ExportTranscript(context.Context, *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.ExportTranscript is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) CompactTask(context.Context, *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.CompactTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ListWorkspaceFiles(context.Context, *connect.Request[v1.ListWorkspaceFilesRequest]) (*connect.Response[v1.ListWorkspaceFilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.ListWorkspaceFiles is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) SearchWorkspaceSymbols(context.Context, *connect.Request[v1.SearchWorkspaceSymbolsRequest]) (*connect.Response[v1.SearchWorkspaceSymbolsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.SearchWorkspaceSymbols is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ListFileChanges(context.Context, *connect.Request[v1.ListFileChangesRequest]) (*connect.Response[v1.ListFileChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.ListFileChanges is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) AcceptFileChange(context.Context, *connect.Request[v1.AcceptFileChangeRequest]) (*connect.Response[v1.AcceptFileChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.AcceptFileChange is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) RejectFileChange(context.Context, *connect.Request[v1.RejectFileChangeRequest]) (*connect.Response[v1.RejectFileChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.RejectFileChange is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ForkTask(context.Context, *connect.Request[v1.ForkTaskRequest]) (*connect.Response[v1.ForkTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.ForkTask is not implemented"))
+}
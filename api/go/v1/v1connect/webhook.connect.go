@@ -0,0 +1,280 @@
+// Webhook API provides operations for managing inbound webhook triggers within Construct.
+// A webhook trigger accepts signed JSON payloads on the daemon's HTTP endpoint and turns them
+// into a new task or a message on an existing task through a prompt template.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: construct/v1/webhook.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/furisto/construct/api/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "construct.v1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceCreateWebhookTriggerProcedure is the fully-qualified name of the WebhookService's
+	// CreateWebhookTrigger RPC.
+	WebhookServiceCreateWebhookTriggerProcedure = "/construct.v1.WebhookService/CreateWebhookTrigger"
+	// WebhookServiceGetWebhookTriggerProcedure is the fully-qualified name of the WebhookService's
+	// GetWebhookTrigger RPC.
+	WebhookServiceGetWebhookTriggerProcedure = "/construct.v1.WebhookService/GetWebhookTrigger"
+	// WebhookServiceListWebhookTriggersProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhookTriggers RPC.
+	WebhookServiceListWebhookTriggersProcedure = "/construct.v1.WebhookService/ListWebhookTriggers"
+	// WebhookServiceUpdateWebhookTriggerProcedure is the fully-qualified name of the WebhookService's
+	// UpdateWebhookTrigger RPC.
+	WebhookServiceUpdateWebhookTriggerProcedure = "/construct.v1.WebhookService/UpdateWebhookTrigger"
+	// WebhookServiceDeleteWebhookTriggerProcedure is the fully-qualified name of the WebhookService's
+	// DeleteWebhookTrigger RPC.
+	WebhookServiceDeleteWebhookTriggerProcedure = "/construct.v1.WebhookService/DeleteWebhookTrigger"
+	// WebhookServiceListWebhookDeliveriesProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhookDeliveries RPC.
+	WebhookServiceListWebhookDeliveriesProcedure = "/construct.v1.WebhookService/ListWebhookDeliveries"
+)
+
+// WebhookServiceClient is a client for the construct.v1.WebhookService service.
+type WebhookServiceClient interface {
+	// CreateWebhookTrigger creates a new webhook trigger. The signing secret is only returned
+	// in the response of this call.
+	CreateWebhookTrigger(context.Context, *connect.Request[v1.CreateWebhookTriggerRequest]) (*connect.Response[v1.CreateWebhookTriggerResponse], error)
+	// GetWebhookTrigger retrieves a specific webhook trigger by its unique identifier.
+	GetWebhookTrigger(context.Context, *connect.Request[v1.GetWebhookTriggerRequest]) (*connect.Response[v1.GetWebhookTriggerResponse], error)
+	// ListWebhookTriggers retrieves a list of webhook triggers with optional filtering.
+	ListWebhookTriggers(context.Context, *connect.Request[v1.ListWebhookTriggersRequest]) (*connect.Response[v1.ListWebhookTriggersResponse], error)
+	// UpdateWebhookTrigger modifies an existing webhook trigger.
+	UpdateWebhookTrigger(context.Context, *connect.Request[v1.UpdateWebhookTriggerRequest]) (*connect.Response[v1.UpdateWebhookTriggerResponse], error)
+	// DeleteWebhookTrigger removes a webhook trigger and its delivery log. Tasks created by the
+	// trigger are kept.
+	DeleteWebhookTrigger(context.Context, *connect.Request[v1.DeleteWebhookTriggerRequest]) (*connect.Response[v1.DeleteWebhookTriggerResponse], error)
+	// ListWebhookDeliveries retrieves the delivery log of a webhook trigger, newest first.
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+}
+
+// NewWebhookServiceClient constructs a client for the construct.v1.WebhookService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	webhookServiceMethods := v1.File_construct_v1_webhook_proto.Services().ByName("WebhookService").Methods()
+	return &webhookServiceClient{
+		createWebhookTrigger: connect.NewClient[v1.CreateWebhookTriggerRequest, v1.CreateWebhookTriggerResponse](
+			httpClient,
+			baseURL+WebhookServiceCreateWebhookTriggerProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("CreateWebhookTrigger")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhookTrigger: connect.NewClient[v1.GetWebhookTriggerRequest, v1.GetWebhookTriggerResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookTriggerProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetWebhookTrigger")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookTriggers: connect.NewClient[v1.ListWebhookTriggersRequest, v1.ListWebhookTriggersResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookTriggersProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookTriggers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhookTrigger: connect.NewClient[v1.UpdateWebhookTriggerRequest, v1.UpdateWebhookTriggerResponse](
+			httpClient,
+			baseURL+WebhookServiceUpdateWebhookTriggerProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("UpdateWebhookTrigger")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookTrigger: connect.NewClient[v1.DeleteWebhookTriggerRequest, v1.DeleteWebhookTriggerResponse](
+			httpClient,
+			baseURL+WebhookServiceDeleteWebhookTriggerProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhookTrigger")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	createWebhookTrigger  *connect.Client[v1.CreateWebhookTriggerRequest, v1.CreateWebhookTriggerResponse]
+	getWebhookTrigger     *connect.Client[v1.GetWebhookTriggerRequest, v1.GetWebhookTriggerResponse]
+	listWebhookTriggers   *connect.Client[v1.ListWebhookTriggersRequest, v1.ListWebhookTriggersResponse]
+	updateWebhookTrigger  *connect.Client[v1.UpdateWebhookTriggerRequest, v1.UpdateWebhookTriggerResponse]
+	deleteWebhookTrigger  *connect.Client[v1.DeleteWebhookTriggerRequest, v1.DeleteWebhookTriggerResponse]
+	listWebhookDeliveries *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+}
+
+// CreateWebhookTrigger calls construct.v1.WebhookService.CreateWebhookTrigger.
+func (c *webhookServiceClient) CreateWebhookTrigger(ctx context.Context, req *connect.Request[v1.CreateWebhookTriggerRequest]) (*connect.Response[v1.CreateWebhookTriggerResponse], error) {
+	return c.createWebhookTrigger.CallUnary(ctx, req)
+}
+
+// GetWebhookTrigger calls construct.v1.WebhookService.GetWebhookTrigger.
+func (c *webhookServiceClient) GetWebhookTrigger(ctx context.Context, req *connect.Request[v1.GetWebhookTriggerRequest]) (*connect.Response[v1.GetWebhookTriggerResponse], error) {
+	return c.getWebhookTrigger.CallUnary(ctx, req)
+}
+
+// ListWebhookTriggers calls construct.v1.WebhookService.ListWebhookTriggers.
+func (c *webhookServiceClient) ListWebhookTriggers(ctx context.Context, req *connect.Request[v1.ListWebhookTriggersRequest]) (*connect.Response[v1.ListWebhookTriggersResponse], error) {
+	return c.listWebhookTriggers.CallUnary(ctx, req)
+}
+
+// UpdateWebhookTrigger calls construct.v1.WebhookService.UpdateWebhookTrigger.
+func (c *webhookServiceClient) UpdateWebhookTrigger(ctx context.Context, req *connect.Request[v1.UpdateWebhookTriggerRequest]) (*connect.Response[v1.UpdateWebhookTriggerResponse], error) {
+	return c.updateWebhookTrigger.CallUnary(ctx, req)
+}
+
+// DeleteWebhookTrigger calls construct.v1.WebhookService.DeleteWebhookTrigger.
+func (c *webhookServiceClient) DeleteWebhookTrigger(ctx context.Context, req *connect.Request[v1.DeleteWebhookTriggerRequest]) (*connect.Response[v1.DeleteWebhookTriggerResponse], error) {
+	return c.deleteWebhookTrigger.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls construct.v1.WebhookService.ListWebhookDeliveries.
+func (c *webhookServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the construct.v1.WebhookService service.
+type WebhookServiceHandler interface {
+	// CreateWebhookTrigger creates a new webhook trigger. The signing secret is only returned
+	// in the response of this call.
+	CreateWebhookTrigger(context.Context, *connect.Request[v1.CreateWebhookTriggerRequest]) (*connect.Response[v1.CreateWebhookTriggerResponse], error)
+	// GetWebhookTrigger retrieves a specific webhook trigger by its unique identifier.
+	GetWebhookTrigger(context.Context, *connect.Request[v1.GetWebhookTriggerRequest]) (*connect.Response[v1.GetWebhookTriggerResponse], error)
+	// ListWebhookTriggers retrieves a list of webhook triggers with optional filtering.
+	ListWebhookTriggers(context.Context, *connect.Request[v1.ListWebhookTriggersRequest]) (*connect.Response[v1.ListWebhookTriggersResponse], error)
+	// UpdateWebhookTrigger modifies an existing webhook trigger.
+	UpdateWebhookTrigger(context.Context, *connect.Request[v1.UpdateWebhookTriggerRequest]) (*connect.Response[v1.UpdateWebhookTriggerResponse], error)
+	// DeleteWebhookTrigger removes a webhook trigger and its delivery log. Tasks created by the
+	// trigger are kept.
+	DeleteWebhookTrigger(context.Context, *connect.Request[v1.DeleteWebhookTriggerRequest]) (*connect.Response[v1.DeleteWebhookTriggerResponse], error)
+	// ListWebhookDeliveries retrieves the delivery log of a webhook trigger, newest first.
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceMethods := v1.File_construct_v1_webhook_proto.Services().ByName("WebhookService").Methods()
+	webhookServiceCreateWebhookTriggerHandler := connect.NewUnaryHandler(
+		WebhookServiceCreateWebhookTriggerProcedure,
+		svc.CreateWebhookTrigger,
+		connect.WithSchema(webhookServiceMethods.ByName("CreateWebhookTrigger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetWebhookTriggerHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookTriggerProcedure,
+		svc.GetWebhookTrigger,
+		connect.WithSchema(webhookServiceMethods.ByName("GetWebhookTrigger")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookTriggersHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookTriggersProcedure,
+		svc.ListWebhookTriggers,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookTriggers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceUpdateWebhookTriggerHandler := connect.NewUnaryHandler(
+		WebhookServiceUpdateWebhookTriggerProcedure,
+		svc.UpdateWebhookTrigger,
+		connect.WithSchema(webhookServiceMethods.ByName("UpdateWebhookTrigger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteWebhookTriggerHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteWebhookTriggerProcedure,
+		svc.DeleteWebhookTrigger,
+		connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhookTrigger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/construct.v1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceCreateWebhookTriggerProcedure:
+			webhookServiceCreateWebhookTriggerHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookTriggerProcedure:
+			webhookServiceGetWebhookTriggerHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookTriggersProcedure:
+			webhookServiceListWebhookTriggersHandler.ServeHTTP(w, r)
+		case WebhookServiceUpdateWebhookTriggerProcedure:
+			webhookServiceUpdateWebhookTriggerHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteWebhookTriggerProcedure:
+			webhookServiceDeleteWebhookTriggerHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookDeliveriesProcedure:
+			webhookServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) CreateWebhookTrigger(context.Context, *connect.Request[v1.CreateWebhookTriggerRequest]) (*connect.Response[v1.CreateWebhookTriggerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.WebhookService.CreateWebhookTrigger is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetWebhookTrigger(context.Context, *connect.Request[v1.GetWebhookTriggerRequest]) (*connect.Response[v1.GetWebhookTriggerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.WebhookService.GetWebhookTrigger is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookTriggers(context.Context, *connect.Request[v1.ListWebhookTriggersRequest]) (*connect.Response[v1.ListWebhookTriggersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.WebhookService.ListWebhookTriggers is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) UpdateWebhookTrigger(context.Context, *connect.Request[v1.UpdateWebhookTriggerRequest]) (*connect.Response[v1.UpdateWebhookTriggerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.WebhookService.UpdateWebhookTrigger is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteWebhookTrigger(context.Context, *connect.Request[v1.DeleteWebhookTriggerRequest]) (*connect.Response[v1.DeleteWebhookTriggerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.WebhookService.DeleteWebhookTrigger is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.WebhookService.ListWebhookDeliveries is not implemented"))
+}
@@ -0,0 +1,1498 @@
+// Webhook API provides operations for managing inbound webhook triggers within Construct.
+// A webhook trigger accepts signed JSON payloads on the daemon's HTTP endpoint and turns them
+// into a new task or a message on an existing task through a prompt template.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/webhook.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WebhookTarget decides what a delivery creates.
+type WebhookTarget int32
+
+const (
+	// WEBHOOK_TARGET_UNSPECIFIED indicates an unset target. The server defaults to a new task.
+	WebhookTarget_WEBHOOK_TARGET_UNSPECIFIED WebhookTarget = 0
+	// WEBHOOK_TARGET_NEW_TASK starts a new task for every delivery.
+	WebhookTarget_WEBHOOK_TARGET_NEW_TASK WebhookTarget = 1
+	// WEBHOOK_TARGET_MESSAGE adds a message to the task rendered by the task ID template.
+	WebhookTarget_WEBHOOK_TARGET_MESSAGE WebhookTarget = 2
+)
+
+// Enum value maps for WebhookTarget.
+var (
+	WebhookTarget_name = map[int32]string{
+		0: "WEBHOOK_TARGET_UNSPECIFIED",
+		1: "WEBHOOK_TARGET_NEW_TASK",
+		2: "WEBHOOK_TARGET_MESSAGE",
+	}
+	WebhookTarget_value = map[string]int32{
+		"WEBHOOK_TARGET_UNSPECIFIED": 0,
+		"WEBHOOK_TARGET_NEW_TASK":    1,
+		"WEBHOOK_TARGET_MESSAGE":     2,
+	}
+)
+
+func (x WebhookTarget) Enum() *WebhookTarget {
+	p := new(WebhookTarget)
+	*p = x
+	return p
+}
+
+func (x WebhookTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_webhook_proto_enumTypes[0].Descriptor()
+}
+
+func (WebhookTarget) Type() protoreflect.EnumType {
+	return &file_construct_v1_webhook_proto_enumTypes[0]
+}
+
+func (x WebhookTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookTarget.Descriptor instead.
+func (WebhookTarget) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+// WebhookDeliveryStatus is the outcome of a delivery.
+type WebhookDeliveryStatus int32
+
+const (
+	// WEBHOOK_DELIVERY_STATUS_UNSPECIFIED indicates an unknown status.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	// WEBHOOK_DELIVERY_STATUS_ACCEPTED indicates a task or message was created.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_ACCEPTED WebhookDeliveryStatus = 1
+	// WEBHOOK_DELIVERY_STATUS_REJECTED indicates the delivery was malformed or the trigger refused it.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_REJECTED WebhookDeliveryStatus = 2
+	// WEBHOOK_DELIVERY_STATUS_FAILED indicates the delivery was valid but could not be processed.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_ACCEPTED",
+		2: "WEBHOOK_DELIVERY_STATUS_REJECTED",
+		3: "WEBHOOK_DELIVERY_STATUS_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_ACCEPTED":    1,
+		"WEBHOOK_DELIVERY_STATUS_REJECTED":    2,
+		"WEBHOOK_DELIVERY_STATUS_FAILED":      3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_webhook_proto_enumTypes[1].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_construct_v1_webhook_proto_enumTypes[1]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+// WebhookTrigger represents a complete webhook trigger entity with metadata and specification.
+type WebhookTrigger struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metadata contains system-managed and immutable information about the trigger.
+	Metadata *WebhookTriggerMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// spec contains the user-configurable specification of the trigger.
+	Spec          *WebhookTriggerSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookTrigger) Reset() {
+	*x = WebhookTrigger{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookTrigger) ProtoMessage() {}
+
+func (x *WebhookTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookTrigger.ProtoReflect.Descriptor instead.
+func (*WebhookTrigger) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebhookTrigger) GetMetadata() *WebhookTriggerMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *WebhookTrigger) GetSpec() *WebhookTriggerSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+// WebhookTriggerMetadata contains system-managed, immutable information about a webhook trigger.
+type WebhookTriggerMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier for the trigger (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is the timestamp when the trigger was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the trigger was last modified.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookTriggerMetadata) Reset() {
+	*x = WebhookTriggerMetadata{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookTriggerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookTriggerMetadata) ProtoMessage() {}
+
+func (x *WebhookTriggerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookTriggerMetadata.ProtoReflect.Descriptor instead.
+func (*WebhookTriggerMetadata) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebhookTriggerMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookTriggerMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookTriggerMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WebhookTriggerSpec defines the user-configurable specification of a webhook trigger.
+type WebhookTriggerSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the trigger. It is part of the delivery URL.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description is a human-readable explanation of the trigger's purpose.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// target decides whether a delivery starts a new task or adds a message to an existing one.
+	Target WebhookTarget `protobuf:"varint,3,opt,name=target,proto3,enum=construct.v1.WebhookTarget" json:"target,omitempty"`
+	// agent_id references the agent that executes new tasks (UUID format, required for new tasks).
+	AgentId *string `protobuf:"bytes,4,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// workspace is the file system path where new tasks will be executed.
+	Workspace string `protobuf:"bytes,5,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	// prompt_template is a Go text/template rendered into the user message of every delivery.
+	PromptTemplate string `protobuf:"bytes,6,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	// task_id_template is a Go text/template that renders the ID of the task a message is added to.
+	TaskIdTemplate string `protobuf:"bytes,7,opt,name=task_id_template,json=taskIdTemplate,proto3" json:"task_id_template,omitempty"`
+	// signature_header is the HTTP header carrying the hex encoded HMAC-SHA256 signature of the body.
+	SignatureHeader string `protobuf:"bytes,8,opt,name=signature_header,json=signatureHeader,proto3" json:"signature_header,omitempty"`
+	// enabled indicates whether the trigger accepts deliveries.
+	Enabled       bool `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookTriggerSpec) Reset() {
+	*x = WebhookTriggerSpec{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookTriggerSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookTriggerSpec) ProtoMessage() {}
+
+func (x *WebhookTriggerSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookTriggerSpec.ProtoReflect.Descriptor instead.
+func (*WebhookTriggerSpec) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookTriggerSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookTriggerSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookTriggerSpec) GetTarget() WebhookTarget {
+	if x != nil {
+		return x.Target
+	}
+	return WebhookTarget_WEBHOOK_TARGET_UNSPECIFIED
+}
+
+func (x *WebhookTriggerSpec) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *WebhookTriggerSpec) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *WebhookTriggerSpec) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *WebhookTriggerSpec) GetTaskIdTemplate() string {
+	if x != nil {
+		return x.TaskIdTemplate
+	}
+	return ""
+}
+
+func (x *WebhookTriggerSpec) GetSignatureHeader() string {
+	if x != nil {
+		return x.SignatureHeader
+	}
+	return ""
+}
+
+func (x *WebhookTriggerSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// WebhookDelivery is a single entry in the delivery log of a webhook trigger. Only deliveries with a
+// valid signature are recorded.
+type WebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier for the delivery (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// trigger_id references the trigger that received the delivery (UUID format).
+	TriggerId string `protobuf:"bytes,2,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	// task_id references the task the delivery created or added a message to (unset if none).
+	TaskId *string `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	// status is the outcome of the delivery.
+	Status WebhookDeliveryStatus `protobuf:"varint,4,opt,name=status,proto3,enum=construct.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	// status_code is the HTTP status code returned to the sender.
+	StatusCode int32 `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// error describes why the delivery was rejected or failed.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// payload is the raw request body of the delivery.
+	Payload []byte `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	// created_at is the time the delivery was received.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateWebhookTriggerRequest contains the parameters needed to create a new webhook trigger.
+type CreateWebhookTriggerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the trigger.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description is a human-readable explanation of the trigger's purpose.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// target decides whether a delivery starts a new task or adds a message to an existing one.
+	Target WebhookTarget `protobuf:"varint,3,opt,name=target,proto3,enum=construct.v1.WebhookTarget" json:"target,omitempty"`
+	// agent_id references the agent that executes new tasks (UUID format, required for new tasks).
+	AgentId *string `protobuf:"bytes,4,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// workspace is the file system path where new tasks will be executed.
+	Workspace string `protobuf:"bytes,5,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	// prompt_template is a Go text/template rendered into the user message of every delivery.
+	PromptTemplate string `protobuf:"bytes,6,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	// task_id_template renders the ID of the task a message is added to (required for messages).
+	TaskIdTemplate string `protobuf:"bytes,7,opt,name=task_id_template,json=taskIdTemplate,proto3" json:"task_id_template,omitempty"`
+	// secret is the shared secret used to sign deliveries. A random secret is generated if empty.
+	Secret string `protobuf:"bytes,8,opt,name=secret,proto3" json:"secret,omitempty"`
+	// signature_header is the HTTP header carrying the signature. Defaults to X-Construct-Signature.
+	SignatureHeader string `protobuf:"bytes,9,opt,name=signature_header,json=signatureHeader,proto3" json:"signature_header,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateWebhookTriggerRequest) Reset() {
+	*x = CreateWebhookTriggerRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookTriggerRequest) ProtoMessage() {}
+
+func (x *CreateWebhookTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookTriggerRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateWebhookTriggerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWebhookTriggerRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateWebhookTriggerRequest) GetTarget() WebhookTarget {
+	if x != nil {
+		return x.Target
+	}
+	return WebhookTarget_WEBHOOK_TARGET_UNSPECIFIED
+}
+
+func (x *CreateWebhookTriggerRequest) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *CreateWebhookTriggerRequest) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *CreateWebhookTriggerRequest) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *CreateWebhookTriggerRequest) GetTaskIdTemplate() string {
+	if x != nil {
+		return x.TaskIdTemplate
+	}
+	return ""
+}
+
+func (x *CreateWebhookTriggerRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookTriggerRequest) GetSignatureHeader() string {
+	if x != nil {
+		return x.SignatureHeader
+	}
+	return ""
+}
+
+// CreateWebhookTriggerResponse contains the newly created webhook trigger.
+type CreateWebhookTriggerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// trigger is the newly created webhook trigger.
+	Trigger *WebhookTrigger `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// secret is the shared secret used to sign deliveries. It cannot be retrieved later.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookTriggerResponse) Reset() {
+	*x = CreateWebhookTriggerResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookTriggerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookTriggerResponse) ProtoMessage() {}
+
+func (x *CreateWebhookTriggerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookTriggerResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookTriggerResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateWebhookTriggerResponse) GetTrigger() *WebhookTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *CreateWebhookTriggerResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// GetWebhookTriggerRequest specifies which webhook trigger to retrieve.
+type GetWebhookTriggerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the trigger to retrieve (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookTriggerRequest) Reset() {
+	*x = GetWebhookTriggerRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookTriggerRequest) ProtoMessage() {}
+
+func (x *GetWebhookTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookTriggerRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWebhookTriggerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetWebhookTriggerResponse contains the requested webhook trigger.
+type GetWebhookTriggerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// trigger is the requested webhook trigger.
+	Trigger       *WebhookTrigger `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookTriggerResponse) Reset() {
+	*x = GetWebhookTriggerResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookTriggerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookTriggerResponse) ProtoMessage() {}
+
+func (x *GetWebhookTriggerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookTriggerResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookTriggerResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetWebhookTriggerResponse) GetTrigger() *WebhookTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+// ListWebhookTriggersRequest specifies parameters for listing webhook triggers with optional filtering.
+type ListWebhookTriggersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter *ListWebhookTriggersRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size limits the number of triggers returned per page (1-100).
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// page_token is used for pagination to retrieve subsequent pages.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookTriggersRequest) Reset() {
+	*x = ListWebhookTriggersRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookTriggersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookTriggersRequest) ProtoMessage() {}
+
+func (x *ListWebhookTriggersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookTriggersRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookTriggersRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListWebhookTriggersRequest) GetFilter() *ListWebhookTriggersRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListWebhookTriggersRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookTriggersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListWebhookTriggersResponse contains the list of webhook triggers matching the request criteria.
+type ListWebhookTriggersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// triggers is the list of webhook triggers matching the filter criteria.
+	Triggers []*WebhookTrigger `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	// next_page_token is used to retrieve the next page of results (empty if no more pages).
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookTriggersResponse) Reset() {
+	*x = ListWebhookTriggersResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookTriggersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookTriggersResponse) ProtoMessage() {}
+
+func (x *ListWebhookTriggersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookTriggersResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookTriggersResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListWebhookTriggersResponse) GetTriggers() []*WebhookTrigger {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+func (x *ListWebhookTriggersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateWebhookTriggerRequest specifies which webhook trigger to update and the new values for its fields.
+type UpdateWebhookTriggerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the trigger to update (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// description is the new description (optional).
+	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// agent_id is the new agent executing new tasks (UUID format, optional).
+	AgentId *string `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// workspace is the new workspace of new tasks (optional).
+	Workspace *string `protobuf:"bytes,4,opt,name=workspace,proto3,oneof" json:"workspace,omitempty"`
+	// prompt_template is the new prompt template (optional).
+	PromptTemplate *string `protobuf:"bytes,5,opt,name=prompt_template,json=promptTemplate,proto3,oneof" json:"prompt_template,omitempty"`
+	// task_id_template is the new task ID template (optional).
+	TaskIdTemplate *string `protobuf:"bytes,6,opt,name=task_id_template,json=taskIdTemplate,proto3,oneof" json:"task_id_template,omitempty"`
+	// secret replaces the shared secret used to sign deliveries (optional).
+	Secret *string `protobuf:"bytes,7,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	// signature_header is the new signature header (optional).
+	SignatureHeader *string `protobuf:"bytes,8,opt,name=signature_header,json=signatureHeader,proto3,oneof" json:"signature_header,omitempty"`
+	// enabled disables (false) or enables (true) the trigger (optional).
+	Enabled       *bool `protobuf:"varint,9,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookTriggerRequest) Reset() {
+	*x = UpdateWebhookTriggerRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookTriggerRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookTriggerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateWebhookTriggerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookTriggerRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateWebhookTriggerRequest) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *UpdateWebhookTriggerRequest) GetWorkspace() string {
+	if x != nil && x.Workspace != nil {
+		return *x.Workspace
+	}
+	return ""
+}
+
+func (x *UpdateWebhookTriggerRequest) GetPromptTemplate() string {
+	if x != nil && x.PromptTemplate != nil {
+		return *x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *UpdateWebhookTriggerRequest) GetTaskIdTemplate() string {
+	if x != nil && x.TaskIdTemplate != nil {
+		return *x.TaskIdTemplate
+	}
+	return ""
+}
+
+func (x *UpdateWebhookTriggerRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateWebhookTriggerRequest) GetSignatureHeader() string {
+	if x != nil && x.SignatureHeader != nil {
+		return *x.SignatureHeader
+	}
+	return ""
+}
+
+func (x *UpdateWebhookTriggerRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// UpdateWebhookTriggerResponse contains the updated webhook trigger.
+type UpdateWebhookTriggerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// trigger is the updated webhook trigger.
+	Trigger       *WebhookTrigger `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookTriggerResponse) Reset() {
+	*x = UpdateWebhookTriggerResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookTriggerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookTriggerResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookTriggerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookTriggerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookTriggerResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateWebhookTriggerResponse) GetTrigger() *WebhookTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+// DeleteWebhookTriggerRequest specifies which webhook trigger to delete.
+type DeleteWebhookTriggerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the trigger to delete (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookTriggerRequest) Reset() {
+	*x = DeleteWebhookTriggerRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookTriggerRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookTriggerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteWebhookTriggerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteWebhookTriggerResponse confirms the webhook trigger deletion (empty response).
+type DeleteWebhookTriggerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookTriggerResponse) Reset() {
+	*x = DeleteWebhookTriggerResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookTriggerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookTriggerResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookTriggerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookTriggerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookTriggerResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{13}
+}
+
+// ListWebhookDeliveriesRequest specifies which delivery log to retrieve.
+type ListWebhookDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// trigger_id is the unique identifier of the webhook trigger (UUID format).
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	// page_size limits the number of deliveries returned (1-100).
+	PageSize      *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// ListWebhookDeliveriesResponse contains the delivery log of the webhook trigger.
+type ListWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deliveries is the list of deliveries, newest first.
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// Filter specifies criteria for narrowing the list of returned webhook triggers.
+type ListWebhookTriggersRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// names filters triggers by name.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// enabled filters triggers by whether they accept deliveries.
+	Enabled       *bool `protobuf:"varint,2,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookTriggersRequest_Filter) Reset() {
+	*x = ListWebhookTriggersRequest_Filter{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookTriggersRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookTriggersRequest_Filter) ProtoMessage() {}
+
+func (x *ListWebhookTriggersRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookTriggersRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListWebhookTriggersRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *ListWebhookTriggersRequest_Filter) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ListWebhookTriggersRequest_Filter) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+var File_construct_v1_webhook_proto protoreflect.FileDescriptor
+
+const file_construct_v1_webhook_proto_rawDesc = "" +
+	"\n" +
+	"\x1aconstruct/v1/webhook.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x01\n" +
+	"\x0eWebhookTrigger\x12@\n" +
+	"\bmetadata\x18\x01 \x01(\v2$.construct.v1.WebhookTriggerMetadataR\bmetadata\x124\n" +
+	"\x04spec\x18\x02 \x01(\v2 .construct.v1.WebhookTriggerSpecR\x04spec\"\xb8\x01\n" +
+	"\x16WebhookTriggerMetadata\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xa7\x03\n" +
+	"\x12WebhookTriggerSpec\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18\xff\x012\x10^[a-zA-Z0-9_-]+$R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12=\n" +
+	"\x06target\x18\x03 \x01(\x0e2\x1b.construct.v1.WebhookTargetB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06target\x12(\n" +
+	"\bagent_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12\x1c\n" +
+	"\tworkspace\x18\x05 \x01(\tR\tworkspace\x120\n" +
+	"\x0fprompt_template\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0epromptTemplate\x12(\n" +
+	"\x10task_id_template\x18\a \x01(\tR\x0etaskIdTemplate\x12)\n" +
+	"\x10signature_header\x18\b \x01(\tR\x0fsignatureHeader\x12\x18\n" +
+	"\aenabled\x18\t \x01(\bR\aenabledB\v\n" +
+	"\t_agent_id\"\xe3\x02\n" +
+	"\x0fWebhookDelivery\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12'\n" +
+	"\n" +
+	"trigger_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\ttriggerId\x12&\n" +
+	"\atask_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06taskId\x88\x01\x01\x12E\n" +
+	"\x06status\x18\x04 \x01(\x0e2#.construct.v1.WebhookDeliveryStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12\x1f\n" +
+	"\vstatus_code\x18\x05 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x18\n" +
+	"\apayload\x18\a \x01(\fR\apayload\x12A\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAtB\n" +
+	"\n" +
+	"\b_task_id\"\xae\x03\n" +
+	"\x1bCreateWebhookTriggerRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18\xff\x012\x10^[a-zA-Z0-9_-]+$R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12=\n" +
+	"\x06target\x18\x03 \x01(\x0e2\x1b.construct.v1.WebhookTargetB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06target\x12(\n" +
+	"\bagent_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12\x1c\n" +
+	"\tworkspace\x18\x05 \x01(\tR\tworkspace\x120\n" +
+	"\x0fprompt_template\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0epromptTemplate\x12(\n" +
+	"\x10task_id_template\x18\a \x01(\tR\x0etaskIdTemplate\x12\x16\n" +
+	"\x06secret\x18\b \x01(\tR\x06secret\x12)\n" +
+	"\x10signature_header\x18\t \x01(\tR\x0fsignatureHeaderB\v\n" +
+	"\t_agent_id\"v\n" +
+	"\x1cCreateWebhookTriggerResponse\x12>\n" +
+	"\atrigger\x18\x01 \x01(\v2\x1c.construct.v1.WebhookTriggerB\x06\xbaH\x03\xc8\x01\x01R\atrigger\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"4\n" +
+	"\x18GetWebhookTriggerRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"[\n" +
+	"\x19GetWebhookTriggerResponse\x12>\n" +
+	"\atrigger\x18\x01 \x01(\v2\x1c.construct.v1.WebhookTriggerB\x06\xbaH\x03\xc8\x01\x01R\atrigger\"\x94\x02\n" +
+	"\x1aListWebhookTriggersRequest\x12G\n" +
+	"\x06filter\x18\x01 \x01(\v2/.construct.v1.ListWebhookTriggersRequest.FilterR\x06filter\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tpageToken\x1aI\n" +
+	"\x06Filter\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bH\x00R\aenabled\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabledB\f\n" +
+	"\n" +
+	"_page_size\"\x7f\n" +
+	"\x1bListWebhookTriggersResponse\x128\n" +
+	"\btriggers\x18\x01 \x03(\v2\x1c.construct.v1.WebhookTriggerR\btriggers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x99\x04\n" +
+	"\x1bUpdateWebhookTriggerRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12/\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01\x12(\n" +
+	"\bagent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\aagentId\x88\x01\x01\x12!\n" +
+	"\tworkspace\x18\x04 \x01(\tH\x02R\tworkspace\x88\x01\x01\x125\n" +
+	"\x0fprompt_template\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x03R\x0epromptTemplate\x88\x01\x01\x12-\n" +
+	"\x10task_id_template\x18\x06 \x01(\tH\x04R\x0etaskIdTemplate\x88\x01\x01\x12$\n" +
+	"\x06secret\x18\a \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x05R\x06secret\x88\x01\x01\x127\n" +
+	"\x10signature_header\x18\b \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x06R\x0fsignatureHeader\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\t \x01(\bH\aR\aenabled\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_agent_idB\f\n" +
+	"\n" +
+	"_workspaceB\x12\n" +
+	"\x10_prompt_templateB\x13\n" +
+	"\x11_task_id_templateB\t\n" +
+	"\a_secretB\x13\n" +
+	"\x11_signature_headerB\n" +
+	"\n" +
+	"\b_enabled\"^\n" +
+	"\x1cUpdateWebhookTriggerResponse\x12>\n" +
+	"\atrigger\x18\x01 \x01(\v2\x1c.construct.v1.WebhookTriggerB\x06\xbaH\x03\xc8\x01\x01R\atrigger\"7\n" +
+	"\x1bDeleteWebhookTriggerRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x1e\n" +
+	"\x1cDeleteWebhookTriggerResponse\"\x82\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12'\n" +
+	"\n" +
+	"trigger_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\ttriggerId\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_page_size\"^\n" +
+	"\x1dListWebhookDeliveriesResponse\x12=\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1d.construct.v1.WebhookDeliveryR\n" +
+	"deliveries*h\n" +
+	"\rWebhookTarget\x12\x1e\n" +
+	"\x1aWEBHOOK_TARGET_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17WEBHOOK_TARGET_NEW_TASK\x10\x01\x12\x1a\n" +
+	"\x16WEBHOOK_TARGET_MESSAGE\x10\x02*\xb0\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" WEBHOOK_DELIVERY_STATUS_ACCEPTED\x10\x01\x12$\n" +
+	" WEBHOOK_DELIVERY_STATUS_REJECTED\x10\x02\x12\"\n" +
+	"\x1eWEBHOOK_DELIVERY_STATUS_FAILED\x10\x032\xb6\x05\n" +
+	"\x0eWebhookService\x12o\n" +
+	"\x14CreateWebhookTrigger\x12).construct.v1.CreateWebhookTriggerRequest\x1a*.construct.v1.CreateWebhookTriggerResponse\"\x00\x12i\n" +
+	"\x11GetWebhookTrigger\x12&.construct.v1.GetWebhookTriggerRequest\x1a'.construct.v1.GetWebhookTriggerResponse\"\x03\x90\x02\x01\x12o\n" +
+	"\x13ListWebhookTriggers\x12(.construct.v1.ListWebhookTriggersRequest\x1a).construct.v1.ListWebhookTriggersResponse\"\x03\x90\x02\x01\x12o\n" +
+	"\x14UpdateWebhookTrigger\x12).construct.v1.UpdateWebhookTriggerRequest\x1a*.construct.v1.UpdateWebhookTriggerResponse\"\x00\x12o\n" +
+	"\x14DeleteWebhookTrigger\x12).construct.v1.DeleteWebhookTriggerRequest\x1a*.construct.v1.DeleteWebhookTriggerResponse\"\x00\x12u\n" +
+	"\x15ListWebhookDeliveries\x12*.construct.v1.ListWebhookDeliveriesRequest\x1a+.construct.v1.ListWebhookDeliveriesResponse\"\x03\x90\x02\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_webhook_proto_rawDescOnce sync.Once
+	file_construct_v1_webhook_proto_rawDescData []byte
+)
+
+func file_construct_v1_webhook_proto_rawDescGZIP() []byte {
+	file_construct_v1_webhook_proto_rawDescOnce.Do(func() {
+		file_construct_v1_webhook_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_webhook_proto_rawDesc), len(file_construct_v1_webhook_proto_rawDesc)))
+	})
+	return file_construct_v1_webhook_proto_rawDescData
+}
+
+var file_construct_v1_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_construct_v1_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_construct_v1_webhook_proto_goTypes = []any{
+	(WebhookTarget)(0),                        // 0: construct.v1.WebhookTarget
+	(WebhookDeliveryStatus)(0),                // 1: construct.v1.WebhookDeliveryStatus
+	(*WebhookTrigger)(nil),                    // 2: construct.v1.WebhookTrigger
+	(*WebhookTriggerMetadata)(nil),            // 3: construct.v1.WebhookTriggerMetadata
+	(*WebhookTriggerSpec)(nil),                // 4: construct.v1.WebhookTriggerSpec
+	(*WebhookDelivery)(nil),                   // 5: construct.v1.WebhookDelivery
+	(*CreateWebhookTriggerRequest)(nil),       // 6: construct.v1.CreateWebhookTriggerRequest
+	(*CreateWebhookTriggerResponse)(nil),      // 7: construct.v1.CreateWebhookTriggerResponse
+	(*GetWebhookTriggerRequest)(nil),          // 8: construct.v1.GetWebhookTriggerRequest
+	(*GetWebhookTriggerResponse)(nil),         // 9: construct.v1.GetWebhookTriggerResponse
+	(*ListWebhookTriggersRequest)(nil),        // 10: construct.v1.ListWebhookTriggersRequest
+	(*ListWebhookTriggersResponse)(nil),       // 11: construct.v1.ListWebhookTriggersResponse
+	(*UpdateWebhookTriggerRequest)(nil),       // 12: construct.v1.UpdateWebhookTriggerRequest
+	(*UpdateWebhookTriggerResponse)(nil),      // 13: construct.v1.UpdateWebhookTriggerResponse
+	(*DeleteWebhookTriggerRequest)(nil),       // 14: construct.v1.DeleteWebhookTriggerRequest
+	(*DeleteWebhookTriggerResponse)(nil),      // 15: construct.v1.DeleteWebhookTriggerResponse
+	(*ListWebhookDeliveriesRequest)(nil),      // 16: construct.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 17: construct.v1.ListWebhookDeliveriesResponse
+	(*ListWebhookTriggersRequest_Filter)(nil), // 18: construct.v1.ListWebhookTriggersRequest.Filter
+	(*timestamppb.Timestamp)(nil),             // 19: google.protobuf.Timestamp
+}
+var file_construct_v1_webhook_proto_depIdxs = []int32{
+	3,  // 0: construct.v1.WebhookTrigger.metadata:type_name -> construct.v1.WebhookTriggerMetadata
+	4,  // 1: construct.v1.WebhookTrigger.spec:type_name -> construct.v1.WebhookTriggerSpec
+	19, // 2: construct.v1.WebhookTriggerMetadata.created_at:type_name -> google.protobuf.Timestamp
+	19, // 3: construct.v1.WebhookTriggerMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: construct.v1.WebhookTriggerSpec.target:type_name -> construct.v1.WebhookTarget
+	1,  // 5: construct.v1.WebhookDelivery.status:type_name -> construct.v1.WebhookDeliveryStatus
+	19, // 6: construct.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: construct.v1.CreateWebhookTriggerRequest.target:type_name -> construct.v1.WebhookTarget
+	2,  // 8: construct.v1.CreateWebhookTriggerResponse.trigger:type_name -> construct.v1.WebhookTrigger
+	2,  // 9: construct.v1.GetWebhookTriggerResponse.trigger:type_name -> construct.v1.WebhookTrigger
+	18, // 10: construct.v1.ListWebhookTriggersRequest.filter:type_name -> construct.v1.ListWebhookTriggersRequest.Filter
+	2,  // 11: construct.v1.ListWebhookTriggersResponse.triggers:type_name -> construct.v1.WebhookTrigger
+	2,  // 12: construct.v1.UpdateWebhookTriggerResponse.trigger:type_name -> construct.v1.WebhookTrigger
+	5,  // 13: construct.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> construct.v1.WebhookDelivery
+	6,  // 14: construct.v1.WebhookService.CreateWebhookTrigger:input_type -> construct.v1.CreateWebhookTriggerRequest
+	8,  // 15: construct.v1.WebhookService.GetWebhookTrigger:input_type -> construct.v1.GetWebhookTriggerRequest
+	10, // 16: construct.v1.WebhookService.ListWebhookTriggers:input_type -> construct.v1.ListWebhookTriggersRequest
+	12, // 17: construct.v1.WebhookService.UpdateWebhookTrigger:input_type -> construct.v1.UpdateWebhookTriggerRequest
+	14, // 18: construct.v1.WebhookService.DeleteWebhookTrigger:input_type -> construct.v1.DeleteWebhookTriggerRequest
+	16, // 19: construct.v1.WebhookService.ListWebhookDeliveries:input_type -> construct.v1.ListWebhookDeliveriesRequest
+	7,  // 20: construct.v1.WebhookService.CreateWebhookTrigger:output_type -> construct.v1.CreateWebhookTriggerResponse
+	9,  // 21: construct.v1.WebhookService.GetWebhookTrigger:output_type -> construct.v1.GetWebhookTriggerResponse
+	11, // 22: construct.v1.WebhookService.ListWebhookTriggers:output_type -> construct.v1.ListWebhookTriggersResponse
+	13, // 23: construct.v1.WebhookService.UpdateWebhookTrigger:output_type -> construct.v1.UpdateWebhookTriggerResponse
+	15, // 24: construct.v1.WebhookService.DeleteWebhookTrigger:output_type -> construct.v1.DeleteWebhookTriggerResponse
+	17, // 25: construct.v1.WebhookService.ListWebhookDeliveries:output_type -> construct.v1.ListWebhookDeliveriesResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_webhook_proto_init() }
+func file_construct_v1_webhook_proto_init() {
+	if File_construct_v1_webhook_proto != nil {
+		return
+	}
+	file_construct_v1_webhook_proto_msgTypes[2].OneofWrappers = []any{}
+	file_construct_v1_webhook_proto_msgTypes[3].OneofWrappers = []any{}
+	file_construct_v1_webhook_proto_msgTypes[4].OneofWrappers = []any{}
+	file_construct_v1_webhook_proto_msgTypes[8].OneofWrappers = []any{}
+	file_construct_v1_webhook_proto_msgTypes[10].OneofWrappers = []any{}
+	file_construct_v1_webhook_proto_msgTypes[14].OneofWrappers = []any{}
+	file_construct_v1_webhook_proto_msgTypes[16].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_webhook_proto_rawDesc), len(file_construct_v1_webhook_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_webhook_proto_goTypes,
+		DependencyIndexes: file_construct_v1_webhook_proto_depIdxs,
+		EnumInfos:         file_construct_v1_webhook_proto_enumTypes,
+		MessageInfos:      file_construct_v1_webhook_proto_msgTypes,
+	}.Build()
+	File_construct_v1_webhook_proto = out.File
+	file_construct_v1_webhook_proto_goTypes = nil
+	file_construct_v1_webhook_proto_depIdxs = nil
+}
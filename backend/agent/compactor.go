@@ -0,0 +1,147 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	memory_message "github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_task "github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/model"
+	"github.com/furisto/construct/backend/prompt"
+	"github.com/google/uuid"
+)
+
+const compactionSystemPrompt = `You are summarizing a conversation between a user and a coding agent, so that the agent can continue the work from the summary alone. Answer only with the summary.`
+
+// compactedConversationPrefix introduces the summary in the message that replaces the
+// compacted conversation.
+const compactedConversationPrefix = "The earlier conversation was compacted. This is a summary of it:\n\n"
+
+// Compactor replaces the conversation of a task with a summary, to free up the context
+// window of the agent.
+type Compactor struct {
+	memory          *memory.Client
+	providerFactory *ModelProviderFactory
+}
+
+func NewCompactor(memory *memory.Client, providerFactory *ModelProviderFactory) *Compactor {
+	return &Compactor{
+		memory:          memory,
+		providerFactory: providerFactory,
+	}
+}
+
+// CompactTask asks the model of the task's agent to summarize all processed messages of the
+// task and replaces them with a single user message that holds the summary. It returns the
+// summary and the number of messages that were replaced. Messages that arrive while the
+// summary is written are kept.
+func (c *Compactor) CompactTask(ctx context.Context, taskID uuid.UUID) (string, int, error) {
+	task, err := c.memory.Task.Query().
+		Where(memory_task.IDEQ(taskID)).
+		WithAgent(func(query *memory.AgentQuery) {
+			query.WithModel()
+		}).
+		Only(ctx)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to fetch task: %w", err)
+	}
+	if task.Edges.Agent == nil || task.Edges.Agent.Edges.Model == nil {
+		return "", 0, fmt.Errorf("no agent associated with task: %s", taskID)
+	}
+	agentModel := task.Edges.Agent.Edges.Model
+
+	messages, err := c.memory.Message.Query().
+		Where(memory_message.TaskIDEQ(taskID), memory_message.ProcessedTimeNotNil()).
+		Order(memory_message.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to fetch messages: %w", err)
+	}
+	if len(messages) == 0 {
+		return "", 0, fmt.Errorf("task %s has no messages to compact", taskID)
+	}
+
+	modelMessages := make([]*model.Message, 0, len(messages)+1)
+	messageIDs := make([]uuid.UUID, 0, len(messages))
+	for _, msg := range messages {
+		modelMsg, err := ConvertMemoryMessageToModel(msg)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to build message history: %w", err)
+		}
+		modelMessages = append(modelMessages, modelMsg)
+		messageIDs = append(messageIDs, msg.ID)
+	}
+	modelMessages = append(modelMessages, &model.Message{
+		Source:  model.MessageSourceUser,
+		Content: []model.ContentBlock{&model.TextBlock{Text: prompt.Summary}},
+	})
+
+	modelProvider, err := c.providerFactory.CreateClient(ctx, agentModel.ModelProviderID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create model provider: %w", err)
+	}
+
+	response, err := modelProvider.InvokeModel(ctx, agentModel.Name, compactionSystemPrompt, modelMessages)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to summarize conversation: %w", err)
+	}
+
+	var summary strings.Builder
+	for _, block := range response.Content {
+		if text, ok := block.(*model.TextBlock); ok {
+			summary.WriteString(text.Text)
+		}
+	}
+	if strings.TrimSpace(summary.String()) == "" {
+		return "", 0, fmt.Errorf("model returned an empty summary")
+	}
+
+	content, err := ConvertModelContentBlocksToMemory([]model.ContentBlock{
+		&model.TextBlock{Text: compactedConversationPrefix + summary.String()},
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	cost := calculateCost(response.Usage, agentModel)
+	// the summary takes the place of the oldest compacted message, so that it stays ahead of
+	// messages that arrived in the meantime
+	createTime := messages[0].CreateTime
+
+	_, err = memory.Transaction(ctx, c.memory, func(tx *memory.Client) (*memory.Message, error) {
+		_, err := tx.Message.Delete().Where(memory_message.IDIn(messageIDs...)).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Message.Create().
+			SetTaskID(taskID).
+			SetSource(types.MessageSourceUser).
+			SetContent(content).
+			SetCreateTime(createTime).
+			SetProcessedTime(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, tx.Task.UpdateOneID(taskID).
+			AddInputTokens(response.Usage.InputTokens).
+			AddOutputTokens(response.Usage.OutputTokens).
+			AddCacheWriteTokens(response.Usage.CacheWriteTokens).
+			AddCacheReadTokens(response.Usage.CacheReadTokens).
+			AddCost(cost).
+			Exec(ctx)
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to replace conversation with summary: %w", err)
+	}
+
+	slog.InfoContext(ctx, "compacted task", "task_id", taskID, "messages", len(messages))
+	return summary.String(), len(messages), nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/furisto/construct/backend/model"
 	toolbase "github.com/furisto/construct/backend/tool/base"
 	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/furisto/construct/backend/workspace"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -92,6 +93,15 @@ func ConvertMemoryMessageBlocksToModel(blocks []types.MessageBlock) ([]model.Con
 				Result:    result,
 				Succeeded: interpreterResult.Error == "",
 			})
+		case types.MessageBlockKindFileAttachment:
+			var attachment types.FileAttachment
+			err := json.Unmarshal([]byte(block.Payload), &attachment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal file attachment block: %w", err)
+			}
+			contentBlocks = append(contentBlocks, &model.TextBlock{
+				Text: workspace.FormatAttachment(attachment.Path, attachment.StartLine, attachment.EndLine, attachment.Content),
+			})
 		default:
 			return nil, fmt.Errorf("unknown message block kind: %s", block.Kind)
 		}
@@ -125,6 +135,24 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 				},
 			})
 
+		case types.MessageBlockKindFileAttachment:
+			var attachment types.FileAttachment
+			err := json.Unmarshal([]byte(block.Payload), &attachment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal file attachment block: %w", err)
+			}
+
+			contentParts = append(contentParts, &v1.MessagePart{
+				Data: &v1.MessagePart_FileAttachment_{
+					FileAttachment: &v1.MessagePart_FileAttachment{
+						Path:      attachment.Path,
+						StartLine: uint32(attachment.StartLine),
+						EndLine:   uint32(attachment.EndLine),
+						Content:   attachment.Content,
+					},
+				},
+			})
+
 		case types.MessageBlockKindCodeInterpreterCall:
 			var toolCall model.ToolCallBlock
 			err := json.Unmarshal([]byte(block.Payload), &toolCall)
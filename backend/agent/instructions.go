@@ -0,0 +1,185 @@
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/furisto/construct/backend/tool/project"
+	"github.com/spf13/afero"
+)
+
+const (
+	// InstructionsFileName is the name of the files that hold the instructions of a project
+	// for every agent.
+	InstructionsFileName = "AGENTS.md"
+
+	// maxInstructionFileSize bounds the content of a single instruction file.
+	maxInstructionFileSize = 16 * 1024
+	// maxInstructionsSize bounds the instructions of a project in the system prompt.
+	maxInstructionsSize = 64 * 1024
+	// maxNestedInstructionDepth bounds how deep below the working directory instruction
+	// files are searched.
+	maxNestedInstructionDepth = 4
+	// maxNestedInstructionDirs bounds the directories that are searched for nested instruction
+	// files, so that a working directory like the home directory does not stall the task.
+	maxNestedInstructionDirs = 2000
+)
+
+var errInstructionSearchLimit = errors.New("instruction search limit reached")
+
+// skippedInstructionDirs are never searched for nested instruction files.
+var skippedInstructionDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"dist":         true,
+	"build":        true,
+	"target":       true,
+}
+
+type instructionFile struct {
+	path    string
+	heading string
+}
+
+// ProjectInstructions collects the instruction files of the project that contains cwd: the
+// AGENTS.md files and .construct/rules/*.md files from the root of the repository down to
+// cwd, the AGENTS.md files in the directories below cwd and the project memory. Files that
+// do not fit into the size limits are cut or left out.
+func ProjectInstructions(fsys afero.Fs, cwd string) string {
+	root := project.Root(fsys, cwd)
+
+	var files []instructionFile
+	for _, dir := range directoriesFromRoot(root, cwd) {
+		files = append(files, instructionFile{path: filepath.Join(dir, InstructionsFileName)})
+
+		rules, err := afero.Glob(fsys, filepath.Join(dir, ".construct", "rules", "*.md"))
+		if err != nil {
+			slog.Warn("failed to list rules", "dir", dir, "error", err)
+		}
+		sort.Strings(rules)
+		for _, rule := range rules {
+			files = append(files, instructionFile{path: rule})
+		}
+	}
+	for _, path := range nestedInstructionFiles(fsys, cwd) {
+		files = append(files, instructionFile{path: path})
+	}
+	files = append(files, instructionFile{path: project.MemoryPath(fsys, cwd), heading: "Project memory"})
+
+	var (
+		sb      strings.Builder
+		omitted []string
+	)
+	for _, file := range files {
+		content, err := afero.ReadFile(fsys, file.path)
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				slog.Warn("failed to read instruction file", "path", file.path, "error", err)
+			}
+			continue
+		}
+
+		text := strings.TrimSpace(string(content))
+		if text == "" {
+			continue
+		}
+		if len(text) > maxInstructionFileSize {
+			text = strings.ToValidUTF8(text[:maxInstructionFileSize], "") + "\n[truncated]"
+		}
+
+		rel, err := filepath.Rel(root, file.path)
+		if err != nil {
+			rel = file.path
+		}
+		heading := rel
+		if file.heading != "" {
+			heading = fmt.Sprintf("%s (%s)", file.heading, rel)
+		}
+
+		section := fmt.Sprintf("## %s\n%s\n\n", heading, text)
+		if sb.Len()+len(section) > maxInstructionsSize {
+			omitted = append(omitted, rel)
+			continue
+		}
+		sb.WriteString(section)
+	}
+
+	if sb.Len() == 0 {
+		return ""
+	}
+
+	if len(omitted) > 0 {
+		fmt.Fprintf(&sb, "[omitted because the instructions exceed %d KB: %s]\n", maxInstructionsSize/1024, strings.Join(omitted, ", "))
+	}
+
+	return "# Project Instructions\n" +
+		"The following instructions were written for this project. Instructions of a directory apply to the files within it and take precedence over the instructions of its parents.\n\n" +
+		strings.TrimRight(sb.String(), "\n")
+}
+
+// directoriesFromRoot returns root and the directories below it down to dir. If dir is not
+// within root, only dir is returned.
+func directoriesFromRoot(root, dir string) []string {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return []string{dir}
+	}
+
+	dirs := []string{root}
+	if rel == "." {
+		return dirs
+	}
+
+	current := root
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		current = filepath.Join(current, part)
+		dirs = append(dirs, current)
+	}
+	return dirs
+}
+
+// nestedInstructionFiles returns the instruction files in the directories below dir, sorted
+// by path. Hidden directories and directories with dependencies or build output are skipped.
+func nestedInstructionFiles(fsys afero.Fs, dir string) []string {
+	var (
+		paths   []string
+		visited int
+	)
+	err := afero.Walk(fsys, dir, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if info.IsDir() {
+			if path == dir {
+				return nil
+			}
+			if visited++; visited > maxNestedInstructionDirs {
+				return errInstructionSearchLimit
+			}
+			name := info.Name()
+			if strings.HasPrefix(name, ".") || skippedInstructionDirs[name] {
+				return filepath.SkipDir
+			}
+			if strings.Count(strings.TrimPrefix(path, dir), string(filepath.Separator)) > maxNestedInstructionDepth {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.Name() == InstructionsFileName && filepath.Dir(path) != dir {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errInstructionSearchLimit) {
+		slog.Warn("failed to search for nested instruction files", "dir", dir, "error", err)
+	}
+
+	return paths
+}
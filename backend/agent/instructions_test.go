@@ -0,0 +1,98 @@
+package agent
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+const instructionsPreamble = "# Project Instructions\n" +
+	"The following instructions were written for this project. Instructions of a directory apply to the files within it and take precedence over the instructions of its parents.\n\n"
+
+func TestProjectInstructions(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		cwd      string
+		expected string
+	}{
+		{
+			name:     "no instruction files",
+			files:    map[string]string{"/repo/.git/HEAD": "ref: refs/heads/main", "/repo/main.go": "package main"},
+			cwd:      "/repo",
+			expected: "",
+		},
+		{
+			name: "files from the root down to the working directory",
+			files: map[string]string{
+				"/repo/.git/HEAD":                     "ref: refs/heads/main",
+				"/repo/AGENTS.md":                     "Use pnpm.\n",
+				"/repo/.construct/rules/b-style.md":   "Prefer small functions.",
+				"/repo/.construct/rules/a-testing.md": "Run the tests.",
+				"/repo/.construct/rules/notes.txt":    "not a rule",
+				"/repo/.construct/memory.md":          "- Lint with make lint",
+				"/repo/web/AGENTS.md":                 "Never touch generated/.",
+				"/repo/docs/AGENTS.md":                "Wrap at 80 columns.",
+				"/repo/web/app/AGENTS.md":             "Use hooks.",
+				"/repo/web/node_modules/x/AGENTS.md":  "skipped",
+				"/repo/web/.cache/AGENTS.md":          "skipped",
+				"/repo/web/empty/AGENTS.md":           "  \n",
+			},
+			cwd: "/repo/web",
+			expected: instructionsPreamble +
+				"## AGENTS.md\nUse pnpm.\n\n" +
+				"## .construct/rules/a-testing.md\nRun the tests.\n\n" +
+				"## .construct/rules/b-style.md\nPrefer small functions.\n\n" +
+				"## web/AGENTS.md\nNever touch generated/.\n\n" +
+				"## web/app/AGENTS.md\nUse hooks.\n\n" +
+				"## Project memory (.construct/memory.md)\n- Lint with make lint",
+		},
+		{
+			name: "working directory outside of a repository",
+			files: map[string]string{
+				"/scratch/AGENTS.md": "Scratch space.",
+			},
+			cwd:      "/scratch",
+			expected: instructionsPreamble + "## AGENTS.md\nScratch space.",
+		},
+		{
+			name: "large files are truncated and files over the limit are omitted",
+			files: map[string]string{
+				"/repo/.git/HEAD":       "ref: refs/heads/main",
+				"/repo/AGENTS.md":       strings.Repeat("a", maxInstructionFileSize+10),
+				"/repo/a/AGENTS.md":     strings.Repeat("b", maxInstructionFileSize),
+				"/repo/b/AGENTS.md":     strings.Repeat("c", maxInstructionFileSize),
+				"/repo/c/AGENTS.md":     strings.Repeat("d", maxInstructionFileSize),
+				"/repo/d/AGENTS.md":     "Short enough.",
+				"/repo/e/f/AGENTS.md":   strings.Repeat("e", maxInstructionFileSize),
+				"/repo/e/f/README.md":   "not an instruction file",
+				"/repo/.construct/x.md": "not a rule",
+			},
+			cwd: "/repo",
+			expected: instructionsPreamble +
+				"## AGENTS.md\n" + strings.Repeat("a", maxInstructionFileSize) + "\n[truncated]\n\n" +
+				"## a/AGENTS.md\n" + strings.Repeat("b", maxInstructionFileSize) + "\n\n" +
+				"## b/AGENTS.md\n" + strings.Repeat("c", maxInstructionFileSize) + "\n\n" +
+				"## d/AGENTS.md\nShort enough.\n\n" +
+				"[omitted because the instructions exceed 64 KB: c/AGENTS.md, e/f/AGENTS.md]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			for path, content := range tt.files {
+				if err := afero.WriteFile(fs, path, []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write %s: %v", path, err)
+				}
+			}
+
+			actual := ProjectInstructions(fs, tt.cwd)
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Errorf("ProjectInstructions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	memory_task "github.com/furisto/construct/backend/memory/task"
+	"github.com/google/uuid"
+)
+
+const DefaultLeaseDuration = 30 * time.Second
+
+// TaskLeaser claims tasks for a single daemon replica. Replicas that share a database only
+// reconcile a task while they hold its lease, so a turn is never processed twice. Leases
+// expire if they are not renewed, which lets another replica take over the tasks of a
+// replica that died.
+type TaskLeaser struct {
+	memory   *memory.Client
+	owner    string
+	duration time.Duration
+	now      func() time.Time
+}
+
+func NewTaskLeaser(memory *memory.Client, owner string, duration time.Duration) *TaskLeaser {
+	return &TaskLeaser{
+		memory:   memory,
+		owner:    owner,
+		duration: duration,
+		now:      time.Now,
+	}
+}
+
+// Owner identifies the replica in the lease_owner column of the tasks it holds.
+func (l *TaskLeaser) Owner() string {
+	return l.owner
+}
+
+func (l *TaskLeaser) Duration() time.Duration {
+	return l.duration
+}
+
+// Acquire claims the lease of a task or extends it if the replica already holds it. It
+// reports false if another replica holds an unexpired lease.
+func (l *TaskLeaser) Acquire(ctx context.Context, taskID uuid.UUID) (bool, error) {
+	now := l.now().UTC()
+	n, err := l.memory.Task.Update().
+		Where(
+			memory_task.IDEQ(taskID),
+			memory_task.Or(
+				memory_task.LeaseOwnerIsNil(),
+				memory_task.LeaseOwnerEQ(l.owner),
+				memory_task.LeaseExpireTimeIsNil(),
+				memory_task.LeaseExpireTimeLT(now),
+			),
+		).
+		SetLeaseOwner(l.owner).
+		SetLeaseExpireTime(now.Add(l.duration)).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire task lease: %w", err)
+	}
+
+	return n == 1, nil
+}
+
+// Release gives up the lease of a task if the replica holds it.
+func (l *TaskLeaser) Release(ctx context.Context, taskID uuid.UUID) error {
+	_, err := l.memory.Task.Update().
+		Where(memory_task.IDEQ(taskID), memory_task.LeaseOwnerEQ(l.owner)).
+		ClearLeaseOwner().
+		ClearLeaseExpireTime().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release task lease: %w", err)
+	}
+	return nil
+}
+
+// ReleaseAll gives up the leases of all tasks, regardless of the replica that holds them.
+// It is only safe if no other replica uses the database.
+func (l *TaskLeaser) ReleaseAll(ctx context.Context) error {
+	_, err := l.memory.Task.Update().
+		Where(memory_task.LeaseOwnerNotNil()).
+		ClearLeaseOwner().
+		ClearLeaseExpireTime().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release task leases: %w", err)
+	}
+	return nil
+}
+
+// Keep renews the lease of a task until ctx is done. It calls lost if the lease could not
+// be renewed, after which the replica must stop working on the task.
+func (l *TaskLeaser) Keep(ctx context.Context, taskID uuid.UUID, lost func(error)) {
+	ticker := time.NewTicker(l.duration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ok, err := l.Acquire(ctx, taskID)
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil && !ok {
+				err = fmt.Errorf("task lease was taken over by another replica")
+			}
+			if err != nil {
+				lost(err)
+				return
+			}
+		}
+	}
+}
+
+// DefaultLeaseOwner returns an identifier that is unique to this daemon process.
+func DefaultLeaseOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s/%d/%s", hostname, os.Getpid(), uuid.NewString()[:8])
+}
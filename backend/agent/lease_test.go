@@ -0,0 +1,110 @@
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/uuid"
+)
+
+func TestTaskLeaser(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+	taskID := newLeaseTestTask(t, db)
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	first := NewTaskLeaser(db, "first", time.Minute)
+	first.now = func() time.Time { return now }
+	second := NewTaskLeaser(db, "second", time.Minute)
+	second.now = func() time.Time { return now }
+
+	assertAcquire(t, first, taskID, true)
+	assertAcquire(t, second, taskID, false)
+	assertAcquire(t, first, taskID, true)
+	assertLeaseOwner(t, db, taskID, "first")
+
+	second.now = func() time.Time { return now.Add(2 * time.Minute) }
+	assertAcquire(t, second, taskID, true)
+	assertLeaseOwner(t, db, taskID, "second")
+
+	if err := first.Release(ctx, taskID); err != nil {
+		t.Fatalf("failed to release lease: %v", err)
+	}
+	assertLeaseOwner(t, db, taskID, "second")
+
+	if err := second.Release(ctx, taskID); err != nil {
+		t.Fatalf("failed to release lease: %v", err)
+	}
+	assertLeaseOwner(t, db, taskID, "")
+	assertAcquire(t, first, taskID, true)
+
+	if err := second.ReleaseAll(ctx); err != nil {
+		t.Fatalf("failed to release leases: %v", err)
+	}
+	assertLeaseOwner(t, db, taskID, "")
+}
+
+func TestTaskLeaserKeep(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	db := test.NewDatabase(t)
+	taskID := newLeaseTestTask(t, db)
+
+	owner := NewTaskLeaser(db, "owner", 30*time.Millisecond)
+	assertAcquire(t, owner, taskID, true)
+
+	thief := NewTaskLeaser(db, "thief", time.Minute)
+	thief.now = func() time.Time { return time.Now().Add(time.Hour) }
+	assertAcquire(t, thief, taskID, true)
+
+	lost := make(chan error, 1)
+	go owner.Keep(ctx, taskID, func(err error) { lost <- err })
+
+	select {
+	case err := <-lost:
+		if err == nil {
+			t.Errorf("expected an error for the lost lease")
+		}
+	case <-ctx.Done():
+		t.Fatalf("lease loss was not detected")
+	}
+	assertLeaseOwner(t, db, taskID, "thief")
+}
+
+func assertAcquire(t *testing.T, leaser *TaskLeaser, taskID uuid.UUID, expected bool) {
+	t.Helper()
+
+	acquired, err := leaser.Acquire(context.Background(), taskID)
+	if err != nil {
+		t.Fatalf("failed to acquire lease: %v", err)
+	}
+	if acquired != expected {
+		t.Errorf("expected %s to acquire the lease: %v, got %v", leaser.Owner(), expected, acquired)
+	}
+}
+
+func assertLeaseOwner(t *testing.T, db *memory.Client, taskID uuid.UUID, expected string) {
+	t.Helper()
+
+	task, err := db.Task.Get(context.Background(), taskID)
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if task.LeaseOwner != expected {
+		t.Errorf("expected lease owner %q, got %q", expected, task.LeaseOwner)
+	}
+}
+
+func newLeaseTestTask(t *testing.T, db *memory.Client) uuid.UUID {
+	t.Helper()
+
+	ctx := context.Background()
+	provider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, provider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	return test.NewTaskBuilder(t, uuid.New(), db, agent).Build(ctx).ID
+}
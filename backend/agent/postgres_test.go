@@ -0,0 +1,57 @@
+//go:build postgres
+
+package agent
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/memory/test"
+)
+
+func TestTaskLeaserPostgres(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewPostgresDatabase(t)
+
+	for range 20 {
+		taskID := newLeaseTestTask(t, db)
+
+		// both replicas try to claim the task at the same time, only one of them may get it
+		leasers := []*TaskLeaser{
+			NewTaskLeaser(db, "first", time.Minute),
+			NewTaskLeaser(db, "second", time.Minute),
+		}
+		acquired := make([]bool, len(leasers))
+		errs := make([]error, len(leasers))
+
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for i, leaser := range leasers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				acquired[i], errs[i] = leaser.Acquire(ctx, taskID)
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		for _, err := range errs {
+			if err != nil {
+				t.Fatalf("failed to acquire lease: %v", err)
+			}
+		}
+		if acquired[0] == acquired[1] {
+			t.Fatalf("expected exactly one replica to acquire the lease, got %v", acquired)
+		}
+
+		winner := leasers[0]
+		if acquired[1] {
+			winner = leasers[1]
+		}
+		assertLeaseOwner(t, db, taskID, winner.Owner())
+	}
+}
@@ -0,0 +1,58 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxReconcileRetries bounds how often a task is retried in a row after errors that are
+	// expected to go away, e.g. rate limits of the model provider or a database that is
+	// briefly unreachable.
+	maxReconcileRetries = 5
+
+	// transientErrorRetryDelay is the delay before a task is reconciled again after a
+	// transient error of the daemon itself.
+	transientErrorRetryDelay = 5 * time.Second
+)
+
+// retryBudget counts the consecutive failed reconciliations of each task, so that the
+// reconciler can tell a task that failed for good from one that is retried.
+type retryBudget struct {
+	mu       sync.Mutex
+	max      int
+	failures map[uuid.UUID]int
+}
+
+func newRetryBudget(max int) *retryBudget {
+	return &retryBudget{
+		max:      max,
+		failures: make(map[uuid.UUID]int),
+	}
+}
+
+// Exhausted records the outcome of a reconciliation and reports whether the task failed:
+// its error is not retried, or it was retried max times in a row without success.
+func (b *retryBudget) Exhausted(taskID uuid.UUID, result Result, err error) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err == nil || errors.Is(err, context.Canceled) {
+		delete(b.failures, taskID)
+		return false
+	}
+
+	if result.Retry || result.RetryAfter > 0 {
+		b.failures[taskID]++
+		if b.failures[taskID] <= b.max {
+			return false
+		}
+	}
+
+	delete(b.failures, taskID)
+	return true
+}
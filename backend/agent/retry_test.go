@@ -0,0 +1,79 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRetryBudget(t *testing.T) {
+	errTransient := errors.New("database is locked")
+	retry := Result{RetryAfter: time.Second}
+
+	type reconciliation struct {
+		result    Result
+		err       error
+		exhausted bool
+	}
+
+	tests := []struct {
+		name            string
+		reconciliations []reconciliation
+	}{
+		{
+			name: "error that is not retried",
+			reconciliations: []reconciliation{
+				{result: Result{}, err: errors.New("unknown phase"), exhausted: true},
+			},
+		},
+		{
+			name: "transient errors that go away",
+			reconciliations: []reconciliation{
+				{result: retry, err: errTransient},
+				{result: retry, err: errTransient},
+				{result: Result{Retry: true}},
+			},
+		},
+		{
+			name: "cancellation",
+			reconciliations: []reconciliation{
+				{result: Result{}, err: fmt.Errorf("invoke model: %w", context.Canceled)},
+			},
+		},
+		{
+			name: "retries are exhausted",
+			reconciliations: []reconciliation{
+				{result: retry, err: errTransient},
+				{result: Result{Retry: true}, err: errTransient},
+				{result: retry, err: errTransient, exhausted: true},
+			},
+		},
+		{
+			name: "success resets the budget",
+			reconciliations: []reconciliation{
+				{result: retry, err: errTransient},
+				{result: retry, err: errTransient},
+				{result: Result{Retry: true}},
+				{result: retry, err: errTransient},
+				{result: retry, err: errTransient},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := newRetryBudget(2)
+			taskID := uuid.New()
+
+			for i, r := range tt.reconciliations {
+				if exhausted := budget.Exhausted(taskID, r.result, r.err); exhausted != r.exhausted {
+					t.Errorf("reconciliation %d: expected exhausted to be %v, got %v", i, r.exhausted, exhausted)
+				}
+			}
+		})
+	}
+}
@@ -6,17 +6,26 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"slices"
 	"sync"
 	"time"
 
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/analytics"
 	"github.com/furisto/construct/backend/api"
+	"github.com/furisto/construct/backend/audit"
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/notification"
+	"github.com/furisto/construct/backend/redact"
+	"github.com/furisto/construct/backend/review"
+	"github.com/furisto/construct/backend/scheduler"
 	"github.com/furisto/construct/backend/secret"
 	"github.com/furisto/construct/backend/tool/codeact"
 	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -26,17 +35,24 @@ import (
 const DefaultServerPort = 29333
 
 type RuntimeOptions struct {
-	Tools        []codeact.Tool
-	Concurrency  int
-	Analytics    analytics.Client
-	LoggerConfig *LoggerConfig
+	Tools          []codeact.Tool
+	CustomTools    []codeact.Tool
+	Concurrency    int
+	Analytics      analytics.Client
+	LoggerConfig   *LoggerConfig
+	TracerProvider trace.TracerProvider
+	Redactor       *redact.Redactor
+	SecretProvider secret.Provider
+	WebUI          bool
 }
 
 func DefaultRuntimeOptions() *RuntimeOptions {
 	return &RuntimeOptions{
-		Tools:        []codeact.Tool{},
-		Concurrency:  50,
-		LoggerConfig: DefaultLoggerConfig(),
+		Tools:          []codeact.Tool{},
+		Concurrency:    50,
+		LoggerConfig:   DefaultLoggerConfig(),
+		TracerProvider: noop.NewTracerProvider(),
+		Redactor:       redact.NewRedactor(),
 	}
 }
 
@@ -48,6 +64,14 @@ func WithCodeActTools(tools ...codeact.Tool) RuntimeOption {
 	}
 }
 
+// WithCustomTools adds the tools of plugins. Agents can only call the custom tools they
+// enable.
+func WithCustomTools(tools ...codeact.Tool) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.CustomTools = tools
+	}
+}
+
 func WithConcurrency(concurrency int) RuntimeOption {
 	return func(o *RuntimeOptions) {
 		o.Concurrency = concurrency
@@ -66,13 +90,47 @@ func WithLoggerConfig(config *LoggerConfig) RuntimeOption {
 	}
 }
 
+func WithTracerProvider(provider trace.TracerProvider) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.TracerProvider = provider
+	}
+}
+
+// WithRedactor sets the redactor that removes secrets from the results of tools. A nil
+// redactor turns redaction off.
+func WithRedactor(redactor *redact.Redactor) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.Redactor = redactor
+	}
+}
+
+// WithSecretProvider sets the provider that stores the encryption keyset, which is needed
+// to rotate the keyset through the API.
+func WithSecretProvider(provider secret.Provider) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.SecretProvider = provider
+	}
+}
+
+// WithWebUI serves the web UI from the API server.
+func WithWebUI(enabled bool) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.WebUI = enabled
+	}
+}
+
 type Runtime struct {
 	api            *api.Server
 	memory         *memory.Client
 	encryption     *secret.Encryption
+	secretProvider secret.Provider
 	eventHub       *event.MessageHub
 	bus            *event.Bus
 	taskReconciler *TaskReconciler
+	scheduler      *scheduler.Scheduler
+	notifier       *notification.Notifier
+	auditLog       *audit.Log
+	compactor      *Compactor
 	logger         *slog.Logger
 
 	wg        sync.WaitGroup
@@ -95,6 +153,15 @@ func NewRuntime(memory *memory.Client, encryption *secret.Encryption, listener n
 		KeyToolsRegistered, len(options.Tools),
 	)
 
+	var customTools []codeact.Tool
+	for _, tool := range options.CustomTools {
+		if slices.ContainsFunc(options.Tools, func(builtin codeact.Tool) bool { return builtin.Name() == tool.Name() }) {
+			logger.Warn("custom tool conflicts with a built-in tool and is ignored", "tool", tool.Name())
+			continue
+		}
+		customTools = append(customTools, tool)
+	}
+
 	metricsRegistry := prometheus.NewRegistry()
 	metricsRegistry.MustRegister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
 	metricsRegistry.MustRegister(collectors.NewGoCollector())
@@ -107,28 +174,48 @@ func NewRuntime(memory *memory.Client, encryption *secret.Encryption, listener n
 		return nil, err
 	}
 	eventBus := event.NewBus(metricsRegistry)
+	auditLog := audit.NewLog(memory)
 
-	interceptors := []codeact.Interceptor{
+	var interceptors []codeact.Interceptor
+	if options.Redactor != nil {
+		interceptors = append(interceptors, codeact.NewRedactionInterceptor(options.Redactor))
+	}
+	interceptors = append(interceptors,
 		codeact.InterceptorFunc(codeact.ToolStatisticsInterceptor),
 		codeact.InterceptorFunc(codeact.DurableFunctionInterceptor),
 		codeact.NewToolEventPublisher(messageHub),
 		codeact.InterceptorFunc(codeact.ResetTemporarySessionValuesInterceptor),
-	}
+		codeact.NewAuditInterceptor(auditLog),
+		codeact.NewReviewInterceptor(review.NewStager(memory, afero.NewOsFs())),
+		codeact.NewTracingInterceptor(options.TracerProvider),
+	)
 
 	clientFactory := NewModelProviderFactory(encryption, memory)
 
+	interpreter := codeact.NewInterpreter(options.Tools, interceptors)
+	interpreter.CustomTools = customTools
+
 	runtime := &Runtime{
 		memory:         memory,
 		encryption:     encryption,
+		secretProvider: options.SecretProvider,
 		eventHub:       messageHub,
 		bus:            eventBus,
-		taskReconciler: NewTaskReconciler(memory, codeact.NewInterpreter(options.Tools, interceptors), options.Concurrency, eventBus, messageHub, clientFactory, metricsRegistry),
+		taskReconciler: NewTaskReconciler(memory, interpreter, options.Concurrency, eventBus, messageHub, clientFactory, metricsRegistry, options.TracerProvider, NewTaskLeaser(memory, DefaultLeaseOwner(), DefaultLeaseDuration)),
+		scheduler:      scheduler.NewScheduler(memory, eventBus),
+		notifier:       notification.NewNotifier(memory, encryption, eventBus),
+		auditLog:       auditLog,
+		compactor:      NewCompactor(memory, clientFactory),
 		analytics:      options.Analytics,
 		logger:         logger,
 		metrics:        metricsRegistry,
 	}
 
-	api := api.NewServer(runtime, listener, runtime.bus, runtime.analytics)
+	api, err := api.NewServer(runtime, listener, runtime.bus, runtime.analytics, options.TracerProvider, options.WebUI)
+	if err != nil {
+		LogError(logger, "initialize API server", err)
+		return nil, err
+	}
 	runtime.api = api
 
 	listenerAddr := listener.Addr().String()
@@ -164,6 +251,26 @@ func (rt *Runtime) Run(ctx context.Context) error {
 		}
 	}()
 
+	rt.wg.Add(1)
+	go func() {
+		defer rt.wg.Done()
+		LogComponentStartup(rt.logger, "scheduler")
+		err := rt.scheduler.Run(ctx)
+		if err != nil {
+			LogError(rt.logger, "scheduler run", err)
+		}
+	}()
+
+	rt.wg.Add(1)
+	go func() {
+		defer rt.wg.Done()
+		LogComponentStartup(rt.logger, "notifier")
+		err := rt.notifier.Run(ctx)
+		if err != nil {
+			LogError(rt.logger, "notifier run", err)
+		}
+	}()
+
 	rt.logger.Info("agent runtime fully initialized, waiting for shutdown signal")
 	<-ctx.Done()
 
@@ -200,6 +307,10 @@ func (rt *Runtime) Encryption() *secret.Encryption {
 	return rt.encryption
 }
 
+func (rt *Runtime) SecretProvider() secret.Provider {
+	return rt.secretProvider
+}
+
 func (rt *Runtime) Memory() *memory.Client {
 	return rt.memory
 }
@@ -208,6 +319,32 @@ func (rt *Runtime) EventHub() *event.MessageHub {
 	return rt.eventHub
 }
 
+func (rt *Runtime) Scheduler() *scheduler.Scheduler {
+	return rt.scheduler
+}
+
+func (rt *Runtime) Notifier() *notification.Notifier {
+	return rt.notifier
+}
+
+func (rt *Runtime) AuditLog() *audit.Log {
+	return rt.auditLog
+}
+
+func (rt *Runtime) TaskCompactor() api.TaskCompactor {
+	return rt.compactor
+}
+
+// CustomTools returns the names of the custom tools that agents can enable, sorted by name.
+func (rt *Runtime) CustomTools() []string {
+	var names []string
+	for _, tool := range rt.taskReconciler.interpreter.CustomTools {
+		names = append(names, tool.Name())
+	}
+	slices.Sort(names)
+	return names
+}
+
 func WithRole(role v1.MessageRole) func(*v1.Message) {
 	return func(msg *v1.Message) {
 		msg.Metadata.Role = role
@@ -13,6 +13,7 @@ import (
 	"text/template"
 	"time"
 
+	"entgo.io/ent/dialect"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
@@ -27,6 +28,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/singleflight"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/client-go/util/workqueue"
@@ -64,6 +68,10 @@ type TaskReconciler struct {
 	concurrency     int
 	runningTasks    *SyncMap[uuid.UUID, context.CancelFunc]
 	titleGenGroup   singleflight.Group
+	traceParents    *SyncMap[uuid.UUID, trace.SpanContext]
+	tracer          trace.Tracer
+	leaser          *TaskLeaser
+	retries         *retryBudget
 	wg              sync.WaitGroup
 	logger          *slog.Logger
 }
@@ -76,6 +84,8 @@ func NewTaskReconciler(
 	eventHub *event.MessageHub,
 	providerFactory *ModelProviderFactory,
 	metricsRegistry prometheus.Registerer,
+	tracerProvider trace.TracerProvider,
+	leaser *TaskLeaser,
 ) *TaskReconciler {
 	wqProvider := newWorkqueueMetricsProvider(metricsRegistry)
 	workqueue.SetProvider(wqProvider)
@@ -92,6 +102,10 @@ func NewTaskReconciler(
 		queue:           queue,
 		concurrency:     concurrency,
 		runningTasks:    NewSyncMap[uuid.UUID, context.CancelFunc](),
+		traceParents:    NewSyncMap[uuid.UUID, trace.SpanContext](),
+		tracer:          tracerProvider.Tracer("github.com/furisto/construct/backend/agent"),
+		leaser:          leaser,
+		retries:         newRetryBudget(maxReconcileRetries),
 		logger:          slog.With(KeyComponent, "task_reconciler"),
 	}
 }
@@ -99,8 +113,17 @@ func NewTaskReconciler(
 func (r *TaskReconciler) Run(ctx context.Context) error {
 	LogComponentStartup(r.logger, "task reconciler",
 		KeyConcurrency, r.concurrency,
+		"lease_owner", r.leaser.Owner(),
 	)
 
+	// a SQLite database belongs to a single daemon, so leases that a crashed process left
+	// behind would only delay its tasks
+	if r.memory.Driver().Dialect() == dialect.SQLite {
+		if err := r.leaser.ReleaseAll(ctx); err != nil {
+			r.logger.WarnContext(ctx, "failed to release stale task leases", "error", err)
+		}
+	}
+
 	for range r.concurrency {
 		r.wg.Add(1)
 		go func() {
@@ -109,6 +132,9 @@ func (r *TaskReconciler) Run(ctx context.Context) error {
 	}
 
 	taskEventSub := event.Subscribe(r.bus, func(ctx context.Context, e event.TaskEvent) {
+		if e.Trace.IsValid() {
+			r.traceParents.Set(e.TaskID, e.Trace)
+		}
 		r.queue.Add(e.TaskID)
 	}, nil)
 
@@ -172,6 +198,15 @@ func (r *TaskReconciler) worker(ctx context.Context) {
 			r.publishError(ctx, err, taskID)
 		}
 
+		// only tasks that are not retried anymore have failed, the others may still succeed
+		if r.retries.Exhausted(taskID, result, err) {
+			result = Result{}
+			event.Publish(r.bus, event.TaskFailedEvent{
+				TaskID: taskID,
+				Error:  err.Error(),
+			})
+		}
+
 		switch {
 		case result.RetryAfter > 0:
 			r.logger.DebugContext(ctx, "scheduling task retry",
@@ -212,7 +247,7 @@ func (r *TaskReconciler) publishError(ctx context.Context, err error, taskID uui
 }
 
 // Reconcile is the main entry point for reconciling a task's conversation state
-func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Result, error) {
+func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (result Result, err error) {
 	logger := r.logger.With(KeyTaskID, taskID)
 
 	defer func() {
@@ -226,15 +261,60 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 	reconcileStart := time.Now()
 	logger.DebugContext(ctx, "reconciliation started")
 
+	// all reconciliations of a turn are part of the trace of the request that started it
+	if parent, ok := r.traceParents.Get(taskID); ok {
+		ctx = trace.ContextWithSpanContext(ctx, parent)
+	}
+	ctx, span := r.tracer.Start(ctx, "TaskReconciler.reconcile", trace.WithAttributes(
+		attribute.String("construct.task.id", taskID.String()),
+	))
+	defer func() {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	ctx, cancel := context.WithCancel(ctx)
 	r.runningTasks.Set(taskID, cancel)
 	defer r.runningTasks.Delete(taskID)
 	defer cancel()
 
+	acquired, err := r.leaser.Acquire(ctx, taskID)
+	if err != nil {
+		LogError(logger, "failed to acquire task lease", err)
+		return Result{RetryAfter: transientErrorRetryDelay}, err
+	}
+	if !acquired {
+		logger.DebugContext(ctx, "task is leased by another replica")
+		return Result{RetryAfter: r.leaser.Duration()}, nil
+	}
+
+	keepCtx, stopKeep := context.WithCancel(ctx)
+	kept := make(chan struct{})
+	go func() {
+		defer close(kept)
+		r.leaser.Keep(keepCtx, taskID, func(err error) {
+			logger.ErrorContext(ctx, "lost task lease", "error", err)
+			cancel()
+		})
+	}()
+	defer func() {
+		stopKeep()
+		<-kept
+		if err := r.leaser.Release(context.WithoutCancel(ctx), taskID); err != nil {
+			logger.WarnContext(ctx, "failed to release task lease", "error", err)
+		}
+	}()
+
 	task, agent, err := r.fetchTaskWithAgent(ctx, taskID)
 	if err != nil {
 		LogError(logger, "failed to fetch task with agent", err)
-		return Result{}, fmt.Errorf("failed to fetch task: %w", err)
+		if memory.IsNotFound(err) {
+			return Result{}, fmt.Errorf("failed to fetch task: %w", err)
+		}
+		return Result{RetryAfter: transientErrorRetryDelay}, fmt.Errorf("failed to fetch task: %w", err)
 	}
 	logger.DebugContext(ctx, "task and agent fetched",
 		KeyAgentID, agent.ID,
@@ -247,7 +327,7 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 		All(ctx)
 	if err != nil {
 		LogError(logger, "failed to fetch messages", err)
-		return Result{}, fmt.Errorf("failed to fetch messages: %w", err)
+		return Result{RetryAfter: transientErrorRetryDelay}, fmt.Errorf("failed to fetch messages: %w", err)
 	}
 	logger.DebugContext(ctx, "messages fetched",
 		KeyMessageCount, len(messages),
@@ -269,6 +349,11 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 		KeyProcessedCount, len(status.ProcessedMessages),
 	)
 
+	span.SetAttributes(
+		attribute.String("construct.agent.id", agent.ID.String()),
+		attribute.String("construct.task.phase", string(status.Phase)),
+	)
+
 	r.setTaskPhaseAndPublish(ctx, taskID, status.Phase)
 	defer r.setTaskPhaseAndPublish(ctx, taskID, TaskPhaseAwaitInput)
 
@@ -412,7 +497,7 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 		return Result{}, fmt.Errorf("failed to create model provider: %w", err)
 	}
 
-	systemPrompt, err := r.assembleSystemPrompt(ctx, agent.Instructions, task.ProjectDirectory)
+	systemPrompt, err := r.assembleSystemPrompt(ctx, agent.Instructions, task.ProjectDirectory, r.interpreter.ToolsFor(agent.Tools))
 	if err != nil {
 		LogError(logger, "failed to assemble system prompt", err)
 		return Result{}, fmt.Errorf("failed to assemble system prompt: %w", err)
@@ -420,8 +505,13 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 
 	LogOperationStart(logger, "invoke model")
 	invokeStart := time.Now()
+	invokeCtx, invokeSpan := r.tracer.Start(ctx, "InvokeModel", trace.WithAttributes(
+		attribute.String("gen_ai.request.model", agent.Edges.Model.Name),
+		attribute.String("construct.model_provider.id", agent.Edges.Model.ModelProviderID.String()),
+		attribute.Int("construct.message.count", len(modelMessages)),
+	))
 	message, err := modelProvider.InvokeModel(
-		ctx,
+		invokeCtx,
 		agent.Edges.Model.Name,
 		systemPrompt,
 		modelMessages,
@@ -440,6 +530,7 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 		}),
 	)
 	LogOperationEnd(logger, "invoke model", invokeStart)
+	endInvokeModelSpan(invokeSpan, message, err)
 
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
@@ -507,11 +598,41 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 	protoMessage.Status.ContentState = v1.ContentStatus_CONTENT_STATUS_COMPLETE
 	r.publishMessage(taskID, protoMessage)
 
+	event.Publish(r.bus, event.TaskUsageEvent{
+		TaskID:       taskID,
+		PreviousCost: task.Cost,
+		Cost:         task.Cost + cost,
+	})
+
+	if protoMessage.Status.IsFinalResponse {
+		r.traceParents.Delete(taskID)
+		event.Publish(r.bus, event.TaskCompletedEvent{
+			TaskID: taskID,
+		})
+	}
+
 	LogOperationEnd(logger, "reconciliation (invoke_model)", reconcileStart)
 
 	return Result{Retry: true}, nil
 }
 
+func endInvokeModelSpan(span trace.Span, message *model.Message, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	span.SetAttributes(
+		attribute.Int64("gen_ai.usage.input_tokens", message.Usage.InputTokens),
+		attribute.Int64("gen_ai.usage.output_tokens", message.Usage.OutputTokens),
+		attribute.Int64("construct.usage.cache_write_tokens", message.Usage.CacheWriteTokens),
+		attribute.Int64("construct.usage.cache_read_tokens", message.Usage.CacheReadTokens),
+	)
+}
+
 func (r *TaskReconciler) buildMessageHistory(processedMessages []*memory.Message, nextMessage *memory.Message) ([]*model.Message, error) {
 	modelMessages := make([]*model.Message, 0, len(processedMessages)+1)
 
@@ -532,14 +653,14 @@ func (r *TaskReconciler) buildMessageHistory(processedMessages []*memory.Message
 	return modelMessages, nil
 }
 
-func (r *TaskReconciler) assembleSystemPrompt(ctx context.Context, agentInstruction string, cwd string) (string, error) {
+func (r *TaskReconciler) assembleSystemPrompt(ctx context.Context, agentInstruction string, cwd string, tools []codeact.Tool) (string, error) {
 	var toolInstruction string
-	if len(r.interpreter.Tools) != 0 {
+	if len(tools) != 0 {
 		toolInstruction = prompt.ToolInstructions()
 	}
 
 	var builder strings.Builder
-	for _, tool := range r.interpreter.Tools {
+	for _, tool := range tools {
 		fmt.Fprintf(&builder, "# %s\n%s\n\n", tool.Name(), tool.Description())
 	}
 
@@ -585,6 +706,10 @@ func (r *TaskReconciler) assembleSystemPrompt(ctx context.Context, agentInstruct
 		return "", err
 	}
 
+	if instructions := ProjectInstructions(afero.NewOsFs(), cwd); instructions != "" {
+		fmt.Fprintf(&builder, "\n\n%s\n", instructions)
+	}
+
 	return builder.String(), nil
 }
 
@@ -705,6 +830,11 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 	var toolResults []base.ToolResult
 	toolStats := make(map[string]int64)
 
+	var customTools []string
+	if task.Edges.Agent != nil {
+		customTools = task.Edges.Agent.Tools
+	}
+
 	for _, block := range message.Content.Blocks {
 		switch block.Kind {
 		case types.MessageBlockKindCodeInterpreterCall:
@@ -720,6 +850,9 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 			result, err := r.interpreter.Interpret(ctx, afero.NewOsFs(), toolCall.Args, &codeact.Task{
 				ID:               task.ID,
 				ProjectDirectory: task.ProjectDirectory,
+				Owner:            task.Owner,
+				ReviewEdits:      task.ReviewEdits,
+				CustomTools:      customTools,
 			})
 			toolDuration := time.Since(toolStart)
 
@@ -752,6 +885,15 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 				)
 			}
 			logInterpreterResult(ctx, task.ID, toolCall.ID, interpreterResult)
+
+			for _, call := range result.FunctionCalls {
+				if call.ToolName == base.ToolNameAskUser && call.Input.AskUser != nil {
+					event.Publish(r.bus, event.TaskInputRequestedEvent{
+						TaskID:   task.ID,
+						Question: call.Input.AskUser.Question,
+					})
+				}
+			}
 		}
 	}
 
@@ -0,0 +1,28 @@
+package analytics
+
+// Catalog returns a sample of every event sent by the Emit functions in events.go, with
+// placeholders instead of real values. It lets users audit what analytics would send.
+func Catalog() []Event {
+	client := NewInMemoryClient()
+
+	EmitAgentCreated(client, "<agent_id>", "<agent_name>", "<model_name>")
+	EmitAgentUpdated(client, "<agent_id>", "<agent_name>", []string{"<field>"})
+	EmitModelProviderCreated(client, "<model_provider_id>", "<model_provider_name>", "<provider_type>")
+	EmitModelCreated(client, "<model_id>", "<model_name>", "<model_provider_id>", 0)
+	EmitTaskCreated(client, "<task_id>", "<agent_id>")
+	EmitTaskUpdated(client, "<task_id>", []string{"<field>"})
+	EmitAgentDeleted(client, "<agent_id>", "<agent_name>")
+	EmitModelProviderDeleted(client, "<model_provider_id>", "<model_provider_name>", "<provider_type>")
+	EmitModelDeleted(client, "<model_id>", "<model_name>", "<model_provider_id>")
+	EmitTaskDeleted(client, "<task_id>", "<agent_id>")
+	EmitMessageCreated(client, "<message_id>", "<task_id>", "<role>")
+	EmitMessageDeleted(client, "<message_id>", "<task_id>", "<role>")
+	EmitSessionStarted(client, "<session_type>", "<agent_name>", "<workspace>", false)
+	EmitConversationResumed(client, "<task_id>", 0, 0)
+	EmitQuickAsk(client, "<agent_name>", 0, 0, false, 0)
+	EmitToolExecuted(client, "<task_id>", "<tool_name>", 0, false, "<error_type>")
+	EmitCodeInterpreterSession(client, "<task_id>", 0, 0, 0)
+	EmitAgentHandoff(client, "<from_agent_id>", "<to_agent_id>", "<task_id>", "<reason>", 0)
+
+	return client.Events
+}
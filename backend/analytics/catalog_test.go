@@ -0,0 +1,36 @@
+package analytics
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestCatalogCoversAllEvents(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "events.go", nil, 0)
+	if err != nil {
+		t.Fatalf("failed to parse events.go: %v", err)
+	}
+
+	var emitters []string
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && strings.HasPrefix(fn.Name.Name, "Emit") {
+			emitters = append(emitters, fn.Name.Name)
+		}
+	}
+
+	catalog := Catalog()
+	if len(catalog) != len(emitters) {
+		t.Fatalf("catalog has %d events but events.go has %d Emit functions, add the missing ones to Catalog", len(catalog), len(emitters))
+	}
+
+	seen := make(map[string]bool)
+	for _, event := range catalog {
+		if seen[event.Event] {
+			t.Errorf("event %s is listed more than once", event.Event)
+		}
+		seen[event.Event] = true
+	}
+}
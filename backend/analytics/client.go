@@ -9,6 +9,10 @@ var (
 	PostHogAPIKey = ""
 )
 
+// DefaultPostHogEndpoint is the PostHog instance events are sent to unless another
+// endpoint is configured.
+const DefaultPostHogEndpoint = "https://eu.i.posthog.com"
+
 type Event struct {
 	DistinctId string
 	Event      string
@@ -24,8 +28,12 @@ type PostHogClient struct {
 	client posthog.Client
 }
 
-func NewPostHogClient() (*PostHogClient, error) {
-	client, err := posthog.NewWithConfig(PostHogAPIKey, posthog.Config{Endpoint: "https://eu.i.posthog.com"})
+func NewPostHogClient(endpoint string) (*PostHogClient, error) {
+	if endpoint == "" {
+		endpoint = DefaultPostHogEndpoint
+	}
+
+	client, err := posthog.NewWithConfig(PostHogAPIKey, posthog.Config{Endpoint: endpoint})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,69 @@
+package analytics
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Record is a single line of the JSONL file written by the FileClient.
+type Record struct {
+	Timestamp  time.Time      `json:"timestamp"`
+	DistinctId string         `json:"distinct_id"`
+	Event      string         `json:"event"`
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+// FileClient appends every event as a JSON line to a local file, so that telemetry
+// never leaves the machine.
+type FileClient struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+	now  func() time.Time
+}
+
+func NewFileClient(path string) (*FileClient, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create analytics directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open analytics file: %w", err)
+	}
+
+	return &FileClient{
+		file: file,
+		enc:  json.NewEncoder(file),
+		now:  time.Now,
+	}, nil
+}
+
+func (c *FileClient) Enqueue(event Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c.enc.Encode(Record{
+		Timestamp:  c.now().UTC(),
+		DistinctId: event.DistinctId,
+		Event:      event.Event,
+		Properties: event.Properties,
+	})
+	if err != nil {
+		slog.Error("failed to write analytics event", "event", event.Event, "error", err)
+	}
+}
+
+func (c *FileClient) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.file.Close()
+}
+
+var _ Client = (*FileClient)(nil)
@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// OTLPClient exports every event as an OpenTelemetry log record over OTLP/HTTP, so that
+// telemetry can be collected by an on-premise collector.
+type OTLPClient struct {
+	provider *sdklog.LoggerProvider
+	logger   log.Logger
+}
+
+func NewOTLPClient(endpoint string) (*OTLPClient, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("an endpoint is required for the otlp analytics sink")
+	}
+
+	exporter, err := otlploghttp.New(context.Background(), otlploghttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp log exporter: %w", err)
+	}
+
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+		sdklog.WithResource(resource.NewSchemaless(semconv.ServiceName("construct"))),
+	)
+
+	return &OTLPClient{
+		provider: provider,
+		logger:   provider.Logger("github.com/furisto/construct/backend/analytics"),
+	}, nil
+}
+
+func (c *OTLPClient) Enqueue(event Event) {
+	var record log.Record
+	record.SetTimestamp(time.Now())
+	record.SetEventName(event.Event)
+	record.SetSeverity(log.SeverityInfo)
+	record.SetBody(log.StringValue(event.Event))
+	record.AddAttributes(log.String("distinct_id", event.DistinctId))
+	for key, value := range event.Properties {
+		record.AddAttributes(log.KeyValue{Key: key, Value: logValue(value)})
+	}
+
+	c.logger.Emit(context.Background(), record)
+}
+
+func (c *OTLPClient) Close() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := c.provider.Shutdown(ctx); err != nil {
+		slog.Error("failed to flush analytics events", "error", err)
+	}
+}
+
+var _ Client = (*OTLPClient)(nil)
+
+func logValue(value any) log.Value {
+	switch v := value.(type) {
+	case string:
+		return log.StringValue(v)
+	case bool:
+		return log.BoolValue(v)
+	case int:
+		return log.IntValue(v)
+	case int32:
+		return log.Int64Value(int64(v))
+	case int64:
+		return log.Int64Value(v)
+	case float64:
+		return log.Float64Value(v)
+	case []string:
+		values := make([]log.Value, len(v))
+		for i, s := range v {
+			values[i] = log.StringValue(s)
+		}
+		return log.SliceValue(values...)
+	default:
+		return log.StringValue(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,65 @@
+package analytics
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Sink string
+
+const (
+	// SinkPostHog sends events to PostHog. This is the default.
+	SinkPostHog Sink = "posthog"
+	// SinkFile appends events as JSON lines to a local file.
+	SinkFile Sink = "file"
+	// SinkOTLP exports events as OpenTelemetry log records.
+	SinkOTLP Sink = "otlp"
+	// SinkNone disables analytics.
+	SinkNone Sink = "none"
+)
+
+func Sinks() []Sink {
+	return []Sink{SinkPostHog, SinkFile, SinkOTLP, SinkNone}
+}
+
+func ParseSink(s string) (Sink, error) {
+	switch Sink(strings.ToLower(strings.TrimSpace(s))) {
+	case "", SinkPostHog:
+		return SinkPostHog, nil
+	case SinkFile:
+		return SinkFile, nil
+	case SinkOTLP:
+		return SinkOTLP, nil
+	case SinkNone, "off", "disabled":
+		return SinkNone, nil
+	default:
+		return "", fmt.Errorf("unknown analytics sink %q, must be one of posthog, file, otlp, none", s)
+	}
+}
+
+// Config selects the analytics sink. Endpoint is the PostHog instance or the OTLP/HTTP logs
+// endpoint, File is the path of the JSONL file.
+type Config struct {
+	Sink     Sink
+	Endpoint string
+	File     string
+}
+
+// NewClient creates the client for the configured sink.
+func NewClient(config Config) (Client, error) {
+	switch config.Sink {
+	case "", SinkPostHog:
+		return NewPostHogClient(config.Endpoint)
+	case SinkFile:
+		if config.File == "" {
+			return nil, fmt.Errorf("a file is required for the file analytics sink")
+		}
+		return NewFileClient(config.File)
+	case SinkOTLP:
+		return NewOTLPClient(config.Endpoint)
+	case SinkNone:
+		return NewNoopClient(), nil
+	default:
+		return nil, fmt.Errorf("unknown analytics sink %q", config.Sink)
+	}
+}
@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"connectrpc.com/connect"
 	v1 "github.com/furisto/construct/api/go/v1"
@@ -11,20 +13,23 @@ import (
 	"github.com/furisto/construct/backend/api/conv"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/google/uuid"
 )
 
 var _ v1connect.AgentServiceHandler = (*AgentHandler)(nil)
 
-func NewAgentHandler(db *memory.Client, analytics analytics.Client) *AgentHandler {
+func NewAgentHandler(db *memory.Client, runtime AgentRuntime, analytics analytics.Client) *AgentHandler {
 	return &AgentHandler{
 		db:        db,
+		runtime:   runtime,
 		analytics: analytics,
 	}
 }
 
 type AgentHandler struct {
 	db        *memory.Client
+	runtime   AgentRuntime
 	analytics analytics.Client
 	v1connect.UnimplementedAgentServiceHandler
 }
@@ -35,15 +40,22 @@ func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid model ID format: %w", err)))
 	}
 
+	if err := h.validateTools(req.Msg.Tools); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
 	type agentModel struct {
 		agent *memory.Agent
 		model *memory.Model
 	}
 
+	owner, team := ownerOf(ctx)
 	am, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*agentModel, error) {
 		create := tx.Agent.Create().
 			SetName(req.Msg.Name).
-			SetInstructions(req.Msg.Instructions)
+			SetInstructions(req.Msg.Instructions).
+			SetOwner(owner).
+			SetTeam(team)
 
 		model, err := tx.Model.Get(ctx, modelID)
 		if err != nil {
@@ -59,6 +71,10 @@ func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.
 			create = create.SetDescription(req.Msg.Description)
 		}
 
+		if len(req.Msg.Tools) > 0 {
+			create = create.SetTools(req.Msg.Tools)
+		}
+
 		agent, err := create.Save(ctx)
 		if err != nil {
 			return nil, err
@@ -93,7 +109,7 @@ func (h *AgentHandler) GetAgent(ctx context.Context, req *connect.Request[v1.Get
 	}
 
 	agent, err := h.db.Agent.Query().
-		Where(agent.ID(id)).
+		Where(agent.ID(id), predicate.Agent(visibleTo(ctx))).
 		WithModel().
 		First(ctx)
 	if err != nil {
@@ -111,7 +127,7 @@ func (h *AgentHandler) GetAgent(ctx context.Context, req *connect.Request[v1.Get
 }
 
 func (h *AgentHandler) ListAgents(ctx context.Context, req *connect.Request[v1.ListAgentsRequest]) (*connect.Response[v1.ListAgentsResponse], error) {
-	query := h.db.Agent.Query().WithModel()
+	query := h.db.Agent.Query().Where(predicate.Agent(visibleTo(ctx))).WithModel()
 
 	if req.Msg.Filter != nil && len(req.Msg.Filter.Names) > 0 {
 		query = query.Where(agent.NameIn(req.Msg.Filter.Names...))
@@ -142,7 +158,15 @@ func (h *AgentHandler) UpdateAgent(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
 	}
 
-	update := h.db.Agent.UpdateOneID(id)
+	existing, err := h.db.Agent.Query().Where(agent.ID(id), predicate.Agent(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := authorizeChange(ctx, "agent", existing.Owner); err != nil {
+		return nil, apiError(err)
+	}
+
+	update := h.db.Agent.UpdateOne(existing)
 
 	var updatedFields []string
 	if req.Msg.Name != nil {
@@ -173,6 +197,18 @@ func (h *AgentHandler) UpdateAgent(ctx context.Context, req *connect.Request[v1.
 		updatedFields = append(updatedFields, "model_id")
 	}
 
+	if req.Msg.Tools != nil {
+		if err := h.validateTools(req.Msg.Tools.Names); err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+		if len(req.Msg.Tools.Names) > 0 {
+			update = update.SetTools(req.Msg.Tools.Names)
+		} else {
+			update = update.ClearTools()
+		}
+		updatedFields = append(updatedFields, "tools")
+	}
+
 	updatedAgent, err := update.Save(ctx)
 	if err != nil {
 		return nil, apiError(err)
@@ -204,10 +240,13 @@ func (h *AgentHandler) DeleteAgent(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
 	}
 
-	agent, err := h.db.Agent.Get(ctx, id)
+	agent, err := h.db.Agent.Query().Where(agent.ID(id), predicate.Agent(visibleTo(ctx))).Only(ctx)
 	if err != nil {
 		return nil, apiError(err)
 	}
+	if err := authorizeChange(ctx, "agent", agent.Owner); err != nil {
+		return nil, apiError(err)
+	}
 
 	if err := h.db.Agent.DeleteOne(agent).Exec(ctx); err != nil {
 		return nil, apiError(fmt.Errorf("failed to delete agent: %w", err))
@@ -217,3 +256,24 @@ func (h *AgentHandler) DeleteAgent(ctx context.Context, req *connect.Request[v1.
 
 	return connect.NewResponse(&v1.DeleteAgentResponse{}), nil
 }
+
+// validateTools checks that the custom tools of an agent are defined by a plugin of the daemon.
+func (h *AgentHandler) validateTools(tools []string) error {
+	available := h.runtime.CustomTools()
+	seen := make(map[string]bool, len(tools))
+	for _, tool := range tools {
+		if seen[tool] {
+			return fmt.Errorf("tool %s is listed more than once", tool)
+		}
+		seen[tool] = true
+
+		if slices.Contains(available, tool) {
+			continue
+		}
+		if len(available) == 0 {
+			return fmt.Errorf("unknown tool %q: the daemon has no custom tools", tool)
+		}
+		return fmt.Errorf("unknown tool %q: available custom tools are %s", tool, strings.Join(available, ", "))
+	}
+	return nil
+}
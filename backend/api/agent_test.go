@@ -115,6 +115,45 @@ func TestCreateAgent(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "success - with custom tools",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+			},
+			Request: &v1.CreateAgentRequest{
+				Name:         "release-agent",
+				Instructions: "Instructions for release agent",
+				ModelId:      modelID.String(),
+				Tools:        []string{"lookup_issue", "deploy_status"},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Response: v1.CreateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{},
+						Spec: &v1.AgentSpec{
+							Name:         "release-agent",
+							Instructions: "Instructions for release agent",
+							ModelId:      modelID.String(),
+							Tools:        []string{"lookup_issue", "deploy_status"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown custom tool",
+			Request: &v1.CreateAgentRequest{
+				Name:         "release-agent",
+				Instructions: "Instructions for release agent",
+				ModelId:      modelID.String(),
+				Tools:        []string{"deploy"},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Error: `invalid_argument: unknown tool "deploy": available custom tools are deploy_status, lookup_issue`,
+			},
+		},
 	})
 }
 
@@ -467,6 +506,86 @@ func TestUpdateAgent(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "success - replace custom tools",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+				test.NewAgentBuilder(t, agentID, db, model).
+					WithName("architect-agent").
+					WithDescription("Architect agent description").
+					WithInstructions("Architect agent instructions").
+					WithTools("lookup_issue").
+					Build(ctx)
+			},
+			Request: &v1.UpdateAgentRequest{
+				Id:    agentID.String(),
+				Tools: &v1.ToolList{Names: []string{"deploy_status"}},
+			},
+			Expected: ServiceTestExpectation[v1.UpdateAgentResponse]{
+				Response: v1.UpdateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{
+							Id: agentID.String(),
+						},
+						Spec: &v1.AgentSpec{
+							Name:         "architect-agent",
+							Description:  "Architect agent description",
+							Instructions: "Architect agent instructions",
+							ModelId:      modelID.String(),
+							Tools:        []string{"deploy_status"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "success - remove custom tools",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+				test.NewAgentBuilder(t, agentID, db, model).
+					WithName("architect-agent").
+					WithDescription("Architect agent description").
+					WithInstructions("Architect agent instructions").
+					WithTools("lookup_issue").
+					Build(ctx)
+			},
+			Request: &v1.UpdateAgentRequest{
+				Id:    agentID.String(),
+				Tools: &v1.ToolList{},
+			},
+			Expected: ServiceTestExpectation[v1.UpdateAgentResponse]{
+				Response: v1.UpdateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{
+							Id: agentID.String(),
+						},
+						Spec: &v1.AgentSpec{
+							Name:         "architect-agent",
+							Description:  "Architect agent description",
+							Instructions: "Architect agent instructions",
+							ModelId:      modelID.String(),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "duplicate custom tool",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+				test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+			},
+			Request: &v1.UpdateAgentRequest{
+				Id:    agentID.String(),
+				Tools: &v1.ToolList{Names: []string{"lookup_issue", "lookup_issue"}},
+			},
+			Expected: ServiceTestExpectation[v1.UpdateAgentResponse]{
+				Error: "invalid_argument: tool lookup_issue is listed more than once",
+			},
+		},
 	})
 }
 
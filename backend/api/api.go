@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
@@ -10,18 +11,40 @@ import (
 	"strings"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
 
 	"github.com/furisto/construct/api/go/v1/v1connect"
 	"github.com/furisto/construct/backend/analytics"
+	"github.com/furisto/construct/backend/audit"
+	"github.com/furisto/construct/backend/auth"
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/notification"
+	"github.com/furisto/construct/backend/scheduler"
 	"github.com/furisto/construct/backend/secret"
+	"github.com/furisto/construct/backend/web"
+	"github.com/furisto/construct/backend/webhook"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type AgentRuntime interface {
 	Memory() *memory.Client
 	Encryption() *secret.Encryption
+	SecretProvider() secret.Provider
 	EventHub() *event.MessageHub
+	Scheduler() *scheduler.Scheduler
+	Notifier() *notification.Notifier
+	AuditLog() *audit.Log
+	TaskCompactor() TaskCompactor
+	// CustomTools returns the names of the custom tools that agents can use, sorted.
+	CustomTools() []string
+}
+
+// TaskCompactor replaces the conversation of a task with a summary. It returns the summary
+// and the number of messages it replaced.
+type TaskCompactor interface {
+	CompactTask(ctx context.Context, taskID uuid.UUID) (string, int, error)
 }
 
 type Server struct {
@@ -30,28 +53,59 @@ type Server struct {
 	listener net.Listener
 }
 
-func NewServer(runtime AgentRuntime, listener net.Listener, eventBus *event.Bus, analyticsClient analytics.Client) *Server {
+// NewServer creates the server for the API. With webUI, it also serves the web UI under /ui/.
+func NewServer(runtime AgentRuntime, listener net.Listener, eventBus *event.Bus, analyticsClient analytics.Client, tracerProvider trace.TracerProvider, webUI bool) (*Server, error) {
+	tracingInterceptor, err := otelconnect.NewInterceptor(
+		otelconnect.WithTracerProvider(tracerProvider),
+		otelconnect.WithoutMetrics(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing interceptor: %w", err)
+	}
+
+	interceptors := []connect.Interceptor{tracingInterceptor}
+	// The Unix socket is protected by its file permissions. Clients that connect over the
+	// network have to present an API token.
+	if listener.Addr().Network() != "unix" {
+		interceptors = append(interceptors, auth.NewInterceptor(runtime.Memory()))
+	}
+	interceptors = append(interceptors, audit.NewInterceptor(runtime.AuditLog(), func(ctx context.Context) string {
+		owner, _ := ownerOf(ctx)
+		return owner
+	}))
+
 	apiHandler := NewHandler(
 		HandlerOptions{
-			DB:           runtime.Memory(),
-			Encryption:   runtime.Encryption(),
-			AgentRuntime: runtime,
-			MessageHub:   runtime.EventHub(),
-			EventBus:     eventBus,
-			Analytics:    analyticsClient,
+			DB:             runtime.Memory(),
+			Encryption:     runtime.Encryption(),
+			SecretProvider: runtime.SecretProvider(),
+			AgentRuntime:   runtime,
+			MessageHub:     runtime.EventHub(),
+			Scheduler:      runtime.Scheduler(),
+			Notifier:       runtime.Notifier(),
+			EventBus:       eventBus,
+			Analytics:      analyticsClient,
+			RequestOptions: []connect.HandlerOption{
+				connect.WithInterceptors(interceptors...),
+			},
 		},
 	)
 
 	mux := http.NewServeMux()
 	mux.Handle("/api/", http.StripPrefix("/api", apiHandler))
+	mux.Handle("POST /webhooks/{name}", webhook.NewHandler(runtime.Memory(), runtime.Encryption(), eventBus))
 	mux.Handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("{\"status\":\"ok\"}"))
 	}))
+	if webUI {
+		mux.Handle("/ui/", http.StripPrefix("/ui", web.Handler()))
+		mux.Handle("GET /{$}", http.RedirectHandler("/ui/", http.StatusFound))
+	}
 
 	return &Server{
 		mux:      mux,
 		listener: listener,
-	}
+	}, nil
 }
 
 func (s *Server) ListenAndServe(ctx context.Context) error {
@@ -70,9 +124,12 @@ func (s *Server) Shutdown(ctx context.Context) error {
 }
 
 type HandlerOptions struct {
-	DB           *memory.Client
-	Encryption   *secret.Encryption
-	AgentRuntime AgentRuntime
+	DB             *memory.Client
+	Encryption     *secret.Encryption
+	SecretProvider secret.Provider
+	AgentRuntime   AgentRuntime
+	Scheduler      *scheduler.Scheduler
+	Notifier       *notification.Notifier
 
 	EventBus   *event.Bus
 	MessageHub *event.MessageHub
@@ -96,7 +153,7 @@ func NewHandler(opts HandlerOptions) *Handler {
 	modelHandler := NewModelHandler(opts.DB)
 	handler.mux.Handle(v1connect.NewModelServiceHandler(modelHandler, opts.RequestOptions...))
 
-	agentHandler := NewAgentHandler(opts.DB, opts.Analytics)
+	agentHandler := NewAgentHandler(opts.DB, opts.AgentRuntime, opts.Analytics)
 	handler.mux.Handle(v1connect.NewAgentServiceHandler(agentHandler, opts.RequestOptions...))
 
 	taskHandler := NewTaskHandler(opts.DB, opts.MessageHub, opts.EventBus, opts.AgentRuntime, opts.Analytics)
@@ -105,6 +162,27 @@ func NewHandler(opts HandlerOptions) *Handler {
 	messageHandler := NewMessageHandler(opts.DB, opts.AgentRuntime, opts.MessageHub, opts.EventBus)
 	handler.mux.Handle(v1connect.NewMessageServiceHandler(messageHandler, opts.RequestOptions...))
 
+	scheduleHandler := NewScheduleHandler(opts.DB, opts.Scheduler)
+	handler.mux.Handle(v1connect.NewScheduleServiceHandler(scheduleHandler, opts.RequestOptions...))
+
+	webhookHandler := NewWebhookHandler(opts.DB, opts.Encryption)
+	handler.mux.Handle(v1connect.NewWebhookServiceHandler(webhookHandler, opts.RequestOptions...))
+
+	notificationHandler := NewNotificationHandler(opts.DB, opts.Encryption, opts.Notifier)
+	handler.mux.Handle(v1connect.NewNotificationServiceHandler(notificationHandler, opts.RequestOptions...))
+
+	archiveHandler := NewArchiveHandler(opts.DB, opts.Encryption)
+	handler.mux.Handle(v1connect.NewArchiveServiceHandler(archiveHandler, opts.RequestOptions...))
+
+	auditHandler := NewAuditHandler(opts.DB)
+	handler.mux.Handle(v1connect.NewAuditServiceHandler(auditHandler, opts.RequestOptions...))
+
+	secretHandler := NewSecretHandler(opts.DB, opts.Encryption, opts.SecretProvider)
+	handler.mux.Handle(v1connect.NewSecretServiceHandler(secretHandler, opts.RequestOptions...))
+
+	searchHandler := NewSearchHandler(opts.DB)
+	handler.mux.Handle(v1connect.NewSearchServiceHandler(searchHandler, opts.RequestOptions...))
+
 	return handler
 }
 
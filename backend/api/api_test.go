@@ -14,11 +14,16 @@ import (
 	"entgo.io/ent/dialect/sql/schema"
 	api_client "github.com/furisto/construct/api/go/client"
 	"github.com/furisto/construct/backend/analytics"
+	"github.com/furisto/construct/backend/audit"
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/backend/notification"
+	"github.com/furisto/construct/backend/scheduler"
 	"github.com/furisto/construct/backend/secret"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
+	"github.com/spf13/afero"
 )
 
 type ClientServiceCall[Request any, Response any] func(ctx context.Context, client *api_client.Client, req *connect.Request[Request]) (*connect.Response[Response], error)
@@ -133,6 +138,11 @@ func DefaultTestHandlerOptions(t *testing.T) HandlerOptions {
 		t.Fatalf("failed creating encryption client: %v", err)
 	}
 
+	secretProvider, err := secret.NewFileProvider("/secrets", afero.NewMemMapFs())
+	if err != nil {
+		t.Fatalf("failed creating secret provider: %v", err)
+	}
+
 	runtime := &MockAgentRuntime{}
 
 	eventBus := event.NewBus(nil)
@@ -142,12 +152,15 @@ func DefaultTestHandlerOptions(t *testing.T) HandlerOptions {
 	}
 
 	return HandlerOptions{
-		DB:           db,
-		Encryption:   encryption,
-		AgentRuntime: runtime,
-		EventBus:     eventBus,
-		MessageHub:   messageHub,
-		Analytics:    analytics.NewInMemoryClient(),
+		DB:             db,
+		Encryption:     encryption,
+		SecretProvider: secretProvider,
+		AgentRuntime:   runtime,
+		Scheduler:      scheduler.NewScheduler(db, eventBus),
+		Notifier:       notification.NewNotifier(db, encryption, eventBus),
+		EventBus:       eventBus,
+		MessageHub:     messageHub,
+		Analytics:      analytics.NewInMemoryClient(),
 	}
 }
 
@@ -171,9 +184,7 @@ func NewTestServer(t *testing.T, handlerOptions HandlerOptions) *TestServer {
 }
 
 func (s *TestServer) Start(ctx context.Context) {
-	if err := s.Options.DB.Schema.Create(ctx); err != nil {
-		s.t.Fatalf("failed creating schema resources: %v", err)
-	}
+	test.Migrate(s.t, s.Options.DB)
 	s.API.Start()
 }
 
@@ -184,8 +195,55 @@ func (s *TestServer) Close() {
 func (s *TestServer) ClearDatabase(ctx context.Context, t *testing.T) error {
 	t.Helper()
 
-	_, err := memory.Transaction(ctx, s.Options.DB, func(tx *memory.Client) (*any, error) {
-		_, err := tx.Message.Delete().Exec(ctx)
+	// audit events are append-only, the trigger that enforces it is recreated once the
+	// log is cleared
+	db := s.Options.DB.DB()
+	var trigger string
+	err := db.QueryRowContext(ctx, "SELECT sql FROM sqlite_master WHERE type = 'trigger' AND name = 'audit_events_no_delete'").Scan(&trigger)
+	if err != nil {
+		return fmt.Errorf("failed to read audit log trigger: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, "DROP TRIGGER audit_events_no_delete"); err != nil {
+		return fmt.Errorf("failed to drop audit log trigger: %w", err)
+	}
+	defer func() {
+		if _, err := db.ExecContext(ctx, trigger); err != nil {
+			t.Fatalf("failed to recreate audit log trigger: %v", err)
+		}
+	}()
+
+	_, err = memory.Transaction(ctx, s.Options.DB, func(tx *memory.Client) (*any, error) {
+		_, err := tx.NotificationSink.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete notification sinks: %w", err)
+		}
+
+		_, err = tx.AuditEvent.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete audit events: %w", err)
+		}
+
+		_, err = tx.WebhookDelivery.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete webhook deliveries: %w", err)
+		}
+
+		_, err = tx.WebhookTrigger.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete webhook triggers: %w", err)
+		}
+
+		_, err = tx.ScheduleRun.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete schedule runs: %w", err)
+		}
+
+		_, err = tx.Schedule.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete schedules: %w", err)
+		}
+
+		_, err = tx.Message.Delete().Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to delete messages: %w", err)
 		}
@@ -302,9 +360,41 @@ func (m *MockAgentRuntime) Encryption() *secret.Encryption {
 	return nil
 }
 
+func (m *MockAgentRuntime) SecretProvider() secret.Provider {
+	return nil
+}
+
 func (m *MockAgentRuntime) EventHub() *event.MessageHub {
 	return nil
 }
 
+func (m *MockAgentRuntime) Scheduler() *scheduler.Scheduler {
+	return nil
+}
+
+func (m *MockAgentRuntime) Notifier() *notification.Notifier {
+	return nil
+}
+
+func (m *MockAgentRuntime) AuditLog() *audit.Log {
+	return nil
+}
+
+func (m *MockAgentRuntime) TaskCompactor() TaskCompactor {
+	return &MockTaskCompactor{}
+}
+
+func (m *MockAgentRuntime) CustomTools() []string {
+	return []string{"deploy_status", "lookup_issue"}
+}
+
 func (m *MockAgentRuntime) CancelTask(id uuid.UUID) {
 }
+
+// MockTaskCompactor reports every compaction as successful without calling a model.
+type MockTaskCompactor struct {
+}
+
+func (m *MockTaskCompactor) CompactTask(ctx context.Context, taskID uuid.UUID) (string, int, error) {
+	return "summary of the conversation", 1, nil
+}
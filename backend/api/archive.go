@@ -0,0 +1,121 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/archive"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/secret"
+)
+
+var _ v1connect.ArchiveServiceHandler = (*ArchiveHandler)(nil)
+
+// archiveChunkSize is the size of the chunks an archive is streamed in.
+const archiveChunkSize = 1 << 20
+
+func NewArchiveHandler(db *memory.Client, encryption *secret.Encryption) *ArchiveHandler {
+	return &ArchiveHandler{
+		db:         db,
+		encryption: encryption,
+	}
+}
+
+type ArchiveHandler struct {
+	db         *memory.Client
+	encryption *secret.Encryption
+	v1connect.UnimplementedArchiveServiceHandler
+}
+
+func (h *ArchiveHandler) ExportArchive(ctx context.Context, req *connect.Request[v1.ExportArchiveRequest], stream *connect.ServerStream[v1.ExportArchiveResponse]) error {
+	if err := authorizeLocal(ctx, "exporting an archive"); err != nil {
+		return apiError(err)
+	}
+
+	secrets, err := conv.ConvertArchiveSecretsToArchive(req.Msg.Secrets)
+	if err != nil {
+		return apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+	if secrets == archive.SecretsEncrypted && req.Msg.Passphrase == "" {
+		return apiError(connect.NewError(connect.CodeInvalidArgument, errors.New("a passphrase is required to export secrets")))
+	}
+
+	filter := archive.Filter{
+		Workspace: req.Msg.GetWorkspace(),
+	}
+	if req.Msg.CreatedAfter != nil {
+		createdAfter := req.Msg.CreatedAfter.AsTime()
+		filter.CreatedAfter = &createdAfter
+	}
+	if req.Msg.CreatedBefore != nil {
+		createdBefore := req.Msg.CreatedBefore.AsTime()
+		filter.CreatedBefore = &createdBefore
+	}
+
+	exported, err := archive.Export(ctx, h.db, h.encryption, archive.ExportOptions{
+		Filter:     filter,
+		Secrets:    secrets,
+		Passphrase: req.Msg.Passphrase,
+	})
+	if err != nil {
+		return apiError(err)
+	}
+
+	w := bufio.NewWriterSize(&chunkWriter{stream: stream}, archiveChunkSize)
+	if err := exported.Write(w); err != nil {
+		return apiError(err)
+	}
+	if err := w.Flush(); err != nil {
+		return apiError(err)
+	}
+
+	return nil
+}
+
+func (h *ArchiveHandler) ImportArchive(ctx context.Context, req *connect.Request[v1.ImportArchiveRequest]) (*connect.Response[v1.ImportArchiveResponse], error) {
+	if err := authorizeLocal(ctx, "importing an archive"); err != nil {
+		return nil, apiError(err)
+	}
+
+	strategy, err := conv.ConvertImportConflictStrategyToArchive(req.Msg.ConflictStrategy)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	imported, err := archive.Read(bytes.NewReader(req.Msg.Archive))
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	result, err := archive.Import(ctx, h.db, h.encryption, imported, archive.ImportOptions{
+		Strategy:   strategy,
+		Passphrase: req.Msg.Passphrase,
+		DryRun:     req.Msg.DryRun,
+	})
+	if errors.Is(err, archive.ErrWrongPassphrase) || errors.Is(err, archive.ErrPassphraseRequired) || errors.Is(err, archive.ErrUnsupportedArchive) {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(conv.ConvertImportResultToProto(result)), nil
+}
+
+// chunkWriter sends everything written to it as a chunk of the archive.
+type chunkWriter struct {
+	stream *connect.ServerStream[v1.ExportArchiveResponse]
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&v1.ExportArchiveResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
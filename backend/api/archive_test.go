@@ -0,0 +1,100 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/archive"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestImportArchive(t *testing.T) {
+	providerID := uuid.New()
+
+	setup := ServiceTestSetup[v1.ImportArchiveRequest, v1.ImportArchiveResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ImportArchiveRequest]) (*connect.Response[v1.ImportArchiveResponse], error) {
+			return client.Archive().ImportArchive(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ImportArchiveResponse{}, v1.ImportResourceResult{}),
+			protocmp.Transform(),
+		},
+	}
+
+	content := writeTestArchive(t, &archive.Archive{
+		Manifest: archive.Manifest{Secrets: archive.SecretsExcluded},
+		ModelProviders: []archive.ModelProviderRecord{
+			{
+				ID:           providerID,
+				CreateTime:   time.Now(),
+				UpdateTime:   time.Now(),
+				Name:         "anthropic",
+				ProviderType: types.ModelProviderTypeAnthropic,
+				Enabled:      true,
+			},
+		},
+	})
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ImportArchiveRequest, v1.ImportArchiveResponse]{
+		{
+			Name: "invalid archive",
+			Request: &v1.ImportArchiveRequest{
+				Archive: []byte("not an archive"),
+			},
+			Expected: ServiceTestExpectation[v1.ImportArchiveResponse]{
+				Error: "invalid_argument: unsupported archive: not a gzip file",
+			},
+		},
+		{
+			Name: "encrypted archive without passphrase",
+			Request: &v1.ImportArchiveRequest{
+				Archive: writeTestArchive(t, &archive.Archive{
+					Manifest: archive.Manifest{
+						Secrets:    archive.SecretsEncrypted,
+						Encryption: &archive.Encryption{KDF: "argon2id"},
+					},
+				}),
+			},
+			Expected: ServiceTestExpectation[v1.ImportArchiveResponse]{
+				Error: "invalid_argument: " + archive.ErrPassphraseRequired.Error(),
+			},
+		},
+		{
+			Name: "model provider without credentials",
+			Request: &v1.ImportArchiveRequest{
+				Archive: content,
+			},
+			Expected: ServiceTestExpectation[v1.ImportArchiveResponse]{
+				Response: v1.ImportArchiveResponse{
+					Results: []*v1.ImportResourceResult{
+						{Resource: "model_providers", Created: 1},
+						{Resource: "models"},
+						{Resource: "agents"},
+						{Resource: "tasks"},
+						{Resource: "messages"},
+					},
+					Warnings: []string{"model provider anthropic was imported without credentials and is disabled"},
+				},
+			},
+		},
+	})
+}
+
+func writeTestArchive(t *testing.T, a *archive.Archive) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	if err := a.Write(&b); err != nil {
+		t.Fatalf("failed to write archive: %v", err)
+	}
+	return b.Bytes()
+}
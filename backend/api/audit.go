@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/google/uuid"
+)
+
+// defaultAuditPageSize is the number of events returned if the request sets no page size.
+const defaultAuditPageSize = 100
+
+var _ v1connect.AuditServiceHandler = (*AuditHandler)(nil)
+
+func NewAuditHandler(db *memory.Client) *AuditHandler {
+	return &AuditHandler{
+		db: db,
+	}
+}
+
+type AuditHandler struct {
+	db *memory.Client
+	v1connect.UnimplementedAuditServiceHandler
+}
+
+func (h *AuditHandler) ListEvents(ctx context.Context, req *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error) {
+	query := h.db.AuditEvent.Query().
+		Where(auditevent.SequenceGT(req.Msg.AfterSequence))
+
+	if identity, ok := auth.IdentityFromContext(ctx); ok {
+		query = query.Where(auditevent.Actor(identity.User))
+	}
+
+	if filter := req.Msg.Filter; filter != nil {
+		if filter.Actor != nil {
+			query = query.Where(auditevent.Actor(*filter.Actor))
+		}
+
+		if filter.Kind != nil {
+			kind, err := conv.ConvertAuditEventKindToMemory(*filter.Kind)
+			if err != nil {
+				return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+			}
+			query = query.Where(auditevent.KindEQ(kind))
+		}
+
+		if filter.TaskId != nil {
+			taskID, err := uuid.Parse(*filter.TaskId)
+			if err != nil {
+				return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+			}
+			query = query.Where(auditevent.TaskID(taskID))
+		}
+
+		if filter.Action != nil {
+			query = query.Where(auditevent.Action(*filter.Action))
+		}
+
+		if filter.CreatedAfter != nil {
+			query = query.Where(auditevent.TimeGTE(filter.CreatedAfter.AsTime()))
+		}
+
+		if filter.CreatedBefore != nil {
+			query = query.Where(auditevent.TimeLT(filter.CreatedBefore.AsTime()))
+		}
+	}
+
+	order := sql.OrderAsc()
+	if req.Msg.SortOrder != nil && *req.Msg.SortOrder == v1.SortOrder_SORT_ORDER_DESC {
+		order = sql.OrderDesc()
+	}
+	query = query.Order(auditevent.BySequence(order))
+
+	pageSize := defaultAuditPageSize
+	if req.Msg.PageSize != nil {
+		pageSize = int(*req.Msg.PageSize)
+	}
+
+	events, err := query.Limit(pageSize).All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoEvents := make([]*v1.AuditEvent, 0, len(events))
+	for _, e := range events {
+		protoEvent, err := conv.ConvertAuditEventToProto(e)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoEvents = append(protoEvents, protoEvent)
+	}
+
+	return connect.NewResponse(&v1.ListEventsResponse{
+		Events: protoEvents,
+	}), nil
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/audit"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestListEvents(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListEventsRequest, v1.ListEventsResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error) {
+			return client.Audit().ListEvents(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListEventsResponse{}, v1.AuditEvent{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.AuditEvent{}, "id", "time", "hash", "previous_hash"),
+		},
+	}
+
+	taskID := uuid.New()
+	seed := func(ctx context.Context, db *memory.Client) {
+		log := audit.NewLog(db)
+		records := []audit.Record{
+			{Kind: types.AuditEventKindToolCall, Actor: "alice", Action: "execute_command", TaskID: &taskID, Command: "go test ./..."},
+			{Kind: types.AuditEventKindAPICall, Actor: "bob", Action: "/construct.v1.AgentService/DeleteAgent", Resource: "coder"},
+			{Kind: types.AuditEventKindToolCall, Actor: "alice", Action: "edit_file", TaskID: &taskID, Path: "/src/main.go"},
+		}
+		for _, record := range records {
+			if _, err := log.Append(ctx, record); err != nil {
+				t.Fatalf("failed to append audit record: %v", err)
+			}
+		}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListEventsRequest, v1.ListEventsResponse]{
+		{
+			Name:    "empty log",
+			Request: &v1.ListEventsRequest{},
+			Expected: ServiceTestExpectation[v1.ListEventsResponse]{
+				Response: v1.ListEventsResponse{},
+			},
+		},
+		{
+			Name:         "filter by task and kind",
+			SeedDatabase: seed,
+			Request: &v1.ListEventsRequest{
+				Filter: &v1.ListEventsRequest_Filter{
+					TaskId: ptr(taskID.String()),
+					Kind:   ptr(v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListEventsResponse]{
+				Response: v1.ListEventsResponse{
+					Events: []*v1.AuditEvent{
+						{Sequence: 1, Kind: v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL, Actor: "alice", Action: "execute_command", TaskId: ptr(taskID.String()), Command: "go test ./..."},
+						{Sequence: 3, Kind: v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL, Actor: "alice", Action: "edit_file", TaskId: ptr(taskID.String()), Path: "/src/main.go"},
+					},
+				},
+			},
+		},
+		{
+			Name:         "newest after sequence",
+			SeedDatabase: seed,
+			Request: &v1.ListEventsRequest{
+				AfterSequence: 1,
+				PageSize:      ptr(int32(1)),
+				SortOrder:     ptr(v1.SortOrder_SORT_ORDER_DESC),
+			},
+			Expected: ServiceTestExpectation[v1.ListEventsResponse]{
+				Response: v1.ListEventsResponse{
+					Events: []*v1.AuditEvent{
+						{Sequence: 3, Kind: v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL, Actor: "alice", Action: "edit_file", TaskId: ptr(taskID.String()), Path: "/src/main.go"},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	ctx := context.Background()
+	handlerOptions := DefaultTestHandlerOptions(t)
+	handlerOptions.RequestOptions = []connect.HandlerOption{
+		connect.WithInterceptors(audit.NewInterceptor(audit.NewLog(handlerOptions.DB), func(ctx context.Context) string {
+			return "alice"
+		})),
+	}
+	server := NewTestServer(t, handlerOptions)
+	server.Start(ctx)
+	defer server.Close()
+
+	apiClient, err := client.NewClient(client.EndpointContext{Address: server.API.URL, Kind: "http"})
+	if err != nil {
+		t.Fatalf("failed to create api client: %v", err)
+	}
+
+	db := server.Options.DB
+	provider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, provider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+
+	created, err := apiClient.Task().CreateTask(ctx, connect.NewRequest(&v1.CreateTaskRequest{
+		AgentId:          agent.ID.String(),
+		ProjectDirectory: "/tmp",
+	}))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	taskID := created.Msg.Task.Metadata.Id
+
+	if _, err := apiClient.Task().ListTasks(ctx, connect.NewRequest(&v1.ListTasksRequest{})); err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	// streams that only read are not audited, the error ends the stream only after the
+	// interceptor returned
+	stream, err := apiClient.Task().Subscribe(ctx, connect.NewRequest(&v1.SubscribeRequest{TaskId: uuid.NewString()}))
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	for stream.Receive() {
+	}
+	if connect.CodeOf(stream.Err()) != connect.CodeNotFound {
+		t.Fatalf("expected subscribing to an unknown task to fail, got %v", stream.Err())
+	}
+	stream.Close()
+
+	_, err = apiClient.Agent().DeleteAgent(ctx, connect.NewRequest(&v1.DeleteAgentRequest{Id: uuid.NewString()}))
+	if err == nil {
+		t.Fatalf("expected deleting an unknown agent to fail")
+	}
+
+	resp, err := apiClient.Audit().ListEvents(ctx, connect.NewRequest(&v1.ListEventsRequest{}))
+	if err != nil {
+		t.Fatalf("failed to list events: %v", err)
+	}
+
+	expected := []*v1.AuditEvent{
+		{Sequence: 1, Kind: v1.AuditEventKind_AUDIT_EVENT_KIND_API_CALL, Actor: "alice", Action: "/construct.v1.TaskService/CreateTask", Resource: taskID, TaskId: ptr(taskID)},
+		{Sequence: 2, Kind: v1.AuditEventKind_AUDIT_EVENT_KIND_API_CALL, Actor: "alice", Action: "/construct.v1.AgentService/DeleteAgent", Error: "not_found: agent not found"},
+	}
+	options := []cmp.Option{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(&v1.AuditEvent{}, "id", "time", "hash", "previous_hash", "duration_ms", "resource"),
+	}
+	if diff := cmp.Diff(expected, resp.Msg.Events, options...); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+
+	if verified, err := audit.Verify(ctx, db); err != nil || verified != 2 {
+		t.Errorf("expected 2 verified events, got %d: %v", verified, err)
+	}
+}
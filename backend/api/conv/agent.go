@@ -22,6 +22,8 @@ func ConvertAgentMetadataToProto(a *memory.Agent) *v1.AgentMetadata {
 		Id:        a.ID.String(),
 		CreatedAt: ConvertTimeToTimestamp(a.CreateTime),
 		UpdatedAt: ConvertTimeToTimestamp(a.UpdateTime),
+		Owner:     a.Owner,
+		Team:      a.Team,
 	}
 }
 
@@ -31,5 +33,6 @@ func ConvertAgentSpecToProto(a *memory.Agent) (*v1.AgentSpec, error) {
 		Description:  a.Description,
 		Instructions: a.Instructions,
 		ModelId:      ConvertUUIDToString(a.ModelID),
+		Tools:        a.Tools,
 	}, nil
 }
@@ -0,0 +1,53 @@
+package conv
+
+import (
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/archive"
+)
+
+func ConvertArchiveSecretsToArchive(s v1.ArchiveSecrets) (archive.Secrets, error) {
+	switch s {
+	case v1.ArchiveSecrets_ARCHIVE_SECRETS_EXCLUDED, v1.ArchiveSecrets_ARCHIVE_SECRETS_UNSPECIFIED:
+		return archive.SecretsExcluded, nil
+	case v1.ArchiveSecrets_ARCHIVE_SECRETS_ENCRYPTED:
+		return archive.SecretsEncrypted, nil
+	default:
+		return "", fmt.Errorf("unsupported archive secrets: %v", s)
+	}
+}
+
+func ConvertImportConflictStrategyToArchive(s v1.ImportConflictStrategy) (archive.ConflictStrategy, error) {
+	switch s {
+	case v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_SKIP, v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_UNSPECIFIED:
+		return archive.ConflictSkip, nil
+	case v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_OVERWRITE:
+		return archive.ConflictOverwrite, nil
+	case v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_REMAP:
+		return archive.ConflictRemap, nil
+	default:
+		return "", fmt.Errorf("unsupported import conflict strategy: %v", s)
+	}
+}
+
+func ConvertImportResultToProto(result *archive.ImportResult) *v1.ImportArchiveResponse {
+	response := &v1.ImportArchiveResponse{
+		Warnings: result.Warnings,
+	}
+
+	for _, resource := range archive.Resources {
+		r, ok := result.Resources[resource]
+		if !ok {
+			continue
+		}
+		response.Results = append(response.Results, &v1.ImportResourceResult{
+			Resource: string(resource),
+			Created:  r.Created,
+			Updated:  r.Updated,
+			Skipped:  r.Skipped,
+			Remapped: r.Remapped,
+		})
+	}
+	return response
+}
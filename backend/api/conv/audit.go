@@ -0,0 +1,63 @@
+package conv
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+func ConvertAuditEventToProto(e *memory.AuditEvent) (*v1.AuditEvent, error) {
+	kind, err := ConvertAuditEventKindToProto(e.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &v1.AuditEvent{
+		Id:           e.ID.String(),
+		Sequence:     e.Sequence,
+		Time:         ConvertTimeToTimestamp(e.Time),
+		Kind:         kind,
+		Actor:        e.Actor,
+		Action:       e.Action,
+		Resource:     e.Resource,
+		TaskId:       ConvertUUIDPtrToStringPtr(e.TaskID),
+		Workspace:    e.Workspace,
+		Command:      e.Command,
+		Path:         e.Path,
+		DiffHash:     e.DiffHash,
+		DurationMs:   e.DurationMs,
+		Error:        e.Error,
+		PreviousHash: hex.EncodeToString(e.PreviousHash),
+		Hash:         hex.EncodeToString(e.Hash),
+	}
+	if e.ExitCode != nil {
+		exitCode := int32(*e.ExitCode)
+		event.ExitCode = &exitCode
+	}
+	return event, nil
+}
+
+func ConvertAuditEventKindToProto(k types.AuditEventKind) (v1.AuditEventKind, error) {
+	switch k {
+	case types.AuditEventKindToolCall:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL, nil
+	case types.AuditEventKindAPICall:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_API_CALL, nil
+	default:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED, fmt.Errorf("unsupported audit event kind: %v", k)
+	}
+}
+
+func ConvertAuditEventKindToMemory(k v1.AuditEventKind) (types.AuditEventKind, error) {
+	switch k {
+	case v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL:
+		return types.AuditEventKindToolCall, nil
+	case v1.AuditEventKind_AUDIT_EVENT_KIND_API_CALL:
+		return types.AuditEventKindAPICall, nil
+	default:
+		return "", fmt.Errorf("unsupported audit event kind: %v", k)
+	}
+}
@@ -0,0 +1,54 @@
+package conv
+
+import (
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/review"
+)
+
+func ConvertFileChangeToProto(c *memory.FileChange) *v1.FileChange {
+	patch, added, removed := review.Patch(c)
+
+	return &v1.FileChange{
+		Id:           c.ID.String(),
+		TaskId:       c.TaskID.String(),
+		Path:         c.Path,
+		Status:       ConvertFileChangeStatusToProto(c.Status),
+		Patch:        patch,
+		LinesAdded:   int32(added),
+		LinesRemoved: int32(removed),
+		Created:      c.OriginalContent == nil,
+		Reason:       c.Reason,
+		CreatedAt:    ConvertTimeToTimestamp(c.CreateTime),
+		UpdatedAt:    ConvertTimeToTimestamp(c.UpdateTime),
+	}
+}
+
+func ConvertFileChangeStatusToProto(s types.FileChangeStatus) v1.FileChangeStatus {
+	switch s {
+	case types.FileChangeStatusPending:
+		return v1.FileChangeStatus_FILE_CHANGE_STATUS_PENDING
+	case types.FileChangeStatusAccepted:
+		return v1.FileChangeStatus_FILE_CHANGE_STATUS_ACCEPTED
+	case types.FileChangeStatusRejected:
+		return v1.FileChangeStatus_FILE_CHANGE_STATUS_REJECTED
+	default:
+		return v1.FileChangeStatus_FILE_CHANGE_STATUS_UNSPECIFIED
+	}
+}
+
+func ConvertFileChangeStatusToMemory(s v1.FileChangeStatus) (types.FileChangeStatus, error) {
+	switch s {
+	case v1.FileChangeStatus_FILE_CHANGE_STATUS_PENDING:
+		return types.FileChangeStatusPending, nil
+	case v1.FileChangeStatus_FILE_CHANGE_STATUS_ACCEPTED:
+		return types.FileChangeStatusAccepted, nil
+	case v1.FileChangeStatus_FILE_CHANGE_STATUS_REJECTED:
+		return types.FileChangeStatusRejected, nil
+	default:
+		return "", fmt.Errorf("unsupported file change status: %v", s)
+	}
+}
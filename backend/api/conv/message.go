@@ -1,6 +1,7 @@
 package conv
 
 import (
+	"encoding/json"
 	"fmt"
 
 	v1 "github.com/furisto/construct/api/go/v1"
@@ -22,10 +23,10 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 			TaskId:    m.TaskID.String(),
 			AgentId:   ConvertUUIDPtrToStringPtr(m.AgentID),
 			ModelId:   ConvertUUIDPtrToStringPtr(m.ModelID),
-			Role:      convertRole(m.Source),
+			Role:      ConvertMemoryRoleToProto(m.Source),
 		},
 		Spec: &v1.MessageSpec{
-			Content: []*v1.MessagePart{
+			Content: append([]*v1.MessagePart{
 				{
 					Data: &v1.MessagePart_Text_{
 						Text: &v1.MessagePart_Text{
@@ -33,7 +34,7 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 						},
 					},
 				},
-			},
+			}, convertFileAttachments(m.Content)...),
 		},
 		Status: &v1.MessageStatus{
 			Usage: convertUsage(m.Usage),
@@ -41,7 +42,7 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 	}, nil
 }
 
-func convertRole(role types.MessageSource) v1.MessageRole {
+func ConvertMemoryRoleToProto(role types.MessageSource) v1.MessageRole {
 	switch role {
 	case types.MessageSourceUser:
 		return v1.MessageRole_MESSAGE_ROLE_USER
@@ -79,6 +80,35 @@ func convertContent(content *types.MessageContent) string {
 	return ""
 }
 
+func convertFileAttachments(content *types.MessageContent) []*v1.MessagePart {
+	if content == nil {
+		return nil
+	}
+
+	var parts []*v1.MessagePart
+	for _, block := range content.Blocks {
+		if block.Kind != types.MessageBlockKindFileAttachment {
+			continue
+		}
+
+		var attachment types.FileAttachment
+		if err := json.Unmarshal([]byte(block.Payload), &attachment); err != nil {
+			continue
+		}
+		parts = append(parts, &v1.MessagePart{
+			Data: &v1.MessagePart_FileAttachment_{
+				FileAttachment: &v1.MessagePart_FileAttachment{
+					Path:      attachment.Path,
+					StartLine: uint32(attachment.StartLine),
+					EndLine:   uint32(attachment.EndLine),
+					Content:   attachment.Content,
+				},
+			},
+		})
+	}
+	return parts
+}
+
 func ConvertProtoMessageToMemory(m *v1.Message) (*memory.Message, error) {
 	if m == nil {
 		return nil, fmt.Errorf("message is nil")
@@ -150,6 +180,17 @@ func ConvertProtoContentToMemory(content []*v1.MessagePart) *types.MessageConten
 				Kind:    types.MessageBlockKindText,
 				Payload: messagePart.Text.Content,
 			})
+		case *v1.MessagePart_FileAttachment_:
+			payload, _ := json.Marshal(types.FileAttachment{
+				Path:      messagePart.FileAttachment.Path,
+				StartLine: int(messagePart.FileAttachment.StartLine),
+				EndLine:   int(messagePart.FileAttachment.EndLine),
+				Content:   messagePart.FileAttachment.Content,
+			})
+			blocks = append(blocks, types.MessageBlock{
+				Kind:    types.MessageBlockKindFileAttachment,
+				Payload: string(payload),
+			})
 		}
 	}
 
@@ -25,6 +25,8 @@ func ConvertModelProviderIntoProto(mp *memory.ModelProvider) (*v1.ModelProvider,
 			CreatedAt:    timestamppb.New(mp.CreateTime),
 			UpdatedAt:    timestamppb.New(mp.UpdateTime),
 			ProviderType: protoType,
+			Owner:        mp.Owner,
+			Team:         mp.Team,
 		},
 		Spec: &v1.ModelProviderSpec{
 			Name:    mp.Name,
@@ -0,0 +1,145 @@
+package conv
+
+import (
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+func ConvertNotificationSinkToProto(s *memory.NotificationSink) (*v1.NotificationSink, error) {
+	kind, err := ConvertNotificationSinkKindToProto(s.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]v1.NotificationEvent, 0, len(s.Events))
+	for _, e := range s.Events {
+		protoEvent, err := ConvertNotificationEventToProto(e)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, protoEvent)
+	}
+
+	return &v1.NotificationSink{
+		Metadata: &v1.NotificationSinkMetadata{
+			Id:        s.ID.String(),
+			CreatedAt: ConvertTimeToTimestamp(s.CreateTime),
+			UpdatedAt: ConvertTimeToTimestamp(s.UpdateTime),
+		},
+		Spec: &v1.NotificationSinkSpec{
+			Name:          s.Name,
+			Description:   s.Description,
+			Kind:          kind,
+			Events:        events,
+			AgentId:       ConvertUUIDPtrToStringPtr(s.AgentID),
+			Budget:        s.Budget,
+			Url:           s.URL,
+			Email:         ConvertNotificationEmailConfigToProto(s.Email),
+			TitleTemplate: s.TitleTemplate,
+			BodyTemplate:  s.BodyTemplate,
+			Enabled:       s.Enabled,
+		},
+	}, nil
+}
+
+func ConvertNotificationEmailConfigToProto(c *types.NotificationEmailConfig) *v1.NotificationEmailConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &v1.NotificationEmailConfig{
+		SmtpAddress: c.SMTPAddress,
+		Username:    c.Username,
+		From:        c.From,
+		To:          c.To,
+	}
+}
+
+func ConvertNotificationEmailConfigToMemory(c *v1.NotificationEmailConfig) *types.NotificationEmailConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &types.NotificationEmailConfig{
+		SMTPAddress: c.SmtpAddress,
+		Username:    c.Username,
+		From:        c.From,
+		To:          c.To,
+	}
+}
+
+func ConvertNotificationSinkKindToProto(k types.NotificationSinkKind) (v1.NotificationSinkKind, error) {
+	switch k {
+	case types.NotificationSinkKindWebhook:
+		return v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK, nil
+	case types.NotificationSinkKindDesktop:
+		return v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_DESKTOP, nil
+	case types.NotificationSinkKindEmail:
+		return v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_EMAIL, nil
+	default:
+		return v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_UNSPECIFIED, fmt.Errorf("unsupported notification sink kind: %v", k)
+	}
+}
+
+func ConvertNotificationSinkKindToMemory(k v1.NotificationSinkKind) (types.NotificationSinkKind, error) {
+	switch k {
+	case v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK:
+		return types.NotificationSinkKindWebhook, nil
+	case v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_DESKTOP:
+		return types.NotificationSinkKindDesktop, nil
+	case v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_EMAIL:
+		return types.NotificationSinkKindEmail, nil
+	default:
+		return "", fmt.Errorf("unsupported notification sink kind: %v", k)
+	}
+}
+
+func ConvertNotificationEventToProto(e types.NotificationEvent) (v1.NotificationEvent, error) {
+	switch e {
+	case types.NotificationEventTaskCompleted:
+		return v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED, nil
+	case types.NotificationEventTaskSuspended:
+		return v1.NotificationEvent_NOTIFICATION_EVENT_TASK_SUSPENDED, nil
+	case types.NotificationEventTaskFailed:
+		return v1.NotificationEvent_NOTIFICATION_EVENT_TASK_FAILED, nil
+	case types.NotificationEventInputRequested:
+		return v1.NotificationEvent_NOTIFICATION_EVENT_INPUT_REQUESTED, nil
+	case types.NotificationEventBudgetExceeded:
+		return v1.NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED, nil
+	default:
+		return v1.NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED, fmt.Errorf("unsupported notification event: %v", e)
+	}
+}
+
+// ConvertNotificationEventsToMemory converts the events of a request and drops duplicates.
+func ConvertNotificationEventsToMemory(events []v1.NotificationEvent) ([]types.NotificationEvent, error) {
+	result := make([]types.NotificationEvent, 0, len(events))
+	seen := make(map[types.NotificationEvent]bool, len(events))
+	for _, e := range events {
+		var event types.NotificationEvent
+		switch e {
+		case v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED:
+			event = types.NotificationEventTaskCompleted
+		case v1.NotificationEvent_NOTIFICATION_EVENT_TASK_SUSPENDED:
+			event = types.NotificationEventTaskSuspended
+		case v1.NotificationEvent_NOTIFICATION_EVENT_TASK_FAILED:
+			event = types.NotificationEventTaskFailed
+		case v1.NotificationEvent_NOTIFICATION_EVENT_INPUT_REQUESTED:
+			event = types.NotificationEventInputRequested
+		case v1.NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED:
+			event = types.NotificationEventBudgetExceeded
+		default:
+			return nil, fmt.Errorf("unsupported notification event: %v", e)
+		}
+
+		if !seen[event] {
+			seen[event] = true
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,182 @@
+package conv
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ConvertScheduleToProto(s *memory.Schedule) (*v1.Schedule, error) {
+	spec, err := ConvertScheduleSpecToProto(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Schedule{
+		Metadata: ConvertScheduleMetadataToProto(s),
+		Spec:     spec,
+		Status:   ConvertScheduleStatusToProto(s),
+	}, nil
+}
+
+func ConvertScheduleMetadataToProto(s *memory.Schedule) *v1.ScheduleMetadata {
+	return &v1.ScheduleMetadata{
+		Id:        s.ID.String(),
+		CreatedAt: ConvertTimeToTimestamp(s.CreateTime),
+		UpdatedAt: ConvertTimeToTimestamp(s.UpdateTime),
+	}
+}
+
+func ConvertScheduleSpecToProto(s *memory.Schedule) (*v1.ScheduleSpec, error) {
+	overlapPolicy, err := ConvertScheduleOverlapPolicyToProto(s.OverlapPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	catchUpPolicy, err := ConvertScheduleCatchUpPolicyToProto(s.CatchUpPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ScheduleSpec{
+		Name:           s.Name,
+		CronExpression: s.CronExpression,
+		AgentId:        s.AgentID.String(),
+		Workspace:      s.Workspace,
+		PromptTemplate: s.PromptTemplate,
+		OverlapPolicy:  overlapPolicy,
+		CatchUpPolicy:  catchUpPolicy,
+		Enabled:        s.Enabled,
+	}, nil
+}
+
+func ConvertScheduleStatusToProto(s *memory.Schedule) *v1.ScheduleStatus {
+	status := &v1.ScheduleStatus{
+		LastRunTime: convertOptionalTimeToTimestamp(s.LastRunTime),
+	}
+
+	if s.Enabled {
+		status.NextRunTime = convertOptionalTimeToTimestamp(s.NextRunTime)
+	}
+
+	return status
+}
+
+func ConvertScheduleRunToProto(r *memory.ScheduleRun) (*v1.ScheduleRun, error) {
+	trigger, err := ConvertScheduleRunTriggerToProto(r.Trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := ConvertScheduleRunStatusToProto(r.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ScheduleRun{
+		Id:            r.ID.String(),
+		ScheduleId:    r.ScheduleID.String(),
+		TaskId:        ConvertUUIDPtrToStringPtr(r.TaskID),
+		Trigger:       trigger,
+		Status:        status,
+		ScheduledTime: ConvertTimeToTimestamp(r.ScheduledTime),
+		CreatedAt:     ConvertTimeToTimestamp(r.CreateTime),
+		Error:         r.Error,
+	}, nil
+}
+
+func ConvertScheduleOverlapPolicyToProto(p types.ScheduleOverlapPolicy) (v1.ScheduleOverlapPolicy, error) {
+	switch p {
+	case types.ScheduleOverlapPolicyAllow:
+		return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW, nil
+	case types.ScheduleOverlapPolicySkip:
+		return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP, nil
+	case types.ScheduleOverlapPolicyReplace:
+		return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_REPLACE, nil
+	default:
+		return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, fmt.Errorf("unsupported overlap policy: %v", p)
+	}
+}
+
+// ConvertScheduleOverlapPolicyToMemory converts the overlap policy of a request. An unspecified
+// policy maps to skip, which never runs two tasks of the same schedule at once.
+func ConvertScheduleOverlapPolicyToMemory(p v1.ScheduleOverlapPolicy) (types.ScheduleOverlapPolicy, error) {
+	switch p {
+	case v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW:
+		return types.ScheduleOverlapPolicyAllow, nil
+	case v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP, v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED:
+		return types.ScheduleOverlapPolicySkip, nil
+	case v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_REPLACE:
+		return types.ScheduleOverlapPolicyReplace, nil
+	default:
+		return "", fmt.Errorf("unsupported overlap policy: %v", p)
+	}
+}
+
+func ConvertScheduleCatchUpPolicyToProto(p types.ScheduleCatchUpPolicy) (v1.ScheduleCatchUpPolicy, error) {
+	switch p {
+	case types.ScheduleCatchUpPolicySkip:
+		return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_SKIP, nil
+	case types.ScheduleCatchUpPolicyRunOnce:
+		return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE, nil
+	case types.ScheduleCatchUpPolicyRunAll:
+		return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ALL, nil
+	default:
+		return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED, fmt.Errorf("unsupported catch-up policy: %v", p)
+	}
+}
+
+// ConvertScheduleCatchUpPolicyToMemory converts the catch-up policy of a request. An unspecified
+// policy maps to run once.
+func ConvertScheduleCatchUpPolicyToMemory(p v1.ScheduleCatchUpPolicy) (types.ScheduleCatchUpPolicy, error) {
+	switch p {
+	case v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_SKIP:
+		return types.ScheduleCatchUpPolicySkip, nil
+	case v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE, v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED:
+		return types.ScheduleCatchUpPolicyRunOnce, nil
+	case v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ALL:
+		return types.ScheduleCatchUpPolicyRunAll, nil
+	default:
+		return "", fmt.Errorf("unsupported catch-up policy: %v", p)
+	}
+}
+
+func ConvertScheduleRunTriggerToProto(t types.ScheduleRunTrigger) (v1.ScheduleRunTrigger, error) {
+	switch t {
+	case types.ScheduleRunTriggerScheduled:
+		return v1.ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_SCHEDULED, nil
+	case types.ScheduleRunTriggerCatchUp:
+		return v1.ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_CATCH_UP, nil
+	case types.ScheduleRunTriggerManual:
+		return v1.ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_MANUAL, nil
+	default:
+		return v1.ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_UNSPECIFIED, fmt.Errorf("unsupported run trigger: %v", t)
+	}
+}
+
+func ConvertScheduleRunStatusToProto(s types.ScheduleRunStatus) (v1.ScheduleRunStatus, error) {
+	switch s {
+	case types.ScheduleRunStatusStarted:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED, nil
+	case types.ScheduleRunStatusSkipped:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED, nil
+	case types.ScheduleRunStatusFailed:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_FAILED, nil
+	case types.ScheduleRunStatusCompleted:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_COMPLETED, nil
+	default:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED, fmt.Errorf("unsupported run status: %v", s)
+	}
+}
+
+func convertOptionalTimeToTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return ConvertTimeToTimestamp(t)
+}
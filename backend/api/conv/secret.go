@@ -0,0 +1,17 @@
+package conv
+
+import (
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/secret"
+)
+
+func ConvertRotationResultToProto(result *secret.RotationResult) *v1.RotateEncryptionKeyResponse {
+	return &v1.RotateEncryptionKeyResponse{
+		PrimaryKeyId:      result.PrimaryKeyID,
+		DisabledKeyIds:    result.DisabledKeyIDs,
+		ModelProviders:    int32(result.ModelProviders),
+		WebhookTriggers:   int32(result.WebhookTriggers),
+		NotificationSinks: int32(result.NotificationSinks),
+		DryRun:            result.DryRun,
+	}
+}
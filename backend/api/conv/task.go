@@ -1,9 +1,13 @@
 package conv
 
 import (
+	"fmt"
+
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/transcript"
+	"github.com/google/uuid"
 )
 
 func ConvertTaskToProto(t *memory.Task) (*v1.Task, error) {
@@ -20,11 +24,22 @@ func ConvertTaskToProto(t *memory.Task) (*v1.Task, error) {
 }
 
 func ConvertTaskMetadataToProto(t *memory.Task) *v1.TaskMetadata {
-	return &v1.TaskMetadata{
+	metadata := &v1.TaskMetadata{
 		Id:        t.ID.String(),
 		CreatedAt: ConvertTimeToTimestamp(t.CreateTime),
 		UpdatedAt: ConvertTimeToTimestamp(t.UpdateTime),
+		Owner:     t.Owner,
+		Team:      t.Team,
+	}
+
+	if t.ForkedFromID != uuid.Nil {
+		metadata.ForkedFromTaskId = strPtr(t.ForkedFromID.String())
+	}
+	if t.ForkedFromMessageID != uuid.Nil {
+		metadata.ForkedFromMessageId = strPtr(t.ForkedFromMessageID.String())
 	}
+
+	return metadata
 }
 
 func ConvertTaskSpecToProto(t *memory.Task) (*v1.TaskSpec, error) {
@@ -33,6 +48,8 @@ func ConvertTaskSpecToProto(t *memory.Task) (*v1.TaskSpec, error) {
 		Workspace:    t.ProjectDirectory,
 		DesiredPhase: ConvertTaskPhaseToProto(t.DesiredPhase),
 		Description:  t.Description,
+		ReviewEdits:  t.ReviewEdits,
+		Labels:       t.Labels,
 	}, nil
 }
 
@@ -64,3 +81,29 @@ func ConvertTaskPhaseToProto(p types.TaskPhase) v1.TaskPhase {
 		return v1.TaskPhase_TASK_PHASE_UNSPECIFIED
 	}
 }
+
+func ConvertTaskPhaseToMemory(p v1.TaskPhase) (types.TaskPhase, error) {
+	switch p {
+	case v1.TaskPhase_TASK_PHASE_AWAITING:
+		return types.TaskPhaseAwaiting, nil
+	case v1.TaskPhase_TASK_PHASE_RUNNING:
+		return types.TaskPhaseRunning, nil
+	case v1.TaskPhase_TASK_PHASE_SUSPENDED:
+		return types.TaskPhaseSuspended, nil
+	default:
+		return "", fmt.Errorf("unsupported task phase: %v", p)
+	}
+}
+
+func ConvertTranscriptFormatToTranscript(f v1.TranscriptFormat) (transcript.Format, error) {
+	switch f {
+	case v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN, v1.TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED:
+		return transcript.FormatMarkdown, nil
+	case v1.TranscriptFormat_TRANSCRIPT_FORMAT_HTML:
+		return transcript.FormatHTML, nil
+	case v1.TranscriptFormat_TRANSCRIPT_FORMAT_JSON:
+		return transcript.FormatJSON, nil
+	default:
+		return "", fmt.Errorf("unsupported transcript format: %v", f)
+	}
+}
@@ -0,0 +1,90 @@
+package conv
+
+import (
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+func ConvertWebhookTriggerToProto(t *memory.WebhookTrigger) (*v1.WebhookTrigger, error) {
+	target, err := ConvertWebhookTargetToProto(t.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.WebhookTrigger{
+		Metadata: &v1.WebhookTriggerMetadata{
+			Id:        t.ID.String(),
+			CreatedAt: ConvertTimeToTimestamp(t.CreateTime),
+			UpdatedAt: ConvertTimeToTimestamp(t.UpdateTime),
+		},
+		Spec: &v1.WebhookTriggerSpec{
+			Name:            t.Name,
+			Description:     t.Description,
+			Target:          target,
+			AgentId:         ConvertUUIDPtrToStringPtr(t.AgentID),
+			Workspace:       t.Workspace,
+			PromptTemplate:  t.PromptTemplate,
+			TaskIdTemplate:  t.TaskIDTemplate,
+			SignatureHeader: t.SignatureHeader,
+			Enabled:         t.Enabled,
+		},
+	}, nil
+}
+
+func ConvertWebhookDeliveryToProto(d *memory.WebhookDelivery) (*v1.WebhookDelivery, error) {
+	status, err := ConvertWebhookDeliveryStatusToProto(d.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.WebhookDelivery{
+		Id:         d.ID.String(),
+		TriggerId:  d.TriggerID.String(),
+		TaskId:     ConvertUUIDPtrToStringPtr(d.TaskID),
+		Status:     status,
+		StatusCode: int32(d.StatusCode),
+		Error:      d.Error,
+		Payload:    d.Payload,
+		CreatedAt:  ConvertTimeToTimestamp(d.CreateTime),
+	}, nil
+}
+
+func ConvertWebhookTargetToProto(t types.WebhookTarget) (v1.WebhookTarget, error) {
+	switch t {
+	case types.WebhookTargetNewTask:
+		return v1.WebhookTarget_WEBHOOK_TARGET_NEW_TASK, nil
+	case types.WebhookTargetMessage:
+		return v1.WebhookTarget_WEBHOOK_TARGET_MESSAGE, nil
+	default:
+		return v1.WebhookTarget_WEBHOOK_TARGET_UNSPECIFIED, fmt.Errorf("unsupported webhook target: %v", t)
+	}
+}
+
+// ConvertWebhookTargetToMemory converts the target of a request. An unspecified target maps
+// to a new task.
+func ConvertWebhookTargetToMemory(t v1.WebhookTarget) (types.WebhookTarget, error) {
+	switch t {
+	case v1.WebhookTarget_WEBHOOK_TARGET_NEW_TASK, v1.WebhookTarget_WEBHOOK_TARGET_UNSPECIFIED:
+		return types.WebhookTargetNewTask, nil
+	case v1.WebhookTarget_WEBHOOK_TARGET_MESSAGE:
+		return types.WebhookTargetMessage, nil
+	default:
+		return "", fmt.Errorf("unsupported webhook target: %v", t)
+	}
+}
+
+func ConvertWebhookDeliveryStatusToProto(s types.WebhookDeliveryStatus) (v1.WebhookDeliveryStatus, error) {
+	switch s {
+	case types.WebhookDeliveryStatusAccepted:
+		return v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_ACCEPTED, nil
+	case types.WebhookDeliveryStatusRejected:
+		return v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_REJECTED, nil
+	case types.WebhookDeliveryStatusFailed:
+		return v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED, nil
+	default:
+		return v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED, fmt.Errorf("unsupported delivery status: %v", s)
+	}
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/task"
+)
+
+const maxLabels = 32
+
+var (
+	// Keys end up in JSON paths of SQL queries, so they are restricted to characters
+	// that never need quoting.
+	labelKeyPattern   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]{0,62}$`)
+	labelValuePattern = regexp.MustCompile(`^[A-Za-z0-9._/-]{1,255}$`)
+)
+
+func validateLabel(key, value string) error {
+	if !labelKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid label key %q: must have 1-63 letters, digits, '.', '_', '-' or '/' and start with a letter or digit", key)
+	}
+	if !labelValuePattern.MatchString(value) {
+		return fmt.Errorf("invalid value %q of label %s: must have 1-255 letters, digits, '.', '_', '-' or '/'", value, key)
+	}
+	return nil
+}
+
+func validateLabels(labels map[string]string) error {
+	if len(labels) > maxLabels {
+		return fmt.Errorf("a task can have at most %d labels", maxLabels)
+	}
+	for key, value := range labels {
+		if err := validateLabel(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeLabels applies an update to the labels of a task. Labels with an empty value
+// in the update are removed.
+func mergeLabels(labels, update map[string]string) (map[string]string, error) {
+	merged := maps.Clone(labels)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+
+	for key, value := range update {
+		if value == "" {
+			if !labelKeyPattern.MatchString(key) {
+				return nil, fmt.Errorf("invalid label key %q", key)
+			}
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+
+	if err := validateLabels(merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// labelSelector parses a comma-separated list of label requirements such as
+// "env=ci,team!=infra,nightly,!flaky" into a predicate that matches tasks meeting all of them.
+func labelSelector(selector string) (predicate.Task, error) {
+	var requirements []func(*sql.Selector) *sql.Predicate
+	for _, requirement := range strings.Split(selector, ",") {
+		requirement = strings.TrimSpace(requirement)
+		if requirement == "" {
+			continue
+		}
+
+		var key, value, op string
+		switch {
+		case strings.Contains(requirement, "!="):
+			key, value, _ = strings.Cut(requirement, "!=")
+			op = "!="
+		case strings.Contains(requirement, "=="):
+			key, value, _ = strings.Cut(requirement, "==")
+			op = "="
+		case strings.Contains(requirement, "="):
+			key, value, _ = strings.Cut(requirement, "=")
+			op = "="
+		case strings.HasPrefix(requirement, "!"):
+			key = strings.TrimPrefix(requirement, "!")
+			op = "!"
+		default:
+			key = requirement
+		}
+
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if op == "=" || op == "!=" {
+			if err := validateLabel(key, value); err != nil {
+				return nil, fmt.Errorf("invalid label selector %q: %w", requirement, err)
+			}
+		} else if !labelKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid label selector %q: invalid label key %q", requirement, key)
+		}
+
+		requirements = append(requirements, labelRequirement(key, value, op))
+	}
+
+	return predicate.Task(func(s *sql.Selector) {
+		for _, requirement := range requirements {
+			s.Where(requirement(s))
+		}
+	}), nil
+}
+
+func labelRequirement(key, value, op string) func(*sql.Selector) *sql.Predicate {
+	return func(s *sql.Selector) *sql.Predicate {
+		column := s.C(task.FieldLabels)
+		path := sqljson.Path(key)
+
+		// Tasks without labels have a NULL column, which the JSON functions propagate.
+		switch op {
+		case "=":
+			return sqljson.ValueEQ(column, value, path)
+		case "!=":
+			return sql.Or(sql.IsNull(column), sql.Not(sqljson.HasKey(column, path)), sqljson.ValueNEQ(column, value, path))
+		case "!":
+			return sql.Or(sql.IsNull(column), sql.Not(sqljson.HasKey(column, path)))
+		default:
+			return sqljson.HasKey(column, path)
+		}
+	}
+}
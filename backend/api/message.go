@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
 	v1 "github.com/furisto/construct/api/go/v1"
@@ -11,9 +12,12 @@ import (
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/workspace"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _ v1connect.MessageServiceHandler = (*MessageHandler)(nil)
@@ -42,10 +46,17 @@ func (h *MessageHandler) CreateMessage(ctx context.Context, req *connect.Request
 	}
 
 	msg, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Message, error) {
-		task, err := tx.Task.Get(ctx, taskID)
+		task, err := tx.Task.Query().Where(task.ID(taskID), predicate.Task(visibleTo(ctx))).Only(ctx)
 		if err != nil {
 			return nil, err
 		}
+		if err := authorizeChange(ctx, "task", task.Owner); err != nil {
+			return nil, err
+		}
+
+		if err := readAttachments(task, req.Msg.Content); err != nil {
+			return nil, err
+		}
 
 		if task.DesiredPhase == types.TaskPhaseSuspended {
 			_, err = tx.Task.UpdateOneID(taskID).SetDesiredPhase(types.TaskPhaseRunning).Save(ctx)
@@ -72,6 +83,7 @@ func (h *MessageHandler) CreateMessage(ctx context.Context, req *connect.Request
 
 	event.Publish(h.eventBus, event.TaskEvent{
 		TaskID: taskID,
+		Trace:  trace.SpanContextFromContext(ctx),
 	})
 
 	return connect.NewResponse(&v1.CreateMessageResponse{
@@ -79,6 +91,38 @@ func (h *MessageHandler) CreateMessage(ctx context.Context, req *connect.Request
 	}), nil
 }
 
+// readAttachments reads the files that are attached to a message from the workspace of the
+// task, so that the message keeps their content even if the files change later.
+func readAttachments(t *memory.Task, content []*v1.MessagePart) error {
+	var ws *workspace.Workspace
+	for _, part := range content {
+		fileAttachment, ok := part.Data.(*v1.MessagePart_FileAttachment_)
+		if !ok {
+			continue
+		}
+
+		if ws == nil {
+			var err error
+			ws, err = openWorkspace(t)
+			if err != nil {
+				return err
+			}
+		}
+
+		file := fileAttachment.FileAttachment
+		attachment, err := ws.ReadAttachment(file.Path, int(file.StartLine), int(file.EndLine))
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to attach %s: %w", file.Path, err))
+		}
+
+		file.Path = attachment.Path
+		file.StartLine = uint32(attachment.StartLine)
+		file.EndLine = uint32(attachment.EndLine)
+		file.Content = attachment.Content
+	}
+	return nil
+}
+
 func (h *MessageHandler) GetMessage(ctx context.Context, req *connect.Request[v1.GetMessageRequest]) (*connect.Response[v1.GetMessageResponse], error) {
 	id, err := uuid.Parse(req.Msg.Id)
 	if err != nil {
@@ -86,7 +130,7 @@ func (h *MessageHandler) GetMessage(ctx context.Context, req *connect.Request[v1
 	}
 
 	msg, err := h.db.Message.Query().
-		Where(message.ID(id)).
+		Where(message.ID(id), message.HasTaskWith(predicate.Task(visibleTo(ctx)))).
 		First(ctx)
 
 	if err != nil {
@@ -104,7 +148,7 @@ func (h *MessageHandler) GetMessage(ctx context.Context, req *connect.Request[v1
 }
 
 func (h *MessageHandler) ListMessages(ctx context.Context, req *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error) {
-	query := h.db.Message.Query().WithTask()
+	query := h.db.Message.Query().Where(message.HasTaskWith(predicate.Task(visibleTo(ctx)))).WithTask()
 
 	if req.Msg.Filter != nil {
 		if req.Msg.Filter.TaskIds != nil {
@@ -160,6 +204,10 @@ func (h *MessageHandler) UpdateMessage(ctx context.Context, req *connect.Request
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
+	if err := h.authorizeMessageChange(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	msg, err := h.db.Message.UpdateOneID(id).
 		SetContent(conv.ConvertProtoContentToMemory(req.Msg.Content)).
 		Save(ctx)
@@ -183,6 +231,10 @@ func (h *MessageHandler) DeleteMessage(ctx context.Context, req *connect.Request
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
+	if err := h.authorizeMessageChange(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	err = h.db.Message.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		return nil, apiError(err)
@@ -190,3 +242,96 @@ func (h *MessageHandler) DeleteMessage(ctx context.Context, req *connect.Request
 
 	return connect.NewResponse(&v1.DeleteMessageResponse{}), nil
 }
+
+// RegenerateMessage deletes the messages that follow a user message and marks it as unprocessed,
+// so that the task reconciler hands the conversation to the agent again from that point on.
+func (h *MessageHandler) RegenerateMessage(ctx context.Context, req *connect.Request[v1.RegenerateMessageRequest]) (*connect.Response[v1.RegenerateMessageResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
+	}
+
+	var removed int
+	msg, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Message, error) {
+		msg, err := tx.Message.Query().
+			Where(message.ID(id), message.HasTaskWith(predicate.Task(visibleTo(ctx)))).
+			WithTask().
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t := msg.Edges.Task
+		if err := authorizeChange(ctx, "task", t.Owner); err != nil {
+			return nil, err
+		}
+
+		if msg.Source != types.MessageSourceUser {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("only user messages can be regenerated"))
+		}
+		if t.Phase == types.TaskPhaseRunning {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task is running, suspend it or wait until it is done"))
+		}
+
+		messages, err := tx.Message.Query().Where(message.TaskIDEQ(t.ID)).Order(message.ByCreateTime()).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		following := messages[slices.IndexFunc(messages, func(m *memory.Message) bool { return m.ID == id })+1:]
+		if len(following) > 0 {
+			ids := make([]uuid.UUID, 0, len(following))
+			for _, m := range following {
+				ids = append(ids, m.ID)
+			}
+			removed, err = tx.Message.Delete().Where(message.IDIn(ids...)).Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if t.DesiredPhase == types.TaskPhaseSuspended {
+			_, err = tx.Task.UpdateOneID(t.ID).SetDesiredPhase(types.TaskPhaseRunning).Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		update := tx.Message.UpdateOneID(id).ClearProcessedTime()
+		if len(req.Msg.Content) > 0 {
+			if err := readAttachments(t, req.Msg.Content); err != nil {
+				return nil, err
+			}
+			update = update.SetContent(conv.ConvertProtoContentToMemory(req.Msg.Content))
+		}
+		return update.Save(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoMsg, err := conv.ConvertMemoryMessageToProto(msg)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	event.Publish(h.eventBus, event.TaskEvent{
+		TaskID: msg.TaskID,
+		Trace:  trace.SpanContextFromContext(ctx),
+	})
+
+	return connect.NewResponse(&v1.RegenerateMessageResponse{
+		Message:         protoMsg,
+		RemovedMessages: int32(removed),
+	}), nil
+}
+
+// authorizeMessageChange applies the ownership of the task to its messages.
+func (h *MessageHandler) authorizeMessageChange(ctx context.Context, id uuid.UUID) error {
+	msg, err := h.db.Message.Query().
+		Where(message.ID(id), message.HasTaskWith(predicate.Task(visibleTo(ctx)))).
+		WithTask().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return authorizeChange(ctx, "task", msg.Edges.Task.Owner)
+}
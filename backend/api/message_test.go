@@ -2,12 +2,16 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/test"
 	"github.com/google/go-cmp/cmp"
@@ -31,6 +35,18 @@ func TestCreateMessage(t *testing.T) {
 
 	taskID := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
 
+	workspaceDir := newTestWorkspace(t, map[string]string{
+		"main.go": "package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n",
+	})
+
+	seedAttachmentTask := func(ctx context.Context, db *memory.Client, projectDirectory string) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+		task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+		db.Task.UpdateOne(task).SetProjectDirectory(projectDirectory).ExecX(ctx)
+	}
+
 	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateMessageRequest, v1.CreateMessageResponse]{
 		{
 			Name: "invalid task ID",
@@ -113,9 +129,98 @@ func TestCreateMessage(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "attachment without workspace",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedAttachmentTask(ctx, db, "")
+			},
+			Request: &v1.CreateMessageRequest{
+				TaskId:  taskID.String(),
+				Content: attachmentContent("main.go", 0, 0),
+			},
+			Expected: ServiceTestExpectation[v1.CreateMessageResponse]{
+				Error: "failed_precondition: task has no workspace",
+			},
+		},
+		{
+			Name: "attachment outside of workspace",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedAttachmentTask(ctx, db, workspaceDir)
+			},
+			Request: &v1.CreateMessageRequest{
+				TaskId:  taskID.String(),
+				Content: attachmentContent("../main.go", 0, 0),
+			},
+			Expected: ServiceTestExpectation[v1.CreateMessageResponse]{
+				Error: fmt.Sprintf("invalid_argument: failed to attach ../main.go: %s: path is outside of the workspace", filepath.Join(filepath.Dir(workspaceDir), "main.go")),
+			},
+		},
+		{
+			Name: "attachment with line range",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedAttachmentTask(ctx, db, workspaceDir)
+			},
+			Request: &v1.CreateMessageRequest{
+				TaskId:  taskID.String(),
+				Content: attachmentContent(filepath.Join(workspaceDir, "main.go"), 3, 5),
+			},
+			Expected: ServiceTestExpectation[v1.CreateMessageResponse]{
+				Response: v1.CreateMessageResponse{
+					Message: &v1.Message{
+						Metadata: &v1.MessageMetadata{
+							TaskId: taskID.String(),
+							Role:   v1.MessageRole_MESSAGE_ROLE_USER,
+						},
+						Spec: &v1.MessageSpec{
+							Content: []*v1.MessagePart{
+								{
+									Data: &v1.MessagePart_Text_{
+										Text: &v1.MessagePart_Text{
+											Content: "Explain this",
+										},
+									},
+								},
+								{
+									Data: &v1.MessagePart_FileAttachment_{
+										FileAttachment: &v1.MessagePart_FileAttachment{
+											Path:      "main.go",
+											StartLine: 3,
+											EndLine:   5,
+											Content:   "func main() {\n\tprintln(\"hello\")\n}\n",
+										},
+									},
+								},
+							},
+						},
+						Status: &v1.MessageStatus{},
+					},
+				},
+			},
+		},
 	})
 }
 
+func attachmentContent(path string, startLine, endLine uint32) []*v1.MessagePart {
+	return []*v1.MessagePart{
+		{
+			Data: &v1.MessagePart_Text_{
+				Text: &v1.MessagePart_Text{
+					Content: "Explain this",
+				},
+			},
+		},
+		{
+			Data: &v1.MessagePart_FileAttachment_{
+				FileAttachment: &v1.MessagePart_FileAttachment{
+					Path:      path,
+					StartLine: startLine,
+					EndLine:   endLine,
+				},
+			},
+		},
+	}
+}
+
 func TestGetMessage(t *testing.T) {
 	setup := ServiceTestSetup[v1.GetMessageRequest, v1.GetMessageResponse]{
 		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.GetMessageRequest]) (*connect.Response[v1.GetMessageResponse], error) {
@@ -712,6 +817,159 @@ func TestDeleteMessage(t *testing.T) {
 	})
 }
 
+func TestRegenerateMessage(t *testing.T) {
+	setup := ServiceTestSetup[v1.RegenerateMessageRequest, v1.RegenerateMessageResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.RegenerateMessageRequest]) (*connect.Response[v1.RegenerateMessageResponse], error) {
+			return client.Message().RegenerateMessage(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.RegenerateMessageResponse{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.MessageMetadata{}, "created_at", "updated_at"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			messages, err := db.Message.Query().Order(message.ByCreateTime()).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			summaries := make([]string, 0, len(messages))
+			for _, m := range messages {
+				summary := fmt.Sprintf("%s: %s", m.Source, m.Content.Blocks[0].Payload)
+				if m.ProcessedTime.IsZero() {
+					summary += " (unprocessed)"
+				}
+				summaries = append(summaries, summary)
+			}
+			return summaries, nil
+		},
+	}
+
+	taskID := uuid.New()
+	messageIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	seed := func(phase types.TaskPhase) func(ctx context.Context, db *memory.Client) {
+		return func(ctx context.Context, db *memory.Client) {
+			modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+			model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+			agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+			task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+			db.Task.UpdateOne(task).SetPhase(phase).ExecX(ctx)
+
+			start := time.Now().Add(-time.Hour)
+			for i, id := range messageIDs {
+				source := types.MessageSourceUser
+				if i%2 == 1 {
+					source = types.MessageSourceAssistant
+				}
+				db.Message.Create().
+					SetID(id).
+					SetTaskID(taskID).
+					SetSource(source).
+					SetContent(&types.MessageContent{Blocks: []types.MessageBlock{
+						{Kind: types.MessageBlockKindText, Payload: fmt.Sprintf("message %d", i+1)},
+					}}).
+					SetCreateTime(start.Add(time.Duration(i) * time.Minute)).
+					SetProcessedTime(time.Now()).
+					ExecX(ctx)
+			}
+		}
+	}
+
+	userMessage := func(id uuid.UUID, content string) *v1.Message {
+		return &v1.Message{
+			Metadata: &v1.MessageMetadata{
+				Id:     id.String(),
+				TaskId: taskID.String(),
+				Role:   v1.MessageRole_MESSAGE_ROLE_USER,
+			},
+			Spec: &v1.MessageSpec{
+				Content: []*v1.MessagePart{
+					{Data: &v1.MessagePart_Text_{Text: &v1.MessagePart_Text{Content: content}}},
+				},
+			},
+			Status: &v1.MessageStatus{},
+		}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.RegenerateMessageRequest, v1.RegenerateMessageResponse]{
+		{
+			Name: "invalid id format",
+			Request: &v1.RegenerateMessageRequest{
+				Id: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.RegenerateMessageResponse]{
+				Error: "invalid_argument: invalid ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "message not found",
+			Request: &v1.RegenerateMessageRequest{
+				Id: messageIDs[0].String(),
+			},
+			Expected: ServiceTestExpectation[v1.RegenerateMessageResponse]{
+				Error: "not_found: message not found",
+			},
+		},
+		{
+			Name:         "assistant message",
+			SeedDatabase: seed(types.TaskPhaseAwaiting),
+			Request: &v1.RegenerateMessageRequest{
+				Id: messageIDs[1].String(),
+			},
+			Expected: ServiceTestExpectation[v1.RegenerateMessageResponse]{
+				Error: "failed_precondition: only user messages can be regenerated",
+			},
+		},
+		{
+			Name:         "task is running",
+			SeedDatabase: seed(types.TaskPhaseRunning),
+			Request: &v1.RegenerateMessageRequest{
+				Id: messageIDs[2].String(),
+			},
+			Expected: ServiceTestExpectation[v1.RegenerateMessageResponse]{
+				Error: "failed_precondition: task is running, suspend it or wait until it is done",
+			},
+		},
+		{
+			Name:         "edit last user message",
+			SeedDatabase: seed(types.TaskPhaseAwaiting),
+			Request: &v1.RegenerateMessageRequest{
+				Id: messageIDs[2].String(),
+				Content: []*v1.MessagePart{
+					{Data: &v1.MessagePart_Text_{Text: &v1.MessagePart_Text{Content: "message 3, but better"}}},
+				},
+			},
+			Expected: ServiceTestExpectation[v1.RegenerateMessageResponse]{
+				Response: v1.RegenerateMessageResponse{
+					Message:         userMessage(messageIDs[2], "message 3, but better"),
+					RemovedMessages: 1,
+				},
+				Database: []string{
+					"user: message 1",
+					"assistant: message 2",
+					"user: message 3, but better (unprocessed)",
+				},
+			},
+		},
+		{
+			Name:         "keep content",
+			SeedDatabase: seed(types.TaskPhaseAwaiting),
+			Request: &v1.RegenerateMessageRequest{
+				Id: messageIDs[0].String(),
+			},
+			Expected: ServiceTestExpectation[v1.RegenerateMessageResponse]{
+				Response: v1.RegenerateMessageResponse{
+					Message:         userMessage(messageIDs[0], "message 1"),
+					RemovedMessages: 3,
+				},
+				Database: []string{
+					"user: message 1 (unprocessed)",
+				},
+			},
+		},
+	})
+}
+
 func rolePtr(r v1.MessageRole) *v1.MessageRole {
 	return &r
 }
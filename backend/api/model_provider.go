@@ -13,6 +13,7 @@ import (
 	"github.com/furisto/construct/backend/memory/agent"
 	modeldb "github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/prompt"
@@ -56,13 +57,16 @@ func (h *ModelProviderHandler) CreateModelProvider(ctx context.Context, req *con
 		return nil, apiError(fmt.Errorf("failed to encrypt API key"))
 	}
 
+	owner, team := ownerOf(ctx)
 	modelProvider, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.ModelProvider, error) {
 		create := tx.ModelProvider.Create().
 			SetID(modelProviderID).
 			SetName(req.Msg.Name).
 			SetProviderType(providerType).
 			SetEnabled(true).
-			SetSecret(encryptedSecret)
+			SetSecret(encryptedSecret).
+			SetOwner(owner).
+			SetTeam(team)
 
 		if req.Msg.Url != nil {
 			create = create.SetURL(*req.Msg.Url)
@@ -132,7 +136,7 @@ func (h *ModelProviderHandler) GetModelProvider(ctx context.Context, req *connec
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
-	modelProvider, err := h.db.ModelProvider.Get(ctx, id)
+	modelProvider, err := h.db.ModelProvider.Query().Where(modelprovider.ID(id), predicate.ModelProvider(visibleTo(ctx))).Only(ctx)
 	if err != nil {
 		return nil, apiError(err)
 	}
@@ -148,7 +152,7 @@ func (h *ModelProviderHandler) GetModelProvider(ctx context.Context, req *connec
 }
 
 func (h *ModelProviderHandler) ListModelProviders(ctx context.Context, req *connect.Request[v1.ListModelProvidersRequest]) (*connect.Response[v1.ListModelProvidersResponse], error) {
-	query := h.db.ModelProvider.Query()
+	query := h.db.ModelProvider.Query().Where(predicate.ModelProvider(visibleTo(ctx)))
 
 	if req.Msg.Filter != nil {
 		if req.Msg.Filter.Enabled != nil {
@@ -194,10 +198,13 @@ func (h *ModelProviderHandler) UpdateModelProvider(ctx context.Context, req *con
 	}
 
 	modelProvider, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.ModelProvider, error) {
-		modelProvider, err := h.db.ModelProvider.Get(ctx, id)
+		modelProvider, err := h.db.ModelProvider.Query().Where(modelprovider.ID(id), predicate.ModelProvider(visibleTo(ctx))).Only(ctx)
 		if err != nil {
 			return nil, apiError(err)
 		}
+		if err := authorizeChange(ctx, "model provider", modelProvider.Owner); err != nil {
+			return nil, err
+		}
 
 		update := h.db.ModelProvider.UpdateOne(modelProvider)
 
@@ -246,10 +253,13 @@ func (h *ModelProviderHandler) DeleteModelProvider(ctx context.Context, req *con
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
-	modelProvider, err := h.db.ModelProvider.Get(ctx, id)
+	modelProvider, err := h.db.ModelProvider.Query().Where(modelprovider.ID(id), predicate.ModelProvider(visibleTo(ctx))).Only(ctx)
 	if err != nil {
 		return nil, apiError(err)
 	}
+	if err := authorizeChange(ctx, "model provider", modelProvider.Owner); err != nil {
+		return nil, apiError(err)
+	}
 
 	if err := h.db.ModelProvider.DeleteOne(modelProvider).Exec(ctx); err != nil {
 		return nil, apiError(fmt.Errorf("failed to delete model provider: %w", err))
@@ -0,0 +1,377 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/notificationsink"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/notification"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+var _ v1connect.NotificationServiceHandler = (*NotificationHandler)(nil)
+
+func NewNotificationHandler(db *memory.Client, encryption *secret.Encryption, notifier *notification.Notifier) *NotificationHandler {
+	return &NotificationHandler{
+		db:         db,
+		encryption: encryption,
+		notifier:   notifier,
+	}
+}
+
+type NotificationHandler struct {
+	db         *memory.Client
+	encryption *secret.Encryption
+	notifier   *notification.Notifier
+	v1connect.UnimplementedNotificationServiceHandler
+}
+
+func (h *NotificationHandler) CreateNotificationSink(ctx context.Context, req *connect.Request[v1.CreateNotificationSinkRequest]) (*connect.Response[v1.CreateNotificationSinkResponse], error) {
+	kind, err := conv.ConvertNotificationSinkKindToMemory(req.Msg.Kind)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	events, err := conv.ConvertNotificationEventsToMemory(req.Msg.Events)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	email := conv.ConvertNotificationEmailConfigToMemory(req.Msg.Email)
+	if err := validateNotificationSink(kind, events, req.Msg.Budget, req.Msg.Url, email); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	if err := validateNotificationTemplates(req.Msg.TitleTemplate, req.Msg.BodyTemplate); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	var agentID uuid.UUID
+	if req.Msg.AgentId != nil {
+		agentID, err = uuid.Parse(*req.Msg.AgentId)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
+		}
+	}
+
+	sinkID := uuid.New()
+	var encryptedSecret []byte
+	if req.Msg.Secret != "" {
+		encryptedSecret, err = h.encryption.Encrypt([]byte(req.Msg.Secret), secret.NotificationSinkAssociated(sinkID))
+		if err != nil {
+			return nil, apiError(fmt.Errorf("failed to encrypt notification sink secret"))
+		}
+	}
+
+	owner, team := ownerOf(ctx)
+	createdSink, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.NotificationSink, error) {
+		create := tx.NotificationSink.Create().
+			SetID(sinkID).
+			SetOwner(owner).
+			SetTeam(team).
+			SetName(req.Msg.Name).
+			SetKind(kind).
+			SetEvents(events).
+			SetNillableBudget(req.Msg.Budget)
+
+		if agentID != uuid.Nil {
+			if _, err := tx.Agent.Query().Where(agent.ID(agentID), predicate.Agent(visibleTo(ctx))).Only(ctx); err != nil {
+				return nil, err
+			}
+			create = create.SetAgentID(agentID)
+		}
+
+		if req.Msg.Description != "" {
+			create = create.SetDescription(req.Msg.Description)
+		}
+
+		if req.Msg.Url != "" {
+			create = create.SetURL(req.Msg.Url)
+		}
+
+		if email != nil {
+			create = create.SetEmail(email)
+		}
+
+		if encryptedSecret != nil {
+			create = create.SetSecret(encryptedSecret)
+		}
+
+		if req.Msg.TitleTemplate != "" {
+			create = create.SetTitleTemplate(req.Msg.TitleTemplate)
+		}
+
+		if req.Msg.BodyTemplate != "" {
+			create = create.SetBodyTemplate(req.Msg.BodyTemplate)
+		}
+
+		return create.Save(ctx)
+	})
+
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSink, err := conv.ConvertNotificationSinkToProto(createdSink)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateNotificationSinkResponse{
+		Sink: protoSink,
+	}), nil
+}
+
+func (h *NotificationHandler) GetNotificationSink(ctx context.Context, req *connect.Request[v1.GetNotificationSinkRequest]) (*connect.Response[v1.GetNotificationSinkResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid notification sink ID format: %w", err)))
+	}
+
+	s, err := h.db.NotificationSink.Query().Where(notificationsink.ID(id), predicate.NotificationSink(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSink, err := conv.ConvertNotificationSinkToProto(s)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetNotificationSinkResponse{
+		Sink: protoSink,
+	}), nil
+}
+
+func (h *NotificationHandler) ListNotificationSinks(ctx context.Context, req *connect.Request[v1.ListNotificationSinksRequest]) (*connect.Response[v1.ListNotificationSinksResponse], error) {
+	query := h.db.NotificationSink.Query().Where(predicate.NotificationSink(visibleTo(ctx)))
+
+	if req.Msg.Filter != nil {
+		if len(req.Msg.Filter.Names) > 0 {
+			query = query.Where(notificationsink.NameIn(req.Msg.Filter.Names...))
+		}
+
+		if req.Msg.Filter.Enabled != nil {
+			query = query.Where(notificationsink.Enabled(*req.Msg.Filter.Enabled))
+		}
+	}
+
+	if req.Msg.PageSize != nil {
+		query = query.Limit(int(*req.Msg.PageSize))
+	}
+
+	sinks, err := query.Order(notificationsink.ByName()).All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSinks := make([]*v1.NotificationSink, 0, len(sinks))
+	for _, s := range sinks {
+		protoSink, err := conv.ConvertNotificationSinkToProto(s)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoSinks = append(protoSinks, protoSink)
+	}
+
+	return connect.NewResponse(&v1.ListNotificationSinksResponse{
+		Sinks: protoSinks,
+	}), nil
+}
+
+func (h *NotificationHandler) UpdateNotificationSink(ctx context.Context, req *connect.Request[v1.UpdateNotificationSinkRequest]) (*connect.Response[v1.UpdateNotificationSinkResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid notification sink ID format: %w", err)))
+	}
+
+	updatedSink, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.NotificationSink, error) {
+		existing, err := tx.NotificationSink.Query().Where(notificationsink.ID(id), predicate.NotificationSink(visibleTo(ctx))).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorizeChange(ctx, "notification sink", existing.Owner); err != nil {
+			return nil, err
+		}
+
+		update := tx.NotificationSink.UpdateOneID(id)
+
+		events := existing.Events
+		if len(req.Msg.Events) > 0 {
+			events, err = conv.ConvertNotificationEventsToMemory(req.Msg.Events)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			update = update.SetEvents(events)
+		}
+
+		budget := existing.Budget
+		if req.Msg.Budget != nil {
+			budget = req.Msg.Budget
+			update = update.SetBudget(*req.Msg.Budget)
+		}
+
+		sinkURL := existing.URL
+		if req.Msg.Url != nil {
+			sinkURL = *req.Msg.Url
+			update = update.SetURL(*req.Msg.Url)
+		}
+
+		if err := validateNotificationSink(existing.Kind, events, budget, sinkURL, existing.Email); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		if err := validateNotificationTemplates(req.Msg.GetTitleTemplate(), req.Msg.GetBodyTemplate()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		if req.Msg.Description != nil {
+			update = update.SetDescription(*req.Msg.Description)
+		}
+
+		if req.Msg.AgentId != nil {
+			agentID, err := uuid.Parse(*req.Msg.AgentId)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err))
+			}
+			if _, err := tx.Agent.Query().Where(agent.ID(agentID), predicate.Agent(visibleTo(ctx))).Only(ctx); err != nil {
+				return nil, err
+			}
+			update = update.SetAgentID(agentID)
+		}
+
+		if req.Msg.Secret != nil {
+			if *req.Msg.Secret == "" {
+				update = update.ClearSecret()
+			} else {
+				encryptedSecret, err := h.encryption.Encrypt([]byte(*req.Msg.Secret), secret.NotificationSinkAssociated(id))
+				if err != nil {
+					return nil, fmt.Errorf("failed to encrypt notification sink secret")
+				}
+				update = update.SetSecret(encryptedSecret)
+			}
+		}
+
+		if req.Msg.TitleTemplate != nil {
+			update = update.SetTitleTemplate(*req.Msg.TitleTemplate)
+		}
+
+		if req.Msg.BodyTemplate != nil {
+			update = update.SetBodyTemplate(*req.Msg.BodyTemplate)
+		}
+
+		if req.Msg.Enabled != nil {
+			update = update.SetEnabled(*req.Msg.Enabled)
+		}
+
+		return update.Save(ctx)
+	})
+
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSink, err := conv.ConvertNotificationSinkToProto(updatedSink)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateNotificationSinkResponse{
+		Sink: protoSink,
+	}), nil
+}
+
+func (h *NotificationHandler) DeleteNotificationSink(ctx context.Context, req *connect.Request[v1.DeleteNotificationSinkRequest]) (*connect.Response[v1.DeleteNotificationSinkResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid notification sink ID format: %w", err)))
+	}
+
+	sink, err := h.db.NotificationSink.Query().Where(notificationsink.ID(id), predicate.NotificationSink(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := authorizeChange(ctx, "notification sink", sink.Owner); err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := h.db.NotificationSink.DeleteOne(sink).Exec(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteNotificationSinkResponse{}), nil
+}
+
+func (h *NotificationHandler) TestNotificationSink(ctx context.Context, req *connect.Request[v1.TestNotificationSinkRequest]) (*connect.Response[v1.TestNotificationSinkResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid notification sink ID format: %w", err)))
+	}
+
+	sink, err := h.db.NotificationSink.Query().Where(notificationsink.ID(id), predicate.NotificationSink(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := authorizeChange(ctx, "notification sink", sink.Owner); err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := h.notifier.Test(ctx, sink.ID); err != nil {
+		if memory.IsNotFound(err) {
+			return nil, apiError(err)
+		}
+		return nil, apiError(connect.NewError(connect.CodeUnavailable, err))
+	}
+
+	return connect.NewResponse(&v1.TestNotificationSinkResponse{}), nil
+}
+
+func validateNotificationSink(kind types.NotificationSinkKind, events []types.NotificationEvent, budget *float64, sinkURL string, email *types.NotificationEmailConfig) error {
+	switch kind {
+	case types.NotificationSinkKindWebhook:
+		if sinkURL == "" {
+			return fmt.Errorf("URL is required for webhook sinks")
+		}
+		u, err := url.Parse(sinkURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid webhook URL %q", sinkURL)
+		}
+	case types.NotificationSinkKindEmail:
+		if email == nil || email.SMTPAddress == "" || email.From == "" || len(email.To) == 0 {
+			return fmt.Errorf("SMTP address, sender and recipients are required for email sinks")
+		}
+	}
+
+	if slices.Contains(events, types.NotificationEventBudgetExceeded) && budget == nil {
+		return fmt.Errorf("budget is required for the budget_exceeded event")
+	}
+
+	return nil
+}
+
+func validateNotificationTemplates(titleTemplate, bodyTemplate string) error {
+	if titleTemplate != "" {
+		if _, err := notification.ParseTemplate("title", titleTemplate); err != nil {
+			return err
+		}
+	}
+
+	if bodyTemplate != "" {
+		if _, err := notification.ParseTemplate("body", bodyTemplate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestCreateNotificationSink(t *testing.T) {
+	setup := ServiceTestSetup[v1.CreateNotificationSinkRequest, v1.CreateNotificationSinkResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.CreateNotificationSinkRequest]) (*connect.Response[v1.CreateNotificationSinkResponse], error) {
+			return client.Notification().CreateNotificationSink(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.CreateNotificationSinkResponse{}, v1.NotificationSink{}, v1.NotificationSinkMetadata{}, v1.NotificationSinkSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.NotificationSink{}, "metadata"),
+		},
+	}
+
+	agentID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateNotificationSinkRequest, v1.CreateNotificationSinkResponse]{
+		{
+			Name: "webhook without URL",
+			Request: &v1.CreateNotificationSinkRequest{
+				Name:   "ci",
+				Kind:   v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK,
+				Events: []v1.NotificationEvent{v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED},
+			},
+			Expected: ServiceTestExpectation[v1.CreateNotificationSinkResponse]{
+				Error: "invalid_argument: URL is required for webhook sinks",
+			},
+		},
+		{
+			Name: "email without recipients",
+			Request: &v1.CreateNotificationSinkRequest{
+				Name:   "mail",
+				Kind:   v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_EMAIL,
+				Events: []v1.NotificationEvent{v1.NotificationEvent_NOTIFICATION_EVENT_TASK_FAILED},
+				Email: &v1.NotificationEmailConfig{
+					SmtpAddress: "smtp.example.com:587",
+					From:        "construct@example.com",
+				},
+			},
+			Expected: ServiceTestExpectation[v1.CreateNotificationSinkResponse]{
+				Error: "invalid_argument: SMTP address, sender and recipients are required for email sinks",
+			},
+		},
+		{
+			Name: "budget event without budget",
+			Request: &v1.CreateNotificationSinkRequest{
+				Name:   "desktop",
+				Kind:   v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_DESKTOP,
+				Events: []v1.NotificationEvent{v1.NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED},
+			},
+			Expected: ServiceTestExpectation[v1.CreateNotificationSinkResponse]{
+				Error: "invalid_argument: budget is required for the budget_exceeded event",
+			},
+		},
+		{
+			Name: "invalid title template",
+			Request: &v1.CreateNotificationSinkRequest{
+				Name:          "desktop",
+				Kind:          v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_DESKTOP,
+				Events:        []v1.NotificationEvent{v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED},
+				TitleTemplate: "{{.Task",
+			},
+			Expected: ServiceTestExpectation[v1.CreateNotificationSinkResponse]{
+				Error: "invalid_argument: invalid title template: template: title:1: unclosed action",
+			},
+		},
+		{
+			Name: "success",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+			},
+			Request: &v1.CreateNotificationSinkRequest{
+				Name:        "ci",
+				Description: "Notify the CI bot",
+				Kind:        v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK,
+				Events: []v1.NotificationEvent{
+					v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED,
+					v1.NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED,
+					v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED,
+				},
+				AgentId: strPtr(agentID.String()),
+				Budget:  ptr(2.5),
+				Url:     "https://ci.example.com/notify",
+				Secret:  "s3cr3t",
+			},
+			Expected: ServiceTestExpectation[v1.CreateNotificationSinkResponse]{
+				Response: v1.CreateNotificationSinkResponse{
+					Sink: &v1.NotificationSink{
+						Spec: &v1.NotificationSinkSpec{
+							Name:        "ci",
+							Description: "Notify the CI bot",
+							Kind:        v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK,
+							Events: []v1.NotificationEvent{
+								v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED,
+								v1.NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED,
+							},
+							AgentId: strPtr(agentID.String()),
+							Budget:  ptr(2.5),
+							Url:     "https://ci.example.com/notify",
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/auth"
+)
+
+// visibleTo restricts a query of tasks, agents, model providers, webhook triggers, schedules
+// or notification sinks to the ones the caller can read: their own, those of their team and
+// those without an owner. Callers without an identity, i.e. over the Unix socket, can read
+// everything.
+func visibleTo(ctx context.Context) func(*sql.Selector) {
+	identity, ok := auth.IdentityFromContext(ctx)
+	return func(s *sql.Selector) {
+		if !ok {
+			return
+		}
+
+		predicates := []*sql.Predicate{
+			sql.IsNull(s.C("owner")),
+			sql.EQ(s.C("owner"), ""),
+			sql.EQ(s.C("owner"), identity.User),
+		}
+		if identity.Team != "" {
+			predicates = append(predicates, sql.EQ(s.C("team"), identity.Team))
+		}
+		s.Where(sql.Or(predicates...))
+	}
+}
+
+// authorizeChange rejects changes to a resource the caller does not own. Resources
+// without an owner can only be changed over the Unix socket.
+func authorizeChange(ctx context.Context, kind string, owner string) error {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok || auth.CanChange(identity, owner) {
+		return nil
+	}
+
+	if owner == "" {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s has no owner and can only be changed locally", kind))
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s is owned by %s", kind, owner))
+}
+
+// ownerOf returns the owner and team recorded on resources the caller creates.
+func ownerOf(ctx context.Context) (owner string, team string) {
+	identity, _ := auth.IdentityFromContext(ctx)
+	return identity.User, identity.Team
+}
+
+// authorizeLocal rejects operations that span the resources of all users unless they are
+// made over the Unix socket.
+func authorizeLocal(ctx context.Context, operation string) error {
+	if identity, ok := auth.IdentityFromContext(ctx); ok {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s covers all users and is not allowed for %s", operation, identity.User))
+	}
+	return nil
+}
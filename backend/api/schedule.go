@@ -0,0 +1,353 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
+	"github.com/furisto/construct/backend/scheduler"
+	"github.com/google/uuid"
+)
+
+var _ v1connect.ScheduleServiceHandler = (*ScheduleHandler)(nil)
+
+func NewScheduleHandler(db *memory.Client, scheduler *scheduler.Scheduler) *ScheduleHandler {
+	return &ScheduleHandler{
+		db:        db,
+		scheduler: scheduler,
+	}
+}
+
+type ScheduleHandler struct {
+	db        *memory.Client
+	scheduler *scheduler.Scheduler
+	v1connect.UnimplementedScheduleServiceHandler
+}
+
+func (h *ScheduleHandler) CreateSchedule(ctx context.Context, req *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error) {
+	agentID, err := uuid.Parse(req.Msg.AgentId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
+	}
+
+	nextRunTime, err := scheduler.NextRunTime(req.Msg.CronExpression, time.Now())
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	if _, err := scheduler.ParsePromptTemplate(req.Msg.PromptTemplate); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	overlapPolicy, err := conv.ConvertScheduleOverlapPolicyToMemory(req.Msg.OverlapPolicy)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	catchUpPolicy, err := conv.ConvertScheduleCatchUpPolicyToMemory(req.Msg.CatchUpPolicy)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	owner, team := ownerOf(ctx)
+	createdSchedule, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Schedule, error) {
+		_, err := tx.Agent.Query().Where(agent.ID(agentID), predicate.Agent(visibleTo(ctx))).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		create := tx.Schedule.Create().
+			SetOwner(owner).
+			SetTeam(team).
+			SetName(req.Msg.Name).
+			SetCronExpression(req.Msg.CronExpression).
+			SetAgentID(agentID).
+			SetPromptTemplate(req.Msg.PromptTemplate).
+			SetOverlapPolicy(overlapPolicy).
+			SetCatchUpPolicy(catchUpPolicy)
+
+		if !nextRunTime.IsZero() {
+			create = create.SetNextRunTime(nextRunTime)
+		}
+
+		if req.Msg.Workspace != "" {
+			create = create.SetWorkspace(req.Msg.Workspace)
+		}
+
+		return create.Save(ctx)
+	})
+
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSchedule, err := conv.ConvertScheduleToProto(createdSchedule)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateScheduleResponse{
+		Schedule: protoSchedule,
+	}), nil
+}
+
+func (h *ScheduleHandler) GetSchedule(ctx context.Context, req *connect.Request[v1.GetScheduleRequest]) (*connect.Response[v1.GetScheduleResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	s, err := h.db.Schedule.Query().Where(schedule.ID(id), predicate.Schedule(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSchedule, err := conv.ConvertScheduleToProto(s)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetScheduleResponse{
+		Schedule: protoSchedule,
+	}), nil
+}
+
+func (h *ScheduleHandler) ListSchedules(ctx context.Context, req *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	query := h.db.Schedule.Query().Where(predicate.Schedule(visibleTo(ctx)))
+
+	if req.Msg.Filter != nil {
+		if len(req.Msg.Filter.Names) > 0 {
+			query = query.Where(schedule.NameIn(req.Msg.Filter.Names...))
+		}
+
+		if req.Msg.Filter.AgentId != nil {
+			agentID, err := uuid.Parse(*req.Msg.Filter.AgentId)
+			if err != nil {
+				return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
+			}
+			query = query.Where(schedule.AgentID(agentID))
+		}
+
+		if req.Msg.Filter.Enabled != nil {
+			query = query.Where(schedule.Enabled(*req.Msg.Filter.Enabled))
+		}
+	}
+
+	if req.Msg.PageSize != nil {
+		query = query.Limit(int(*req.Msg.PageSize))
+	}
+
+	schedules, err := query.Order(schedule.ByName()).All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSchedules := make([]*v1.Schedule, 0, len(schedules))
+	for _, s := range schedules {
+		protoSchedule, err := conv.ConvertScheduleToProto(s)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoSchedules = append(protoSchedules, protoSchedule)
+	}
+
+	return connect.NewResponse(&v1.ListSchedulesResponse{
+		Schedules: protoSchedules,
+	}), nil
+}
+
+func (h *ScheduleHandler) UpdateSchedule(ctx context.Context, req *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	updatedSchedule, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Schedule, error) {
+		existing, err := tx.Schedule.Query().Where(schedule.ID(id), predicate.Schedule(visibleTo(ctx))).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorizeChange(ctx, "schedule", existing.Owner); err != nil {
+			return nil, err
+		}
+
+		update := tx.Schedule.UpdateOneID(id)
+
+		cronExpression := existing.CronExpression
+		if req.Msg.CronExpression != nil {
+			cronExpression = *req.Msg.CronExpression
+			update = update.SetCronExpression(cronExpression)
+		}
+
+		if req.Msg.AgentId != nil {
+			agentID, err := uuid.Parse(*req.Msg.AgentId)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err))
+			}
+			if _, err := tx.Agent.Query().Where(agent.ID(agentID), predicate.Agent(visibleTo(ctx))).Only(ctx); err != nil {
+				return nil, err
+			}
+			update = update.SetAgentID(agentID)
+		}
+
+		if req.Msg.Workspace != nil {
+			update = update.SetWorkspace(*req.Msg.Workspace)
+		}
+
+		if req.Msg.PromptTemplate != nil {
+			if _, err := scheduler.ParsePromptTemplate(*req.Msg.PromptTemplate); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			update = update.SetPromptTemplate(*req.Msg.PromptTemplate)
+		}
+
+		if req.Msg.OverlapPolicy != nil {
+			overlapPolicy, err := conv.ConvertScheduleOverlapPolicyToMemory(*req.Msg.OverlapPolicy)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			update = update.SetOverlapPolicy(overlapPolicy)
+		}
+
+		if req.Msg.CatchUpPolicy != nil {
+			catchUpPolicy, err := conv.ConvertScheduleCatchUpPolicyToMemory(*req.Msg.CatchUpPolicy)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			update = update.SetCatchUpPolicy(catchUpPolicy)
+		}
+
+		if req.Msg.Enabled != nil {
+			update = update.SetEnabled(*req.Msg.Enabled)
+		}
+
+		// A new cron expression or a resumed schedule starts counting from now, so that
+		// resuming a paused schedule does not catch up on the runs missed while paused.
+		resumed := req.Msg.Enabled != nil && *req.Msg.Enabled && !existing.Enabled
+		if req.Msg.CronExpression != nil || resumed {
+			nextRunTime, err := scheduler.NextRunTime(cronExpression, time.Now())
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+
+			if nextRunTime.IsZero() {
+				update = update.ClearNextRunTime()
+			} else {
+				update = update.SetNextRunTime(nextRunTime)
+			}
+		}
+
+		return update.Save(ctx)
+	})
+
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSchedule, err := conv.ConvertScheduleToProto(updatedSchedule)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateScheduleResponse{
+		Schedule: protoSchedule,
+	}), nil
+}
+
+func (h *ScheduleHandler) DeleteSchedule(ctx context.Context, req *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	s, err := h.db.Schedule.Query().Where(schedule.ID(id), predicate.Schedule(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := authorizeChange(ctx, "schedule", s.Owner); err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := h.db.Schedule.DeleteOne(s).Exec(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteScheduleResponse{}), nil
+}
+
+func (h *ScheduleHandler) TriggerSchedule(ctx context.Context, req *connect.Request[v1.TriggerScheduleRequest]) (*connect.Response[v1.TriggerScheduleResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	// a run starts a task on behalf of the owner, so only the owner may trigger it
+	s, err := h.db.Schedule.Query().Where(schedule.ID(id), predicate.Schedule(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := authorizeChange(ctx, "schedule", s.Owner); err != nil {
+		return nil, apiError(err)
+	}
+
+	run, err := h.scheduler.Trigger(ctx, s.ID)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoRun, err := conv.ConvertScheduleRunToProto(run)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.TriggerScheduleResponse{
+		Run: protoRun,
+	}), nil
+}
+
+func (h *ScheduleHandler) ListScheduleRuns(ctx context.Context, req *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error) {
+	id, err := uuid.Parse(req.Msg.ScheduleId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	if _, err := h.db.Schedule.Query().Where(schedule.ID(id), predicate.Schedule(visibleTo(ctx))).Only(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
+	query := h.db.ScheduleRun.Query().
+		Where(schedulerun.ScheduleID(id)).
+		Order(schedulerun.ByCreateTime(sql.OrderDesc()))
+
+	if req.Msg.PageSize != nil {
+		query = query.Limit(int(*req.Msg.PageSize))
+	}
+
+	runs, err := query.All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoRuns := make([]*v1.ScheduleRun, 0, len(runs))
+	for _, r := range runs {
+		protoRun, err := conv.ConvertScheduleRunToProto(r)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoRuns = append(protoRuns, protoRun)
+	}
+
+	return connect.NewResponse(&v1.ListScheduleRunsResponse{
+		Runs: protoRuns,
+	}), nil
+}
@@ -0,0 +1,267 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestCreateSchedule(t *testing.T) {
+	setup := ServiceTestSetup[v1.CreateScheduleRequest, v1.CreateScheduleResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error) {
+			return client.Schedule().CreateSchedule(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.CreateScheduleResponse{}, v1.Schedule{}, v1.ScheduleMetadata{}, v1.ScheduleSpec{}, v1.ScheduleStatus{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.Schedule{}, "metadata", "status"),
+		},
+	}
+
+	agentID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateScheduleRequest, v1.CreateScheduleResponse]{
+		{
+			Name: "invalid agent ID format",
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly",
+				CronExpression: "0 2 * * *",
+				AgentId:        "not-a-valid-uuid",
+				PromptTemplate: "Run the nightly checks",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Error: "invalid_argument: invalid agent ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "invalid cron expression",
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly",
+				CronExpression: "every night",
+				AgentId:        agentID.String(),
+				PromptTemplate: "Run the nightly checks",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Error: "invalid_argument: invalid cron expression \"every night\": expected exactly 5 fields, found 2: [every night]",
+			},
+		},
+		{
+			Name: "invalid prompt template",
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly",
+				CronExpression: "0 2 * * *",
+				AgentId:        agentID.String(),
+				PromptTemplate: "Run the checks for {{.ScheduledTime",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Error: "invalid_argument: invalid prompt template: template: prompt:1: unclosed action",
+			},
+		},
+		{
+			Name: "agent not found",
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly",
+				CronExpression: "0 2 * * *",
+				AgentId:        agentID.String(),
+				PromptTemplate: "Run the nightly checks",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Error: "not_found: agent not found",
+			},
+		},
+		{
+			Name: "success with default policies",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+			},
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly",
+				CronExpression: "0 2 * * *",
+				AgentId:        agentID.String(),
+				Workspace:      "/tmp/test",
+				PromptTemplate: "Run the nightly checks for {{.ScheduledTime.Format \"2006-01-02\"}}",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Response: v1.CreateScheduleResponse{
+					Schedule: &v1.Schedule{
+						Spec: &v1.ScheduleSpec{
+							Name:           "nightly",
+							CronExpression: "0 2 * * *",
+							AgentId:        agentID.String(),
+							Workspace:      "/tmp/test",
+							PromptTemplate: "Run the nightly checks for {{.ScheduledTime.Format \"2006-01-02\"}}",
+							OverlapPolicy:  v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP,
+							CatchUpPolicy:  v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE,
+							Enabled:        true,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	setup := ServiceTestSetup[v1.UpdateScheduleRequest, v1.UpdateScheduleResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+			return client.Schedule().UpdateSchedule(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.UpdateScheduleResponse{}, v1.Schedule{}, v1.ScheduleMetadata{}, v1.ScheduleSpec{}, v1.ScheduleStatus{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.Schedule{}, "metadata", "status"),
+		},
+	}
+
+	scheduleID := uuid.New()
+	agentID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.UpdateScheduleRequest, v1.UpdateScheduleResponse]{
+		{
+			Name: "schedule not found",
+			Request: &v1.UpdateScheduleRequest{
+				Id:      scheduleID.String(),
+				Enabled: boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateScheduleResponse]{
+				Error: "not_found: schedule not found",
+			},
+		},
+		{
+			Name: "invalid cron expression",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				test.NewScheduleBuilder(t, scheduleID, db, agent).Build(ctx)
+			},
+			Request: &v1.UpdateScheduleRequest{
+				Id:             scheduleID.String(),
+				CronExpression: strPtr("61 * * * *"),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateScheduleResponse]{
+				Error: "invalid_argument: invalid cron expression \"61 * * * *\": end of range (61) above maximum (59): 61",
+			},
+		},
+		{
+			Name: "pause",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				test.NewScheduleBuilder(t, scheduleID, db, agent).Build(ctx)
+			},
+			Request: &v1.UpdateScheduleRequest{
+				Id:            scheduleID.String(),
+				Enabled:       boolPtr(false),
+				OverlapPolicy: ptr(v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_REPLACE),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateScheduleResponse]{
+				Response: v1.UpdateScheduleResponse{
+					Schedule: &v1.Schedule{
+						Spec: &v1.ScheduleSpec{
+							Name:           "nightly",
+							CronExpression: "0 2 * * *",
+							AgentId:        agentID.String(),
+							Workspace:      "/tmp/test",
+							PromptTemplate: "Run the nightly checks",
+							OverlapPolicy:  v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_REPLACE,
+							CatchUpPolicy:  v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE,
+							Enabled:        false,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestTriggerSchedule(t *testing.T) {
+	setup := ServiceTestSetup[v1.TriggerScheduleRequest, v1.TriggerScheduleResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.TriggerScheduleRequest]) (*connect.Response[v1.TriggerScheduleResponse], error) {
+			return client.Schedule().TriggerSchedule(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.TriggerScheduleResponse{}, v1.ScheduleRun{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ScheduleRun{}, "id", "task_id", "scheduled_time", "created_at"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			messages, err := db.Message.Query().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			prompts := make([]string, 0, len(messages))
+			for _, m := range messages {
+				if m.Source != types.MessageSourceUser {
+					continue
+				}
+				prompts = append(prompts, m.Content.Blocks[0].Payload)
+			}
+			return prompts, nil
+		},
+	}
+
+	scheduleID := uuid.New()
+	agentID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.TriggerScheduleRequest, v1.TriggerScheduleResponse]{
+		{
+			Name: "invalid id format",
+			Request: &v1.TriggerScheduleRequest{
+				Id: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.TriggerScheduleResponse]{
+				Error: "invalid_argument: invalid schedule ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "schedule not found",
+			Request: &v1.TriggerScheduleRequest{
+				Id: scheduleID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.TriggerScheduleResponse]{
+				Error: "not_found: schedule not found",
+			},
+		},
+		{
+			Name: "paused schedule starts a task",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				test.NewScheduleBuilder(t, scheduleID, db, agent).
+					WithPromptTemplate("Run {{.ScheduleName}} ({{.Trigger}})").
+					WithEnabled(false).
+					Build(ctx)
+			},
+			Request: &v1.TriggerScheduleRequest{
+				Id: scheduleID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.TriggerScheduleResponse]{
+				Response: v1.TriggerScheduleResponse{
+					Run: &v1.ScheduleRun{
+						ScheduleId: scheduleID.String(),
+						Trigger:    v1.ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_MANUAL,
+						Status:     v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED,
+					},
+				},
+				Database: []string{"Run nightly (manual)"},
+			},
+		},
+	})
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/search"
+	"github.com/furisto/construct/backend/memory/task"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultSearchPageSize is the number of results returned if the request sets no page size.
+const defaultSearchPageSize = 20
+
+var _ v1connect.SearchServiceHandler = (*SearchHandler)(nil)
+
+func NewSearchHandler(db *memory.Client) *SearchHandler {
+	return &SearchHandler{
+		db: db,
+	}
+}
+
+type SearchHandler struct {
+	db *memory.Client
+
+	// indexMu guards the creation of the search index on the first search
+	indexMu sync.Mutex
+	indexed bool
+	v1connect.UnimplementedSearchServiceHandler
+}
+
+func (h *SearchHandler) Search(ctx context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	terms := search.Terms(req.Msg.Query)
+	if len(terms) == 0 {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("query has no words to search for")))
+	}
+
+	if err := h.ensureIndex(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
+	limit := defaultSearchPageSize
+	if req.Msg.PageSize != nil {
+		limit = int(*req.Msg.PageSize)
+	}
+
+	tasks, err := h.db.Task.Query().
+		Where(search.TaskMatches(terms), predicate.Task(visibleTo(ctx))).
+		Order(task.ByUpdateTime(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	messages, err := h.db.Message.Query().
+		Where(search.MessageMatches(terms), message.HasTaskWith(predicate.Task(visibleTo(ctx)))).
+		WithTask().
+		Order(message.ByCreateTime(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	results := make([]*v1.SearchResult, 0, len(tasks)+len(messages))
+	for _, t := range tasks {
+		results = append(results, &v1.SearchResult{
+			TaskId:          t.ID.String(),
+			TaskDescription: t.Description,
+			Kind:            v1.SearchMatchKind_SEARCH_MATCH_KIND_TITLE,
+			Snippet:         convertSnippet(search.NewSnippet(t.Description, terms)),
+			Time:            timestamppb.New(t.UpdateTime),
+		})
+	}
+
+	for _, m := range messages {
+		// the snippet is taken from the part of the message with the most matching words
+		var (
+			best search.Snippet
+			kind = v1.SearchMatchKind_SEARCH_MATCH_KIND_MESSAGE
+		)
+		for i, block := range search.MessageBlocks(m.Content) {
+			snippet := search.NewSnippet(block.Text, terms)
+			if i > 0 && snippet.Matches <= best.Matches {
+				continue
+			}
+			best = snippet
+			kind = v1.SearchMatchKind_SEARCH_MATCH_KIND_MESSAGE
+			if block.Kind != types.MessageBlockKindText {
+				kind = v1.SearchMatchKind_SEARCH_MATCH_KIND_TOOL_CALL
+			}
+		}
+
+		messageID := m.ID.String()
+		role := conv.ConvertMemoryRoleToProto(m.Source)
+		result := &v1.SearchResult{
+			TaskId:    m.TaskID.String(),
+			MessageId: &messageID,
+			Role:      &role,
+			Kind:      kind,
+			Snippet:   convertSnippet(best),
+			Time:      timestamppb.New(m.CreateTime),
+		}
+		if m.Edges.Task != nil {
+			result.TaskDescription = m.Edges.Task.Description
+		}
+		results = append(results, result)
+	}
+
+	slices.SortStableFunc(results, func(a, b *v1.SearchResult) int {
+		return b.Time.AsTime().Compare(a.Time.AsTime())
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return connect.NewResponse(&v1.SearchResponse{
+		Results: results,
+	}), nil
+}
+
+// ensureIndex creates the search index on the first search. It is retried on the next
+// search if it fails.
+func (h *SearchHandler) ensureIndex(ctx context.Context) error {
+	h.indexMu.Lock()
+	defer h.indexMu.Unlock()
+
+	if h.indexed {
+		return nil
+	}
+	if err := search.EnsureIndex(ctx, h.db); err != nil {
+		return err
+	}
+	h.indexed = true
+	return nil
+}
+
+func convertSnippet(snippet search.Snippet) *v1.SearchSnippet {
+	highlights := make([]*v1.SearchHighlight, 0, len(snippet.Highlights))
+	for _, highlight := range snippet.Highlights {
+		highlights = append(highlights, &v1.SearchHighlight{
+			Start: int32(highlight.Start),
+			End:   int32(highlight.End),
+		})
+	}
+	return &v1.SearchSnippet{
+		Text:       snippet.Text,
+		Highlights: highlights,
+	}
+}
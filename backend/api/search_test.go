@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestSearch(t *testing.T) {
+	setup := ServiceTestSetup[v1.SearchRequest, v1.SearchResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+			return client.Search().Search(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.SearchResponse{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.SearchResult{}, "time"),
+		},
+	}
+
+	taskID := uuid.New()
+	userMessageID := uuid.New()
+	toolCallID := uuid.New()
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+
+		parser := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+		db.Task.UpdateOne(parser).SetDescription("Refactor the parser").ExecX(ctx)
+		docs := test.NewTaskBuilder(t, uuid.New(), db, agent).Build(ctx)
+		db.Task.UpdateOne(docs).SetDescription("Write the docs").ExecX(ctx)
+
+		start := time.Now().Add(-time.Hour)
+		messages := []struct {
+			id     uuid.UUID
+			taskID uuid.UUID
+			source types.MessageSource
+			block  types.MessageBlock
+		}{
+			{userMessageID, taskID, types.MessageSourceUser, types.MessageBlock{Kind: types.MessageBlockKindText, Payload: "Please fix the failing\nparser tests"}},
+			{toolCallID, taskID, types.MessageSourceAssistant, types.MessageBlock{Kind: types.MessageBlockKindNativeToolCall, Payload: `{"id":"call_1","tool":"edit_file","args":{"path":"parser.go"}}`}},
+			{uuid.New(), taskID, types.MessageSourceAssistant, types.MessageBlock{Kind: types.MessageBlockKindNativeToolResult, Payload: `{"id":"call_1","name":"edit_file","result":"parser.go updated"}`}},
+			{uuid.New(), docs.ID, types.MessageSourceUser, types.MessageBlock{Kind: types.MessageBlockKindText, Payload: "Document the configuration"}},
+		}
+		for i, m := range messages {
+			db.Message.Create().
+				SetID(m.id).
+				SetTaskID(m.taskID).
+				SetSource(m.source).
+				SetContent(&types.MessageContent{Blocks: []types.MessageBlock{m.block}}).
+				SetCreateTime(start.Add(time.Duration(i) * time.Minute)).
+				ExecX(ctx)
+		}
+	}
+
+	titleResult := &v1.SearchResult{
+		TaskId:          taskID.String(),
+		TaskDescription: "Refactor the parser",
+		Kind:            v1.SearchMatchKind_SEARCH_MATCH_KIND_TITLE,
+		Snippet: &v1.SearchSnippet{
+			Text:       "Refactor the parser",
+			Highlights: []*v1.SearchHighlight{{Start: 13, End: 19}},
+		},
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.SearchRequest, v1.SearchResponse]{
+		{
+			Name: "query without words",
+			Request: &v1.SearchRequest{
+				Query: "*?",
+			},
+			Expected: ServiceTestExpectation[v1.SearchResponse]{
+				Error: "invalid_argument: query has no words to search for",
+			},
+		},
+		{
+			Name:         "matches in titles, messages and tool calls",
+			SeedDatabase: seed,
+			Request: &v1.SearchRequest{
+				Query: "pars",
+			},
+			Expected: ServiceTestExpectation[v1.SearchResponse]{
+				Response: v1.SearchResponse{
+					Results: []*v1.SearchResult{
+						titleResult,
+						{
+							TaskId:          taskID.String(),
+							TaskDescription: "Refactor the parser",
+							MessageId:       client.Ptr(toolCallID.String()),
+							Role:            client.Ptr(v1.MessageRole_MESSAGE_ROLE_ASSISTANT),
+							Kind:            v1.SearchMatchKind_SEARCH_MATCH_KIND_TOOL_CALL,
+							Snippet: &v1.SearchSnippet{
+								Text:       `edit_file {"path":"parser.go"}`,
+								Highlights: []*v1.SearchHighlight{{Start: 19, End: 25}},
+							},
+						},
+						{
+							TaskId:          taskID.String(),
+							TaskDescription: "Refactor the parser",
+							MessageId:       client.Ptr(userMessageID.String()),
+							Role:            client.Ptr(v1.MessageRole_MESSAGE_ROLE_USER),
+							Kind:            v1.SearchMatchKind_SEARCH_MATCH_KIND_MESSAGE,
+							Snippet: &v1.SearchSnippet{
+								Text:       "Please fix the failing parser tests",
+								Highlights: []*v1.SearchHighlight{{Start: 23, End: 29}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "all words have to match",
+			SeedDatabase: seed,
+			Request: &v1.SearchRequest{
+				Query: "parser configuration",
+			},
+			Expected: ServiceTestExpectation[v1.SearchResponse]{
+				Response: v1.SearchResponse{
+					Results: []*v1.SearchResult{},
+				},
+			},
+		},
+		{
+			Name:         "page size",
+			SeedDatabase: seed,
+			Request: &v1.SearchRequest{
+				Query:    "parser",
+				PageSize: client.Ptr(int32(1)),
+			},
+			Expected: ServiceTestExpectation[v1.SearchResponse]{
+				Response: v1.SearchResponse{
+					Results: []*v1.SearchResult{titleResult},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/secret"
+)
+
+var _ v1connect.SecretServiceHandler = (*SecretHandler)(nil)
+
+func NewSecretHandler(db *memory.Client, encryption *secret.Encryption, provider secret.Provider) *SecretHandler {
+	return &SecretHandler{
+		db:         db,
+		encryption: encryption,
+		provider:   provider,
+	}
+}
+
+type SecretHandler struct {
+	db         *memory.Client
+	encryption *secret.Encryption
+	provider   secret.Provider
+	v1connect.UnimplementedSecretServiceHandler
+}
+
+func (h *SecretHandler) RotateEncryptionKey(ctx context.Context, req *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error) {
+	if err := authorizeLocal(ctx, "rotating the encryption key"); err != nil {
+		return nil, apiError(err)
+	}
+
+	if h.provider == nil {
+		return nil, apiError(connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the daemon has no secret provider to store the rotated keyset")))
+	}
+
+	result, err := secret.Rotate(ctx, h.db, h.encryption, h.provider, secret.RotateOptions{
+		DryRun: req.Msg.DryRun,
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(conv.ConvertRotationResultToProto(result)), nil
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRotateEncryptionKey(t *testing.T) {
+	setup := ServiceTestSetup[v1.RotateEncryptionKeyRequest, v1.RotateEncryptionKeyResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error) {
+			return client.Secret().RotateEncryptionKey(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.RotateEncryptionKeyResponse{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.RotateEncryptionKeyResponse{}, "primary_key_id", "disabled_key_ids"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			providers, err := db.ModelProvider.Query().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			secrets := make([]string, len(providers))
+			for i, provider := range providers {
+				secrets[i] = string(provider.Secret)
+			}
+			return secrets, nil
+		},
+	}
+
+	providerID := uuid.MustParse("01974c1d-0be8-70e1-88b4-ad9462fff25e")
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.RotateEncryptionKeyRequest, v1.RotateEncryptionKeyResponse]{
+		{
+			Name:    "dry run",
+			Request: &v1.RotateEncryptionKeyRequest{DryRun: true},
+			Expected: ServiceTestExpectation[v1.RotateEncryptionKeyResponse]{
+				Response: v1.RotateEncryptionKeyResponse{DryRun: true},
+			},
+		},
+		{
+			Name:    "rotate",
+			Request: &v1.RotateEncryptionKeyRequest{},
+			Expected: ServiceTestExpectation[v1.RotateEncryptionKeyResponse]{
+				Response: v1.RotateEncryptionKeyResponse{},
+			},
+		},
+		{
+			Name: "secret that cannot be decrypted",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				test.NewModelProviderBuilder(t, providerID, db).Build(ctx)
+			},
+			Request: &v1.RotateEncryptionKeyRequest{},
+			Expected: ServiceTestExpectation[v1.RotateEncryptionKeyResponse]{
+				Error:    "internal: failed to re-encrypt secret of model provider anthropic: decryption failed: aead_factory: decryption failed",
+				Database: []string{"mock-secret"},
+			},
+		},
+	})
+}
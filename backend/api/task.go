@@ -1,8 +1,11 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
 	"entgo.io/ent/dialect/sql"
@@ -14,10 +17,16 @@ import (
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/extension"
+	"github.com/furisto/construct/backend/memory/filechange"
 	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/review"
+	"github.com/furisto/construct/backend/transcript"
+	"github.com/furisto/construct/backend/workspace"
 	"github.com/google/uuid"
+	"github.com/spf13/afero"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -30,6 +39,7 @@ func NewTaskHandler(db *memory.Client, messageHub *event.MessageHub, eventBus *e
 		eventBus:   eventBus,
 		runtime:    runtime,
 		analytics:  analytics,
+		stager:     review.NewStager(db, afero.NewOsFs()),
 	}
 }
 
@@ -39,6 +49,7 @@ type TaskHandler struct {
 	eventBus   *event.Bus
 	runtime    AgentRuntime
 	analytics  analytics.Client
+	stager     *review.Stager
 	v1connect.UnimplementedTaskServiceHandler
 }
 
@@ -48,20 +59,32 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *connect.Request[v1.Cr
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
 	}
 
+	if err := validateLabels(req.Msg.Labels); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	owner, team := ownerOf(ctx)
 	createdTask, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
-		_, err := tx.Agent.Get(ctx, agentID)
+		_, err := tx.Agent.Query().Where(agent.ID(agentID), predicate.Agent(visibleTo(ctx))).Only(ctx)
 		if err != nil {
 			return nil, err
 		}
 
 		taskCreate := tx.Task.Create().
 			SetAgentID(agentID).
-			SetProjectDirectory(req.Msg.ProjectDirectory)
+			SetProjectDirectory(req.Msg.ProjectDirectory).
+			SetReviewEdits(req.Msg.ReviewEdits).
+			SetOwner(owner).
+			SetTeam(team)
 
 		if req.Msg.Description != "" {
 			taskCreate = taskCreate.SetDescription(req.Msg.Description)
 		}
 
+		if len(req.Msg.Labels) > 0 {
+			taskCreate = taskCreate.SetLabels(req.Msg.Labels)
+		}
+
 		return taskCreate.Save(ctx)
 	})
 
@@ -87,7 +110,7 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *connect.Request[v1.GetTa
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
 	}
 
-	task, err := h.db.Task.Query().Where(task.ID(id)).WithAgent().First(ctx)
+	task, err := h.db.Task.Query().Where(task.ID(id), predicate.Task(visibleTo(ctx))).WithAgent().First(ctx)
 	if err != nil {
 		return nil, apiError(err)
 	}
@@ -103,7 +126,11 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *connect.Request[v1.GetTa
 }
 
 func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
-	query := h.db.Task.Query()
+	query := h.db.Task.Query().Where(predicate.Task(visibleTo(ctx)))
+
+	if req.Msg.Filter != nil && req.Msg.Filter.Owner != nil {
+		query = query.Where(task.Owner(*req.Msg.Filter.Owner))
+	}
 
 	if req.Msg.Filter != nil && req.Msg.Filter.AgentId != nil {
 		agentID, err := uuid.Parse(*req.Msg.Filter.AgentId)
@@ -113,10 +140,66 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.Lis
 		query = query.Where(task.HasAgentWith(agent.ID(agentID)))
 	}
 
+	if req.Msg.Filter != nil && req.Msg.Filter.ForkedFromTaskId != nil {
+		forkedFromID, err := uuid.Parse(*req.Msg.Filter.ForkedFromTaskId)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+		}
+		query = query.Where(task.ForkedFromID(forkedFromID))
+	}
+
 	if req.Msg.Filter != nil && req.Msg.Filter.TaskIdPrefix != nil {
 		query = query.Where(extension.UUIDHasPrefix(task.Table, task.FieldID, *req.Msg.Filter.TaskIdPrefix))
 	}
 
+	if req.Msg.Filter != nil && req.Msg.Filter.LabelSelector != nil {
+		selector, err := labelSelector(*req.Msg.Filter.LabelSelector)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+		query = query.Where(selector)
+	}
+
+	if req.Msg.Filter != nil && req.Msg.Filter.WorkspacePrefix != nil {
+		query = query.Where(task.ProjectDirectoryHasPrefix(*req.Msg.Filter.WorkspacePrefix))
+	}
+
+	if req.Msg.Filter != nil && len(req.Msg.Filter.Phases) > 0 {
+		phases := make([]types.TaskPhase, 0, len(req.Msg.Filter.Phases))
+		for _, p := range req.Msg.Filter.Phases {
+			phase, err := conv.ConvertTaskPhaseToMemory(p)
+			if err != nil {
+				return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+			}
+			phases = append(phases, phase)
+		}
+		query = query.Where(task.PhaseIn(phases...))
+	}
+
+	if req.Msg.Filter != nil {
+		if req.Msg.Filter.CreatedAfter != nil {
+			query = query.Where(task.CreateTimeGTE(req.Msg.Filter.CreatedAfter.AsTime()))
+		}
+		if req.Msg.Filter.CreatedBefore != nil {
+			query = query.Where(task.CreateTimeLT(req.Msg.Filter.CreatedBefore.AsTime()))
+		}
+		if req.Msg.Filter.UpdatedAfter != nil {
+			query = query.Where(task.UpdateTimeGTE(req.Msg.Filter.UpdatedAfter.AsTime()))
+		}
+		if req.Msg.Filter.UpdatedBefore != nil {
+			query = query.Where(task.UpdateTimeLT(req.Msg.Filter.UpdatedBefore.AsTime()))
+		}
+	}
+
+	if req.Msg.Filter != nil && req.Msg.Filter.MinCost != nil {
+		query = query.Where(task.CostGTE(*req.Msg.Filter.MinCost))
+	}
+
+	if req.Msg.Filter != nil && req.Msg.Filter.MaxCost != nil {
+		// Tasks that have not used a model yet have no cost.
+		query = query.Where(task.Or(task.CostIsNil(), task.CostLTE(*req.Msg.Filter.MaxCost)))
+	}
+
 	query.Modify(func(s *sql.Selector) {
 		m := sql.Table(message.Table).As("t1")
 		countExpr := sql.Count(m.C(message.FieldTaskID))
@@ -156,6 +239,12 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.Lis
 		} else {
 			query = query.Order(task.ByUpdateTime(sql.OrderDesc()))
 		}
+	case v1.SortField_SORT_FIELD_COST:
+		if sortOrder == v1.SortOrder_SORT_ORDER_ASC {
+			query = query.Order(task.ByCost(sql.OrderAsc(), sql.OrderNullsFirst()), task.ByCreateTime(sql.OrderAsc()))
+		} else {
+			query = query.Order(task.ByCost(sql.OrderDesc(), sql.OrderNullsLast()), task.ByCreateTime(sql.OrderDesc()))
+		}
 	}
 
 	if req.Msg.PageSize != nil {
@@ -197,10 +286,13 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[v1.Up
 
 	var updatedFields []string
 	updatedTask, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
-		t, err := tx.Task.Get(ctx, id)
+		t, err := tx.Task.Query().Where(task.ID(id), predicate.Task(visibleTo(ctx))).Only(ctx)
 		if err != nil {
 			return nil, err
 		}
+		if err := authorizeChange(ctx, "task", t.Owner); err != nil {
+			return nil, err
+		}
 		update := t.Update()
 
 		if req.Msg.AgentId != nil {
@@ -209,7 +301,7 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[v1.Up
 				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err))
 			}
 
-			_, err = tx.Agent.Get(ctx, agentID)
+			_, err = tx.Agent.Query().Where(agent.ID(agentID), predicate.Agent(visibleTo(ctx))).Only(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -218,6 +310,20 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[v1.Up
 			updatedFields = append(updatedFields, "agent_id")
 		}
 
+		if req.Msg.ReviewEdits != nil {
+			update = update.SetReviewEdits(*req.Msg.ReviewEdits)
+			updatedFields = append(updatedFields, "review_edits")
+		}
+
+		if len(req.Msg.Labels) > 0 {
+			labels, err := mergeLabels(t.Labels, req.Msg.Labels)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			update = update.SetLabels(labels)
+			updatedFields = append(updatedFields, "labels")
+		}
+
 		return update.Save(ctx)
 	})
 
@@ -258,7 +364,15 @@ func (h *TaskHandler) DeleteTask(ctx context.Context, req *connect.Request[v1.De
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
 	}
 
-	if err := h.db.Task.DeleteOneID(id).Exec(ctx); err != nil {
+	t, err := h.db.Task.Query().Where(task.ID(id), predicate.Task(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := authorizeChange(ctx, "task", t.Owner); err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := h.db.Task.DeleteOne(t).Exec(ctx); err != nil {
 		return nil, apiError(err)
 	}
 
@@ -271,7 +385,7 @@ func (h *TaskHandler) Subscribe(ctx context.Context, req *connect.Request[v1.Sub
 		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err))
 	}
 
-	_, err = h.db.Task.Get(ctx, taskID)
+	_, err = h.db.Task.Query().Where(task.ID(taskID), predicate.Task(visibleTo(ctx))).Only(ctx)
 	if err != nil {
 		return apiError(err)
 	}
@@ -299,6 +413,14 @@ func (h *TaskHandler) SuspendTask(ctx context.Context, req *connect.Request[v1.S
 	}
 
 	_, err = memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
+		t, err := tx.Task.Query().Where(task.ID(taskID), predicate.Task(visibleTo(ctx))).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorizeChange(ctx, "task", t.Owner); err != nil {
+			return nil, err
+		}
+
 		_, err = h.db.Task.UpdateOneID(taskID).SetPhase(types.TaskPhaseSuspended).Save(ctx)
 		if err != nil {
 			return nil, err
@@ -315,3 +437,373 @@ func (h *TaskHandler) SuspendTask(ctx context.Context, req *connect.Request[v1.S
 	})
 	return connect.NewResponse(&v1.SuspendTaskResponse{}), nil
 }
+
+func (h *TaskHandler) ExportTranscript(ctx context.Context, req *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error) {
+	taskID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	format, err := conv.ConvertTranscriptFormatToTranscript(req.Msg.Format)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	_, err = h.db.Task.Query().Where(task.ID(taskID), predicate.Task(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	t, err := transcript.Load(ctx, h.db, taskID)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	var content bytes.Buffer
+	if err := transcript.Render(&content, t, format); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.ExportTranscriptResponse{
+		Content:     content.String(),
+		ContentType: format.ContentType(),
+	}), nil
+}
+
+func (h *TaskHandler) CompactTask(ctx context.Context, req *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error) {
+	taskID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	t, err := h.db.Task.Query().Where(task.ID(taskID), predicate.Task(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := authorizeChange(ctx, "task", t.Owner); err != nil {
+		return nil, apiError(err)
+	}
+
+	if t.Phase == types.TaskPhaseRunning {
+		return nil, apiError(connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task is running, suspend it or wait until it is done")))
+	}
+
+	processed, err := h.db.Message.Query().Where(message.TaskIDEQ(taskID), message.ProcessedTimeNotNil()).Exist(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if !processed {
+		return nil, apiError(connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task has no messages to compact")))
+	}
+
+	summary, compacted, err := h.runtime.TaskCompactor().CompactTask(ctx, taskID)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	event.Publish(h.eventBus, event.TaskEvent{
+		TaskID: taskID,
+	})
+
+	return connect.NewResponse(&v1.CompactTaskResponse{
+		Summary:           summary,
+		CompactedMessages: int32(compacted),
+	}), nil
+}
+
+func (h *TaskHandler) ForkTask(ctx context.Context, req *connect.Request[v1.ForkTaskRequest]) (*connect.Response[v1.ForkTaskResponse], error) {
+	taskID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	var messageID uuid.UUID
+	if req.Msg.MessageId != nil {
+		messageID, err = uuid.Parse(*req.Msg.MessageId)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid message ID format: %w", err)))
+		}
+	}
+
+	owner, team := ownerOf(ctx)
+	var copied int
+	forkedTask, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
+		// Members of the team of the owner can fork a task they can only read, the fork is theirs.
+		original, err := tx.Task.Query().Where(task.ID(taskID), predicate.Task(visibleTo(ctx))).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		messages, err := tx.Message.Query().Where(message.TaskIDEQ(taskID)).Order(message.ByCreateTime()).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if messageID != uuid.Nil {
+			last := slices.IndexFunc(messages, func(m *memory.Message) bool { return m.ID == messageID })
+			if last < 0 {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("message not found"))
+			}
+			messages = messages[:last+1]
+		}
+		if len(messages) == 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task has no messages to fork from"))
+		}
+
+		description := original.Description
+		if req.Msg.Description != nil {
+			description = *req.Msg.Description
+		}
+
+		taskCreate := tx.Task.Create().
+			SetProjectDirectory(original.ProjectDirectory).
+			SetReviewEdits(original.ReviewEdits).
+			SetDescription(description).
+			SetForkedFromID(original.ID).
+			SetForkedFromMessageID(messages[len(messages)-1].ID).
+			SetOwner(owner).
+			SetTeam(team)
+		if original.AgentID != uuid.Nil {
+			taskCreate = taskCreate.SetAgentID(original.AgentID)
+		}
+		if len(original.Labels) > 0 {
+			taskCreate = taskCreate.SetLabels(original.Labels)
+		}
+
+		fork, err := taskCreate.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The copies keep the times of the originals, so that the conversation stays in order.
+		creates := make([]*memory.MessageCreate, 0, len(messages))
+		for _, m := range messages {
+			create := tx.Message.Create().
+				SetTaskID(fork.ID).
+				SetSource(m.Source).
+				SetContent(m.Content).
+				SetCreateTime(m.CreateTime).
+				SetUpdateTime(m.UpdateTime)
+			if m.Usage != nil {
+				create = create.SetUsage(m.Usage)
+			}
+			if !m.ProcessedTime.IsZero() {
+				create = create.SetProcessedTime(m.ProcessedTime)
+			}
+			if m.AgentID != uuid.Nil {
+				create = create.SetAgentID(m.AgentID)
+			}
+			if m.ModelID != uuid.Nil {
+				create = create.SetModelID(m.ModelID)
+			}
+			creates = append(creates, create)
+		}
+		if err := tx.Message.CreateBulk(creates...).Exec(ctx); err != nil {
+			return nil, err
+		}
+		copied = len(creates)
+
+		return fork, nil
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTask, err := conv.ConvertTaskToProto(forkedTask)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	protoTask.Status.MessageCount = int64(copied)
+
+	analytics.EmitTaskCreated(h.analytics, forkedTask.ID.String(), forkedTask.AgentID.String())
+
+	return connect.NewResponse(&v1.ForkTaskResponse{
+		Task:           protoTask,
+		CopiedMessages: int32(copied),
+	}), nil
+}
+
+// defaultWorkspaceResults is the number of files and symbols returned if the request sets no limit.
+const defaultWorkspaceResults = 50
+
+func (h *TaskHandler) ListWorkspaceFiles(ctx context.Context, req *connect.Request[v1.ListWorkspaceFilesRequest]) (*connect.Response[v1.ListWorkspaceFilesResponse], error) {
+	ws, err := h.taskWorkspace(ctx, req.Msg.TaskId)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	limit := defaultWorkspaceResults
+	if req.Msg.Limit != nil {
+		limit = int(*req.Msg.Limit)
+	}
+
+	paths, err := ws.FindFiles(ctx, req.Msg.Query, limit)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.ListWorkspaceFilesResponse{
+		Paths: paths,
+	}), nil
+}
+
+func (h *TaskHandler) SearchWorkspaceSymbols(ctx context.Context, req *connect.Request[v1.SearchWorkspaceSymbolsRequest]) (*connect.Response[v1.SearchWorkspaceSymbolsResponse], error) {
+	ws, err := h.taskWorkspace(ctx, req.Msg.TaskId)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	limit := defaultWorkspaceResults
+	if req.Msg.Limit != nil {
+		limit = int(*req.Msg.Limit)
+	}
+
+	symbols, err := ws.FindSymbols(ctx, req.Msg.Query, limit)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSymbols := make([]*v1.WorkspaceSymbol, 0, len(symbols))
+	for _, symbol := range symbols {
+		protoSymbols = append(protoSymbols, &v1.WorkspaceSymbol{
+			Name:    symbol.Name,
+			Kind:    symbol.Kind,
+			Path:    symbol.Path,
+			Line:    uint32(symbol.Line),
+			EndLine: uint32(symbol.EndLine),
+		})
+	}
+
+	return connect.NewResponse(&v1.SearchWorkspaceSymbolsResponse{
+		Symbols: protoSymbols,
+	}), nil
+}
+
+func (h *TaskHandler) taskWorkspace(ctx context.Context, id string) (*workspace.Workspace, error) {
+	taskID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err))
+	}
+
+	t, err := h.db.Task.Query().Where(task.ID(taskID), predicate.Task(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return openWorkspace(t)
+}
+
+// openWorkspace returns the workspace of a task. Tasks that were created without a project
+// directory have no workspace.
+func openWorkspace(t *memory.Task) (*workspace.Workspace, error) {
+	if t.ProjectDirectory == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task has no workspace"))
+	}
+
+	ws, err := workspace.New(t.ProjectDirectory, afero.NewOsFs())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return ws, nil
+}
+
+func (h *TaskHandler) ListFileChanges(ctx context.Context, req *connect.Request[v1.ListFileChangesRequest]) (*connect.Response[v1.ListFileChangesResponse], error) {
+	taskID, err := uuid.Parse(req.Msg.TaskId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	_, err = h.db.Task.Query().Where(task.ID(taskID), predicate.Task(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	query := h.db.FileChange.Query().
+		Where(filechange.TaskID(taskID)).
+		Order(filechange.ByCreateTime(), filechange.ByPath())
+
+	if req.Msg.Status != nil {
+		status, err := conv.ConvertFileChangeStatusToMemory(*req.Msg.Status)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+		query = query.Where(filechange.StatusEQ(status))
+	}
+
+	changes, err := query.All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoChanges := make([]*v1.FileChange, 0, len(changes))
+	for _, change := range changes {
+		protoChanges = append(protoChanges, conv.ConvertFileChangeToProto(change))
+	}
+
+	return connect.NewResponse(&v1.ListFileChangesResponse{
+		Changes: protoChanges,
+	}), nil
+}
+
+func (h *TaskHandler) AcceptFileChange(ctx context.Context, req *connect.Request[v1.AcceptFileChangeRequest]) (*connect.Response[v1.AcceptFileChangeResponse], error) {
+	changeID, err := h.authorizeFileChange(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	change, err := h.stager.Accept(ctx, changeID, req.Msg.Force)
+	if err != nil {
+		return nil, apiError(reviewError(err))
+	}
+
+	return connect.NewResponse(&v1.AcceptFileChangeResponse{
+		Change: conv.ConvertFileChangeToProto(change),
+	}), nil
+}
+
+func (h *TaskHandler) RejectFileChange(ctx context.Context, req *connect.Request[v1.RejectFileChangeRequest]) (*connect.Response[v1.RejectFileChangeResponse], error) {
+	changeID, err := h.authorizeFileChange(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	change, err := h.stager.Reject(ctx, changeID, req.Msg.Reason)
+	if err != nil {
+		return nil, apiError(reviewError(err))
+	}
+
+	event.Publish(h.eventBus, event.TaskEvent{
+		TaskID: change.TaskID,
+	})
+
+	return connect.NewResponse(&v1.RejectFileChangeResponse{
+		Change: conv.ConvertFileChangeToProto(change),
+	}), nil
+}
+
+// authorizeFileChange checks that the caller may review a change, which requires that they
+// may change the task whose agent made it.
+func (h *TaskHandler) authorizeFileChange(ctx context.Context, id string) (uuid.UUID, error) {
+	changeID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid change ID format: %w", err))
+	}
+
+	change, err := h.db.FileChange.Query().
+		Where(filechange.ID(changeID), filechange.HasTaskWith(predicate.Task(visibleTo(ctx)))).
+		WithTask().
+		Only(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := authorizeChange(ctx, "task", change.Edges.Task.Owner); err != nil {
+		return uuid.Nil, err
+	}
+	return changeID, nil
+}
+
+func reviewError(err error) error {
+	if errors.Is(err, review.ErrNotPending) || errors.Is(err, review.ErrConflict) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return err
+}
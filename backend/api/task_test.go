@@ -2,17 +2,25 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/task"
 	"github.com/furisto/construct/backend/memory/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	_ "modernc.org/sqlite"
 )
 
@@ -81,6 +89,48 @@ func TestCreateTask(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "with labels",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+
+				test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+			},
+			Request: &v1.CreateTaskRequest{
+				AgentId:          agentID.String(),
+				ProjectDirectory: "/tmp/test",
+				Labels:           map[string]string{"env": "ci", "ci.run": "1234"},
+			},
+			Expected: ServiceTestExpectation[v1.CreateTaskResponse]{
+				Response: v1.CreateTaskResponse{
+					Task: &v1.Task{
+						Metadata: &v1.TaskMetadata{},
+						Spec: &v1.TaskSpec{
+							AgentId:      strPtr(agentID.String()),
+							Workspace:    "/tmp/test",
+							DesiredPhase: v1.TaskPhase_TASK_PHASE_RUNNING,
+							Labels:       map[string]string{"env": "ci", "ci.run": "1234"},
+						},
+						Status: &v1.TaskStatus{
+							Usage: &v1.TaskUsage{},
+							Phase: v1.TaskPhase_TASK_PHASE_AWAITING,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid label",
+			Request: &v1.CreateTaskRequest{
+				AgentId:          agentID.String(),
+				ProjectDirectory: "/tmp/test",
+				Labels:           map[string]string{"env": "c i"},
+			},
+			Expected: ServiceTestExpectation[v1.CreateTaskResponse]{
+				Error: `invalid_argument: invalid value "c i" of label env: must have 1-255 letters, digits, '.', '_', '-' or '/'`,
+			},
+		},
 	})
 }
 
@@ -220,6 +270,43 @@ func TestListTasks(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "filter by forked from",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+
+				test.NewTaskBuilder(t, taskID1, db, agent).Build(ctx)
+				fork := test.NewTaskBuilder(t, taskID2, db, agent).Build(ctx)
+				db.Task.UpdateOne(fork).SetForkedFromID(taskID1).ExecX(ctx)
+			},
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{
+					ForkedFromTaskId: strPtr(taskID1.String()),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{
+				Response: v1.ListTasksResponse{
+					Tasks: []*v1.Task{
+						{
+							Metadata: &v1.TaskMetadata{
+								Id:               taskID2.String(),
+								ForkedFromTaskId: strPtr(taskID1.String()),
+							},
+							Spec: &v1.TaskSpec{
+								AgentId:      strPtr(agentID.String()),
+								DesiredPhase: v1.TaskPhase_TASK_PHASE_RUNNING,
+							},
+							Status: &v1.TaskStatus{
+								Usage: &v1.TaskUsage{},
+								Phase: v1.TaskPhase_TASK_PHASE_AWAITING,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			Name: "invalid agent ID format",
 			Request: &v1.ListTasksRequest{
@@ -315,6 +402,177 @@ func TestListTasks(t *testing.T) {
 	})
 }
 
+func TestListTasksFilters(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListTasksRequest, v1.ListTasksResponse]{
+		// Only the IDs of the listed tasks are compared, in order.
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+			resp, err := client.Task().ListTasks(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			for i, task := range resp.Msg.Tasks {
+				resp.Msg.Tasks[i] = &v1.Task{Metadata: &v1.TaskMetadata{Id: task.Metadata.Id}}
+			}
+			return resp, nil
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListTasksResponse{}, v1.Task{}, v1.TaskMetadata{}),
+			protocmp.Transform(),
+		},
+	}
+
+	ciTaskID := uuid.New()
+	devTaskID := uuid.New()
+	backendTaskID := uuid.New()
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+
+		db.Task.Create().
+			SetID(ciTaskID).
+			SetAgentID(agent.ID).
+			SetProjectDirectory("/src/construct").
+			SetLabels(map[string]string{"env": "ci", "team": "infra"}).
+			SetPhase(types.TaskPhaseAwaiting).
+			SetCost(0.5).
+			SetCreateTime(start).
+			SetUpdateTime(start.Add(3 * time.Hour)).
+			ExecX(ctx)
+		db.Task.Create().
+			SetID(devTaskID).
+			SetAgentID(agent.ID).
+			SetProjectDirectory("/src/website").
+			SetLabels(map[string]string{"env": "dev"}).
+			SetPhase(types.TaskPhaseRunning).
+			SetCost(2).
+			SetCreateTime(start.Add(time.Hour)).
+			SetUpdateTime(start.Add(time.Hour)).
+			ExecX(ctx)
+		db.Task.Create().
+			SetID(backendTaskID).
+			SetAgentID(agent.ID).
+			SetProjectDirectory("/src/construct/backend").
+			SetPhase(types.TaskPhaseSuspended).
+			SetCreateTime(start.Add(2 * time.Hour)).
+			SetUpdateTime(start.Add(2 * time.Hour)).
+			ExecX(ctx)
+	}
+
+	tasks := func(ids ...uuid.UUID) v1.ListTasksResponse {
+		tasks := make([]*v1.Task, 0, len(ids))
+		for _, id := range ids {
+			tasks = append(tasks, &v1.Task{Metadata: &v1.TaskMetadata{Id: id.String()}})
+		}
+		return v1.ListTasksResponse{Tasks: tasks}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListTasksRequest, v1.ListTasksResponse]{
+		{
+			Name:         "label equals",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{LabelSelector: strPtr("env=ci,team=infra")},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(ciTaskID)},
+		},
+		{
+			Name:         "label not equals matches tasks without the label",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{LabelSelector: strPtr("env!=ci")},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(backendTaskID, devTaskID)},
+		},
+		{
+			Name:         "label exists",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{LabelSelector: strPtr("env, !team")},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(devTaskID)},
+		},
+		{
+			Name: "invalid label selector",
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{LabelSelector: strPtr("env='ci'")},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{
+				Error: `invalid_argument: invalid label selector "env='ci'": invalid value "'ci'" of label env: must have 1-255 letters, digits, '.', '_', '-' or '/'`,
+			},
+		},
+		{
+			Name:         "workspace prefix",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{WorkspacePrefix: strPtr("/src/construct")},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(backendTaskID, ciTaskID)},
+		},
+		{
+			Name:         "phases",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{
+					Phases: []v1.TaskPhase{v1.TaskPhase_TASK_PHASE_RUNNING, v1.TaskPhase_TASK_PHASE_SUSPENDED},
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(backendTaskID, devTaskID)},
+		},
+		{
+			Name:         "created range",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{
+					CreatedAfter:  timestamppb.New(start.Add(time.Hour)),
+					CreatedBefore: timestamppb.New(start.Add(2 * time.Hour)),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(devTaskID)},
+		},
+		{
+			Name:         "updated after",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{
+					UpdatedAfter: timestamppb.New(start.Add(150 * time.Minute)),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(ciTaskID)},
+		},
+		{
+			Name:         "cost range",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{
+					MinCost: client.Ptr(0.1),
+					MaxCost: client.Ptr(1.0),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(ciTaskID)},
+		},
+		{
+			Name:         "sort by cost",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				SortField: client.Ptr(v1.SortField_SORT_FIELD_COST),
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(devTaskID, ciTaskID, backendTaskID)},
+		},
+		{
+			Name:         "sort by cost ascending",
+			SeedDatabase: seed,
+			Request: &v1.ListTasksRequest{
+				SortField: client.Ptr(v1.SortField_SORT_FIELD_COST),
+				SortOrder: client.Ptr(v1.SortOrder_SORT_ORDER_ASC),
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{Response: tasks(backendTaskID, ciTaskID, devTaskID)},
+		},
+	})
+}
+
 func TestUpdateTask(t *testing.T) {
 	setup := ServiceTestSetup[v1.UpdateTaskRequest, v1.UpdateTaskResponse]{
 		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error) {
@@ -422,6 +680,71 @@ func TestUpdateTask(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "success - enable review of edits",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+			},
+			Request: &v1.UpdateTaskRequest{
+				Id:          taskID.String(),
+				ReviewEdits: client.Ptr(true),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateTaskResponse]{
+				Response: v1.UpdateTaskResponse{
+					Task: &v1.Task{
+						Metadata: &v1.TaskMetadata{
+							Id: taskID.String(),
+						},
+						Spec: &v1.TaskSpec{
+							AgentId:      strPtr(agentID.String()),
+							DesiredPhase: v1.TaskPhase_TASK_PHASE_RUNNING,
+							ReviewEdits:  true,
+						},
+						Status: &v1.TaskStatus{
+							Usage: &v1.TaskUsage{},
+							Phase: v1.TaskPhase_TASK_PHASE_AWAITING,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "success - merge labels",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+				db.Task.UpdateOne(task).SetLabels(map[string]string{"env": "ci", "team": "infra"}).ExecX(ctx)
+			},
+			Request: &v1.UpdateTaskRequest{
+				Id:     taskID.String(),
+				Labels: map[string]string{"env": "dev", "team": "", "owner": "alice"},
+			},
+			Expected: ServiceTestExpectation[v1.UpdateTaskResponse]{
+				Response: v1.UpdateTaskResponse{
+					Task: &v1.Task{
+						Metadata: &v1.TaskMetadata{
+							Id: taskID.String(),
+						},
+						Spec: &v1.TaskSpec{
+							AgentId:      strPtr(agentID.String()),
+							DesiredPhase: v1.TaskPhase_TASK_PHASE_RUNNING,
+							Labels:       map[string]string{"env": "dev", "owner": "alice"},
+						},
+						Status: &v1.TaskStatus{
+							Usage: &v1.TaskUsage{},
+							Phase: v1.TaskPhase_TASK_PHASE_AWAITING,
+						},
+					},
+				},
+			},
+		},
 	})
 }
 
@@ -482,3 +805,704 @@ func TestDeleteTask(t *testing.T) {
 		},
 	})
 }
+
+func TestExportTranscript(t *testing.T) {
+	setup := ServiceTestSetup[v1.ExportTranscriptRequest, v1.ExportTranscriptResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error) {
+			return client.Task().ExportTranscript(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ExportTranscriptResponse{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ExportTranscriptResponse{}, "content"),
+		},
+	}
+
+	taskID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ExportTranscriptRequest, v1.ExportTranscriptResponse]{
+		{
+			Name: "task not found",
+			Request: &v1.ExportTranscriptRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ExportTranscriptResponse]{
+				Error: "not_found: task not found",
+			},
+		},
+		{
+			Name: "success",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+				task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+				test.NewMessageBuilder(t, uuid.New(), db, task).Build(ctx)
+			},
+			Request: &v1.ExportTranscriptRequest{
+				Id:     taskID.String(),
+				Format: v1.TranscriptFormat_TRANSCRIPT_FORMAT_HTML,
+			},
+			Expected: ServiceTestExpectation[v1.ExportTranscriptResponse]{
+				Response: v1.ExportTranscriptResponse{
+					ContentType: "text/html; charset=utf-8",
+				},
+			},
+		},
+	})
+}
+
+func TestCompactTask(t *testing.T) {
+	setup := ServiceTestSetup[v1.CompactTaskRequest, v1.CompactTaskResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error) {
+			return client.Task().CompactTask(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.CompactTaskResponse{}),
+			protocmp.Transform(),
+		},
+	}
+
+	taskID := uuid.New()
+
+	seedTask := func(ctx context.Context, db *memory.Client) *memory.Task {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+		return test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.CompactTaskRequest, v1.CompactTaskResponse]{
+		{
+			Name: "task not found",
+			Request: &v1.CompactTaskRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.CompactTaskResponse]{
+				Error: "not_found: task not found",
+			},
+		},
+		{
+			Name: "task is running",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				task := seedTask(ctx, db)
+				db.Task.UpdateOne(task).SetPhase(types.TaskPhaseRunning).ExecX(ctx)
+			},
+			Request: &v1.CompactTaskRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.CompactTaskResponse]{
+				Error: "failed_precondition: task is running, suspend it or wait until it is done",
+			},
+		},
+		{
+			Name: "no processed messages",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				task := seedTask(ctx, db)
+				test.NewMessageBuilder(t, uuid.New(), db, task).Build(ctx)
+			},
+			Request: &v1.CompactTaskRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.CompactTaskResponse]{
+				Error: "failed_precondition: task has no messages to compact",
+			},
+		},
+		{
+			Name: "success",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				task := seedTask(ctx, db)
+				message := test.NewMessageBuilder(t, uuid.New(), db, task).Build(ctx)
+				db.Message.UpdateOne(message).SetProcessedTime(time.Now()).ExecX(ctx)
+			},
+			Request: &v1.CompactTaskRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.CompactTaskResponse]{
+				Response: v1.CompactTaskResponse{
+					Summary:           "summary of the conversation",
+					CompactedMessages: 1,
+				},
+			},
+		},
+	})
+}
+
+func TestForkTask(t *testing.T) {
+	setup := ServiceTestSetup[v1.ForkTaskRequest, v1.ForkTaskResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ForkTaskRequest]) (*connect.Response[v1.ForkTaskResponse], error) {
+			return client.Task().ForkTask(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ForkTaskResponse{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.TaskMetadata{}, "id", "created_at", "updated_at"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			messages, err := db.Message.Query().
+				Where(message.HasTaskWith(task.HasForkedFrom())).
+				Order(message.ByCreateTime()).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			texts := make([]string, 0, len(messages))
+			for _, m := range messages {
+				texts = append(texts, m.Content.Blocks[0].Payload)
+			}
+			return texts, nil
+		},
+	}
+
+	taskID := uuid.New()
+	agentID := uuid.New()
+	messageIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+		original := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+		db.Task.UpdateOne(original).SetDescription("refactor the parser").SetProjectDirectory("/workspace").ExecX(ctx)
+
+		start := time.Now().Add(-time.Hour)
+		for i, id := range messageIDs {
+			db.Message.Create().
+				SetID(id).
+				SetTaskID(taskID).
+				SetSource(types.MessageSourceUser).
+				SetContent(&types.MessageContent{Blocks: []types.MessageBlock{
+					{Kind: types.MessageBlockKindText, Payload: fmt.Sprintf("message %d", i+1)},
+				}}).
+				SetCreateTime(start.Add(time.Duration(i) * time.Minute)).
+				SetProcessedTime(time.Now()).
+				ExecX(ctx)
+		}
+	}
+
+	forkedTask := func(messageID uuid.UUID, description string, messageCount int64) *v1.Task {
+		return &v1.Task{
+			Metadata: &v1.TaskMetadata{
+				ForkedFromTaskId:    client.Ptr(taskID.String()),
+				ForkedFromMessageId: client.Ptr(messageID.String()),
+			},
+			Spec: &v1.TaskSpec{
+				AgentId:      client.Ptr(agentID.String()),
+				Workspace:    "/workspace",
+				DesiredPhase: v1.TaskPhase_TASK_PHASE_RUNNING,
+				Description:  description,
+			},
+			Status: &v1.TaskStatus{
+				Usage:        &v1.TaskUsage{},
+				Phase:        v1.TaskPhase_TASK_PHASE_AWAITING,
+				MessageCount: messageCount,
+			},
+		}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ForkTaskRequest, v1.ForkTaskResponse]{
+		{
+			Name: "task not found",
+			Request: &v1.ForkTaskRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ForkTaskResponse]{
+				Error: "not_found: task not found",
+			},
+		},
+		{
+			Name:         "message of another task",
+			SeedDatabase: seed,
+			Request: &v1.ForkTaskRequest{
+				Id:        taskID.String(),
+				MessageId: client.Ptr(uuid.New().String()),
+			},
+			Expected: ServiceTestExpectation[v1.ForkTaskResponse]{
+				Error: "not_found: message not found",
+			},
+		},
+		{
+			Name:         "success - fork from message",
+			SeedDatabase: seed,
+			Request: &v1.ForkTaskRequest{
+				Id:        taskID.String(),
+				MessageId: client.Ptr(messageIDs[1].String()),
+			},
+			Expected: ServiceTestExpectation[v1.ForkTaskResponse]{
+				Response: v1.ForkTaskResponse{
+					Task:           forkedTask(messageIDs[1], "refactor the parser", 2),
+					CopiedMessages: 2,
+				},
+				Database: []string{"message 1", "message 2"},
+			},
+		},
+		{
+			Name:         "success - fork whole conversation",
+			SeedDatabase: seed,
+			Request: &v1.ForkTaskRequest{
+				Id:          taskID.String(),
+				Description: client.Ptr("refactor the parser without generics"),
+			},
+			Expected: ServiceTestExpectation[v1.ForkTaskResponse]{
+				Response: v1.ForkTaskResponse{
+					Task:           forkedTask(messageIDs[2], "refactor the parser without generics", 3),
+					CopiedMessages: 3,
+				},
+				Database: []string{"message 1", "message 2", "message 3"},
+			},
+		},
+	})
+}
+
+func TestListWorkspaceFiles(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListWorkspaceFilesRequest, v1.ListWorkspaceFilesResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListWorkspaceFilesRequest]) (*connect.Response[v1.ListWorkspaceFilesResponse], error) {
+			return client.Task().ListWorkspaceFiles(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListWorkspaceFilesResponse{}),
+			protocmp.Transform(),
+		},
+	}
+
+	taskID := uuid.New()
+	workspaceDir := newTestWorkspace(t, map[string]string{
+		"main.go":             "package main\n",
+		"backend/api/task.go": "package api\n",
+		"docs/README.md":      "# Docs\n",
+	})
+
+	seedTask := func(ctx context.Context, db *memory.Client, projectDirectory string) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+		task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+		db.Task.UpdateOne(task).SetProjectDirectory(projectDirectory).ExecX(ctx)
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListWorkspaceFilesRequest, v1.ListWorkspaceFilesResponse]{
+		{
+			Name: "task not found",
+			Request: &v1.ListWorkspaceFilesRequest{
+				TaskId: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListWorkspaceFilesResponse]{
+				Error: "not_found: task not found",
+			},
+		},
+		{
+			Name: "task without workspace",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedTask(ctx, db, "")
+			},
+			Request: &v1.ListWorkspaceFilesRequest{
+				TaskId: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListWorkspaceFilesResponse]{
+				Error: "failed_precondition: task has no workspace",
+			},
+		},
+		{
+			Name: "all files",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedTask(ctx, db, workspaceDir)
+			},
+			Request: &v1.ListWorkspaceFilesRequest{
+				TaskId: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListWorkspaceFilesResponse]{
+				Response: v1.ListWorkspaceFilesResponse{
+					Paths: []string{"main.go", "docs/README.md", "backend/api/task.go"},
+				},
+			},
+		},
+		{
+			Name: "fuzzy query with limit",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedTask(ctx, db, workspaceDir)
+			},
+			Request: &v1.ListWorkspaceFilesRequest{
+				TaskId: taskID.String(),
+				Query:  "apitsk",
+				Limit:  client.Ptr(int32(1)),
+			},
+			Expected: ServiceTestExpectation[v1.ListWorkspaceFilesResponse]{
+				Response: v1.ListWorkspaceFilesResponse{
+					Paths: []string{"backend/api/task.go"},
+				},
+			},
+		},
+	})
+}
+
+func TestSearchWorkspaceSymbols(t *testing.T) {
+	setup := ServiceTestSetup[v1.SearchWorkspaceSymbolsRequest, v1.SearchWorkspaceSymbolsResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.SearchWorkspaceSymbolsRequest]) (*connect.Response[v1.SearchWorkspaceSymbolsResponse], error) {
+			return client.Task().SearchWorkspaceSymbols(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.SearchWorkspaceSymbolsResponse{}, v1.WorkspaceSymbol{}),
+			protocmp.Transform(),
+		},
+	}
+
+	taskID := uuid.New()
+	workspaceDir := newTestWorkspace(t, map[string]string{
+		"server.go": "package main\n\ntype Server struct {\n\taddr string\n}\n\nfunc (s *Server) Start() error {\n\treturn nil\n}\n",
+	})
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.SearchWorkspaceSymbolsRequest, v1.SearchWorkspaceSymbolsResponse]{
+		{
+			Name: "go method",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+				task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+				db.Task.UpdateOne(task).SetProjectDirectory(workspaceDir).ExecX(ctx)
+			},
+			Request: &v1.SearchWorkspaceSymbolsRequest{
+				TaskId: taskID.String(),
+				Query:  "start",
+			},
+			Expected: ServiceTestExpectation[v1.SearchWorkspaceSymbolsResponse]{
+				Response: v1.SearchWorkspaceSymbolsResponse{
+					Symbols: []*v1.WorkspaceSymbol{
+						{Name: "Start", Kind: "function", Path: "server.go", Line: 7, EndLine: 9},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestListFileChanges(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListFileChangesRequest, v1.ListFileChangesResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListFileChangesRequest]) (*connect.Response[v1.ListFileChangesResponse], error) {
+			return client.Task().ListFileChanges(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListFileChangesResponse{}, v1.FileChange{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.FileChange{}, "patch", "created_at", "updated_at"),
+		},
+	}
+
+	taskID := uuid.New()
+	pendingID := uuid.New()
+	rejectedID := uuid.New()
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+		task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+
+		db.FileChange.Create().
+			SetID(pendingID).
+			SetTask(task).
+			SetPath("/workspace/main.go").
+			SetOriginalContent("package main\n").
+			SetContent("package main\n\nfunc main() {}\n").
+			SaveX(ctx)
+		db.FileChange.Create().
+			SetID(rejectedID).
+			SetTask(task).
+			SetPath("/workspace/util.go").
+			SetContent("package main\n").
+			SetStatus(types.FileChangeStatusRejected).
+			SetReason("not needed").
+			SaveX(ctx)
+	}
+
+	pending := &v1.FileChange{
+		Id:         pendingID.String(),
+		TaskId:     taskID.String(),
+		Path:       "/workspace/main.go",
+		Status:     v1.FileChangeStatus_FILE_CHANGE_STATUS_PENDING,
+		LinesAdded: 2,
+	}
+	rejected := &v1.FileChange{
+		Id:         rejectedID.String(),
+		TaskId:     taskID.String(),
+		Path:       "/workspace/util.go",
+		Status:     v1.FileChangeStatus_FILE_CHANGE_STATUS_REJECTED,
+		LinesAdded: 1,
+		Created:    true,
+		Reason:     "not needed",
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListFileChangesRequest, v1.ListFileChangesResponse]{
+		{
+			Name: "task not found",
+			Request: &v1.ListFileChangesRequest{
+				TaskId: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListFileChangesResponse]{
+				Error: "not_found: task not found",
+			},
+		},
+		{
+			Name:         "all changes",
+			SeedDatabase: seed,
+			Request: &v1.ListFileChangesRequest{
+				TaskId: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListFileChangesResponse]{
+				Response: v1.ListFileChangesResponse{
+					Changes: []*v1.FileChange{pending, rejected},
+				},
+			},
+		},
+		{
+			Name:         "pending changes",
+			SeedDatabase: seed,
+			Request: &v1.ListFileChangesRequest{
+				TaskId: taskID.String(),
+				Status: client.Ptr(v1.FileChangeStatus_FILE_CHANGE_STATUS_PENDING),
+			},
+			Expected: ServiceTestExpectation[v1.ListFileChangesResponse]{
+				Response: v1.ListFileChangesResponse{
+					Changes: []*v1.FileChange{pending},
+				},
+			},
+		},
+	})
+}
+
+func TestAcceptFileChange(t *testing.T) {
+	workspaceDir := newTestWorkspace(t, nil)
+	path := filepath.Join(workspaceDir, "main.go")
+
+	setup := ServiceTestSetup[v1.AcceptFileChangeRequest, v1.AcceptFileChangeResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.AcceptFileChangeRequest]) (*connect.Response[v1.AcceptFileChangeResponse], error) {
+			return client.Task().AcceptFileChange(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.AcceptFileChangeResponse{}, v1.FileChange{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.FileChange{}, "patch", "created_at", "updated_at"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			return string(content), nil
+		},
+	}
+
+	taskID := uuid.New()
+	changeID := uuid.New()
+
+	// seed stages the creation of the file and writes current to the workspace, if set
+	seed := func(status types.FileChangeStatus, current *string) func(ctx context.Context, db *memory.Client) {
+		return func(ctx context.Context, db *memory.Client) {
+			modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+			model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+			agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+			task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+
+			db.FileChange.Create().
+				SetID(changeID).
+				SetTask(task).
+				SetPath(path).
+				SetContent("package main\n").
+				SetStatus(status).
+				SaveX(ctx)
+
+			os.Remove(path)
+			if current != nil {
+				if err := os.WriteFile(path, []byte(*current), 0644); err != nil {
+					t.Fatalf("failed to write %s: %v", path, err)
+				}
+			}
+		}
+	}
+
+	accepted := &v1.FileChange{
+		Id:         changeID.String(),
+		TaskId:     taskID.String(),
+		Path:       path,
+		Status:     v1.FileChangeStatus_FILE_CHANGE_STATUS_ACCEPTED,
+		LinesAdded: 1,
+		Created:    true,
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.AcceptFileChangeRequest, v1.AcceptFileChangeResponse]{
+		{
+			Name: "invalid change ID format",
+			Request: &v1.AcceptFileChangeRequest{
+				Id: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.AcceptFileChangeResponse]{
+				Error: "invalid_argument: invalid change ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "change not found",
+			Request: &v1.AcceptFileChangeRequest{
+				Id: changeID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.AcceptFileChangeResponse]{
+				Error: "not_found: file_change not found",
+			},
+		},
+		{
+			Name:         "already reviewed",
+			SeedDatabase: seed(types.FileChangeStatusRejected, nil),
+			Request: &v1.AcceptFileChangeRequest{
+				Id: changeID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.AcceptFileChangeResponse]{
+				Error: "failed_precondition: the change was already reviewed",
+			},
+		},
+		{
+			Name:         "file changed since the edit",
+			SeedDatabase: seed(types.FileChangeStatusPending, client.Ptr("package edited\n")),
+			Request: &v1.AcceptFileChangeRequest{
+				Id: changeID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.AcceptFileChangeResponse]{
+				Error:    "failed_precondition: the file was changed since the agent edited it",
+				Database: "package edited\n",
+			},
+		},
+		{
+			Name:         "success",
+			SeedDatabase: seed(types.FileChangeStatusPending, nil),
+			Request: &v1.AcceptFileChangeRequest{
+				Id: changeID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.AcceptFileChangeResponse]{
+				Response: v1.AcceptFileChangeResponse{Change: accepted},
+				Database: "package main\n",
+			},
+		},
+		{
+			Name:         "force",
+			SeedDatabase: seed(types.FileChangeStatusPending, client.Ptr("package edited\n")),
+			Request: &v1.AcceptFileChangeRequest{
+				Id:    changeID.String(),
+				Force: true,
+			},
+			Expected: ServiceTestExpectation[v1.AcceptFileChangeResponse]{
+				Response: v1.AcceptFileChangeResponse{Change: accepted},
+				Database: "package main\n",
+			},
+		},
+	})
+}
+
+func TestRejectFileChange(t *testing.T) {
+	workspaceDir := newTestWorkspace(t, map[string]string{"main.go": "package main\n"})
+	path := filepath.Join(workspaceDir, "main.go")
+
+	type rejectResources struct {
+		Content  string
+		Messages []string
+	}
+
+	setup := ServiceTestSetup[v1.RejectFileChangeRequest, v1.RejectFileChangeResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.RejectFileChangeRequest]) (*connect.Response[v1.RejectFileChangeResponse], error) {
+			return client.Task().RejectFileChange(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.RejectFileChangeResponse{}, v1.FileChange{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.FileChange{}, "patch", "created_at", "updated_at"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+
+			messages, err := db.Message.Query().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			texts := make([]string, 0, len(messages))
+			for _, m := range messages {
+				texts = append(texts, m.Content.Blocks[0].Payload)
+			}
+			return rejectResources{Content: string(content), Messages: texts}, nil
+		},
+	}
+
+	taskID := uuid.New()
+	changeID := uuid.New()
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+		task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+
+		db.FileChange.Create().
+			SetID(changeID).
+			SetTask(task).
+			SetPath(path).
+			SetOriginalContent("package main\n").
+			SetContent("package app\n").
+			SaveX(ctx)
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.RejectFileChangeRequest, v1.RejectFileChangeResponse]{
+		{
+			Name: "change not found",
+			Request: &v1.RejectFileChangeRequest{
+				Id: changeID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.RejectFileChangeResponse]{
+				Error: "not_found: file_change not found",
+			},
+		},
+		{
+			// the staged content was never written, so the file keeps its content
+			Name:         "success",
+			SeedDatabase: seed,
+			Request: &v1.RejectFileChangeRequest{
+				Id:     changeID.String(),
+				Reason: "keep the package name",
+			},
+			Expected: ServiceTestExpectation[v1.RejectFileChangeResponse]{
+				Response: v1.RejectFileChangeResponse{
+					Change: &v1.FileChange{
+						Id:           changeID.String(),
+						TaskId:       taskID.String(),
+						Path:         path,
+						Status:       v1.FileChangeStatus_FILE_CHANGE_STATUS_REJECTED,
+						LinesAdded:   1,
+						LinesRemoved: 1,
+						Reason:       "keep the package name",
+					},
+				},
+				Database: rejectResources{
+					Content: "package main\n",
+					Messages: []string{
+						"I rejected your changes to " + path + ". The file keeps the content it had before you edited it.\n\n" +
+							"Reason: keep the package name\n\nTake the reason into account before you continue.",
+					},
+				},
+			},
+		},
+	})
+}
+
+func newTestWorkspace(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+	return dir
+}
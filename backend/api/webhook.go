@@ -0,0 +1,340 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/furisto/construct/backend/memory/webhooktrigger"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/furisto/construct/backend/webhook"
+	"github.com/google/uuid"
+)
+
+var _ v1connect.WebhookServiceHandler = (*WebhookHandler)(nil)
+
+func NewWebhookHandler(db *memory.Client, encryption *secret.Encryption) *WebhookHandler {
+	return &WebhookHandler{
+		db:         db,
+		encryption: encryption,
+	}
+}
+
+type WebhookHandler struct {
+	db         *memory.Client
+	encryption *secret.Encryption
+	v1connect.UnimplementedWebhookServiceHandler
+}
+
+func (h *WebhookHandler) CreateWebhookTrigger(ctx context.Context, req *connect.Request[v1.CreateWebhookTriggerRequest]) (*connect.Response[v1.CreateWebhookTriggerResponse], error) {
+	target, err := conv.ConvertWebhookTargetToMemory(req.Msg.Target)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	var agentID uuid.UUID
+	if req.Msg.AgentId != nil {
+		agentID, err = uuid.Parse(*req.Msg.AgentId)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
+		}
+	}
+
+	switch target {
+	case types.WebhookTargetNewTask:
+		if agentID == uuid.Nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent ID is required for new tasks")))
+		}
+	case types.WebhookTargetMessage:
+		if req.Msg.TaskIdTemplate == "" {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("task ID template is required for messages")))
+		}
+	}
+
+	if _, err := webhook.ParseTemplate("prompt", req.Msg.PromptTemplate); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	if req.Msg.TaskIdTemplate != "" {
+		if _, err := webhook.ParseTemplate("task ID", req.Msg.TaskIdTemplate); err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+	}
+
+	signingSecret := req.Msg.Secret
+	if signingSecret == "" {
+		signingSecret, err = webhook.GenerateSecret()
+		if err != nil {
+			return nil, apiError(err)
+		}
+	}
+
+	signatureHeader := req.Msg.SignatureHeader
+	if signatureHeader == "" {
+		signatureHeader = webhook.DefaultSignatureHeader
+	}
+
+	triggerID := uuid.New()
+	encryptedSecret, err := h.encryption.Encrypt([]byte(signingSecret), secret.WebhookTriggerAssociated(triggerID))
+	if err != nil {
+		return nil, apiError(fmt.Errorf("failed to encrypt webhook secret"))
+	}
+
+	owner, team := ownerOf(ctx)
+	createdTrigger, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.WebhookTrigger, error) {
+		create := tx.WebhookTrigger.Create().
+			SetID(triggerID).
+			SetOwner(owner).
+			SetTeam(team).
+			SetName(req.Msg.Name).
+			SetTarget(target).
+			SetPromptTemplate(req.Msg.PromptTemplate).
+			SetSecret(encryptedSecret).
+			SetSignatureHeader(signatureHeader)
+
+		if agentID != uuid.Nil {
+			if _, err := tx.Agent.Query().Where(agent.ID(agentID), predicate.Agent(visibleTo(ctx))).Only(ctx); err != nil {
+				return nil, err
+			}
+			create = create.SetAgentID(agentID)
+		}
+
+		if req.Msg.Description != "" {
+			create = create.SetDescription(req.Msg.Description)
+		}
+
+		if req.Msg.Workspace != "" {
+			create = create.SetWorkspace(req.Msg.Workspace)
+		}
+
+		if req.Msg.TaskIdTemplate != "" {
+			create = create.SetTaskIDTemplate(req.Msg.TaskIdTemplate)
+		}
+
+		return create.Save(ctx)
+	})
+
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTrigger, err := conv.ConvertWebhookTriggerToProto(createdTrigger)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateWebhookTriggerResponse{
+		Trigger: protoTrigger,
+		Secret:  signingSecret,
+	}), nil
+}
+
+func (h *WebhookHandler) GetWebhookTrigger(ctx context.Context, req *connect.Request[v1.GetWebhookTriggerRequest]) (*connect.Response[v1.GetWebhookTriggerResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook trigger ID format: %w", err)))
+	}
+
+	t, err := h.db.WebhookTrigger.Query().Where(webhooktrigger.ID(id), predicate.WebhookTrigger(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTrigger, err := conv.ConvertWebhookTriggerToProto(t)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetWebhookTriggerResponse{
+		Trigger: protoTrigger,
+	}), nil
+}
+
+func (h *WebhookHandler) ListWebhookTriggers(ctx context.Context, req *connect.Request[v1.ListWebhookTriggersRequest]) (*connect.Response[v1.ListWebhookTriggersResponse], error) {
+	query := h.db.WebhookTrigger.Query().Where(predicate.WebhookTrigger(visibleTo(ctx)))
+
+	if req.Msg.Filter != nil {
+		if len(req.Msg.Filter.Names) > 0 {
+			query = query.Where(webhooktrigger.NameIn(req.Msg.Filter.Names...))
+		}
+
+		if req.Msg.Filter.Enabled != nil {
+			query = query.Where(webhooktrigger.Enabled(*req.Msg.Filter.Enabled))
+		}
+	}
+
+	if req.Msg.PageSize != nil {
+		query = query.Limit(int(*req.Msg.PageSize))
+	}
+
+	triggers, err := query.Order(webhooktrigger.ByName()).All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTriggers := make([]*v1.WebhookTrigger, 0, len(triggers))
+	for _, t := range triggers {
+		protoTrigger, err := conv.ConvertWebhookTriggerToProto(t)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoTriggers = append(protoTriggers, protoTrigger)
+	}
+
+	return connect.NewResponse(&v1.ListWebhookTriggersResponse{
+		Triggers: protoTriggers,
+	}), nil
+}
+
+func (h *WebhookHandler) UpdateWebhookTrigger(ctx context.Context, req *connect.Request[v1.UpdateWebhookTriggerRequest]) (*connect.Response[v1.UpdateWebhookTriggerResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook trigger ID format: %w", err)))
+	}
+
+	updatedTrigger, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.WebhookTrigger, error) {
+		existing, err := tx.WebhookTrigger.Query().Where(webhooktrigger.ID(id), predicate.WebhookTrigger(visibleTo(ctx))).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorizeChange(ctx, "webhook trigger", existing.Owner); err != nil {
+			return nil, err
+		}
+
+		update := tx.WebhookTrigger.UpdateOneID(id)
+
+		if req.Msg.Description != nil {
+			update = update.SetDescription(*req.Msg.Description)
+		}
+
+		if req.Msg.AgentId != nil {
+			agentID, err := uuid.Parse(*req.Msg.AgentId)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err))
+			}
+			if _, err := tx.Agent.Query().Where(agent.ID(agentID), predicate.Agent(visibleTo(ctx))).Only(ctx); err != nil {
+				return nil, err
+			}
+			update = update.SetAgentID(agentID)
+		}
+
+		if req.Msg.Workspace != nil {
+			update = update.SetWorkspace(*req.Msg.Workspace)
+		}
+
+		if req.Msg.PromptTemplate != nil {
+			if _, err := webhook.ParseTemplate("prompt", *req.Msg.PromptTemplate); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			update = update.SetPromptTemplate(*req.Msg.PromptTemplate)
+		}
+
+		if req.Msg.TaskIdTemplate != nil {
+			if *req.Msg.TaskIdTemplate == "" && existing.Target == types.WebhookTargetMessage {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("task ID template is required for messages"))
+			}
+			if _, err := webhook.ParseTemplate("task ID", *req.Msg.TaskIdTemplate); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			update = update.SetTaskIDTemplate(*req.Msg.TaskIdTemplate)
+		}
+
+		if req.Msg.Secret != nil {
+			encryptedSecret, err := h.encryption.Encrypt([]byte(*req.Msg.Secret), secret.WebhookTriggerAssociated(id))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt webhook secret")
+			}
+			update = update.SetSecret(encryptedSecret)
+		}
+
+		if req.Msg.SignatureHeader != nil {
+			update = update.SetSignatureHeader(*req.Msg.SignatureHeader)
+		}
+
+		if req.Msg.Enabled != nil {
+			update = update.SetEnabled(*req.Msg.Enabled)
+		}
+
+		return update.Save(ctx)
+	})
+
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTrigger, err := conv.ConvertWebhookTriggerToProto(updatedTrigger)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateWebhookTriggerResponse{
+		Trigger: protoTrigger,
+	}), nil
+}
+
+func (h *WebhookHandler) DeleteWebhookTrigger(ctx context.Context, req *connect.Request[v1.DeleteWebhookTriggerRequest]) (*connect.Response[v1.DeleteWebhookTriggerResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook trigger ID format: %w", err)))
+	}
+
+	trigger, err := h.db.WebhookTrigger.Query().Where(webhooktrigger.ID(id), predicate.WebhookTrigger(visibleTo(ctx))).Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := authorizeChange(ctx, "webhook trigger", trigger.Owner); err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := h.db.WebhookTrigger.DeleteOne(trigger).Exec(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteWebhookTriggerResponse{}), nil
+}
+
+func (h *WebhookHandler) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	id, err := uuid.Parse(req.Msg.TriggerId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook trigger ID format: %w", err)))
+	}
+
+	if _, err := h.db.WebhookTrigger.Query().Where(webhooktrigger.ID(id), predicate.WebhookTrigger(visibleTo(ctx))).Only(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
+	query := h.db.WebhookDelivery.Query().
+		Where(webhookdelivery.TriggerID(id)).
+		Order(webhookdelivery.ByCreateTime(sql.OrderDesc()))
+
+	if req.Msg.PageSize != nil {
+		query = query.Limit(int(*req.Msg.PageSize))
+	}
+
+	deliveries, err := query.All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoDeliveries := make([]*v1.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		protoDelivery, err := conv.ConvertWebhookDeliveryToProto(d)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoDeliveries = append(protoDeliveries, protoDelivery)
+	}
+
+	return connect.NewResponse(&v1.ListWebhookDeliveriesResponse{
+		Deliveries: protoDeliveries,
+	}), nil
+}
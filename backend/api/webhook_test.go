@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestCreateWebhookTrigger(t *testing.T) {
+	setup := ServiceTestSetup[v1.CreateWebhookTriggerRequest, v1.CreateWebhookTriggerResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.CreateWebhookTriggerRequest]) (*connect.Response[v1.CreateWebhookTriggerResponse], error) {
+			return client.Webhook().CreateWebhookTrigger(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.CreateWebhookTriggerResponse{}, v1.WebhookTrigger{}, v1.WebhookTriggerMetadata{}, v1.WebhookTriggerSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookTrigger{}, "metadata"),
+		},
+	}
+
+	agentID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateWebhookTriggerRequest, v1.CreateWebhookTriggerResponse]{
+		{
+			Name: "new task without agent",
+			Request: &v1.CreateWebhookTriggerRequest{
+				Name:           "push",
+				PromptTemplate: "Review the push to {{.Payload.ref}}",
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookTriggerResponse]{
+				Error: "invalid_argument: agent ID is required for new tasks",
+			},
+		},
+		{
+			Name: "message without task ID template",
+			Request: &v1.CreateWebhookTriggerRequest{
+				Name:           "comment",
+				Target:         v1.WebhookTarget_WEBHOOK_TARGET_MESSAGE,
+				PromptTemplate: "{{.Payload.comment}}",
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookTriggerResponse]{
+				Error: "invalid_argument: task ID template is required for messages",
+			},
+		},
+		{
+			Name: "invalid prompt template",
+			Request: &v1.CreateWebhookTriggerRequest{
+				Name:           "push",
+				AgentId:        strPtr(agentID.String()),
+				PromptTemplate: "Review {{.Payload.ref",
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookTriggerResponse]{
+				Error: "invalid_argument: invalid prompt template: template: prompt:1: unclosed action",
+			},
+		},
+		{
+			Name: "agent not found",
+			Request: &v1.CreateWebhookTriggerRequest{
+				Name:           "push",
+				AgentId:        strPtr(agentID.String()),
+				PromptTemplate: "Review the push to {{.Payload.ref}}",
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookTriggerResponse]{
+				Error: "not_found: agent not found",
+			},
+		},
+		{
+			Name: "success with default signature header",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+			},
+			Request: &v1.CreateWebhookTriggerRequest{
+				Name:           "push",
+				Description:    "Review every push",
+				AgentId:        strPtr(agentID.String()),
+				Workspace:      "/tmp/test",
+				PromptTemplate: "Review the push to {{.Payload.ref}}",
+				Secret:         "s3cr3t",
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookTriggerResponse]{
+				Response: v1.CreateWebhookTriggerResponse{
+					Trigger: &v1.WebhookTrigger{
+						Spec: &v1.WebhookTriggerSpec{
+							Name:            "push",
+							Description:     "Review every push",
+							Target:          v1.WebhookTarget_WEBHOOK_TARGET_NEW_TASK,
+							AgentId:         strPtr(agentID.String()),
+							Workspace:       "/tmp/test",
+							PromptTemplate:  "Review the push to {{.Payload.ref}}",
+							SignatureHeader: "X-Construct-Signature",
+							Enabled:         true,
+						},
+					},
+					Secret: "s3cr3t",
+				},
+			},
+		},
+	})
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+			return client.Webhook().ListWebhookDeliveries(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListWebhookDeliveriesResponse{}, v1.WebhookDelivery{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookDelivery{}, "id", "created_at"),
+		},
+	}
+
+	triggerID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]{
+		{
+			Name: "invalid id format",
+			Request: &v1.ListWebhookDeliveriesRequest{
+				TriggerId: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeliveriesResponse]{
+				Error: "invalid_argument: invalid webhook trigger ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "trigger not found",
+			Request: &v1.ListWebhookDeliveriesRequest{
+				TriggerId: triggerID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeliveriesResponse]{
+				Error: "not_found: webhook_trigger not found",
+			},
+		},
+		{
+			Name: "deliveries",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				test.NewWebhookTriggerBuilder(t, triggerID, db, nil).Build(ctx)
+				_, err := db.WebhookDelivery.Create().
+					SetTriggerID(triggerID).
+					SetStatus(types.WebhookDeliveryStatusRejected).
+					SetStatusCode(401).
+					SetError("invalid signature").
+					Save(ctx)
+				if err != nil {
+					t.Fatalf("failed to create webhook delivery: %v", err)
+				}
+			},
+			Request: &v1.ListWebhookDeliveriesRequest{
+				TriggerId: triggerID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeliveriesResponse]{
+				Response: v1.ListWebhookDeliveriesResponse{
+					Deliveries: []*v1.WebhookDelivery{
+						{
+							TriggerId:  triggerID.String(),
+							Status:     v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_REJECTED,
+							StatusCode: 401,
+							Error:      "invalid signature",
+						},
+					},
+				},
+			},
+		},
+	})
+}
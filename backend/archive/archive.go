@@ -0,0 +1,313 @@
+// Package archive exports the agents, models, model providers, tasks and messages of a
+// daemon into a portable archive and imports them into another daemon.
+//
+// An archive is a gzip compressed tar file. It contains a manifest.json and one JSON
+// Lines file per resource, with one record per line.
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// Format identifies construct archives in the manifest.
+const Format = "construct-archive"
+
+// Version is the version of the archive layout written by Export. Import reads archives
+// up to this version.
+const Version = 1
+
+const ManifestFile = "manifest.json"
+
+// Resource names the JSON Lines file of a resource in the archive.
+type Resource string
+
+const (
+	ResourceModelProviders Resource = "model_providers"
+	ResourceModels         Resource = "models"
+	ResourceAgents         Resource = "agents"
+	ResourceTasks          Resource = "tasks"
+	ResourceMessages       Resource = "messages"
+)
+
+// Resources lists the resources in the order they are imported, dependencies first.
+var Resources = []Resource{
+	ResourceModelProviders,
+	ResourceModels,
+	ResourceAgents,
+	ResourceTasks,
+	ResourceMessages,
+}
+
+func (r Resource) FileName() string {
+	return string(r) + ".jsonl"
+}
+
+// Secrets decides how the credentials of model providers are stored in an archive.
+type Secrets string
+
+const (
+	SecretsExcluded  Secrets = "excluded"
+	SecretsEncrypted Secrets = "encrypted"
+)
+
+var ErrUnsupportedArchive = errors.New("unsupported archive")
+
+type Manifest struct {
+	Format     string             `json:"format"`
+	Version    int                `json:"version"`
+	CreateTime time.Time          `json:"create_time"`
+	Filter     Filter             `json:"filter"`
+	Secrets    Secrets            `json:"secrets"`
+	Encryption *Encryption        `json:"encryption,omitempty"`
+	Counts     map[Resource]int64 `json:"counts"`
+}
+
+// Filter selects the tasks of an export. Tasks are exported with all of their messages.
+// Agents, models and model providers are always exported in full.
+type Filter struct {
+	CreatedAfter  *time.Time `json:"created_after,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+	// Workspace selects the tasks whose project directory is the workspace or lies within it.
+	Workspace string `json:"workspace,omitempty"`
+}
+
+type ModelProviderRecord struct {
+	ID           uuid.UUID               `json:"id"`
+	CreateTime   time.Time               `json:"create_time"`
+	UpdateTime   time.Time               `json:"update_time"`
+	Name         string                  `json:"name"`
+	ProviderType types.ModelProviderType `json:"provider_type"`
+	URL          string                  `json:"url,omitempty"`
+	Enabled      bool                    `json:"enabled"`
+	// Secret holds the credentials sealed with the passphrase of the archive.
+	Secret []byte `json:"secret,omitempty"`
+}
+
+type ModelRecord struct {
+	ID              uuid.UUID               `json:"id"`
+	CreateTime      time.Time               `json:"create_time"`
+	UpdateTime      time.Time               `json:"update_time"`
+	Name            string                  `json:"name"`
+	ContextWindow   int64                   `json:"context_window"`
+	Capabilities    []types.ModelCapability `json:"capabilities,omitempty"`
+	InputCost       float64                 `json:"input_cost"`
+	OutputCost      float64                 `json:"output_cost"`
+	CacheWriteCost  float64                 `json:"cache_write_cost"`
+	CacheReadCost   float64                 `json:"cache_read_cost"`
+	Enabled         bool                    `json:"enabled"`
+	Alias           string                  `json:"alias,omitempty"`
+	ModelProviderID uuid.UUID               `json:"model_provider_id"`
+}
+
+type AgentRecord struct {
+	ID           uuid.UUID  `json:"id"`
+	CreateTime   time.Time  `json:"create_time"`
+	UpdateTime   time.Time  `json:"update_time"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	Instructions string     `json:"instructions"`
+	Builtin      bool       `json:"builtin"`
+	ModelID      *uuid.UUID `json:"model_id,omitempty"`
+}
+
+type TaskRecord struct {
+	ID               uuid.UUID        `json:"id"`
+	CreateTime       time.Time        `json:"create_time"`
+	UpdateTime       time.Time        `json:"update_time"`
+	Description      string           `json:"description,omitempty"`
+	ProjectDirectory string           `json:"project_directory,omitempty"`
+	InputTokens      int64            `json:"input_tokens,omitempty"`
+	OutputTokens     int64            `json:"output_tokens,omitempty"`
+	CacheWriteTokens int64            `json:"cache_write_tokens,omitempty"`
+	CacheReadTokens  int64            `json:"cache_read_tokens,omitempty"`
+	Cost             float64          `json:"cost,omitempty"`
+	Turns            int64            `json:"turns"`
+	ToolUses         map[string]int64 `json:"tool_uses,omitempty"`
+	DesiredPhase     types.TaskPhase  `json:"desired_phase"`
+	Phase            types.TaskPhase  `json:"phase"`
+	AgentID          *uuid.UUID       `json:"agent_id,omitempty"`
+}
+
+type MessageRecord struct {
+	ID            uuid.UUID             `json:"id"`
+	CreateTime    time.Time             `json:"create_time"`
+	UpdateTime    time.Time             `json:"update_time"`
+	TaskID        uuid.UUID             `json:"task_id"`
+	Source        types.MessageSource   `json:"source"`
+	Content       *types.MessageContent `json:"content"`
+	Usage         *types.MessageUsage   `json:"usage,omitempty"`
+	ProcessedTime *time.Time            `json:"processed_time,omitempty"`
+	AgentID       *uuid.UUID            `json:"agent_id,omitempty"`
+	ModelID       *uuid.UUID            `json:"model_id,omitempty"`
+}
+
+// Archive is the content of an archive, read into memory.
+type Archive struct {
+	Manifest       Manifest
+	ModelProviders []ModelProviderRecord
+	Models         []ModelRecord
+	Agents         []AgentRecord
+	Tasks          []TaskRecord
+	Messages       []MessageRecord
+}
+
+// Write encodes the archive and writes it to w. The counts of the manifest are derived
+// from the records.
+func (a *Archive) Write(w io.Writer) error {
+	files := map[Resource]*bytes.Buffer{}
+	for _, resource := range Resources {
+		files[resource] = &bytes.Buffer{}
+	}
+
+	err := errors.Join(
+		encodeLines(files[ResourceModelProviders], a.ModelProviders),
+		encodeLines(files[ResourceModels], a.Models),
+		encodeLines(files[ResourceAgents], a.Agents),
+		encodeLines(files[ResourceTasks], a.Tasks),
+		encodeLines(files[ResourceMessages], a.Messages),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to encode records: %w", err)
+	}
+
+	a.Manifest.Format = Format
+	a.Manifest.Version = Version
+	a.Manifest.Counts = map[Resource]int64{
+		ResourceModelProviders: int64(len(a.ModelProviders)),
+		ResourceModels:         int64(len(a.Models)),
+		ResourceAgents:         int64(len(a.Agents)),
+		ResourceTasks:          int64(len(a.Tasks)),
+		ResourceMessages:       int64(len(a.Messages)),
+	}
+	manifest, err := json.MarshalIndent(a.Manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	writeFile := func(name string, content []byte) error {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(content)),
+			ModTime: a.Manifest.CreateTime,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	}
+
+	if err := writeFile(ManifestFile, manifest); err != nil {
+		return err
+	}
+	for _, resource := range Resources {
+		if err := writeFile(resource.FileName(), files[resource].Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Read decodes an archive. It fails for archives written by a newer version of construct.
+func Read(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: not a gzip file", ErrUnsupportedArchive)
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedArchive, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[header.Name] = content
+	}
+
+	manifest, ok := files[ManifestFile]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is missing", ErrUnsupportedArchive, ManifestFile)
+	}
+
+	archive := &Archive{}
+	if err := json.Unmarshal(manifest, &archive.Manifest); err != nil {
+		return nil, fmt.Errorf("%w: invalid manifest: %v", ErrUnsupportedArchive, err)
+	}
+	if archive.Manifest.Format != Format {
+		return nil, fmt.Errorf("%w: unknown format %q", ErrUnsupportedArchive, archive.Manifest.Format)
+	}
+	if archive.Manifest.Version > Version {
+		return nil, fmt.Errorf("%w: version %d was written by a newer version of construct", ErrUnsupportedArchive, archive.Manifest.Version)
+	}
+
+	err = errors.Join(
+		decodeLines(files, ResourceModelProviders, &archive.ModelProviders),
+		decodeLines(files, ResourceModels, &archive.Models),
+		decodeLines(files, ResourceAgents, &archive.Agents),
+		decodeLines(files, ResourceTasks, &archive.Tasks),
+		decodeLines(files, ResourceMessages, &archive.Messages),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedArchive, err)
+	}
+
+	return archive, nil
+}
+
+func encodeLines[T any](w io.Writer, records []T) error {
+	encoder := json.NewEncoder(w)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeLines[T any](files map[string][]byte, resource Resource, records *[]T) error {
+	content, ok := files[resource.FileName()]
+	if !ok {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	for decoder.More() {
+		var record T
+		if err := decoder.Decode(&record); err != nil {
+			return fmt.Errorf("invalid %s: %w", resource.FileName(), err)
+		}
+		*records = append(*records, record)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+
+	source, sourceEncryption := newTestDatabase(t)
+	seed := seedDatabase(t, source, sourceEncryption)
+
+	exported, err := Export(ctx, source, sourceEncryption, ExportOptions{
+		Filter:     Filter{Workspace: "/work/construct"},
+		Secrets:    SecretsEncrypted,
+		Passphrase: "correct horse",
+	})
+	if err != nil {
+		t.Fatalf("failed to export: %v", err)
+	}
+
+	var b bytes.Buffer
+	if err := exported.Write(&b); err != nil {
+		t.Fatalf("failed to write archive: %v", err)
+	}
+
+	archive, err := Read(&b)
+	if err != nil {
+		t.Fatalf("failed to read archive: %v", err)
+	}
+
+	expectedCounts := map[Resource]int64{
+		ResourceModelProviders: 1,
+		ResourceModels:         1,
+		ResourceAgents:         1,
+		ResourceTasks:          1,
+		ResourceMessages:       2,
+	}
+	if diff := cmp.Diff(expectedCounts, archive.Manifest.Counts); diff != "" {
+		t.Errorf("manifest counts mismatch (-want +got):\n%s", diff)
+	}
+
+	target, targetEncryption := newTestDatabase(t)
+
+	if _, err := Import(ctx, target, targetEncryption, archive, ImportOptions{Passphrase: "wrong"}); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("expected ErrWrongPassphrase, got %v", err)
+	}
+
+	result, err := Import(ctx, target, targetEncryption, archive, ImportOptions{Passphrase: "correct horse", DryRun: true})
+	if err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+	if result.Resources[ResourceMessages].Created != 2 {
+		t.Errorf("expected the dry run to report 2 created messages, got %d", result.Resources[ResourceMessages].Created)
+	}
+	if count := target.Message.Query().CountX(ctx); count != 0 {
+		t.Errorf("expected the dry run to leave the database unchanged, got %d messages", count)
+	}
+
+	result, err = Import(ctx, target, targetEncryption, archive, ImportOptions{Passphrase: "correct horse"})
+	if err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+	assertResult(t, result, ResourceResult{Created: 1}, ResourceResult{Created: 2})
+
+	provider := target.ModelProvider.GetX(ctx, seed.provider)
+	credentials, err := targetEncryption.Decrypt(provider.Secret, secret.ModelProviderAssociated(provider.ID))
+	if err != nil {
+		t.Fatalf("failed to decrypt imported credentials: %v", err)
+	}
+	if string(credentials) != `{"apiKey":"sk-test"}` {
+		t.Errorf("unexpected imported credentials %s", credentials)
+	}
+
+	imported := target.Task.GetX(ctx, seed.task)
+	if imported.ProjectDirectory != "/work/construct/backend" || imported.AgentID != seed.agent {
+		t.Errorf("unexpected imported task %+v", imported)
+	}
+
+	tests := []struct {
+		strategy ConflictStrategy
+		tasks    ResourceResult
+		messages ResourceResult
+	}{
+		{strategy: ConflictSkip, tasks: ResourceResult{Skipped: 1}, messages: ResourceResult{Skipped: 2}},
+		{strategy: ConflictOverwrite, tasks: ResourceResult{Updated: 1}, messages: ResourceResult{Updated: 2}},
+		{strategy: ConflictRemap, tasks: ResourceResult{Remapped: 1}, messages: ResourceResult{Remapped: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			result, err := Import(ctx, target, targetEncryption, archive, ImportOptions{Strategy: tt.strategy, Passphrase: "correct horse"})
+			if err != nil {
+				t.Fatalf("failed to import: %v", err)
+			}
+			assertResult(t, result, tt.tasks, tt.messages)
+		})
+	}
+
+	if count := target.Task.Query().CountX(ctx); count != 2 {
+		t.Errorf("expected the remapped task to be imported next to the original, got %d tasks", count)
+	}
+	if count := target.Message.Query().CountX(ctx); count != 4 {
+		t.Errorf("expected 4 messages, got %d", count)
+	}
+}
+
+func TestImportMatchesResourcesByName(t *testing.T) {
+	ctx := context.Background()
+
+	source, sourceEncryption := newTestDatabase(t)
+	seedDatabase(t, source, sourceEncryption)
+
+	archive, err := Export(ctx, source, sourceEncryption, ExportOptions{})
+	if err != nil {
+		t.Fatalf("failed to export: %v", err)
+	}
+
+	target, targetEncryption := newTestDatabase(t)
+	provider := test.NewModelProviderBuilder(t, uuid.New(), target).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), target, provider).WithName("claude").Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), target, model).WithName("coder").Build(ctx)
+
+	result, err := Import(ctx, target, targetEncryption, archive, ImportOptions{})
+	if err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+
+	for _, resource := range []Resource{ResourceModelProviders, ResourceModels, ResourceAgents} {
+		if diff := cmp.Diff(ResourceResult{Skipped: 1}, *result.Resources[resource]); diff != "" {
+			t.Errorf("%s result mismatch (-want +got):\n%s", resource, diff)
+		}
+	}
+
+	tasks := target.Task.Query().AllX(ctx)
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(tasks))
+	}
+	for _, task := range tasks {
+		if task.AgentID != agent.ID {
+			t.Errorf("expected task %s to reference the existing agent", task.ID)
+		}
+	}
+}
+
+func TestImportWithoutSecrets(t *testing.T) {
+	ctx := context.Background()
+
+	source, sourceEncryption := newTestDatabase(t)
+	seed := seedDatabase(t, source, sourceEncryption)
+
+	archive, err := Export(ctx, source, sourceEncryption, ExportOptions{Secrets: SecretsExcluded})
+	if err != nil {
+		t.Fatalf("failed to export: %v", err)
+	}
+	if len(archive.ModelProviders[0].Secret) != 0 {
+		t.Errorf("expected credentials to be excluded")
+	}
+
+	target, targetEncryption := newTestDatabase(t)
+	result, err := Import(ctx, target, targetEncryption, archive, ImportOptions{})
+	if err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"model provider anthropic was imported without credentials and is disabled"}, result.Warnings); diff != "" {
+		t.Errorf("warnings mismatch (-want +got):\n%s", diff)
+	}
+	if target.ModelProvider.GetX(ctx, seed.provider).Enabled {
+		t.Errorf("expected the model provider to be disabled")
+	}
+}
+
+func assertResult(t *testing.T, result *ImportResult, tasks, messages ResourceResult) {
+	t.Helper()
+
+	if diff := cmp.Diff(tasks, *result.Resources[ResourceTasks]); diff != "" {
+		t.Errorf("task result mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(messages, *result.Resources[ResourceMessages]); diff != "" {
+		t.Errorf("message result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+type seeded struct {
+	provider uuid.UUID
+	agent    uuid.UUID
+	task     uuid.UUID
+}
+
+func seedDatabase(t *testing.T, db *memory.Client, encryption *secret.Encryption) seeded {
+	t.Helper()
+	ctx := context.Background()
+
+	providerID := uuid.New()
+	credentials, err := encryption.Encrypt([]byte(`{"apiKey":"sk-test"}`), secret.ModelProviderAssociated(providerID))
+	if err != nil {
+		t.Fatalf("failed to encrypt credentials: %v", err)
+	}
+	provider := db.ModelProvider.Create().
+		SetID(providerID).
+		SetName("anthropic").
+		SetProviderType(types.ModelProviderTypeAnthropic).
+		SetSecret(credentials).
+		SaveX(ctx)
+
+	model := test.NewModelBuilder(t, uuid.New(), db, provider).WithName("claude").Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).WithName("coder").Build(ctx)
+
+	task := test.NewTaskBuilder(t, uuid.New(), db, agent).Build(ctx)
+	db.Task.UpdateOneID(task.ID).SetProjectDirectory("/work/construct/backend").ExecX(ctx)
+	test.NewMessageBuilder(t, uuid.New(), db, task).Build(ctx)
+	test.NewMessageBuilder(t, uuid.New(), db, task).WithAgent(agent).Build(ctx)
+
+	other := test.NewTaskBuilder(t, uuid.New(), db, agent).Build(ctx)
+	db.Task.UpdateOneID(other.ID).SetProjectDirectory("/work/construct-web").ExecX(ctx)
+	test.NewMessageBuilder(t, uuid.New(), db, other).Build(ctx)
+
+	return seeded{provider: provider.ID, agent: agent.ID, task: task.ID}
+}
+
+func newTestDatabase(t *testing.T) (*memory.Client, *secret.Encryption) {
+	t.Helper()
+
+	db := test.NewDatabase(t)
+
+	keyset, err := secret.GenerateKeyset()
+	if err != nil {
+		t.Fatalf("failed to generate keyset: %v", err)
+	}
+	encryption, err := secret.NewClient(keyset)
+	if err != nil {
+		t.Fatalf("failed to create encryption client: %v", err)
+	}
+
+	return db, encryption
+}
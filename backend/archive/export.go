@@ -0,0 +1,200 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+type ExportOptions struct {
+	Filter  Filter
+	Secrets Secrets
+	// Passphrase seals the credentials of model providers if Secrets is SecretsEncrypted.
+	Passphrase string
+}
+
+// Export reads the resources selected by the options into an archive. Credentials are
+// decrypted with the key of the daemon and sealed with the passphrase of the archive.
+func Export(ctx context.Context, db *memory.Client, encryption *secret.Encryption, options ExportOptions) (*Archive, error) {
+	archive := &Archive{
+		Manifest: Manifest{
+			CreateTime: time.Now().UTC(),
+			Filter:     options.Filter,
+			Secrets:    SecretsExcluded,
+		},
+	}
+
+	var s *sealer
+	if options.Secrets == SecretsEncrypted {
+		var err error
+		archive.Manifest.Encryption, s, err = newEncryption(options.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+		archive.Manifest.Secrets = SecretsEncrypted
+	}
+
+	providers, err := db.ModelProvider.Query().Order(modelprovider.ByCreateTime()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query model providers: %w", err)
+	}
+	for _, provider := range providers {
+		record := ModelProviderRecord{
+			ID:           provider.ID,
+			CreateTime:   provider.CreateTime,
+			UpdateTime:   provider.UpdateTime,
+			Name:         provider.Name,
+			ProviderType: provider.ProviderType,
+			URL:          provider.URL,
+			Enabled:      provider.Enabled,
+		}
+
+		if s != nil {
+			credentials, err := encryption.Decrypt(provider.Secret, secret.ModelProviderAssociated(provider.ID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt credentials of model provider %s: %w", provider.Name, err)
+			}
+			record.Secret, err = s.seal(credentials, provider.ID[:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to seal credentials of model provider %s: %w", provider.Name, err)
+			}
+		}
+		archive.ModelProviders = append(archive.ModelProviders, record)
+	}
+
+	models, err := db.Model.Query().Order(model.ByCreateTime()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query models: %w", err)
+	}
+	for _, m := range models {
+		archive.Models = append(archive.Models, ModelRecord{
+			ID:              m.ID,
+			CreateTime:      m.CreateTime,
+			UpdateTime:      m.UpdateTime,
+			Name:            m.Name,
+			ContextWindow:   m.ContextWindow,
+			Capabilities:    m.Capabilities,
+			InputCost:       m.InputCost,
+			OutputCost:      m.OutputCost,
+			CacheWriteCost:  m.CacheWriteCost,
+			CacheReadCost:   m.CacheReadCost,
+			Enabled:         m.Enabled,
+			Alias:           m.Alias,
+			ModelProviderID: m.ModelProviderID,
+		})
+	}
+
+	agents, err := db.Agent.Query().Order(agent.ByCreateTime()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query agents: %w", err)
+	}
+	for _, a := range agents {
+		archive.Agents = append(archive.Agents, AgentRecord{
+			ID:           a.ID,
+			CreateTime:   a.CreateTime,
+			UpdateTime:   a.UpdateTime,
+			Name:         a.Name,
+			Description:  a.Description,
+			Instructions: a.Instructions,
+			Builtin:      a.Builtin,
+			ModelID:      optionalID(a.ModelID),
+		})
+	}
+
+	taskFilter := options.Filter.predicates()
+	tasks, err := db.Task.Query().Where(taskFilter...).Order(task.ByCreateTime()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	for _, t := range tasks {
+		archive.Tasks = append(archive.Tasks, TaskRecord{
+			ID:               t.ID,
+			CreateTime:       t.CreateTime,
+			UpdateTime:       t.UpdateTime,
+			Description:      t.Description,
+			ProjectDirectory: t.ProjectDirectory,
+			InputTokens:      t.InputTokens,
+			OutputTokens:     t.OutputTokens,
+			CacheWriteTokens: t.CacheWriteTokens,
+			CacheReadTokens:  t.CacheReadTokens,
+			Cost:             t.Cost,
+			Turns:            t.Turns,
+			ToolUses:         t.ToolUses,
+			DesiredPhase:     t.DesiredPhase,
+			Phase:            t.Phase,
+			AgentID:          optionalID(t.AgentID),
+		})
+	}
+
+	messages, err := db.Message.Query().
+		Where(message.HasTaskWith(taskFilter...)).
+		Order(message.ByTaskID(), message.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query messages: %w", err)
+	}
+	for _, m := range messages {
+		archive.Messages = append(archive.Messages, MessageRecord{
+			ID:            m.ID,
+			CreateTime:    m.CreateTime,
+			UpdateTime:    m.UpdateTime,
+			TaskID:        m.TaskID,
+			Source:        m.Source,
+			Content:       m.Content,
+			Usage:         m.Usage,
+			ProcessedTime: optionalTime(m.ProcessedTime),
+			AgentID:       optionalID(m.AgentID),
+			ModelID:       optionalID(m.ModelID),
+		})
+	}
+
+	return archive, nil
+}
+
+func (f Filter) predicates() []predicate.Task {
+	var predicates []predicate.Task
+	if f.CreatedAfter != nil {
+		predicates = append(predicates, task.CreateTimeGTE(*f.CreatedAfter))
+	}
+	if f.CreatedBefore != nil {
+		predicates = append(predicates, task.CreateTimeLT(*f.CreatedBefore))
+	}
+	if f.Workspace != "" {
+		workspace := filepath.Clean(f.Workspace)
+		prefix := workspace
+		if !strings.HasSuffix(prefix, string(filepath.Separator)) {
+			prefix += string(filepath.Separator)
+		}
+		predicates = append(predicates, task.Or(
+			task.ProjectDirectoryEQ(workspace),
+			task.ProjectDirectoryHasPrefix(prefix),
+		))
+	}
+	return predicates
+}
+
+func optionalID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,599 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+// ConflictStrategy decides what happens to a record that conflicts with an existing
+// resource. Tasks and messages conflict by ID. Model providers, models and agents also
+// conflict by name, because two daemons usually configure the same providers and agents
+// under different IDs.
+type ConflictStrategy string
+
+const (
+	// ConflictSkip keeps the existing resource.
+	ConflictSkip ConflictStrategy = "skip"
+	// ConflictOverwrite replaces the existing resource with the record.
+	ConflictOverwrite ConflictStrategy = "overwrite"
+	// ConflictRemap imports conflicting tasks and messages under new IDs. Model providers,
+	// models and agents are matched to the existing resources as with ConflictSkip.
+	ConflictRemap ConflictStrategy = "remap"
+)
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+type ImportOptions struct {
+	Strategy ConflictStrategy
+	// Passphrase opens the credentials of model providers in archives with encrypted secrets.
+	Passphrase string
+	// DryRun reports the outcome of the import without changing the database.
+	DryRun bool
+}
+
+// ResourceResult counts what happened to the records of a resource.
+type ResourceResult struct {
+	Created  int64
+	Updated  int64
+	Skipped  int64
+	Remapped int64
+}
+
+type ImportResult struct {
+	Resources map[Resource]*ResourceResult
+	Warnings  []string
+}
+
+// Import writes the records of an archive to the database in a single transaction.
+// Credentials are re-encrypted with the key of the daemon. Model providers that are
+// created without credentials are disabled.
+func Import(ctx context.Context, db *memory.Client, encryption *secret.Encryption, archive *Archive, options ImportOptions) (*ImportResult, error) {
+	switch options.Strategy {
+	case "":
+		options.Strategy = ConflictSkip
+	case ConflictSkip, ConflictOverwrite, ConflictRemap:
+	default:
+		return nil, fmt.Errorf("unknown conflict strategy %q", options.Strategy)
+	}
+
+	var s *sealer
+	if archive.Manifest.Secrets == SecretsEncrypted {
+		if archive.Manifest.Encryption == nil {
+			return nil, fmt.Errorf("%w: encryption parameters are missing", ErrUnsupportedArchive)
+		}
+
+		var err error
+		s, err = archive.Manifest.Encryption.open(options.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	imp := &importer{
+		archive:    archive,
+		encryption: encryption,
+		sealer:     s,
+		strategy:   options.Strategy,
+		result:     &ImportResult{Resources: map[Resource]*ResourceResult{}},
+		providers:  map[uuid.UUID]uuid.UUID{},
+		models:     map[uuid.UUID]uuid.UUID{},
+		agents:     map[uuid.UUID]uuid.UUID{},
+		tasks:      map[uuid.UUID]uuid.UUID{},
+	}
+	for _, resource := range Resources {
+		imp.result.Resources[resource] = &ResourceResult{}
+	}
+
+	_, err := memory.Transaction(ctx, db, func(tx *memory.Client) (*ImportResult, error) {
+		imp.tx = tx
+		steps := []func(context.Context) error{
+			imp.importModelProviders,
+			imp.importModels,
+			imp.importAgents,
+			imp.importTasks,
+			imp.importMessages,
+		}
+		for _, step := range steps {
+			if err := step(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		if options.DryRun {
+			return nil, errDryRun
+		}
+		return imp.result, nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	return imp.result, nil
+}
+
+type action int
+
+const (
+	actionCreate action = iota
+	actionSkip
+	actionOverwrite
+	actionRemap
+)
+
+type importer struct {
+	tx         *memory.Client
+	archive    *Archive
+	encryption *secret.Encryption
+	sealer     *sealer
+	strategy   ConflictStrategy
+	result     *ImportResult
+
+	// IDs of the records in the archive mapped to the IDs of the resources in the database
+	providers map[uuid.UUID]uuid.UUID
+	models    map[uuid.UUID]uuid.UUID
+	agents    map[uuid.UUID]uuid.UUID
+	tasks     map[uuid.UUID]uuid.UUID
+}
+
+// resolve decides what happens to a record. existing is the ID of the resource the record
+// conflicts with, or uuid.Nil. Only history can be remapped.
+func (i *importer) resolve(existing uuid.UUID, remappable bool) action {
+	if existing == uuid.Nil {
+		return actionCreate
+	}
+
+	switch i.strategy {
+	case ConflictOverwrite:
+		return actionOverwrite
+	case ConflictRemap:
+		if remappable {
+			return actionRemap
+		}
+	}
+	return actionSkip
+}
+
+func (i *importer) count(resource Resource, a action) {
+	result := i.result.Resources[resource]
+	switch a {
+	case actionCreate:
+		result.Created++
+	case actionSkip:
+		result.Skipped++
+	case actionOverwrite:
+		result.Updated++
+	case actionRemap:
+		result.Remapped++
+	}
+}
+
+func (i *importer) warn(format string, args ...any) {
+	i.result.Warnings = append(i.result.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (i *importer) importModelProviders(ctx context.Context) error {
+	for _, record := range i.archive.ModelProviders {
+		existing, err := i.tx.ModelProvider.Query().
+			Where(modelprovider.Or(
+				modelprovider.ID(record.ID),
+				modelprovider.And(modelprovider.Name(record.Name), modelprovider.ProviderTypeEQ(record.ProviderType)),
+			)).
+			Order(modelprovider.ByCreateTime()).
+			First(ctx)
+		if err != nil && !memory.IsNotFound(err) {
+			return fmt.Errorf("failed to look up model provider %s: %w", record.Name, err)
+		}
+
+		existingID := uuid.Nil
+		if existing != nil {
+			existingID = existing.ID
+		}
+
+		a := i.resolve(existingID, false)
+		i.count(ResourceModelProviders, a)
+
+		switch a {
+		case actionSkip:
+			i.providers[record.ID] = existingID
+			continue
+		case actionOverwrite:
+			i.providers[record.ID] = existingID
+			update := i.tx.ModelProvider.UpdateOneID(existingID).
+				SetName(record.Name).
+				SetProviderType(record.ProviderType).
+				SetURL(record.URL).
+				SetEnabled(record.Enabled)
+
+			credentials, err := i.openSecret(record)
+			if err != nil {
+				return err
+			}
+			if credentials != nil {
+				encrypted, err := i.encryption.Encrypt(credentials, secret.ModelProviderAssociated(existingID))
+				if err != nil {
+					return fmt.Errorf("failed to encrypt credentials of model provider %s: %w", record.Name, err)
+				}
+				update = update.SetSecret(encrypted)
+			}
+
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update model provider %s: %w", record.Name, err)
+			}
+		case actionCreate:
+			i.providers[record.ID] = record.ID
+			credentials, err := i.openSecret(record)
+			if err != nil {
+				return err
+			}
+
+			enabled := record.Enabled
+			if credentials == nil {
+				credentials, _ = json.Marshal(map[string]string{"apiKey": ""})
+				enabled = false
+				i.warn("model provider %s was imported without credentials and is disabled", record.Name)
+			}
+
+			encrypted, err := i.encryption.Encrypt(credentials, secret.ModelProviderAssociated(record.ID))
+			if err != nil {
+				return fmt.Errorf("failed to encrypt credentials of model provider %s: %w", record.Name, err)
+			}
+
+			err = i.tx.ModelProvider.Create().
+				SetID(record.ID).
+				SetCreateTime(record.CreateTime).
+				SetUpdateTime(record.UpdateTime).
+				SetName(record.Name).
+				SetProviderType(record.ProviderType).
+				SetURL(record.URL).
+				SetEnabled(enabled).
+				SetSecret(encrypted).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create model provider %s: %w", record.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// openSecret returns the credentials of a model provider, or nil if the archive does not
+// contain them.
+func (i *importer) openSecret(record ModelProviderRecord) ([]byte, error) {
+	if i.sealer == nil || len(record.Secret) == 0 {
+		return nil, nil
+	}
+
+	credentials, err := i.sealer.open(record.Secret, record.ID[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to open credentials of model provider %s: %w", record.Name, err)
+	}
+	return credentials, nil
+}
+
+func (i *importer) importModels(ctx context.Context) error {
+	for _, record := range i.archive.Models {
+		providerID, ok := i.providers[record.ModelProviderID]
+		if !ok {
+			i.warn("model %s was skipped, its model provider is not part of the archive", record.Name)
+			i.count(ResourceModels, actionSkip)
+			continue
+		}
+
+		existing, err := i.tx.Model.Query().
+			Where(model.Or(
+				model.ID(record.ID),
+				model.And(model.Name(record.Name), model.ModelProviderID(providerID)),
+			)).
+			Order(model.ByCreateTime()).
+			First(ctx)
+		if err != nil && !memory.IsNotFound(err) {
+			return fmt.Errorf("failed to look up model %s: %w", record.Name, err)
+		}
+
+		existingID := uuid.Nil
+		if existing != nil {
+			existingID = existing.ID
+		}
+
+		a := i.resolve(existingID, false)
+		i.count(ResourceModels, a)
+
+		switch a {
+		case actionSkip:
+			i.models[record.ID] = existingID
+		case actionOverwrite:
+			i.models[record.ID] = existingID
+			err := i.tx.Model.UpdateOneID(existingID).
+				SetName(record.Name).
+				SetContextWindow(record.ContextWindow).
+				SetCapabilities(record.Capabilities).
+				SetInputCost(record.InputCost).
+				SetOutputCost(record.OutputCost).
+				SetCacheWriteCost(record.CacheWriteCost).
+				SetCacheReadCost(record.CacheReadCost).
+				SetEnabled(record.Enabled).
+				SetAlias(record.Alias).
+				SetModelProviderID(providerID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update model %s: %w", record.Name, err)
+			}
+		case actionCreate:
+			i.models[record.ID] = record.ID
+			err := i.tx.Model.Create().
+				SetID(record.ID).
+				SetCreateTime(record.CreateTime).
+				SetUpdateTime(record.UpdateTime).
+				SetName(record.Name).
+				SetContextWindow(record.ContextWindow).
+				SetCapabilities(record.Capabilities).
+				SetInputCost(record.InputCost).
+				SetOutputCost(record.OutputCost).
+				SetCacheWriteCost(record.CacheWriteCost).
+				SetCacheReadCost(record.CacheReadCost).
+				SetEnabled(record.Enabled).
+				SetAlias(record.Alias).
+				SetModelProviderID(providerID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create model %s: %w", record.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (i *importer) importAgents(ctx context.Context) error {
+	for _, record := range i.archive.Agents {
+		existing, err := i.tx.Agent.Query().
+			Where(agent.Or(agent.ID(record.ID), agent.Name(record.Name))).
+			Order(agent.ByCreateTime()).
+			First(ctx)
+		if err != nil && !memory.IsNotFound(err) {
+			return fmt.Errorf("failed to look up agent %s: %w", record.Name, err)
+		}
+
+		existingID := uuid.Nil
+		if existing != nil {
+			existingID = existing.ID
+		}
+
+		modelID := i.reference(i.models, record.ModelID)
+		if record.ModelID != nil && modelID == nil {
+			i.warn("agent %s was imported without a model, its model is not part of the archive", record.Name)
+		}
+
+		a := i.resolve(existingID, false)
+		i.count(ResourceAgents, a)
+
+		switch a {
+		case actionSkip:
+			i.agents[record.ID] = existingID
+		case actionOverwrite:
+			i.agents[record.ID] = existingID
+			err := i.tx.Agent.UpdateOneID(existingID).
+				SetName(record.Name).
+				SetDescription(record.Description).
+				SetInstructions(record.Instructions).
+				SetNillableModelID(modelID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update agent %s: %w", record.Name, err)
+			}
+		case actionCreate:
+			i.agents[record.ID] = record.ID
+			err := i.tx.Agent.Create().
+				SetID(record.ID).
+				SetCreateTime(record.CreateTime).
+				SetUpdateTime(record.UpdateTime).
+				SetName(record.Name).
+				SetDescription(record.Description).
+				SetInstructions(record.Instructions).
+				SetBuiltin(record.Builtin).
+				SetNillableModelID(modelID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create agent %s: %w", record.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (i *importer) importTasks(ctx context.Context) error {
+	for _, record := range i.archive.Tasks {
+		exists, err := i.tx.Task.Get(ctx, record.ID)
+		if err != nil && !memory.IsNotFound(err) {
+			return fmt.Errorf("failed to look up task %s: %w", record.ID, err)
+		}
+
+		existingID := uuid.Nil
+		if exists != nil {
+			existingID = exists.ID
+		}
+
+		agentID := i.reference(i.agents, record.AgentID)
+
+		// the archive may have been taken while the task was running
+		phase := record.Phase
+		if phase == types.TaskPhaseRunning {
+			phase = types.TaskPhaseAwaiting
+		}
+
+		a := i.resolve(existingID, true)
+		i.count(ResourceTasks, a)
+
+		id := record.ID
+		switch a {
+		case actionSkip:
+			i.tasks[record.ID] = existingID
+			continue
+		case actionOverwrite:
+			i.tasks[record.ID] = existingID
+			update := i.tx.Task.UpdateOneID(existingID).
+				SetDescription(record.Description).
+				SetProjectDirectory(record.ProjectDirectory).
+				SetInputTokens(record.InputTokens).
+				SetOutputTokens(record.OutputTokens).
+				SetCacheWriteTokens(record.CacheWriteTokens).
+				SetCacheReadTokens(record.CacheReadTokens).
+				SetCost(record.Cost).
+				SetTurns(record.Turns).
+				SetToolUses(toolUses(record.ToolUses)).
+				SetDesiredPhase(record.DesiredPhase).
+				SetPhase(phase)
+			if agentID != nil {
+				update = update.SetAgentID(*agentID)
+			} else {
+				update = update.ClearAgentID()
+			}
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update task %s: %w", record.ID, err)
+			}
+			continue
+		case actionRemap:
+			id = uuid.New()
+		}
+
+		i.tasks[record.ID] = id
+		err = i.tx.Task.Create().
+			SetID(id).
+			SetCreateTime(record.CreateTime).
+			SetUpdateTime(record.UpdateTime).
+			SetDescription(record.Description).
+			SetProjectDirectory(record.ProjectDirectory).
+			SetInputTokens(record.InputTokens).
+			SetOutputTokens(record.OutputTokens).
+			SetCacheWriteTokens(record.CacheWriteTokens).
+			SetCacheReadTokens(record.CacheReadTokens).
+			SetCost(record.Cost).
+			SetTurns(record.Turns).
+			SetToolUses(toolUses(record.ToolUses)).
+			SetDesiredPhase(record.DesiredPhase).
+			SetPhase(phase).
+			SetNillableAgentID(agentID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create task %s: %w", record.ID, err)
+		}
+	}
+	return nil
+}
+
+func (i *importer) importMessages(ctx context.Context) error {
+	for _, record := range i.archive.Messages {
+		taskID, ok := i.tasks[record.TaskID]
+		if !ok {
+			i.warn("message %s was skipped, its task is not part of the archive", record.ID)
+			i.count(ResourceMessages, actionSkip)
+			continue
+		}
+
+		exists, err := i.tx.Message.Get(ctx, record.ID)
+		if err != nil && !memory.IsNotFound(err) {
+			return fmt.Errorf("failed to look up message %s: %w", record.ID, err)
+		}
+
+		existingID := uuid.Nil
+		if exists != nil {
+			existingID = exists.ID
+		}
+
+		agentID := i.reference(i.agents, record.AgentID)
+		modelID := i.reference(i.models, record.ModelID)
+		if modelID == nil {
+			// messages of an agent must name the model that generated them
+			agentID = nil
+		}
+
+		a := i.resolve(existingID, true)
+		i.count(ResourceMessages, a)
+
+		id := record.ID
+		switch a {
+		case actionSkip:
+			continue
+		case actionOverwrite:
+			update := i.tx.Message.UpdateOneID(existingID).
+				SetTaskID(taskID).
+				SetSource(record.Source).
+				SetContent(record.Content)
+			if record.Usage != nil {
+				update = update.SetUsage(record.Usage)
+			} else {
+				update = update.ClearUsage()
+			}
+			if record.ProcessedTime != nil {
+				update = update.SetProcessedTime(*record.ProcessedTime)
+			} else {
+				update = update.ClearProcessedTime()
+			}
+			if modelID != nil {
+				update = update.SetModelID(*modelID)
+			} else {
+				update = update.ClearModelID()
+			}
+			if agentID != nil {
+				update = update.SetAgentID(*agentID)
+			} else {
+				update = update.ClearAgentID()
+			}
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update message %s: %w", record.ID, err)
+			}
+			continue
+		case actionRemap:
+			id = uuid.New()
+		}
+
+		create := i.tx.Message.Create().
+			SetID(id).
+			SetCreateTime(record.CreateTime).
+			SetUpdateTime(record.UpdateTime).
+			SetTaskID(taskID).
+			SetSource(record.Source).
+			SetContent(record.Content).
+			SetNillableProcessedTime(record.ProcessedTime).
+			SetNillableAgentID(agentID).
+			SetNillableModelID(modelID)
+		if record.Usage != nil {
+			create = create.SetUsage(record.Usage)
+		}
+		if err := create.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create message %s: %w", record.ID, err)
+		}
+	}
+	return nil
+}
+
+// reference maps an optional reference of a record to the ID of the resource in the
+// database. It returns nil if the referenced record is not part of the archive.
+func (i *importer) reference(ids map[uuid.UUID]uuid.UUID, id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+
+	mapped, ok := ids[*id]
+	if !ok {
+		return nil
+	}
+	return &mapped
+}
+
+func toolUses(uses map[string]int64) map[string]int64 {
+	if uses == nil {
+		return map[string]int64{}
+	}
+	return uses
+}
@@ -0,0 +1,124 @@
+package archive
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// ErrWrongPassphrase is returned if the passphrase does not match the one the archive was
+// exported with.
+var ErrWrongPassphrase = errors.New("wrong passphrase")
+
+// ErrPassphraseRequired is returned if an archive with encrypted secrets is imported
+// without a passphrase.
+var ErrPassphraseRequired = errors.New("the archive contains encrypted secrets, a passphrase is required")
+
+// Encryption describes how the secrets of an archive are sealed. The key is derived from
+// the passphrase with Argon2id and the secrets are sealed with AES-256-GCM.
+type Encryption struct {
+	KDF     string `json:"kdf"`
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+	// Check is a known value sealed with the key, so that a wrong passphrase is detected
+	// before anything is imported.
+	Check []byte `json:"check"`
+}
+
+const (
+	kdfArgon2id = "argon2id"
+	checkValue  = "construct"
+)
+
+// sealer seals and opens the secrets of an archive with a key derived from a passphrase.
+type sealer struct {
+	aead cipher.AEAD
+}
+
+// newEncryption derives a key from the passphrase with a random salt.
+func newEncryption(passphrase string) (*Encryption, *sealer, error) {
+	if passphrase == "" {
+		return nil, nil, fmt.Errorf("a passphrase is required to export secrets")
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, nil, err
+	}
+
+	encryption := &Encryption{
+		KDF:     kdfArgon2id,
+		Salt:    salt,
+		Time:    1,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+
+	s, err := encryption.sealer(passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encryption.Check, err = s.seal([]byte(checkValue), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encryption, s, nil
+}
+
+// open derives the key of an archive from the passphrase and verifies it.
+func (e *Encryption) open(passphrase string) (*sealer, error) {
+	if passphrase == "" {
+		return nil, ErrPassphraseRequired
+	}
+	if e.KDF != kdfArgon2id {
+		return nil, fmt.Errorf("%w: unknown key derivation %q", ErrUnsupportedArchive, e.KDF)
+	}
+
+	s, err := e.sealer(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := s.open(e.Check, nil)
+	if err != nil || string(check) != checkValue {
+		return nil, ErrWrongPassphrase
+	}
+	return s, nil
+}
+
+func (e *Encryption) sealer(passphrase string) (*sealer, error) {
+	key := argon2.IDKey([]byte(passphrase), e.Salt, e.Time, e.Memory, e.Threads, 32)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &sealer{aead: aead}, nil
+}
+
+func (s *sealer) seal(plaintext, associatedData []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+func (s *sealer) open(ciphertext, associatedData []byte) ([]byte, error) {
+	if len(ciphertext) < s.aead.NonceSize() {
+		return nil, fmt.Errorf("sealed secret is too short")
+	}
+	nonce, sealed := ciphertext[:s.aead.NonceSize()], ciphertext[s.aead.NonceSize():]
+	return s.aead.Open(nil, nonce, sealed, associatedData)
+}
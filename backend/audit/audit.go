@@ -0,0 +1,215 @@
+// Package audit maintains a tamper-evident log of the side-effecting tool calls of agents
+// and the API calls that change state. Events are chained by hash: the hash of an event
+// covers its content and the hash of its predecessor, so that changing, removing or
+// reordering events is detected by Verify.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// appendAttempts bounds the retries of an append that lost the race for the next sequence
+// number against another replica.
+const appendAttempts = 5
+
+// Record describes an action before it is appended to the log.
+type Record struct {
+	Kind      types.AuditEventKind
+	Actor     string
+	Action    string
+	Resource  string
+	TaskID    *uuid.UUID
+	Workspace string
+	Command   string
+	Path      string
+	DiffHash  string
+	ExitCode  *int
+	Duration  time.Duration
+	Error     string
+}
+
+// Log appends events to the audit log in the database.
+type Log struct {
+	db  *memory.Client
+	mu  sync.Mutex
+	now func() time.Time
+}
+
+func NewLog(db *memory.Client) *Log {
+	return &Log{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// Append adds a record as the next event of the log.
+func (l *Log) Append(ctx context.Context, record Record) (*memory.AuditEvent, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var err error
+	for range appendAttempts {
+		var event *memory.AuditEvent
+		event, err = memory.Transaction(ctx, l.db, func(tx *memory.Client) (*memory.AuditEvent, error) {
+			return l.append(ctx, tx, record)
+		})
+		if err == nil {
+			return event, nil
+		}
+		if !memory.IsConstraintError(err) {
+			break
+		}
+	}
+	return nil, fmt.Errorf("failed to append audit event: %w", err)
+}
+
+func (l *Log) append(ctx context.Context, tx *memory.Client, record Record) (*memory.AuditEvent, error) {
+	last, err := tx.AuditEvent.Query().Order(memory.Desc(auditevent.FieldSequence)).First(ctx)
+	if err != nil && !memory.IsNotFound(err) {
+		return nil, err
+	}
+
+	event := &memory.AuditEvent{
+		Sequence:   1,
+		Time:       l.now().UTC().Truncate(time.Microsecond),
+		Kind:       record.Kind,
+		Actor:      record.Actor,
+		Action:     record.Action,
+		Resource:   record.Resource,
+		Workspace:  record.Workspace,
+		Command:    record.Command,
+		Path:       record.Path,
+		DiffHash:   record.DiffHash,
+		ExitCode:   record.ExitCode,
+		DurationMs: record.Duration.Milliseconds(),
+		Error:      record.Error,
+	}
+	if record.TaskID != nil {
+		event.TaskID = *record.TaskID
+	}
+	if last != nil {
+		event.Sequence = last.Sequence + 1
+		event.PreviousHash = last.Hash
+	}
+	event.Hash = Hash(event)
+
+	return tx.AuditEvent.Create().
+		SetSequence(event.Sequence).
+		SetTime(event.Time).
+		SetKind(event.Kind).
+		SetActor(event.Actor).
+		SetAction(event.Action).
+		SetResource(event.Resource).
+		SetNillableTaskID(record.TaskID).
+		SetWorkspace(event.Workspace).
+		SetCommand(event.Command).
+		SetPath(event.Path).
+		SetDiffHash(event.DiffHash).
+		SetNillableExitCode(event.ExitCode).
+		SetDurationMs(event.DurationMs).
+		SetError(event.Error).
+		SetPreviousHash(event.PreviousHash).
+		SetHash(event.Hash).
+		Save(ctx)
+}
+
+// Hash computes the hash of an event from its content and the hash of its predecessor.
+// Every field is length-prefixed so that no two different events encode the same way.
+func Hash(event *memory.AuditEvent) []byte {
+	h := sha256.New()
+	write := func(s string) {
+		binary.Write(h, binary.BigEndian, uint64(len(s)))
+		h.Write([]byte(s))
+	}
+
+	taskID := ""
+	if event.TaskID != uuid.Nil {
+		taskID = event.TaskID.String()
+	}
+	exitCode := ""
+	if event.ExitCode != nil {
+		exitCode = strconv.Itoa(*event.ExitCode)
+	}
+
+	write(string(event.PreviousHash))
+	write(strconv.FormatInt(event.Sequence, 10))
+	write(event.Time.UTC().Format(time.RFC3339Nano))
+	write(string(event.Kind))
+	write(event.Actor)
+	write(event.Action)
+	write(event.Resource)
+	write(taskID)
+	write(event.Workspace)
+	write(event.Command)
+	write(event.Path)
+	write(event.DiffHash)
+	write(exitCode)
+	write(strconv.FormatInt(event.DurationMs, 10))
+	write(event.Error)
+
+	return h.Sum(nil)
+}
+
+// ErrTampered is returned by Verify if the log was changed after events were appended.
+var ErrTampered = errors.New("audit log has been tampered with")
+
+// Verify walks the log from the first event and checks that sequence numbers have no gaps
+// and that every event carries the hash of its content and its predecessor. It returns
+// the number of verified events.
+func Verify(ctx context.Context, db *memory.Client) (int, error) {
+	const pageSize = 500
+
+	var (
+		previous []byte
+		sequence int64
+		verified int
+	)
+	for {
+		events, err := db.AuditEvent.Query().
+			Where(auditevent.SequenceGT(sequence)).
+			Order(memory.Asc(auditevent.FieldSequence)).
+			Limit(pageSize).
+			All(ctx)
+		if err != nil {
+			return verified, fmt.Errorf("failed to query audit events: %w", err)
+		}
+
+		for _, event := range events {
+			switch {
+			case event.Sequence != sequence+1:
+				return verified, fmt.Errorf("%w: event %d is missing", ErrTampered, sequence+1)
+			case !bytes.Equal(event.PreviousHash, previous):
+				return verified, fmt.Errorf("%w: event %d does not follow event %d", ErrTampered, event.Sequence, sequence)
+			case !bytes.Equal(event.Hash, Hash(event)):
+				return verified, fmt.Errorf("%w: event %d has been modified", ErrTampered, event.Sequence)
+			}
+			previous = event.Hash
+			sequence = event.Sequence
+			verified++
+		}
+
+		if len(events) < pageSize {
+			return verified, nil
+		}
+	}
+}
+
+// DiffHash returns the hex encoded SHA-256 hash of a diff or of the content of a file.
+func DiffHash(diff string) string {
+	sum := sha256.Sum256([]byte(diff))
+	return hex.EncodeToString(sum[:])
+}
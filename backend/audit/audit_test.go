@@ -0,0 +1,122 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/uuid"
+)
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name     string
+		tamper   string
+		verified int
+		err      string
+	}{
+		{
+			name:     "intact",
+			verified: 3,
+		},
+		{
+			name:     "modified event",
+			tamper:   "UPDATE audit_events SET command = 'true' WHERE sequence = 2",
+			verified: 1,
+			err:      "audit log has been tampered with: event 2 has been modified",
+		},
+		{
+			name:     "removed event",
+			tamper:   "DELETE FROM audit_events WHERE sequence = 2",
+			verified: 1,
+			err:      "audit log has been tampered with: event 2 is missing",
+		},
+		{
+			name:     "rehashed event",
+			tamper:   "UPDATE audit_events SET hash = previous_hash WHERE sequence = 3",
+			verified: 2,
+			err:      "audit log has been tampered with: event 3 has been modified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := test.NewDatabase(t)
+			log := NewLog(db)
+
+			for _, command := range []string{"go build ./...", "rm -rf /tmp/cache", "go test ./..."} {
+				_, err := log.Append(ctx, Record{
+					Kind:     types.AuditEventKindToolCall,
+					Actor:    "alice",
+					Action:   "execute_command",
+					Command:  command,
+					Duration: time.Second,
+				})
+				if err != nil {
+					t.Fatalf("failed to append record: %v", err)
+				}
+			}
+
+			if tt.tamper != "" {
+				if _, err := db.DB().ExecContext(ctx, tt.tamper); err == nil || !strings.Contains(err.Error(), "append-only") {
+					t.Fatalf("expected the triggers of the table to reject the change, got %v", err)
+				}
+
+				// whoever can write to the database can also drop the triggers
+				for _, trigger := range []string{"audit_events_no_update", "audit_events_no_delete"} {
+					if _, err := db.DB().ExecContext(ctx, "DROP TRIGGER "+trigger); err != nil {
+						t.Fatalf("failed to drop trigger %s: %v", trigger, err)
+					}
+				}
+				if _, err := db.DB().ExecContext(ctx, tt.tamper); err != nil {
+					t.Fatalf("failed to tamper with the log: %v", err)
+				}
+			}
+
+			verified, err := Verify(ctx, db)
+			if verified != tt.verified {
+				t.Errorf("expected %d verified events, got %d", tt.verified, verified)
+			}
+			if tt.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.err != "" && (!errors.Is(err, ErrTampered) || err.Error() != tt.err) {
+				t.Errorf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestAppend(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+	log := NewLog(db)
+
+	taskID := uuid.New()
+	first, err := log.Append(ctx, Record{Kind: types.AuditEventKindAPICall, Action: "/construct.v1.TaskService/CreateTask", TaskID: &taskID})
+	if err != nil {
+		t.Fatalf("failed to append record: %v", err)
+	}
+	second, err := log.Append(ctx, Record{Kind: types.AuditEventKindAPICall, Action: "/construct.v1.TaskService/DeleteTask", TaskID: &taskID})
+	if err != nil {
+		t.Fatalf("failed to append record: %v", err)
+	}
+
+	if first.Sequence != 1 || second.Sequence != 2 {
+		t.Errorf("expected sequences 1 and 2, got %d and %d", first.Sequence, second.Sequence)
+	}
+	if first.PreviousHash != nil {
+		t.Errorf("expected first event to have no predecessor")
+	}
+	if string(second.PreviousHash) != string(first.Hash) {
+		t.Errorf("expected second event to chain to the first")
+	}
+	if second.TaskID != taskID {
+		t.Errorf("expected task %s, got %s", taskID, second.TaskID)
+	}
+}
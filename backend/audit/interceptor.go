@@ -0,0 +1,149 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// auditedReads lists procedures without side effects that are audited nonetheless,
+// because they hand out the data of all users.
+var auditedReads = map[string]bool{
+	v1connect.ArchiveServiceExportArchiveProcedure: true,
+}
+
+// Interceptor appends every API call that changes state to the audit log. It has to run
+// after the authentication interceptor to attribute calls to their user.
+type Interceptor struct {
+	log    *Log
+	actor  func(context.Context) string
+	logger *slog.Logger
+}
+
+var _ connect.Interceptor = (*Interceptor)(nil)
+
+// NewInterceptor creates an interceptor that attributes calls to the user returned by actor.
+func NewInterceptor(log *Log, actor func(context.Context) string) *Interceptor {
+	return &Interceptor{
+		log:    log,
+		actor:  actor,
+		logger: slog.With("component", "audit"),
+	}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !audited(req.Spec()) {
+			return next(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+
+		record := i.record(ctx, req.Spec(), time.Since(start), err)
+		if msg, ok := req.Any().(proto.Message); ok {
+			record.Resource = stringField(msg.ProtoReflect(), "id")
+			if taskID := stringField(msg.ProtoReflect(), "task_id"); taskID != "" {
+				record.TaskID = parseUUID(taskID)
+			}
+		}
+		if record.Resource == "" && resp != nil {
+			if msg, ok := resp.Any().(proto.Message); ok {
+				record.Resource = createdID(msg.ProtoReflect())
+			}
+		}
+		if record.TaskID == nil && strings.HasPrefix(req.Spec().Procedure, "/"+v1connect.TaskServiceName+"/") {
+			record.TaskID = parseUUID(record.Resource)
+		}
+
+		i.append(ctx, record)
+		return resp, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !audited(conn.Spec()) {
+			return next(ctx, conn)
+		}
+
+		start := time.Now()
+		err := next(ctx, conn)
+		i.append(ctx, i.record(ctx, conn.Spec(), time.Since(start), err))
+		return err
+	}
+}
+
+func (i *Interceptor) record(ctx context.Context, spec connect.Spec, duration time.Duration, err error) Record {
+	record := Record{
+		Kind:     types.AuditEventKindAPICall,
+		Actor:    i.actor(ctx),
+		Action:   spec.Procedure,
+		Duration: duration,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	return record
+}
+
+func (i *Interceptor) append(ctx context.Context, record Record) {
+	// The call has already been made, so the event is appended even if the client is gone.
+	if _, err := i.log.Append(context.WithoutCancel(ctx), record); err != nil {
+		i.logger.Error("failed to audit API call", "procedure", record.Action, "error", err)
+	}
+}
+
+func audited(spec connect.Spec) bool {
+	return spec.IdempotencyLevel != connect.IdempotencyNoSideEffects || auditedReads[spec.Procedure]
+}
+
+func stringField(msg protoreflect.Message, name protoreflect.Name) string {
+	field := msg.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return ""
+	}
+	return msg.Get(field).String()
+}
+
+// createdID returns the ID of the resource in the response of a create call, which holds
+// the resource with its metadata in its only field.
+func createdID(msg protoreflect.Message) string {
+	fields := msg.Descriptor().Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() || !msg.Has(field) {
+			continue
+		}
+
+		resource := msg.Get(field).Message()
+		metadata := resource.Descriptor().Fields().ByName("metadata")
+		if metadata == nil || metadata.Kind() != protoreflect.MessageKind || !resource.Has(metadata) {
+			continue
+		}
+		if id := stringField(resource.Get(metadata).Message(), "id"); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func parseUUID(s string) *uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
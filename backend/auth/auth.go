@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	memory_token "github.com/furisto/construct/backend/memory/token"
+)
+
+// TokenPrefix starts every API token, which makes leaked tokens easy to spot in logs
+// and secret scanners.
+const TokenPrefix = "construct_"
+
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+// GenerateToken returns a new random API token. The token is only shown once; the
+// daemon keeps its hash.
+func GenerateToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Hash returns the value under which a token is stored. Tokens have enough entropy that
+// a plain SHA-256 is sufficient, and unlike a password hash it can be looked up directly.
+func Hash(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// Authenticate looks up the token and verifies that it has not expired.
+func Authenticate(ctx context.Context, db *memory.Client, token string, now time.Time) (*memory.Token, error) {
+	if !strings.HasPrefix(token, TokenPrefix) {
+		return nil, ErrInvalidToken
+	}
+
+	t, err := db.Token.Query().Where(memory_token.HashEQ(Hash(token))).Only(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			return nil, ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to look up token: %w", err)
+	}
+
+	if t.ExpireTime != nil && !now.Before(*t.ExpireTime) {
+		return nil, ErrInvalidToken
+	}
+
+	return t, nil
+}
+
+type tokenKey struct{}
+
+// WithToken returns a context that carries the token the request was authenticated with.
+func WithToken(ctx context.Context, token *memory.Token) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// FromContext returns the token the request was authenticated with. Requests over the
+// Unix socket are not authenticated and carry no token.
+func FromContext(ctx context.Context) (*memory.Token, bool) {
+	token, ok := ctx.Value(tokenKey{}).(*memory.Token)
+	return token, ok
+}
+
+// Identity is the user a request is made on behalf of.
+type Identity struct {
+	User string
+	Team string
+}
+
+// CanChange reports whether identity may change a resource with the given owner. Only the
+// owner can change a resource, resources without an owner can only be changed over the
+// Unix socket.
+func CanChange(identity Identity, owner string) bool {
+	return owner != "" && owner == identity.User
+}
+
+// IdentityFromContext returns the identity of the token the request was authenticated
+// with. Requests over the Unix socket have no identity and may access every resource.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	token, ok := FromContext(ctx)
+	if !ok {
+		return Identity{}, false
+	}
+
+	user := token.User
+	if user == "" {
+		user = token.Name
+	}
+	return Identity{User: user, Team: token.Team}, true
+}
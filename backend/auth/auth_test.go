@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+)
+
+func TestInterceptor(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+
+	readToken := createToken(t, db, "ci", types.TokenScopeRead, nil)
+	fullToken := createToken(t, db, "laptop", types.TokenScopeFull, nil)
+	expiredToken := createToken(t, db, "old", types.TokenScopeFull, conv.Ptr(time.Now().Add(-time.Hour)))
+
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewAgentServiceHandler(
+		v1connect.UnimplementedAgentServiceHandler{},
+		connect.WithInterceptors(NewInterceptor(db)),
+	))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		token    string
+		call     func(client v1connect.AgentServiceClient, ctx context.Context) error
+		expected connect.Code
+	}{
+		{
+			name:     "missing token",
+			call:     listAgents,
+			expected: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "unknown token",
+			token:    TokenPrefix + "unknown",
+			call:     listAgents,
+			expected: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "expired token",
+			token:    expiredToken,
+			call:     listAgents,
+			expected: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "read token reads",
+			token:    readToken,
+			call:     listAgents,
+			expected: connect.CodeUnimplemented,
+		},
+		{
+			name:     "read token writes",
+			token:    readToken,
+			call:     deleteAgent,
+			expected: connect.CodePermissionDenied,
+		},
+		{
+			name:     "full token writes",
+			token:    fullToken,
+			call:     deleteAgent,
+			expected: connect.CodeUnimplemented,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var options []connect.ClientOption
+			if tt.token != "" {
+				options = append(options, connect.WithInterceptors(bearer(tt.token)))
+			}
+			client := v1connect.NewAgentServiceClient(server.Client(), server.URL, options...)
+
+			err := tt.call(client, ctx)
+			if code := connect.CodeOf(err); code != tt.expected {
+				t.Errorf("expected code %s, got %s (%v)", tt.expected, code, err)
+			}
+		})
+	}
+
+	tokens, err := db.Token.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query tokens: %v", err)
+	}
+	for _, token := range tokens {
+		used := token.LastUsedTime != nil
+		if expected := token.Name != "old"; used != expected {
+			t.Errorf("expected last use of token %s to be recorded: %t, got %t", token.Name, expected, used)
+		}
+	}
+}
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		scope       types.TokenScope
+		procedure   string
+		idempotency connect.IdempotencyLevel
+		expected    bool
+	}{
+		{types.TokenScopeRead, v1connect.AgentServiceListAgentsProcedure, connect.IdempotencyNoSideEffects, true},
+		{types.TokenScopeRead, v1connect.AgentServiceCreateAgentProcedure, connect.IdempotencyUnknown, false},
+		{types.TokenScopeRead, v1connect.TaskServiceSubscribeProcedure, connect.IdempotencyNoSideEffects, true},
+		{types.TokenScopeRead, v1connect.ArchiveServiceExportArchiveProcedure, connect.IdempotencyNoSideEffects, false},
+		{types.TokenScopeFull, v1connect.ArchiveServiceExportArchiveProcedure, connect.IdempotencyNoSideEffects, true},
+		{types.TokenScopeFull, v1connect.AgentServiceCreateAgentProcedure, connect.IdempotencyUnknown, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s", tt.scope, tt.procedure), func(t *testing.T) {
+			spec := connect.Spec{Procedure: tt.procedure, IdempotencyLevel: tt.idempotency}
+			if actual := Allows(tt.scope, spec); actual != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func listAgents(client v1connect.AgentServiceClient, ctx context.Context) error {
+	_, err := client.ListAgents(ctx, connect.NewRequest(&v1.ListAgentsRequest{}))
+	return err
+}
+
+func deleteAgent(client v1connect.AgentServiceClient, ctx context.Context) error {
+	_, err := client.DeleteAgent(ctx, connect.NewRequest(&v1.DeleteAgentRequest{Id: uuid.NewString()}))
+	return err
+}
+
+type bearer string
+
+func (b bearer) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set("Authorization", "Bearer "+string(b))
+		return next(ctx, req)
+	}
+}
+
+func (b bearer) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (b bearer) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func createToken(t *testing.T, db *memory.Client, name string, scope types.TokenScope, expireTime *time.Time) string {
+	t.Helper()
+
+	token, err := GenerateToken()
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	err = db.Token.Create().
+		SetName(name).
+		SetHash(Hash(token)).
+		SetScope(scope).
+		SetNillableExpireTime(expireTime).
+		Exec(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+	return token
+}
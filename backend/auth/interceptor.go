@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+// lastUsedInterval limits how often the last use of a token is written, so that busy
+// clients do not cause a write on every request.
+const lastUsedInterval = time.Minute
+
+// fullProcedures lists procedures without side effects that still require a full token.
+// An archive can contain the decrypted credentials of model providers.
+var fullProcedures = map[string]bool{
+	v1connect.ArchiveServiceExportArchiveProcedure: true,
+}
+
+// Interceptor authenticates requests with the bearer token in the Authorization header
+// and rejects calls the scope of the token does not allow.
+type Interceptor struct {
+	db     *memory.Client
+	now    func() time.Time
+	logger *slog.Logger
+}
+
+var _ connect.Interceptor = (*Interceptor)(nil)
+
+func NewInterceptor(db *memory.Client) *Interceptor {
+	return &Interceptor{
+		db:     db,
+		now:    time.Now,
+		logger: slog.With("component", "auth"),
+	}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authorize(ctx, req.Spec(), req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authorize(ctx, conn.Spec(), conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *Interceptor) authorize(ctx context.Context, spec connect.Spec, header http.Header) (context.Context, error) {
+	raw, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || raw == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
+	}
+
+	now := i.now()
+	token, err := Authenticate(ctx, i.db, raw, now)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if !Allows(token.Scope, spec) {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("token %s with scope %s is not allowed to call %s", token.Name, token.Scope, spec.Procedure))
+	}
+
+	if token.LastUsedTime == nil || now.Sub(*token.LastUsedTime) >= lastUsedInterval {
+		err := i.db.Token.UpdateOne(token).SetLastUsedTime(now).Exec(ctx)
+		if err != nil {
+			i.logger.Warn("failed to record token use", "token", token.Name, "error", err)
+		}
+	}
+
+	return WithToken(ctx, token), nil
+}
+
+// Allows reports whether a token with the given scope may call the procedure. Read tokens
+// are limited to procedures without side effects.
+func Allows(scope types.TokenScope, spec connect.Spec) bool {
+	switch scope {
+	case types.TokenScopeFull:
+		return true
+	case types.TokenScopeRead:
+		if fullProcedures[spec.Procedure] {
+			return false
+		}
+		return spec.IdempotencyLevel == connect.IdempotencyNoSideEffects
+	default:
+		return false
+	}
+}
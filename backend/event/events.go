@@ -1,9 +1,15 @@
 package event
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
 
+// TaskEvent asks the reconciler to process a task. Trace is the span that caused the
+// event, the reconciliation becomes part of its trace.
 type TaskEvent struct {
 	TaskID uuid.UUID
+	Trace  trace.SpanContext
 }
 
 func (TaskEvent) Event() {}
@@ -20,3 +26,39 @@ type MessageEvent struct {
 }
 
 func (MessageEvent) Event() {}
+
+// TaskCompletedEvent is published when the agent gave its final response and the task
+// waits for new input.
+type TaskCompletedEvent struct {
+	TaskID uuid.UUID
+}
+
+func (TaskCompletedEvent) Event() {}
+
+// TaskFailedEvent is published when the reconciliation of a task failed with an error that
+// is not retried or after its retries were exhausted.
+type TaskFailedEvent struct {
+	TaskID uuid.UUID
+	Error  string
+}
+
+func (TaskFailedEvent) Event() {}
+
+// TaskInputRequestedEvent is published when the agent asked the user a question.
+type TaskInputRequestedEvent struct {
+	TaskID   uuid.UUID
+	Question string
+}
+
+func (TaskInputRequestedEvent) Event() {}
+
+// TaskUsageEvent is published after the cost of a model invocation was added to a task.
+// PreviousCost is the cost before the invocation, so that subscribers can detect budget
+// crossings without tracking state.
+type TaskUsageEvent struct {
+	TaskID       uuid.UUID
+	PreviousCost float64
+	Cost         float64
+}
+
+func (TaskUsageEvent) Event() {}
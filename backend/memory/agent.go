@@ -3,6 +3,7 @@
 package memory
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -23,6 +24,10 @@ type Agent struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Team holds the value of the "team" field.
+	Team string `json:"team,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
@@ -31,6 +36,8 @@ type Agent struct {
 	Instructions string `json:"instructions,omitempty"`
 	// Builtin holds the value of the "builtin" field.
 	Builtin bool `json:"builtin,omitempty"`
+	// Tools holds the value of the "tools" field.
+	Tools []string `json:"tools,omitempty"`
 	// ModelID holds the value of the "model_id" field.
 	ModelID uuid.UUID `json:"model_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -47,9 +54,15 @@ type AgentEdges struct {
 	Tasks []*Task `json:"tasks,omitempty"`
 	// Messages holds the value of the messages edge.
 	Messages []*Message `json:"messages,omitempty"`
+	// Schedules holds the value of the schedules edge.
+	Schedules []*Schedule `json:"schedules,omitempty"`
+	// WebhookTriggers holds the value of the webhook_triggers edge.
+	WebhookTriggers []*WebhookTrigger `json:"webhook_triggers,omitempty"`
+	// NotificationSinks holds the value of the notification_sinks edge.
+	NotificationSinks []*NotificationSink `json:"notification_sinks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // ModelOrErr returns the Model value or an error if the edge
@@ -81,14 +94,43 @@ func (e AgentEdges) MessagesOrErr() ([]*Message, error) {
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// SchedulesOrErr returns the Schedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentEdges) SchedulesOrErr() ([]*Schedule, error) {
+	if e.loadedTypes[3] {
+		return e.Schedules, nil
+	}
+	return nil, &NotLoadedError{edge: "schedules"}
+}
+
+// WebhookTriggersOrErr returns the WebhookTriggers value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentEdges) WebhookTriggersOrErr() ([]*WebhookTrigger, error) {
+	if e.loadedTypes[4] {
+		return e.WebhookTriggers, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_triggers"}
+}
+
+// NotificationSinksOrErr returns the NotificationSinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentEdges) NotificationSinksOrErr() ([]*NotificationSink, error) {
+	if e.loadedTypes[5] {
+		return e.NotificationSinks, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_sinks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Agent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case agent.FieldTools:
+			values[i] = new([]byte)
 		case agent.FieldBuiltin:
 			values[i] = new(sql.NullBool)
-		case agent.FieldName, agent.FieldDescription, agent.FieldInstructions:
+		case agent.FieldOwner, agent.FieldTeam, agent.FieldName, agent.FieldDescription, agent.FieldInstructions:
 			values[i] = new(sql.NullString)
 		case agent.FieldCreateTime, agent.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -127,6 +169,18 @@ func (a *Agent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.UpdateTime = value.Time
 			}
+		case agent.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				a.Owner = value.String
+			}
+		case agent.FieldTeam:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field team", values[i])
+			} else if value.Valid {
+				a.Team = value.String
+			}
 		case agent.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -151,6 +205,14 @@ func (a *Agent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Builtin = value.Bool
 			}
+		case agent.FieldTools:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tools", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Tools); err != nil {
+					return fmt.Errorf("unmarshal field tools: %w", err)
+				}
+			}
 		case agent.FieldModelID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field model_id", values[i])
@@ -185,6 +247,21 @@ func (a *Agent) QueryMessages() *MessageQuery {
 	return NewAgentClient(a.config).QueryMessages(a)
 }
 
+// QuerySchedules queries the "schedules" edge of the Agent entity.
+func (a *Agent) QuerySchedules() *ScheduleQuery {
+	return NewAgentClient(a.config).QuerySchedules(a)
+}
+
+// QueryWebhookTriggers queries the "webhook_triggers" edge of the Agent entity.
+func (a *Agent) QueryWebhookTriggers() *WebhookTriggerQuery {
+	return NewAgentClient(a.config).QueryWebhookTriggers(a)
+}
+
+// QueryNotificationSinks queries the "notification_sinks" edge of the Agent entity.
+func (a *Agent) QueryNotificationSinks() *NotificationSinkQuery {
+	return NewAgentClient(a.config).QueryNotificationSinks(a)
+}
+
 // Update returns a builder for updating this Agent.
 // Note that you need to call Agent.Unwrap() before calling this method if this Agent
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -214,6 +291,12 @@ func (a *Agent) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(a.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(a.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("team=")
+	builder.WriteString(a.Team)
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(a.Name)
 	builder.WriteString(", ")
@@ -226,6 +309,9 @@ func (a *Agent) String() string {
 	builder.WriteString("builtin=")
 	builder.WriteString(fmt.Sprintf("%v", a.Builtin))
 	builder.WriteString(", ")
+	builder.WriteString("tools=")
+	builder.WriteString(fmt.Sprintf("%v", a.Tools))
+	builder.WriteString(", ")
 	builder.WriteString("model_id=")
 	builder.WriteString(fmt.Sprintf("%v", a.ModelID))
 	builder.WriteByte(')')
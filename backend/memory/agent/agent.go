@@ -19,6 +19,10 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldTeam holds the string denoting the team field in the database.
+	FieldTeam = "team"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -27,6 +31,8 @@ const (
 	FieldInstructions = "instructions"
 	// FieldBuiltin holds the string denoting the builtin field in the database.
 	FieldBuiltin = "builtin"
+	// FieldTools holds the string denoting the tools field in the database.
+	FieldTools = "tools"
 	// FieldModelID holds the string denoting the model_id field in the database.
 	FieldModelID = "model_id"
 	// EdgeModel holds the string denoting the model edge name in mutations.
@@ -35,6 +41,12 @@ const (
 	EdgeTasks = "tasks"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
 	EdgeMessages = "messages"
+	// EdgeSchedules holds the string denoting the schedules edge name in mutations.
+	EdgeSchedules = "schedules"
+	// EdgeWebhookTriggers holds the string denoting the webhook_triggers edge name in mutations.
+	EdgeWebhookTriggers = "webhook_triggers"
+	// EdgeNotificationSinks holds the string denoting the notification_sinks edge name in mutations.
+	EdgeNotificationSinks = "notification_sinks"
 	// Table holds the table name of the agent in the database.
 	Table = "agents"
 	// ModelTable is the table that holds the model relation/edge.
@@ -58,6 +70,27 @@ const (
 	MessagesInverseTable = "messages"
 	// MessagesColumn is the table column denoting the messages relation/edge.
 	MessagesColumn = "agent_id"
+	// SchedulesTable is the table that holds the schedules relation/edge.
+	SchedulesTable = "schedules"
+	// SchedulesInverseTable is the table name for the Schedule entity.
+	// It exists in this package in order to avoid circular dependency with the "schedule" package.
+	SchedulesInverseTable = "schedules"
+	// SchedulesColumn is the table column denoting the schedules relation/edge.
+	SchedulesColumn = "agent_id"
+	// WebhookTriggersTable is the table that holds the webhook_triggers relation/edge.
+	WebhookTriggersTable = "webhook_triggers"
+	// WebhookTriggersInverseTable is the table name for the WebhookTrigger entity.
+	// It exists in this package in order to avoid circular dependency with the "webhooktrigger" package.
+	WebhookTriggersInverseTable = "webhook_triggers"
+	// WebhookTriggersColumn is the table column denoting the webhook_triggers relation/edge.
+	WebhookTriggersColumn = "agent_id"
+	// NotificationSinksTable is the table that holds the notification_sinks relation/edge.
+	NotificationSinksTable = "notification_sinks"
+	// NotificationSinksInverseTable is the table name for the NotificationSink entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationsink" package.
+	NotificationSinksInverseTable = "notification_sinks"
+	// NotificationSinksColumn is the table column denoting the notification_sinks relation/edge.
+	NotificationSinksColumn = "agent_id"
 )
 
 // Columns holds all SQL columns for agent fields.
@@ -65,10 +98,13 @@ var Columns = []string{
 	FieldID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldOwner,
+	FieldTeam,
 	FieldName,
 	FieldDescription,
 	FieldInstructions,
 	FieldBuiltin,
+	FieldTools,
 	FieldModelID,
 }
 
@@ -115,6 +151,16 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByTeam orders the results by the team field.
+func ByTeam(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeam, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -174,6 +220,48 @@ func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySchedulesCount orders the results by schedules count.
+func BySchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSchedulesStep(), opts...)
+	}
+}
+
+// BySchedules orders the results by schedules terms.
+func BySchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhookTriggersCount orders the results by webhook_triggers count.
+func ByWebhookTriggersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhookTriggersStep(), opts...)
+	}
+}
+
+// ByWebhookTriggers orders the results by webhook_triggers terms.
+func ByWebhookTriggers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhookTriggersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationSinksCount orders the results by notification_sinks count.
+func ByNotificationSinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationSinksStep(), opts...)
+	}
+}
+
+// ByNotificationSinks orders the results by notification_sinks terms.
+func ByNotificationSinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationSinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newModelStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -195,3 +283,24 @@ func newMessagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, MessagesTable, MessagesColumn),
 	)
 }
+func newSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SchedulesTable, SchedulesColumn),
+	)
+}
+func newWebhookTriggersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhookTriggersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, WebhookTriggersTable, WebhookTriggersColumn),
+	)
+}
+func newNotificationSinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationSinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, NotificationSinksTable, NotificationSinksColumn),
+	)
+}
@@ -66,6 +66,16 @@ func UpdateTime(v time.Time) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldOwner, v))
+}
+
+// Team applies equality check predicate on the "team" field. It's identical to TeamEQ.
+func Team(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldTeam, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldName, v))
@@ -171,6 +181,156 @@ func UpdateTimeLTE(v time.Time) predicate.Agent {
 	return predicate.Agent(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// TeamEQ applies the EQ predicate on the "team" field.
+func TeamEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldTeam, v))
+}
+
+// TeamNEQ applies the NEQ predicate on the "team" field.
+func TeamNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldTeam, v))
+}
+
+// TeamIn applies the In predicate on the "team" field.
+func TeamIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldTeam, vs...))
+}
+
+// TeamNotIn applies the NotIn predicate on the "team" field.
+func TeamNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldTeam, vs...))
+}
+
+// TeamGT applies the GT predicate on the "team" field.
+func TeamGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldTeam, v))
+}
+
+// TeamGTE applies the GTE predicate on the "team" field.
+func TeamGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldTeam, v))
+}
+
+// TeamLT applies the LT predicate on the "team" field.
+func TeamLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldTeam, v))
+}
+
+// TeamLTE applies the LTE predicate on the "team" field.
+func TeamLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldTeam, v))
+}
+
+// TeamContains applies the Contains predicate on the "team" field.
+func TeamContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldTeam, v))
+}
+
+// TeamHasPrefix applies the HasPrefix predicate on the "team" field.
+func TeamHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldTeam, v))
+}
+
+// TeamHasSuffix applies the HasSuffix predicate on the "team" field.
+func TeamHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldTeam, v))
+}
+
+// TeamIsNil applies the IsNil predicate on the "team" field.
+func TeamIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldTeam))
+}
+
+// TeamNotNil applies the NotNil predicate on the "team" field.
+func TeamNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldTeam))
+}
+
+// TeamEqualFold applies the EqualFold predicate on the "team" field.
+func TeamEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldTeam, v))
+}
+
+// TeamContainsFold applies the ContainsFold predicate on the "team" field.
+func TeamContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldTeam, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldName, v))
@@ -386,6 +546,16 @@ func BuiltinNEQ(v bool) predicate.Agent {
 	return predicate.Agent(sql.FieldNEQ(FieldBuiltin, v))
 }
 
+// ToolsIsNil applies the IsNil predicate on the "tools" field.
+func ToolsIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldTools))
+}
+
+// ToolsNotNil applies the NotNil predicate on the "tools" field.
+func ToolsNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldTools))
+}
+
 // ModelIDEQ applies the EQ predicate on the "model_id" field.
 func ModelIDEQ(v uuid.UUID) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldModelID, v))
@@ -485,6 +655,75 @@ func HasMessagesWith(preds ...predicate.Message) predicate.Agent {
 	})
 }
 
+// HasSchedules applies the HasEdge predicate on the "schedules" edge.
+func HasSchedules() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SchedulesTable, SchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSchedulesWith applies the HasEdge predicate on the "schedules" edge with a given conditions (other predicates).
+func HasSchedulesWith(preds ...predicate.Schedule) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhookTriggers applies the HasEdge predicate on the "webhook_triggers" edge.
+func HasWebhookTriggers() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, WebhookTriggersTable, WebhookTriggersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhookTriggersWith applies the HasEdge predicate on the "webhook_triggers" edge with a given conditions (other predicates).
+func HasWebhookTriggersWith(preds ...predicate.WebhookTrigger) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newWebhookTriggersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationSinks applies the HasEdge predicate on the "notification_sinks" edge.
+func HasNotificationSinks() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, NotificationSinksTable, NotificationSinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationSinksWith applies the HasEdge predicate on the "notification_sinks" edge with a given conditions (other predicates).
+func HasNotificationSinksWith(preds ...predicate.NotificationSink) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newNotificationSinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Agent) predicate.Agent {
 	return predicate.Agent(sql.AndPredicates(predicates...))
@@ -13,7 +13,10 @@ import (
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/notificationsink"
+	"github.com/furisto/construct/backend/memory/schedule"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/webhooktrigger"
 	"github.com/google/uuid"
 )
 
@@ -52,6 +55,34 @@ func (ac *AgentCreate) SetNillableUpdateTime(t *time.Time) *AgentCreate {
 	return ac
 }
 
+// SetOwner sets the "owner" field.
+func (ac *AgentCreate) SetOwner(s string) *AgentCreate {
+	ac.mutation.SetOwner(s)
+	return ac
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (ac *AgentCreate) SetNillableOwner(s *string) *AgentCreate {
+	if s != nil {
+		ac.SetOwner(*s)
+	}
+	return ac
+}
+
+// SetTeam sets the "team" field.
+func (ac *AgentCreate) SetTeam(s string) *AgentCreate {
+	ac.mutation.SetTeam(s)
+	return ac
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (ac *AgentCreate) SetNillableTeam(s *string) *AgentCreate {
+	if s != nil {
+		ac.SetTeam(*s)
+	}
+	return ac
+}
+
 // SetName sets the "name" field.
 func (ac *AgentCreate) SetName(s string) *AgentCreate {
 	ac.mutation.SetName(s)
@@ -92,6 +123,12 @@ func (ac *AgentCreate) SetNillableBuiltin(b *bool) *AgentCreate {
 	return ac
 }
 
+// SetTools sets the "tools" field.
+func (ac *AgentCreate) SetTools(s []string) *AgentCreate {
+	ac.mutation.SetTools(s)
+	return ac
+}
+
 // SetModelID sets the "model_id" field.
 func (ac *AgentCreate) SetModelID(u uuid.UUID) *AgentCreate {
 	ac.mutation.SetModelID(u)
@@ -155,6 +192,51 @@ func (ac *AgentCreate) AddMessages(m ...*Message) *AgentCreate {
 	return ac.AddMessageIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (ac *AgentCreate) AddScheduleIDs(ids ...uuid.UUID) *AgentCreate {
+	ac.mutation.AddScheduleIDs(ids...)
+	return ac
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (ac *AgentCreate) AddSchedules(s ...*Schedule) *AgentCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ac.AddScheduleIDs(ids...)
+}
+
+// AddWebhookTriggerIDs adds the "webhook_triggers" edge to the WebhookTrigger entity by IDs.
+func (ac *AgentCreate) AddWebhookTriggerIDs(ids ...uuid.UUID) *AgentCreate {
+	ac.mutation.AddWebhookTriggerIDs(ids...)
+	return ac
+}
+
+// AddWebhookTriggers adds the "webhook_triggers" edges to the WebhookTrigger entity.
+func (ac *AgentCreate) AddWebhookTriggers(w ...*WebhookTrigger) *AgentCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ac.AddWebhookTriggerIDs(ids...)
+}
+
+// AddNotificationSinkIDs adds the "notification_sinks" edge to the NotificationSink entity by IDs.
+func (ac *AgentCreate) AddNotificationSinkIDs(ids ...uuid.UUID) *AgentCreate {
+	ac.mutation.AddNotificationSinkIDs(ids...)
+	return ac
+}
+
+// AddNotificationSinks adds the "notification_sinks" edges to the NotificationSink entity.
+func (ac *AgentCreate) AddNotificationSinks(n ...*NotificationSink) *AgentCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return ac.AddNotificationSinkIDs(ids...)
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (ac *AgentCreate) Mutation() *AgentMutation {
 	return ac.mutation
@@ -273,6 +355,14 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_spec.SetField(agent.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := ac.mutation.Owner(); ok {
+		_spec.SetField(agent.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := ac.mutation.Team(); ok {
+		_spec.SetField(agent.FieldTeam, field.TypeString, value)
+		_node.Team = value
+	}
 	if value, ok := ac.mutation.Name(); ok {
 		_spec.SetField(agent.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -289,6 +379,10 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 		_node.Builtin = value
 	}
+	if value, ok := ac.mutation.Tools(); ok {
+		_spec.SetField(agent.FieldTools, field.TypeJSON, value)
+		_node.Tools = value
+	}
 	if nodes := ac.mutation.ModelIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -338,6 +432,54 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.WebhookTriggersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.WebhookTriggersTable,
+			Columns: []string{agent.WebhookTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooktrigger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.NotificationSinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.NotificationSinksTable,
+			Columns: []string{agent.NotificationSinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,22 +15,28 @@ import (
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/notificationsink"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedule"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/webhooktrigger"
 	"github.com/google/uuid"
 )
 
 // AgentQuery is the builder for querying Agent entities.
 type AgentQuery struct {
 	config
-	ctx          *QueryContext
-	order        []agent.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Agent
-	withModel    *ModelQuery
-	withTasks    *TaskQuery
-	withMessages *MessageQuery
-	modifiers    []func(*sql.Selector)
+	ctx                   *QueryContext
+	order                 []agent.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Agent
+	withModel             *ModelQuery
+	withTasks             *TaskQuery
+	withMessages          *MessageQuery
+	withSchedules         *ScheduleQuery
+	withWebhookTriggers   *WebhookTriggerQuery
+	withNotificationSinks *NotificationSinkQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -133,6 +139,72 @@ func (aq *AgentQuery) QueryMessages() *MessageQuery {
 	return query
 }
 
+// QuerySchedules chains the current query on the "schedules" edge.
+func (aq *AgentQuery) QuerySchedules() *ScheduleQuery {
+	query := (&ScheduleClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(schedule.Table, schedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, agent.SchedulesTable, agent.SchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhookTriggers chains the current query on the "webhook_triggers" edge.
+func (aq *AgentQuery) QueryWebhookTriggers() *WebhookTriggerQuery {
+	query := (&WebhookTriggerClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(webhooktrigger.Table, webhooktrigger.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, agent.WebhookTriggersTable, agent.WebhookTriggersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationSinks chains the current query on the "notification_sinks" edge.
+func (aq *AgentQuery) QueryNotificationSinks() *NotificationSinkQuery {
+	query := (&NotificationSinkClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(notificationsink.Table, notificationsink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, agent.NotificationSinksTable, agent.NotificationSinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Agent entity from the query.
 // Returns a *NotFoundError when no Agent was found.
 func (aq *AgentQuery) First(ctx context.Context) (*Agent, error) {
@@ -320,14 +392,17 @@ func (aq *AgentQuery) Clone() *AgentQuery {
 		return nil
 	}
 	return &AgentQuery{
-		config:       aq.config,
-		ctx:          aq.ctx.Clone(),
-		order:        append([]agent.OrderOption{}, aq.order...),
-		inters:       append([]Interceptor{}, aq.inters...),
-		predicates:   append([]predicate.Agent{}, aq.predicates...),
-		withModel:    aq.withModel.Clone(),
-		withTasks:    aq.withTasks.Clone(),
-		withMessages: aq.withMessages.Clone(),
+		config:                aq.config,
+		ctx:                   aq.ctx.Clone(),
+		order:                 append([]agent.OrderOption{}, aq.order...),
+		inters:                append([]Interceptor{}, aq.inters...),
+		predicates:            append([]predicate.Agent{}, aq.predicates...),
+		withModel:             aq.withModel.Clone(),
+		withTasks:             aq.withTasks.Clone(),
+		withMessages:          aq.withMessages.Clone(),
+		withSchedules:         aq.withSchedules.Clone(),
+		withWebhookTriggers:   aq.withWebhookTriggers.Clone(),
+		withNotificationSinks: aq.withNotificationSinks.Clone(),
 		// clone intermediate query.
 		sql:       aq.sql.Clone(),
 		path:      aq.path,
@@ -368,6 +443,39 @@ func (aq *AgentQuery) WithMessages(opts ...func(*MessageQuery)) *AgentQuery {
 	return aq
 }
 
+// WithSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AgentQuery) WithSchedules(opts ...func(*ScheduleQuery)) *AgentQuery {
+	query := (&ScheduleClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withSchedules = query
+	return aq
+}
+
+// WithWebhookTriggers tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_triggers" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AgentQuery) WithWebhookTriggers(opts ...func(*WebhookTriggerQuery)) *AgentQuery {
+	query := (&WebhookTriggerClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withWebhookTriggers = query
+	return aq
+}
+
+// WithNotificationSinks tells the query-builder to eager-load the nodes that are connected to
+// the "notification_sinks" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AgentQuery) WithNotificationSinks(opts ...func(*NotificationSinkQuery)) *AgentQuery {
+	query := (&NotificationSinkClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withNotificationSinks = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -446,10 +554,13 @@ func (aq *AgentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Agent,
 	var (
 		nodes       = []*Agent{}
 		_spec       = aq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			aq.withModel != nil,
 			aq.withTasks != nil,
 			aq.withMessages != nil,
+			aq.withSchedules != nil,
+			aq.withWebhookTriggers != nil,
+			aq.withNotificationSinks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -493,6 +604,27 @@ func (aq *AgentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Agent,
 			return nil, err
 		}
 	}
+	if query := aq.withSchedules; query != nil {
+		if err := aq.loadSchedules(ctx, query, nodes,
+			func(n *Agent) { n.Edges.Schedules = []*Schedule{} },
+			func(n *Agent, e *Schedule) { n.Edges.Schedules = append(n.Edges.Schedules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withWebhookTriggers; query != nil {
+		if err := aq.loadWebhookTriggers(ctx, query, nodes,
+			func(n *Agent) { n.Edges.WebhookTriggers = []*WebhookTrigger{} },
+			func(n *Agent, e *WebhookTrigger) { n.Edges.WebhookTriggers = append(n.Edges.WebhookTriggers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withNotificationSinks; query != nil {
+		if err := aq.loadNotificationSinks(ctx, query, nodes,
+			func(n *Agent) { n.Edges.NotificationSinks = []*NotificationSink{} },
+			func(n *Agent, e *NotificationSink) { n.Edges.NotificationSinks = append(n.Edges.NotificationSinks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -585,6 +717,96 @@ func (aq *AgentQuery) loadMessages(ctx context.Context, query *MessageQuery, nod
 	}
 	return nil
 }
+func (aq *AgentQuery) loadSchedules(ctx context.Context, query *ScheduleQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *Schedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Agent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(schedule.FieldAgentID)
+	}
+	query.Where(predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(agent.SchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AgentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "agent_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AgentQuery) loadWebhookTriggers(ctx context.Context, query *WebhookTriggerQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *WebhookTrigger)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Agent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhooktrigger.FieldAgentID)
+	}
+	query.Where(predicate.WebhookTrigger(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(agent.WebhookTriggersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AgentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "agent_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AgentQuery) loadNotificationSinks(ctx context.Context, query *NotificationSinkQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *NotificationSink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Agent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationsink.FieldAgentID)
+	}
+	query.Where(predicate.NotificationSink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(agent.NotificationSinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AgentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "agent_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *AgentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
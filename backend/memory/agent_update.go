@@ -10,12 +10,16 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/notificationsink"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedule"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/webhooktrigger"
 	"github.com/google/uuid"
 )
 
@@ -39,6 +43,46 @@ func (au *AgentUpdate) SetUpdateTime(t time.Time) *AgentUpdate {
 	return au
 }
 
+// SetOwner sets the "owner" field.
+func (au *AgentUpdate) SetOwner(s string) *AgentUpdate {
+	au.mutation.SetOwner(s)
+	return au
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (au *AgentUpdate) SetNillableOwner(s *string) *AgentUpdate {
+	if s != nil {
+		au.SetOwner(*s)
+	}
+	return au
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (au *AgentUpdate) ClearOwner() *AgentUpdate {
+	au.mutation.ClearOwner()
+	return au
+}
+
+// SetTeam sets the "team" field.
+func (au *AgentUpdate) SetTeam(s string) *AgentUpdate {
+	au.mutation.SetTeam(s)
+	return au
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (au *AgentUpdate) SetNillableTeam(s *string) *AgentUpdate {
+	if s != nil {
+		au.SetTeam(*s)
+	}
+	return au
+}
+
+// ClearTeam clears the value of the "team" field.
+func (au *AgentUpdate) ClearTeam() *AgentUpdate {
+	au.mutation.ClearTeam()
+	return au
+}
+
 // SetName sets the "name" field.
 func (au *AgentUpdate) SetName(s string) *AgentUpdate {
 	au.mutation.SetName(s)
@@ -101,6 +145,24 @@ func (au *AgentUpdate) SetNillableBuiltin(b *bool) *AgentUpdate {
 	return au
 }
 
+// SetTools sets the "tools" field.
+func (au *AgentUpdate) SetTools(s []string) *AgentUpdate {
+	au.mutation.SetTools(s)
+	return au
+}
+
+// AppendTools appends s to the "tools" field.
+func (au *AgentUpdate) AppendTools(s []string) *AgentUpdate {
+	au.mutation.AppendTools(s)
+	return au
+}
+
+// ClearTools clears the value of the "tools" field.
+func (au *AgentUpdate) ClearTools() *AgentUpdate {
+	au.mutation.ClearTools()
+	return au
+}
+
 // SetModelID sets the "model_id" field.
 func (au *AgentUpdate) SetModelID(u uuid.UUID) *AgentUpdate {
 	au.mutation.SetModelID(u)
@@ -156,6 +218,51 @@ func (au *AgentUpdate) AddMessages(m ...*Message) *AgentUpdate {
 	return au.AddMessageIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (au *AgentUpdate) AddScheduleIDs(ids ...uuid.UUID) *AgentUpdate {
+	au.mutation.AddScheduleIDs(ids...)
+	return au
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (au *AgentUpdate) AddSchedules(s ...*Schedule) *AgentUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.AddScheduleIDs(ids...)
+}
+
+// AddWebhookTriggerIDs adds the "webhook_triggers" edge to the WebhookTrigger entity by IDs.
+func (au *AgentUpdate) AddWebhookTriggerIDs(ids ...uuid.UUID) *AgentUpdate {
+	au.mutation.AddWebhookTriggerIDs(ids...)
+	return au
+}
+
+// AddWebhookTriggers adds the "webhook_triggers" edges to the WebhookTrigger entity.
+func (au *AgentUpdate) AddWebhookTriggers(w ...*WebhookTrigger) *AgentUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return au.AddWebhookTriggerIDs(ids...)
+}
+
+// AddNotificationSinkIDs adds the "notification_sinks" edge to the NotificationSink entity by IDs.
+func (au *AgentUpdate) AddNotificationSinkIDs(ids ...uuid.UUID) *AgentUpdate {
+	au.mutation.AddNotificationSinkIDs(ids...)
+	return au
+}
+
+// AddNotificationSinks adds the "notification_sinks" edges to the NotificationSink entity.
+func (au *AgentUpdate) AddNotificationSinks(n ...*NotificationSink) *AgentUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return au.AddNotificationSinkIDs(ids...)
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (au *AgentUpdate) Mutation() *AgentMutation {
 	return au.mutation
@@ -209,6 +316,69 @@ func (au *AgentUpdate) RemoveMessages(m ...*Message) *AgentUpdate {
 	return au.RemoveMessageIDs(ids...)
 }
 
+// ClearSchedules clears all "schedules" edges to the Schedule entity.
+func (au *AgentUpdate) ClearSchedules() *AgentUpdate {
+	au.mutation.ClearSchedules()
+	return au
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to Schedule entities by IDs.
+func (au *AgentUpdate) RemoveScheduleIDs(ids ...uuid.UUID) *AgentUpdate {
+	au.mutation.RemoveScheduleIDs(ids...)
+	return au
+}
+
+// RemoveSchedules removes "schedules" edges to Schedule entities.
+func (au *AgentUpdate) RemoveSchedules(s ...*Schedule) *AgentUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.RemoveScheduleIDs(ids...)
+}
+
+// ClearWebhookTriggers clears all "webhook_triggers" edges to the WebhookTrigger entity.
+func (au *AgentUpdate) ClearWebhookTriggers() *AgentUpdate {
+	au.mutation.ClearWebhookTriggers()
+	return au
+}
+
+// RemoveWebhookTriggerIDs removes the "webhook_triggers" edge to WebhookTrigger entities by IDs.
+func (au *AgentUpdate) RemoveWebhookTriggerIDs(ids ...uuid.UUID) *AgentUpdate {
+	au.mutation.RemoveWebhookTriggerIDs(ids...)
+	return au
+}
+
+// RemoveWebhookTriggers removes "webhook_triggers" edges to WebhookTrigger entities.
+func (au *AgentUpdate) RemoveWebhookTriggers(w ...*WebhookTrigger) *AgentUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return au.RemoveWebhookTriggerIDs(ids...)
+}
+
+// ClearNotificationSinks clears all "notification_sinks" edges to the NotificationSink entity.
+func (au *AgentUpdate) ClearNotificationSinks() *AgentUpdate {
+	au.mutation.ClearNotificationSinks()
+	return au
+}
+
+// RemoveNotificationSinkIDs removes the "notification_sinks" edge to NotificationSink entities by IDs.
+func (au *AgentUpdate) RemoveNotificationSinkIDs(ids ...uuid.UUID) *AgentUpdate {
+	au.mutation.RemoveNotificationSinkIDs(ids...)
+	return au
+}
+
+// RemoveNotificationSinks removes "notification_sinks" edges to NotificationSink entities.
+func (au *AgentUpdate) RemoveNotificationSinks(n ...*NotificationSink) *AgentUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return au.RemoveNotificationSinkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AgentUpdate) Save(ctx context.Context) (int, error) {
 	au.defaults()
@@ -276,6 +446,18 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.UpdateTime(); ok {
 		_spec.SetField(agent.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := au.mutation.Owner(); ok {
+		_spec.SetField(agent.FieldOwner, field.TypeString, value)
+	}
+	if au.mutation.OwnerCleared() {
+		_spec.ClearField(agent.FieldOwner, field.TypeString)
+	}
+	if value, ok := au.mutation.Team(); ok {
+		_spec.SetField(agent.FieldTeam, field.TypeString, value)
+	}
+	if au.mutation.TeamCleared() {
+		_spec.ClearField(agent.FieldTeam, field.TypeString)
+	}
 	if value, ok := au.mutation.Name(); ok {
 		_spec.SetField(agent.FieldName, field.TypeString, value)
 	}
@@ -291,6 +473,17 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Builtin(); ok {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 	}
+	if value, ok := au.mutation.Tools(); ok {
+		_spec.SetField(agent.FieldTools, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedTools(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldTools, value)
+		})
+	}
+	if au.mutation.ToolsCleared() {
+		_spec.ClearField(agent.FieldTools, field.TypeJSON)
+	}
 	if au.mutation.ModelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -410,6 +603,141 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedSchedulesIDs(); len(nodes) > 0 && !au.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.WebhookTriggersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.WebhookTriggersTable,
+			Columns: []string{agent.WebhookTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooktrigger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedWebhookTriggersIDs(); len(nodes) > 0 && !au.mutation.WebhookTriggersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.WebhookTriggersTable,
+			Columns: []string{agent.WebhookTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooktrigger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.WebhookTriggersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.WebhookTriggersTable,
+			Columns: []string{agent.WebhookTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooktrigger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.NotificationSinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.NotificationSinksTable,
+			Columns: []string{agent.NotificationSinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedNotificationSinksIDs(); len(nodes) > 0 && !au.mutation.NotificationSinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.NotificationSinksTable,
+			Columns: []string{agent.NotificationSinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.NotificationSinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.NotificationSinksTable,
+			Columns: []string{agent.NotificationSinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(au.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -438,6 +766,46 @@ func (auo *AgentUpdateOne) SetUpdateTime(t time.Time) *AgentUpdateOne {
 	return auo
 }
 
+// SetOwner sets the "owner" field.
+func (auo *AgentUpdateOne) SetOwner(s string) *AgentUpdateOne {
+	auo.mutation.SetOwner(s)
+	return auo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (auo *AgentUpdateOne) SetNillableOwner(s *string) *AgentUpdateOne {
+	if s != nil {
+		auo.SetOwner(*s)
+	}
+	return auo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (auo *AgentUpdateOne) ClearOwner() *AgentUpdateOne {
+	auo.mutation.ClearOwner()
+	return auo
+}
+
+// SetTeam sets the "team" field.
+func (auo *AgentUpdateOne) SetTeam(s string) *AgentUpdateOne {
+	auo.mutation.SetTeam(s)
+	return auo
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (auo *AgentUpdateOne) SetNillableTeam(s *string) *AgentUpdateOne {
+	if s != nil {
+		auo.SetTeam(*s)
+	}
+	return auo
+}
+
+// ClearTeam clears the value of the "team" field.
+func (auo *AgentUpdateOne) ClearTeam() *AgentUpdateOne {
+	auo.mutation.ClearTeam()
+	return auo
+}
+
 // SetName sets the "name" field.
 func (auo *AgentUpdateOne) SetName(s string) *AgentUpdateOne {
 	auo.mutation.SetName(s)
@@ -500,6 +868,24 @@ func (auo *AgentUpdateOne) SetNillableBuiltin(b *bool) *AgentUpdateOne {
 	return auo
 }
 
+// SetTools sets the "tools" field.
+func (auo *AgentUpdateOne) SetTools(s []string) *AgentUpdateOne {
+	auo.mutation.SetTools(s)
+	return auo
+}
+
+// AppendTools appends s to the "tools" field.
+func (auo *AgentUpdateOne) AppendTools(s []string) *AgentUpdateOne {
+	auo.mutation.AppendTools(s)
+	return auo
+}
+
+// ClearTools clears the value of the "tools" field.
+func (auo *AgentUpdateOne) ClearTools() *AgentUpdateOne {
+	auo.mutation.ClearTools()
+	return auo
+}
+
 // SetModelID sets the "model_id" field.
 func (auo *AgentUpdateOne) SetModelID(u uuid.UUID) *AgentUpdateOne {
 	auo.mutation.SetModelID(u)
@@ -555,6 +941,51 @@ func (auo *AgentUpdateOne) AddMessages(m ...*Message) *AgentUpdateOne {
 	return auo.AddMessageIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (auo *AgentUpdateOne) AddScheduleIDs(ids ...uuid.UUID) *AgentUpdateOne {
+	auo.mutation.AddScheduleIDs(ids...)
+	return auo
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (auo *AgentUpdateOne) AddSchedules(s ...*Schedule) *AgentUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.AddScheduleIDs(ids...)
+}
+
+// AddWebhookTriggerIDs adds the "webhook_triggers" edge to the WebhookTrigger entity by IDs.
+func (auo *AgentUpdateOne) AddWebhookTriggerIDs(ids ...uuid.UUID) *AgentUpdateOne {
+	auo.mutation.AddWebhookTriggerIDs(ids...)
+	return auo
+}
+
+// AddWebhookTriggers adds the "webhook_triggers" edges to the WebhookTrigger entity.
+func (auo *AgentUpdateOne) AddWebhookTriggers(w ...*WebhookTrigger) *AgentUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return auo.AddWebhookTriggerIDs(ids...)
+}
+
+// AddNotificationSinkIDs adds the "notification_sinks" edge to the NotificationSink entity by IDs.
+func (auo *AgentUpdateOne) AddNotificationSinkIDs(ids ...uuid.UUID) *AgentUpdateOne {
+	auo.mutation.AddNotificationSinkIDs(ids...)
+	return auo
+}
+
+// AddNotificationSinks adds the "notification_sinks" edges to the NotificationSink entity.
+func (auo *AgentUpdateOne) AddNotificationSinks(n ...*NotificationSink) *AgentUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return auo.AddNotificationSinkIDs(ids...)
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (auo *AgentUpdateOne) Mutation() *AgentMutation {
 	return auo.mutation
@@ -608,6 +1039,69 @@ func (auo *AgentUpdateOne) RemoveMessages(m ...*Message) *AgentUpdateOne {
 	return auo.RemoveMessageIDs(ids...)
 }
 
+// ClearSchedules clears all "schedules" edges to the Schedule entity.
+func (auo *AgentUpdateOne) ClearSchedules() *AgentUpdateOne {
+	auo.mutation.ClearSchedules()
+	return auo
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to Schedule entities by IDs.
+func (auo *AgentUpdateOne) RemoveScheduleIDs(ids ...uuid.UUID) *AgentUpdateOne {
+	auo.mutation.RemoveScheduleIDs(ids...)
+	return auo
+}
+
+// RemoveSchedules removes "schedules" edges to Schedule entities.
+func (auo *AgentUpdateOne) RemoveSchedules(s ...*Schedule) *AgentUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.RemoveScheduleIDs(ids...)
+}
+
+// ClearWebhookTriggers clears all "webhook_triggers" edges to the WebhookTrigger entity.
+func (auo *AgentUpdateOne) ClearWebhookTriggers() *AgentUpdateOne {
+	auo.mutation.ClearWebhookTriggers()
+	return auo
+}
+
+// RemoveWebhookTriggerIDs removes the "webhook_triggers" edge to WebhookTrigger entities by IDs.
+func (auo *AgentUpdateOne) RemoveWebhookTriggerIDs(ids ...uuid.UUID) *AgentUpdateOne {
+	auo.mutation.RemoveWebhookTriggerIDs(ids...)
+	return auo
+}
+
+// RemoveWebhookTriggers removes "webhook_triggers" edges to WebhookTrigger entities.
+func (auo *AgentUpdateOne) RemoveWebhookTriggers(w ...*WebhookTrigger) *AgentUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return auo.RemoveWebhookTriggerIDs(ids...)
+}
+
+// ClearNotificationSinks clears all "notification_sinks" edges to the NotificationSink entity.
+func (auo *AgentUpdateOne) ClearNotificationSinks() *AgentUpdateOne {
+	auo.mutation.ClearNotificationSinks()
+	return auo
+}
+
+// RemoveNotificationSinkIDs removes the "notification_sinks" edge to NotificationSink entities by IDs.
+func (auo *AgentUpdateOne) RemoveNotificationSinkIDs(ids ...uuid.UUID) *AgentUpdateOne {
+	auo.mutation.RemoveNotificationSinkIDs(ids...)
+	return auo
+}
+
+// RemoveNotificationSinks removes "notification_sinks" edges to NotificationSink entities.
+func (auo *AgentUpdateOne) RemoveNotificationSinks(n ...*NotificationSink) *AgentUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return auo.RemoveNotificationSinkIDs(ids...)
+}
+
 // Where appends a list predicates to the AgentUpdate builder.
 func (auo *AgentUpdateOne) Where(ps ...predicate.Agent) *AgentUpdateOne {
 	auo.mutation.Where(ps...)
@@ -705,6 +1199,18 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 	if value, ok := auo.mutation.UpdateTime(); ok {
 		_spec.SetField(agent.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := auo.mutation.Owner(); ok {
+		_spec.SetField(agent.FieldOwner, field.TypeString, value)
+	}
+	if auo.mutation.OwnerCleared() {
+		_spec.ClearField(agent.FieldOwner, field.TypeString)
+	}
+	if value, ok := auo.mutation.Team(); ok {
+		_spec.SetField(agent.FieldTeam, field.TypeString, value)
+	}
+	if auo.mutation.TeamCleared() {
+		_spec.ClearField(agent.FieldTeam, field.TypeString)
+	}
 	if value, ok := auo.mutation.Name(); ok {
 		_spec.SetField(agent.FieldName, field.TypeString, value)
 	}
@@ -720,6 +1226,17 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 	if value, ok := auo.mutation.Builtin(); ok {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 	}
+	if value, ok := auo.mutation.Tools(); ok {
+		_spec.SetField(agent.FieldTools, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedTools(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldTools, value)
+		})
+	}
+	if auo.mutation.ToolsCleared() {
+		_spec.ClearField(agent.FieldTools, field.TypeJSON)
+	}
 	if auo.mutation.ModelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -839,6 +1356,141 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedSchedulesIDs(); len(nodes) > 0 && !auo.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.WebhookTriggersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.WebhookTriggersTable,
+			Columns: []string{agent.WebhookTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooktrigger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedWebhookTriggersIDs(); len(nodes) > 0 && !auo.mutation.WebhookTriggersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.WebhookTriggersTable,
+			Columns: []string{agent.WebhookTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooktrigger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.WebhookTriggersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.WebhookTriggersTable,
+			Columns: []string{agent.WebhookTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooktrigger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.NotificationSinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.NotificationSinksTable,
+			Columns: []string{agent.NotificationSinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedNotificationSinksIDs(); len(nodes) > 0 && !auo.mutation.NotificationSinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.NotificationSinksTable,
+			Columns: []string{agent.NotificationSinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.NotificationSinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.NotificationSinksTable,
+			Columns: []string{agent.NotificationSinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(auo.modifiers...)
 	_node = &Agent{config: auo.config}
 	_spec.Assign = _node.assignValues
@@ -13,10 +13,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/filechange"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/notificationsink"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/token"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/furisto/construct/backend/memory/webhooktrigger"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +85,19 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			agent.Table:         agent.ValidColumn,
-			message.Table:       message.ValidColumn,
-			model.Table:         model.ValidColumn,
-			modelprovider.Table: modelprovider.ValidColumn,
-			task.Table:          task.ValidColumn,
+			agent.Table:            agent.ValidColumn,
+			auditevent.Table:       auditevent.ValidColumn,
+			filechange.Table:       filechange.ValidColumn,
+			message.Table:          message.ValidColumn,
+			model.Table:            model.ValidColumn,
+			modelprovider.Table:    modelprovider.ValidColumn,
+			notificationsink.Table: notificationsink.ValidColumn,
+			schedule.Table:         schedule.ValidColumn,
+			schedulerun.Table:      schedulerun.ValidColumn,
+			task.Table:             task.ValidColumn,
+			token.Table:            token.ValidColumn,
+			webhookdelivery.Table:  webhookdelivery.ValidColumn,
+			webhooktrigger.Table:   webhooktrigger.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
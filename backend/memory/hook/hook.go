@@ -21,6 +21,30 @@ func (f AgentFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.AgentMutation", m)
 }
 
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *memory.AuditEventMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.AuditEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.AuditEventMutation", m)
+}
+
+// The FileChangeFunc type is an adapter to allow the use of ordinary
+// function as FileChange mutator.
+type FileChangeFunc func(context.Context, *memory.FileChangeMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileChangeFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.FileChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.FileChangeMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *memory.MessageMutation) (memory.Value, error)
@@ -57,6 +81,42 @@ func (f ModelProviderFunc) Mutate(ctx context.Context, m memory.Mutation) (memor
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ModelProviderMutation", m)
 }
 
+// The NotificationSinkFunc type is an adapter to allow the use of ordinary
+// function as NotificationSink mutator.
+type NotificationSinkFunc func(context.Context, *memory.NotificationSinkMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationSinkFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.NotificationSinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.NotificationSinkMutation", m)
+}
+
+// The ScheduleFunc type is an adapter to allow the use of ordinary
+// function as Schedule mutator.
+type ScheduleFunc func(context.Context, *memory.ScheduleMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduleFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.ScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ScheduleMutation", m)
+}
+
+// The ScheduleRunFunc type is an adapter to allow the use of ordinary
+// function as ScheduleRun mutator.
+type ScheduleRunFunc func(context.Context, *memory.ScheduleRunMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduleRunFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.ScheduleRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ScheduleRunMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *memory.TaskMutation) (memory.Value, error)
@@ -69,6 +129,42 @@ func (f TaskFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.TaskMutation", m)
 }
 
+// The TokenFunc type is an adapter to allow the use of ordinary
+// function as Token mutator.
+type TokenFunc func(context.Context, *memory.TokenMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TokenFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.TokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.TokenMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *memory.WebhookDeliveryMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.WebhookDeliveryMutation", m)
+}
+
+// The WebhookTriggerFunc type is an adapter to allow the use of ordinary
+// function as WebhookTrigger mutator.
+type WebhookTriggerFunc func(context.Context, *memory.WebhookTriggerMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookTriggerFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.WebhookTriggerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.WebhookTriggerMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, memory.Mutation) bool
 
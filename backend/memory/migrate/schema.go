@@ -14,10 +14,13 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "team", Type: field.TypeString, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "instructions", Type: field.TypeString},
 		{Name: "builtin", Type: field.TypeBool, Default: false},
+		{Name: "tools", Type: field.TypeJSON, Nullable: true},
 		{Name: "model_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// AgentsTable holds the schema information for the "agents" table.
@@ -28,16 +31,97 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "agents_models_model",
-				Columns:    []*schema.Column{AgentsColumns[7]},
+				Columns:    []*schema.Column{AgentsColumns[10]},
 				RefColumns: []*schema.Column{ModelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "agent_owner",
+				Unique:  false,
+				Columns: []*schema.Column{AgentsColumns[3]},
+			},
 			{
 				Name:    "agent_name",
 				Unique:  true,
-				Columns: []*schema.Column{AgentsColumns[3]},
+				Columns: []*schema.Column{AgentsColumns[5]},
+			},
+		},
+	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "sequence", Type: field.TypeInt64},
+		{Name: "time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"tool_call", "api_call"}},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "resource", Type: field.TypeString, Nullable: true},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "workspace", Type: field.TypeString, Nullable: true},
+		{Name: "command", Type: field.TypeString, Nullable: true},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "diff_hash", Type: field.TypeString, Nullable: true},
+		{Name: "exit_code", Type: field.TypeInt, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt64},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "previous_hash", Type: field.TypeBytes, Nullable: true},
+		{Name: "hash", Type: field.TypeBytes},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_sequence",
+				Unique:  true,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_actor_sequence",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[4], AuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[7]},
+			},
+		},
+	}
+	// FileChangesColumns holds the columns for the "file_changes" table.
+	FileChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "path", Type: field.TypeString},
+		{Name: "original_content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "rejected"}, Default: "pending"},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "task_id", Type: field.TypeUUID},
+	}
+	// FileChangesTable holds the schema information for the "file_changes" table.
+	FileChangesTable = &schema.Table{
+		Name:       "file_changes",
+		Columns:    FileChangesColumns,
+		PrimaryKey: []*schema.Column{FileChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "file_changes_tasks_task",
+				Columns:    []*schema.Column{FileChangesColumns[8]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "filechange_task_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{FileChangesColumns[8], FileChangesColumns[6]},
 			},
 		},
 	}
@@ -139,6 +223,8 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "team", Type: field.TypeString, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "provider_type", Type: field.TypeEnum, Enums: []string{"anthropic", "openai", "gemini", "xai"}},
 		{Name: "url", Type: field.TypeString, Nullable: true},
@@ -150,12 +236,155 @@ var (
 		Name:       "model_providers",
 		Columns:    ModelProvidersColumns,
 		PrimaryKey: []*schema.Column{ModelProvidersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "modelprovider_owner",
+				Unique:  false,
+				Columns: []*schema.Column{ModelProvidersColumns[3]},
+			},
+		},
+	}
+	// NotificationSinksColumns holds the columns for the "notification_sinks" table.
+	NotificationSinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "team", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"webhook", "desktop", "email"}},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "budget", Type: field.TypeFloat64, Nullable: true},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeJSON, Nullable: true},
+		{Name: "secret", Type: field.TypeBytes, Nullable: true},
+		{Name: "title_template", Type: field.TypeString, Nullable: true},
+		{Name: "body_template", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "agent_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// NotificationSinksTable holds the schema information for the "notification_sinks" table.
+	NotificationSinksTable = &schema.Table{
+		Name:       "notification_sinks",
+		Columns:    NotificationSinksColumns,
+		PrimaryKey: []*schema.Column{NotificationSinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_sinks_agents_agent",
+				Columns:    []*schema.Column{NotificationSinksColumns[16]},
+				RefColumns: []*schema.Column{AgentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationsink_owner",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationSinksColumns[3]},
+			},
+			{
+				Name:    "notificationsink_name",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationSinksColumns[5]},
+			},
+		},
+	}
+	// SchedulesColumns holds the columns for the "schedules" table.
+	SchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "team", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "cron_expression", Type: field.TypeString},
+		{Name: "prompt_template", Type: field.TypeString},
+		{Name: "workspace", Type: field.TypeString, Nullable: true},
+		{Name: "overlap_policy", Type: field.TypeEnum, Enums: []string{"allow", "skip", "replace"}, Default: "skip"},
+		{Name: "catch_up_policy", Type: field.TypeEnum, Enums: []string{"skip", "run_once", "run_all"}, Default: "run_once"},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "last_run_time", Type: field.TypeTime, Nullable: true},
+		{Name: "next_run_time", Type: field.TypeTime, Nullable: true},
+		{Name: "agent_id", Type: field.TypeUUID},
+	}
+	// SchedulesTable holds the schema information for the "schedules" table.
+	SchedulesTable = &schema.Table{
+		Name:       "schedules",
+		Columns:    SchedulesColumns,
+		PrimaryKey: []*schema.Column{SchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedules_agents_agent",
+				Columns:    []*schema.Column{SchedulesColumns[14]},
+				RefColumns: []*schema.Column{AgentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedule_owner",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[3]},
+			},
+			{
+				Name:    "schedule_name",
+				Unique:  true,
+				Columns: []*schema.Column{SchedulesColumns[5]},
+			},
+			{
+				Name:    "schedule_next_run_time",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[13]},
+			},
+		},
+	}
+	// ScheduleRunsColumns holds the columns for the "schedule_runs" table.
+	ScheduleRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "catch_up", "manual"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"started", "skipped", "failed", "completed"}},
+		{Name: "scheduled_time", Type: field.TypeTime},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "schedule_id", Type: field.TypeUUID},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ScheduleRunsTable holds the schema information for the "schedule_runs" table.
+	ScheduleRunsTable = &schema.Table{
+		Name:       "schedule_runs",
+		Columns:    ScheduleRunsColumns,
+		PrimaryKey: []*schema.Column{ScheduleRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedule_runs_schedules_schedule",
+				Columns:    []*schema.Column{ScheduleRunsColumns[7]},
+				RefColumns: []*schema.Column{SchedulesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "schedule_runs_tasks_task",
+				Columns:    []*schema.Column{ScheduleRunsColumns[8]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedulerun_schedule_id_scheduled_time",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduleRunsColumns[7], ScheduleRunsColumns[5]},
+			},
+		},
 	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "team", Type: field.TypeString, Nullable: true},
 		{Name: "project_directory", Type: field.TypeString, Nullable: true},
 		{Name: "input_tokens", Type: field.TypeInt64, Nullable: true},
 		{Name: "output_tokens", Type: field.TypeInt64, Nullable: true},
@@ -167,7 +396,13 @@ var (
 		{Name: "desired_phase", Type: field.TypeEnum, Enums: []string{"unspecified", "running", "awaiting", "suspended"}, Default: "running"},
 		{Name: "phase", Type: field.TypeEnum, Enums: []string{"unspecified", "running", "awaiting", "suspended"}, Default: "awaiting"},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "review_edits", Type: field.TypeBool, Default: false},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "forked_from_message_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "lease_owner", Type: field.TypeString, Nullable: true},
+		{Name: "lease_expire_time", Type: field.TypeTime, Nullable: true},
 		{Name: "agent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "forked_from_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
@@ -177,12 +412,23 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tasks_agents_agent",
-				Columns:    []*schema.Column{TasksColumns[14]},
+				Columns:    []*schema.Column{TasksColumns[21]},
 				RefColumns: []*schema.Column{AgentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "tasks_tasks_forks",
+				Columns:    []*schema.Column{TasksColumns[22]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "task_owner",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[3]},
+			},
 			{
 				Name:    "task_create_time",
 				Unique:  false,
@@ -195,18 +441,141 @@ var (
 			},
 		},
 	}
+	// TokensColumns holds the columns for the "tokens" table.
+	TokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeBytes},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"read", "full"}},
+		{Name: "user", Type: field.TypeString, Nullable: true},
+		{Name: "team", Type: field.TypeString, Nullable: true},
+		{Name: "expire_time", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_time", Type: field.TypeTime, Nullable: true},
+	}
+	// TokensTable holds the schema information for the "tokens" table.
+	TokensTable = &schema.Table{
+		Name:       "tokens",
+		Columns:    TokensColumns,
+		PrimaryKey: []*schema.Column{TokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "token_name",
+				Unique:  true,
+				Columns: []*schema.Column{TokensColumns[3]},
+			},
+			{
+				Name:    "token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{TokensColumns[4]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"accepted", "rejected", "failed"}},
+		{Name: "status_code", Type: field.TypeInt},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "payload", Type: field.TypeBytes, Nullable: true},
+		{Name: "trigger_id", Type: field.TypeUUID},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_triggers_trigger",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[7]},
+				RefColumns: []*schema.Column{WebhookTriggersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "webhook_deliveries_tasks_task",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[8]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_trigger_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[7], WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// WebhookTriggersColumns holds the columns for the "webhook_triggers" table.
+	WebhookTriggersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "team", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "target", Type: field.TypeEnum, Enums: []string{"new_task", "message"}},
+		{Name: "prompt_template", Type: field.TypeString},
+		{Name: "task_id_template", Type: field.TypeString, Nullable: true},
+		{Name: "workspace", Type: field.TypeString, Nullable: true},
+		{Name: "secret", Type: field.TypeBytes},
+		{Name: "signature_header", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "agent_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// WebhookTriggersTable holds the schema information for the "webhook_triggers" table.
+	WebhookTriggersTable = &schema.Table{
+		Name:       "webhook_triggers",
+		Columns:    WebhookTriggersColumns,
+		PrimaryKey: []*schema.Column{WebhookTriggersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_triggers_agents_agent",
+				Columns:    []*schema.Column{WebhookTriggersColumns[14]},
+				RefColumns: []*schema.Column{AgentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhooktrigger_owner",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookTriggersColumns[3]},
+			},
+			{
+				Name:    "webhooktrigger_name",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookTriggersColumns[5]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AgentsTable,
+		AuditEventsTable,
+		FileChangesTable,
 		MessagesTable,
 		ModelsTable,
 		ModelProvidersTable,
+		NotificationSinksTable,
+		SchedulesTable,
+		ScheduleRunsTable,
 		TasksTable,
+		TokensTable,
+		WebhookDeliveriesTable,
+		WebhookTriggersTable,
 	}
 )
 
 func init() {
 	AgentsTable.ForeignKeys[0].RefTable = ModelsTable
+	FileChangesTable.ForeignKeys[0].RefTable = TasksTable
 	MessagesTable.ForeignKeys[0].RefTable = TasksTable
 	MessagesTable.ForeignKeys[1].RefTable = AgentsTable
 	MessagesTable.ForeignKeys[2].RefTable = ModelsTable
@@ -215,5 +584,13 @@ func init() {
 		"agent_model": "(agent_id IS NULL OR agent_id IS NOT NULL AND model_id IS NOT NULL)",
 	}
 	ModelsTable.ForeignKeys[0].RefTable = ModelProvidersTable
+	NotificationSinksTable.ForeignKeys[0].RefTable = AgentsTable
+	SchedulesTable.ForeignKeys[0].RefTable = AgentsTable
+	ScheduleRunsTable.ForeignKeys[0].RefTable = SchedulesTable
+	ScheduleRunsTable.ForeignKeys[1].RefTable = TasksTable
 	TasksTable.ForeignKeys[0].RefTable = AgentsTable
+	TasksTable.ForeignKeys[1].RefTable = TasksTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookTriggersTable
+	WebhookDeliveriesTable.ForeignKeys[1].RefTable = TasksTable
+	WebhookTriggersTable.ForeignKeys[0].RefTable = AgentsTable
 }
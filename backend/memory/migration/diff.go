@@ -0,0 +1,189 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlite"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/google/uuid"
+)
+
+// SnapshotFile holds the schema of the Postgres migrations. Postgres schemas cannot be
+// replayed without a server, so the next migration is diffed against this snapshot.
+const SnapshotFile = "schema.hcl"
+
+// Change is a migration that closes the gap between the migrations of a dialect and the
+// ent schema. Up and Down are empty if the migrations are up to date.
+type Change struct {
+	Up   string
+	Down string
+	// Snapshot is the schema after the change, for dialects that keep a snapshot.
+	Snapshot []byte
+}
+
+// Diff compares the migrations in dir to the ent schema.
+func Diff(ctx context.Context, dialectName string, dir fs.FS) (*Change, error) {
+	switch dialectName {
+	case dialect.SQLite:
+		migrations, err := Load(dir)
+		if err != nil {
+			return nil, err
+		}
+		up, down, err := diffSQLite(ctx, migrations)
+		if err != nil {
+			return nil, err
+		}
+		return &Change{Up: up, Down: down}, nil
+	case dialect.Postgres:
+		return diffPostgres(ctx, dir)
+	default:
+		return nil, fmt.Errorf("unsupported dialect %q", dialectName)
+	}
+}
+
+// diffSQLite replays the migrations on a scratch database and compares it to a scratch
+// database created from the ent schema.
+func diffSQLite(ctx context.Context, migrations []Migration) (up string, down string, err error) {
+	current, err := openScratch()
+	if err != nil {
+		return "", "", err
+	}
+	defer current.Close()
+
+	for _, migration := range migrations {
+		if _, err := current.ExecContext(ctx, migration.Up); err != nil {
+			return "", "", fmt.Errorf("failed to replay migration %s: %w", migration, err)
+		}
+	}
+
+	desired, err := openScratch()
+	if err != nil {
+		return "", "", err
+	}
+	defer desired.Close()
+
+	client := memory.NewClient(memory.Driver(entsql.OpenDB(dialect.SQLite, desired)))
+	if err := client.Schema.Create(ctx); err != nil {
+		return "", "", fmt.Errorf("failed to create ent schema: %w", err)
+	}
+
+	currentSchema, err := inspect(ctx, current)
+	if err != nil {
+		return "", "", err
+	}
+
+	desiredSchema, err := inspect(ctx, desired)
+	if err != nil {
+		return "", "", err
+	}
+
+	up, err = plan(ctx, current, currentSchema, desiredSchema)
+	if err != nil {
+		return "", "", err
+	}
+
+	down, err = plan(ctx, desired, desiredSchema, currentSchema)
+	if err != nil {
+		return "", "", err
+	}
+
+	return up, down, nil
+}
+
+func diffPostgres(ctx context.Context, dir fs.FS) (*Change, error) {
+	current := schema.New("public")
+	snapshot, err := fs.ReadFile(dir, SnapshotFile)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if current, err = unmarshalPostgresSchema(snapshot); err != nil {
+			return nil, fmt.Errorf("failed to read schema snapshot: %w", err)
+		}
+	}
+
+	desired, err := postgresSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	up, err := planPostgres(ctx, current, desired)
+	if err != nil {
+		return nil, err
+	}
+	if up == "" {
+		return &Change{}, nil
+	}
+
+	down, err := planPostgres(ctx, desired, current)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err = marshalPostgresSchema(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write schema snapshot: %w", err)
+	}
+
+	return &Change{Up: up, Down: down, Snapshot: snapshot}, nil
+}
+
+func openScratch() (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", uuid.NewString()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open scratch database: %w", err)
+	}
+	return db, nil
+}
+
+func inspect(ctx context.Context, db *sql.DB) (*schema.Schema, error) {
+	driver, err := sqlite.Open(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open atlas driver: %w", err)
+	}
+
+	s, err := driver.InspectSchema(ctx, "main", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect schema: %w", err)
+	}
+	return s, nil
+}
+
+func plan(ctx context.Context, db *sql.DB, from, to *schema.Schema) (string, error) {
+	driver, err := sqlite.Open(db)
+	if err != nil {
+		return "", fmt.Errorf("failed to open atlas driver: %w", err)
+	}
+
+	changes, err := driver.SchemaDiff(from, to)
+	if err != nil {
+		return "", fmt.Errorf("failed to diff schemas: %w", err)
+	}
+
+	if len(changes) == 0 {
+		return "", nil
+	}
+
+	p, err := driver.PlanChanges(ctx, "", changes)
+	if err != nil {
+		return "", fmt.Errorf("failed to plan changes: %w", err)
+	}
+
+	var b strings.Builder
+	for _, change := range p.Changes {
+		if change.Comment != "" {
+			fmt.Fprintf(&b, "-- %s\n", change.Comment)
+		}
+		fmt.Fprintf(&b, "%s;\n", change.Cmd)
+	}
+	return b.String(), nil
+}
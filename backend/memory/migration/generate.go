@@ -0,0 +1,76 @@
+//go:build ignore
+// +build ignore
+
+// Generates the next migration of every dialect from the difference between its
+// migrations and the ent schema:
+//
+//	go run generate.go <name>
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory/migration"
+)
+
+func main() {
+	if len(os.Args) != 2 || !regexp.MustCompile(`^[a-z0-9_]+$`).MatchString(os.Args[1]) {
+		fmt.Fprintln(os.Stderr, "usage: go run generate.go <name_in_snake_case>")
+		os.Exit(2)
+	}
+	name := os.Args[1]
+
+	for _, d := range []string{dialect.SQLite, dialect.Postgres} {
+		if err := generate(d, name); err != nil {
+			slog.Error("generating migration", "dialect", d, "error", err)
+			os.Exit(1)
+		}
+	}
+}
+
+func generate(dialectName, name string) error {
+	dirName, err := migration.DirName(dialectName)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join("migrations", dirName)
+	migrations, err := migration.Load(os.DirFS(dir))
+	if err != nil {
+		return err
+	}
+
+	change, err := migration.Diff(context.Background(), dialectName, os.DirFS(dir))
+	if err != nil {
+		return err
+	}
+
+	if change.Up == "" {
+		fmt.Printf("%s migrations are up to date with the ent schema\n", dialectName)
+		return nil
+	}
+
+	version := len(migrations) + 1
+	for direction, content := range map[string]string{"up": change.Up, "down": change.Down} {
+		file := filepath.Join(dir, migration.FileName(version, name, direction))
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			return err
+		}
+		fmt.Println("wrote", file)
+	}
+
+	if change.Snapshot != nil {
+		file := filepath.Join(dir, migration.SnapshotFile)
+		if err := os.WriteFile(file, change.Snapshot, 0644); err != nil {
+			return err
+		}
+		fmt.Println("wrote", file)
+	}
+	return nil
+}
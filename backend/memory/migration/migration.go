@@ -0,0 +1,511 @@
+package migration
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory"
+)
+
+// VersionTable records the migrations that have been applied to a database.
+const VersionTable = "schema_migrations"
+
+// advisoryLockKey identifies the Postgres advisory lock held while migrating.
+const advisoryLockKey = 7_263_001
+
+var ErrDatabaseNewer = errors.New("database schema is newer than this version of construct")
+
+//go:embed migrations
+var embedded embed.FS
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is a versioned schema change together with the statements that revert it.
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Dir returns the embedded migrations directory of a dialect.
+func Dir(dialectName string) (fs.FS, error) {
+	name, err := DirName(dialectName)
+	if err != nil {
+		return nil, err
+	}
+	return fs.Sub(embedded, path.Join("migrations", name))
+}
+
+// DirName returns the name of the migrations directory of a dialect.
+func DirName(dialectName string) (string, error) {
+	switch dialectName {
+	case dialect.SQLite:
+		return "sqlite", nil
+	case dialect.Postgres:
+		return "postgres", nil
+	default:
+		return "", fmt.Errorf("unsupported dialect %q", dialectName)
+	}
+}
+
+// For returns the migrations of a dialect that are embedded in the binary.
+func For(dialectName string) ([]Migration, error) {
+	dir, err := Dir(dialectName)
+	if err != nil {
+		return nil, err
+	}
+	return Load(dir)
+}
+
+// Load reads the migrations of a directory. Every migration consists of a
+// <version>_<name>.up.sql and a <version>_<name>.down.sql file, other files are ignored.
+func Load(dir fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(dir, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %s", entry.Name())
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		content, err := fs.ReadFile(dir, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names %s and %s", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %s needs both an up and a down file", migration)
+		}
+		checksum := sha256.Sum256([]byte(migration.Up + migration.Down))
+		migration.Checksum = hex.EncodeToString(checksum[:])
+		migrations = append(migrations, *migration)
+	}
+
+	slices.SortFunc(migrations, func(a, b Migration) int { return a.Version - b.Version })
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			return nil, fmt.Errorf("migration versions must be consecutive starting at 1, got %s", migration)
+		}
+	}
+
+	return migrations, nil
+}
+
+// Status describes how far a database is behind the migrations of the binary.
+type Status struct {
+	Version int
+	Latest  int
+	Pending []Migration
+	// Unversioned is set for databases that were created by the automatic migration of
+	// earlier versions and have no version table yet.
+	Unversioned bool
+}
+
+type MigratorOption func(*Migrator)
+
+// WithBackupDir creates a copy of the database in dir before the schema is changed.
+func WithBackupDir(dir string) MigratorOption {
+	return func(m *Migrator) {
+		m.backupDir = dir
+	}
+}
+
+type Migrator struct {
+	client     *memory.Client
+	db         *sql.DB
+	dialect    string
+	migrations []Migration
+	backupDir  string
+	now        func() time.Time
+	logger     *slog.Logger
+}
+
+func NewMigrator(client *memory.Client, migrations []Migration, opts ...MigratorOption) *Migrator {
+	migrator := &Migrator{
+		client:     client,
+		db:         client.MustDB(),
+		dialect:    client.Driver().Dialect(),
+		migrations: migrations,
+		now:        time.Now,
+		logger:     slog.With("component", "migrator"),
+	}
+
+	for _, opt := range opts {
+		opt(migrator)
+	}
+
+	return migrator
+}
+
+// Status returns the version of the database and the migrations that still have to be
+// applied. It fails with ErrDatabaseNewer if the database was migrated by a newer binary.
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	status := &Status{Latest: len(m.migrations)}
+
+	exists, err := m.tableExists(ctx, VersionTable)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		tables, err := m.countTables(ctx)
+		if err != nil {
+			return nil, err
+		}
+		status.Unversioned = tables > 0
+		status.Pending = m.migrations
+		return status, nil
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version, checksum FROM "+VersionTable+" ORDER BY version")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			version  int
+			checksum string
+		)
+		if err := rows.Scan(&version, &checksum); err != nil {
+			return nil, fmt.Errorf("failed to read schema version: %w", err)
+		}
+
+		if version > len(m.migrations) {
+			return nil, fmt.Errorf("%w: database is at version %d, this binary supports up to version %d", ErrDatabaseNewer, version, len(m.migrations))
+		}
+		if m.migrations[version-1].Checksum != checksum {
+			return nil, fmt.Errorf("applied migration %s does not match the migration of this binary", m.migrations[version-1])
+		}
+		status.Version = version
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	status.Pending = m.migrations[status.Version:]
+	return status, nil
+}
+
+// Up applies all pending migrations and returns them.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(status.Pending) == 0 {
+		return nil, nil
+	}
+
+	if status.Unversioned || status.Version > 0 {
+		if err := m.backup(ctx, status.Version); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return nil, err
+	}
+
+	if status.Unversioned {
+		// The automatic migration of earlier versions only ever lagged behind the schema
+		// of the binary, so the database is brought up to it without dropping anything
+		// and then recorded as fully migrated.
+		m.logger.Info("adopting unversioned database")
+		if err := m.client.Schema.Create(ctx); err != nil {
+			return nil, fmt.Errorf("failed to migrate unversioned database: %w", err)
+		}
+		for _, migration := range m.migrations {
+			if _, err := m.db.ExecContext(ctx, m.bind("INSERT INTO "+VersionTable+" (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)"),
+				migration.Version, migration.Name, migration.Checksum, m.now().UTC()); err != nil {
+				return nil, fmt.Errorf("failed to record migration %s: %w", migration, err)
+			}
+		}
+		return nil, nil
+	}
+
+	for _, migration := range status.Pending {
+		m.logger.Info("applying migration", "migration", migration.String())
+		err := m.apply(ctx, migration.Up, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, m.bind("INSERT INTO "+VersionTable+" (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)"),
+				migration.Version, migration.Name, migration.Checksum, m.now().UTC())
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply migration %s: %w", migration, err)
+		}
+	}
+
+	return status.Pending, nil
+}
+
+// Rollback reverts the last steps migrations and returns them in the order they were
+// reverted.
+func (m *Migrator) Rollback(ctx context.Context, steps int) ([]Migration, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	reverted, err := m.RollbackPlan(ctx, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reverted) == 0 {
+		return nil, nil
+	}
+
+	if err := m.backup(ctx, reverted[0].Version); err != nil {
+		return nil, err
+	}
+
+	for _, migration := range reverted {
+		m.logger.Info("reverting migration", "migration", migration.String())
+		err := m.apply(ctx, migration.Down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, m.bind("DELETE FROM "+VersionTable+" WHERE version = ?"), migration.Version)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to revert migration %s: %w", migration, err)
+		}
+	}
+
+	return reverted, nil
+}
+
+// RollbackPlan returns the migrations that Rollback would revert.
+func (m *Migrator) RollbackPlan(ctx context.Context, steps int) ([]Migration, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("steps must be at least 1")
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if status.Unversioned {
+		return nil, fmt.Errorf("database has no schema version, run the migration first")
+	}
+
+	var reverted []Migration
+	for version := status.Version; version > 0 && len(reverted) < steps; version-- {
+		reverted = append(reverted, m.migrations[version-1])
+	}
+
+	return reverted, nil
+}
+
+// apply runs the statements and record in one transaction. On SQLite, foreign keys are
+// disabled while the statements run, because SQLite rebuilds tables to alter them, and
+// are verified before the transaction commits.
+func (m *Migrator) apply(ctx context.Context, statements string, record func(tx *sql.Tx) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	sqlite := m.dialect == dialect.SQLite
+	if sqlite {
+		if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = off"); err != nil {
+			return err
+		}
+		defer conn.ExecContext(context.Background(), "PRAGMA foreign_keys = on")
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, statements); err != nil {
+		return err
+	}
+
+	if err := record(tx); err != nil {
+		return err
+	}
+
+	if sqlite {
+		rows, err := tx.QueryContext(ctx, "PRAGMA foreign_key_check")
+		if err != nil {
+			return err
+		}
+		violation := rows.Next()
+		rows.Close()
+		if violation {
+			return fmt.Errorf("migration violates foreign key constraints")
+		}
+	}
+
+	return tx.Commit()
+}
+
+// lock serializes migrations of daemons that share a Postgres database. SQLite
+// databases are local to a single daemon.
+func (m *Migrator) lock(ctx context.Context) (func(), error) {
+	if m.dialect != dialect.Postgres {
+		return func() {}, nil
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", advisoryLockKey); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+
+	return func() {
+		conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", advisoryLockKey)
+		conn.Close()
+	}, nil
+}
+
+// backup copies SQLite databases into the backup directory. Postgres databases are
+// expected to be backed up by their operators.
+func (m *Migrator) backup(ctx context.Context, version int) error {
+	if m.backupDir == "" || m.dialect != dialect.SQLite {
+		return nil
+	}
+
+	if err := os.MkdirAll(m.backupDir, 0700); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	file := filepath.Join(m.backupDir, fmt.Sprintf("construct-%s-v%04d.db", m.now().UTC().Format("20060102T150405"), version))
+	if _, err := m.db.ExecContext(ctx, "VACUUM INTO ?", file); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+
+	m.logger.Info("database backed up", "file", file)
+	return nil
+}
+
+func (m *Migrator) ensureVersionTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+VersionTable+` (
+	version integer NOT NULL PRIMARY KEY,
+	name text NOT NULL,
+	checksum text NOT NULL,
+	applied_at timestamp NOT NULL
+)`)
+	if err != nil {
+		return fmt.Errorf("failed to create version table: %w", err)
+	}
+	return nil
+}
+
+func (m *Migrator) tableExists(ctx context.Context, name string) (bool, error) {
+	query := "SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	if m.dialect == dialect.Postgres {
+		query = "SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1"
+	}
+
+	var count int
+	err := m.db.QueryRowContext(ctx, query, name).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect database: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (m *Migrator) countTables(ctx context.Context) (int, error) {
+	query := "SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'"
+	if m.dialect == dialect.Postgres {
+		query = "SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema()"
+	}
+
+	var count int
+	err := m.db.QueryRowContext(ctx, query).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to inspect database: %w", err)
+	}
+	return count, nil
+}
+
+// bind rewrites the ? placeholders of a query for Postgres.
+func (m *Migrator) bind(query string) string {
+	if m.dialect != dialect.Postgres {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// FileName returns the name of the up or down file of a migration.
+func FileName(version int, name string, direction string) string {
+	return fmt.Sprintf("%04d_%s.%s.sql", version, name, direction)
+}
@@ -0,0 +1,203 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestMigrationsUpToDate(t *testing.T) {
+	for _, d := range []string{dialect.SQLite, dialect.Postgres} {
+		t.Run(d, func(t *testing.T) {
+			dir, err := Dir(d)
+			if err != nil {
+				t.Fatalf("failed to open migrations: %v", err)
+			}
+
+			change, err := Diff(context.Background(), d, dir)
+			if err != nil {
+				t.Fatalf("failed to diff migrations: %v", err)
+			}
+
+			if change.Up != "" {
+				t.Errorf("the ent schema has changes that are not covered by a migration, run `go run generate.go <name>` in backend/memory/migration:\n%s", change.Up)
+			}
+		})
+	}
+}
+
+func TestMigrationsMatchAcrossDialects(t *testing.T) {
+	sqlite, err := For(dialect.SQLite)
+	if err != nil {
+		t.Fatalf("failed to load sqlite migrations: %v", err)
+	}
+
+	postgres, err := For(dialect.Postgres)
+	if err != nil {
+		t.Fatalf("failed to load postgres migrations: %v", err)
+	}
+
+	if diff := cmp.Diff(names(sqlite), names(postgres)); diff != "" {
+		t.Errorf("migrations of the dialects diverge (-sqlite +postgres):\n%s", diff)
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+
+	migrations, err := For(dialect.SQLite)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	migrations = append(migrations, Migration{
+		Version:  len(migrations) + 1,
+		Name:     "add_task_label",
+		Up:       "ALTER TABLE `tasks` ADD COLUMN `label` text NULL;",
+		Down:     "ALTER TABLE `tasks` DROP COLUMN `label`;",
+		Checksum: "label",
+	})
+	latest := len(migrations)
+
+	db := newTestDatabase(t)
+	backupDir := t.TempDir()
+	migrator := NewMigrator(db, migrations, WithBackupDir(backupDir))
+	migrator.now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	if len(applied) != latest {
+		t.Errorf("expected %d applied migrations, got %d", latest, len(applied))
+	}
+	assertVersion(t, migrator, latest)
+	assertColumn(t, db, "label", true)
+
+	entries, _ := os.ReadDir(backupDir)
+	if len(entries) != 0 {
+		t.Errorf("expected no backup of an empty database, got %d", len(entries))
+	}
+
+	applied, err = migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("expected no pending migrations, got %d", len(applied))
+	}
+
+	reverted, err := migrator.Rollback(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to roll back: %v", err)
+	}
+	if diff := cmp.Diff([]string{fmt.Sprintf("%04d_add_task_label", latest)}, names(reverted)); diff != "" {
+		t.Errorf("reverted migrations mismatch (-want +got):\n%s", diff)
+	}
+	assertVersion(t, migrator, latest-1)
+	assertColumn(t, db, "label", false)
+
+	if _, err := os.Stat(filepath.Join(backupDir, fmt.Sprintf("construct-20250601T120000-v%04d.db", latest))); err != nil {
+		t.Errorf("expected a backup before the rollback: %v", err)
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("failed to migrate after rollback: %v", err)
+	}
+	assertVersion(t, migrator, latest)
+
+	older := NewMigrator(db, migrations[:latest-1])
+	if _, err := older.Up(ctx); !errors.Is(err, ErrDatabaseNewer) {
+		t.Errorf("expected ErrDatabaseNewer, got %v", err)
+	}
+}
+
+func TestMigratorAdoptsUnversionedDatabase(t *testing.T) {
+	ctx := context.Background()
+
+	migrations, err := For(dialect.SQLite)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+
+	db := newTestDatabase(t)
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	backupDir := t.TempDir()
+	migrator := NewMigrator(db, migrations, WithBackupDir(backupDir))
+
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	}
+	if !status.Unversioned {
+		t.Errorf("expected database to be unversioned")
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	assertVersion(t, migrator, len(migrations))
+
+	entries, _ := os.ReadDir(backupDir)
+	if len(entries) != 1 {
+		t.Errorf("expected one backup, got %d", len(entries))
+	}
+}
+
+func assertVersion(t *testing.T, migrator *Migrator, expected int) {
+	t.Helper()
+
+	status, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	}
+	if status.Version != expected {
+		t.Errorf("expected version %d, got %d", expected, status.Version)
+	}
+}
+
+func assertColumn(t *testing.T, db *memory.Client, column string, expected bool) {
+	t.Helper()
+
+	var count int
+	err := db.MustDB().QueryRow("SELECT count(*) FROM pragma_table_info('tasks') WHERE name = ?", column).Scan(&count)
+	if err != nil {
+		t.Fatalf("failed to inspect tasks table: %v", err)
+	}
+	if (count > 0) != expected {
+		t.Errorf("expected column %s to exist: %v", column, expected)
+	}
+}
+
+func names(migrations []Migration) []string {
+	var result []string
+	for _, migration := range migrations {
+		result = append(result, migration.String())
+	}
+	return result
+}
+
+// newTestDatabase returns an empty database. Unlike test.NewDatabase it is not migrated,
+// the tests of the migrator do that themselves.
+func newTestDatabase(t *testing.T) *memory.Client {
+	t.Helper()
+
+	db, err := memory.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", uuid.NewString()))
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
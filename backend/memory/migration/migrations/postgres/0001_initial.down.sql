@@ -0,0 +1,20 @@
+-- drop "messages" table
+DROP TABLE "messages";
+-- drop "notification_sinks" table
+DROP TABLE "notification_sinks";
+-- drop "schedule_runs" table
+DROP TABLE "schedule_runs";
+-- drop "schedules" table
+DROP TABLE "schedules";
+-- drop "webhook_deliveries" table
+DROP TABLE "webhook_deliveries";
+-- drop "tasks" table
+DROP TABLE "tasks";
+-- drop "webhook_triggers" table
+DROP TABLE "webhook_triggers";
+-- drop "agents" table
+DROP TABLE "agents";
+-- drop "models" table
+DROP TABLE "models";
+-- drop "model_providers" table
+DROP TABLE "model_providers";
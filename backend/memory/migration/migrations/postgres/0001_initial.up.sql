@@ -0,0 +1,46 @@
+-- create "model_providers" table
+CREATE TABLE "model_providers" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "provider_type" character varying NOT NULL, "url" character varying NULL, "secret" bytea NOT NULL, "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+-- create "models" table
+CREATE TABLE "models" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "context_window" bigint NOT NULL, "capabilities" jsonb NULL, "input_cost" double precision NOT NULL DEFAULT 0, "output_cost" double precision NOT NULL DEFAULT 0, "cache_write_cost" double precision NOT NULL DEFAULT 0, "cache_read_cost" double precision NOT NULL DEFAULT 0, "enabled" boolean NOT NULL DEFAULT true, "alias" character varying NULL, "model_provider_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "models_model_providers_models" FOREIGN KEY ("model_provider_id") REFERENCES "model_providers" ("id") ON DELETE NO ACTION);
+-- create index "model_name_model_provider_id" to table: "models"
+CREATE UNIQUE INDEX "model_name_model_provider_id" ON "models" ("name", "model_provider_id");
+-- create "agents" table
+CREATE TABLE "agents" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "instructions" character varying NOT NULL, "builtin" boolean NOT NULL DEFAULT false, "model_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "agents_models_model" FOREIGN KEY ("model_id") REFERENCES "models" ("id") ON DELETE SET NULL);
+-- create index "agent_name" to table: "agents"
+CREATE UNIQUE INDEX "agent_name" ON "agents" ("name");
+-- create "tasks" table
+CREATE TABLE "tasks" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "project_directory" character varying NULL, "input_tokens" bigint NULL, "output_tokens" bigint NULL, "cache_write_tokens" bigint NULL, "cache_read_tokens" bigint NULL, "cost" double precision NULL, "turns" bigint NOT NULL DEFAULT 0, "tool_uses" jsonb NOT NULL, "desired_phase" character varying NOT NULL DEFAULT 'running', "phase" character varying NOT NULL DEFAULT 'awaiting', "description" character varying NULL, "agent_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "tasks_agents_agent" FOREIGN KEY ("agent_id") REFERENCES "agents" ("id") ON DELETE SET NULL);
+-- create index "task_create_time" to table: "tasks"
+CREATE INDEX "task_create_time" ON "tasks" ("create_time");
+-- create index "task_update_time" to table: "tasks"
+CREATE INDEX "task_update_time" ON "tasks" ("update_time");
+-- create "messages" table
+CREATE TABLE "messages" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "source" character varying NOT NULL, "content" jsonb NOT NULL, "usage" jsonb NULL, "processed_time" timestamptz NULL, "task_id" uuid NOT NULL, "agent_id" uuid NULL, "model_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "messages_tasks_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON DELETE CASCADE, CONSTRAINT "messages_agents_agent" FOREIGN KEY ("agent_id") REFERENCES "agents" ("id") ON DELETE SET NULL, CONSTRAINT "messages_models_model" FOREIGN KEY ("model_id") REFERENCES "models" ("id") ON DELETE SET NULL, CONSTRAINT "agent_model" CHECK (agent_id IS NULL OR agent_id IS NOT NULL AND model_id IS NOT NULL));
+-- create index "message_create_time" to table: "messages"
+CREATE INDEX "message_create_time" ON "messages" ("create_time");
+-- create index "message_update_time" to table: "messages"
+CREATE INDEX "message_update_time" ON "messages" ("update_time");
+-- create index "message_task_id" to table: "messages"
+CREATE INDEX "message_task_id" ON "messages" ("task_id");
+-- create "notification_sinks" table
+CREATE TABLE "notification_sinks" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "kind" character varying NOT NULL, "events" jsonb NOT NULL, "budget" double precision NULL, "url" character varying NULL, "email" jsonb NULL, "secret" bytea NULL, "title_template" character varying NULL, "body_template" character varying NULL, "enabled" boolean NOT NULL DEFAULT true, "agent_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "notification_sinks_agents_agent" FOREIGN KEY ("agent_id") REFERENCES "agents" ("id") ON DELETE CASCADE);
+-- create index "notificationsink_name" to table: "notification_sinks"
+CREATE UNIQUE INDEX "notificationsink_name" ON "notification_sinks" ("name");
+-- create "schedules" table
+CREATE TABLE "schedules" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "cron_expression" character varying NOT NULL, "prompt_template" character varying NOT NULL, "workspace" character varying NULL, "overlap_policy" character varying NOT NULL DEFAULT 'skip', "catch_up_policy" character varying NOT NULL DEFAULT 'run_once', "enabled" boolean NOT NULL DEFAULT true, "last_run_time" timestamptz NULL, "next_run_time" timestamptz NULL, "agent_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "schedules_agents_agent" FOREIGN KEY ("agent_id") REFERENCES "agents" ("id") ON DELETE CASCADE);
+-- create index "schedule_name" to table: "schedules"
+CREATE UNIQUE INDEX "schedule_name" ON "schedules" ("name");
+-- create index "schedule_next_run_time" to table: "schedules"
+CREATE INDEX "schedule_next_run_time" ON "schedules" ("next_run_time");
+-- create "schedule_runs" table
+CREATE TABLE "schedule_runs" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "trigger" character varying NOT NULL, "status" character varying NOT NULL, "scheduled_time" timestamptz NOT NULL, "error" character varying NULL, "schedule_id" uuid NOT NULL, "task_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "schedule_runs_schedules_schedule" FOREIGN KEY ("schedule_id") REFERENCES "schedules" ("id") ON DELETE CASCADE, CONSTRAINT "schedule_runs_tasks_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON DELETE SET NULL);
+-- create index "schedulerun_schedule_id_scheduled_time" to table: "schedule_runs"
+CREATE INDEX "schedulerun_schedule_id_scheduled_time" ON "schedule_runs" ("schedule_id", "scheduled_time");
+-- create "webhook_triggers" table
+CREATE TABLE "webhook_triggers" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "target" character varying NOT NULL, "prompt_template" character varying NOT NULL, "task_id_template" character varying NULL, "workspace" character varying NULL, "secret" bytea NOT NULL, "signature_header" character varying NOT NULL, "enabled" boolean NOT NULL DEFAULT true, "agent_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_triggers_agents_agent" FOREIGN KEY ("agent_id") REFERENCES "agents" ("id") ON DELETE CASCADE);
+-- create index "webhooktrigger_name" to table: "webhook_triggers"
+CREATE UNIQUE INDEX "webhooktrigger_name" ON "webhook_triggers" ("name");
+-- create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "status" character varying NOT NULL, "status_code" bigint NOT NULL, "error" character varying NULL, "payload" bytea NULL, "trigger_id" uuid NOT NULL, "task_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_webhook_triggers_trigger" FOREIGN KEY ("trigger_id") REFERENCES "webhook_triggers" ("id") ON DELETE CASCADE, CONSTRAINT "webhook_deliveries_tasks_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON DELETE SET NULL);
+-- create index "webhookdelivery_trigger_id_create_time" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_trigger_id_create_time" ON "webhook_deliveries" ("trigger_id", "create_time");
@@ -0,0 +1,2 @@
+-- modify "tasks" table
+ALTER TABLE "tasks" DROP COLUMN "lease_owner", DROP COLUMN "lease_expire_time";
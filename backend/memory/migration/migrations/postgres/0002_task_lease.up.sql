@@ -0,0 +1,2 @@
+-- modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "lease_owner" character varying NULL, ADD COLUMN "lease_expire_time" timestamptz NULL;
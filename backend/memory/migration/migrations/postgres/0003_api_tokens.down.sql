@@ -0,0 +1,2 @@
+-- drop "tokens" table
+DROP TABLE "tokens";
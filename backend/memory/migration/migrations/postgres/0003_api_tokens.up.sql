@@ -0,0 +1,6 @@
+-- create "tokens" table
+CREATE TABLE "tokens" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "hash" bytea NOT NULL, "scope" character varying NOT NULL, "expire_time" timestamptz NULL, "last_used_time" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "token_name" to table: "tokens"
+CREATE UNIQUE INDEX "token_name" ON "tokens" ("name");
+-- create index "token_hash" to table: "tokens"
+CREATE UNIQUE INDEX "token_hash" ON "tokens" ("hash");
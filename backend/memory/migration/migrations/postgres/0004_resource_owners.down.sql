@@ -0,0 +1,8 @@
+-- modify "agents" table
+ALTER TABLE "agents" DROP COLUMN "owner", DROP COLUMN "team";
+-- modify "model_providers" table
+ALTER TABLE "model_providers" DROP COLUMN "owner", DROP COLUMN "team";
+-- modify "tasks" table
+ALTER TABLE "tasks" DROP COLUMN "owner", DROP COLUMN "team";
+-- modify "tokens" table
+ALTER TABLE "tokens" DROP COLUMN "user", DROP COLUMN "team";
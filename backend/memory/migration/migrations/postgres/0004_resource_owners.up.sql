@@ -0,0 +1,14 @@
+-- modify "agents" table
+ALTER TABLE "agents" ADD COLUMN "owner" character varying NULL, ADD COLUMN "team" character varying NULL;
+-- create index "agent_owner" to table: "agents"
+CREATE INDEX "agent_owner" ON "agents" ("owner");
+-- modify "model_providers" table
+ALTER TABLE "model_providers" ADD COLUMN "owner" character varying NULL, ADD COLUMN "team" character varying NULL;
+-- create index "modelprovider_owner" to table: "model_providers"
+CREATE INDEX "modelprovider_owner" ON "model_providers" ("owner");
+-- modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "owner" character varying NULL, ADD COLUMN "team" character varying NULL;
+-- create index "task_owner" to table: "tasks"
+CREATE INDEX "task_owner" ON "tasks" ("owner");
+-- modify "tokens" table
+ALTER TABLE "tokens" ADD COLUMN "user" character varying NULL, ADD COLUMN "team" character varying NULL;
@@ -0,0 +1,4 @@
+-- drop "audit_events" table
+DROP TABLE "audit_events";
+-- drop "audit_events_append_only" function
+DROP FUNCTION "audit_events_append_only";
@@ -0,0 +1,11 @@
+-- create "audit_events" table
+CREATE TABLE "audit_events" ("id" uuid NOT NULL, "sequence" bigint NOT NULL, "time" timestamptz NOT NULL, "kind" character varying NOT NULL, "actor" character varying NULL, "action" character varying NOT NULL, "resource" character varying NULL, "task_id" uuid NULL, "workspace" character varying NULL, "command" character varying NULL, "path" character varying NULL, "diff_hash" character varying NULL, "exit_code" bigint NULL, "duration_ms" bigint NOT NULL, "error" character varying NULL, "previous_hash" bytea NULL, "hash" bytea NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditevent_sequence" to table: "audit_events"
+CREATE UNIQUE INDEX "auditevent_sequence" ON "audit_events" ("sequence");
+-- create index "auditevent_actor_sequence" to table: "audit_events"
+CREATE INDEX "auditevent_actor_sequence" ON "audit_events" ("actor", "sequence");
+-- create index "auditevent_task_id" to table: "audit_events"
+CREATE INDEX "auditevent_task_id" ON "audit_events" ("task_id");
+-- reject changes to "audit_events", the log is append-only
+CREATE FUNCTION "audit_events_append_only"() RETURNS trigger LANGUAGE plpgsql AS $$ BEGIN RAISE EXCEPTION 'audit events are append-only'; END; $$;
+CREATE TRIGGER "audit_events_no_change" BEFORE UPDATE OR DELETE ON "audit_events" FOR EACH ROW EXECUTE FUNCTION "audit_events_append_only"();
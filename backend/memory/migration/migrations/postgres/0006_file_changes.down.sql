@@ -0,0 +1,4 @@
+-- modify "tasks" table
+ALTER TABLE "tasks" DROP COLUMN "review_edits";
+-- drop "file_changes" table
+DROP TABLE "file_changes";
@@ -0,0 +1,6 @@
+-- modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "review_edits" boolean NOT NULL DEFAULT false;
+-- create "file_changes" table
+CREATE TABLE "file_changes" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "path" character varying NOT NULL, "original_content" text NULL, "content" text NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "reason" character varying NULL, "task_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "file_changes_tasks_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON DELETE CASCADE);
+-- create index "filechange_task_id_status" to table: "file_changes"
+CREATE INDEX "filechange_task_id_status" ON "file_changes" ("task_id", "status");
@@ -0,0 +1,2 @@
+-- modify "tasks" table
+ALTER TABLE "tasks" DROP COLUMN "forked_from_message_id", DROP COLUMN "forked_from_id";
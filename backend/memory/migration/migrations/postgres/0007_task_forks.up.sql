@@ -0,0 +1,2 @@
+-- modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "forked_from_message_id" uuid NULL, ADD COLUMN "forked_from_id" uuid NULL, ADD CONSTRAINT "tasks_tasks_forks" FOREIGN KEY ("forked_from_id") REFERENCES "tasks" ("id") ON DELETE SET NULL;
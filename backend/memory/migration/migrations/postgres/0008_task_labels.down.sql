@@ -0,0 +1,2 @@
+-- modify "tasks" table
+ALTER TABLE "tasks" DROP COLUMN "labels";
@@ -0,0 +1,2 @@
+-- modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "labels" jsonb NULL;
@@ -0,0 +1,2 @@
+-- modify "agents" table
+ALTER TABLE "agents" ADD COLUMN "tools" jsonb NULL;
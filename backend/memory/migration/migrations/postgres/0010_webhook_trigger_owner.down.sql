@@ -0,0 +1,2 @@
+-- modify "webhook_triggers" table
+ALTER TABLE "webhook_triggers" DROP COLUMN "owner", DROP COLUMN "team";
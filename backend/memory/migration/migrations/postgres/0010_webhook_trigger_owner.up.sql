@@ -0,0 +1,4 @@
+-- modify "webhook_triggers" table
+ALTER TABLE "webhook_triggers" ADD COLUMN "owner" character varying NULL, ADD COLUMN "team" character varying NULL;
+-- create index "webhooktrigger_owner" to table: "webhook_triggers"
+CREATE INDEX "webhooktrigger_owner" ON "webhook_triggers" ("owner");
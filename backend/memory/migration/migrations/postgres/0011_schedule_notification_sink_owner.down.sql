@@ -0,0 +1,4 @@
+-- modify "notification_sinks" table
+ALTER TABLE "notification_sinks" DROP COLUMN "owner", DROP COLUMN "team";
+-- modify "schedules" table
+ALTER TABLE "schedules" DROP COLUMN "owner", DROP COLUMN "team";
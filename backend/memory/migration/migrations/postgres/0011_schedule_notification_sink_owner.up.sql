@@ -0,0 +1,8 @@
+-- modify "notification_sinks" table
+ALTER TABLE "notification_sinks" ADD COLUMN "owner" character varying NULL, ADD COLUMN "team" character varying NULL;
+-- create index "notificationsink_owner" to table: "notification_sinks"
+CREATE INDEX "notificationsink_owner" ON "notification_sinks" ("owner");
+-- modify "schedules" table
+ALTER TABLE "schedules" ADD COLUMN "owner" character varying NULL, ADD COLUMN "team" character varying NULL;
+-- create index "schedule_owner" to table: "schedules"
+CREATE INDEX "schedule_owner" ON "schedules" ("owner");
@@ -0,0 +1,930 @@
+table "agents" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "owner" {
+    null = true
+    type = varchar
+  }
+  column "team" {
+    null = true
+    type = varchar
+  }
+  column "name" {
+    null = false
+    type = varchar
+  }
+  column "description" {
+    null = true
+    type = varchar
+  }
+  column "instructions" {
+    null = false
+    type = varchar
+  }
+  column "builtin" {
+    null    = false
+    type    = boolean
+    default = sql("false")
+  }
+  column "tools" {
+    null = true
+    type = jsonb
+  }
+  column "model_id" {
+    null = true
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "agents_models_model" {
+    columns     = [column.model_id]
+    ref_columns = [table.models.column.id]
+    on_delete   = SET_NULL
+  }
+  index "agent_owner" {
+    columns = [column.owner]
+  }
+  index "agent_name" {
+    unique  = true
+    columns = [column.name]
+  }
+}
+table "audit_events" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "sequence" {
+    null = false
+    type = bigint
+  }
+  column "time" {
+    null = false
+    type = timestamptz
+  }
+  column "kind" {
+    null = false
+    type = varchar
+  }
+  column "actor" {
+    null = true
+    type = varchar
+  }
+  column "action" {
+    null = false
+    type = varchar
+  }
+  column "resource" {
+    null = true
+    type = varchar
+  }
+  column "task_id" {
+    null = true
+    type = uuid
+  }
+  column "workspace" {
+    null = true
+    type = varchar
+  }
+  column "command" {
+    null = true
+    type = varchar
+  }
+  column "path" {
+    null = true
+    type = varchar
+  }
+  column "diff_hash" {
+    null = true
+    type = varchar
+  }
+  column "exit_code" {
+    null = true
+    type = bigint
+  }
+  column "duration_ms" {
+    null = false
+    type = bigint
+  }
+  column "error" {
+    null = true
+    type = varchar
+  }
+  column "previous_hash" {
+    null = true
+    type = bytea
+  }
+  column "hash" {
+    null = false
+    type = bytea
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "auditevent_sequence" {
+    unique  = true
+    columns = [column.sequence]
+  }
+  index "auditevent_actor_sequence" {
+    columns = [column.actor, column.sequence]
+  }
+  index "auditevent_task_id" {
+    columns = [column.task_id]
+  }
+}
+table "file_changes" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "path" {
+    null = false
+    type = varchar
+  }
+  column "original_content" {
+    null = true
+    type = text
+  }
+  column "content" {
+    null = false
+    type = text
+  }
+  column "status" {
+    null    = false
+    type    = varchar
+    default = sql("'pending'")
+  }
+  column "reason" {
+    null = true
+    type = varchar
+  }
+  column "task_id" {
+    null = false
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "file_changes_tasks_task" {
+    columns     = [column.task_id]
+    ref_columns = [table.tasks.column.id]
+    on_delete   = CASCADE
+  }
+  index "filechange_task_id_status" {
+    columns = [column.task_id, column.status]
+  }
+}
+table "messages" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "source" {
+    null = false
+    type = varchar
+  }
+  column "content" {
+    null = false
+    type = jsonb
+  }
+  column "usage" {
+    null = true
+    type = jsonb
+  }
+  column "processed_time" {
+    null = true
+    type = timestamptz
+  }
+  column "task_id" {
+    null = false
+    type = uuid
+  }
+  column "agent_id" {
+    null = true
+    type = uuid
+  }
+  column "model_id" {
+    null = true
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "messages_tasks_task" {
+    columns     = [column.task_id]
+    ref_columns = [table.tasks.column.id]
+    on_delete   = CASCADE
+  }
+  foreign_key "messages_agents_agent" {
+    columns     = [column.agent_id]
+    ref_columns = [table.agents.column.id]
+    on_delete   = SET_NULL
+  }
+  foreign_key "messages_models_model" {
+    columns     = [column.model_id]
+    ref_columns = [table.models.column.id]
+    on_delete   = SET_NULL
+  }
+  index "message_create_time" {
+    columns = [column.create_time]
+  }
+  index "message_update_time" {
+    columns = [column.update_time]
+  }
+  index "message_task_id" {
+    columns = [column.task_id]
+  }
+  check "agent_model" {
+    expr = "(agent_id IS NULL OR agent_id IS NOT NULL AND model_id IS NOT NULL)"
+  }
+}
+table "models" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "name" {
+    null = false
+    type = varchar
+  }
+  column "context_window" {
+    null = false
+    type = bigint
+  }
+  column "capabilities" {
+    null = true
+    type = jsonb
+  }
+  column "input_cost" {
+    null    = false
+    type    = double_precision
+    default = sql("0")
+  }
+  column "output_cost" {
+    null    = false
+    type    = double_precision
+    default = sql("0")
+  }
+  column "cache_write_cost" {
+    null    = false
+    type    = double_precision
+    default = sql("0")
+  }
+  column "cache_read_cost" {
+    null    = false
+    type    = double_precision
+    default = sql("0")
+  }
+  column "enabled" {
+    null    = false
+    type    = boolean
+    default = sql("true")
+  }
+  column "alias" {
+    null = true
+    type = varchar
+  }
+  column "model_provider_id" {
+    null = false
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "models_model_providers_models" {
+    columns     = [column.model_provider_id]
+    ref_columns = [table.model_providers.column.id]
+    on_delete   = NO_ACTION
+  }
+  index "model_name_model_provider_id" {
+    unique  = true
+    columns = [column.name, column.model_provider_id]
+  }
+}
+table "model_providers" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "owner" {
+    null = true
+    type = varchar
+  }
+  column "team" {
+    null = true
+    type = varchar
+  }
+  column "name" {
+    null = false
+    type = varchar
+  }
+  column "provider_type" {
+    null = false
+    type = varchar
+  }
+  column "url" {
+    null = true
+    type = varchar
+  }
+  column "secret" {
+    null = false
+    type = bytea
+  }
+  column "enabled" {
+    null    = false
+    type    = boolean
+    default = sql("true")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "modelprovider_owner" {
+    columns = [column.owner]
+  }
+}
+table "notification_sinks" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "owner" {
+    null = true
+    type = varchar
+  }
+  column "team" {
+    null = true
+    type = varchar
+  }
+  column "name" {
+    null = false
+    type = varchar
+  }
+  column "description" {
+    null = true
+    type = varchar
+  }
+  column "kind" {
+    null = false
+    type = varchar
+  }
+  column "events" {
+    null = false
+    type = jsonb
+  }
+  column "budget" {
+    null = true
+    type = double_precision
+  }
+  column "url" {
+    null = true
+    type = varchar
+  }
+  column "email" {
+    null = true
+    type = jsonb
+  }
+  column "secret" {
+    null = true
+    type = bytea
+  }
+  column "title_template" {
+    null = true
+    type = varchar
+  }
+  column "body_template" {
+    null = true
+    type = varchar
+  }
+  column "enabled" {
+    null    = false
+    type    = boolean
+    default = sql("true")
+  }
+  column "agent_id" {
+    null = true
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "notification_sinks_agents_agent" {
+    columns     = [column.agent_id]
+    ref_columns = [table.agents.column.id]
+    on_delete   = CASCADE
+  }
+  index "notificationsink_owner" {
+    columns = [column.owner]
+  }
+  index "notificationsink_name" {
+    unique  = true
+    columns = [column.name]
+  }
+}
+table "schedules" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "owner" {
+    null = true
+    type = varchar
+  }
+  column "team" {
+    null = true
+    type = varchar
+  }
+  column "name" {
+    null = false
+    type = varchar
+  }
+  column "cron_expression" {
+    null = false
+    type = varchar
+  }
+  column "prompt_template" {
+    null = false
+    type = varchar
+  }
+  column "workspace" {
+    null = true
+    type = varchar
+  }
+  column "overlap_policy" {
+    null    = false
+    type    = varchar
+    default = sql("'skip'")
+  }
+  column "catch_up_policy" {
+    null    = false
+    type    = varchar
+    default = sql("'run_once'")
+  }
+  column "enabled" {
+    null    = false
+    type    = boolean
+    default = sql("true")
+  }
+  column "last_run_time" {
+    null = true
+    type = timestamptz
+  }
+  column "next_run_time" {
+    null = true
+    type = timestamptz
+  }
+  column "agent_id" {
+    null = false
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "schedules_agents_agent" {
+    columns     = [column.agent_id]
+    ref_columns = [table.agents.column.id]
+    on_delete   = CASCADE
+  }
+  index "schedule_owner" {
+    columns = [column.owner]
+  }
+  index "schedule_name" {
+    unique  = true
+    columns = [column.name]
+  }
+  index "schedule_next_run_time" {
+    columns = [column.next_run_time]
+  }
+}
+table "schedule_runs" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "trigger" {
+    null = false
+    type = varchar
+  }
+  column "status" {
+    null = false
+    type = varchar
+  }
+  column "scheduled_time" {
+    null = false
+    type = timestamptz
+  }
+  column "error" {
+    null = true
+    type = varchar
+  }
+  column "schedule_id" {
+    null = false
+    type = uuid
+  }
+  column "task_id" {
+    null = true
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "schedule_runs_schedules_schedule" {
+    columns     = [column.schedule_id]
+    ref_columns = [table.schedules.column.id]
+    on_delete   = CASCADE
+  }
+  foreign_key "schedule_runs_tasks_task" {
+    columns     = [column.task_id]
+    ref_columns = [table.tasks.column.id]
+    on_delete   = SET_NULL
+  }
+  index "schedulerun_schedule_id_scheduled_time" {
+    columns = [column.schedule_id, column.scheduled_time]
+  }
+}
+table "tasks" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "owner" {
+    null = true
+    type = varchar
+  }
+  column "team" {
+    null = true
+    type = varchar
+  }
+  column "project_directory" {
+    null = true
+    type = varchar
+  }
+  column "input_tokens" {
+    null = true
+    type = bigint
+  }
+  column "output_tokens" {
+    null = true
+    type = bigint
+  }
+  column "cache_write_tokens" {
+    null = true
+    type = bigint
+  }
+  column "cache_read_tokens" {
+    null = true
+    type = bigint
+  }
+  column "cost" {
+    null = true
+    type = double_precision
+  }
+  column "turns" {
+    null    = false
+    type    = bigint
+    default = sql("0")
+  }
+  column "tool_uses" {
+    null = false
+    type = jsonb
+  }
+  column "desired_phase" {
+    null    = false
+    type    = varchar
+    default = sql("'running'")
+  }
+  column "phase" {
+    null    = false
+    type    = varchar
+    default = sql("'awaiting'")
+  }
+  column "description" {
+    null = true
+    type = varchar
+  }
+  column "review_edits" {
+    null    = false
+    type    = boolean
+    default = sql("false")
+  }
+  column "labels" {
+    null = true
+    type = jsonb
+  }
+  column "forked_from_message_id" {
+    null = true
+    type = uuid
+  }
+  column "lease_owner" {
+    null = true
+    type = varchar
+  }
+  column "lease_expire_time" {
+    null = true
+    type = timestamptz
+  }
+  column "agent_id" {
+    null = true
+    type = uuid
+  }
+  column "forked_from_id" {
+    null = true
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tasks_agents_agent" {
+    columns     = [column.agent_id]
+    ref_columns = [table.agents.column.id]
+    on_delete   = SET_NULL
+  }
+  foreign_key "tasks_tasks_forks" {
+    columns     = [column.forked_from_id]
+    ref_columns = [column.id]
+    on_delete   = SET_NULL
+  }
+  index "task_owner" {
+    columns = [column.owner]
+  }
+  index "task_create_time" {
+    columns = [column.create_time]
+  }
+  index "task_update_time" {
+    columns = [column.update_time]
+  }
+}
+table "tokens" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "name" {
+    null = false
+    type = varchar
+  }
+  column "hash" {
+    null = false
+    type = bytea
+  }
+  column "scope" {
+    null = false
+    type = varchar
+  }
+  column "user" {
+    null = true
+    type = varchar
+  }
+  column "team" {
+    null = true
+    type = varchar
+  }
+  column "expire_time" {
+    null = true
+    type = timestamptz
+  }
+  column "last_used_time" {
+    null = true
+    type = timestamptz
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "token_name" {
+    unique  = true
+    columns = [column.name]
+  }
+  index "token_hash" {
+    unique  = true
+    columns = [column.hash]
+  }
+}
+table "webhook_deliveries" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "status" {
+    null = false
+    type = varchar
+  }
+  column "status_code" {
+    null = false
+    type = bigint
+  }
+  column "error" {
+    null = true
+    type = varchar
+  }
+  column "payload" {
+    null = true
+    type = bytea
+  }
+  column "trigger_id" {
+    null = false
+    type = uuid
+  }
+  column "task_id" {
+    null = true
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "webhook_deliveries_webhook_triggers_trigger" {
+    columns     = [column.trigger_id]
+    ref_columns = [table.webhook_triggers.column.id]
+    on_delete   = CASCADE
+  }
+  foreign_key "webhook_deliveries_tasks_task" {
+    columns     = [column.task_id]
+    ref_columns = [table.tasks.column.id]
+    on_delete   = SET_NULL
+  }
+  index "webhookdelivery_trigger_id_create_time" {
+    columns = [column.trigger_id, column.create_time]
+  }
+}
+table "webhook_triggers" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "create_time" {
+    null = false
+    type = timestamptz
+  }
+  column "update_time" {
+    null = false
+    type = timestamptz
+  }
+  column "owner" {
+    null = true
+    type = varchar
+  }
+  column "team" {
+    null = true
+    type = varchar
+  }
+  column "name" {
+    null = false
+    type = varchar
+  }
+  column "description" {
+    null = true
+    type = varchar
+  }
+  column "target" {
+    null = false
+    type = varchar
+  }
+  column "prompt_template" {
+    null = false
+    type = varchar
+  }
+  column "task_id_template" {
+    null = true
+    type = varchar
+  }
+  column "workspace" {
+    null = true
+    type = varchar
+  }
+  column "secret" {
+    null = false
+    type = bytea
+  }
+  column "signature_header" {
+    null = false
+    type = varchar
+  }
+  column "enabled" {
+    null    = false
+    type    = boolean
+    default = sql("true")
+  }
+  column "agent_id" {
+    null = true
+    type = uuid
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "webhook_triggers_agents_agent" {
+    columns     = [column.agent_id]
+    ref_columns = [table.agents.column.id]
+    on_delete   = CASCADE
+  }
+  index "webhooktrigger_owner" {
+    columns = [column.owner]
+  }
+  index "webhooktrigger_name" {
+    unique  = true
+    columns = [column.name]
+  }
+}
+schema "public" {
+}
@@ -0,0 +1,24 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "agents" table
+DROP TABLE `agents`;
+-- drop "messages" table
+DROP TABLE `messages`;
+-- drop "models" table
+DROP TABLE `models`;
+-- drop "model_providers" table
+DROP TABLE `model_providers`;
+-- drop "notification_sinks" table
+DROP TABLE `notification_sinks`;
+-- drop "schedules" table
+DROP TABLE `schedules`;
+-- drop "schedule_runs" table
+DROP TABLE `schedule_runs`;
+-- drop "tasks" table
+DROP TABLE `tasks`;
+-- drop "webhook_deliveries" table
+DROP TABLE `webhook_deliveries`;
+-- drop "webhook_triggers" table
+DROP TABLE `webhook_triggers`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
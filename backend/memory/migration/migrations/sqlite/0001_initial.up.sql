@@ -0,0 +1,46 @@
+-- create "agents" table
+CREATE TABLE `agents` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `instructions` text NOT NULL, `builtin` bool NOT NULL DEFAULT false, `model_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `agents_models_model` FOREIGN KEY (`model_id`) REFERENCES `models` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL);
+-- create index "agent_name" to table: "agents"
+CREATE UNIQUE INDEX `agent_name` ON `agents` (`name`);
+-- create "messages" table
+CREATE TABLE `messages` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `source` text NOT NULL, `content` json NOT NULL, `usage` json NULL, `processed_time` datetime NULL, `task_id` uuid NOT NULL, `agent_id` uuid NULL, `model_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `messages_models_model` FOREIGN KEY (`model_id`) REFERENCES `models` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT `messages_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT `messages_tasks_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `agent_model` CHECK (agent_id IS NULL OR agent_id IS NOT NULL AND model_id IS NOT NULL));
+-- create index "message_create_time" to table: "messages"
+CREATE INDEX `message_create_time` ON `messages` (`create_time`);
+-- create index "message_update_time" to table: "messages"
+CREATE INDEX `message_update_time` ON `messages` (`update_time`);
+-- create index "message_task_id" to table: "messages"
+CREATE INDEX `message_task_id` ON `messages` (`task_id`);
+-- create "models" table
+CREATE TABLE `models` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `context_window` integer NOT NULL, `capabilities` json NULL, `input_cost` real NOT NULL DEFAULT 0, `output_cost` real NOT NULL DEFAULT 0, `cache_write_cost` real NOT NULL DEFAULT 0, `cache_read_cost` real NOT NULL DEFAULT 0, `enabled` bool NOT NULL DEFAULT true, `alias` text NULL, `model_provider_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `models_model_providers_models` FOREIGN KEY (`model_provider_id`) REFERENCES `model_providers` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "model_name_model_provider_id" to table: "models"
+CREATE UNIQUE INDEX `model_name_model_provider_id` ON `models` (`name`, `model_provider_id`);
+-- create "model_providers" table
+CREATE TABLE `model_providers` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `provider_type` text NOT NULL, `url` text NULL, `secret` blob NOT NULL, `enabled` bool NOT NULL DEFAULT true, PRIMARY KEY (`id`));
+-- create "notification_sinks" table
+CREATE TABLE `notification_sinks` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `kind` text NOT NULL, `events` json NOT NULL, `budget` real NULL, `url` text NULL, `email` json NULL, `secret` blob NULL, `title_template` text NULL, `body_template` text NULL, `enabled` bool NOT NULL DEFAULT true, `agent_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `notification_sinks_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "notificationsink_name" to table: "notification_sinks"
+CREATE UNIQUE INDEX `notificationsink_name` ON `notification_sinks` (`name`);
+-- create "schedules" table
+CREATE TABLE `schedules` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `cron_expression` text NOT NULL, `prompt_template` text NOT NULL, `workspace` text NULL, `overlap_policy` text NOT NULL DEFAULT 'skip', `catch_up_policy` text NOT NULL DEFAULT 'run_once', `enabled` bool NOT NULL DEFAULT true, `last_run_time` datetime NULL, `next_run_time` datetime NULL, `agent_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `schedules_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "schedule_name" to table: "schedules"
+CREATE UNIQUE INDEX `schedule_name` ON `schedules` (`name`);
+-- create index "schedule_next_run_time" to table: "schedules"
+CREATE INDEX `schedule_next_run_time` ON `schedules` (`next_run_time`);
+-- create "schedule_runs" table
+CREATE TABLE `schedule_runs` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `trigger` text NOT NULL, `status` text NOT NULL, `scheduled_time` datetime NOT NULL, `error` text NULL, `schedule_id` uuid NOT NULL, `task_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `schedule_runs_tasks_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT `schedule_runs_schedules_schedule` FOREIGN KEY (`schedule_id`) REFERENCES `schedules` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "schedulerun_schedule_id_scheduled_time" to table: "schedule_runs"
+CREATE INDEX `schedulerun_schedule_id_scheduled_time` ON `schedule_runs` (`schedule_id`, `scheduled_time`);
+-- create "tasks" table
+CREATE TABLE `tasks` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `project_directory` text NULL, `input_tokens` integer NULL, `output_tokens` integer NULL, `cache_write_tokens` integer NULL, `cache_read_tokens` integer NULL, `cost` real NULL, `turns` integer NOT NULL DEFAULT 0, `tool_uses` json NOT NULL, `desired_phase` text NOT NULL DEFAULT 'running', `phase` text NOT NULL DEFAULT 'awaiting', `description` text NULL, `agent_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `tasks_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL);
+-- create index "task_create_time" to table: "tasks"
+CREATE INDEX `task_create_time` ON `tasks` (`create_time`);
+-- create index "task_update_time" to table: "tasks"
+CREATE INDEX `task_update_time` ON `tasks` (`update_time`);
+-- create "webhook_deliveries" table
+CREATE TABLE `webhook_deliveries` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `status` text NOT NULL, `status_code` integer NOT NULL, `error` text NULL, `payload` blob NULL, `trigger_id` uuid NOT NULL, `task_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `webhook_deliveries_tasks_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT `webhook_deliveries_webhook_triggers_trigger` FOREIGN KEY (`trigger_id`) REFERENCES `webhook_triggers` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "webhookdelivery_trigger_id_create_time" to table: "webhook_deliveries"
+CREATE INDEX `webhookdelivery_trigger_id_create_time` ON `webhook_deliveries` (`trigger_id`, `create_time`);
+-- create "webhook_triggers" table
+CREATE TABLE `webhook_triggers` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `target` text NOT NULL, `prompt_template` text NOT NULL, `task_id_template` text NULL, `workspace` text NULL, `secret` blob NOT NULL, `signature_header` text NOT NULL, `enabled` bool NOT NULL DEFAULT true, `agent_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `webhook_triggers_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "webhooktrigger_name" to table: "webhook_triggers"
+CREATE UNIQUE INDEX `webhooktrigger_name` ON `webhook_triggers` (`name`);
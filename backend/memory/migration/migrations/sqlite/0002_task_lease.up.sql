@@ -0,0 +1,4 @@
+-- add column "lease_owner" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `lease_owner` text NULL;
+-- add column "lease_expire_time" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `lease_expire_time` datetime NULL;
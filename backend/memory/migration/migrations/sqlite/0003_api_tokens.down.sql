@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "tokens" table
+DROP TABLE `tokens`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- create "tokens" table
+CREATE TABLE `tokens` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `hash` blob NOT NULL, `scope` text NOT NULL, `expire_time` datetime NULL, `last_used_time` datetime NULL, PRIMARY KEY (`id`));
+-- create index "token_name" to table: "tokens"
+CREATE UNIQUE INDEX `token_name` ON `tokens` (`name`);
+-- create index "token_hash" to table: "tokens"
+CREATE UNIQUE INDEX `token_hash` ON `tokens` (`hash`);
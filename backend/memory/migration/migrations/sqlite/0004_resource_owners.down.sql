@@ -0,0 +1,46 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_agents" table
+CREATE TABLE `new_agents` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `instructions` text NOT NULL, `builtin` bool NOT NULL DEFAULT false, `model_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `agents_models_model` FOREIGN KEY (`model_id`) REFERENCES `models` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL);
+-- copy rows from old table "agents" to new temporary table "new_agents"
+INSERT INTO `new_agents` (`id`, `create_time`, `update_time`, `name`, `description`, `instructions`, `builtin`, `model_id`) SELECT `id`, `create_time`, `update_time`, `name`, `description`, `instructions`, `builtin`, `model_id` FROM `agents`;
+-- drop "agents" table after copying rows
+DROP TABLE `agents`;
+-- rename temporary table "new_agents" to "agents"
+ALTER TABLE `new_agents` RENAME TO `agents`;
+-- create index "agent_name" to table: "agents"
+CREATE UNIQUE INDEX `agent_name` ON `agents` (`name`);
+-- create "new_model_providers" table
+CREATE TABLE `new_model_providers` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `provider_type` text NOT NULL, `url` text NULL, `secret` blob NOT NULL, `enabled` bool NOT NULL DEFAULT true, PRIMARY KEY (`id`));
+-- copy rows from old table "model_providers" to new temporary table "new_model_providers"
+INSERT INTO `new_model_providers` (`id`, `create_time`, `update_time`, `name`, `provider_type`, `url`, `secret`, `enabled`) SELECT `id`, `create_time`, `update_time`, `name`, `provider_type`, `url`, `secret`, `enabled` FROM `model_providers`;
+-- drop "model_providers" table after copying rows
+DROP TABLE `model_providers`;
+-- rename temporary table "new_model_providers" to "model_providers"
+ALTER TABLE `new_model_providers` RENAME TO `model_providers`;
+-- create "new_tasks" table
+CREATE TABLE `new_tasks` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `project_directory` text NULL, `input_tokens` integer NULL, `output_tokens` integer NULL, `cache_write_tokens` integer NULL, `cache_read_tokens` integer NULL, `cost` real NULL, `turns` integer NOT NULL DEFAULT 0, `tool_uses` json NOT NULL, `desired_phase` text NOT NULL DEFAULT 'running', `phase` text NOT NULL DEFAULT 'awaiting', `description` text NULL, `agent_id` uuid NULL, `lease_owner` text NULL, `lease_expire_time` datetime NULL, PRIMARY KEY (`id`), CONSTRAINT `tasks_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL);
+-- copy rows from old table "tasks" to new temporary table "new_tasks"
+INSERT INTO `new_tasks` (`id`, `create_time`, `update_time`, `project_directory`, `input_tokens`, `output_tokens`, `cache_write_tokens`, `cache_read_tokens`, `cost`, `turns`, `tool_uses`, `desired_phase`, `phase`, `description`, `agent_id`, `lease_owner`, `lease_expire_time`) SELECT `id`, `create_time`, `update_time`, `project_directory`, `input_tokens`, `output_tokens`, `cache_write_tokens`, `cache_read_tokens`, `cost`, `turns`, `tool_uses`, `desired_phase`, `phase`, `description`, `agent_id`, `lease_owner`, `lease_expire_time` FROM `tasks`;
+-- drop "tasks" table after copying rows
+DROP TABLE `tasks`;
+-- rename temporary table "new_tasks" to "tasks"
+ALTER TABLE `new_tasks` RENAME TO `tasks`;
+-- create index "task_create_time" to table: "tasks"
+CREATE INDEX `task_create_time` ON `tasks` (`create_time`);
+-- create index "task_update_time" to table: "tasks"
+CREATE INDEX `task_update_time` ON `tasks` (`update_time`);
+-- create "new_tokens" table
+CREATE TABLE `new_tokens` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `hash` blob NOT NULL, `scope` text NOT NULL, `expire_time` datetime NULL, `last_used_time` datetime NULL, PRIMARY KEY (`id`));
+-- copy rows from old table "tokens" to new temporary table "new_tokens"
+INSERT INTO `new_tokens` (`id`, `create_time`, `update_time`, `name`, `hash`, `scope`, `expire_time`, `last_used_time`) SELECT `id`, `create_time`, `update_time`, `name`, `hash`, `scope`, `expire_time`, `last_used_time` FROM `tokens`;
+-- drop "tokens" table after copying rows
+DROP TABLE `tokens`;
+-- rename temporary table "new_tokens" to "tokens"
+ALTER TABLE `new_tokens` RENAME TO `tokens`;
+-- create index "token_name" to table: "tokens"
+CREATE UNIQUE INDEX `token_name` ON `tokens` (`name`);
+-- create index "token_hash" to table: "tokens"
+CREATE UNIQUE INDEX `token_hash` ON `tokens` (`hash`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,22 @@
+-- add column "owner" to table: "agents"
+ALTER TABLE `agents` ADD COLUMN `owner` text NULL;
+-- add column "team" to table: "agents"
+ALTER TABLE `agents` ADD COLUMN `team` text NULL;
+-- create index "agent_owner" to table: "agents"
+CREATE INDEX `agent_owner` ON `agents` (`owner`);
+-- add column "owner" to table: "model_providers"
+ALTER TABLE `model_providers` ADD COLUMN `owner` text NULL;
+-- add column "team" to table: "model_providers"
+ALTER TABLE `model_providers` ADD COLUMN `team` text NULL;
+-- create index "modelprovider_owner" to table: "model_providers"
+CREATE INDEX `modelprovider_owner` ON `model_providers` (`owner`);
+-- add column "owner" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `owner` text NULL;
+-- add column "team" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `team` text NULL;
+-- create index "task_owner" to table: "tasks"
+CREATE INDEX `task_owner` ON `tasks` (`owner`);
+-- add column "user" to table: "tokens"
+ALTER TABLE `tokens` ADD COLUMN `user` text NULL;
+-- add column "team" to table: "tokens"
+ALTER TABLE `tokens` ADD COLUMN `team` text NULL;
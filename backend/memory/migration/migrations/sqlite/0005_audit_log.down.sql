@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "audit_events" table
+DROP TABLE `audit_events`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
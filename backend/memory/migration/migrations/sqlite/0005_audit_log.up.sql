@@ -0,0 +1,11 @@
+-- create "audit_events" table
+CREATE TABLE `audit_events` (`id` uuid NOT NULL, `sequence` integer NOT NULL, `time` datetime NOT NULL, `kind` text NOT NULL, `actor` text NULL, `action` text NOT NULL, `resource` text NULL, `task_id` uuid NULL, `workspace` text NULL, `command` text NULL, `path` text NULL, `diff_hash` text NULL, `exit_code` integer NULL, `duration_ms` integer NOT NULL, `error` text NULL, `previous_hash` blob NULL, `hash` blob NOT NULL, PRIMARY KEY (`id`));
+-- create index "auditevent_sequence" to table: "audit_events"
+CREATE UNIQUE INDEX `auditevent_sequence` ON `audit_events` (`sequence`);
+-- create index "auditevent_actor_sequence" to table: "audit_events"
+CREATE INDEX `auditevent_actor_sequence` ON `audit_events` (`actor`, `sequence`);
+-- create index "auditevent_task_id" to table: "audit_events"
+CREATE INDEX `auditevent_task_id` ON `audit_events` (`task_id`);
+-- reject changes to "audit_events", the log is append-only
+CREATE TRIGGER `audit_events_no_update` BEFORE UPDATE ON `audit_events` BEGIN SELECT RAISE(ABORT, 'audit events are append-only'); END;
+CREATE TRIGGER `audit_events_no_delete` BEFORE DELETE ON `audit_events` BEGIN SELECT RAISE(ABORT, 'audit events are append-only'); END;
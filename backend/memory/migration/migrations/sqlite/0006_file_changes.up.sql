@@ -0,0 +1,6 @@
+-- add column "review_edits" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `review_edits` bool NOT NULL DEFAULT false;
+-- create "file_changes" table
+CREATE TABLE `file_changes` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `path` text NOT NULL, `original_content` text NULL, `content` text NOT NULL, `status` text NOT NULL DEFAULT 'pending', `reason` text NULL, `task_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `file_changes_tasks_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "filechange_task_id_status" to table: "file_changes"
+CREATE INDEX `filechange_task_id_status` ON `file_changes` (`task_id`, `status`);
@@ -0,0 +1,18 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_tasks" table
+CREATE TABLE `new_tasks` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `project_directory` text NULL, `input_tokens` integer NULL, `output_tokens` integer NULL, `cache_write_tokens` integer NULL, `cache_read_tokens` integer NULL, `cost` real NULL, `turns` integer NOT NULL DEFAULT 0, `tool_uses` json NOT NULL, `desired_phase` text NOT NULL DEFAULT 'running', `phase` text NOT NULL DEFAULT 'awaiting', `description` text NULL, `agent_id` uuid NULL, `lease_owner` text NULL, `lease_expire_time` datetime NULL, `owner` text NULL, `team` text NULL, `review_edits` bool NOT NULL DEFAULT false, PRIMARY KEY (`id`), CONSTRAINT `tasks_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL);
+-- copy rows from old table "tasks" to new temporary table "new_tasks"
+INSERT INTO `new_tasks` (`id`, `create_time`, `update_time`, `project_directory`, `input_tokens`, `output_tokens`, `cache_write_tokens`, `cache_read_tokens`, `cost`, `turns`, `tool_uses`, `desired_phase`, `phase`, `description`, `agent_id`, `lease_owner`, `lease_expire_time`, `owner`, `team`, `review_edits`) SELECT `id`, `create_time`, `update_time`, `project_directory`, `input_tokens`, `output_tokens`, `cache_write_tokens`, `cache_read_tokens`, `cost`, `turns`, `tool_uses`, `desired_phase`, `phase`, `description`, `agent_id`, `lease_owner`, `lease_expire_time`, `owner`, `team`, `review_edits` FROM `tasks`;
+-- drop "tasks" table after copying rows
+DROP TABLE `tasks`;
+-- rename temporary table "new_tasks" to "tasks"
+ALTER TABLE `new_tasks` RENAME TO `tasks`;
+-- create index "task_create_time" to table: "tasks"
+CREATE INDEX `task_create_time` ON `tasks` (`create_time`);
+-- create index "task_update_time" to table: "tasks"
+CREATE INDEX `task_update_time` ON `tasks` (`update_time`);
+-- create index "task_owner" to table: "tasks"
+CREATE INDEX `task_owner` ON `tasks` (`owner`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
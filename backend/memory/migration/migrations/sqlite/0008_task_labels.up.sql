@@ -0,0 +1,2 @@
+-- add column "labels" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `labels` json NULL;
@@ -0,0 +1,16 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_agents" table
+CREATE TABLE `new_agents` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `instructions` text NOT NULL, `builtin` bool NOT NULL DEFAULT false, `model_id` uuid NULL, `owner` text NULL, `team` text NULL, PRIMARY KEY (`id`), CONSTRAINT `agents_models_model` FOREIGN KEY (`model_id`) REFERENCES `models` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL);
+-- copy rows from old table "agents" to new temporary table "new_agents"
+INSERT INTO `new_agents` (`id`, `create_time`, `update_time`, `name`, `description`, `instructions`, `builtin`, `model_id`, `owner`, `team`) SELECT `id`, `create_time`, `update_time`, `name`, `description`, `instructions`, `builtin`, `model_id`, `owner`, `team` FROM `agents`;
+-- drop "agents" table after copying rows
+DROP TABLE `agents`;
+-- rename temporary table "new_agents" to "agents"
+ALTER TABLE `new_agents` RENAME TO `agents`;
+-- create index "agent_name" to table: "agents"
+CREATE UNIQUE INDEX `agent_name` ON `agents` (`name`);
+-- create index "agent_owner" to table: "agents"
+CREATE INDEX `agent_owner` ON `agents` (`owner`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- add column "tools" to table: "agents"
+ALTER TABLE `agents` ADD COLUMN `tools` json NULL;
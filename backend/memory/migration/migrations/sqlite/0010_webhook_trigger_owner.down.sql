@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_webhook_triggers" table
+CREATE TABLE `new_webhook_triggers` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `target` text NOT NULL, `prompt_template` text NOT NULL, `task_id_template` text NULL, `workspace` text NULL, `secret` blob NOT NULL, `signature_header` text NOT NULL, `enabled` bool NOT NULL DEFAULT true, `agent_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `webhook_triggers_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- copy rows from old table "webhook_triggers" to new temporary table "new_webhook_triggers"
+INSERT INTO `new_webhook_triggers` (`id`, `create_time`, `update_time`, `name`, `description`, `target`, `prompt_template`, `task_id_template`, `workspace`, `secret`, `signature_header`, `enabled`, `agent_id`) SELECT `id`, `create_time`, `update_time`, `name`, `description`, `target`, `prompt_template`, `task_id_template`, `workspace`, `secret`, `signature_header`, `enabled`, `agent_id` FROM `webhook_triggers`;
+-- drop "webhook_triggers" table after copying rows
+DROP TABLE `webhook_triggers`;
+-- rename temporary table "new_webhook_triggers" to "webhook_triggers"
+ALTER TABLE `new_webhook_triggers` RENAME TO `webhook_triggers`;
+-- create index "webhooktrigger_name" to table: "webhook_triggers"
+CREATE UNIQUE INDEX `webhooktrigger_name` ON `webhook_triggers` (`name`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
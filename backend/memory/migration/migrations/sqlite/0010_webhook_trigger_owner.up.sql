@@ -0,0 +1,6 @@
+-- add column "owner" to table: "webhook_triggers"
+ALTER TABLE `webhook_triggers` ADD COLUMN `owner` text NULL;
+-- add column "team" to table: "webhook_triggers"
+ALTER TABLE `webhook_triggers` ADD COLUMN `team` text NULL;
+-- create index "webhooktrigger_owner" to table: "webhook_triggers"
+CREATE INDEX `webhooktrigger_owner` ON `webhook_triggers` (`owner`);
@@ -0,0 +1,26 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_notification_sinks" table
+CREATE TABLE `new_notification_sinks` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `kind` text NOT NULL, `events` json NOT NULL, `budget` real NULL, `url` text NULL, `email` json NULL, `secret` blob NULL, `title_template` text NULL, `body_template` text NULL, `enabled` bool NOT NULL DEFAULT true, `agent_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `notification_sinks_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- copy rows from old table "notification_sinks" to new temporary table "new_notification_sinks"
+INSERT INTO `new_notification_sinks` (`id`, `create_time`, `update_time`, `name`, `description`, `kind`, `events`, `budget`, `url`, `email`, `secret`, `title_template`, `body_template`, `enabled`, `agent_id`) SELECT `id`, `create_time`, `update_time`, `name`, `description`, `kind`, `events`, `budget`, `url`, `email`, `secret`, `title_template`, `body_template`, `enabled`, `agent_id` FROM `notification_sinks`;
+-- drop "notification_sinks" table after copying rows
+DROP TABLE `notification_sinks`;
+-- rename temporary table "new_notification_sinks" to "notification_sinks"
+ALTER TABLE `new_notification_sinks` RENAME TO `notification_sinks`;
+-- create index "notificationsink_name" to table: "notification_sinks"
+CREATE UNIQUE INDEX `notificationsink_name` ON `notification_sinks` (`name`);
+-- create "new_schedules" table
+CREATE TABLE `new_schedules` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `cron_expression` text NOT NULL, `prompt_template` text NOT NULL, `workspace` text NULL, `overlap_policy` text NOT NULL DEFAULT 'skip', `catch_up_policy` text NOT NULL DEFAULT 'run_once', `enabled` bool NOT NULL DEFAULT true, `last_run_time` datetime NULL, `next_run_time` datetime NULL, `agent_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `schedules_agents_agent` FOREIGN KEY (`agent_id`) REFERENCES `agents` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- copy rows from old table "schedules" to new temporary table "new_schedules"
+INSERT INTO `new_schedules` (`id`, `create_time`, `update_time`, `name`, `cron_expression`, `prompt_template`, `workspace`, `overlap_policy`, `catch_up_policy`, `enabled`, `last_run_time`, `next_run_time`, `agent_id`) SELECT `id`, `create_time`, `update_time`, `name`, `cron_expression`, `prompt_template`, `workspace`, `overlap_policy`, `catch_up_policy`, `enabled`, `last_run_time`, `next_run_time`, `agent_id` FROM `schedules`;
+-- drop "schedules" table after copying rows
+DROP TABLE `schedules`;
+-- rename temporary table "new_schedules" to "schedules"
+ALTER TABLE `new_schedules` RENAME TO `schedules`;
+-- create index "schedule_name" to table: "schedules"
+CREATE UNIQUE INDEX `schedule_name` ON `schedules` (`name`);
+-- create index "schedule_next_run_time" to table: "schedules"
+CREATE INDEX `schedule_next_run_time` ON `schedules` (`next_run_time`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
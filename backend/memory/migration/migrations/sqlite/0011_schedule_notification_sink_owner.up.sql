@@ -0,0 +1,12 @@
+-- add column "owner" to table: "notification_sinks"
+ALTER TABLE `notification_sinks` ADD COLUMN `owner` text NULL;
+-- add column "team" to table: "notification_sinks"
+ALTER TABLE `notification_sinks` ADD COLUMN `team` text NULL;
+-- create index "notificationsink_owner" to table: "notification_sinks"
+CREATE INDEX `notificationsink_owner` ON `notification_sinks` (`owner`);
+-- add column "owner" to table: "schedules"
+ALTER TABLE `schedules` ADD COLUMN `owner` text NULL;
+-- add column "team" to table: "schedules"
+ALTER TABLE `schedules` ADD COLUMN `team` text NULL;
+-- create index "schedule_owner" to table: "schedules"
+CREATE INDEX `schedule_owner` ON `schedules` (`owner`);
@@ -0,0 +1,129 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	entschema "entgo.io/ent/dialect/sql/schema"
+	entmigrate "github.com/furisto/construct/backend/memory/migrate"
+)
+
+// PostgresVersion is the server version the Postgres schema is generated for.
+const PostgresVersion = "160000"
+
+// postgresSchema returns the Postgres schema described by the ent tables. It is computed
+// without a database connection, so that migrations can be generated offline.
+func postgresSchema(ctx context.Context) (*schema.Schema, error) {
+	atlas, err := entschema.NewMigrate(offlineDriver{}, entschema.WithForeignKeys(true))
+	if err != nil {
+		return nil, err
+	}
+
+	realm, err := atlas.StateReader(entmigrate.Tables...)(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ent schema: %w", err)
+	}
+	if len(realm.Schemas) != 1 {
+		return nil, fmt.Errorf("expected one schema, got %d", len(realm.Schemas))
+	}
+	s := realm.Schemas[0]
+	s.Name = "public"
+	return s, nil
+}
+
+func marshalPostgresSchema(s *schema.Schema) ([]byte, error) {
+	return postgres.MarshalHCL(s)
+}
+
+func unmarshalPostgresSchema(b []byte) (*schema.Schema, error) {
+	var realm schema.Realm
+	if err := postgres.EvalHCLBytes(b, &realm, nil); err != nil {
+		return nil, err
+	}
+	if len(realm.Schemas) != 1 {
+		return nil, fmt.Errorf("expected one schema, got %d", len(realm.Schemas))
+	}
+	return realm.Schemas[0], nil
+}
+
+func planPostgres(ctx context.Context, from, to *schema.Schema) (string, error) {
+	changes, err := postgres.DefaultDiff.SchemaDiff(from, to)
+	if err != nil {
+		return "", fmt.Errorf("failed to diff schemas: %w", err)
+	}
+	if len(changes) == 0 {
+		return "", nil
+	}
+
+	// Tables are not qualified, so that migrations apply to the schema of the connection.
+	p, err := postgres.DefaultPlan.PlanChanges(ctx, "", changes, func(o *migrate.PlanOptions) {
+		o.SchemaQualifier = new(string)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to plan changes: %w", err)
+	}
+
+	var b strings.Builder
+	for _, change := range p.Changes {
+		if change.Comment != "" {
+			fmt.Fprintf(&b, "-- %s\n", change.Comment)
+		}
+		fmt.Fprintf(&b, "%s;\n", change.Cmd)
+	}
+	return b.String(), nil
+}
+
+// offlineDriver is an ent driver that only answers the server version query, which is
+// all ent needs to describe its tables as a Postgres schema.
+type offlineDriver struct{}
+
+func (offlineDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return fmt.Errorf("offline driver cannot execute %q", query)
+}
+
+func (offlineDriver) Query(ctx context.Context, query string, args, v any) error {
+	rows, ok := v.(*entsql.Rows)
+	if !ok || !strings.Contains(query, "server_version_num") {
+		return fmt.Errorf("offline driver cannot query %q", query)
+	}
+	rows.ColumnScanner = &versionRows{}
+	return nil
+}
+
+func (offlineDriver) Tx(context.Context) (dialect.Tx, error) {
+	return nil, fmt.Errorf("offline driver does not support transactions")
+}
+
+func (offlineDriver) Close() error { return nil }
+
+func (offlineDriver) Dialect() string { return dialect.Postgres }
+
+type versionRows struct {
+	done bool
+}
+
+func (r *versionRows) Close() error                            { return nil }
+func (r *versionRows) ColumnTypes() ([]*sql.ColumnType, error) { return nil, nil }
+func (r *versionRows) Columns() ([]string, error)              { return []string{"server_version_num"}, nil }
+func (r *versionRows) Err() error                              { return nil }
+func (r *versionRows) NextResultSet() bool                     { return false }
+
+func (r *versionRows) Next() bool {
+	if r.done {
+		return false
+	}
+	r.done = true
+	return true
+}
+
+func (r *versionRows) Scan(dest ...any) error {
+	*(dest[0].(*string)) = PostgresVersion
+	return nil
+}
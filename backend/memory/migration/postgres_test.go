@@ -0,0 +1,86 @@
+//go:build postgres
+
+package migration_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory/migration"
+	"github.com/furisto/construct/backend/memory/test"
+)
+
+func TestMigratorPostgres(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewEmptyPostgresDatabase(t)
+
+	migrations, err := migration.For(dialect.Postgres)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	latest := len(migrations)
+
+	// Daemons that share the database migrate it at the same time when they start. The
+	// advisory lock lets only one of them apply the migrations.
+	applied := make([]int, 2)
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			migrated, err := migration.NewMigrator(db, migrations).Up(ctx)
+			applied[i], errs[i] = len(migrated), err
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("failed to migrate: %v", err)
+		}
+	}
+	if applied[0]+applied[1] != latest || applied[0]*applied[1] != 0 {
+		t.Errorf("expected one migrator to apply %d migrations, got %v", latest, applied)
+	}
+
+	migrator := migration.NewMigrator(db, migrations)
+	assertPostgresVersion(t, migrator, latest)
+
+	reverted, err := migrator.Rollback(ctx, latest)
+	if err != nil {
+		t.Fatalf("failed to roll back: %v", err)
+	}
+	if len(reverted) != latest {
+		t.Errorf("expected %d reverted migrations, got %d", latest, len(reverted))
+	}
+	assertPostgresVersion(t, migrator, 0)
+
+	var tables int
+	err = db.MustDB().QueryRowContext(ctx, "SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name <> $1", migration.VersionTable).Scan(&tables)
+	if err != nil {
+		t.Fatalf("failed to count tables: %v", err)
+	}
+	if tables != 0 {
+		t.Errorf("expected the rollback to drop all tables, %d are left", tables)
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("failed to migrate after rollback: %v", err)
+	}
+	assertPostgresVersion(t, migrator, latest)
+}
+
+func assertPostgresVersion(t *testing.T, migrator *migration.Migrator, expected int) {
+	t.Helper()
+
+	status, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	}
+	if status.Version != expected {
+		t.Errorf("expected version %d, got %d", expected, status.Version)
+	}
+}
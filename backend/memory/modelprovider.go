@@ -23,6 +23,10 @@ type ModelProvider struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Team holds the value of the "team" field.
+	Team string `json:"team,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// ProviderType holds the value of the "provider_type" field.
@@ -66,7 +70,7 @@ func (*ModelProvider) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case modelprovider.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case modelprovider.FieldName, modelprovider.FieldProviderType, modelprovider.FieldURL:
+		case modelprovider.FieldOwner, modelprovider.FieldTeam, modelprovider.FieldName, modelprovider.FieldProviderType, modelprovider.FieldURL:
 			values[i] = new(sql.NullString)
 		case modelprovider.FieldCreateTime, modelprovider.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -105,6 +109,18 @@ func (mp *ModelProvider) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				mp.UpdateTime = value.Time
 			}
+		case modelprovider.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				mp.Owner = value.String
+			}
+		case modelprovider.FieldTeam:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field team", values[i])
+			} else if value.Valid {
+				mp.Team = value.String
+			}
 		case modelprovider.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -182,6 +198,12 @@ func (mp *ModelProvider) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(mp.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(mp.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("team=")
+	builder.WriteString(mp.Team)
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(mp.Name)
 	builder.WriteString(", ")
@@ -67,6 +67,16 @@ func UpdateTime(v time.Time) predicate.ModelProvider {
 	return predicate.ModelProvider(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldEQ(FieldOwner, v))
+}
+
+// Team applies equality check predicate on the "team" field. It's identical to TeamEQ.
+func Team(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldEQ(FieldTeam, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.ModelProvider {
 	return predicate.ModelProvider(sql.FieldEQ(FieldName, v))
@@ -167,6 +177,156 @@ func UpdateTimeLTE(v time.Time) predicate.ModelProvider {
 	return predicate.ModelProvider(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// TeamEQ applies the EQ predicate on the "team" field.
+func TeamEQ(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldEQ(FieldTeam, v))
+}
+
+// TeamNEQ applies the NEQ predicate on the "team" field.
+func TeamNEQ(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldNEQ(FieldTeam, v))
+}
+
+// TeamIn applies the In predicate on the "team" field.
+func TeamIn(vs ...string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldIn(FieldTeam, vs...))
+}
+
+// TeamNotIn applies the NotIn predicate on the "team" field.
+func TeamNotIn(vs ...string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldNotIn(FieldTeam, vs...))
+}
+
+// TeamGT applies the GT predicate on the "team" field.
+func TeamGT(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldGT(FieldTeam, v))
+}
+
+// TeamGTE applies the GTE predicate on the "team" field.
+func TeamGTE(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldGTE(FieldTeam, v))
+}
+
+// TeamLT applies the LT predicate on the "team" field.
+func TeamLT(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldLT(FieldTeam, v))
+}
+
+// TeamLTE applies the LTE predicate on the "team" field.
+func TeamLTE(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldLTE(FieldTeam, v))
+}
+
+// TeamContains applies the Contains predicate on the "team" field.
+func TeamContains(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldContains(FieldTeam, v))
+}
+
+// TeamHasPrefix applies the HasPrefix predicate on the "team" field.
+func TeamHasPrefix(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldHasPrefix(FieldTeam, v))
+}
+
+// TeamHasSuffix applies the HasSuffix predicate on the "team" field.
+func TeamHasSuffix(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldHasSuffix(FieldTeam, v))
+}
+
+// TeamIsNil applies the IsNil predicate on the "team" field.
+func TeamIsNil() predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldIsNull(FieldTeam))
+}
+
+// TeamNotNil applies the NotNil predicate on the "team" field.
+func TeamNotNil() predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldNotNull(FieldTeam))
+}
+
+// TeamEqualFold applies the EqualFold predicate on the "team" field.
+func TeamEqualFold(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldEqualFold(FieldTeam, v))
+}
+
+// TeamContainsFold applies the ContainsFold predicate on the "team" field.
+func TeamContainsFold(v string) predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldContainsFold(FieldTeam, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.ModelProvider {
 	return predicate.ModelProvider(sql.FieldEQ(FieldName, v))
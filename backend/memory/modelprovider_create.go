@@ -51,6 +51,34 @@ func (mpc *ModelProviderCreate) SetNillableUpdateTime(t *time.Time) *ModelProvid
 	return mpc
 }
 
+// SetOwner sets the "owner" field.
+func (mpc *ModelProviderCreate) SetOwner(s string) *ModelProviderCreate {
+	mpc.mutation.SetOwner(s)
+	return mpc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (mpc *ModelProviderCreate) SetNillableOwner(s *string) *ModelProviderCreate {
+	if s != nil {
+		mpc.SetOwner(*s)
+	}
+	return mpc
+}
+
+// SetTeam sets the "team" field.
+func (mpc *ModelProviderCreate) SetTeam(s string) *ModelProviderCreate {
+	mpc.mutation.SetTeam(s)
+	return mpc
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (mpc *ModelProviderCreate) SetNillableTeam(s *string) *ModelProviderCreate {
+	if s != nil {
+		mpc.SetTeam(*s)
+	}
+	return mpc
+}
+
 // SetName sets the "name" field.
 func (mpc *ModelProviderCreate) SetName(s string) *ModelProviderCreate {
 	mpc.mutation.SetName(s)
@@ -257,6 +285,14 @@ func (mpc *ModelProviderCreate) createSpec() (*ModelProvider, *sqlgraph.CreateSp
 		_spec.SetField(modelprovider.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := mpc.mutation.Owner(); ok {
+		_spec.SetField(modelprovider.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := mpc.mutation.Team(); ok {
+		_spec.SetField(modelprovider.FieldTeam, field.TypeString, value)
+		_node.Team = value
+	}
 	if value, ok := mpc.mutation.Name(); ok {
 		_spec.SetField(modelprovider.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -38,6 +38,46 @@ func (mpu *ModelProviderUpdate) SetUpdateTime(t time.Time) *ModelProviderUpdate
 	return mpu
 }
 
+// SetOwner sets the "owner" field.
+func (mpu *ModelProviderUpdate) SetOwner(s string) *ModelProviderUpdate {
+	mpu.mutation.SetOwner(s)
+	return mpu
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (mpu *ModelProviderUpdate) SetNillableOwner(s *string) *ModelProviderUpdate {
+	if s != nil {
+		mpu.SetOwner(*s)
+	}
+	return mpu
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (mpu *ModelProviderUpdate) ClearOwner() *ModelProviderUpdate {
+	mpu.mutation.ClearOwner()
+	return mpu
+}
+
+// SetTeam sets the "team" field.
+func (mpu *ModelProviderUpdate) SetTeam(s string) *ModelProviderUpdate {
+	mpu.mutation.SetTeam(s)
+	return mpu
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (mpu *ModelProviderUpdate) SetNillableTeam(s *string) *ModelProviderUpdate {
+	if s != nil {
+		mpu.SetTeam(*s)
+	}
+	return mpu
+}
+
+// ClearTeam clears the value of the "team" field.
+func (mpu *ModelProviderUpdate) ClearTeam() *ModelProviderUpdate {
+	mpu.mutation.ClearTeam()
+	return mpu
+}
+
 // SetName sets the "name" field.
 func (mpu *ModelProviderUpdate) SetName(s string) *ModelProviderUpdate {
 	mpu.mutation.SetName(s)
@@ -224,6 +264,18 @@ func (mpu *ModelProviderUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := mpu.mutation.UpdateTime(); ok {
 		_spec.SetField(modelprovider.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := mpu.mutation.Owner(); ok {
+		_spec.SetField(modelprovider.FieldOwner, field.TypeString, value)
+	}
+	if mpu.mutation.OwnerCleared() {
+		_spec.ClearField(modelprovider.FieldOwner, field.TypeString)
+	}
+	if value, ok := mpu.mutation.Team(); ok {
+		_spec.SetField(modelprovider.FieldTeam, field.TypeString, value)
+	}
+	if mpu.mutation.TeamCleared() {
+		_spec.ClearField(modelprovider.FieldTeam, field.TypeString)
+	}
 	if value, ok := mpu.mutation.Name(); ok {
 		_spec.SetField(modelprovider.FieldName, field.TypeString, value)
 	}
@@ -315,6 +367,46 @@ func (mpuo *ModelProviderUpdateOne) SetUpdateTime(t time.Time) *ModelProviderUpd
 	return mpuo
 }
 
+// SetOwner sets the "owner" field.
+func (mpuo *ModelProviderUpdateOne) SetOwner(s string) *ModelProviderUpdateOne {
+	mpuo.mutation.SetOwner(s)
+	return mpuo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (mpuo *ModelProviderUpdateOne) SetNillableOwner(s *string) *ModelProviderUpdateOne {
+	if s != nil {
+		mpuo.SetOwner(*s)
+	}
+	return mpuo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (mpuo *ModelProviderUpdateOne) ClearOwner() *ModelProviderUpdateOne {
+	mpuo.mutation.ClearOwner()
+	return mpuo
+}
+
+// SetTeam sets the "team" field.
+func (mpuo *ModelProviderUpdateOne) SetTeam(s string) *ModelProviderUpdateOne {
+	mpuo.mutation.SetTeam(s)
+	return mpuo
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (mpuo *ModelProviderUpdateOne) SetNillableTeam(s *string) *ModelProviderUpdateOne {
+	if s != nil {
+		mpuo.SetTeam(*s)
+	}
+	return mpuo
+}
+
+// ClearTeam clears the value of the "team" field.
+func (mpuo *ModelProviderUpdateOne) ClearTeam() *ModelProviderUpdateOne {
+	mpuo.mutation.ClearTeam()
+	return mpuo
+}
+
 // SetName sets the "name" field.
 func (mpuo *ModelProviderUpdateOne) SetName(s string) *ModelProviderUpdateOne {
 	mpuo.mutation.SetName(s)
@@ -531,6 +623,18 @@ func (mpuo *ModelProviderUpdateOne) sqlSave(ctx context.Context) (_node *ModelPr
 	if value, ok := mpuo.mutation.UpdateTime(); ok {
 		_spec.SetField(modelprovider.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := mpuo.mutation.Owner(); ok {
+		_spec.SetField(modelprovider.FieldOwner, field.TypeString, value)
+	}
+	if mpuo.mutation.OwnerCleared() {
+		_spec.ClearField(modelprovider.FieldOwner, field.TypeString)
+	}
+	if value, ok := mpuo.mutation.Team(); ok {
+		_spec.SetField(modelprovider.FieldTeam, field.TypeString, value)
+	}
+	if mpuo.mutation.TeamCleared() {
+		_spec.ClearField(modelprovider.FieldTeam, field.TypeString)
+	}
 	if value, ok := mpuo.mutation.Name(); ok {
 		_spec.SetField(modelprovider.FieldName, field.TypeString, value)
 	}
@@ -12,12 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/filechange"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/notificationsink"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/token"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/furisto/construct/backend/memory/webhooktrigger"
 	"github.com/google/uuid"
 )
 
@@ -30,37 +38,58 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAgent         = "Agent"
-	TypeMessage       = "Message"
-	TypeModel         = "Model"
-	TypeModelProvider = "ModelProvider"
-	TypeTask          = "Task"
+	TypeAgent            = "Agent"
+	TypeAuditEvent       = "AuditEvent"
+	TypeFileChange       = "FileChange"
+	TypeMessage          = "Message"
+	TypeModel            = "Model"
+	TypeModelProvider    = "ModelProvider"
+	TypeNotificationSink = "NotificationSink"
+	TypeSchedule         = "Schedule"
+	TypeScheduleRun      = "ScheduleRun"
+	TypeTask             = "Task"
+	TypeToken            = "Token"
+	TypeWebhookDelivery  = "WebhookDelivery"
+	TypeWebhookTrigger   = "WebhookTrigger"
 )
 
 // AgentMutation represents an operation that mutates the Agent nodes in the graph.
 type AgentMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	create_time     *time.Time
-	update_time     *time.Time
-	name            *string
-	description     *string
-	instructions    *string
-	builtin         *bool
-	clearedFields   map[string]struct{}
-	model           *uuid.UUID
-	clearedmodel    bool
-	tasks           map[uuid.UUID]struct{}
-	removedtasks    map[uuid.UUID]struct{}
-	clearedtasks    bool
-	messages        map[uuid.UUID]struct{}
-	removedmessages map[uuid.UUID]struct{}
-	clearedmessages bool
-	done            bool
-	oldValue        func(context.Context) (*Agent, error)
-	predicates      []predicate.Agent
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	create_time               *time.Time
+	update_time               *time.Time
+	owner                     *string
+	team                      *string
+	name                      *string
+	description               *string
+	instructions              *string
+	builtin                   *bool
+	tools                     *[]string
+	appendtools               []string
+	clearedFields             map[string]struct{}
+	model                     *uuid.UUID
+	clearedmodel              bool
+	tasks                     map[uuid.UUID]struct{}
+	removedtasks              map[uuid.UUID]struct{}
+	clearedtasks              bool
+	messages                  map[uuid.UUID]struct{}
+	removedmessages           map[uuid.UUID]struct{}
+	clearedmessages           bool
+	schedules                 map[uuid.UUID]struct{}
+	removedschedules          map[uuid.UUID]struct{}
+	clearedschedules          bool
+	webhook_triggers          map[uuid.UUID]struct{}
+	removedwebhook_triggers   map[uuid.UUID]struct{}
+	clearedwebhook_triggers   bool
+	notification_sinks        map[uuid.UUID]struct{}
+	removednotification_sinks map[uuid.UUID]struct{}
+	clearednotification_sinks bool
+	done                      bool
+	oldValue                  func(context.Context) (*Agent, error)
+	predicates                []predicate.Agent
 }
 
 var _ ent.Mutation = (*AgentMutation)(nil)
@@ -239,6 +268,104 @@ func (m *AgentMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
+// SetOwner sets the "owner" field.
+func (m *AgentMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *AgentMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *AgentMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[agent.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *AgentMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[agent.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *AgentMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, agent.FieldOwner)
+}
+
+// SetTeam sets the "team" field.
+func (m *AgentMutation) SetTeam(s string) {
+	m.team = &s
+}
+
+// Team returns the value of the "team" field in the mutation.
+func (m *AgentMutation) Team() (r string, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeam returns the old "team" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldTeam(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+	}
+	return oldValue.Team, nil
+}
+
+// ClearTeam clears the value of the "team" field.
+func (m *AgentMutation) ClearTeam() {
+	m.team = nil
+	m.clearedFields[agent.FieldTeam] = struct{}{}
+}
+
+// TeamCleared returns if the "team" field was cleared in this mutation.
+func (m *AgentMutation) TeamCleared() bool {
+	_, ok := m.clearedFields[agent.FieldTeam]
+	return ok
+}
+
+// ResetTeam resets all changes to the "team" field.
+func (m *AgentMutation) ResetTeam() {
+	m.team = nil
+	delete(m.clearedFields, agent.FieldTeam)
+}
+
 // SetName sets the "name" field.
 func (m *AgentMutation) SetName(s string) {
 	m.name = &s
@@ -396,6 +523,71 @@ func (m *AgentMutation) ResetBuiltin() {
 	m.builtin = nil
 }
 
+// SetTools sets the "tools" field.
+func (m *AgentMutation) SetTools(s []string) {
+	m.tools = &s
+	m.appendtools = nil
+}
+
+// Tools returns the value of the "tools" field in the mutation.
+func (m *AgentMutation) Tools() (r []string, exists bool) {
+	v := m.tools
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTools returns the old "tools" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldTools(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTools is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTools requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTools: %w", err)
+	}
+	return oldValue.Tools, nil
+}
+
+// AppendTools adds s to the "tools" field.
+func (m *AgentMutation) AppendTools(s []string) {
+	m.appendtools = append(m.appendtools, s...)
+}
+
+// AppendedTools returns the list of values that were appended to the "tools" field in this mutation.
+func (m *AgentMutation) AppendedTools() ([]string, bool) {
+	if len(m.appendtools) == 0 {
+		return nil, false
+	}
+	return m.appendtools, true
+}
+
+// ClearTools clears the value of the "tools" field.
+func (m *AgentMutation) ClearTools() {
+	m.tools = nil
+	m.appendtools = nil
+	m.clearedFields[agent.FieldTools] = struct{}{}
+}
+
+// ToolsCleared returns if the "tools" field was cleared in this mutation.
+func (m *AgentMutation) ToolsCleared() bool {
+	_, ok := m.clearedFields[agent.FieldTools]
+	return ok
+}
+
+// ResetTools resets all changes to the "tools" field.
+func (m *AgentMutation) ResetTools() {
+	m.tools = nil
+	m.appendtools = nil
+	delete(m.clearedFields, agent.FieldTools)
+}
+
 // SetModelID sets the "model_id" field.
 func (m *AgentMutation) SetModelID(u uuid.UUID) {
 	m.model = &u
@@ -580,6 +772,168 @@ func (m *AgentMutation) ResetMessages() {
 	m.removedmessages = nil
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by ids.
+func (m *AgentMutation) AddScheduleIDs(ids ...uuid.UUID) {
+	if m.schedules == nil {
+		m.schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.schedules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSchedules clears the "schedules" edge to the Schedule entity.
+func (m *AgentMutation) ClearSchedules() {
+	m.clearedschedules = true
+}
+
+// SchedulesCleared reports if the "schedules" edge to the Schedule entity was cleared.
+func (m *AgentMutation) SchedulesCleared() bool {
+	return m.clearedschedules
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to the Schedule entity by IDs.
+func (m *AgentMutation) RemoveScheduleIDs(ids ...uuid.UUID) {
+	if m.removedschedules == nil {
+		m.removedschedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.schedules, ids[i])
+		m.removedschedules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSchedules returns the removed IDs of the "schedules" edge to the Schedule entity.
+func (m *AgentMutation) RemovedSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedschedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SchedulesIDs returns the "schedules" edge IDs in the mutation.
+func (m *AgentMutation) SchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSchedules resets all changes to the "schedules" edge.
+func (m *AgentMutation) ResetSchedules() {
+	m.schedules = nil
+	m.clearedschedules = false
+	m.removedschedules = nil
+}
+
+// AddWebhookTriggerIDs adds the "webhook_triggers" edge to the WebhookTrigger entity by ids.
+func (m *AgentMutation) AddWebhookTriggerIDs(ids ...uuid.UUID) {
+	if m.webhook_triggers == nil {
+		m.webhook_triggers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webhook_triggers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhookTriggers clears the "webhook_triggers" edge to the WebhookTrigger entity.
+func (m *AgentMutation) ClearWebhookTriggers() {
+	m.clearedwebhook_triggers = true
+}
+
+// WebhookTriggersCleared reports if the "webhook_triggers" edge to the WebhookTrigger entity was cleared.
+func (m *AgentMutation) WebhookTriggersCleared() bool {
+	return m.clearedwebhook_triggers
+}
+
+// RemoveWebhookTriggerIDs removes the "webhook_triggers" edge to the WebhookTrigger entity by IDs.
+func (m *AgentMutation) RemoveWebhookTriggerIDs(ids ...uuid.UUID) {
+	if m.removedwebhook_triggers == nil {
+		m.removedwebhook_triggers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.webhook_triggers, ids[i])
+		m.removedwebhook_triggers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhookTriggers returns the removed IDs of the "webhook_triggers" edge to the WebhookTrigger entity.
+func (m *AgentMutation) RemovedWebhookTriggersIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebhook_triggers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhookTriggersIDs returns the "webhook_triggers" edge IDs in the mutation.
+func (m *AgentMutation) WebhookTriggersIDs() (ids []uuid.UUID) {
+	for id := range m.webhook_triggers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhookTriggers resets all changes to the "webhook_triggers" edge.
+func (m *AgentMutation) ResetWebhookTriggers() {
+	m.webhook_triggers = nil
+	m.clearedwebhook_triggers = false
+	m.removedwebhook_triggers = nil
+}
+
+// AddNotificationSinkIDs adds the "notification_sinks" edge to the NotificationSink entity by ids.
+func (m *AgentMutation) AddNotificationSinkIDs(ids ...uuid.UUID) {
+	if m.notification_sinks == nil {
+		m.notification_sinks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notification_sinks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotificationSinks clears the "notification_sinks" edge to the NotificationSink entity.
+func (m *AgentMutation) ClearNotificationSinks() {
+	m.clearednotification_sinks = true
+}
+
+// NotificationSinksCleared reports if the "notification_sinks" edge to the NotificationSink entity was cleared.
+func (m *AgentMutation) NotificationSinksCleared() bool {
+	return m.clearednotification_sinks
+}
+
+// RemoveNotificationSinkIDs removes the "notification_sinks" edge to the NotificationSink entity by IDs.
+func (m *AgentMutation) RemoveNotificationSinkIDs(ids ...uuid.UUID) {
+	if m.removednotification_sinks == nil {
+		m.removednotification_sinks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notification_sinks, ids[i])
+		m.removednotification_sinks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotificationSinks returns the removed IDs of the "notification_sinks" edge to the NotificationSink entity.
+func (m *AgentMutation) RemovedNotificationSinksIDs() (ids []uuid.UUID) {
+	for id := range m.removednotification_sinks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationSinksIDs returns the "notification_sinks" edge IDs in the mutation.
+func (m *AgentMutation) NotificationSinksIDs() (ids []uuid.UUID) {
+	for id := range m.notification_sinks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotificationSinks resets all changes to the "notification_sinks" edge.
+func (m *AgentMutation) ResetNotificationSinks() {
+	m.notification_sinks = nil
+	m.clearednotification_sinks = false
+	m.removednotification_sinks = nil
+}
+
 // Where appends a list predicates to the AgentMutation builder.
 func (m *AgentMutation) Where(ps ...predicate.Agent) {
 	m.predicates = append(m.predicates, ps...)
@@ -614,13 +968,19 @@ func (m *AgentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AgentMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 10)
 	if m.create_time != nil {
 		fields = append(fields, agent.FieldCreateTime)
 	}
 	if m.update_time != nil {
 		fields = append(fields, agent.FieldUpdateTime)
 	}
+	if m.owner != nil {
+		fields = append(fields, agent.FieldOwner)
+	}
+	if m.team != nil {
+		fields = append(fields, agent.FieldTeam)
+	}
 	if m.name != nil {
 		fields = append(fields, agent.FieldName)
 	}
@@ -633,6 +993,9 @@ func (m *AgentMutation) Fields() []string {
 	if m.builtin != nil {
 		fields = append(fields, agent.FieldBuiltin)
 	}
+	if m.tools != nil {
+		fields = append(fields, agent.FieldTools)
+	}
 	if m.model != nil {
 		fields = append(fields, agent.FieldModelID)
 	}
@@ -648,6 +1011,10 @@ func (m *AgentMutation) Field(name string) (ent.Value, bool) {
 		return m.CreateTime()
 	case agent.FieldUpdateTime:
 		return m.UpdateTime()
+	case agent.FieldOwner:
+		return m.Owner()
+	case agent.FieldTeam:
+		return m.Team()
 	case agent.FieldName:
 		return m.Name()
 	case agent.FieldDescription:
@@ -656,6 +1023,8 @@ func (m *AgentMutation) Field(name string) (ent.Value, bool) {
 		return m.Instructions()
 	case agent.FieldBuiltin:
 		return m.Builtin()
+	case agent.FieldTools:
+		return m.Tools()
 	case agent.FieldModelID:
 		return m.ModelID()
 	}
@@ -671,6 +1040,10 @@ func (m *AgentMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldCreateTime(ctx)
 	case agent.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
+	case agent.FieldOwner:
+		return m.OldOwner(ctx)
+	case agent.FieldTeam:
+		return m.OldTeam(ctx)
 	case agent.FieldName:
 		return m.OldName(ctx)
 	case agent.FieldDescription:
@@ -679,6 +1052,8 @@ func (m *AgentMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldInstructions(ctx)
 	case agent.FieldBuiltin:
 		return m.OldBuiltin(ctx)
+	case agent.FieldTools:
+		return m.OldTools(ctx)
 	case agent.FieldModelID:
 		return m.OldModelID(ctx)
 	}
@@ -704,6 +1079,20 @@ func (m *AgentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdateTime(v)
 		return nil
+	case agent.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case agent.FieldTeam:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeam(v)
+		return nil
 	case agent.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -732,6 +1121,13 @@ func (m *AgentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBuiltin(v)
 		return nil
+	case agent.FieldTools:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTools(v)
+		return nil
 	case agent.FieldModelID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -769,9 +1165,18 @@ func (m *AgentMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *AgentMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(agent.FieldOwner) {
+		fields = append(fields, agent.FieldOwner)
+	}
+	if m.FieldCleared(agent.FieldTeam) {
+		fields = append(fields, agent.FieldTeam)
+	}
 	if m.FieldCleared(agent.FieldDescription) {
 		fields = append(fields, agent.FieldDescription)
 	}
+	if m.FieldCleared(agent.FieldTools) {
+		fields = append(fields, agent.FieldTools)
+	}
 	if m.FieldCleared(agent.FieldModelID) {
 		fields = append(fields, agent.FieldModelID)
 	}
@@ -789,9 +1194,18 @@ func (m *AgentMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *AgentMutation) ClearField(name string) error {
 	switch name {
+	case agent.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case agent.FieldTeam:
+		m.ClearTeam()
+		return nil
 	case agent.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case agent.FieldTools:
+		m.ClearTools()
+		return nil
 	case agent.FieldModelID:
 		m.ClearModelID()
 		return nil
@@ -809,6 +1223,12 @@ func (m *AgentMutation) ResetField(name string) error {
 	case agent.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
+	case agent.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case agent.FieldTeam:
+		m.ResetTeam()
+		return nil
 	case agent.FieldName:
 		m.ResetName()
 		return nil
@@ -821,6 +1241,9 @@ func (m *AgentMutation) ResetField(name string) error {
 	case agent.FieldBuiltin:
 		m.ResetBuiltin()
 		return nil
+	case agent.FieldTools:
+		m.ResetTools()
+		return nil
 	case agent.FieldModelID:
 		m.ResetModelID()
 		return nil
@@ -830,7 +1253,7 @@ func (m *AgentMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AgentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 6)
 	if m.model != nil {
 		edges = append(edges, agent.EdgeModel)
 	}
@@ -840,6 +1263,15 @@ func (m *AgentMutation) AddedEdges() []string {
 	if m.messages != nil {
 		edges = append(edges, agent.EdgeMessages)
 	}
+	if m.schedules != nil {
+		edges = append(edges, agent.EdgeSchedules)
+	}
+	if m.webhook_triggers != nil {
+		edges = append(edges, agent.EdgeWebhookTriggers)
+	}
+	if m.notification_sinks != nil {
+		edges = append(edges, agent.EdgeNotificationSinks)
+	}
 	return edges
 }
 
@@ -863,19 +1295,46 @@ func (m *AgentMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case agent.EdgeSchedules:
+		ids := make([]ent.Value, 0, len(m.schedules))
+		for id := range m.schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case agent.EdgeWebhookTriggers:
+		ids := make([]ent.Value, 0, len(m.webhook_triggers))
+		for id := range m.webhook_triggers {
+			ids = append(ids, id)
+		}
+		return ids
+	case agent.EdgeNotificationSinks:
+		ids := make([]ent.Value, 0, len(m.notification_sinks))
+		for id := range m.notification_sinks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AgentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 6)
 	if m.removedtasks != nil {
 		edges = append(edges, agent.EdgeTasks)
 	}
 	if m.removedmessages != nil {
 		edges = append(edges, agent.EdgeMessages)
 	}
+	if m.removedschedules != nil {
+		edges = append(edges, agent.EdgeSchedules)
+	}
+	if m.removedwebhook_triggers != nil {
+		edges = append(edges, agent.EdgeWebhookTriggers)
+	}
+	if m.removednotification_sinks != nil {
+		edges = append(edges, agent.EdgeNotificationSinks)
+	}
 	return edges
 }
 
@@ -895,13 +1354,31 @@ func (m *AgentMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case agent.EdgeSchedules:
+		ids := make([]ent.Value, 0, len(m.removedschedules))
+		for id := range m.removedschedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case agent.EdgeWebhookTriggers:
+		ids := make([]ent.Value, 0, len(m.removedwebhook_triggers))
+		for id := range m.removedwebhook_triggers {
+			ids = append(ids, id)
+		}
+		return ids
+	case agent.EdgeNotificationSinks:
+		ids := make([]ent.Value, 0, len(m.removednotification_sinks))
+		for id := range m.removednotification_sinks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AgentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 6)
 	if m.clearedmodel {
 		edges = append(edges, agent.EdgeModel)
 	}
@@ -911,6 +1388,15 @@ func (m *AgentMutation) ClearedEdges() []string {
 	if m.clearedmessages {
 		edges = append(edges, agent.EdgeMessages)
 	}
+	if m.clearedschedules {
+		edges = append(edges, agent.EdgeSchedules)
+	}
+	if m.clearedwebhook_triggers {
+		edges = append(edges, agent.EdgeWebhookTriggers)
+	}
+	if m.clearednotification_sinks {
+		edges = append(edges, agent.EdgeNotificationSinks)
+	}
 	return edges
 }
 
@@ -924,6 +1410,12 @@ func (m *AgentMutation) EdgeCleared(name string) bool {
 		return m.clearedtasks
 	case agent.EdgeMessages:
 		return m.clearedmessages
+	case agent.EdgeSchedules:
+		return m.clearedschedules
+	case agent.EdgeWebhookTriggers:
+		return m.clearedwebhook_triggers
+	case agent.EdgeNotificationSinks:
+		return m.clearednotification_sinks
 	}
 	return false
 }
@@ -952,45 +1444,61 @@ func (m *AgentMutation) ResetEdge(name string) error {
 	case agent.EdgeMessages:
 		m.ResetMessages()
 		return nil
+	case agent.EdgeSchedules:
+		m.ResetSchedules()
+		return nil
+	case agent.EdgeWebhookTriggers:
+		m.ResetWebhookTriggers()
+		return nil
+	case agent.EdgeNotificationSinks:
+		m.ResetNotificationSinks()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *uuid.UUID
-	create_time    *time.Time
-	update_time    *time.Time
-	source         *types.MessageSource
-	content        **types.MessageContent
-	usage          **types.MessageUsage
-	processed_time *time.Time
+	sequence       *int64
+	addsequence    *int64
+	time           *time.Time
+	kind           *types.AuditEventKind
+	actor          *string
+	action         *string
+	resource       *string
+	task_id        *uuid.UUID
+	workspace      *string
+	command        *string
+	_path          *string
+	diff_hash      *string
+	exit_code      *int
+	addexit_code   *int
+	duration_ms    *int64
+	addduration_ms *int64
+	error          *string
+	previous_hash  *[]byte
+	hash           *[]byte
 	clearedFields  map[string]struct{}
-	task           *uuid.UUID
-	clearedtask    bool
-	agent          *uuid.UUID
-	clearedagent   bool
-	model          *uuid.UUID
-	clearedmodel   bool
 	done           bool
-	oldValue       func(context.Context) (*Message, error)
-	predicates     []predicate.Message
+	oldValue       func(context.Context) (*AuditEvent, error)
+	predicates     []predicate.AuditEvent
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*AuditEventMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeAuditEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -999,20 +1507,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id uuid.UUID) auditeventOption {
+	return func(m *AuditEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *AuditEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().AuditEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1021,10 +1529,10 @@ func withMessageID(id uuid.UUID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1033,7 +1541,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m AuditEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1041,7 +1549,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m AuditEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -1051,14 +1559,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuditEvent entities.
+func (m *AuditEventMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuditEventMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1069,7 +1577,7 @@ func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1078,272 +1586,292 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *MessageMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetSequence sets the "sequence" field.
+func (m *AuditEventMutation) SetSequence(i int64) {
+	m.sequence = &i
+	m.addsequence = nil
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *AuditEventMutation) Sequence() (r int64, exists bool) {
+	v := m.sequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldSequence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.Sequence, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *MessageMutation) ResetCreateTime() {
-	m.create_time = nil
+// AddSequence adds i to the "sequence" field.
+func (m *AuditEventMutation) AddSequence(i int64) {
+	if m.addsequence != nil {
+		*m.addsequence += i
+	} else {
+		m.addsequence = &i
+	}
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *MessageMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *AuditEventMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// ResetSequence resets all changes to the "sequence" field.
+func (m *AuditEventMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
+}
+
+// SetTime sets the "time" field.
+func (m *AuditEventMutation) SetTime(t time.Time) {
+	m.time = &t
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *AuditEventMutation) Time() (r time.Time, exists bool) {
+	v := m.time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldTime returns the old "time" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.Time, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *MessageMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ResetTime resets all changes to the "time" field.
+func (m *AuditEventMutation) ResetTime() {
+	m.time = nil
 }
 
-// SetSource sets the "source" field.
-func (m *MessageMutation) SetSource(ts types.MessageSource) {
-	m.source = &ts
+// SetKind sets the "kind" field.
+func (m *AuditEventMutation) SetKind(tek types.AuditEventKind) {
+	m.kind = &tek
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *MessageMutation) Source() (r types.MessageSource, exists bool) {
-	v := m.source
+// Kind returns the value of the "kind" field in the mutation.
+func (m *AuditEventMutation) Kind() (r types.AuditEventKind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSource(ctx context.Context) (v types.MessageSource, err error) {
+func (m *AuditEventMutation) OldKind(ctx context.Context) (v types.AuditEventKind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Kind, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *MessageMutation) ResetSource() {
-	m.source = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *AuditEventMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(tc *types.MessageContent) {
-	m.content = &tc
+// SetActor sets the "actor" field.
+func (m *AuditEventMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r *types.MessageContent, exists bool) {
-	v := m.content
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditEventMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v *types.MessageContent, err error) {
+func (m *AuditEventMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Actor, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
+// ClearActor clears the value of the "actor" field.
+func (m *AuditEventMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[auditevent.FieldActor] = struct{}{}
 }
 
-// SetUsage sets the "usage" field.
-func (m *MessageMutation) SetUsage(tu *types.MessageUsage) {
-	m.usage = &tu
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *AuditEventMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldActor]
+	return ok
 }
 
-// Usage returns the value of the "usage" field in the mutation.
-func (m *MessageMutation) Usage() (r *types.MessageUsage, exists bool) {
-	v := m.usage
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditEventMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, auditevent.FieldActor)
+}
+
+// SetAction sets the "action" field.
+func (m *AuditEventMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEventMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsage returns the old "usage" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUsage(ctx context.Context) (v *types.MessageUsage, err error) {
+func (m *AuditEventMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsage requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.Usage, nil
-}
-
-// ClearUsage clears the value of the "usage" field.
-func (m *MessageMutation) ClearUsage() {
-	m.usage = nil
-	m.clearedFields[message.FieldUsage] = struct{}{}
-}
-
-// UsageCleared returns if the "usage" field was cleared in this mutation.
-func (m *MessageMutation) UsageCleared() bool {
-	_, ok := m.clearedFields[message.FieldUsage]
-	return ok
+	return oldValue.Action, nil
 }
 
-// ResetUsage resets all changes to the "usage" field.
-func (m *MessageMutation) ResetUsage() {
-	m.usage = nil
-	delete(m.clearedFields, message.FieldUsage)
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEventMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetProcessedTime sets the "processed_time" field.
-func (m *MessageMutation) SetProcessedTime(t time.Time) {
-	m.processed_time = &t
+// SetResource sets the "resource" field.
+func (m *AuditEventMutation) SetResource(s string) {
+	m.resource = &s
 }
 
-// ProcessedTime returns the value of the "processed_time" field in the mutation.
-func (m *MessageMutation) ProcessedTime() (r time.Time, exists bool) {
-	v := m.processed_time
+// Resource returns the value of the "resource" field in the mutation.
+func (m *AuditEventMutation) Resource() (r string, exists bool) {
+	v := m.resource
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessedTime returns the old "processed_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldResource returns the old "resource" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldProcessedTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldResource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessedTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldResource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessedTime requires an ID field in the mutation")
+		return v, errors.New("OldResource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessedTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldResource: %w", err)
 	}
-	return oldValue.ProcessedTime, nil
+	return oldValue.Resource, nil
 }
 
-// ClearProcessedTime clears the value of the "processed_time" field.
-func (m *MessageMutation) ClearProcessedTime() {
-	m.processed_time = nil
-	m.clearedFields[message.FieldProcessedTime] = struct{}{}
+// ClearResource clears the value of the "resource" field.
+func (m *AuditEventMutation) ClearResource() {
+	m.resource = nil
+	m.clearedFields[auditevent.FieldResource] = struct{}{}
 }
 
-// ProcessedTimeCleared returns if the "processed_time" field was cleared in this mutation.
-func (m *MessageMutation) ProcessedTimeCleared() bool {
-	_, ok := m.clearedFields[message.FieldProcessedTime]
+// ResourceCleared returns if the "resource" field was cleared in this mutation.
+func (m *AuditEventMutation) ResourceCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldResource]
 	return ok
 }
 
-// ResetProcessedTime resets all changes to the "processed_time" field.
-func (m *MessageMutation) ResetProcessedTime() {
-	m.processed_time = nil
-	delete(m.clearedFields, message.FieldProcessedTime)
+// ResetResource resets all changes to the "resource" field.
+func (m *AuditEventMutation) ResetResource() {
+	m.resource = nil
+	delete(m.clearedFields, auditevent.FieldResource)
 }
 
 // SetTaskID sets the "task_id" field.
-func (m *MessageMutation) SetTaskID(u uuid.UUID) {
-	m.task = &u
+func (m *AuditEventMutation) SetTaskID(u uuid.UUID) {
+	m.task_id = &u
 }
 
 // TaskID returns the value of the "task_id" field in the mutation.
-func (m *MessageMutation) TaskID() (r uuid.UUID, exists bool) {
-	v := m.task
+func (m *AuditEventMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskID returns the old "task_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AuditEventMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
@@ -1357,199 +1885,489 @@ func (m *MessageMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error
 	return oldValue.TaskID, nil
 }
 
+// ClearTaskID clears the value of the "task_id" field.
+func (m *AuditEventMutation) ClearTaskID() {
+	m.task_id = nil
+	m.clearedFields[auditevent.FieldTaskID] = struct{}{}
+}
+
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *AuditEventMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldTaskID]
+	return ok
+}
+
 // ResetTaskID resets all changes to the "task_id" field.
-func (m *MessageMutation) ResetTaskID() {
-	m.task = nil
+func (m *AuditEventMutation) ResetTaskID() {
+	m.task_id = nil
+	delete(m.clearedFields, auditevent.FieldTaskID)
 }
 
-// SetAgentID sets the "agent_id" field.
-func (m *MessageMutation) SetAgentID(u uuid.UUID) {
-	m.agent = &u
+// SetWorkspace sets the "workspace" field.
+func (m *AuditEventMutation) SetWorkspace(s string) {
+	m.workspace = &s
 }
 
-// AgentID returns the value of the "agent_id" field in the mutation.
-func (m *MessageMutation) AgentID() (r uuid.UUID, exists bool) {
-	v := m.agent
+// Workspace returns the value of the "workspace" field in the mutation.
+func (m *AuditEventMutation) Workspace() (r string, exists bool) {
+	v := m.workspace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAgentID returns the old "agent_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldWorkspace returns the old "workspace" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AuditEventMutation) OldWorkspace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorkspace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgentID requires an ID field in the mutation")
+		return v, errors.New("OldWorkspace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorkspace: %w", err)
 	}
-	return oldValue.AgentID, nil
+	return oldValue.Workspace, nil
 }
 
-// ClearAgentID clears the value of the "agent_id" field.
-func (m *MessageMutation) ClearAgentID() {
-	m.agent = nil
-	m.clearedFields[message.FieldAgentID] = struct{}{}
+// ClearWorkspace clears the value of the "workspace" field.
+func (m *AuditEventMutation) ClearWorkspace() {
+	m.workspace = nil
+	m.clearedFields[auditevent.FieldWorkspace] = struct{}{}
 }
 
-// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
-func (m *MessageMutation) AgentIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldAgentID]
+// WorkspaceCleared returns if the "workspace" field was cleared in this mutation.
+func (m *AuditEventMutation) WorkspaceCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldWorkspace]
 	return ok
 }
 
-// ResetAgentID resets all changes to the "agent_id" field.
-func (m *MessageMutation) ResetAgentID() {
-	m.agent = nil
-	delete(m.clearedFields, message.FieldAgentID)
+// ResetWorkspace resets all changes to the "workspace" field.
+func (m *AuditEventMutation) ResetWorkspace() {
+	m.workspace = nil
+	delete(m.clearedFields, auditevent.FieldWorkspace)
 }
 
-// SetModelID sets the "model_id" field.
-func (m *MessageMutation) SetModelID(u uuid.UUID) {
-	m.model = &u
+// SetCommand sets the "command" field.
+func (m *AuditEventMutation) SetCommand(s string) {
+	m.command = &s
 }
 
-// ModelID returns the value of the "model_id" field in the mutation.
-func (m *MessageMutation) ModelID() (r uuid.UUID, exists bool) {
-	v := m.model
+// Command returns the value of the "command" field in the mutation.
+func (m *AuditEventMutation) Command() (r string, exists bool) {
+	v := m.command
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelID returns the old "model_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCommand returns the old "command" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldModelID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AuditEventMutation) OldCommand(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCommand is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelID requires an ID field in the mutation")
+		return v, errors.New("OldCommand requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCommand: %w", err)
 	}
-	return oldValue.ModelID, nil
+	return oldValue.Command, nil
 }
 
-// ClearModelID clears the value of the "model_id" field.
-func (m *MessageMutation) ClearModelID() {
-	m.model = nil
-	m.clearedFields[message.FieldModelID] = struct{}{}
+// ClearCommand clears the value of the "command" field.
+func (m *AuditEventMutation) ClearCommand() {
+	m.command = nil
+	m.clearedFields[auditevent.FieldCommand] = struct{}{}
 }
 
-// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
-func (m *MessageMutation) ModelIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldModelID]
+// CommandCleared returns if the "command" field was cleared in this mutation.
+func (m *AuditEventMutation) CommandCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldCommand]
 	return ok
 }
 
-// ResetModelID resets all changes to the "model_id" field.
-func (m *MessageMutation) ResetModelID() {
-	m.model = nil
-	delete(m.clearedFields, message.FieldModelID)
+// ResetCommand resets all changes to the "command" field.
+func (m *AuditEventMutation) ResetCommand() {
+	m.command = nil
+	delete(m.clearedFields, auditevent.FieldCommand)
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *MessageMutation) ClearTask() {
-	m.clearedtask = true
-	m.clearedFields[message.FieldTaskID] = struct{}{}
+// SetPath sets the "path" field.
+func (m *AuditEventMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *MessageMutation) TaskCleared() bool {
-	return m.clearedtask
+// Path returns the value of the "path" field in the mutation.
+func (m *AuditEventMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TaskIDs returns the "task" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) TaskIDs() (ids []uuid.UUID) {
-	if id := m.task; id != nil {
-		ids = append(ids, *id)
+// OldPath returns the old "path" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
 }
 
-// ResetTask resets all changes to the "task" edge.
-func (m *MessageMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
+// ClearPath clears the value of the "path" field.
+func (m *AuditEventMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[auditevent.FieldPath] = struct{}{}
 }
 
-// ClearAgent clears the "agent" edge to the Agent entity.
-func (m *MessageMutation) ClearAgent() {
-	m.clearedagent = true
-	m.clearedFields[message.FieldAgentID] = struct{}{}
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *AuditEventMutation) PathCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldPath]
+	return ok
 }
 
-// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
-func (m *MessageMutation) AgentCleared() bool {
-	return m.AgentIDCleared() || m.clearedagent
+// ResetPath resets all changes to the "path" field.
+func (m *AuditEventMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, auditevent.FieldPath)
 }
 
-// AgentIDs returns the "agent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AgentID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) AgentIDs() (ids []uuid.UUID) {
-	if id := m.agent; id != nil {
-		ids = append(ids, *id)
+// SetDiffHash sets the "diff_hash" field.
+func (m *AuditEventMutation) SetDiffHash(s string) {
+	m.diff_hash = &s
+}
+
+// DiffHash returns the value of the "diff_hash" field in the mutation.
+func (m *AuditEventMutation) DiffHash() (r string, exists bool) {
+	v := m.diff_hash
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetAgent resets all changes to the "agent" edge.
-func (m *MessageMutation) ResetAgent() {
-	m.agent = nil
-	m.clearedagent = false
+// OldDiffHash returns the old "diff_hash" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldDiffHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiffHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiffHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiffHash: %w", err)
+	}
+	return oldValue.DiffHash, nil
 }
 
-// ClearModel clears the "model" edge to the Model entity.
-func (m *MessageMutation) ClearModel() {
-	m.clearedmodel = true
-	m.clearedFields[message.FieldModelID] = struct{}{}
+// ClearDiffHash clears the value of the "diff_hash" field.
+func (m *AuditEventMutation) ClearDiffHash() {
+	m.diff_hash = nil
+	m.clearedFields[auditevent.FieldDiffHash] = struct{}{}
 }
 
-// ModelCleared reports if the "model" edge to the Model entity was cleared.
-func (m *MessageMutation) ModelCleared() bool {
-	return m.ModelIDCleared() || m.clearedmodel
+// DiffHashCleared returns if the "diff_hash" field was cleared in this mutation.
+func (m *AuditEventMutation) DiffHashCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDiffHash]
+	return ok
 }
 
-// ModelIDs returns the "model" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModelID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ModelIDs() (ids []uuid.UUID) {
-	if id := m.model; id != nil {
-		ids = append(ids, *id)
+// ResetDiffHash resets all changes to the "diff_hash" field.
+func (m *AuditEventMutation) ResetDiffHash() {
+	m.diff_hash = nil
+	delete(m.clearedFields, auditevent.FieldDiffHash)
+}
+
+// SetExitCode sets the "exit_code" field.
+func (m *AuditEventMutation) SetExitCode(i int) {
+	m.exit_code = &i
+	m.addexit_code = nil
+}
+
+// ExitCode returns the value of the "exit_code" field in the mutation.
+func (m *AuditEventMutation) ExitCode() (r int, exists bool) {
+	v := m.exit_code
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetModel resets all changes to the "model" edge.
-func (m *MessageMutation) ResetModel() {
-	m.model = nil
-	m.clearedmodel = false
+// OldExitCode returns the old "exit_code" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldExitCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitCode: %w", err)
+	}
+	return oldValue.ExitCode, nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// AddExitCode adds i to the "exit_code" field.
+func (m *AuditEventMutation) AddExitCode(i int) {
+	if m.addexit_code != nil {
+		*m.addexit_code += i
+	} else {
+		m.addexit_code = &i
+	}
+}
+
+// AddedExitCode returns the value that was added to the "exit_code" field in this mutation.
+func (m *AuditEventMutation) AddedExitCode() (r int, exists bool) {
+	v := m.addexit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (m *AuditEventMutation) ClearExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+	m.clearedFields[auditevent.FieldExitCode] = struct{}{}
+}
+
+// ExitCodeCleared returns if the "exit_code" field was cleared in this mutation.
+func (m *AuditEventMutation) ExitCodeCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldExitCode]
+	return ok
+}
+
+// ResetExitCode resets all changes to the "exit_code" field.
+func (m *AuditEventMutation) ResetExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+	delete(m.clearedFields, auditevent.FieldExitCode)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *AuditEventMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *AuditEventMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *AuditEventMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *AuditEventMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *AuditEventMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetError sets the "error" field.
+func (m *AuditEventMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *AuditEventMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *AuditEventMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[auditevent.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AuditEventMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *AuditEventMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, auditevent.FieldError)
+}
+
+// SetPreviousHash sets the "previous_hash" field.
+func (m *AuditEventMutation) SetPreviousHash(b []byte) {
+	m.previous_hash = &b
+}
+
+// PreviousHash returns the value of the "previous_hash" field in the mutation.
+func (m *AuditEventMutation) PreviousHash() (r []byte, exists bool) {
+	v := m.previous_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousHash returns the old "previous_hash" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldPreviousHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousHash: %w", err)
+	}
+	return oldValue.PreviousHash, nil
+}
+
+// ClearPreviousHash clears the value of the "previous_hash" field.
+func (m *AuditEventMutation) ClearPreviousHash() {
+	m.previous_hash = nil
+	m.clearedFields[auditevent.FieldPreviousHash] = struct{}{}
+}
+
+// PreviousHashCleared returns if the "previous_hash" field was cleared in this mutation.
+func (m *AuditEventMutation) PreviousHashCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldPreviousHash]
+	return ok
+}
+
+// ResetPreviousHash resets all changes to the "previous_hash" field.
+func (m *AuditEventMutation) ResetPreviousHash() {
+	m.previous_hash = nil
+	delete(m.clearedFields, auditevent.FieldPreviousHash)
+}
+
+// SetHash sets the "hash" field.
+func (m *AuditEventMutation) SetHash(b []byte) {
+	m.hash = &b
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *AuditEventMutation) Hash() (r []byte, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *AuditEventMutation) ResetHash() {
+	m.hash = nil
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1557,51 +2375,72 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *AuditEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *AuditEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.create_time != nil {
-		fields = append(fields, message.FieldCreateTime)
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.sequence != nil {
+		fields = append(fields, auditevent.FieldSequence)
 	}
-	if m.update_time != nil {
-		fields = append(fields, message.FieldUpdateTime)
+	if m.time != nil {
+		fields = append(fields, auditevent.FieldTime)
 	}
-	if m.source != nil {
-		fields = append(fields, message.FieldSource)
+	if m.kind != nil {
+		fields = append(fields, auditevent.FieldKind)
 	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+	if m.actor != nil {
+		fields = append(fields, auditevent.FieldActor)
 	}
-	if m.usage != nil {
-		fields = append(fields, message.FieldUsage)
+	if m.action != nil {
+		fields = append(fields, auditevent.FieldAction)
 	}
-	if m.processed_time != nil {
-		fields = append(fields, message.FieldProcessedTime)
+	if m.resource != nil {
+		fields = append(fields, auditevent.FieldResource)
 	}
-	if m.task != nil {
-		fields = append(fields, message.FieldTaskID)
+	if m.task_id != nil {
+		fields = append(fields, auditevent.FieldTaskID)
 	}
-	if m.agent != nil {
-		fields = append(fields, message.FieldAgentID)
+	if m.workspace != nil {
+		fields = append(fields, auditevent.FieldWorkspace)
 	}
-	if m.model != nil {
-		fields = append(fields, message.FieldModelID)
+	if m.command != nil {
+		fields = append(fields, auditevent.FieldCommand)
+	}
+	if m._path != nil {
+		fields = append(fields, auditevent.FieldPath)
+	}
+	if m.diff_hash != nil {
+		fields = append(fields, auditevent.FieldDiffHash)
+	}
+	if m.exit_code != nil {
+		fields = append(fields, auditevent.FieldExitCode)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, auditevent.FieldDurationMs)
+	}
+	if m.error != nil {
+		fields = append(fields, auditevent.FieldError)
+	}
+	if m.previous_hash != nil {
+		fields = append(fields, auditevent.FieldPreviousHash)
+	}
+	if m.hash != nil {
+		fields = append(fields, auditevent.FieldHash)
 	}
 	return fields
 }
@@ -1609,26 +2448,40 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldCreateTime:
-		return m.CreateTime()
-	case message.FieldUpdateTime:
-		return m.UpdateTime()
-	case message.FieldSource:
-		return m.Source()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldUsage:
-		return m.Usage()
-	case message.FieldProcessedTime:
-		return m.ProcessedTime()
-	case message.FieldTaskID:
+	case auditevent.FieldSequence:
+		return m.Sequence()
+	case auditevent.FieldTime:
+		return m.Time()
+	case auditevent.FieldKind:
+		return m.Kind()
+	case auditevent.FieldActor:
+		return m.Actor()
+	case auditevent.FieldAction:
+		return m.Action()
+	case auditevent.FieldResource:
+		return m.Resource()
+	case auditevent.FieldTaskID:
 		return m.TaskID()
-	case message.FieldAgentID:
-		return m.AgentID()
-	case message.FieldModelID:
-		return m.ModelID()
+	case auditevent.FieldWorkspace:
+		return m.Workspace()
+	case auditevent.FieldCommand:
+		return m.Command()
+	case auditevent.FieldPath:
+		return m.Path()
+	case auditevent.FieldDiffHash:
+		return m.DiffHash()
+	case auditevent.FieldExitCode:
+		return m.ExitCode()
+	case auditevent.FieldDurationMs:
+		return m.DurationMs()
+	case auditevent.FieldError:
+		return m.Error()
+	case auditevent.FieldPreviousHash:
+		return m.PreviousHash()
+	case auditevent.FieldHash:
+		return m.Hash()
 	}
 	return nil, false
 }
@@ -1636,363 +2489,444 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case message.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case message.FieldSource:
-		return m.OldSource(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldUsage:
-		return m.OldUsage(ctx)
-	case message.FieldProcessedTime:
-		return m.OldProcessedTime(ctx)
-	case message.FieldTaskID:
+	case auditevent.FieldSequence:
+		return m.OldSequence(ctx)
+	case auditevent.FieldTime:
+		return m.OldTime(ctx)
+	case auditevent.FieldKind:
+		return m.OldKind(ctx)
+	case auditevent.FieldActor:
+		return m.OldActor(ctx)
+	case auditevent.FieldAction:
+		return m.OldAction(ctx)
+	case auditevent.FieldResource:
+		return m.OldResource(ctx)
+	case auditevent.FieldTaskID:
 		return m.OldTaskID(ctx)
-	case message.FieldAgentID:
-		return m.OldAgentID(ctx)
-	case message.FieldModelID:
-		return m.OldModelID(ctx)
-	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	case auditevent.FieldWorkspace:
+		return m.OldWorkspace(ctx)
+	case auditevent.FieldCommand:
+		return m.OldCommand(ctx)
+	case auditevent.FieldPath:
+		return m.OldPath(ctx)
+	case auditevent.FieldDiffHash:
+		return m.OldDiffHash(ctx)
+	case auditevent.FieldExitCode:
+		return m.OldExitCode(ctx)
+	case auditevent.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case auditevent.FieldError:
+		return m.OldError(ctx)
+	case auditevent.FieldPreviousHash:
+		return m.OldPreviousHash(ctx)
+	case auditevent.FieldHash:
+		return m.OldHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldCreateTime:
-		v, ok := value.(time.Time)
+	case auditevent.FieldSequence:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetSequence(v)
 		return nil
-	case message.FieldUpdateTime:
+	case auditevent.FieldTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
+		m.SetTime(v)
 		return nil
-	case message.FieldSource:
-		v, ok := value.(types.MessageSource)
+	case auditevent.FieldKind:
+		v, ok := value.(types.AuditEventKind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSource(v)
+		m.SetKind(v)
 		return nil
-	case message.FieldContent:
-		v, ok := value.(*types.MessageContent)
+	case auditevent.FieldActor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetActor(v)
 		return nil
-	case message.FieldUsage:
-		v, ok := value.(*types.MessageUsage)
+	case auditevent.FieldAction:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsage(v)
+		m.SetAction(v)
 		return nil
-	case message.FieldProcessedTime:
-		v, ok := value.(time.Time)
+	case auditevent.FieldResource:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessedTime(v)
+		m.SetResource(v)
 		return nil
-	case message.FieldTaskID:
+	case auditevent.FieldTaskID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTaskID(v)
 		return nil
-	case message.FieldAgentID:
-		v, ok := value.(uuid.UUID)
+	case auditevent.FieldWorkspace:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAgentID(v)
+		m.SetWorkspace(v)
 		return nil
-	case message.FieldModelID:
-		v, ok := value.(uuid.UUID)
+	case auditevent.FieldCommand:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelID(v)
+		m.SetCommand(v)
+		return nil
+	case auditevent.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case auditevent.FieldDiffHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiffHash(v)
+		return nil
+	case auditevent.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitCode(v)
+		return nil
+	case auditevent.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case auditevent.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case auditevent.FieldPreviousHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousHash(v)
+		return nil
+	case auditevent.FieldHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	return nil
+func (m *AuditEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addsequence != nil {
+		fields = append(fields, auditevent.FieldSequence)
+	}
+	if m.addexit_code != nil {
+		fields = append(fields, auditevent.FieldExitCode)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, auditevent.FieldDurationMs)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldSequence:
+		return m.AddedSequence()
+	case auditevent.FieldExitCode:
+		return m.AddedExitCode()
+	case auditevent.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case auditevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSequence(v)
+		return nil
+	case auditevent.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExitCode(v)
+		return nil
+	case auditevent.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *AuditEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldUsage) {
-		fields = append(fields, message.FieldUsage)
+	if m.FieldCleared(auditevent.FieldActor) {
+		fields = append(fields, auditevent.FieldActor)
 	}
-	if m.FieldCleared(message.FieldProcessedTime) {
-		fields = append(fields, message.FieldProcessedTime)
+	if m.FieldCleared(auditevent.FieldResource) {
+		fields = append(fields, auditevent.FieldResource)
 	}
-	if m.FieldCleared(message.FieldAgentID) {
-		fields = append(fields, message.FieldAgentID)
+	if m.FieldCleared(auditevent.FieldTaskID) {
+		fields = append(fields, auditevent.FieldTaskID)
 	}
-	if m.FieldCleared(message.FieldModelID) {
-		fields = append(fields, message.FieldModelID)
+	if m.FieldCleared(auditevent.FieldWorkspace) {
+		fields = append(fields, auditevent.FieldWorkspace)
+	}
+	if m.FieldCleared(auditevent.FieldCommand) {
+		fields = append(fields, auditevent.FieldCommand)
+	}
+	if m.FieldCleared(auditevent.FieldPath) {
+		fields = append(fields, auditevent.FieldPath)
+	}
+	if m.FieldCleared(auditevent.FieldDiffHash) {
+		fields = append(fields, auditevent.FieldDiffHash)
+	}
+	if m.FieldCleared(auditevent.FieldExitCode) {
+		fields = append(fields, auditevent.FieldExitCode)
+	}
+	if m.FieldCleared(auditevent.FieldError) {
+		fields = append(fields, auditevent.FieldError)
+	}
+	if m.FieldCleared(auditevent.FieldPreviousHash) {
+		fields = append(fields, auditevent.FieldPreviousHash)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *AuditEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *AuditEventMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldUsage:
-		m.ClearUsage()
+	case auditevent.FieldActor:
+		m.ClearActor()
 		return nil
-	case message.FieldProcessedTime:
-		m.ClearProcessedTime()
+	case auditevent.FieldResource:
+		m.ClearResource()
 		return nil
-	case message.FieldAgentID:
-		m.ClearAgentID()
+	case auditevent.FieldTaskID:
+		m.ClearTaskID()
 		return nil
-	case message.FieldModelID:
-		m.ClearModelID()
+	case auditevent.FieldWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case auditevent.FieldCommand:
+		m.ClearCommand()
+		return nil
+	case auditevent.FieldPath:
+		m.ClearPath()
+		return nil
+	case auditevent.FieldDiffHash:
+		m.ClearDiffHash()
+		return nil
+	case auditevent.FieldExitCode:
+		m.ClearExitCode()
+		return nil
+	case auditevent.FieldError:
+		m.ClearError()
+		return nil
+	case auditevent.FieldPreviousHash:
+		m.ClearPreviousHash()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *AuditEventMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldCreateTime:
-		m.ResetCreateTime()
+	case auditevent.FieldSequence:
+		m.ResetSequence()
 		return nil
-	case message.FieldUpdateTime:
-		m.ResetUpdateTime()
+	case auditevent.FieldTime:
+		m.ResetTime()
 		return nil
-	case message.FieldSource:
-		m.ResetSource()
+	case auditevent.FieldKind:
+		m.ResetKind()
 		return nil
-	case message.FieldContent:
-		m.ResetContent()
+	case auditevent.FieldActor:
+		m.ResetActor()
 		return nil
-	case message.FieldUsage:
-		m.ResetUsage()
+	case auditevent.FieldAction:
+		m.ResetAction()
 		return nil
-	case message.FieldProcessedTime:
-		m.ResetProcessedTime()
+	case auditevent.FieldResource:
+		m.ResetResource()
 		return nil
-	case message.FieldTaskID:
+	case auditevent.FieldTaskID:
 		m.ResetTaskID()
 		return nil
-	case message.FieldAgentID:
-		m.ResetAgentID()
+	case auditevent.FieldWorkspace:
+		m.ResetWorkspace()
 		return nil
-	case message.FieldModelID:
-		m.ResetModelID()
+	case auditevent.FieldCommand:
+		m.ResetCommand()
+		return nil
+	case auditevent.FieldPath:
+		m.ResetPath()
+		return nil
+	case auditevent.FieldDiffHash:
+		m.ResetDiffHash()
+		return nil
+	case auditevent.FieldExitCode:
+		m.ResetExitCode()
+		return nil
+	case auditevent.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case auditevent.FieldError:
+		m.ResetError()
+		return nil
+	case auditevent.FieldPreviousHash:
+		m.ResetPreviousHash()
+		return nil
+	case auditevent.FieldHash:
+		m.ResetHash()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.task != nil {
-		edges = append(edges, message.EdgeTask)
-	}
-	if m.agent != nil {
-		edges = append(edges, message.EdgeAgent)
-	}
-	if m.model != nil {
-		edges = append(edges, message.EdgeModel)
-	}
+func (m *AuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case message.EdgeTask:
-		if id := m.task; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeAgent:
-		if id := m.agent; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeModel:
-		if id := m.model; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *AuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedtask {
-		edges = append(edges, message.EdgeTask)
-	}
-	if m.clearedagent {
-		edges = append(edges, message.EdgeAgent)
-	}
-	if m.clearedmodel {
-		edges = append(edges, message.EdgeModel)
-	}
+func (m *AuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case message.EdgeTask:
-		return m.clearedtask
-	case message.EdgeAgent:
-		return m.clearedagent
-	case message.EdgeModel:
-		return m.clearedmodel
-	}
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
-	switch name {
-	case message.EdgeTask:
-		m.ClearTask()
-		return nil
-	case message.EdgeAgent:
-		m.ClearAgent()
-		return nil
-	case message.EdgeModel:
-		m.ClearModel()
-		return nil
-	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
-	switch name {
-	case message.EdgeTask:
-		m.ResetTask()
-		return nil
-	case message.EdgeAgent:
-		m.ResetAgent()
-		return nil
-	case message.EdgeModel:
-		m.ResetModel()
-		return nil
-	}
-	return fmt.Errorf("unknown Message edge %s", name)
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
 }
 
-// ModelMutation represents an operation that mutates the Model nodes in the graph.
-type ModelMutation struct {
+// FileChangeMutation represents an operation that mutates the FileChange nodes in the graph.
+type FileChangeMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	create_time           *time.Time
-	update_time           *time.Time
-	name                  *string
-	context_window        *int64
-	addcontext_window     *int64
-	capabilities          *[]types.ModelCapability
-	appendcapabilities    []types.ModelCapability
-	input_cost            *float64
-	addinput_cost         *float64
-	output_cost           *float64
-	addoutput_cost        *float64
-	cache_write_cost      *float64
-	addcache_write_cost   *float64
-	cache_read_cost       *float64
-	addcache_read_cost    *float64
-	enabled               *bool
-	alias                 *string
-	clearedFields         map[string]struct{}
-	agents                map[uuid.UUID]struct{}
-	removedagents         map[uuid.UUID]struct{}
-	clearedagents         bool
-	model_provider        *uuid.UUID
-	clearedmodel_provider bool
-	messages              map[uuid.UUID]struct{}
-	removedmessages       map[uuid.UUID]struct{}
-	clearedmessages       bool
-	done                  bool
-	oldValue              func(context.Context) (*Model, error)
-	predicates            []predicate.Model
-}
-
-var _ ent.Mutation = (*ModelMutation)(nil)
-
-// modelOption allows management of the mutation configuration using functional options.
-type modelOption func(*ModelMutation)
-
-// newModelMutation creates new mutation for the Model entity.
-func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
-	m := &ModelMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	_path            *string
+	original_content *string
+	content          *string
+	status           *types.FileChangeStatus
+	reason           *string
+	clearedFields    map[string]struct{}
+	task             *uuid.UUID
+	clearedtask      bool
+	done             bool
+	oldValue         func(context.Context) (*FileChange, error)
+	predicates       []predicate.FileChange
+}
+
+var _ ent.Mutation = (*FileChangeMutation)(nil)
+
+// filechangeOption allows management of the mutation configuration using functional options.
+type filechangeOption func(*FileChangeMutation)
+
+// newFileChangeMutation creates new mutation for the FileChange entity.
+func newFileChangeMutation(c config, op Op, opts ...filechangeOption) *FileChangeMutation {
+	m := &FileChangeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeModel,
+		typ:           TypeFileChange,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2001,20 +2935,20 @@ func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
 	return m
 }
 
-// withModelID sets the ID field of the mutation.
-func withModelID(id uuid.UUID) modelOption {
-	return func(m *ModelMutation) {
+// withFileChangeID sets the ID field of the mutation.
+func withFileChangeID(id uuid.UUID) filechangeOption {
+	return func(m *FileChangeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Model
+			value *FileChange
 		)
-		m.oldValue = func(ctx context.Context) (*Model, error) {
+		m.oldValue = func(ctx context.Context) (*FileChange, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Model.Get(ctx, id)
+					value, err = m.Client().FileChange.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2023,10 +2957,10 @@ func withModelID(id uuid.UUID) modelOption {
 	}
 }
 
-// withModel sets the old Model of the mutation.
-func withModel(node *Model) modelOption {
-	return func(m *ModelMutation) {
-		m.oldValue = func(context.Context) (*Model, error) {
+// withFileChange sets the old FileChange of the mutation.
+func withFileChange(node *FileChange) filechangeOption {
+	return func(m *FileChangeMutation) {
+		m.oldValue = func(context.Context) (*FileChange, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2035,7 +2969,7 @@ func withModel(node *Model) modelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelMutation) Client() *Client {
+func (m FileChangeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2043,7 +2977,7 @@ func (m ModelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ModelMutation) Tx() (*Tx, error) {
+func (m FileChangeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -2053,14 +2987,14 @@ func (m ModelMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Model entities.
-func (m *ModelMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of FileChange entities.
+func (m *FileChangeMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
+func (m *FileChangeMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2071,7 +3005,7 @@ func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *FileChangeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2080,19 +3014,19 @@ func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Model.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FileChange.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ModelMutation) SetCreateTime(t time.Time) {
+func (m *FileChangeMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
+func (m *FileChangeMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2100,10 +3034,10 @@ func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the FileChange entity.
+// If the FileChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *FileChangeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2118,17 +3052,17 @@ func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ModelMutation) ResetCreateTime() {
+func (m *FileChangeMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ModelMutation) SetUpdateTime(t time.Time) {
+func (m *FileChangeMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *FileChangeMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2136,10 +3070,10 @@ func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the FileChange entity.
+// If the FileChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *FileChangeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2154,656 +3088,10283 @@ func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ModelMutation) ResetUpdateTime() {
+func (m *FileChangeMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetName sets the "name" field.
-func (m *ModelMutation) SetName(s string) {
-	m.name = &s
+// SetPath sets the "path" field.
+func (m *FileChangeMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ModelMutation) Name() (r string, exists bool) {
-	v := m.name
+// Path returns the value of the "path" field in the mutation.
+func (m *FileChangeMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the FileChange entity.
+// If the FileChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *FileChangeMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Path, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ModelMutation) ResetName() {
-	m.name = nil
+// ResetPath resets all changes to the "path" field.
+func (m *FileChangeMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetContextWindow sets the "context_window" field.
-func (m *ModelMutation) SetContextWindow(i int64) {
-	m.context_window = &i
-	m.addcontext_window = nil
+// SetOriginalContent sets the "original_content" field.
+func (m *FileChangeMutation) SetOriginalContent(s string) {
+	m.original_content = &s
 }
 
-// ContextWindow returns the value of the "context_window" field in the mutation.
-func (m *ModelMutation) ContextWindow() (r int64, exists bool) {
-	v := m.context_window
+// OriginalContent returns the value of the "original_content" field in the mutation.
+func (m *FileChangeMutation) OriginalContent() (r string, exists bool) {
+	v := m.original_content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContextWindow returns the old "context_window" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldOriginalContent returns the old "original_content" field's value of the FileChange entity.
+// If the FileChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldContextWindow(ctx context.Context) (v int64, err error) {
+func (m *FileChangeMutation) OldOriginalContent(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContextWindow is only allowed on UpdateOne operations")
+		return v, errors.New("OldOriginalContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContextWindow requires an ID field in the mutation")
+		return v, errors.New("OldOriginalContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContextWindow: %w", err)
+		return v, fmt.Errorf("querying old value for OldOriginalContent: %w", err)
 	}
-	return oldValue.ContextWindow, nil
+	return oldValue.OriginalContent, nil
 }
 
-// AddContextWindow adds i to the "context_window" field.
-func (m *ModelMutation) AddContextWindow(i int64) {
-	if m.addcontext_window != nil {
-		*m.addcontext_window += i
-	} else {
-		m.addcontext_window = &i
-	}
+// ClearOriginalContent clears the value of the "original_content" field.
+func (m *FileChangeMutation) ClearOriginalContent() {
+	m.original_content = nil
+	m.clearedFields[filechange.FieldOriginalContent] = struct{}{}
 }
 
-// AddedContextWindow returns the value that was added to the "context_window" field in this mutation.
-func (m *ModelMutation) AddedContextWindow() (r int64, exists bool) {
-	v := m.addcontext_window
-	if v == nil {
-		return
-	}
-	return *v, true
+// OriginalContentCleared returns if the "original_content" field was cleared in this mutation.
+func (m *FileChangeMutation) OriginalContentCleared() bool {
+	_, ok := m.clearedFields[filechange.FieldOriginalContent]
+	return ok
 }
 
-// ResetContextWindow resets all changes to the "context_window" field.
-func (m *ModelMutation) ResetContextWindow() {
-	m.context_window = nil
-	m.addcontext_window = nil
+// ResetOriginalContent resets all changes to the "original_content" field.
+func (m *FileChangeMutation) ResetOriginalContent() {
+	m.original_content = nil
+	delete(m.clearedFields, filechange.FieldOriginalContent)
 }
 
-// SetCapabilities sets the "capabilities" field.
-func (m *ModelMutation) SetCapabilities(tc []types.ModelCapability) {
-	m.capabilities = &tc
-	m.appendcapabilities = nil
+// SetContent sets the "content" field.
+func (m *FileChangeMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// Capabilities returns the value of the "capabilities" field in the mutation.
-func (m *ModelMutation) Capabilities() (r []types.ModelCapability, exists bool) {
-	v := m.capabilities
+// Content returns the value of the "content" field in the mutation.
+func (m *FileChangeMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCapabilities returns the old "capabilities" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the FileChange entity.
+// If the FileChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCapabilities(ctx context.Context) (v []types.ModelCapability, err error) {
+func (m *FileChangeMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCapabilities requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.Capabilities, nil
+	return oldValue.Content, nil
 }
 
-// AppendCapabilities adds tc to the "capabilities" field.
-func (m *ModelMutation) AppendCapabilities(tc []types.ModelCapability) {
-	m.appendcapabilities = append(m.appendcapabilities, tc...)
+// ResetContent resets all changes to the "content" field.
+func (m *FileChangeMutation) ResetContent() {
+	m.content = nil
 }
 
-// AppendedCapabilities returns the list of values that were appended to the "capabilities" field in this mutation.
-func (m *ModelMutation) AppendedCapabilities() ([]types.ModelCapability, bool) {
-	if len(m.appendcapabilities) == 0 {
-		return nil, false
-	}
-	return m.appendcapabilities, true
+// SetStatus sets the "status" field.
+func (m *FileChangeMutation) SetStatus(tcs types.FileChangeStatus) {
+	m.status = &tcs
 }
 
-// ClearCapabilities clears the value of the "capabilities" field.
-func (m *ModelMutation) ClearCapabilities() {
-	m.capabilities = nil
-	m.appendcapabilities = nil
-	m.clearedFields[model.FieldCapabilities] = struct{}{}
+// Status returns the value of the "status" field in the mutation.
+func (m *FileChangeMutation) Status() (r types.FileChangeStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CapabilitiesCleared returns if the "capabilities" field was cleared in this mutation.
-func (m *ModelMutation) CapabilitiesCleared() bool {
-	_, ok := m.clearedFields[model.FieldCapabilities]
-	return ok
+// OldStatus returns the old "status" field's value of the FileChange entity.
+// If the FileChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileChangeMutation) OldStatus(ctx context.Context) (v types.FileChangeStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ResetCapabilities resets all changes to the "capabilities" field.
-func (m *ModelMutation) ResetCapabilities() {
-	m.capabilities = nil
-	m.appendcapabilities = nil
-	delete(m.clearedFields, model.FieldCapabilities)
+// ResetStatus resets all changes to the "status" field.
+func (m *FileChangeMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetInputCost sets the "input_cost" field.
-func (m *ModelMutation) SetInputCost(f float64) {
-	m.input_cost = &f
-	m.addinput_cost = nil
+// SetReason sets the "reason" field.
+func (m *FileChangeMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// InputCost returns the value of the "input_cost" field in the mutation.
-func (m *ModelMutation) InputCost() (r float64, exists bool) {
-	v := m.input_cost
+// Reason returns the value of the "reason" field in the mutation.
+func (m *FileChangeMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputCost returns the old "input_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the FileChange entity.
+// If the FileChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldInputCost(ctx context.Context) (v float64, err error) {
+func (m *FileChangeMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputCost requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.InputCost, nil
+	return oldValue.Reason, nil
 }
 
-// AddInputCost adds f to the "input_cost" field.
-func (m *ModelMutation) AddInputCost(f float64) {
-	if m.addinput_cost != nil {
-		*m.addinput_cost += f
-	} else {
-		m.addinput_cost = &f
-	}
+// ClearReason clears the value of the "reason" field.
+func (m *FileChangeMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[filechange.FieldReason] = struct{}{}
 }
 
-// AddedInputCost returns the value that was added to the "input_cost" field in this mutation.
-func (m *ModelMutation) AddedInputCost() (r float64, exists bool) {
-	v := m.addinput_cost
-	if v == nil {
-		return
-	}
-	return *v, true
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *FileChangeMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[filechange.FieldReason]
+	return ok
 }
 
-// ResetInputCost resets all changes to the "input_cost" field.
-func (m *ModelMutation) ResetInputCost() {
-	m.input_cost = nil
-	m.addinput_cost = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *FileChangeMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, filechange.FieldReason)
 }
 
-// SetOutputCost sets the "output_cost" field.
-func (m *ModelMutation) SetOutputCost(f float64) {
-	m.output_cost = &f
-	m.addoutput_cost = nil
+// SetTaskID sets the "task_id" field.
+func (m *FileChangeMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
 }
 
-// OutputCost returns the value of the "output_cost" field in the mutation.
-func (m *ModelMutation) OutputCost() (r float64, exists bool) {
-	v := m.output_cost
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *FileChangeMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputCost returns the old "output_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the FileChange entity.
+// If the FileChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldOutputCost(ctx context.Context) (v float64, err error) {
+func (m *FileChangeMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputCost requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.OutputCost, nil
+	return oldValue.TaskID, nil
 }
 
-// AddOutputCost adds f to the "output_cost" field.
-func (m *ModelMutation) AddOutputCost(f float64) {
-	if m.addoutput_cost != nil {
-		*m.addoutput_cost += f
-	} else {
-		m.addoutput_cost = &f
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *FileChangeMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *FileChangeMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[filechange.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *FileChangeMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *FileChangeMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// AddedOutputCost returns the value that was added to the "output_cost" field in this mutation.
-func (m *ModelMutation) AddedOutputCost() (r float64, exists bool) {
-	v := m.addoutput_cost
-	if v == nil {
-		return
+// ResetTask resets all changes to the "task" edge.
+func (m *FileChangeMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the FileChangeMutation builder.
+func (m *FileChangeMutation) Where(ps ...predicate.FileChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FileChange).
+func (m *FileChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileChangeMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, filechange.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, filechange.FieldUpdateTime)
+	}
+	if m._path != nil {
+		fields = append(fields, filechange.FieldPath)
+	}
+	if m.original_content != nil {
+		fields = append(fields, filechange.FieldOriginalContent)
+	}
+	if m.content != nil {
+		fields = append(fields, filechange.FieldContent)
+	}
+	if m.status != nil {
+		fields = append(fields, filechange.FieldStatus)
+	}
+	if m.reason != nil {
+		fields = append(fields, filechange.FieldReason)
+	}
+	if m.task != nil {
+		fields = append(fields, filechange.FieldTaskID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileChangeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filechange.FieldCreateTime:
+		return m.CreateTime()
+	case filechange.FieldUpdateTime:
+		return m.UpdateTime()
+	case filechange.FieldPath:
+		return m.Path()
+	case filechange.FieldOriginalContent:
+		return m.OriginalContent()
+	case filechange.FieldContent:
+		return m.Content()
+	case filechange.FieldStatus:
+		return m.Status()
+	case filechange.FieldReason:
+		return m.Reason()
+	case filechange.FieldTaskID:
+		return m.TaskID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case filechange.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case filechange.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case filechange.FieldPath:
+		return m.OldPath(ctx)
+	case filechange.FieldOriginalContent:
+		return m.OldOriginalContent(ctx)
+	case filechange.FieldContent:
+		return m.OldContent(ctx)
+	case filechange.FieldStatus:
+		return m.OldStatus(ctx)
+	case filechange.FieldReason:
+		return m.OldReason(ctx)
+	case filechange.FieldTaskID:
+		return m.OldTaskID(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileChange field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileChangeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filechange.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case filechange.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case filechange.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case filechange.FieldOriginalContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalContent(v)
+		return nil
+	case filechange.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case filechange.FieldStatus:
+		v, ok := value.(types.FileChangeStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case filechange.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case filechange.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileChange field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileChangeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileChangeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileChangeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FileChange numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileChangeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(filechange.FieldOriginalContent) {
+		fields = append(fields, filechange.FieldOriginalContent)
+	}
+	if m.FieldCleared(filechange.FieldReason) {
+		fields = append(fields, filechange.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileChangeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileChangeMutation) ClearField(name string) error {
+	switch name {
+	case filechange.FieldOriginalContent:
+		m.ClearOriginalContent()
+		return nil
+	case filechange.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown FileChange nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileChangeMutation) ResetField(name string) error {
+	switch name {
+	case filechange.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case filechange.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case filechange.FieldPath:
+		m.ResetPath()
+		return nil
+	case filechange.FieldOriginalContent:
+		m.ResetOriginalContent()
+		return nil
+	case filechange.FieldContent:
+		m.ResetContent()
+		return nil
+	case filechange.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case filechange.FieldReason:
+		m.ResetReason()
+		return nil
+	case filechange.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	}
+	return fmt.Errorf("unknown FileChange field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, filechange.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileChangeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filechange.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileChangeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, filechange.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileChangeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filechange.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileChangeMutation) ClearEdge(name string) error {
+	switch name {
+	case filechange.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown FileChange unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileChangeMutation) ResetEdge(name string) error {
+	switch name {
+	case filechange.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown FileChange edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	source         *types.MessageSource
+	content        **types.MessageContent
+	usage          **types.MessageUsage
+	processed_time *time.Time
+	clearedFields  map[string]struct{}
+	task           *uuid.UUID
+	clearedtask    bool
+	agent          *uuid.UUID
+	clearedagent   bool
+	model          *uuid.UUID
+	clearedmodel   bool
+	done           bool
+	oldValue       func(context.Context) (*Message, error)
+	predicates     []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *MessageMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MessageMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *MessageMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *MessageMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetSource sets the "source" field.
+func (m *MessageMutation) SetSource(ts types.MessageSource) {
+	m.source = &ts
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *MessageMutation) Source() (r types.MessageSource, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSource(ctx context.Context) (v types.MessageSource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *MessageMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(tc *types.MessageContent) {
+	m.content = &tc
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r *types.MessageContent, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v *types.MessageContent, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetUsage sets the "usage" field.
+func (m *MessageMutation) SetUsage(tu *types.MessageUsage) {
+	m.usage = &tu
+}
+
+// Usage returns the value of the "usage" field in the mutation.
+func (m *MessageMutation) Usage() (r *types.MessageUsage, exists bool) {
+	v := m.usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsage returns the old "usage" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUsage(ctx context.Context) (v *types.MessageUsage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
+	}
+	return oldValue.Usage, nil
+}
+
+// ClearUsage clears the value of the "usage" field.
+func (m *MessageMutation) ClearUsage() {
+	m.usage = nil
+	m.clearedFields[message.FieldUsage] = struct{}{}
+}
+
+// UsageCleared returns if the "usage" field was cleared in this mutation.
+func (m *MessageMutation) UsageCleared() bool {
+	_, ok := m.clearedFields[message.FieldUsage]
+	return ok
+}
+
+// ResetUsage resets all changes to the "usage" field.
+func (m *MessageMutation) ResetUsage() {
+	m.usage = nil
+	delete(m.clearedFields, message.FieldUsage)
+}
+
+// SetProcessedTime sets the "processed_time" field.
+func (m *MessageMutation) SetProcessedTime(t time.Time) {
+	m.processed_time = &t
+}
+
+// ProcessedTime returns the value of the "processed_time" field in the mutation.
+func (m *MessageMutation) ProcessedTime() (r time.Time, exists bool) {
+	v := m.processed_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessedTime returns the old "processed_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldProcessedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedTime: %w", err)
+	}
+	return oldValue.ProcessedTime, nil
+}
+
+// ClearProcessedTime clears the value of the "processed_time" field.
+func (m *MessageMutation) ClearProcessedTime() {
+	m.processed_time = nil
+	m.clearedFields[message.FieldProcessedTime] = struct{}{}
+}
+
+// ProcessedTimeCleared returns if the "processed_time" field was cleared in this mutation.
+func (m *MessageMutation) ProcessedTimeCleared() bool {
+	_, ok := m.clearedFields[message.FieldProcessedTime]
+	return ok
+}
+
+// ResetProcessedTime resets all changes to the "processed_time" field.
+func (m *MessageMutation) ResetProcessedTime() {
+	m.processed_time = nil
+	delete(m.clearedFields, message.FieldProcessedTime)
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *MessageMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *MessageMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *MessageMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *MessageMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *MessageMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *MessageMutation) ClearAgentID() {
+	m.agent = nil
+	m.clearedFields[message.FieldAgentID] = struct{}{}
+}
+
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *MessageMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAgentID]
+	return ok
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *MessageMutation) ResetAgentID() {
+	m.agent = nil
+	delete(m.clearedFields, message.FieldAgentID)
+}
+
+// SetModelID sets the "model_id" field.
+func (m *MessageMutation) SetModelID(u uuid.UUID) {
+	m.model = &u
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *MessageMutation) ModelID() (r uuid.UUID, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldModelID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (m *MessageMutation) ClearModelID() {
+	m.model = nil
+	m.clearedFields[message.FieldModelID] = struct{}{}
+}
+
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *MessageMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldModelID]
+	return ok
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *MessageMutation) ResetModelID() {
+	m.model = nil
+	delete(m.clearedFields, message.FieldModelID)
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *MessageMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[message.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *MessageMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *MessageMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *MessageMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[message.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *MessageMutation) AgentCleared() bool {
+	return m.AgentIDCleared() || m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *MessageMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// ClearModel clears the "model" edge to the Model entity.
+func (m *MessageMutation) ClearModel() {
+	m.clearedmodel = true
+	m.clearedFields[message.FieldModelID] = struct{}{}
+}
+
+// ModelCleared reports if the "model" edge to the Model entity was cleared.
+func (m *MessageMutation) ModelCleared() bool {
+	return m.ModelIDCleared() || m.clearedmodel
+}
+
+// ModelIDs returns the "model" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ModelIDs() (ids []uuid.UUID) {
+	if id := m.model; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModel resets all changes to the "model" edge.
+func (m *MessageMutation) ResetModel() {
+	m.model = nil
+	m.clearedmodel = false
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, message.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, message.FieldUpdateTime)
+	}
+	if m.source != nil {
+		fields = append(fields, message.FieldSource)
+	}
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.usage != nil {
+		fields = append(fields, message.FieldUsage)
+	}
+	if m.processed_time != nil {
+		fields = append(fields, message.FieldProcessedTime)
+	}
+	if m.task != nil {
+		fields = append(fields, message.FieldTaskID)
+	}
+	if m.agent != nil {
+		fields = append(fields, message.FieldAgentID)
+	}
+	if m.model != nil {
+		fields = append(fields, message.FieldModelID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldCreateTime:
+		return m.CreateTime()
+	case message.FieldUpdateTime:
+		return m.UpdateTime()
+	case message.FieldSource:
+		return m.Source()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldUsage:
+		return m.Usage()
+	case message.FieldProcessedTime:
+		return m.ProcessedTime()
+	case message.FieldTaskID:
+		return m.TaskID()
+	case message.FieldAgentID:
+		return m.AgentID()
+	case message.FieldModelID:
+		return m.ModelID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case message.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case message.FieldSource:
+		return m.OldSource(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldUsage:
+		return m.OldUsage(ctx)
+	case message.FieldProcessedTime:
+		return m.OldProcessedTime(ctx)
+	case message.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case message.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case message.FieldModelID:
+		return m.OldModelID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case message.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case message.FieldSource:
+		v, ok := value.(types.MessageSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case message.FieldContent:
+		v, ok := value.(*types.MessageContent)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldUsage:
+		v, ok := value.(*types.MessageUsage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsage(v)
+		return nil
+	case message.FieldProcessedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedTime(v)
+		return nil
+	case message.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case message.FieldAgentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case message.FieldModelID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldUsage) {
+		fields = append(fields, message.FieldUsage)
+	}
+	if m.FieldCleared(message.FieldProcessedTime) {
+		fields = append(fields, message.FieldProcessedTime)
+	}
+	if m.FieldCleared(message.FieldAgentID) {
+		fields = append(fields, message.FieldAgentID)
+	}
+	if m.FieldCleared(message.FieldModelID) {
+		fields = append(fields, message.FieldModelID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldUsage:
+		m.ClearUsage()
+		return nil
+	case message.FieldProcessedTime:
+		m.ClearProcessedTime()
+		return nil
+	case message.FieldAgentID:
+		m.ClearAgentID()
+		return nil
+	case message.FieldModelID:
+		m.ClearModelID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case message.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case message.FieldSource:
+		m.ResetSource()
+		return nil
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldUsage:
+		m.ResetUsage()
+		return nil
+	case message.FieldProcessedTime:
+		m.ResetProcessedTime()
+		return nil
+	case message.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case message.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case message.FieldModelID:
+		m.ResetModelID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.task != nil {
+		edges = append(edges, message.EdgeTask)
+	}
+	if m.agent != nil {
+		edges = append(edges, message.EdgeAgent)
+	}
+	if m.model != nil {
+		edges = append(edges, message.EdgeModel)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeModel:
+		if id := m.model; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtask {
+		edges = append(edges, message.EdgeTask)
+	}
+	if m.clearedagent {
+		edges = append(edges, message.EdgeAgent)
+	}
+	if m.clearedmodel {
+		edges = append(edges, message.EdgeModel)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeTask:
+		return m.clearedtask
+	case message.EdgeAgent:
+		return m.clearedagent
+	case message.EdgeModel:
+		return m.clearedmodel
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeTask:
+		m.ClearTask()
+		return nil
+	case message.EdgeAgent:
+		m.ClearAgent()
+		return nil
+	case message.EdgeModel:
+		m.ClearModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeTask:
+		m.ResetTask()
+		return nil
+	case message.EdgeAgent:
+		m.ResetAgent()
+		return nil
+	case message.EdgeModel:
+		m.ResetModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// ModelMutation represents an operation that mutates the Model nodes in the graph.
+type ModelMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	create_time           *time.Time
+	update_time           *time.Time
+	name                  *string
+	context_window        *int64
+	addcontext_window     *int64
+	capabilities          *[]types.ModelCapability
+	appendcapabilities    []types.ModelCapability
+	input_cost            *float64
+	addinput_cost         *float64
+	output_cost           *float64
+	addoutput_cost        *float64
+	cache_write_cost      *float64
+	addcache_write_cost   *float64
+	cache_read_cost       *float64
+	addcache_read_cost    *float64
+	enabled               *bool
+	alias                 *string
+	clearedFields         map[string]struct{}
+	agents                map[uuid.UUID]struct{}
+	removedagents         map[uuid.UUID]struct{}
+	clearedagents         bool
+	model_provider        *uuid.UUID
+	clearedmodel_provider bool
+	messages              map[uuid.UUID]struct{}
+	removedmessages       map[uuid.UUID]struct{}
+	clearedmessages       bool
+	done                  bool
+	oldValue              func(context.Context) (*Model, error)
+	predicates            []predicate.Model
+}
+
+var _ ent.Mutation = (*ModelMutation)(nil)
+
+// modelOption allows management of the mutation configuration using functional options.
+type modelOption func(*ModelMutation)
+
+// newModelMutation creates new mutation for the Model entity.
+func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
+	m := &ModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelID sets the ID field of the mutation.
+func withModelID(id uuid.UUID) modelOption {
+	return func(m *ModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Model
+		)
+		m.oldValue = func(ctx context.Context) (*Model, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Model.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModel sets the old Model of the mutation.
+func withModel(node *Model) modelOption {
+	return func(m *ModelMutation) {
+		m.oldValue = func(context.Context) (*Model, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Model entities.
+func (m *ModelMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Model.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ModelMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ModelMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ModelMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ModelMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ModelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ModelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ModelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetContextWindow sets the "context_window" field.
+func (m *ModelMutation) SetContextWindow(i int64) {
+	m.context_window = &i
+	m.addcontext_window = nil
+}
+
+// ContextWindow returns the value of the "context_window" field in the mutation.
+func (m *ModelMutation) ContextWindow() (r int64, exists bool) {
+	v := m.context_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContextWindow returns the old "context_window" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldContextWindow(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContextWindow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContextWindow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContextWindow: %w", err)
+	}
+	return oldValue.ContextWindow, nil
+}
+
+// AddContextWindow adds i to the "context_window" field.
+func (m *ModelMutation) AddContextWindow(i int64) {
+	if m.addcontext_window != nil {
+		*m.addcontext_window += i
+	} else {
+		m.addcontext_window = &i
+	}
+}
+
+// AddedContextWindow returns the value that was added to the "context_window" field in this mutation.
+func (m *ModelMutation) AddedContextWindow() (r int64, exists bool) {
+	v := m.addcontext_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetContextWindow resets all changes to the "context_window" field.
+func (m *ModelMutation) ResetContextWindow() {
+	m.context_window = nil
+	m.addcontext_window = nil
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (m *ModelMutation) SetCapabilities(tc []types.ModelCapability) {
+	m.capabilities = &tc
+	m.appendcapabilities = nil
+}
+
+// Capabilities returns the value of the "capabilities" field in the mutation.
+func (m *ModelMutation) Capabilities() (r []types.ModelCapability, exists bool) {
+	v := m.capabilities
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapabilities returns the old "capabilities" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCapabilities(ctx context.Context) (v []types.ModelCapability, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapabilities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
+	}
+	return oldValue.Capabilities, nil
+}
+
+// AppendCapabilities adds tc to the "capabilities" field.
+func (m *ModelMutation) AppendCapabilities(tc []types.ModelCapability) {
+	m.appendcapabilities = append(m.appendcapabilities, tc...)
+}
+
+// AppendedCapabilities returns the list of values that were appended to the "capabilities" field in this mutation.
+func (m *ModelMutation) AppendedCapabilities() ([]types.ModelCapability, bool) {
+	if len(m.appendcapabilities) == 0 {
+		return nil, false
+	}
+	return m.appendcapabilities, true
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (m *ModelMutation) ClearCapabilities() {
+	m.capabilities = nil
+	m.appendcapabilities = nil
+	m.clearedFields[model.FieldCapabilities] = struct{}{}
+}
+
+// CapabilitiesCleared returns if the "capabilities" field was cleared in this mutation.
+func (m *ModelMutation) CapabilitiesCleared() bool {
+	_, ok := m.clearedFields[model.FieldCapabilities]
+	return ok
+}
+
+// ResetCapabilities resets all changes to the "capabilities" field.
+func (m *ModelMutation) ResetCapabilities() {
+	m.capabilities = nil
+	m.appendcapabilities = nil
+	delete(m.clearedFields, model.FieldCapabilities)
+}
+
+// SetInputCost sets the "input_cost" field.
+func (m *ModelMutation) SetInputCost(f float64) {
+	m.input_cost = &f
+	m.addinput_cost = nil
+}
+
+// InputCost returns the value of the "input_cost" field in the mutation.
+func (m *ModelMutation) InputCost() (r float64, exists bool) {
+	v := m.input_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputCost returns the old "input_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldInputCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputCost: %w", err)
+	}
+	return oldValue.InputCost, nil
+}
+
+// AddInputCost adds f to the "input_cost" field.
+func (m *ModelMutation) AddInputCost(f float64) {
+	if m.addinput_cost != nil {
+		*m.addinput_cost += f
+	} else {
+		m.addinput_cost = &f
+	}
+}
+
+// AddedInputCost returns the value that was added to the "input_cost" field in this mutation.
+func (m *ModelMutation) AddedInputCost() (r float64, exists bool) {
+	v := m.addinput_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInputCost resets all changes to the "input_cost" field.
+func (m *ModelMutation) ResetInputCost() {
+	m.input_cost = nil
+	m.addinput_cost = nil
+}
+
+// SetOutputCost sets the "output_cost" field.
+func (m *ModelMutation) SetOutputCost(f float64) {
+	m.output_cost = &f
+	m.addoutput_cost = nil
+}
+
+// OutputCost returns the value of the "output_cost" field in the mutation.
+func (m *ModelMutation) OutputCost() (r float64, exists bool) {
+	v := m.output_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputCost returns the old "output_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldOutputCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputCost: %w", err)
+	}
+	return oldValue.OutputCost, nil
+}
+
+// AddOutputCost adds f to the "output_cost" field.
+func (m *ModelMutation) AddOutputCost(f float64) {
+	if m.addoutput_cost != nil {
+		*m.addoutput_cost += f
+	} else {
+		m.addoutput_cost = &f
+	}
+}
+
+// AddedOutputCost returns the value that was added to the "output_cost" field in this mutation.
+func (m *ModelMutation) AddedOutputCost() (r float64, exists bool) {
+	v := m.addoutput_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOutputCost resets all changes to the "output_cost" field.
+func (m *ModelMutation) ResetOutputCost() {
+	m.output_cost = nil
+	m.addoutput_cost = nil
+}
+
+// SetCacheWriteCost sets the "cache_write_cost" field.
+func (m *ModelMutation) SetCacheWriteCost(f float64) {
+	m.cache_write_cost = &f
+	m.addcache_write_cost = nil
+}
+
+// CacheWriteCost returns the value of the "cache_write_cost" field in the mutation.
+func (m *ModelMutation) CacheWriteCost() (r float64, exists bool) {
+	v := m.cache_write_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheWriteCost returns the old "cache_write_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCacheWriteCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheWriteCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheWriteCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheWriteCost: %w", err)
+	}
+	return oldValue.CacheWriteCost, nil
+}
+
+// AddCacheWriteCost adds f to the "cache_write_cost" field.
+func (m *ModelMutation) AddCacheWriteCost(f float64) {
+	if m.addcache_write_cost != nil {
+		*m.addcache_write_cost += f
+	} else {
+		m.addcache_write_cost = &f
+	}
+}
+
+// AddedCacheWriteCost returns the value that was added to the "cache_write_cost" field in this mutation.
+func (m *ModelMutation) AddedCacheWriteCost() (r float64, exists bool) {
+	v := m.addcache_write_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheWriteCost resets all changes to the "cache_write_cost" field.
+func (m *ModelMutation) ResetCacheWriteCost() {
+	m.cache_write_cost = nil
+	m.addcache_write_cost = nil
+}
+
+// SetCacheReadCost sets the "cache_read_cost" field.
+func (m *ModelMutation) SetCacheReadCost(f float64) {
+	m.cache_read_cost = &f
+	m.addcache_read_cost = nil
+}
+
+// CacheReadCost returns the value of the "cache_read_cost" field in the mutation.
+func (m *ModelMutation) CacheReadCost() (r float64, exists bool) {
+	v := m.cache_read_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheReadCost returns the old "cache_read_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCacheReadCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheReadCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheReadCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheReadCost: %w", err)
+	}
+	return oldValue.CacheReadCost, nil
+}
+
+// AddCacheReadCost adds f to the "cache_read_cost" field.
+func (m *ModelMutation) AddCacheReadCost(f float64) {
+	if m.addcache_read_cost != nil {
+		*m.addcache_read_cost += f
+	} else {
+		m.addcache_read_cost = &f
+	}
+}
+
+// AddedCacheReadCost returns the value that was added to the "cache_read_cost" field in this mutation.
+func (m *ModelMutation) AddedCacheReadCost() (r float64, exists bool) {
+	v := m.addcache_read_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheReadCost resets all changes to the "cache_read_cost" field.
+func (m *ModelMutation) ResetCacheReadCost() {
+	m.cache_read_cost = nil
+	m.addcache_read_cost = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ModelMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ModelMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ModelMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *ModelMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *ModelMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (m *ModelMutation) ClearAlias() {
+	m.alias = nil
+	m.clearedFields[model.FieldAlias] = struct{}{}
+}
+
+// AliasCleared returns if the "alias" field was cleared in this mutation.
+func (m *ModelMutation) AliasCleared() bool {
+	_, ok := m.clearedFields[model.FieldAlias]
+	return ok
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *ModelMutation) ResetAlias() {
+	m.alias = nil
+	delete(m.clearedFields, model.FieldAlias)
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (m *ModelMutation) SetModelProviderID(u uuid.UUID) {
+	m.model_provider = &u
+}
+
+// ModelProviderID returns the value of the "model_provider_id" field in the mutation.
+func (m *ModelMutation) ModelProviderID() (r uuid.UUID, exists bool) {
+	v := m.model_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelProviderID returns the old "model_provider_id" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldModelProviderID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelProviderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelProviderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelProviderID: %w", err)
+	}
+	return oldValue.ModelProviderID, nil
+}
+
+// ResetModelProviderID resets all changes to the "model_provider_id" field.
+func (m *ModelMutation) ResetModelProviderID() {
+	m.model_provider = nil
+}
+
+// AddAgentIDs adds the "agents" edge to the Agent entity by ids.
+func (m *ModelMutation) AddAgentIDs(ids ...uuid.UUID) {
+	if m.agents == nil {
+		m.agents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.agents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAgents clears the "agents" edge to the Agent entity.
+func (m *ModelMutation) ClearAgents() {
+	m.clearedagents = true
+}
+
+// AgentsCleared reports if the "agents" edge to the Agent entity was cleared.
+func (m *ModelMutation) AgentsCleared() bool {
+	return m.clearedagents
+}
+
+// RemoveAgentIDs removes the "agents" edge to the Agent entity by IDs.
+func (m *ModelMutation) RemoveAgentIDs(ids ...uuid.UUID) {
+	if m.removedagents == nil {
+		m.removedagents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.agents, ids[i])
+		m.removedagents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAgents returns the removed IDs of the "agents" edge to the Agent entity.
+func (m *ModelMutation) RemovedAgentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedagents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AgentsIDs returns the "agents" edge IDs in the mutation.
+func (m *ModelMutation) AgentsIDs() (ids []uuid.UUID) {
+	for id := range m.agents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAgents resets all changes to the "agents" edge.
+func (m *ModelMutation) ResetAgents() {
+	m.agents = nil
+	m.clearedagents = false
+	m.removedagents = nil
+}
+
+// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
+func (m *ModelMutation) ClearModelProvider() {
+	m.clearedmodel_provider = true
+	m.clearedFields[model.FieldModelProviderID] = struct{}{}
+}
+
+// ModelProviderCleared reports if the "model_provider" edge to the ModelProvider entity was cleared.
+func (m *ModelMutation) ModelProviderCleared() bool {
+	return m.clearedmodel_provider
+}
+
+// ModelProviderIDs returns the "model_provider" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelProviderID instead. It exists only for internal usage by the builders.
+func (m *ModelMutation) ModelProviderIDs() (ids []uuid.UUID) {
+	if id := m.model_provider; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModelProvider resets all changes to the "model_provider" edge.
+func (m *ModelMutation) ResetModelProvider() {
+	m.model_provider = nil
+	m.clearedmodel_provider = false
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ModelMutation) AddMessageIDs(ids ...uuid.UUID) {
+	if m.messages == nil {
+		m.messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ModelMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ModelMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ModelMutation) RemoveMessageIDs(ids ...uuid.UUID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ModelMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ModelMutation) MessagesIDs() (ids []uuid.UUID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ModelMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// Where appends a list predicates to the ModelMutation builder.
+func (m *ModelMutation) Where(ps ...predicate.Model) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Model, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Model).
+func (m *ModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_time != nil {
+		fields = append(fields, model.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, model.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, model.FieldName)
+	}
+	if m.context_window != nil {
+		fields = append(fields, model.FieldContextWindow)
+	}
+	if m.capabilities != nil {
+		fields = append(fields, model.FieldCapabilities)
+	}
+	if m.input_cost != nil {
+		fields = append(fields, model.FieldInputCost)
+	}
+	if m.output_cost != nil {
+		fields = append(fields, model.FieldOutputCost)
+	}
+	if m.cache_write_cost != nil {
+		fields = append(fields, model.FieldCacheWriteCost)
+	}
+	if m.cache_read_cost != nil {
+		fields = append(fields, model.FieldCacheReadCost)
+	}
+	if m.enabled != nil {
+		fields = append(fields, model.FieldEnabled)
+	}
+	if m.alias != nil {
+		fields = append(fields, model.FieldAlias)
+	}
+	if m.model_provider != nil {
+		fields = append(fields, model.FieldModelProviderID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case model.FieldCreateTime:
+		return m.CreateTime()
+	case model.FieldUpdateTime:
+		return m.UpdateTime()
+	case model.FieldName:
+		return m.Name()
+	case model.FieldContextWindow:
+		return m.ContextWindow()
+	case model.FieldCapabilities:
+		return m.Capabilities()
+	case model.FieldInputCost:
+		return m.InputCost()
+	case model.FieldOutputCost:
+		return m.OutputCost()
+	case model.FieldCacheWriteCost:
+		return m.CacheWriteCost()
+	case model.FieldCacheReadCost:
+		return m.CacheReadCost()
+	case model.FieldEnabled:
+		return m.Enabled()
+	case model.FieldAlias:
+		return m.Alias()
+	case model.FieldModelProviderID:
+		return m.ModelProviderID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case model.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case model.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case model.FieldName:
+		return m.OldName(ctx)
+	case model.FieldContextWindow:
+		return m.OldContextWindow(ctx)
+	case model.FieldCapabilities:
+		return m.OldCapabilities(ctx)
+	case model.FieldInputCost:
+		return m.OldInputCost(ctx)
+	case model.FieldOutputCost:
+		return m.OldOutputCost(ctx)
+	case model.FieldCacheWriteCost:
+		return m.OldCacheWriteCost(ctx)
+	case model.FieldCacheReadCost:
+		return m.OldCacheReadCost(ctx)
+	case model.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case model.FieldAlias:
+		return m.OldAlias(ctx)
+	case model.FieldModelProviderID:
+		return m.OldModelProviderID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Model field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case model.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case model.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case model.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case model.FieldContextWindow:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContextWindow(v)
+		return nil
+	case model.FieldCapabilities:
+		v, ok := value.([]types.ModelCapability)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapabilities(v)
+		return nil
+	case model.FieldInputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputCost(v)
+		return nil
+	case model.FieldOutputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputCost(v)
+		return nil
+	case model.FieldCacheWriteCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheWriteCost(v)
+		return nil
+	case model.FieldCacheReadCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheReadCost(v)
+		return nil
+	case model.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case model.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case model.FieldModelProviderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelProviderID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Model field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelMutation) AddedFields() []string {
+	var fields []string
+	if m.addcontext_window != nil {
+		fields = append(fields, model.FieldContextWindow)
+	}
+	if m.addinput_cost != nil {
+		fields = append(fields, model.FieldInputCost)
+	}
+	if m.addoutput_cost != nil {
+		fields = append(fields, model.FieldOutputCost)
+	}
+	if m.addcache_write_cost != nil {
+		fields = append(fields, model.FieldCacheWriteCost)
+	}
+	if m.addcache_read_cost != nil {
+		fields = append(fields, model.FieldCacheReadCost)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case model.FieldContextWindow:
+		return m.AddedContextWindow()
+	case model.FieldInputCost:
+		return m.AddedInputCost()
+	case model.FieldOutputCost:
+		return m.AddedOutputCost()
+	case model.FieldCacheWriteCost:
+		return m.AddedCacheWriteCost()
+	case model.FieldCacheReadCost:
+		return m.AddedCacheReadCost()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case model.FieldContextWindow:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddContextWindow(v)
+		return nil
+	case model.FieldInputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputCost(v)
+		return nil
+	case model.FieldOutputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputCost(v)
+		return nil
+	case model.FieldCacheWriteCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheWriteCost(v)
+		return nil
+	case model.FieldCacheReadCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadCost(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Model numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(model.FieldCapabilities) {
+		fields = append(fields, model.FieldCapabilities)
+	}
+	if m.FieldCleared(model.FieldAlias) {
+		fields = append(fields, model.FieldAlias)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelMutation) ClearField(name string) error {
+	switch name {
+	case model.FieldCapabilities:
+		m.ClearCapabilities()
+		return nil
+	case model.FieldAlias:
+		m.ClearAlias()
+		return nil
+	}
+	return fmt.Errorf("unknown Model nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelMutation) ResetField(name string) error {
+	switch name {
+	case model.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case model.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case model.FieldName:
+		m.ResetName()
+		return nil
+	case model.FieldContextWindow:
+		m.ResetContextWindow()
+		return nil
+	case model.FieldCapabilities:
+		m.ResetCapabilities()
+		return nil
+	case model.FieldInputCost:
+		m.ResetInputCost()
+		return nil
+	case model.FieldOutputCost:
+		m.ResetOutputCost()
+		return nil
+	case model.FieldCacheWriteCost:
+		m.ResetCacheWriteCost()
+		return nil
+	case model.FieldCacheReadCost:
+		m.ResetCacheReadCost()
+		return nil
+	case model.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case model.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case model.FieldModelProviderID:
+		m.ResetModelProviderID()
+		return nil
+	}
+	return fmt.Errorf("unknown Model field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.agents != nil {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.model_provider != nil {
+		edges = append(edges, model.EdgeModelProvider)
+	}
+	if m.messages != nil {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case model.EdgeAgents:
+		ids := make([]ent.Value, 0, len(m.agents))
+		for id := range m.agents {
+			ids = append(ids, id)
+		}
+		return ids
+	case model.EdgeModelProvider:
+		if id := m.model_provider; id != nil {
+			return []ent.Value{*id}
+		}
+	case model.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedagents != nil {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.removedmessages != nil {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case model.EdgeAgents:
+		ids := make([]ent.Value, 0, len(m.removedagents))
+		for id := range m.removedagents {
+			ids = append(ids, id)
+		}
+		return ids
+	case model.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedagents {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.clearedmodel_provider {
+		edges = append(edges, model.EdgeModelProvider)
+	}
+	if m.clearedmessages {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case model.EdgeAgents:
+		return m.clearedagents
+	case model.EdgeModelProvider:
+		return m.clearedmodel_provider
+	case model.EdgeMessages:
+		return m.clearedmessages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelMutation) ClearEdge(name string) error {
+	switch name {
+	case model.EdgeModelProvider:
+		m.ClearModelProvider()
+		return nil
+	}
+	return fmt.Errorf("unknown Model unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelMutation) ResetEdge(name string) error {
+	switch name {
+	case model.EdgeAgents:
+		m.ResetAgents()
+		return nil
+	case model.EdgeModelProvider:
+		m.ResetModelProvider()
+		return nil
+	case model.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	}
+	return fmt.Errorf("unknown Model edge %s", name)
+}
+
+// ModelProviderMutation represents an operation that mutates the ModelProvider nodes in the graph.
+type ModelProviderMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	owner         *string
+	team          *string
+	name          *string
+	provider_type *types.ModelProviderType
+	url           *string
+	secret        *[]byte
+	enabled       *bool
+	clearedFields map[string]struct{}
+	models        map[uuid.UUID]struct{}
+	removedmodels map[uuid.UUID]struct{}
+	clearedmodels bool
+	done          bool
+	oldValue      func(context.Context) (*ModelProvider, error)
+	predicates    []predicate.ModelProvider
+}
+
+var _ ent.Mutation = (*ModelProviderMutation)(nil)
+
+// modelproviderOption allows management of the mutation configuration using functional options.
+type modelproviderOption func(*ModelProviderMutation)
+
+// newModelProviderMutation creates new mutation for the ModelProvider entity.
+func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *ModelProviderMutation {
+	m := &ModelProviderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModelProvider,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelProviderID sets the ID field of the mutation.
+func withModelProviderID(id uuid.UUID) modelproviderOption {
+	return func(m *ModelProviderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModelProvider
+		)
+		m.oldValue = func(ctx context.Context) (*ModelProvider, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModelProvider.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModelProvider sets the old ModelProvider of the mutation.
+func withModelProvider(node *ModelProvider) modelproviderOption {
+	return func(m *ModelProviderMutation) {
+		m.oldValue = func(context.Context) (*ModelProvider, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelProviderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelProviderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModelProvider entities.
+func (m *ModelProviderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModelProvider.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ModelProviderMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ModelProviderMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ModelProviderMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ModelProviderMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *ModelProviderMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *ModelProviderMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *ModelProviderMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[modelprovider.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *ModelProviderMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[modelprovider.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *ModelProviderMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, modelprovider.FieldOwner)
+}
+
+// SetTeam sets the "team" field.
+func (m *ModelProviderMutation) SetTeam(s string) {
+	m.team = &s
+}
+
+// Team returns the value of the "team" field in the mutation.
+func (m *ModelProviderMutation) Team() (r string, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeam returns the old "team" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldTeam(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+	}
+	return oldValue.Team, nil
+}
+
+// ClearTeam clears the value of the "team" field.
+func (m *ModelProviderMutation) ClearTeam() {
+	m.team = nil
+	m.clearedFields[modelprovider.FieldTeam] = struct{}{}
+}
+
+// TeamCleared returns if the "team" field was cleared in this mutation.
+func (m *ModelProviderMutation) TeamCleared() bool {
+	_, ok := m.clearedFields[modelprovider.FieldTeam]
+	return ok
+}
+
+// ResetTeam resets all changes to the "team" field.
+func (m *ModelProviderMutation) ResetTeam() {
+	m.team = nil
+	delete(m.clearedFields, modelprovider.FieldTeam)
+}
+
+// SetName sets the "name" field.
+func (m *ModelProviderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ModelProviderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ModelProviderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetProviderType sets the "provider_type" field.
+func (m *ModelProviderMutation) SetProviderType(tpt types.ModelProviderType) {
+	m.provider_type = &tpt
+}
+
+// ProviderType returns the value of the "provider_type" field in the mutation.
+func (m *ModelProviderMutation) ProviderType() (r types.ModelProviderType, exists bool) {
+	v := m.provider_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderType returns the old "provider_type" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldProviderType(ctx context.Context) (v types.ModelProviderType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+	}
+	return oldValue.ProviderType, nil
+}
+
+// ResetProviderType resets all changes to the "provider_type" field.
+func (m *ModelProviderMutation) ResetProviderType() {
+	m.provider_type = nil
+}
+
+// SetURL sets the "url" field.
+func (m *ModelProviderMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ModelProviderMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *ModelProviderMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[modelprovider.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *ModelProviderMutation) URLCleared() bool {
+	_, ok := m.clearedFields[modelprovider.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ModelProviderMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, modelprovider.FieldURL)
+}
+
+// SetSecret sets the "secret" field.
+func (m *ModelProviderMutation) SetSecret(b []byte) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *ModelProviderMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldSecret(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *ModelProviderMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ModelProviderMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ModelProviderMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ModelProviderMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// AddModelIDs adds the "models" edge to the Model entity by ids.
+func (m *ModelProviderMutation) AddModelIDs(ids ...uuid.UUID) {
+	if m.models == nil {
+		m.models = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.models[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModels clears the "models" edge to the Model entity.
+func (m *ModelProviderMutation) ClearModels() {
+	m.clearedmodels = true
+}
+
+// ModelsCleared reports if the "models" edge to the Model entity was cleared.
+func (m *ModelProviderMutation) ModelsCleared() bool {
+	return m.clearedmodels
+}
+
+// RemoveModelIDs removes the "models" edge to the Model entity by IDs.
+func (m *ModelProviderMutation) RemoveModelIDs(ids ...uuid.UUID) {
+	if m.removedmodels == nil {
+		m.removedmodels = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.models, ids[i])
+		m.removedmodels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModels returns the removed IDs of the "models" edge to the Model entity.
+func (m *ModelProviderMutation) RemovedModelsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmodels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModelsIDs returns the "models" edge IDs in the mutation.
+func (m *ModelProviderMutation) ModelsIDs() (ids []uuid.UUID) {
+	for id := range m.models {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModels resets all changes to the "models" edge.
+func (m *ModelProviderMutation) ResetModels() {
+	m.models = nil
+	m.clearedmodels = false
+	m.removedmodels = nil
+}
+
+// Where appends a list predicates to the ModelProviderMutation builder.
+func (m *ModelProviderMutation) Where(ps ...predicate.ModelProvider) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelProviderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelProviderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModelProvider, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelProviderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelProviderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModelProvider).
+func (m *ModelProviderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelProviderMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, modelprovider.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, modelprovider.FieldUpdateTime)
+	}
+	if m.owner != nil {
+		fields = append(fields, modelprovider.FieldOwner)
+	}
+	if m.team != nil {
+		fields = append(fields, modelprovider.FieldTeam)
+	}
+	if m.name != nil {
+		fields = append(fields, modelprovider.FieldName)
+	}
+	if m.provider_type != nil {
+		fields = append(fields, modelprovider.FieldProviderType)
+	}
+	if m.url != nil {
+		fields = append(fields, modelprovider.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, modelprovider.FieldSecret)
+	}
+	if m.enabled != nil {
+		fields = append(fields, modelprovider.FieldEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelProviderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		return m.CreateTime()
+	case modelprovider.FieldUpdateTime:
+		return m.UpdateTime()
+	case modelprovider.FieldOwner:
+		return m.Owner()
+	case modelprovider.FieldTeam:
+		return m.Team()
+	case modelprovider.FieldName:
+		return m.Name()
+	case modelprovider.FieldProviderType:
+		return m.ProviderType()
+	case modelprovider.FieldURL:
+		return m.URL()
+	case modelprovider.FieldSecret:
+		return m.Secret()
+	case modelprovider.FieldEnabled:
+		return m.Enabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case modelprovider.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case modelprovider.FieldOwner:
+		return m.OldOwner(ctx)
+	case modelprovider.FieldTeam:
+		return m.OldTeam(ctx)
+	case modelprovider.FieldName:
+		return m.OldName(ctx)
+	case modelprovider.FieldProviderType:
+		return m.OldProviderType(ctx)
+	case modelprovider.FieldURL:
+		return m.OldURL(ctx)
+	case modelprovider.FieldSecret:
+		return m.OldSecret(ctx)
+	case modelprovider.FieldEnabled:
+		return m.OldEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelProviderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case modelprovider.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case modelprovider.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case modelprovider.FieldTeam:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeam(v)
+		return nil
+	case modelprovider.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case modelprovider.FieldProviderType:
+		v, ok := value.(types.ModelProviderType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderType(v)
+		return nil
+	case modelprovider.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case modelprovider.FieldSecret:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case modelprovider.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelProviderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelProviderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelProviderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModelProvider numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelProviderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modelprovider.FieldOwner) {
+		fields = append(fields, modelprovider.FieldOwner)
+	}
+	if m.FieldCleared(modelprovider.FieldTeam) {
+		fields = append(fields, modelprovider.FieldTeam)
+	}
+	if m.FieldCleared(modelprovider.FieldURL) {
+		fields = append(fields, modelprovider.FieldURL)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelProviderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelProviderMutation) ClearField(name string) error {
+	switch name {
+	case modelprovider.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case modelprovider.FieldTeam:
+		m.ClearTeam()
+		return nil
+	case modelprovider.FieldURL:
+		m.ClearURL()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelProviderMutation) ResetField(name string) error {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case modelprovider.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case modelprovider.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case modelprovider.FieldTeam:
+		m.ResetTeam()
+		return nil
+	case modelprovider.FieldName:
+		m.ResetName()
+		return nil
+	case modelprovider.FieldProviderType:
+		m.ResetProviderType()
+		return nil
+	case modelprovider.FieldURL:
+		m.ResetURL()
+		return nil
+	case modelprovider.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case modelprovider.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelProviderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.models != nil {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelProviderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case modelprovider.EdgeModels:
+		ids := make([]ent.Value, 0, len(m.models))
+		for id := range m.models {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelProviderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmodels != nil {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelProviderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case modelprovider.EdgeModels:
+		ids := make([]ent.Value, 0, len(m.removedmodels))
+		for id := range m.removedmodels {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelProviderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmodels {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelProviderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case modelprovider.EdgeModels:
+		return m.clearedmodels
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelProviderMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModelProvider unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelProviderMutation) ResetEdge(name string) error {
+	switch name {
+	case modelprovider.EdgeModels:
+		m.ResetModels()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider edge %s", name)
+}
+
+// NotificationSinkMutation represents an operation that mutates the NotificationSink nodes in the graph.
+type NotificationSinkMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	owner          *string
+	team           *string
+	name           *string
+	description    *string
+	kind           *types.NotificationSinkKind
+	events         *[]types.NotificationEvent
+	appendevents   []types.NotificationEvent
+	budget         *float64
+	addbudget      *float64
+	url            *string
+	email          **types.NotificationEmailConfig
+	secret         *[]byte
+	title_template *string
+	body_template  *string
+	enabled        *bool
+	clearedFields  map[string]struct{}
+	agent          *uuid.UUID
+	clearedagent   bool
+	done           bool
+	oldValue       func(context.Context) (*NotificationSink, error)
+	predicates     []predicate.NotificationSink
+}
+
+var _ ent.Mutation = (*NotificationSinkMutation)(nil)
+
+// notificationsinkOption allows management of the mutation configuration using functional options.
+type notificationsinkOption func(*NotificationSinkMutation)
+
+// newNotificationSinkMutation creates new mutation for the NotificationSink entity.
+func newNotificationSinkMutation(c config, op Op, opts ...notificationsinkOption) *NotificationSinkMutation {
+	m := &NotificationSinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationSink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationSinkID sets the ID field of the mutation.
+func withNotificationSinkID(id uuid.UUID) notificationsinkOption {
+	return func(m *NotificationSinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationSink
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationSink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationSink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationSink sets the old NotificationSink of the mutation.
+func withNotificationSink(node *NotificationSink) notificationsinkOption {
+	return func(m *NotificationSinkMutation) {
+		m.oldValue = func(context.Context) (*NotificationSink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationSinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationSinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationSink entities.
+func (m *NotificationSinkMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationSinkMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationSinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationSink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *NotificationSinkMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *NotificationSinkMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *NotificationSinkMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *NotificationSinkMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *NotificationSinkMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *NotificationSinkMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *NotificationSinkMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *NotificationSinkMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *NotificationSinkMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[notificationsink.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *NotificationSinkMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *NotificationSinkMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, notificationsink.FieldOwner)
+}
+
+// SetTeam sets the "team" field.
+func (m *NotificationSinkMutation) SetTeam(s string) {
+	m.team = &s
+}
+
+// Team returns the value of the "team" field in the mutation.
+func (m *NotificationSinkMutation) Team() (r string, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeam returns the old "team" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldTeam(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+	}
+	return oldValue.Team, nil
+}
+
+// ClearTeam clears the value of the "team" field.
+func (m *NotificationSinkMutation) ClearTeam() {
+	m.team = nil
+	m.clearedFields[notificationsink.FieldTeam] = struct{}{}
+}
+
+// TeamCleared returns if the "team" field was cleared in this mutation.
+func (m *NotificationSinkMutation) TeamCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldTeam]
+	return ok
+}
+
+// ResetTeam resets all changes to the "team" field.
+func (m *NotificationSinkMutation) ResetTeam() {
+	m.team = nil
+	delete(m.clearedFields, notificationsink.FieldTeam)
+}
+
+// SetName sets the "name" field.
+func (m *NotificationSinkMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NotificationSinkMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NotificationSinkMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *NotificationSinkMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *NotificationSinkMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *NotificationSinkMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[notificationsink.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *NotificationSinkMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *NotificationSinkMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, notificationsink.FieldDescription)
+}
+
+// SetKind sets the "kind" field.
+func (m *NotificationSinkMutation) SetKind(tsk types.NotificationSinkKind) {
+	m.kind = &tsk
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NotificationSinkMutation) Kind() (r types.NotificationSinkKind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldKind(ctx context.Context) (v types.NotificationSinkKind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NotificationSinkMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetEvents sets the "events" field.
+func (m *NotificationSinkMutation) SetEvents(te []types.NotificationEvent) {
+	m.events = &te
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *NotificationSinkMutation) Events() (r []types.NotificationEvent, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldEvents(ctx context.Context) (v []types.NotificationEvent, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds te to the "events" field.
+func (m *NotificationSinkMutation) AppendEvents(te []types.NotificationEvent) {
+	m.appendevents = append(m.appendevents, te...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *NotificationSinkMutation) AppendedEvents() ([]types.NotificationEvent, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *NotificationSinkMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+}
+
+// SetBudget sets the "budget" field.
+func (m *NotificationSinkMutation) SetBudget(f float64) {
+	m.budget = &f
+	m.addbudget = nil
+}
+
+// Budget returns the value of the "budget" field in the mutation.
+func (m *NotificationSinkMutation) Budget() (r float64, exists bool) {
+	v := m.budget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBudget returns the old "budget" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldBudget(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBudget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBudget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBudget: %w", err)
+	}
+	return oldValue.Budget, nil
+}
+
+// AddBudget adds f to the "budget" field.
+func (m *NotificationSinkMutation) AddBudget(f float64) {
+	if m.addbudget != nil {
+		*m.addbudget += f
+	} else {
+		m.addbudget = &f
+	}
+}
+
+// AddedBudget returns the value that was added to the "budget" field in this mutation.
+func (m *NotificationSinkMutation) AddedBudget() (r float64, exists bool) {
+	v := m.addbudget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (m *NotificationSinkMutation) ClearBudget() {
+	m.budget = nil
+	m.addbudget = nil
+	m.clearedFields[notificationsink.FieldBudget] = struct{}{}
+}
+
+// BudgetCleared returns if the "budget" field was cleared in this mutation.
+func (m *NotificationSinkMutation) BudgetCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldBudget]
+	return ok
+}
+
+// ResetBudget resets all changes to the "budget" field.
+func (m *NotificationSinkMutation) ResetBudget() {
+	m.budget = nil
+	m.addbudget = nil
+	delete(m.clearedFields, notificationsink.FieldBudget)
+}
+
+// SetURL sets the "url" field.
+func (m *NotificationSinkMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *NotificationSinkMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *NotificationSinkMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[notificationsink.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *NotificationSinkMutation) URLCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *NotificationSinkMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, notificationsink.FieldURL)
+}
+
+// SetEmail sets the "email" field.
+func (m *NotificationSinkMutation) SetEmail(tec *types.NotificationEmailConfig) {
+	m.email = &tec
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *NotificationSinkMutation) Email() (r *types.NotificationEmailConfig, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldEmail(ctx context.Context) (v *types.NotificationEmailConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *NotificationSinkMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[notificationsink.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *NotificationSinkMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *NotificationSinkMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, notificationsink.FieldEmail)
+}
+
+// SetSecret sets the "secret" field.
+func (m *NotificationSinkMutation) SetSecret(b []byte) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *NotificationSinkMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldSecret(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (m *NotificationSinkMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[notificationsink.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *NotificationSinkMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldSecret]
+	return ok
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *NotificationSinkMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, notificationsink.FieldSecret)
+}
+
+// SetTitleTemplate sets the "title_template" field.
+func (m *NotificationSinkMutation) SetTitleTemplate(s string) {
+	m.title_template = &s
+}
+
+// TitleTemplate returns the value of the "title_template" field in the mutation.
+func (m *NotificationSinkMutation) TitleTemplate() (r string, exists bool) {
+	v := m.title_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitleTemplate returns the old "title_template" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldTitleTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitleTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitleTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitleTemplate: %w", err)
+	}
+	return oldValue.TitleTemplate, nil
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (m *NotificationSinkMutation) ClearTitleTemplate() {
+	m.title_template = nil
+	m.clearedFields[notificationsink.FieldTitleTemplate] = struct{}{}
+}
+
+// TitleTemplateCleared returns if the "title_template" field was cleared in this mutation.
+func (m *NotificationSinkMutation) TitleTemplateCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldTitleTemplate]
+	return ok
+}
+
+// ResetTitleTemplate resets all changes to the "title_template" field.
+func (m *NotificationSinkMutation) ResetTitleTemplate() {
+	m.title_template = nil
+	delete(m.clearedFields, notificationsink.FieldTitleTemplate)
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (m *NotificationSinkMutation) SetBodyTemplate(s string) {
+	m.body_template = &s
+}
+
+// BodyTemplate returns the value of the "body_template" field in the mutation.
+func (m *NotificationSinkMutation) BodyTemplate() (r string, exists bool) {
+	v := m.body_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBodyTemplate returns the old "body_template" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldBodyTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodyTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodyTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodyTemplate: %w", err)
+	}
+	return oldValue.BodyTemplate, nil
+}
+
+// ClearBodyTemplate clears the value of the "body_template" field.
+func (m *NotificationSinkMutation) ClearBodyTemplate() {
+	m.body_template = nil
+	m.clearedFields[notificationsink.FieldBodyTemplate] = struct{}{}
+}
+
+// BodyTemplateCleared returns if the "body_template" field was cleared in this mutation.
+func (m *NotificationSinkMutation) BodyTemplateCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldBodyTemplate]
+	return ok
+}
+
+// ResetBodyTemplate resets all changes to the "body_template" field.
+func (m *NotificationSinkMutation) ResetBodyTemplate() {
+	m.body_template = nil
+	delete(m.clearedFields, notificationsink.FieldBodyTemplate)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *NotificationSinkMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *NotificationSinkMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *NotificationSinkMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *NotificationSinkMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *NotificationSinkMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the NotificationSink entity.
+// If the NotificationSink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationSinkMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *NotificationSinkMutation) ClearAgentID() {
+	m.agent = nil
+	m.clearedFields[notificationsink.FieldAgentID] = struct{}{}
+}
+
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *NotificationSinkMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[notificationsink.FieldAgentID]
+	return ok
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *NotificationSinkMutation) ResetAgentID() {
+	m.agent = nil
+	delete(m.clearedFields, notificationsink.FieldAgentID)
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *NotificationSinkMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[notificationsink.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *NotificationSinkMutation) AgentCleared() bool {
+	return m.AgentIDCleared() || m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *NotificationSinkMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *NotificationSinkMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// Where appends a list predicates to the NotificationSinkMutation builder.
+func (m *NotificationSinkMutation) Where(ps ...predicate.NotificationSink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationSinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationSinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationSink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationSinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationSinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationSink).
+func (m *NotificationSinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationSinkMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.create_time != nil {
+		fields = append(fields, notificationsink.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, notificationsink.FieldUpdateTime)
+	}
+	if m.owner != nil {
+		fields = append(fields, notificationsink.FieldOwner)
+	}
+	if m.team != nil {
+		fields = append(fields, notificationsink.FieldTeam)
+	}
+	if m.name != nil {
+		fields = append(fields, notificationsink.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, notificationsink.FieldDescription)
+	}
+	if m.kind != nil {
+		fields = append(fields, notificationsink.FieldKind)
+	}
+	if m.events != nil {
+		fields = append(fields, notificationsink.FieldEvents)
+	}
+	if m.budget != nil {
+		fields = append(fields, notificationsink.FieldBudget)
+	}
+	if m.url != nil {
+		fields = append(fields, notificationsink.FieldURL)
+	}
+	if m.email != nil {
+		fields = append(fields, notificationsink.FieldEmail)
+	}
+	if m.secret != nil {
+		fields = append(fields, notificationsink.FieldSecret)
+	}
+	if m.title_template != nil {
+		fields = append(fields, notificationsink.FieldTitleTemplate)
+	}
+	if m.body_template != nil {
+		fields = append(fields, notificationsink.FieldBodyTemplate)
+	}
+	if m.enabled != nil {
+		fields = append(fields, notificationsink.FieldEnabled)
+	}
+	if m.agent != nil {
+		fields = append(fields, notificationsink.FieldAgentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationSinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationsink.FieldCreateTime:
+		return m.CreateTime()
+	case notificationsink.FieldUpdateTime:
+		return m.UpdateTime()
+	case notificationsink.FieldOwner:
+		return m.Owner()
+	case notificationsink.FieldTeam:
+		return m.Team()
+	case notificationsink.FieldName:
+		return m.Name()
+	case notificationsink.FieldDescription:
+		return m.Description()
+	case notificationsink.FieldKind:
+		return m.Kind()
+	case notificationsink.FieldEvents:
+		return m.Events()
+	case notificationsink.FieldBudget:
+		return m.Budget()
+	case notificationsink.FieldURL:
+		return m.URL()
+	case notificationsink.FieldEmail:
+		return m.Email()
+	case notificationsink.FieldSecret:
+		return m.Secret()
+	case notificationsink.FieldTitleTemplate:
+		return m.TitleTemplate()
+	case notificationsink.FieldBodyTemplate:
+		return m.BodyTemplate()
+	case notificationsink.FieldEnabled:
+		return m.Enabled()
+	case notificationsink.FieldAgentID:
+		return m.AgentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationSinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationsink.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case notificationsink.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case notificationsink.FieldOwner:
+		return m.OldOwner(ctx)
+	case notificationsink.FieldTeam:
+		return m.OldTeam(ctx)
+	case notificationsink.FieldName:
+		return m.OldName(ctx)
+	case notificationsink.FieldDescription:
+		return m.OldDescription(ctx)
+	case notificationsink.FieldKind:
+		return m.OldKind(ctx)
+	case notificationsink.FieldEvents:
+		return m.OldEvents(ctx)
+	case notificationsink.FieldBudget:
+		return m.OldBudget(ctx)
+	case notificationsink.FieldURL:
+		return m.OldURL(ctx)
+	case notificationsink.FieldEmail:
+		return m.OldEmail(ctx)
+	case notificationsink.FieldSecret:
+		return m.OldSecret(ctx)
+	case notificationsink.FieldTitleTemplate:
+		return m.OldTitleTemplate(ctx)
+	case notificationsink.FieldBodyTemplate:
+		return m.OldBodyTemplate(ctx)
+	case notificationsink.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case notificationsink.FieldAgentID:
+		return m.OldAgentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationSink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationSinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationsink.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case notificationsink.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case notificationsink.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case notificationsink.FieldTeam:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeam(v)
+		return nil
+	case notificationsink.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case notificationsink.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case notificationsink.FieldKind:
+		v, ok := value.(types.NotificationSinkKind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case notificationsink.FieldEvents:
+		v, ok := value.([]types.NotificationEvent)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case notificationsink.FieldBudget:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBudget(v)
+		return nil
+	case notificationsink.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case notificationsink.FieldEmail:
+		v, ok := value.(*types.NotificationEmailConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case notificationsink.FieldSecret:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case notificationsink.FieldTitleTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleTemplate(v)
+		return nil
+	case notificationsink.FieldBodyTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodyTemplate(v)
+		return nil
+	case notificationsink.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case notificationsink.FieldAgentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationSink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationSinkMutation) AddedFields() []string {
+	var fields []string
+	if m.addbudget != nil {
+		fields = append(fields, notificationsink.FieldBudget)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationSinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationsink.FieldBudget:
+		return m.AddedBudget()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationSinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notificationsink.FieldBudget:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBudget(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationSink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationSinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationsink.FieldOwner) {
+		fields = append(fields, notificationsink.FieldOwner)
+	}
+	if m.FieldCleared(notificationsink.FieldTeam) {
+		fields = append(fields, notificationsink.FieldTeam)
+	}
+	if m.FieldCleared(notificationsink.FieldDescription) {
+		fields = append(fields, notificationsink.FieldDescription)
+	}
+	if m.FieldCleared(notificationsink.FieldBudget) {
+		fields = append(fields, notificationsink.FieldBudget)
+	}
+	if m.FieldCleared(notificationsink.FieldURL) {
+		fields = append(fields, notificationsink.FieldURL)
+	}
+	if m.FieldCleared(notificationsink.FieldEmail) {
+		fields = append(fields, notificationsink.FieldEmail)
+	}
+	if m.FieldCleared(notificationsink.FieldSecret) {
+		fields = append(fields, notificationsink.FieldSecret)
+	}
+	if m.FieldCleared(notificationsink.FieldTitleTemplate) {
+		fields = append(fields, notificationsink.FieldTitleTemplate)
+	}
+	if m.FieldCleared(notificationsink.FieldBodyTemplate) {
+		fields = append(fields, notificationsink.FieldBodyTemplate)
+	}
+	if m.FieldCleared(notificationsink.FieldAgentID) {
+		fields = append(fields, notificationsink.FieldAgentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationSinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationSinkMutation) ClearField(name string) error {
+	switch name {
+	case notificationsink.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case notificationsink.FieldTeam:
+		m.ClearTeam()
+		return nil
+	case notificationsink.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case notificationsink.FieldBudget:
+		m.ClearBudget()
+		return nil
+	case notificationsink.FieldURL:
+		m.ClearURL()
+		return nil
+	case notificationsink.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case notificationsink.FieldSecret:
+		m.ClearSecret()
+		return nil
+	case notificationsink.FieldTitleTemplate:
+		m.ClearTitleTemplate()
+		return nil
+	case notificationsink.FieldBodyTemplate:
+		m.ClearBodyTemplate()
+		return nil
+	case notificationsink.FieldAgentID:
+		m.ClearAgentID()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationSink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationSinkMutation) ResetField(name string) error {
+	switch name {
+	case notificationsink.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case notificationsink.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case notificationsink.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case notificationsink.FieldTeam:
+		m.ResetTeam()
+		return nil
+	case notificationsink.FieldName:
+		m.ResetName()
+		return nil
+	case notificationsink.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case notificationsink.FieldKind:
+		m.ResetKind()
+		return nil
+	case notificationsink.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case notificationsink.FieldBudget:
+		m.ResetBudget()
+		return nil
+	case notificationsink.FieldURL:
+		m.ResetURL()
+		return nil
+	case notificationsink.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case notificationsink.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case notificationsink.FieldTitleTemplate:
+		m.ResetTitleTemplate()
+		return nil
+	case notificationsink.FieldBodyTemplate:
+		m.ResetBodyTemplate()
+		return nil
+	case notificationsink.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case notificationsink.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationSink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationSinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.agent != nil {
+		edges = append(edges, notificationsink.EdgeAgent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationSinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationsink.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationSinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationSinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationSinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedagent {
+		edges = append(edges, notificationsink.EdgeAgent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationSinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationsink.EdgeAgent:
+		return m.clearedagent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationSinkMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationsink.EdgeAgent:
+		m.ClearAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationSink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationSinkMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationsink.EdgeAgent:
+		m.ResetAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationSink edge %s", name)
+}
+
+// ScheduleMutation represents an operation that mutates the Schedule nodes in the graph.
+type ScheduleMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	owner           *string
+	team            *string
+	name            *string
+	cron_expression *string
+	prompt_template *string
+	workspace       *string
+	overlap_policy  *types.ScheduleOverlapPolicy
+	catch_up_policy *types.ScheduleCatchUpPolicy
+	enabled         *bool
+	last_run_time   *time.Time
+	next_run_time   *time.Time
+	clearedFields   map[string]struct{}
+	agent           *uuid.UUID
+	clearedagent    bool
+	runs            map[uuid.UUID]struct{}
+	removedruns     map[uuid.UUID]struct{}
+	clearedruns     bool
+	done            bool
+	oldValue        func(context.Context) (*Schedule, error)
+	predicates      []predicate.Schedule
+}
+
+var _ ent.Mutation = (*ScheduleMutation)(nil)
+
+// scheduleOption allows management of the mutation configuration using functional options.
+type scheduleOption func(*ScheduleMutation)
+
+// newScheduleMutation creates new mutation for the Schedule entity.
+func newScheduleMutation(c config, op Op, opts ...scheduleOption) *ScheduleMutation {
+	m := &ScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScheduleID sets the ID field of the mutation.
+func withScheduleID(id uuid.UUID) scheduleOption {
+	return func(m *ScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Schedule
+		)
+		m.oldValue = func(ctx context.Context) (*Schedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Schedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSchedule sets the old Schedule of the mutation.
+func withSchedule(node *Schedule) scheduleOption {
+	return func(m *ScheduleMutation) {
+		m.oldValue = func(context.Context) (*Schedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Schedule entities.
+func (m *ScheduleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Schedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ScheduleMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ScheduleMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ScheduleMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ScheduleMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ScheduleMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ScheduleMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *ScheduleMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *ScheduleMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *ScheduleMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[schedule.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *ScheduleMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *ScheduleMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, schedule.FieldOwner)
+}
+
+// SetTeam sets the "team" field.
+func (m *ScheduleMutation) SetTeam(s string) {
+	m.team = &s
+}
+
+// Team returns the value of the "team" field in the mutation.
+func (m *ScheduleMutation) Team() (r string, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeam returns the old "team" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldTeam(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+	}
+	return oldValue.Team, nil
+}
+
+// ClearTeam clears the value of the "team" field.
+func (m *ScheduleMutation) ClearTeam() {
+	m.team = nil
+	m.clearedFields[schedule.FieldTeam] = struct{}{}
+}
+
+// TeamCleared returns if the "team" field was cleared in this mutation.
+func (m *ScheduleMutation) TeamCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldTeam]
+	return ok
+}
+
+// ResetTeam resets all changes to the "team" field.
+func (m *ScheduleMutation) ResetTeam() {
+	m.team = nil
+	delete(m.clearedFields, schedule.FieldTeam)
+}
+
+// SetName sets the "name" field.
+func (m *ScheduleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ScheduleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ScheduleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (m *ScheduleMutation) SetCronExpression(s string) {
+	m.cron_expression = &s
+}
+
+// CronExpression returns the value of the "cron_expression" field in the mutation.
+func (m *ScheduleMutation) CronExpression() (r string, exists bool) {
+	v := m.cron_expression
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCronExpression returns the old "cron_expression" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldCronExpression(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCronExpression is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCronExpression requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCronExpression: %w", err)
+	}
+	return oldValue.CronExpression, nil
+}
+
+// ResetCronExpression resets all changes to the "cron_expression" field.
+func (m *ScheduleMutation) ResetCronExpression() {
+	m.cron_expression = nil
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (m *ScheduleMutation) SetPromptTemplate(s string) {
+	m.prompt_template = &s
+}
+
+// PromptTemplate returns the value of the "prompt_template" field in the mutation.
+func (m *ScheduleMutation) PromptTemplate() (r string, exists bool) {
+	v := m.prompt_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTemplate returns the old "prompt_template" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldPromptTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTemplate: %w", err)
+	}
+	return oldValue.PromptTemplate, nil
+}
+
+// ResetPromptTemplate resets all changes to the "prompt_template" field.
+func (m *ScheduleMutation) ResetPromptTemplate() {
+	m.prompt_template = nil
+}
+
+// SetWorkspace sets the "workspace" field.
+func (m *ScheduleMutation) SetWorkspace(s string) {
+	m.workspace = &s
+}
+
+// Workspace returns the value of the "workspace" field in the mutation.
+func (m *ScheduleMutation) Workspace() (r string, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspace returns the old "workspace" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldWorkspace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspace: %w", err)
+	}
+	return oldValue.Workspace, nil
+}
+
+// ClearWorkspace clears the value of the "workspace" field.
+func (m *ScheduleMutation) ClearWorkspace() {
+	m.workspace = nil
+	m.clearedFields[schedule.FieldWorkspace] = struct{}{}
+}
+
+// WorkspaceCleared returns if the "workspace" field was cleared in this mutation.
+func (m *ScheduleMutation) WorkspaceCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldWorkspace]
+	return ok
+}
+
+// ResetWorkspace resets all changes to the "workspace" field.
+func (m *ScheduleMutation) ResetWorkspace() {
+	m.workspace = nil
+	delete(m.clearedFields, schedule.FieldWorkspace)
+}
+
+// SetOverlapPolicy sets the "overlap_policy" field.
+func (m *ScheduleMutation) SetOverlapPolicy(top types.ScheduleOverlapPolicy) {
+	m.overlap_policy = &top
+}
+
+// OverlapPolicy returns the value of the "overlap_policy" field in the mutation.
+func (m *ScheduleMutation) OverlapPolicy() (r types.ScheduleOverlapPolicy, exists bool) {
+	v := m.overlap_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOverlapPolicy returns the old "overlap_policy" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldOverlapPolicy(ctx context.Context) (v types.ScheduleOverlapPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverlapPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverlapPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverlapPolicy: %w", err)
+	}
+	return oldValue.OverlapPolicy, nil
+}
+
+// ResetOverlapPolicy resets all changes to the "overlap_policy" field.
+func (m *ScheduleMutation) ResetOverlapPolicy() {
+	m.overlap_policy = nil
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (m *ScheduleMutation) SetCatchUpPolicy(tcup types.ScheduleCatchUpPolicy) {
+	m.catch_up_policy = &tcup
+}
+
+// CatchUpPolicy returns the value of the "catch_up_policy" field in the mutation.
+func (m *ScheduleMutation) CatchUpPolicy() (r types.ScheduleCatchUpPolicy, exists bool) {
+	v := m.catch_up_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCatchUpPolicy returns the old "catch_up_policy" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldCatchUpPolicy(ctx context.Context) (v types.ScheduleCatchUpPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCatchUpPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCatchUpPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCatchUpPolicy: %w", err)
+	}
+	return oldValue.CatchUpPolicy, nil
+}
+
+// ResetCatchUpPolicy resets all changes to the "catch_up_policy" field.
+func (m *ScheduleMutation) ResetCatchUpPolicy() {
+	m.catch_up_policy = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ScheduleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ScheduleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ScheduleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetLastRunTime sets the "last_run_time" field.
+func (m *ScheduleMutation) SetLastRunTime(t time.Time) {
+	m.last_run_time = &t
+}
+
+// LastRunTime returns the value of the "last_run_time" field in the mutation.
+func (m *ScheduleMutation) LastRunTime() (r time.Time, exists bool) {
+	v := m.last_run_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunTime returns the old "last_run_time" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldLastRunTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunTime: %w", err)
+	}
+	return oldValue.LastRunTime, nil
+}
+
+// ClearLastRunTime clears the value of the "last_run_time" field.
+func (m *ScheduleMutation) ClearLastRunTime() {
+	m.last_run_time = nil
+	m.clearedFields[schedule.FieldLastRunTime] = struct{}{}
+}
+
+// LastRunTimeCleared returns if the "last_run_time" field was cleared in this mutation.
+func (m *ScheduleMutation) LastRunTimeCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldLastRunTime]
+	return ok
+}
+
+// ResetLastRunTime resets all changes to the "last_run_time" field.
+func (m *ScheduleMutation) ResetLastRunTime() {
+	m.last_run_time = nil
+	delete(m.clearedFields, schedule.FieldLastRunTime)
+}
+
+// SetNextRunTime sets the "next_run_time" field.
+func (m *ScheduleMutation) SetNextRunTime(t time.Time) {
+	m.next_run_time = &t
+}
+
+// NextRunTime returns the value of the "next_run_time" field in the mutation.
+func (m *ScheduleMutation) NextRunTime() (r time.Time, exists bool) {
+	v := m.next_run_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunTime returns the old "next_run_time" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldNextRunTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunTime: %w", err)
+	}
+	return oldValue.NextRunTime, nil
+}
+
+// ClearNextRunTime clears the value of the "next_run_time" field.
+func (m *ScheduleMutation) ClearNextRunTime() {
+	m.next_run_time = nil
+	m.clearedFields[schedule.FieldNextRunTime] = struct{}{}
+}
+
+// NextRunTimeCleared returns if the "next_run_time" field was cleared in this mutation.
+func (m *ScheduleMutation) NextRunTimeCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldNextRunTime]
+	return ok
+}
+
+// ResetNextRunTime resets all changes to the "next_run_time" field.
+func (m *ScheduleMutation) ResetNextRunTime() {
+	m.next_run_time = nil
+	delete(m.clearedFields, schedule.FieldNextRunTime)
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *ScheduleMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *ScheduleMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *ScheduleMutation) ResetAgentID() {
+	m.agent = nil
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *ScheduleMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[schedule.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *ScheduleMutation) AgentCleared() bool {
+	return m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *ScheduleMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *ScheduleMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// AddRunIDs adds the "runs" edge to the ScheduleRun entity by ids.
+func (m *ScheduleMutation) AddRunIDs(ids ...uuid.UUID) {
+	if m.runs == nil {
+		m.runs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRuns clears the "runs" edge to the ScheduleRun entity.
+func (m *ScheduleMutation) ClearRuns() {
+	m.clearedruns = true
+}
+
+// RunsCleared reports if the "runs" edge to the ScheduleRun entity was cleared.
+func (m *ScheduleMutation) RunsCleared() bool {
+	return m.clearedruns
+}
+
+// RemoveRunIDs removes the "runs" edge to the ScheduleRun entity by IDs.
+func (m *ScheduleMutation) RemoveRunIDs(ids ...uuid.UUID) {
+	if m.removedruns == nil {
+		m.removedruns = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.runs, ids[i])
+		m.removedruns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRuns returns the removed IDs of the "runs" edge to the ScheduleRun entity.
+func (m *ScheduleMutation) RemovedRunsIDs() (ids []uuid.UUID) {
+	for id := range m.removedruns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RunsIDs returns the "runs" edge IDs in the mutation.
+func (m *ScheduleMutation) RunsIDs() (ids []uuid.UUID) {
+	for id := range m.runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRuns resets all changes to the "runs" edge.
+func (m *ScheduleMutation) ResetRuns() {
+	m.runs = nil
+	m.clearedruns = false
+	m.removedruns = nil
+}
+
+// Where appends a list predicates to the ScheduleMutation builder.
+func (m *ScheduleMutation) Where(ps ...predicate.Schedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Schedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Schedule).
+func (m *ScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, schedule.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, schedule.FieldUpdateTime)
+	}
+	if m.owner != nil {
+		fields = append(fields, schedule.FieldOwner)
+	}
+	if m.team != nil {
+		fields = append(fields, schedule.FieldTeam)
+	}
+	if m.name != nil {
+		fields = append(fields, schedule.FieldName)
+	}
+	if m.cron_expression != nil {
+		fields = append(fields, schedule.FieldCronExpression)
+	}
+	if m.prompt_template != nil {
+		fields = append(fields, schedule.FieldPromptTemplate)
+	}
+	if m.workspace != nil {
+		fields = append(fields, schedule.FieldWorkspace)
+	}
+	if m.overlap_policy != nil {
+		fields = append(fields, schedule.FieldOverlapPolicy)
+	}
+	if m.catch_up_policy != nil {
+		fields = append(fields, schedule.FieldCatchUpPolicy)
+	}
+	if m.enabled != nil {
+		fields = append(fields, schedule.FieldEnabled)
+	}
+	if m.last_run_time != nil {
+		fields = append(fields, schedule.FieldLastRunTime)
+	}
+	if m.next_run_time != nil {
+		fields = append(fields, schedule.FieldNextRunTime)
+	}
+	if m.agent != nil {
+		fields = append(fields, schedule.FieldAgentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schedule.FieldCreateTime:
+		return m.CreateTime()
+	case schedule.FieldUpdateTime:
+		return m.UpdateTime()
+	case schedule.FieldOwner:
+		return m.Owner()
+	case schedule.FieldTeam:
+		return m.Team()
+	case schedule.FieldName:
+		return m.Name()
+	case schedule.FieldCronExpression:
+		return m.CronExpression()
+	case schedule.FieldPromptTemplate:
+		return m.PromptTemplate()
+	case schedule.FieldWorkspace:
+		return m.Workspace()
+	case schedule.FieldOverlapPolicy:
+		return m.OverlapPolicy()
+	case schedule.FieldCatchUpPolicy:
+		return m.CatchUpPolicy()
+	case schedule.FieldEnabled:
+		return m.Enabled()
+	case schedule.FieldLastRunTime:
+		return m.LastRunTime()
+	case schedule.FieldNextRunTime:
+		return m.NextRunTime()
+	case schedule.FieldAgentID:
+		return m.AgentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schedule.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case schedule.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case schedule.FieldOwner:
+		return m.OldOwner(ctx)
+	case schedule.FieldTeam:
+		return m.OldTeam(ctx)
+	case schedule.FieldName:
+		return m.OldName(ctx)
+	case schedule.FieldCronExpression:
+		return m.OldCronExpression(ctx)
+	case schedule.FieldPromptTemplate:
+		return m.OldPromptTemplate(ctx)
+	case schedule.FieldWorkspace:
+		return m.OldWorkspace(ctx)
+	case schedule.FieldOverlapPolicy:
+		return m.OldOverlapPolicy(ctx)
+	case schedule.FieldCatchUpPolicy:
+		return m.OldCatchUpPolicy(ctx)
+	case schedule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case schedule.FieldLastRunTime:
+		return m.OldLastRunTime(ctx)
+	case schedule.FieldNextRunTime:
+		return m.OldNextRunTime(ctx)
+	case schedule.FieldAgentID:
+		return m.OldAgentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Schedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schedule.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case schedule.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case schedule.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case schedule.FieldTeam:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeam(v)
+		return nil
+	case schedule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case schedule.FieldCronExpression:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCronExpression(v)
+		return nil
+	case schedule.FieldPromptTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTemplate(v)
+		return nil
+	case schedule.FieldWorkspace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspace(v)
+		return nil
+	case schedule.FieldOverlapPolicy:
+		v, ok := value.(types.ScheduleOverlapPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverlapPolicy(v)
+		return nil
+	case schedule.FieldCatchUpPolicy:
+		v, ok := value.(types.ScheduleCatchUpPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCatchUpPolicy(v)
+		return nil
+	case schedule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case schedule.FieldLastRunTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunTime(v)
+		return nil
+	case schedule.FieldNextRunTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunTime(v)
+		return nil
+	case schedule.FieldAgentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Schedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(schedule.FieldOwner) {
+		fields = append(fields, schedule.FieldOwner)
+	}
+	if m.FieldCleared(schedule.FieldTeam) {
+		fields = append(fields, schedule.FieldTeam)
+	}
+	if m.FieldCleared(schedule.FieldWorkspace) {
+		fields = append(fields, schedule.FieldWorkspace)
+	}
+	if m.FieldCleared(schedule.FieldLastRunTime) {
+		fields = append(fields, schedule.FieldLastRunTime)
+	}
+	if m.FieldCleared(schedule.FieldNextRunTime) {
+		fields = append(fields, schedule.FieldNextRunTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduleMutation) ClearField(name string) error {
+	switch name {
+	case schedule.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case schedule.FieldTeam:
+		m.ClearTeam()
+		return nil
+	case schedule.FieldWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case schedule.FieldLastRunTime:
+		m.ClearLastRunTime()
+		return nil
+	case schedule.FieldNextRunTime:
+		m.ClearNextRunTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduleMutation) ResetField(name string) error {
+	switch name {
+	case schedule.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case schedule.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case schedule.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case schedule.FieldTeam:
+		m.ResetTeam()
+		return nil
+	case schedule.FieldName:
+		m.ResetName()
+		return nil
+	case schedule.FieldCronExpression:
+		m.ResetCronExpression()
+		return nil
+	case schedule.FieldPromptTemplate:
+		m.ResetPromptTemplate()
+		return nil
+	case schedule.FieldWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case schedule.FieldOverlapPolicy:
+		m.ResetOverlapPolicy()
+		return nil
+	case schedule.FieldCatchUpPolicy:
+		m.ResetCatchUpPolicy()
+		return nil
+	case schedule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case schedule.FieldLastRunTime:
+		m.ResetLastRunTime()
+		return nil
+	case schedule.FieldNextRunTime:
+		m.ResetNextRunTime()
+		return nil
+	case schedule.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.agent != nil {
+		edges = append(edges, schedule.EdgeAgent)
+	}
+	if m.runs != nil {
+		edges = append(edges, schedule.EdgeRuns)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case schedule.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
+	case schedule.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.runs))
+		for id := range m.runs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedruns != nil {
+		edges = append(edges, schedule.EdgeRuns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case schedule.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.removedruns))
+		for id := range m.removedruns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedagent {
+		edges = append(edges, schedule.EdgeAgent)
+	}
+	if m.clearedruns {
+		edges = append(edges, schedule.EdgeRuns)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case schedule.EdgeAgent:
+		return m.clearedagent
+	case schedule.EdgeRuns:
+		return m.clearedruns
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduleMutation) ClearEdge(name string) error {
+	switch name {
+	case schedule.EdgeAgent:
+		m.ClearAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduleMutation) ResetEdge(name string) error {
+	switch name {
+	case schedule.EdgeAgent:
+		m.ResetAgent()
+		return nil
+	case schedule.EdgeRuns:
+		m.ResetRuns()
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule edge %s", name)
+}
+
+// ScheduleRunMutation represents an operation that mutates the ScheduleRun nodes in the graph.
+type ScheduleRunMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	trigger         *types.ScheduleRunTrigger
+	status          *types.ScheduleRunStatus
+	scheduled_time  *time.Time
+	error           *string
+	clearedFields   map[string]struct{}
+	schedule        *uuid.UUID
+	clearedschedule bool
+	task            *uuid.UUID
+	clearedtask     bool
+	done            bool
+	oldValue        func(context.Context) (*ScheduleRun, error)
+	predicates      []predicate.ScheduleRun
+}
+
+var _ ent.Mutation = (*ScheduleRunMutation)(nil)
+
+// schedulerunOption allows management of the mutation configuration using functional options.
+type schedulerunOption func(*ScheduleRunMutation)
+
+// newScheduleRunMutation creates new mutation for the ScheduleRun entity.
+func newScheduleRunMutation(c config, op Op, opts ...schedulerunOption) *ScheduleRunMutation {
+	m := &ScheduleRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScheduleRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScheduleRunID sets the ID field of the mutation.
+func withScheduleRunID(id uuid.UUID) schedulerunOption {
+	return func(m *ScheduleRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScheduleRun
+		)
+		m.oldValue = func(ctx context.Context) (*ScheduleRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScheduleRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScheduleRun sets the old ScheduleRun of the mutation.
+func withScheduleRun(node *ScheduleRun) schedulerunOption {
+	return func(m *ScheduleRunMutation) {
+		m.oldValue = func(context.Context) (*ScheduleRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduleRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduleRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScheduleRun entities.
+func (m *ScheduleRunMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduleRunMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduleRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScheduleRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ScheduleRunMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ScheduleRunMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ScheduleRunMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ScheduleRunMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ScheduleRunMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ScheduleRunMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *ScheduleRunMutation) SetTrigger(trt types.ScheduleRunTrigger) {
+	m.trigger = &trt
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *ScheduleRunMutation) Trigger() (r types.ScheduleRunTrigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldTrigger(ctx context.Context) (v types.ScheduleRunTrigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *ScheduleRunMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ScheduleRunMutation) SetStatus(trs types.ScheduleRunStatus) {
+	m.status = &trs
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScheduleRunMutation) Status() (r types.ScheduleRunStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldStatus(ctx context.Context) (v types.ScheduleRunStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScheduleRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetScheduledTime sets the "scheduled_time" field.
+func (m *ScheduleRunMutation) SetScheduledTime(t time.Time) {
+	m.scheduled_time = &t
+}
+
+// ScheduledTime returns the value of the "scheduled_time" field in the mutation.
+func (m *ScheduleRunMutation) ScheduledTime() (r time.Time, exists bool) {
+	v := m.scheduled_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledTime returns the old "scheduled_time" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldScheduledTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledTime: %w", err)
+	}
+	return oldValue.ScheduledTime, nil
+}
+
+// ResetScheduledTime resets all changes to the "scheduled_time" field.
+func (m *ScheduleRunMutation) ResetScheduledTime() {
+	m.scheduled_time = nil
+}
+
+// SetError sets the "error" field.
+func (m *ScheduleRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ScheduleRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ScheduleRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[schedulerun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ScheduleRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[schedulerun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ScheduleRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, schedulerun.FieldError)
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (m *ScheduleRunMutation) SetScheduleID(u uuid.UUID) {
+	m.schedule = &u
+}
+
+// ScheduleID returns the value of the "schedule_id" field in the mutation.
+func (m *ScheduleRunMutation) ScheduleID() (r uuid.UUID, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduleID returns the old "schedule_id" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldScheduleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduleID: %w", err)
+	}
+	return oldValue.ScheduleID, nil
+}
+
+// ResetScheduleID resets all changes to the "schedule_id" field.
+func (m *ScheduleRunMutation) ResetScheduleID() {
+	m.schedule = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *ScheduleRunMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ScheduleRunMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (m *ScheduleRunMutation) ClearTaskID() {
+	m.task = nil
+	m.clearedFields[schedulerun.FieldTaskID] = struct{}{}
+}
+
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *ScheduleRunMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[schedulerun.FieldTaskID]
+	return ok
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ScheduleRunMutation) ResetTaskID() {
+	m.task = nil
+	delete(m.clearedFields, schedulerun.FieldTaskID)
+}
+
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (m *ScheduleRunMutation) ClearSchedule() {
+	m.clearedschedule = true
+	m.clearedFields[schedulerun.FieldScheduleID] = struct{}{}
+}
+
+// ScheduleCleared reports if the "schedule" edge to the Schedule entity was cleared.
+func (m *ScheduleRunMutation) ScheduleCleared() bool {
+	return m.clearedschedule
+}
+
+// ScheduleIDs returns the "schedule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScheduleID instead. It exists only for internal usage by the builders.
+func (m *ScheduleRunMutation) ScheduleIDs() (ids []uuid.UUID) {
+	if id := m.schedule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchedule resets all changes to the "schedule" edge.
+func (m *ScheduleRunMutation) ResetSchedule() {
+	m.schedule = nil
+	m.clearedschedule = false
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *ScheduleRunMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[schedulerun.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *ScheduleRunMutation) TaskCleared() bool {
+	return m.TaskIDCleared() || m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *ScheduleRunMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *ScheduleRunMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the ScheduleRunMutation builder.
+func (m *ScheduleRunMutation) Where(ps ...predicate.ScheduleRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScheduleRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduleRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduleRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScheduleRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScheduleRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScheduleRun).
+func (m *ScheduleRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduleRunMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, schedulerun.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, schedulerun.FieldUpdateTime)
+	}
+	if m.trigger != nil {
+		fields = append(fields, schedulerun.FieldTrigger)
+	}
+	if m.status != nil {
+		fields = append(fields, schedulerun.FieldStatus)
+	}
+	if m.scheduled_time != nil {
+		fields = append(fields, schedulerun.FieldScheduledTime)
+	}
+	if m.error != nil {
+		fields = append(fields, schedulerun.FieldError)
+	}
+	if m.schedule != nil {
+		fields = append(fields, schedulerun.FieldScheduleID)
+	}
+	if m.task != nil {
+		fields = append(fields, schedulerun.FieldTaskID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduleRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schedulerun.FieldCreateTime:
+		return m.CreateTime()
+	case schedulerun.FieldUpdateTime:
+		return m.UpdateTime()
+	case schedulerun.FieldTrigger:
+		return m.Trigger()
+	case schedulerun.FieldStatus:
+		return m.Status()
+	case schedulerun.FieldScheduledTime:
+		return m.ScheduledTime()
+	case schedulerun.FieldError:
+		return m.Error()
+	case schedulerun.FieldScheduleID:
+		return m.ScheduleID()
+	case schedulerun.FieldTaskID:
+		return m.TaskID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduleRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schedulerun.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case schedulerun.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case schedulerun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case schedulerun.FieldStatus:
+		return m.OldStatus(ctx)
+	case schedulerun.FieldScheduledTime:
+		return m.OldScheduledTime(ctx)
+	case schedulerun.FieldError:
+		return m.OldError(ctx)
+	case schedulerun.FieldScheduleID:
+		return m.OldScheduleID(ctx)
+	case schedulerun.FieldTaskID:
+		return m.OldTaskID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScheduleRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schedulerun.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case schedulerun.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case schedulerun.FieldTrigger:
+		v, ok := value.(types.ScheduleRunTrigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case schedulerun.FieldStatus:
+		v, ok := value.(types.ScheduleRunStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case schedulerun.FieldScheduledTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledTime(v)
+		return nil
+	case schedulerun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case schedulerun.FieldScheduleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleID(v)
+		return nil
+	case schedulerun.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduleRunMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduleRunMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScheduleRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduleRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(schedulerun.FieldError) {
+		fields = append(fields, schedulerun.FieldError)
+	}
+	if m.FieldCleared(schedulerun.FieldTaskID) {
+		fields = append(fields, schedulerun.FieldTaskID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduleRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduleRunMutation) ClearField(name string) error {
+	switch name {
+	case schedulerun.FieldError:
+		m.ClearError()
+		return nil
+	case schedulerun.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduleRunMutation) ResetField(name string) error {
+	switch name {
+	case schedulerun.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case schedulerun.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case schedulerun.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case schedulerun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case schedulerun.FieldScheduledTime:
+		m.ResetScheduledTime()
+		return nil
+	case schedulerun.FieldError:
+		m.ResetError()
+		return nil
+	case schedulerun.FieldScheduleID:
+		m.ResetScheduleID()
+		return nil
+	case schedulerun.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduleRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.schedule != nil {
+		edges = append(edges, schedulerun.EdgeSchedule)
+	}
+	if m.task != nil {
+		edges = append(edges, schedulerun.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduleRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case schedulerun.EdgeSchedule:
+		if id := m.schedule; id != nil {
+			return []ent.Value{*id}
+		}
+	case schedulerun.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduleRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduleRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduleRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedschedule {
+		edges = append(edges, schedulerun.EdgeSchedule)
+	}
+	if m.clearedtask {
+		edges = append(edges, schedulerun.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduleRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case schedulerun.EdgeSchedule:
+		return m.clearedschedule
+	case schedulerun.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduleRunMutation) ClearEdge(name string) error {
+	switch name {
+	case schedulerun.EdgeSchedule:
+		m.ClearSchedule()
+		return nil
+	case schedulerun.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduleRunMutation) ResetEdge(name string) error {
+	switch name {
+	case schedulerun.EdgeSchedule:
+		m.ResetSchedule()
+		return nil
+	case schedulerun.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	create_time            *time.Time
+	update_time            *time.Time
+	owner                  *string
+	team                   *string
+	project_directory      *string
+	input_tokens           *int64
+	addinput_tokens        *int64
+	output_tokens          *int64
+	addoutput_tokens       *int64
+	cache_write_tokens     *int64
+	addcache_write_tokens  *int64
+	cache_read_tokens      *int64
+	addcache_read_tokens   *int64
+	cost                   *float64
+	addcost                *float64
+	turns                  *int64
+	addturns               *int64
+	tool_uses              *map[string]int64
+	desired_phase          *types.TaskPhase
+	phase                  *types.TaskPhase
+	description            *string
+	review_edits           *bool
+	labels                 *map[string]string
+	forked_from_message_id *uuid.UUID
+	lease_owner            *string
+	lease_expire_time      *time.Time
+	clearedFields          map[string]struct{}
+	messages               map[uuid.UUID]struct{}
+	removedmessages        map[uuid.UUID]struct{}
+	clearedmessages        bool
+	file_changes           map[uuid.UUID]struct{}
+	removedfile_changes    map[uuid.UUID]struct{}
+	clearedfile_changes    bool
+	agent                  *uuid.UUID
+	clearedagent           bool
+	forked_from            *uuid.UUID
+	clearedforked_from     bool
+	forks                  map[uuid.UUID]struct{}
+	removedforks           map[uuid.UUID]struct{}
+	clearedforks           bool
+	done                   bool
+	oldValue               func(context.Context) (*Task, error)
+	predicates             []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id uuid.UUID) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Task entities.
+func (m *TaskMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TaskMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TaskMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TaskMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TaskMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *TaskMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *TaskMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *TaskMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[task.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *TaskMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[task.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *TaskMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, task.FieldOwner)
+}
+
+// SetTeam sets the "team" field.
+func (m *TaskMutation) SetTeam(s string) {
+	m.team = &s
+}
+
+// Team returns the value of the "team" field in the mutation.
+func (m *TaskMutation) Team() (r string, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeam returns the old "team" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldTeam(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+	}
+	return oldValue.Team, nil
+}
+
+// ClearTeam clears the value of the "team" field.
+func (m *TaskMutation) ClearTeam() {
+	m.team = nil
+	m.clearedFields[task.FieldTeam] = struct{}{}
+}
+
+// TeamCleared returns if the "team" field was cleared in this mutation.
+func (m *TaskMutation) TeamCleared() bool {
+	_, ok := m.clearedFields[task.FieldTeam]
+	return ok
+}
+
+// ResetTeam resets all changes to the "team" field.
+func (m *TaskMutation) ResetTeam() {
+	m.team = nil
+	delete(m.clearedFields, task.FieldTeam)
+}
+
+// SetProjectDirectory sets the "project_directory" field.
+func (m *TaskMutation) SetProjectDirectory(s string) {
+	m.project_directory = &s
+}
+
+// ProjectDirectory returns the value of the "project_directory" field in the mutation.
+func (m *TaskMutation) ProjectDirectory() (r string, exists bool) {
+	v := m.project_directory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectDirectory returns the old "project_directory" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldProjectDirectory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectDirectory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectDirectory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectDirectory: %w", err)
+	}
+	return oldValue.ProjectDirectory, nil
+}
+
+// ClearProjectDirectory clears the value of the "project_directory" field.
+func (m *TaskMutation) ClearProjectDirectory() {
+	m.project_directory = nil
+	m.clearedFields[task.FieldProjectDirectory] = struct{}{}
+}
+
+// ProjectDirectoryCleared returns if the "project_directory" field was cleared in this mutation.
+func (m *TaskMutation) ProjectDirectoryCleared() bool {
+	_, ok := m.clearedFields[task.FieldProjectDirectory]
+	return ok
+}
+
+// ResetProjectDirectory resets all changes to the "project_directory" field.
+func (m *TaskMutation) ResetProjectDirectory() {
+	m.project_directory = nil
+	delete(m.clearedFields, task.FieldProjectDirectory)
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (m *TaskMutation) SetInputTokens(i int64) {
+	m.input_tokens = &i
+	m.addinput_tokens = nil
+}
+
+// InputTokens returns the value of the "input_tokens" field in the mutation.
+func (m *TaskMutation) InputTokens() (r int64, exists bool) {
+	v := m.input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputTokens returns the old "input_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldInputTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+	}
+	return oldValue.InputTokens, nil
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (m *TaskMutation) AddInputTokens(i int64) {
+	if m.addinput_tokens != nil {
+		*m.addinput_tokens += i
+	} else {
+		m.addinput_tokens = &i
+	}
+}
+
+// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
+func (m *TaskMutation) AddedInputTokens() (r int64, exists bool) {
+	v := m.addinput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearInputTokens clears the value of the "input_tokens" field.
+func (m *TaskMutation) ClearInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+	m.clearedFields[task.FieldInputTokens] = struct{}{}
+}
+
+// InputTokensCleared returns if the "input_tokens" field was cleared in this mutation.
+func (m *TaskMutation) InputTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldInputTokens]
+	return ok
+}
+
+// ResetInputTokens resets all changes to the "input_tokens" field.
+func (m *TaskMutation) ResetInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+	delete(m.clearedFields, task.FieldInputTokens)
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (m *TaskMutation) SetOutputTokens(i int64) {
+	m.output_tokens = &i
+	m.addoutput_tokens = nil
+}
+
+// OutputTokens returns the value of the "output_tokens" field in the mutation.
+func (m *TaskMutation) OutputTokens() (r int64, exists bool) {
+	v := m.output_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputTokens returns the old "output_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOutputTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+	}
+	return oldValue.OutputTokens, nil
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (m *TaskMutation) AddOutputTokens(i int64) {
+	if m.addoutput_tokens != nil {
+		*m.addoutput_tokens += i
+	} else {
+		m.addoutput_tokens = &i
+	}
+}
+
+// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
+func (m *TaskMutation) AddedOutputTokens() (r int64, exists bool) {
+	v := m.addoutput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOutputTokens clears the value of the "output_tokens" field.
+func (m *TaskMutation) ClearOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+	m.clearedFields[task.FieldOutputTokens] = struct{}{}
+}
+
+// OutputTokensCleared returns if the "output_tokens" field was cleared in this mutation.
+func (m *TaskMutation) OutputTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldOutputTokens]
+	return ok
+}
+
+// ResetOutputTokens resets all changes to the "output_tokens" field.
+func (m *TaskMutation) ResetOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+	delete(m.clearedFields, task.FieldOutputTokens)
+}
+
+// SetCacheWriteTokens sets the "cache_write_tokens" field.
+func (m *TaskMutation) SetCacheWriteTokens(i int64) {
+	m.cache_write_tokens = &i
+	m.addcache_write_tokens = nil
+}
+
+// CacheWriteTokens returns the value of the "cache_write_tokens" field in the mutation.
+func (m *TaskMutation) CacheWriteTokens() (r int64, exists bool) {
+	v := m.cache_write_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheWriteTokens returns the old "cache_write_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCacheWriteTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheWriteTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheWriteTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheWriteTokens: %w", err)
+	}
+	return oldValue.CacheWriteTokens, nil
+}
+
+// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
+func (m *TaskMutation) AddCacheWriteTokens(i int64) {
+	if m.addcache_write_tokens != nil {
+		*m.addcache_write_tokens += i
+	} else {
+		m.addcache_write_tokens = &i
+	}
+}
+
+// AddedCacheWriteTokens returns the value that was added to the "cache_write_tokens" field in this mutation.
+func (m *TaskMutation) AddedCacheWriteTokens() (r int64, exists bool) {
+	v := m.addcache_write_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCacheWriteTokens clears the value of the "cache_write_tokens" field.
+func (m *TaskMutation) ClearCacheWriteTokens() {
+	m.cache_write_tokens = nil
+	m.addcache_write_tokens = nil
+	m.clearedFields[task.FieldCacheWriteTokens] = struct{}{}
+}
+
+// CacheWriteTokensCleared returns if the "cache_write_tokens" field was cleared in this mutation.
+func (m *TaskMutation) CacheWriteTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldCacheWriteTokens]
+	return ok
+}
+
+// ResetCacheWriteTokens resets all changes to the "cache_write_tokens" field.
+func (m *TaskMutation) ResetCacheWriteTokens() {
+	m.cache_write_tokens = nil
+	m.addcache_write_tokens = nil
+	delete(m.clearedFields, task.FieldCacheWriteTokens)
+}
+
+// SetCacheReadTokens sets the "cache_read_tokens" field.
+func (m *TaskMutation) SetCacheReadTokens(i int64) {
+	m.cache_read_tokens = &i
+	m.addcache_read_tokens = nil
+}
+
+// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
+func (m *TaskMutation) CacheReadTokens() (r int64, exists bool) {
+	v := m.cache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCacheReadTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
+	}
+	return oldValue.CacheReadTokens, nil
+}
+
+// AddCacheReadTokens adds i to the "cache_read_tokens" field.
+func (m *TaskMutation) AddCacheReadTokens(i int64) {
+	if m.addcache_read_tokens != nil {
+		*m.addcache_read_tokens += i
+	} else {
+		m.addcache_read_tokens = &i
+	}
+}
+
+// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
+func (m *TaskMutation) AddedCacheReadTokens() (r int64, exists bool) {
+	v := m.addcache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCacheReadTokens clears the value of the "cache_read_tokens" field.
+func (m *TaskMutation) ClearCacheReadTokens() {
+	m.cache_read_tokens = nil
+	m.addcache_read_tokens = nil
+	m.clearedFields[task.FieldCacheReadTokens] = struct{}{}
+}
+
+// CacheReadTokensCleared returns if the "cache_read_tokens" field was cleared in this mutation.
+func (m *TaskMutation) CacheReadTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldCacheReadTokens]
+	return ok
+}
+
+// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
+func (m *TaskMutation) ResetCacheReadTokens() {
+	m.cache_read_tokens = nil
+	m.addcache_read_tokens = nil
+	delete(m.clearedFields, task.FieldCacheReadTokens)
+}
+
+// SetCost sets the "cost" field.
+func (m *TaskMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *TaskMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *TaskMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *TaskMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCost clears the value of the "cost" field.
+func (m *TaskMutation) ClearCost() {
+	m.cost = nil
+	m.addcost = nil
+	m.clearedFields[task.FieldCost] = struct{}{}
+}
+
+// CostCleared returns if the "cost" field was cleared in this mutation.
+func (m *TaskMutation) CostCleared() bool {
+	_, ok := m.clearedFields[task.FieldCost]
+	return ok
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *TaskMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+	delete(m.clearedFields, task.FieldCost)
+}
+
+// SetTurns sets the "turns" field.
+func (m *TaskMutation) SetTurns(i int64) {
+	m.turns = &i
+	m.addturns = nil
+}
+
+// Turns returns the value of the "turns" field in the mutation.
+func (m *TaskMutation) Turns() (r int64, exists bool) {
+	v := m.turns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTurns returns the old "turns" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldTurns(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTurns is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTurns requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTurns: %w", err)
+	}
+	return oldValue.Turns, nil
+}
+
+// AddTurns adds i to the "turns" field.
+func (m *TaskMutation) AddTurns(i int64) {
+	if m.addturns != nil {
+		*m.addturns += i
+	} else {
+		m.addturns = &i
+	}
+}
+
+// AddedTurns returns the value that was added to the "turns" field in this mutation.
+func (m *TaskMutation) AddedTurns() (r int64, exists bool) {
+	v := m.addturns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTurns resets all changes to the "turns" field.
+func (m *TaskMutation) ResetTurns() {
+	m.turns = nil
+	m.addturns = nil
+}
+
+// SetToolUses sets the "tool_uses" field.
+func (m *TaskMutation) SetToolUses(value map[string]int64) {
+	m.tool_uses = &value
+}
+
+// ToolUses returns the value of the "tool_uses" field in the mutation.
+func (m *TaskMutation) ToolUses() (r map[string]int64, exists bool) {
+	v := m.tool_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToolUses returns the old "tool_uses" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldToolUses(ctx context.Context) (v map[string]int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToolUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToolUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToolUses: %w", err)
+	}
+	return oldValue.ToolUses, nil
+}
+
+// ResetToolUses resets all changes to the "tool_uses" field.
+func (m *TaskMutation) ResetToolUses() {
+	m.tool_uses = nil
+}
+
+// SetDesiredPhase sets the "desired_phase" field.
+func (m *TaskMutation) SetDesiredPhase(tp types.TaskPhase) {
+	m.desired_phase = &tp
+}
+
+// DesiredPhase returns the value of the "desired_phase" field in the mutation.
+func (m *TaskMutation) DesiredPhase() (r types.TaskPhase, exists bool) {
+	v := m.desired_phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDesiredPhase returns the old "desired_phase" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDesiredPhase(ctx context.Context) (v types.TaskPhase, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDesiredPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDesiredPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDesiredPhase: %w", err)
+	}
+	return oldValue.DesiredPhase, nil
+}
+
+// ResetDesiredPhase resets all changes to the "desired_phase" field.
+func (m *TaskMutation) ResetDesiredPhase() {
+	m.desired_phase = nil
+}
+
+// SetPhase sets the "phase" field.
+func (m *TaskMutation) SetPhase(tp types.TaskPhase) {
+	m.phase = &tp
+}
+
+// Phase returns the value of the "phase" field in the mutation.
+func (m *TaskMutation) Phase() (r types.TaskPhase, exists bool) {
+	v := m.phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhase returns the old "phase" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPhase(ctx context.Context) (v types.TaskPhase, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+	}
+	return oldValue.Phase, nil
+}
+
+// ResetPhase resets all changes to the "phase" field.
+func (m *TaskMutation) ResetPhase() {
+	m.phase = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[task.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[task.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, task.FieldDescription)
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *TaskMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *TaskMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *TaskMutation) ClearAgentID() {
+	m.agent = nil
+	m.clearedFields[task.FieldAgentID] = struct{}{}
+}
+
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *TaskMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldAgentID]
+	return ok
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *TaskMutation) ResetAgentID() {
+	m.agent = nil
+	delete(m.clearedFields, task.FieldAgentID)
+}
+
+// SetReviewEdits sets the "review_edits" field.
+func (m *TaskMutation) SetReviewEdits(b bool) {
+	m.review_edits = &b
+}
+
+// ReviewEdits returns the value of the "review_edits" field in the mutation.
+func (m *TaskMutation) ReviewEdits() (r bool, exists bool) {
+	v := m.review_edits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewEdits returns the old "review_edits" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldReviewEdits(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewEdits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewEdits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewEdits: %w", err)
+	}
+	return oldValue.ReviewEdits, nil
+}
+
+// ResetReviewEdits resets all changes to the "review_edits" field.
+func (m *TaskMutation) ResetReviewEdits() {
+	m.review_edits = nil
+}
+
+// SetLabels sets the "labels" field.
+func (m *TaskMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *TaskMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *TaskMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[task.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *TaskMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[task.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *TaskMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, task.FieldLabels)
+}
+
+// SetForkedFromID sets the "forked_from_id" field.
+func (m *TaskMutation) SetForkedFromID(u uuid.UUID) {
+	m.forked_from = &u
+}
+
+// ForkedFromID returns the value of the "forked_from_id" field in the mutation.
+func (m *TaskMutation) ForkedFromID() (r uuid.UUID, exists bool) {
+	v := m.forked_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForkedFromID returns the old "forked_from_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldForkedFromID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForkedFromID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForkedFromID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForkedFromID: %w", err)
+	}
+	return oldValue.ForkedFromID, nil
+}
+
+// ClearForkedFromID clears the value of the "forked_from_id" field.
+func (m *TaskMutation) ClearForkedFromID() {
+	m.forked_from = nil
+	m.clearedFields[task.FieldForkedFromID] = struct{}{}
+}
+
+// ForkedFromIDCleared returns if the "forked_from_id" field was cleared in this mutation.
+func (m *TaskMutation) ForkedFromIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldForkedFromID]
+	return ok
+}
+
+// ResetForkedFromID resets all changes to the "forked_from_id" field.
+func (m *TaskMutation) ResetForkedFromID() {
+	m.forked_from = nil
+	delete(m.clearedFields, task.FieldForkedFromID)
+}
+
+// SetForkedFromMessageID sets the "forked_from_message_id" field.
+func (m *TaskMutation) SetForkedFromMessageID(u uuid.UUID) {
+	m.forked_from_message_id = &u
+}
+
+// ForkedFromMessageID returns the value of the "forked_from_message_id" field in the mutation.
+func (m *TaskMutation) ForkedFromMessageID() (r uuid.UUID, exists bool) {
+	v := m.forked_from_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForkedFromMessageID returns the old "forked_from_message_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldForkedFromMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForkedFromMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForkedFromMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForkedFromMessageID: %w", err)
+	}
+	return oldValue.ForkedFromMessageID, nil
+}
+
+// ClearForkedFromMessageID clears the value of the "forked_from_message_id" field.
+func (m *TaskMutation) ClearForkedFromMessageID() {
+	m.forked_from_message_id = nil
+	m.clearedFields[task.FieldForkedFromMessageID] = struct{}{}
+}
+
+// ForkedFromMessageIDCleared returns if the "forked_from_message_id" field was cleared in this mutation.
+func (m *TaskMutation) ForkedFromMessageIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldForkedFromMessageID]
+	return ok
+}
+
+// ResetForkedFromMessageID resets all changes to the "forked_from_message_id" field.
+func (m *TaskMutation) ResetForkedFromMessageID() {
+	m.forked_from_message_id = nil
+	delete(m.clearedFields, task.FieldForkedFromMessageID)
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (m *TaskMutation) SetLeaseOwner(s string) {
+	m.lease_owner = &s
+}
+
+// LeaseOwner returns the value of the "lease_owner" field in the mutation.
+func (m *TaskMutation) LeaseOwner() (r string, exists bool) {
+	v := m.lease_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseOwner returns the old "lease_owner" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldLeaseOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseOwner: %w", err)
+	}
+	return oldValue.LeaseOwner, nil
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (m *TaskMutation) ClearLeaseOwner() {
+	m.lease_owner = nil
+	m.clearedFields[task.FieldLeaseOwner] = struct{}{}
+}
+
+// LeaseOwnerCleared returns if the "lease_owner" field was cleared in this mutation.
+func (m *TaskMutation) LeaseOwnerCleared() bool {
+	_, ok := m.clearedFields[task.FieldLeaseOwner]
+	return ok
+}
+
+// ResetLeaseOwner resets all changes to the "lease_owner" field.
+func (m *TaskMutation) ResetLeaseOwner() {
+	m.lease_owner = nil
+	delete(m.clearedFields, task.FieldLeaseOwner)
+}
+
+// SetLeaseExpireTime sets the "lease_expire_time" field.
+func (m *TaskMutation) SetLeaseExpireTime(t time.Time) {
+	m.lease_expire_time = &t
+}
+
+// LeaseExpireTime returns the value of the "lease_expire_time" field in the mutation.
+func (m *TaskMutation) LeaseExpireTime() (r time.Time, exists bool) {
+	v := m.lease_expire_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpireTime returns the old "lease_expire_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldLeaseExpireTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpireTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpireTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpireTime: %w", err)
+	}
+	return oldValue.LeaseExpireTime, nil
+}
+
+// ClearLeaseExpireTime clears the value of the "lease_expire_time" field.
+func (m *TaskMutation) ClearLeaseExpireTime() {
+	m.lease_expire_time = nil
+	m.clearedFields[task.FieldLeaseExpireTime] = struct{}{}
+}
+
+// LeaseExpireTimeCleared returns if the "lease_expire_time" field was cleared in this mutation.
+func (m *TaskMutation) LeaseExpireTimeCleared() bool {
+	_, ok := m.clearedFields[task.FieldLeaseExpireTime]
+	return ok
+}
+
+// ResetLeaseExpireTime resets all changes to the "lease_expire_time" field.
+func (m *TaskMutation) ResetLeaseExpireTime() {
+	m.lease_expire_time = nil
+	delete(m.clearedFields, task.FieldLeaseExpireTime)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *TaskMutation) AddMessageIDs(ids ...uuid.UUID) {
+	if m.messages == nil {
+		m.messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *TaskMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *TaskMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *TaskMutation) RemoveMessageIDs(ids ...uuid.UUID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *TaskMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *TaskMutation) MessagesIDs() (ids []uuid.UUID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *TaskMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddFileChangeIDs adds the "file_changes" edge to the FileChange entity by ids.
+func (m *TaskMutation) AddFileChangeIDs(ids ...uuid.UUID) {
+	if m.file_changes == nil {
+		m.file_changes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.file_changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFileChanges clears the "file_changes" edge to the FileChange entity.
+func (m *TaskMutation) ClearFileChanges() {
+	m.clearedfile_changes = true
+}
+
+// FileChangesCleared reports if the "file_changes" edge to the FileChange entity was cleared.
+func (m *TaskMutation) FileChangesCleared() bool {
+	return m.clearedfile_changes
+}
+
+// RemoveFileChangeIDs removes the "file_changes" edge to the FileChange entity by IDs.
+func (m *TaskMutation) RemoveFileChangeIDs(ids ...uuid.UUID) {
+	if m.removedfile_changes == nil {
+		m.removedfile_changes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.file_changes, ids[i])
+		m.removedfile_changes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFileChanges returns the removed IDs of the "file_changes" edge to the FileChange entity.
+func (m *TaskMutation) RemovedFileChangesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfile_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FileChangesIDs returns the "file_changes" edge IDs in the mutation.
+func (m *TaskMutation) FileChangesIDs() (ids []uuid.UUID) {
+	for id := range m.file_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFileChanges resets all changes to the "file_changes" edge.
+func (m *TaskMutation) ResetFileChanges() {
+	m.file_changes = nil
+	m.clearedfile_changes = false
+	m.removedfile_changes = nil
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *TaskMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[task.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *TaskMutation) AgentCleared() bool {
+	return m.AgentIDCleared() || m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *TaskMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// ClearForkedFrom clears the "forked_from" edge to the Task entity.
+func (m *TaskMutation) ClearForkedFrom() {
+	m.clearedforked_from = true
+	m.clearedFields[task.FieldForkedFromID] = struct{}{}
+}
+
+// ForkedFromCleared reports if the "forked_from" edge to the Task entity was cleared.
+func (m *TaskMutation) ForkedFromCleared() bool {
+	return m.ForkedFromIDCleared() || m.clearedforked_from
+}
+
+// ForkedFromIDs returns the "forked_from" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ForkedFromID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) ForkedFromIDs() (ids []uuid.UUID) {
+	if id := m.forked_from; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetForkedFrom resets all changes to the "forked_from" edge.
+func (m *TaskMutation) ResetForkedFrom() {
+	m.forked_from = nil
+	m.clearedforked_from = false
+}
+
+// AddForkIDs adds the "forks" edge to the Task entity by ids.
+func (m *TaskMutation) AddForkIDs(ids ...uuid.UUID) {
+	if m.forks == nil {
+		m.forks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.forks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearForks clears the "forks" edge to the Task entity.
+func (m *TaskMutation) ClearForks() {
+	m.clearedforks = true
+}
+
+// ForksCleared reports if the "forks" edge to the Task entity was cleared.
+func (m *TaskMutation) ForksCleared() bool {
+	return m.clearedforks
+}
+
+// RemoveForkIDs removes the "forks" edge to the Task entity by IDs.
+func (m *TaskMutation) RemoveForkIDs(ids ...uuid.UUID) {
+	if m.removedforks == nil {
+		m.removedforks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.forks, ids[i])
+		m.removedforks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedForks returns the removed IDs of the "forks" edge to the Task entity.
+func (m *TaskMutation) RemovedForksIDs() (ids []uuid.UUID) {
+	for id := range m.removedforks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ForksIDs returns the "forks" edge IDs in the mutation.
+func (m *TaskMutation) ForksIDs() (ids []uuid.UUID) {
+	for id := range m.forks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetForks resets all changes to the "forks" edge.
+func (m *TaskMutation) ResetForks() {
+	m.forks = nil
+	m.clearedforks = false
+	m.removedforks = nil
+}
+
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.create_time != nil {
+		fields = append(fields, task.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, task.FieldUpdateTime)
+	}
+	if m.owner != nil {
+		fields = append(fields, task.FieldOwner)
+	}
+	if m.team != nil {
+		fields = append(fields, task.FieldTeam)
+	}
+	if m.project_directory != nil {
+		fields = append(fields, task.FieldProjectDirectory)
+	}
+	if m.input_tokens != nil {
+		fields = append(fields, task.FieldInputTokens)
+	}
+	if m.output_tokens != nil {
+		fields = append(fields, task.FieldOutputTokens)
+	}
+	if m.cache_write_tokens != nil {
+		fields = append(fields, task.FieldCacheWriteTokens)
+	}
+	if m.cache_read_tokens != nil {
+		fields = append(fields, task.FieldCacheReadTokens)
+	}
+	if m.cost != nil {
+		fields = append(fields, task.FieldCost)
+	}
+	if m.turns != nil {
+		fields = append(fields, task.FieldTurns)
+	}
+	if m.tool_uses != nil {
+		fields = append(fields, task.FieldToolUses)
+	}
+	if m.desired_phase != nil {
+		fields = append(fields, task.FieldDesiredPhase)
+	}
+	if m.phase != nil {
+		fields = append(fields, task.FieldPhase)
+	}
+	if m.description != nil {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.agent != nil {
+		fields = append(fields, task.FieldAgentID)
+	}
+	if m.review_edits != nil {
+		fields = append(fields, task.FieldReviewEdits)
+	}
+	if m.labels != nil {
+		fields = append(fields, task.FieldLabels)
+	}
+	if m.forked_from != nil {
+		fields = append(fields, task.FieldForkedFromID)
+	}
+	if m.forked_from_message_id != nil {
+		fields = append(fields, task.FieldForkedFromMessageID)
+	}
+	if m.lease_owner != nil {
+		fields = append(fields, task.FieldLeaseOwner)
+	}
+	if m.lease_expire_time != nil {
+		fields = append(fields, task.FieldLeaseExpireTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldCreateTime:
+		return m.CreateTime()
+	case task.FieldUpdateTime:
+		return m.UpdateTime()
+	case task.FieldOwner:
+		return m.Owner()
+	case task.FieldTeam:
+		return m.Team()
+	case task.FieldProjectDirectory:
+		return m.ProjectDirectory()
+	case task.FieldInputTokens:
+		return m.InputTokens()
+	case task.FieldOutputTokens:
+		return m.OutputTokens()
+	case task.FieldCacheWriteTokens:
+		return m.CacheWriteTokens()
+	case task.FieldCacheReadTokens:
+		return m.CacheReadTokens()
+	case task.FieldCost:
+		return m.Cost()
+	case task.FieldTurns:
+		return m.Turns()
+	case task.FieldToolUses:
+		return m.ToolUses()
+	case task.FieldDesiredPhase:
+		return m.DesiredPhase()
+	case task.FieldPhase:
+		return m.Phase()
+	case task.FieldDescription:
+		return m.Description()
+	case task.FieldAgentID:
+		return m.AgentID()
+	case task.FieldReviewEdits:
+		return m.ReviewEdits()
+	case task.FieldLabels:
+		return m.Labels()
+	case task.FieldForkedFromID:
+		return m.ForkedFromID()
+	case task.FieldForkedFromMessageID:
+		return m.ForkedFromMessageID()
+	case task.FieldLeaseOwner:
+		return m.LeaseOwner()
+	case task.FieldLeaseExpireTime:
+		return m.LeaseExpireTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case task.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case task.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case task.FieldOwner:
+		return m.OldOwner(ctx)
+	case task.FieldTeam:
+		return m.OldTeam(ctx)
+	case task.FieldProjectDirectory:
+		return m.OldProjectDirectory(ctx)
+	case task.FieldInputTokens:
+		return m.OldInputTokens(ctx)
+	case task.FieldOutputTokens:
+		return m.OldOutputTokens(ctx)
+	case task.FieldCacheWriteTokens:
+		return m.OldCacheWriteTokens(ctx)
+	case task.FieldCacheReadTokens:
+		return m.OldCacheReadTokens(ctx)
+	case task.FieldCost:
+		return m.OldCost(ctx)
+	case task.FieldTurns:
+		return m.OldTurns(ctx)
+	case task.FieldToolUses:
+		return m.OldToolUses(ctx)
+	case task.FieldDesiredPhase:
+		return m.OldDesiredPhase(ctx)
+	case task.FieldPhase:
+		return m.OldPhase(ctx)
+	case task.FieldDescription:
+		return m.OldDescription(ctx)
+	case task.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case task.FieldReviewEdits:
+		return m.OldReviewEdits(ctx)
+	case task.FieldLabels:
+		return m.OldLabels(ctx)
+	case task.FieldForkedFromID:
+		return m.OldForkedFromID(ctx)
+	case task.FieldForkedFromMessageID:
+		return m.OldForkedFromMessageID(ctx)
+	case task.FieldLeaseOwner:
+		return m.OldLeaseOwner(ctx)
+	case task.FieldLeaseExpireTime:
+		return m.OldLeaseExpireTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown Task field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case task.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case task.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case task.FieldTeam:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeam(v)
+		return nil
+	case task.FieldProjectDirectory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectDirectory(v)
+		return nil
+	case task.FieldInputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputTokens(v)
+		return nil
+	case task.FieldOutputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputTokens(v)
+		return nil
+	case task.FieldCacheWriteTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheWriteTokens(v)
+		return nil
+	case task.FieldCacheReadTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheReadTokens(v)
+		return nil
+	case task.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case task.FieldTurns:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTurns(v)
+		return nil
+	case task.FieldToolUses:
+		v, ok := value.(map[string]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToolUses(v)
+		return nil
+	case task.FieldDesiredPhase:
+		v, ok := value.(types.TaskPhase)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesiredPhase(v)
+		return nil
+	case task.FieldPhase:
+		v, ok := value.(types.TaskPhase)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhase(v)
+		return nil
+	case task.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case task.FieldAgentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case task.FieldReviewEdits:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewEdits(v)
+		return nil
+	case task.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case task.FieldForkedFromID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForkedFromID(v)
+		return nil
+	case task.FieldForkedFromMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForkedFromMessageID(v)
+		return nil
+	case task.FieldLeaseOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseOwner(v)
+		return nil
+	case task.FieldLeaseExpireTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpireTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addinput_tokens != nil {
+		fields = append(fields, task.FieldInputTokens)
+	}
+	if m.addoutput_tokens != nil {
+		fields = append(fields, task.FieldOutputTokens)
+	}
+	if m.addcache_write_tokens != nil {
+		fields = append(fields, task.FieldCacheWriteTokens)
+	}
+	if m.addcache_read_tokens != nil {
+		fields = append(fields, task.FieldCacheReadTokens)
+	}
+	if m.addcost != nil {
+		fields = append(fields, task.FieldCost)
+	}
+	if m.addturns != nil {
+		fields = append(fields, task.FieldTurns)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldInputTokens:
+		return m.AddedInputTokens()
+	case task.FieldOutputTokens:
+		return m.AddedOutputTokens()
+	case task.FieldCacheWriteTokens:
+		return m.AddedCacheWriteTokens()
+	case task.FieldCacheReadTokens:
+		return m.AddedCacheReadTokens()
+	case task.FieldCost:
+		return m.AddedCost()
+	case task.FieldTurns:
+		return m.AddedTurns()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldInputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputTokens(v)
+		return nil
+	case task.FieldOutputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputTokens(v)
+		return nil
+	case task.FieldCacheWriteTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheWriteTokens(v)
+		return nil
+	case task.FieldCacheReadTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadTokens(v)
+		return nil
+	case task.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	case task.FieldTurns:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTurns(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(task.FieldOwner) {
+		fields = append(fields, task.FieldOwner)
+	}
+	if m.FieldCleared(task.FieldTeam) {
+		fields = append(fields, task.FieldTeam)
+	}
+	if m.FieldCleared(task.FieldProjectDirectory) {
+		fields = append(fields, task.FieldProjectDirectory)
+	}
+	if m.FieldCleared(task.FieldInputTokens) {
+		fields = append(fields, task.FieldInputTokens)
+	}
+	if m.FieldCleared(task.FieldOutputTokens) {
+		fields = append(fields, task.FieldOutputTokens)
+	}
+	if m.FieldCleared(task.FieldCacheWriteTokens) {
+		fields = append(fields, task.FieldCacheWriteTokens)
+	}
+	if m.FieldCleared(task.FieldCacheReadTokens) {
+		fields = append(fields, task.FieldCacheReadTokens)
+	}
+	if m.FieldCleared(task.FieldCost) {
+		fields = append(fields, task.FieldCost)
+	}
+	if m.FieldCleared(task.FieldDescription) {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.FieldCleared(task.FieldAgentID) {
+		fields = append(fields, task.FieldAgentID)
+	}
+	if m.FieldCleared(task.FieldLabels) {
+		fields = append(fields, task.FieldLabels)
+	}
+	if m.FieldCleared(task.FieldForkedFromID) {
+		fields = append(fields, task.FieldForkedFromID)
+	}
+	if m.FieldCleared(task.FieldForkedFromMessageID) {
+		fields = append(fields, task.FieldForkedFromMessageID)
+	}
+	if m.FieldCleared(task.FieldLeaseOwner) {
+		fields = append(fields, task.FieldLeaseOwner)
+	}
+	if m.FieldCleared(task.FieldLeaseExpireTime) {
+		fields = append(fields, task.FieldLeaseExpireTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case task.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case task.FieldTeam:
+		m.ClearTeam()
+		return nil
+	case task.FieldProjectDirectory:
+		m.ClearProjectDirectory()
+		return nil
+	case task.FieldInputTokens:
+		m.ClearInputTokens()
+		return nil
+	case task.FieldOutputTokens:
+		m.ClearOutputTokens()
+		return nil
+	case task.FieldCacheWriteTokens:
+		m.ClearCacheWriteTokens()
+		return nil
+	case task.FieldCacheReadTokens:
+		m.ClearCacheReadTokens()
+		return nil
+	case task.FieldCost:
+		m.ClearCost()
+		return nil
+	case task.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case task.FieldAgentID:
+		m.ClearAgentID()
+		return nil
+	case task.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case task.FieldForkedFromID:
+		m.ClearForkedFromID()
+		return nil
+	case task.FieldForkedFromMessageID:
+		m.ClearForkedFromMessageID()
+		return nil
+	case task.FieldLeaseOwner:
+		m.ClearLeaseOwner()
+		return nil
+	case task.FieldLeaseExpireTime:
+		m.ClearLeaseExpireTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Task nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskMutation) ResetField(name string) error {
+	switch name {
+	case task.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case task.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case task.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case task.FieldTeam:
+		m.ResetTeam()
+		return nil
+	case task.FieldProjectDirectory:
+		m.ResetProjectDirectory()
+		return nil
+	case task.FieldInputTokens:
+		m.ResetInputTokens()
+		return nil
+	case task.FieldOutputTokens:
+		m.ResetOutputTokens()
+		return nil
+	case task.FieldCacheWriteTokens:
+		m.ResetCacheWriteTokens()
+		return nil
+	case task.FieldCacheReadTokens:
+		m.ResetCacheReadTokens()
+		return nil
+	case task.FieldCost:
+		m.ResetCost()
+		return nil
+	case task.FieldTurns:
+		m.ResetTurns()
+		return nil
+	case task.FieldToolUses:
+		m.ResetToolUses()
+		return nil
+	case task.FieldDesiredPhase:
+		m.ResetDesiredPhase()
+		return nil
+	case task.FieldPhase:
+		m.ResetPhase()
+		return nil
+	case task.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case task.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case task.FieldReviewEdits:
+		m.ResetReviewEdits()
+		return nil
+	case task.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case task.FieldForkedFromID:
+		m.ResetForkedFromID()
+		return nil
+	case task.FieldForkedFromMessageID:
+		m.ResetForkedFromMessageID()
+		return nil
+	case task.FieldLeaseOwner:
+		m.ResetLeaseOwner()
+		return nil
+	case task.FieldLeaseExpireTime:
+		m.ResetLeaseExpireTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.messages != nil {
+		edges = append(edges, task.EdgeMessages)
+	}
+	if m.file_changes != nil {
+		edges = append(edges, task.EdgeFileChanges)
+	}
+	if m.agent != nil {
+		edges = append(edges, task.EdgeAgent)
+	}
+	if m.forked_from != nil {
+		edges = append(edges, task.EdgeForkedFrom)
+	}
+	if m.forks != nil {
+		edges = append(edges, task.EdgeForks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeFileChanges:
+		ids := make([]ent.Value, 0, len(m.file_changes))
+		for id := range m.file_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeForkedFrom:
+		if id := m.forked_from; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeForks:
+		ids := make([]ent.Value, 0, len(m.forks))
+		for id := range m.forks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedmessages != nil {
+		edges = append(edges, task.EdgeMessages)
+	}
+	if m.removedfile_changes != nil {
+		edges = append(edges, task.EdgeFileChanges)
+	}
+	if m.removedforks != nil {
+		edges = append(edges, task.EdgeForks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeFileChanges:
+		ids := make([]ent.Value, 0, len(m.removedfile_changes))
+		for id := range m.removedfile_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeForks:
+		ids := make([]ent.Value, 0, len(m.removedforks))
+		for id := range m.removedforks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedmessages {
+		edges = append(edges, task.EdgeMessages)
+	}
+	if m.clearedfile_changes {
+		edges = append(edges, task.EdgeFileChanges)
+	}
+	if m.clearedagent {
+		edges = append(edges, task.EdgeAgent)
+	}
+	if m.clearedforked_from {
+		edges = append(edges, task.EdgeForkedFrom)
+	}
+	if m.clearedforks {
+		edges = append(edges, task.EdgeForks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case task.EdgeMessages:
+		return m.clearedmessages
+	case task.EdgeFileChanges:
+		return m.clearedfile_changes
+	case task.EdgeAgent:
+		return m.clearedagent
+	case task.EdgeForkedFrom:
+		return m.clearedforked_from
+	case task.EdgeForks:
+		return m.clearedforks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskMutation) ClearEdge(name string) error {
+	switch name {
+	case task.EdgeAgent:
+		m.ClearAgent()
+		return nil
+	case task.EdgeForkedFrom:
+		m.ClearForkedFrom()
+		return nil
+	}
+	return fmt.Errorf("unknown Task unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskMutation) ResetEdge(name string) error {
+	switch name {
+	case task.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case task.EdgeFileChanges:
+		m.ResetFileChanges()
+		return nil
+	case task.EdgeAgent:
+		m.ResetAgent()
+		return nil
+	case task.EdgeForkedFrom:
+		m.ResetForkedFrom()
+		return nil
+	case task.EdgeForks:
+		m.ResetForks()
+		return nil
+	}
+	return fmt.Errorf("unknown Task edge %s", name)
+}
+
+// TokenMutation represents an operation that mutates the Token nodes in the graph.
+type TokenMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	name           *string
+	hash           *[]byte
+	scope          *types.TokenScope
+	user           *string
+	team           *string
+	expire_time    *time.Time
+	last_used_time *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Token, error)
+	predicates     []predicate.Token
+}
+
+var _ ent.Mutation = (*TokenMutation)(nil)
+
+// tokenOption allows management of the mutation configuration using functional options.
+type tokenOption func(*TokenMutation)
+
+// newTokenMutation creates new mutation for the Token entity.
+func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
+	m := &TokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenID sets the ID field of the mutation.
+func withTokenID(id uuid.UUID) tokenOption {
+	return func(m *TokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Token
+		)
+		m.oldValue = func(ctx context.Context) (*Token, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Token.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withToken sets the old Token of the mutation.
+func withToken(node *Token) tokenOption {
+	return func(m *TokenMutation) {
+		m.oldValue = func(context.Context) (*Token, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Token entities.
+func (m *TokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return *v, true
-}
-
-// ResetOutputCost resets all changes to the "output_cost" field.
-func (m *ModelMutation) ResetOutputCost() {
-	m.output_cost = nil
-	m.addoutput_cost = nil
 }
 
-// SetCacheWriteCost sets the "cache_write_cost" field.
-func (m *ModelMutation) SetCacheWriteCost(f float64) {
-	m.cache_write_cost = &f
-	m.addcache_write_cost = nil
+// SetCreateTime sets the "create_time" field.
+func (m *TokenMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// CacheWriteCost returns the value of the "cache_write_cost" field in the mutation.
-func (m *ModelMutation) CacheWriteCost() (r float64, exists bool) {
-	v := m.cache_write_cost
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TokenMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheWriteCost returns the old "cache_write_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCacheWriteCost(ctx context.Context) (v float64, err error) {
+func (m *TokenMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheWriteCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheWriteCost requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheWriteCost: %w", err)
-	}
-	return oldValue.CacheWriteCost, nil
-}
-
-// AddCacheWriteCost adds f to the "cache_write_cost" field.
-func (m *ModelMutation) AddCacheWriteCost(f float64) {
-	if m.addcache_write_cost != nil {
-		*m.addcache_write_cost += f
-	} else {
-		m.addcache_write_cost = &f
-	}
-}
-
-// AddedCacheWriteCost returns the value that was added to the "cache_write_cost" field in this mutation.
-func (m *ModelMutation) AddedCacheWriteCost() (r float64, exists bool) {
-	v := m.addcache_write_cost
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return *v, true
+	return oldValue.CreateTime, nil
 }
 
-// ResetCacheWriteCost resets all changes to the "cache_write_cost" field.
-func (m *ModelMutation) ResetCacheWriteCost() {
-	m.cache_write_cost = nil
-	m.addcache_write_cost = nil
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TokenMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetCacheReadCost sets the "cache_read_cost" field.
-func (m *ModelMutation) SetCacheReadCost(f float64) {
-	m.cache_read_cost = &f
-	m.addcache_read_cost = nil
+// SetUpdateTime sets the "update_time" field.
+func (m *TokenMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// CacheReadCost returns the value of the "cache_read_cost" field in the mutation.
-func (m *ModelMutation) CacheReadCost() (r float64, exists bool) {
-	v := m.cache_read_cost
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TokenMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheReadCost returns the old "cache_read_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCacheReadCost(ctx context.Context) (v float64, err error) {
+func (m *TokenMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadCost requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.CacheReadCost, nil
+	return oldValue.UpdateTime, nil
 }
 
-// AddCacheReadCost adds f to the "cache_read_cost" field.
-func (m *ModelMutation) AddCacheReadCost(f float64) {
-	if m.addcache_read_cost != nil {
-		*m.addcache_read_cost += f
-	} else {
-		m.addcache_read_cost = &f
-	}
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TokenMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// AddedCacheReadCost returns the value that was added to the "cache_read_cost" field in this mutation.
-func (m *ModelMutation) AddedCacheReadCost() (r float64, exists bool) {
-	v := m.addcache_read_cost
+// SetName sets the "name" field.
+func (m *TokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCacheReadCost resets all changes to the "cache_read_cost" field.
-func (m *ModelMutation) ResetCacheReadCost() {
-	m.cache_read_cost = nil
-	m.addcache_read_cost = nil
+// OldName returns the old "name" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *ModelMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// ResetName resets all changes to the "name" field.
+func (m *TokenMutation) ResetName() {
+	m.name = nil
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *ModelMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// SetHash sets the "hash" field.
+func (m *TokenMutation) SetHash(b []byte) {
+	m.hash = &b
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *TokenMutation) Hash() (r []byte, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *TokenMutation) OldHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.Hash, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *ModelMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *TokenMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetAlias sets the "alias" field.
-func (m *ModelMutation) SetAlias(s string) {
-	m.alias = &s
+// SetScope sets the "scope" field.
+func (m *TokenMutation) SetScope(ts types.TokenScope) {
+	m.scope = &ts
 }
 
-// Alias returns the value of the "alias" field in the mutation.
-func (m *ModelMutation) Alias() (r string, exists bool) {
-	v := m.alias
+// Scope returns the value of the "scope" field in the mutation.
+func (m *TokenMutation) Scope() (r types.TokenScope, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAlias returns the old "alias" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldAlias(ctx context.Context) (v string, err error) {
+func (m *TokenMutation) OldScope(ctx context.Context) (v types.TokenScope, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlias requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.Alias, nil
-}
-
-// ClearAlias clears the value of the "alias" field.
-func (m *ModelMutation) ClearAlias() {
-	m.alias = nil
-	m.clearedFields[model.FieldAlias] = struct{}{}
-}
-
-// AliasCleared returns if the "alias" field was cleared in this mutation.
-func (m *ModelMutation) AliasCleared() bool {
-	_, ok := m.clearedFields[model.FieldAlias]
-	return ok
+	return oldValue.Scope, nil
 }
 
-// ResetAlias resets all changes to the "alias" field.
-func (m *ModelMutation) ResetAlias() {
-	m.alias = nil
-	delete(m.clearedFields, model.FieldAlias)
+// ResetScope resets all changes to the "scope" field.
+func (m *TokenMutation) ResetScope() {
+	m.scope = nil
 }
 
-// SetModelProviderID sets the "model_provider_id" field.
-func (m *ModelMutation) SetModelProviderID(u uuid.UUID) {
-	m.model_provider = &u
+// SetUser sets the "user" field.
+func (m *TokenMutation) SetUser(s string) {
+	m.user = &s
 }
 
-// ModelProviderID returns the value of the "model_provider_id" field in the mutation.
-func (m *ModelMutation) ModelProviderID() (r uuid.UUID, exists bool) {
-	v := m.model_provider
+// User returns the value of the "user" field in the mutation.
+func (m *TokenMutation) User() (r string, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelProviderID returns the old "model_provider_id" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldUser returns the old "user" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldModelProviderID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TokenMutation) OldUser(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelProviderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelProviderID requires an ID field in the mutation")
+		return v, errors.New("OldUser requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelProviderID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
 	}
-	return oldValue.ModelProviderID, nil
+	return oldValue.User, nil
 }
 
-// ResetModelProviderID resets all changes to the "model_provider_id" field.
-func (m *ModelMutation) ResetModelProviderID() {
-	m.model_provider = nil
+// ClearUser clears the value of the "user" field.
+func (m *TokenMutation) ClearUser() {
+	m.user = nil
+	m.clearedFields[token.FieldUser] = struct{}{}
 }
 
-// AddAgentIDs adds the "agents" edge to the Agent entity by ids.
-func (m *ModelMutation) AddAgentIDs(ids ...uuid.UUID) {
-	if m.agents == nil {
-		m.agents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.agents[ids[i]] = struct{}{}
-	}
+// UserCleared returns if the "user" field was cleared in this mutation.
+func (m *TokenMutation) UserCleared() bool {
+	_, ok := m.clearedFields[token.FieldUser]
+	return ok
 }
 
-// ClearAgents clears the "agents" edge to the Agent entity.
-func (m *ModelMutation) ClearAgents() {
-	m.clearedagents = true
+// ResetUser resets all changes to the "user" field.
+func (m *TokenMutation) ResetUser() {
+	m.user = nil
+	delete(m.clearedFields, token.FieldUser)
 }
 
-// AgentsCleared reports if the "agents" edge to the Agent entity was cleared.
-func (m *ModelMutation) AgentsCleared() bool {
-	return m.clearedagents
+// SetTeam sets the "team" field.
+func (m *TokenMutation) SetTeam(s string) {
+	m.team = &s
 }
 
-// RemoveAgentIDs removes the "agents" edge to the Agent entity by IDs.
-func (m *ModelMutation) RemoveAgentIDs(ids ...uuid.UUID) {
-	if m.removedagents == nil {
-		m.removedagents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.agents, ids[i])
-		m.removedagents[ids[i]] = struct{}{}
+// Team returns the value of the "team" field in the mutation.
+func (m *TokenMutation) Team() (r string, exists bool) {
+	v := m.team
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAgents returns the removed IDs of the "agents" edge to the Agent entity.
-func (m *ModelMutation) RemovedAgentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedagents {
-		ids = append(ids, id)
+// OldTeam returns the old "team" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldTeam(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// AgentsIDs returns the "agents" edge IDs in the mutation.
-func (m *ModelMutation) AgentsIDs() (ids []uuid.UUID) {
-	for id := range m.agents {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeam requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+	}
+	return oldValue.Team, nil
 }
 
-// ResetAgents resets all changes to the "agents" edge.
-func (m *ModelMutation) ResetAgents() {
-	m.agents = nil
-	m.clearedagents = false
-	m.removedagents = nil
+// ClearTeam clears the value of the "team" field.
+func (m *TokenMutation) ClearTeam() {
+	m.team = nil
+	m.clearedFields[token.FieldTeam] = struct{}{}
 }
 
-// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
-func (m *ModelMutation) ClearModelProvider() {
-	m.clearedmodel_provider = true
-	m.clearedFields[model.FieldModelProviderID] = struct{}{}
+// TeamCleared returns if the "team" field was cleared in this mutation.
+func (m *TokenMutation) TeamCleared() bool {
+	_, ok := m.clearedFields[token.FieldTeam]
+	return ok
 }
 
-// ModelProviderCleared reports if the "model_provider" edge to the ModelProvider entity was cleared.
-func (m *ModelMutation) ModelProviderCleared() bool {
-	return m.clearedmodel_provider
+// ResetTeam resets all changes to the "team" field.
+func (m *TokenMutation) ResetTeam() {
+	m.team = nil
+	delete(m.clearedFields, token.FieldTeam)
 }
 
-// ModelProviderIDs returns the "model_provider" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModelProviderID instead. It exists only for internal usage by the builders.
-func (m *ModelMutation) ModelProviderIDs() (ids []uuid.UUID) {
-	if id := m.model_provider; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetExpireTime sets the "expire_time" field.
+func (m *TokenMutation) SetExpireTime(t time.Time) {
+	m.expire_time = &t
 }
 
-// ResetModelProvider resets all changes to the "model_provider" edge.
-func (m *ModelMutation) ResetModelProvider() {
-	m.model_provider = nil
-	m.clearedmodel_provider = false
+// ExpireTime returns the value of the "expire_time" field in the mutation.
+func (m *TokenMutation) ExpireTime() (r time.Time, exists bool) {
+	v := m.expire_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ModelMutation) AddMessageIDs(ids ...uuid.UUID) {
-	if m.messages == nil {
-		m.messages = make(map[uuid.UUID]struct{})
+// OldExpireTime returns the old "expire_time" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldExpireTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpireTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpireTime requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpireTime: %w", err)
 	}
+	return oldValue.ExpireTime, nil
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ModelMutation) ClearMessages() {
-	m.clearedmessages = true
+// ClearExpireTime clears the value of the "expire_time" field.
+func (m *TokenMutation) ClearExpireTime() {
+	m.expire_time = nil
+	m.clearedFields[token.FieldExpireTime] = struct{}{}
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ModelMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// ExpireTimeCleared returns if the "expire_time" field was cleared in this mutation.
+func (m *TokenMutation) ExpireTimeCleared() bool {
+	_, ok := m.clearedFields[token.FieldExpireTime]
+	return ok
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ModelMutation) RemoveMessageIDs(ids ...uuid.UUID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
-	}
+// ResetExpireTime resets all changes to the "expire_time" field.
+func (m *TokenMutation) ResetExpireTime() {
+	m.expire_time = nil
+	delete(m.clearedFields, token.FieldExpireTime)
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ModelMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// SetLastUsedTime sets the "last_used_time" field.
+func (m *TokenMutation) SetLastUsedTime(t time.Time) {
+	m.last_used_time = &t
+}
+
+// LastUsedTime returns the value of the "last_used_time" field in the mutation.
+func (m *TokenMutation) LastUsedTime() (r time.Time, exists bool) {
+	v := m.last_used_time
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ModelMutation) MessagesIDs() (ids []uuid.UUID) {
-	for id := range m.messages {
-		ids = append(ids, id)
+// OldLastUsedTime returns the old "last_used_time" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldLastUsedTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedTime is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedTime: %w", err)
+	}
+	return oldValue.LastUsedTime, nil
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ModelMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ClearLastUsedTime clears the value of the "last_used_time" field.
+func (m *TokenMutation) ClearLastUsedTime() {
+	m.last_used_time = nil
+	m.clearedFields[token.FieldLastUsedTime] = struct{}{}
 }
 
-// Where appends a list predicates to the ModelMutation builder.
-func (m *ModelMutation) Where(ps ...predicate.Model) {
+// LastUsedTimeCleared returns if the "last_used_time" field was cleared in this mutation.
+func (m *TokenMutation) LastUsedTimeCleared() bool {
+	_, ok := m.clearedFields[token.FieldLastUsedTime]
+	return ok
+}
+
+// ResetLastUsedTime resets all changes to the "last_used_time" field.
+func (m *TokenMutation) ResetLastUsedTime() {
+	m.last_used_time = nil
+	delete(m.clearedFields, token.FieldLastUsedTime)
+}
+
+// Where appends a list predicates to the TokenMutation builder.
+func (m *TokenMutation) Where(ps ...predicate.Token) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ModelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Model, len(ps))
+func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Token, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2811,60 +13372,51 @@ func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ModelMutation) Op() Op {
+func (m *TokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ModelMutation) SetOp(op Op) {
+func (m *TokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Model).
-func (m *ModelMutation) Type() string {
+// Type returns the node type of this mutation (Token).
+func (m *TokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ModelMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *TokenMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.create_time != nil {
-		fields = append(fields, model.FieldCreateTime)
+		fields = append(fields, token.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, model.FieldUpdateTime)
+		fields = append(fields, token.FieldUpdateTime)
 	}
 	if m.name != nil {
-		fields = append(fields, model.FieldName)
-	}
-	if m.context_window != nil {
-		fields = append(fields, model.FieldContextWindow)
-	}
-	if m.capabilities != nil {
-		fields = append(fields, model.FieldCapabilities)
-	}
-	if m.input_cost != nil {
-		fields = append(fields, model.FieldInputCost)
+		fields = append(fields, token.FieldName)
 	}
-	if m.output_cost != nil {
-		fields = append(fields, model.FieldOutputCost)
+	if m.hash != nil {
+		fields = append(fields, token.FieldHash)
 	}
-	if m.cache_write_cost != nil {
-		fields = append(fields, model.FieldCacheWriteCost)
+	if m.scope != nil {
+		fields = append(fields, token.FieldScope)
 	}
-	if m.cache_read_cost != nil {
-		fields = append(fields, model.FieldCacheReadCost)
+	if m.user != nil {
+		fields = append(fields, token.FieldUser)
 	}
-	if m.enabled != nil {
-		fields = append(fields, model.FieldEnabled)
+	if m.team != nil {
+		fields = append(fields, token.FieldTeam)
 	}
-	if m.alias != nil {
-		fields = append(fields, model.FieldAlias)
+	if m.expire_time != nil {
+		fields = append(fields, token.FieldExpireTime)
 	}
-	if m.model_provider != nil {
-		fields = append(fields, model.FieldModelProviderID)
+	if m.last_used_time != nil {
+		fields = append(fields, token.FieldLastUsedTime)
 	}
 	return fields
 }
@@ -2872,32 +13424,26 @@ func (m *ModelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ModelMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case model.FieldCreateTime:
+	case token.FieldCreateTime:
 		return m.CreateTime()
-	case model.FieldUpdateTime:
+	case token.FieldUpdateTime:
 		return m.UpdateTime()
-	case model.FieldName:
+	case token.FieldName:
 		return m.Name()
-	case model.FieldContextWindow:
-		return m.ContextWindow()
-	case model.FieldCapabilities:
-		return m.Capabilities()
-	case model.FieldInputCost:
-		return m.InputCost()
-	case model.FieldOutputCost:
-		return m.OutputCost()
-	case model.FieldCacheWriteCost:
-		return m.CacheWriteCost()
-	case model.FieldCacheReadCost:
-		return m.CacheReadCost()
-	case model.FieldEnabled:
-		return m.Enabled()
-	case model.FieldAlias:
-		return m.Alias()
-	case model.FieldModelProviderID:
-		return m.ModelProviderID()
+	case token.FieldHash:
+		return m.Hash()
+	case token.FieldScope:
+		return m.Scope()
+	case token.FieldUser:
+		return m.User()
+	case token.FieldTeam:
+		return m.Team()
+	case token.FieldExpireTime:
+		return m.ExpireTime()
+	case token.FieldLastUsedTime:
+		return m.LastUsedTime()
 	}
 	return nil, false
 }
@@ -2905,453 +13451,287 @@ func (m *ModelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case model.FieldCreateTime:
+	case token.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case model.FieldUpdateTime:
+	case token.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case model.FieldName:
+	case token.FieldName:
 		return m.OldName(ctx)
-	case model.FieldContextWindow:
-		return m.OldContextWindow(ctx)
-	case model.FieldCapabilities:
-		return m.OldCapabilities(ctx)
-	case model.FieldInputCost:
-		return m.OldInputCost(ctx)
-	case model.FieldOutputCost:
-		return m.OldOutputCost(ctx)
-	case model.FieldCacheWriteCost:
-		return m.OldCacheWriteCost(ctx)
-	case model.FieldCacheReadCost:
-		return m.OldCacheReadCost(ctx)
-	case model.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case model.FieldAlias:
-		return m.OldAlias(ctx)
-	case model.FieldModelProviderID:
-		return m.OldModelProviderID(ctx)
-	}
-	return nil, fmt.Errorf("unknown Model field %s", name)
+	case token.FieldHash:
+		return m.OldHash(ctx)
+	case token.FieldScope:
+		return m.OldScope(ctx)
+	case token.FieldUser:
+		return m.OldUser(ctx)
+	case token.FieldTeam:
+		return m.OldTeam(ctx)
+	case token.FieldExpireTime:
+		return m.OldExpireTime(ctx)
+	case token.FieldLastUsedTime:
+		return m.OldLastUsedTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown Token field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelMutation) SetField(name string, value ent.Value) error {
+func (m *TokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case model.FieldCreateTime:
+	case token.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case model.FieldUpdateTime:
+	case token.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case model.FieldName:
+	case token.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case model.FieldContextWindow:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContextWindow(v)
-		return nil
-	case model.FieldCapabilities:
-		v, ok := value.([]types.ModelCapability)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCapabilities(v)
-		return nil
-	case model.FieldInputCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInputCost(v)
-		return nil
-	case model.FieldOutputCost:
-		v, ok := value.(float64)
+	case token.FieldHash:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOutputCost(v)
+		m.SetHash(v)
 		return nil
-	case model.FieldCacheWriteCost:
-		v, ok := value.(float64)
+	case token.FieldScope:
+		v, ok := value.(types.TokenScope)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheWriteCost(v)
+		m.SetScope(v)
 		return nil
-	case model.FieldCacheReadCost:
-		v, ok := value.(float64)
+	case token.FieldUser:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheReadCost(v)
+		m.SetUser(v)
 		return nil
-	case model.FieldEnabled:
-		v, ok := value.(bool)
+	case token.FieldTeam:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetTeam(v)
 		return nil
-	case model.FieldAlias:
-		v, ok := value.(string)
+	case token.FieldExpireTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlias(v)
+		m.SetExpireTime(v)
 		return nil
-	case model.FieldModelProviderID:
-		v, ok := value.(uuid.UUID)
+	case token.FieldLastUsedTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelProviderID(v)
+		m.SetLastUsedTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Model field %s", name)
+	return fmt.Errorf("unknown Token field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ModelMutation) AddedFields() []string {
-	var fields []string
-	if m.addcontext_window != nil {
-		fields = append(fields, model.FieldContextWindow)
-	}
-	if m.addinput_cost != nil {
-		fields = append(fields, model.FieldInputCost)
-	}
-	if m.addoutput_cost != nil {
-		fields = append(fields, model.FieldOutputCost)
-	}
-	if m.addcache_write_cost != nil {
-		fields = append(fields, model.FieldCacheWriteCost)
-	}
-	if m.addcache_read_cost != nil {
-		fields = append(fields, model.FieldCacheReadCost)
-	}
-	return fields
+func (m *TokenMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case model.FieldContextWindow:
-		return m.AddedContextWindow()
-	case model.FieldInputCost:
-		return m.AddedInputCost()
-	case model.FieldOutputCost:
-		return m.AddedOutputCost()
-	case model.FieldCacheWriteCost:
-		return m.AddedCacheWriteCost()
-	case model.FieldCacheReadCost:
-		return m.AddedCacheReadCost()
-	}
+func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelMutation) AddField(name string, value ent.Value) error {
+func (m *TokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case model.FieldContextWindow:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddContextWindow(v)
-		return nil
-	case model.FieldInputCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddInputCost(v)
-		return nil
-	case model.FieldOutputCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOutputCost(v)
-		return nil
-	case model.FieldCacheWriteCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheWriteCost(v)
-		return nil
-	case model.FieldCacheReadCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheReadCost(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Model numeric field %s", name)
+	return fmt.Errorf("unknown Token numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ModelMutation) ClearedFields() []string {
+func (m *TokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(model.FieldCapabilities) {
-		fields = append(fields, model.FieldCapabilities)
+	if m.FieldCleared(token.FieldUser) {
+		fields = append(fields, token.FieldUser)
+	}
+	if m.FieldCleared(token.FieldTeam) {
+		fields = append(fields, token.FieldTeam)
 	}
-	if m.FieldCleared(model.FieldAlias) {
-		fields = append(fields, model.FieldAlias)
+	if m.FieldCleared(token.FieldExpireTime) {
+		fields = append(fields, token.FieldExpireTime)
+	}
+	if m.FieldCleared(token.FieldLastUsedTime) {
+		fields = append(fields, token.FieldLastUsedTime)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ModelMutation) FieldCleared(name string) bool {
+func (m *TokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ModelMutation) ClearField(name string) error {
+func (m *TokenMutation) ClearField(name string) error {
 	switch name {
-	case model.FieldCapabilities:
-		m.ClearCapabilities()
+	case token.FieldUser:
+		m.ClearUser()
 		return nil
-	case model.FieldAlias:
-		m.ClearAlias()
+	case token.FieldTeam:
+		m.ClearTeam()
+		return nil
+	case token.FieldExpireTime:
+		m.ClearExpireTime()
+		return nil
+	case token.FieldLastUsedTime:
+		m.ClearLastUsedTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Model nullable field %s", name)
+	return fmt.Errorf("unknown Token nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ModelMutation) ResetField(name string) error {
+func (m *TokenMutation) ResetField(name string) error {
 	switch name {
-	case model.FieldCreateTime:
+	case token.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case model.FieldUpdateTime:
+	case token.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case model.FieldName:
+	case token.FieldName:
 		m.ResetName()
 		return nil
-	case model.FieldContextWindow:
-		m.ResetContextWindow()
-		return nil
-	case model.FieldCapabilities:
-		m.ResetCapabilities()
-		return nil
-	case model.FieldInputCost:
-		m.ResetInputCost()
+	case token.FieldHash:
+		m.ResetHash()
 		return nil
-	case model.FieldOutputCost:
-		m.ResetOutputCost()
-		return nil
-	case model.FieldCacheWriteCost:
-		m.ResetCacheWriteCost()
+	case token.FieldScope:
+		m.ResetScope()
 		return nil
-	case model.FieldCacheReadCost:
-		m.ResetCacheReadCost()
+	case token.FieldUser:
+		m.ResetUser()
 		return nil
-	case model.FieldEnabled:
-		m.ResetEnabled()
+	case token.FieldTeam:
+		m.ResetTeam()
 		return nil
-	case model.FieldAlias:
-		m.ResetAlias()
+	case token.FieldExpireTime:
+		m.ResetExpireTime()
 		return nil
-	case model.FieldModelProviderID:
-		m.ResetModelProviderID()
+	case token.FieldLastUsedTime:
+		m.ResetLastUsedTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Model field %s", name)
+	return fmt.Errorf("unknown Token field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.agents != nil {
-		edges = append(edges, model.EdgeAgents)
-	}
-	if m.model_provider != nil {
-		edges = append(edges, model.EdgeModelProvider)
-	}
-	if m.messages != nil {
-		edges = append(edges, model.EdgeMessages)
-	}
+func (m *TokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ModelMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case model.EdgeAgents:
-		ids := make([]ent.Value, 0, len(m.agents))
-		for id := range m.agents {
-			ids = append(ids, id)
-		}
-		return ids
-	case model.EdgeModelProvider:
-		if id := m.model_provider; id != nil {
-			return []ent.Value{*id}
-		}
-	case model.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedagents != nil {
-		edges = append(edges, model.EdgeAgents)
-	}
-	if m.removedmessages != nil {
-		edges = append(edges, model.EdgeMessages)
-	}
+func (m *TokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case model.EdgeAgents:
-		ids := make([]ent.Value, 0, len(m.removedagents))
-		for id := range m.removedagents {
-			ids = append(ids, id)
-		}
-		return ids
-	case model.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedagents {
-		edges = append(edges, model.EdgeAgents)
-	}
-	if m.clearedmodel_provider {
-		edges = append(edges, model.EdgeModelProvider)
-	}
-	if m.clearedmessages {
-		edges = append(edges, model.EdgeMessages)
-	}
+func (m *TokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ModelMutation) EdgeCleared(name string) bool {
-	switch name {
-	case model.EdgeAgents:
-		return m.clearedagents
-	case model.EdgeModelProvider:
-		return m.clearedmodel_provider
-	case model.EdgeMessages:
-		return m.clearedmessages
-	}
+func (m *TokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ModelMutation) ClearEdge(name string) error {
-	switch name {
-	case model.EdgeModelProvider:
-		m.ClearModelProvider()
-		return nil
-	}
-	return fmt.Errorf("unknown Model unique edge %s", name)
+func (m *TokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Token unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ModelMutation) ResetEdge(name string) error {
-	switch name {
-	case model.EdgeAgents:
-		m.ResetAgents()
-		return nil
-	case model.EdgeModelProvider:
-		m.ResetModelProvider()
-		return nil
-	case model.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	}
-	return fmt.Errorf("unknown Model edge %s", name)
+func (m *TokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Token edge %s", name)
 }
 
-// ModelProviderMutation represents an operation that mutates the ModelProvider nodes in the graph.
-type ModelProviderMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	create_time   *time.Time
-	update_time   *time.Time
-	name          *string
-	provider_type *types.ModelProviderType
-	url           *string
-	secret        *[]byte
-	enabled       *bool
-	clearedFields map[string]struct{}
-	models        map[uuid.UUID]struct{}
-	removedmodels map[uuid.UUID]struct{}
-	clearedmodels bool
-	done          bool
-	oldValue      func(context.Context) (*ModelProvider, error)
-	predicates    []predicate.ModelProvider
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	status         *types.WebhookDeliveryStatus
+	status_code    *int
+	addstatus_code *int
+	error          *string
+	payload        *[]byte
+	clearedFields  map[string]struct{}
+	trigger        *uuid.UUID
+	clearedtrigger bool
+	task           *uuid.UUID
+	clearedtask    bool
+	done           bool
+	oldValue       func(context.Context) (*WebhookDelivery, error)
+	predicates     []predicate.WebhookDelivery
 }
 
-var _ ent.Mutation = (*ModelProviderMutation)(nil)
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
 
-// modelproviderOption allows management of the mutation configuration using functional options.
-type modelproviderOption func(*ModelProviderMutation)
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
 
-// newModelProviderMutation creates new mutation for the ModelProvider entity.
-func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *ModelProviderMutation {
-	m := &ModelProviderMutation{
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeModelProvider,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3360,20 +13740,20 @@ func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *Mod
 	return m
 }
 
-// withModelProviderID sets the ID field of the mutation.
-func withModelProviderID(id uuid.UUID) modelproviderOption {
-	return func(m *ModelProviderMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ModelProvider
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*ModelProvider, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ModelProvider.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3382,10 +13762,10 @@ func withModelProviderID(id uuid.UUID) modelproviderOption {
 	}
 }
 
-// withModelProvider sets the old ModelProvider of the mutation.
-func withModelProvider(node *ModelProvider) modelproviderOption {
-	return func(m *ModelProviderMutation) {
-		m.oldValue = func(context.Context) (*ModelProvider, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3394,7 +13774,7 @@ func withModelProvider(node *ModelProvider) modelproviderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelProviderMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3402,7 +13782,7 @@ func (m ModelProviderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ModelProviderMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -3412,14 +13792,14 @@ func (m ModelProviderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ModelProvider entities.
-func (m *ModelProviderMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3430,7 +13810,7 @@ func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3439,19 +13819,19 @@ func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ModelProvider.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ModelProviderMutation) SetCreateTime(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3459,10 +13839,10 @@ func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3477,17 +13857,17 @@ func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ModelProviderMutation) ResetCreateTime() {
+func (m *WebhookDeliveryMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ModelProviderMutation) SetUpdateTime(t time.Time) {
+func (m *WebhookDeliveryMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -3495,10 +13875,10 @@ func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -3513,266 +13893,348 @@ func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ModelProviderMutation) ResetUpdateTime() {
+func (m *WebhookDeliveryMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetName sets the "name" field.
-func (m *ModelProviderMutation) SetName(s string) {
-	m.name = &s
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(tds types.WebhookDeliveryStatus) {
+	m.status = &tds
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ModelProviderMutation) Name() (r string, exists bool) {
-	v := m.name
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r types.WebhookDeliveryStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v types.WebhookDeliveryStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Status, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ModelProviderMutation) ResetName() {
-	m.name = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetProviderType sets the "provider_type" field.
-func (m *ModelProviderMutation) SetProviderType(tpt types.ModelProviderType) {
-	m.provider_type = &tpt
+// SetStatusCode sets the "status_code" field.
+func (m *WebhookDeliveryMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
 }
 
-// ProviderType returns the value of the "provider_type" field in the mutation.
-func (m *ModelProviderMutation) ProviderType() (r types.ModelProviderType, exists bool) {
-	v := m.provider_type
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *WebhookDeliveryMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderType returns the old "provider_type" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldStatusCode returns the old "status_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldProviderType(ctx context.Context) (v types.ModelProviderType, err error) {
+func (m *WebhookDeliveryMutation) OldStatusCode(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderType requires an ID field in the mutation")
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
 	}
-	return oldValue.ProviderType, nil
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *WebhookDeliveryMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
+	}
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *WebhookDeliveryMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+}
+
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
 }
 
-// ResetProviderType resets all changes to the "provider_type" field.
-func (m *ModelProviderMutation) ResetProviderType() {
-	m.provider_type = nil
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
 }
 
-// SetURL sets the "url" field.
-func (m *ModelProviderMutation) SetURL(s string) {
-	m.url = &s
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *ModelProviderMutation) URL() (r string, exists bool) {
-	v := m.url
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.Payload, nil
 }
 
-// ClearURL clears the value of the "url" field.
-func (m *ModelProviderMutation) ClearURL() {
-	m.url = nil
-	m.clearedFields[modelprovider.FieldURL] = struct{}{}
+// ClearPayload clears the value of the "payload" field.
+func (m *WebhookDeliveryMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[webhookdelivery.FieldPayload] = struct{}{}
 }
 
-// URLCleared returns if the "url" field was cleared in this mutation.
-func (m *ModelProviderMutation) URLCleared() bool {
-	_, ok := m.clearedFields[modelprovider.FieldURL]
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldPayload]
 	return ok
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *ModelProviderMutation) ResetURL() {
-	m.url = nil
-	delete(m.clearedFields, modelprovider.FieldURL)
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, webhookdelivery.FieldPayload)
 }
 
-// SetSecret sets the "secret" field.
-func (m *ModelProviderMutation) SetSecret(b []byte) {
-	m.secret = &b
+// SetTriggerID sets the "trigger_id" field.
+func (m *WebhookDeliveryMutation) SetTriggerID(u uuid.UUID) {
+	m.trigger = &u
 }
 
-// Secret returns the value of the "secret" field in the mutation.
-func (m *ModelProviderMutation) Secret() (r []byte, exists bool) {
-	v := m.secret
+// TriggerID returns the value of the "trigger_id" field in the mutation.
+func (m *WebhookDeliveryMutation) TriggerID() (r uuid.UUID, exists bool) {
+	v := m.trigger
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecret returns the old "secret" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldTriggerID returns the old "trigger_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldSecret(ctx context.Context) (v []byte, err error) {
+func (m *WebhookDeliveryMutation) OldTriggerID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldTriggerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecret requires an ID field in the mutation")
+		return v, errors.New("OldTriggerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldTriggerID: %w", err)
 	}
-	return oldValue.Secret, nil
+	return oldValue.TriggerID, nil
 }
 
-// ResetSecret resets all changes to the "secret" field.
-func (m *ModelProviderMutation) ResetSecret() {
-	m.secret = nil
+// ResetTriggerID resets all changes to the "trigger_id" field.
+func (m *WebhookDeliveryMutation) ResetTriggerID() {
+	m.trigger = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *ModelProviderMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetTaskID sets the "task_id" field.
+func (m *WebhookDeliveryMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *ModelProviderMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *WebhookDeliveryMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *WebhookDeliveryMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *ModelProviderMutation) ResetEnabled() {
-	m.enabled = nil
+// ClearTaskID clears the value of the "task_id" field.
+func (m *WebhookDeliveryMutation) ClearTaskID() {
+	m.task = nil
+	m.clearedFields[webhookdelivery.FieldTaskID] = struct{}{}
 }
 
-// AddModelIDs adds the "models" edge to the Model entity by ids.
-func (m *ModelProviderMutation) AddModelIDs(ids ...uuid.UUID) {
-	if m.models == nil {
-		m.models = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.models[ids[i]] = struct{}{}
-	}
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldTaskID]
+	return ok
 }
 
-// ClearModels clears the "models" edge to the Model entity.
-func (m *ModelProviderMutation) ClearModels() {
-	m.clearedmodels = true
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *WebhookDeliveryMutation) ResetTaskID() {
+	m.task = nil
+	delete(m.clearedFields, webhookdelivery.FieldTaskID)
 }
 
-// ModelsCleared reports if the "models" edge to the Model entity was cleared.
-func (m *ModelProviderMutation) ModelsCleared() bool {
-	return m.clearedmodels
+// ClearTrigger clears the "trigger" edge to the WebhookTrigger entity.
+func (m *WebhookDeliveryMutation) ClearTrigger() {
+	m.clearedtrigger = true
+	m.clearedFields[webhookdelivery.FieldTriggerID] = struct{}{}
 }
 
-// RemoveModelIDs removes the "models" edge to the Model entity by IDs.
-func (m *ModelProviderMutation) RemoveModelIDs(ids ...uuid.UUID) {
-	if m.removedmodels == nil {
-		m.removedmodels = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.models, ids[i])
-		m.removedmodels[ids[i]] = struct{}{}
-	}
+// TriggerCleared reports if the "trigger" edge to the WebhookTrigger entity was cleared.
+func (m *WebhookDeliveryMutation) TriggerCleared() bool {
+	return m.clearedtrigger
 }
 
-// RemovedModels returns the removed IDs of the "models" edge to the Model entity.
-func (m *ModelProviderMutation) RemovedModelsIDs() (ids []uuid.UUID) {
-	for id := range m.removedmodels {
-		ids = append(ids, id)
+// TriggerIDs returns the "trigger" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TriggerID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) TriggerIDs() (ids []uuid.UUID) {
+	if id := m.trigger; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ModelsIDs returns the "models" edge IDs in the mutation.
-func (m *ModelProviderMutation) ModelsIDs() (ids []uuid.UUID) {
-	for id := range m.models {
-		ids = append(ids, id)
+// ResetTrigger resets all changes to the "trigger" edge.
+func (m *WebhookDeliveryMutation) ResetTrigger() {
+	m.trigger = nil
+	m.clearedtrigger = false
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *WebhookDeliveryMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[webhookdelivery.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *WebhookDeliveryMutation) TaskCleared() bool {
+	return m.TaskIDCleared() || m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetModels resets all changes to the "models" edge.
-func (m *ModelProviderMutation) ResetModels() {
-	m.models = nil
-	m.clearedmodels = false
-	m.removedmodels = nil
+// ResetTask resets all changes to the "task" edge.
+func (m *WebhookDeliveryMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
 }
 
-// Where appends a list predicates to the ModelProviderMutation builder.
-func (m *ModelProviderMutation) Where(ps ...predicate.ModelProvider) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ModelProviderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelProviderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ModelProvider, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3780,45 +14242,48 @@ func (m *ModelProviderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ModelProviderMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ModelProviderMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ModelProvider).
-func (m *ModelProviderMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ModelProviderMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.create_time != nil {
-		fields = append(fields, modelprovider.FieldCreateTime)
+		fields = append(fields, webhookdelivery.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, modelprovider.FieldUpdateTime)
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
-	if m.name != nil {
-		fields = append(fields, modelprovider.FieldName)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.provider_type != nil {
-		fields = append(fields, modelprovider.FieldProviderType)
+	if m.status_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
 	}
-	if m.url != nil {
-		fields = append(fields, modelprovider.FieldURL)
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
 	}
-	if m.secret != nil {
-		fields = append(fields, modelprovider.FieldSecret)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.enabled != nil {
-		fields = append(fields, modelprovider.FieldEnabled)
+	if m.trigger != nil {
+		fields = append(fields, webhookdelivery.FieldTriggerID)
+	}
+	if m.task != nil {
+		fields = append(fields, webhookdelivery.FieldTaskID)
 	}
 	return fields
 }
@@ -3826,22 +14291,24 @@ func (m *ModelProviderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ModelProviderMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case modelprovider.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.CreateTime()
-	case modelprovider.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.UpdateTime()
-	case modelprovider.FieldName:
-		return m.Name()
-	case modelprovider.FieldProviderType:
-		return m.ProviderType()
-	case modelprovider.FieldURL:
-		return m.URL()
-	case modelprovider.FieldSecret:
-		return m.Secret()
-	case modelprovider.FieldEnabled:
-		return m.Enabled()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldStatusCode:
+		return m.StatusCode()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldTriggerID:
+		return m.TriggerID()
+	case webhookdelivery.FieldTaskID:
+		return m.TaskID()
 	}
 	return nil, false
 }
@@ -3849,294 +14316,335 @@ func (m *ModelProviderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ModelProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case modelprovider.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case modelprovider.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case modelprovider.FieldName:
-		return m.OldName(ctx)
-	case modelprovider.FieldProviderType:
-		return m.OldProviderType(ctx)
-	case modelprovider.FieldURL:
-		return m.OldURL(ctx)
-	case modelprovider.FieldSecret:
-		return m.OldSecret(ctx)
-	case modelprovider.FieldEnabled:
-		return m.OldEnabled(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldTriggerID:
+		return m.OldTriggerID(ctx)
+	case webhookdelivery.FieldTaskID:
+		return m.OldTaskID(ctx)
 	}
-	return nil, fmt.Errorf("unknown ModelProvider field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelProviderMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case modelprovider.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case modelprovider.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case modelprovider.FieldName:
-		v, ok := value.(string)
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(types.WebhookDeliveryStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetStatus(v)
 		return nil
-	case modelprovider.FieldProviderType:
-		v, ok := value.(types.ModelProviderType)
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProviderType(v)
+		m.SetStatusCode(v)
 		return nil
-	case modelprovider.FieldURL:
+	case webhookdelivery.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetError(v)
 		return nil
-	case modelprovider.FieldSecret:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSecret(v)
+		m.SetPayload(v)
 		return nil
-	case modelprovider.FieldEnabled:
-		v, ok := value.(bool)
+	case webhookdelivery.FieldTriggerID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetTriggerID(v)
+		return nil
+	case webhookdelivery.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ModelProviderMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ModelProviderMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldStatusCode:
+		return m.AddedStatusCode()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelProviderMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ModelProviderMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(modelprovider.FieldURL) {
-		fields = append(fields, modelprovider.FieldURL)
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldPayload) {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.FieldCleared(webhookdelivery.FieldTaskID) {
+		fields = append(fields, webhookdelivery.FieldTaskID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ModelProviderMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ModelProviderMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case modelprovider.FieldURL:
-		m.ClearURL()
+	case webhookdelivery.FieldError:
+		m.ClearError()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case webhookdelivery.FieldTaskID:
+		m.ClearTaskID()
 		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ModelProviderMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case modelprovider.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case modelprovider.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case modelprovider.FieldName:
-		m.ResetName()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case modelprovider.FieldProviderType:
-		m.ResetProviderType()
+	case webhookdelivery.FieldStatusCode:
+		m.ResetStatusCode()
 		return nil
-	case modelprovider.FieldURL:
-		m.ResetURL()
+	case webhookdelivery.FieldError:
+		m.ResetError()
 		return nil
-	case modelprovider.FieldSecret:
-		m.ResetSecret()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case modelprovider.FieldEnabled:
-		m.ResetEnabled()
+	case webhookdelivery.FieldTriggerID:
+		m.ResetTriggerID()
+		return nil
+	case webhookdelivery.FieldTaskID:
+		m.ResetTaskID()
 		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelProviderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.models != nil {
-		edges = append(edges, modelprovider.EdgeModels)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.trigger != nil {
+		edges = append(edges, webhookdelivery.EdgeTrigger)
+	}
+	if m.task != nil {
+		edges = append(edges, webhookdelivery.EdgeTask)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ModelProviderMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case modelprovider.EdgeModels:
-		ids := make([]ent.Value, 0, len(m.models))
-		for id := range m.models {
-			ids = append(ids, id)
+	case webhookdelivery.EdgeTrigger:
+		if id := m.trigger; id != nil {
+			return []ent.Value{*id}
+		}
+	case webhookdelivery.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelProviderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedmodels != nil {
-		edges = append(edges, modelprovider.EdgeModels)
-	}
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ModelProviderMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case modelprovider.EdgeModels:
-		ids := make([]ent.Value, 0, len(m.removedmodels))
-		for id := range m.removedmodels {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelProviderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmodels {
-		edges = append(edges, modelprovider.EdgeModels)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtrigger {
+		edges = append(edges, webhookdelivery.EdgeTrigger)
+	}
+	if m.clearedtask {
+		edges = append(edges, webhookdelivery.EdgeTask)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ModelProviderMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case modelprovider.EdgeModels:
-		return m.clearedmodels
+	case webhookdelivery.EdgeTrigger:
+		return m.clearedtrigger
+	case webhookdelivery.EdgeTask:
+		return m.clearedtask
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ModelProviderMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookdelivery.EdgeTrigger:
+		m.ClearTrigger()
+		return nil
+	case webhookdelivery.EdgeTask:
+		m.ClearTask()
+		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ModelProviderMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case modelprovider.EdgeModels:
-		m.ResetModels()
+	case webhookdelivery.EdgeTrigger:
+		m.ResetTrigger()
+		return nil
+	case webhookdelivery.EdgeTask:
+		m.ResetTask()
 		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// WebhookTriggerMutation represents an operation that mutates the WebhookTrigger nodes in the graph.
+type WebhookTriggerMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	create_time           *time.Time
-	update_time           *time.Time
-	project_directory     *string
-	input_tokens          *int64
-	addinput_tokens       *int64
-	output_tokens         *int64
-	addoutput_tokens      *int64
-	cache_write_tokens    *int64
-	addcache_write_tokens *int64
-	cache_read_tokens     *int64
-	addcache_read_tokens  *int64
-	cost                  *float64
-	addcost               *float64
-	turns                 *int64
-	addturns              *int64
-	tool_uses             *map[string]int64
-	desired_phase         *types.TaskPhase
-	phase                 *types.TaskPhase
-	description           *string
-	clearedFields         map[string]struct{}
-	messages              map[uuid.UUID]struct{}
-	removedmessages       map[uuid.UUID]struct{}
-	clearedmessages       bool
-	agent                 *uuid.UUID
-	clearedagent          bool
-	done                  bool
-	oldValue              func(context.Context) (*Task, error)
-	predicates            []predicate.Task
-}
-
-var _ ent.Mutation = (*TaskMutation)(nil)
-
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
-
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	create_time       *time.Time
+	update_time       *time.Time
+	owner             *string
+	team              *string
+	name              *string
+	description       *string
+	target            *types.WebhookTarget
+	prompt_template   *string
+	task_id_template  *string
+	workspace         *string
+	secret            *[]byte
+	signature_header  *string
+	enabled           *bool
+	clearedFields     map[string]struct{}
+	agent             *uuid.UUID
+	clearedagent      bool
+	deliveries        map[uuid.UUID]struct{}
+	removeddeliveries map[uuid.UUID]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*WebhookTrigger, error)
+	predicates        []predicate.WebhookTrigger
+}
+
+var _ ent.Mutation = (*WebhookTriggerMutation)(nil)
+
+// webhooktriggerOption allows management of the mutation configuration using functional options.
+type webhooktriggerOption func(*WebhookTriggerMutation)
+
+// newWebhookTriggerMutation creates new mutation for the WebhookTrigger entity.
+func newWebhookTriggerMutation(c config, op Op, opts ...webhooktriggerOption) *WebhookTriggerMutation {
+	m := &WebhookTriggerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeWebhookTrigger,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4145,20 +14653,20 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id uuid.UUID) taskOption {
-	return func(m *TaskMutation) {
+// withWebhookTriggerID sets the ID field of the mutation.
+func withWebhookTriggerID(id uuid.UUID) webhooktriggerOption {
+	return func(m *WebhookTriggerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *WebhookTrigger
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookTrigger, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().WebhookTrigger.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4167,10 +14675,10 @@ func withTaskID(id uuid.UUID) taskOption {
 	}
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+// withWebhookTrigger sets the old WebhookTrigger of the mutation.
+func withWebhookTrigger(node *WebhookTrigger) webhooktriggerOption {
+	return func(m *WebhookTriggerMutation) {
+		m.oldValue = func(context.Context) (*WebhookTrigger, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4179,7 +14687,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m WebhookTriggerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4187,7 +14695,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m WebhookTriggerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -4197,14 +14705,14 @@ func (m TaskMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Task entities.
-func (m *TaskMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookTrigger entities.
+func (m *WebhookTriggerMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookTriggerMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4215,7 +14723,7 @@ func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookTriggerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4224,19 +14732,19 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookTrigger.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TaskMutation) SetCreateTime(t time.Time) {
+func (m *WebhookTriggerMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
+func (m *WebhookTriggerMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -4244,10 +14752,10 @@ func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookTriggerMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -4262,17 +14770,17 @@ func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TaskMutation) ResetCreateTime() {
+func (m *WebhookTriggerMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TaskMutation) SetUpdateTime(t time.Time) {
+func (m *WebhookTriggerMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *WebhookTriggerMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -4280,10 +14788,10 @@ func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookTriggerMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -4298,629 +14806,478 @@ func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TaskMutation) ResetUpdateTime() {
+func (m *WebhookTriggerMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetProjectDirectory sets the "project_directory" field.
-func (m *TaskMutation) SetProjectDirectory(s string) {
-	m.project_directory = &s
-}
-
-// ProjectDirectory returns the value of the "project_directory" field in the mutation.
-func (m *TaskMutation) ProjectDirectory() (r string, exists bool) {
-	v := m.project_directory
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProjectDirectory returns the old "project_directory" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldProjectDirectory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProjectDirectory is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProjectDirectory requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProjectDirectory: %w", err)
-	}
-	return oldValue.ProjectDirectory, nil
-}
-
-// ClearProjectDirectory clears the value of the "project_directory" field.
-func (m *TaskMutation) ClearProjectDirectory() {
-	m.project_directory = nil
-	m.clearedFields[task.FieldProjectDirectory] = struct{}{}
-}
-
-// ProjectDirectoryCleared returns if the "project_directory" field was cleared in this mutation.
-func (m *TaskMutation) ProjectDirectoryCleared() bool {
-	_, ok := m.clearedFields[task.FieldProjectDirectory]
-	return ok
-}
-
-// ResetProjectDirectory resets all changes to the "project_directory" field.
-func (m *TaskMutation) ResetProjectDirectory() {
-	m.project_directory = nil
-	delete(m.clearedFields, task.FieldProjectDirectory)
-}
-
-// SetInputTokens sets the "input_tokens" field.
-func (m *TaskMutation) SetInputTokens(i int64) {
-	m.input_tokens = &i
-	m.addinput_tokens = nil
-}
-
-// InputTokens returns the value of the "input_tokens" field in the mutation.
-func (m *TaskMutation) InputTokens() (r int64, exists bool) {
-	v := m.input_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldInputTokens returns the old "input_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldInputTokens(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputTokens requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
-	}
-	return oldValue.InputTokens, nil
-}
-
-// AddInputTokens adds i to the "input_tokens" field.
-func (m *TaskMutation) AddInputTokens(i int64) {
-	if m.addinput_tokens != nil {
-		*m.addinput_tokens += i
-	} else {
-		m.addinput_tokens = &i
-	}
-}
-
-// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
-func (m *TaskMutation) AddedInputTokens() (r int64, exists bool) {
-	v := m.addinput_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearInputTokens clears the value of the "input_tokens" field.
-func (m *TaskMutation) ClearInputTokens() {
-	m.input_tokens = nil
-	m.addinput_tokens = nil
-	m.clearedFields[task.FieldInputTokens] = struct{}{}
-}
-
-// InputTokensCleared returns if the "input_tokens" field was cleared in this mutation.
-func (m *TaskMutation) InputTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldInputTokens]
-	return ok
-}
-
-// ResetInputTokens resets all changes to the "input_tokens" field.
-func (m *TaskMutation) ResetInputTokens() {
-	m.input_tokens = nil
-	m.addinput_tokens = nil
-	delete(m.clearedFields, task.FieldInputTokens)
-}
-
-// SetOutputTokens sets the "output_tokens" field.
-func (m *TaskMutation) SetOutputTokens(i int64) {
-	m.output_tokens = &i
-	m.addoutput_tokens = nil
-}
-
-// OutputTokens returns the value of the "output_tokens" field in the mutation.
-func (m *TaskMutation) OutputTokens() (r int64, exists bool) {
-	v := m.output_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOutputTokens returns the old "output_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldOutputTokens(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
-	}
-	return oldValue.OutputTokens, nil
-}
-
-// AddOutputTokens adds i to the "output_tokens" field.
-func (m *TaskMutation) AddOutputTokens(i int64) {
-	if m.addoutput_tokens != nil {
-		*m.addoutput_tokens += i
-	} else {
-		m.addoutput_tokens = &i
-	}
+// SetOwner sets the "owner" field.
+func (m *WebhookTriggerMutation) SetOwner(s string) {
+	m.owner = &s
 }
 
-// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
-func (m *TaskMutation) AddedOutputTokens() (r int64, exists bool) {
-	v := m.addoutput_tokens
+// Owner returns the value of the "owner" field in the mutation.
+func (m *WebhookTriggerMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearOutputTokens clears the value of the "output_tokens" field.
-func (m *TaskMutation) ClearOutputTokens() {
-	m.output_tokens = nil
-	m.addoutput_tokens = nil
-	m.clearedFields[task.FieldOutputTokens] = struct{}{}
+// OldOwner returns the old "owner" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookTriggerMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
 }
 
-// OutputTokensCleared returns if the "output_tokens" field was cleared in this mutation.
-func (m *TaskMutation) OutputTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldOutputTokens]
+// ClearOwner clears the value of the "owner" field.
+func (m *WebhookTriggerMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[webhooktrigger.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *WebhookTriggerMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[webhooktrigger.FieldOwner]
 	return ok
 }
 
-// ResetOutputTokens resets all changes to the "output_tokens" field.
-func (m *TaskMutation) ResetOutputTokens() {
-	m.output_tokens = nil
-	m.addoutput_tokens = nil
-	delete(m.clearedFields, task.FieldOutputTokens)
+// ResetOwner resets all changes to the "owner" field.
+func (m *WebhookTriggerMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, webhooktrigger.FieldOwner)
 }
 
-// SetCacheWriteTokens sets the "cache_write_tokens" field.
-func (m *TaskMutation) SetCacheWriteTokens(i int64) {
-	m.cache_write_tokens = &i
-	m.addcache_write_tokens = nil
+// SetTeam sets the "team" field.
+func (m *WebhookTriggerMutation) SetTeam(s string) {
+	m.team = &s
 }
 
-// CacheWriteTokens returns the value of the "cache_write_tokens" field in the mutation.
-func (m *TaskMutation) CacheWriteTokens() (r int64, exists bool) {
-	v := m.cache_write_tokens
+// Team returns the value of the "team" field in the mutation.
+func (m *WebhookTriggerMutation) Team() (r string, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheWriteTokens returns the old "cache_write_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTeam returns the old "team" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCacheWriteTokens(ctx context.Context) (v int64, err error) {
+func (m *WebhookTriggerMutation) OldTeam(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheWriteTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheWriteTokens requires an ID field in the mutation")
+		return v, errors.New("OldTeam requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheWriteTokens: %w", err)
-	}
-	return oldValue.CacheWriteTokens, nil
-}
-
-// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
-func (m *TaskMutation) AddCacheWriteTokens(i int64) {
-	if m.addcache_write_tokens != nil {
-		*m.addcache_write_tokens += i
-	} else {
-		m.addcache_write_tokens = &i
-	}
-}
-
-// AddedCacheWriteTokens returns the value that was added to the "cache_write_tokens" field in this mutation.
-func (m *TaskMutation) AddedCacheWriteTokens() (r int64, exists bool) {
-	v := m.addcache_write_tokens
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
 	}
-	return *v, true
+	return oldValue.Team, nil
 }
 
-// ClearCacheWriteTokens clears the value of the "cache_write_tokens" field.
-func (m *TaskMutation) ClearCacheWriteTokens() {
-	m.cache_write_tokens = nil
-	m.addcache_write_tokens = nil
-	m.clearedFields[task.FieldCacheWriteTokens] = struct{}{}
+// ClearTeam clears the value of the "team" field.
+func (m *WebhookTriggerMutation) ClearTeam() {
+	m.team = nil
+	m.clearedFields[webhooktrigger.FieldTeam] = struct{}{}
 }
 
-// CacheWriteTokensCleared returns if the "cache_write_tokens" field was cleared in this mutation.
-func (m *TaskMutation) CacheWriteTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldCacheWriteTokens]
+// TeamCleared returns if the "team" field was cleared in this mutation.
+func (m *WebhookTriggerMutation) TeamCleared() bool {
+	_, ok := m.clearedFields[webhooktrigger.FieldTeam]
 	return ok
 }
 
-// ResetCacheWriteTokens resets all changes to the "cache_write_tokens" field.
-func (m *TaskMutation) ResetCacheWriteTokens() {
-	m.cache_write_tokens = nil
-	m.addcache_write_tokens = nil
-	delete(m.clearedFields, task.FieldCacheWriteTokens)
+// ResetTeam resets all changes to the "team" field.
+func (m *WebhookTriggerMutation) ResetTeam() {
+	m.team = nil
+	delete(m.clearedFields, webhooktrigger.FieldTeam)
 }
 
-// SetCacheReadTokens sets the "cache_read_tokens" field.
-func (m *TaskMutation) SetCacheReadTokens(i int64) {
-	m.cache_read_tokens = &i
-	m.addcache_read_tokens = nil
+// SetName sets the "name" field.
+func (m *WebhookTriggerMutation) SetName(s string) {
+	m.name = &s
 }
 
-// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
-func (m *TaskMutation) CacheReadTokens() (r int64, exists bool) {
-	v := m.cache_read_tokens
+// Name returns the value of the "name" field in the mutation.
+func (m *WebhookTriggerMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCacheReadTokens(ctx context.Context) (v int64, err error) {
+func (m *WebhookTriggerMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.CacheReadTokens, nil
+	return oldValue.Name, nil
 }
 
-// AddCacheReadTokens adds i to the "cache_read_tokens" field.
-func (m *TaskMutation) AddCacheReadTokens(i int64) {
-	if m.addcache_read_tokens != nil {
-		*m.addcache_read_tokens += i
-	} else {
-		m.addcache_read_tokens = &i
-	}
+// ResetName resets all changes to the "name" field.
+func (m *WebhookTriggerMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
-func (m *TaskMutation) AddedCacheReadTokens() (r int64, exists bool) {
-	v := m.addcache_read_tokens
+// SetDescription sets the "description" field.
+func (m *WebhookTriggerMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *WebhookTriggerMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearCacheReadTokens clears the value of the "cache_read_tokens" field.
-func (m *TaskMutation) ClearCacheReadTokens() {
-	m.cache_read_tokens = nil
-	m.addcache_read_tokens = nil
-	m.clearedFields[task.FieldCacheReadTokens] = struct{}{}
+// OldDescription returns the old "description" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookTriggerMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// CacheReadTokensCleared returns if the "cache_read_tokens" field was cleared in this mutation.
-func (m *TaskMutation) CacheReadTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldCacheReadTokens]
+// ClearDescription clears the value of the "description" field.
+func (m *WebhookTriggerMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[webhooktrigger.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *WebhookTriggerMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhooktrigger.FieldDescription]
 	return ok
 }
 
-// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
-func (m *TaskMutation) ResetCacheReadTokens() {
-	m.cache_read_tokens = nil
-	m.addcache_read_tokens = nil
-	delete(m.clearedFields, task.FieldCacheReadTokens)
+// ResetDescription resets all changes to the "description" field.
+func (m *WebhookTriggerMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, webhooktrigger.FieldDescription)
 }
 
-// SetCost sets the "cost" field.
-func (m *TaskMutation) SetCost(f float64) {
-	m.cost = &f
-	m.addcost = nil
+// SetTarget sets the "target" field.
+func (m *WebhookTriggerMutation) SetTarget(tt types.WebhookTarget) {
+	m.target = &tt
 }
 
-// Cost returns the value of the "cost" field in the mutation.
-func (m *TaskMutation) Cost() (r float64, exists bool) {
-	v := m.cost
+// Target returns the value of the "target" field in the mutation.
+func (m *WebhookTriggerMutation) Target() (r types.WebhookTarget, exists bool) {
+	v := m.target
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCost returns the old "cost" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTarget returns the old "target" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCost(ctx context.Context) (v float64, err error) {
+func (m *WebhookTriggerMutation) OldTarget(ctx context.Context) (v types.WebhookTarget, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCost requires an ID field in the mutation")
+		return v, errors.New("OldTarget requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
 	}
-	return oldValue.Cost, nil
+	return oldValue.Target, nil
 }
 
-// AddCost adds f to the "cost" field.
-func (m *TaskMutation) AddCost(f float64) {
-	if m.addcost != nil {
-		*m.addcost += f
-	} else {
-		m.addcost = &f
-	}
+// ResetTarget resets all changes to the "target" field.
+func (m *WebhookTriggerMutation) ResetTarget() {
+	m.target = nil
 }
 
-// AddedCost returns the value that was added to the "cost" field in this mutation.
-func (m *TaskMutation) AddedCost() (r float64, exists bool) {
-	v := m.addcost
+// SetPromptTemplate sets the "prompt_template" field.
+func (m *WebhookTriggerMutation) SetPromptTemplate(s string) {
+	m.prompt_template = &s
+}
+
+// PromptTemplate returns the value of the "prompt_template" field in the mutation.
+func (m *WebhookTriggerMutation) PromptTemplate() (r string, exists bool) {
+	v := m.prompt_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearCost clears the value of the "cost" field.
-func (m *TaskMutation) ClearCost() {
-	m.cost = nil
-	m.addcost = nil
-	m.clearedFields[task.FieldCost] = struct{}{}
-}
-
-// CostCleared returns if the "cost" field was cleared in this mutation.
-func (m *TaskMutation) CostCleared() bool {
-	_, ok := m.clearedFields[task.FieldCost]
-	return ok
+// OldPromptTemplate returns the old "prompt_template" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookTriggerMutation) OldPromptTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTemplate: %w", err)
+	}
+	return oldValue.PromptTemplate, nil
 }
 
-// ResetCost resets all changes to the "cost" field.
-func (m *TaskMutation) ResetCost() {
-	m.cost = nil
-	m.addcost = nil
-	delete(m.clearedFields, task.FieldCost)
+// ResetPromptTemplate resets all changes to the "prompt_template" field.
+func (m *WebhookTriggerMutation) ResetPromptTemplate() {
+	m.prompt_template = nil
 }
 
-// SetTurns sets the "turns" field.
-func (m *TaskMutation) SetTurns(i int64) {
-	m.turns = &i
-	m.addturns = nil
+// SetTaskIDTemplate sets the "task_id_template" field.
+func (m *WebhookTriggerMutation) SetTaskIDTemplate(s string) {
+	m.task_id_template = &s
 }
 
-// Turns returns the value of the "turns" field in the mutation.
-func (m *TaskMutation) Turns() (r int64, exists bool) {
-	v := m.turns
+// TaskIDTemplate returns the value of the "task_id_template" field in the mutation.
+func (m *WebhookTriggerMutation) TaskIDTemplate() (r string, exists bool) {
+	v := m.task_id_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTurns returns the old "turns" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskIDTemplate returns the old "task_id_template" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldTurns(ctx context.Context) (v int64, err error) {
+func (m *WebhookTriggerMutation) OldTaskIDTemplate(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTurns is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskIDTemplate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTurns requires an ID field in the mutation")
+		return v, errors.New("OldTaskIDTemplate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTurns: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskIDTemplate: %w", err)
 	}
-	return oldValue.Turns, nil
+	return oldValue.TaskIDTemplate, nil
 }
 
-// AddTurns adds i to the "turns" field.
-func (m *TaskMutation) AddTurns(i int64) {
-	if m.addturns != nil {
-		*m.addturns += i
-	} else {
-		m.addturns = &i
-	}
+// ClearTaskIDTemplate clears the value of the "task_id_template" field.
+func (m *WebhookTriggerMutation) ClearTaskIDTemplate() {
+	m.task_id_template = nil
+	m.clearedFields[webhooktrigger.FieldTaskIDTemplate] = struct{}{}
 }
 
-// AddedTurns returns the value that was added to the "turns" field in this mutation.
-func (m *TaskMutation) AddedTurns() (r int64, exists bool) {
-	v := m.addturns
-	if v == nil {
-		return
-	}
-	return *v, true
+// TaskIDTemplateCleared returns if the "task_id_template" field was cleared in this mutation.
+func (m *WebhookTriggerMutation) TaskIDTemplateCleared() bool {
+	_, ok := m.clearedFields[webhooktrigger.FieldTaskIDTemplate]
+	return ok
 }
 
-// ResetTurns resets all changes to the "turns" field.
-func (m *TaskMutation) ResetTurns() {
-	m.turns = nil
-	m.addturns = nil
+// ResetTaskIDTemplate resets all changes to the "task_id_template" field.
+func (m *WebhookTriggerMutation) ResetTaskIDTemplate() {
+	m.task_id_template = nil
+	delete(m.clearedFields, webhooktrigger.FieldTaskIDTemplate)
 }
 
-// SetToolUses sets the "tool_uses" field.
-func (m *TaskMutation) SetToolUses(value map[string]int64) {
-	m.tool_uses = &value
+// SetWorkspace sets the "workspace" field.
+func (m *WebhookTriggerMutation) SetWorkspace(s string) {
+	m.workspace = &s
 }
 
-// ToolUses returns the value of the "tool_uses" field in the mutation.
-func (m *TaskMutation) ToolUses() (r map[string]int64, exists bool) {
-	v := m.tool_uses
+// Workspace returns the value of the "workspace" field in the mutation.
+func (m *WebhookTriggerMutation) Workspace() (r string, exists bool) {
+	v := m.workspace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToolUses returns the old "tool_uses" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldWorkspace returns the old "workspace" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldToolUses(ctx context.Context) (v map[string]int64, err error) {
+func (m *WebhookTriggerMutation) OldWorkspace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToolUses is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorkspace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToolUses requires an ID field in the mutation")
+		return v, errors.New("OldWorkspace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToolUses: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorkspace: %w", err)
 	}
-	return oldValue.ToolUses, nil
+	return oldValue.Workspace, nil
 }
 
-// ResetToolUses resets all changes to the "tool_uses" field.
-func (m *TaskMutation) ResetToolUses() {
-	m.tool_uses = nil
+// ClearWorkspace clears the value of the "workspace" field.
+func (m *WebhookTriggerMutation) ClearWorkspace() {
+	m.workspace = nil
+	m.clearedFields[webhooktrigger.FieldWorkspace] = struct{}{}
 }
 
-// SetDesiredPhase sets the "desired_phase" field.
-func (m *TaskMutation) SetDesiredPhase(tp types.TaskPhase) {
-	m.desired_phase = &tp
+// WorkspaceCleared returns if the "workspace" field was cleared in this mutation.
+func (m *WebhookTriggerMutation) WorkspaceCleared() bool {
+	_, ok := m.clearedFields[webhooktrigger.FieldWorkspace]
+	return ok
 }
 
-// DesiredPhase returns the value of the "desired_phase" field in the mutation.
-func (m *TaskMutation) DesiredPhase() (r types.TaskPhase, exists bool) {
-	v := m.desired_phase
+// ResetWorkspace resets all changes to the "workspace" field.
+func (m *WebhookTriggerMutation) ResetWorkspace() {
+	m.workspace = nil
+	delete(m.clearedFields, webhooktrigger.FieldWorkspace)
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookTriggerMutation) SetSecret(b []byte) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookTriggerMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDesiredPhase returns the old "desired_phase" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDesiredPhase(ctx context.Context) (v types.TaskPhase, err error) {
+func (m *WebhookTriggerMutation) OldSecret(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDesiredPhase is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDesiredPhase requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDesiredPhase: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.DesiredPhase, nil
+	return oldValue.Secret, nil
 }
 
-// ResetDesiredPhase resets all changes to the "desired_phase" field.
-func (m *TaskMutation) ResetDesiredPhase() {
-	m.desired_phase = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookTriggerMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetPhase sets the "phase" field.
-func (m *TaskMutation) SetPhase(tp types.TaskPhase) {
-	m.phase = &tp
+// SetSignatureHeader sets the "signature_header" field.
+func (m *WebhookTriggerMutation) SetSignatureHeader(s string) {
+	m.signature_header = &s
 }
 
-// Phase returns the value of the "phase" field in the mutation.
-func (m *TaskMutation) Phase() (r types.TaskPhase, exists bool) {
-	v := m.phase
+// SignatureHeader returns the value of the "signature_header" field in the mutation.
+func (m *WebhookTriggerMutation) SignatureHeader() (r string, exists bool) {
+	v := m.signature_header
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPhase returns the old "phase" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldSignatureHeader returns the old "signature_header" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPhase(ctx context.Context) (v types.TaskPhase, err error) {
+func (m *WebhookTriggerMutation) OldSignatureHeader(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+		return v, errors.New("OldSignatureHeader is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhase requires an ID field in the mutation")
+		return v, errors.New("OldSignatureHeader requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+		return v, fmt.Errorf("querying old value for OldSignatureHeader: %w", err)
 	}
-	return oldValue.Phase, nil
+	return oldValue.SignatureHeader, nil
 }
 
-// ResetPhase resets all changes to the "phase" field.
-func (m *TaskMutation) ResetPhase() {
-	m.phase = nil
+// ResetSignatureHeader resets all changes to the "signature_header" field.
+func (m *WebhookTriggerMutation) ResetSignatureHeader() {
+	m.signature_header = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *TaskMutation) SetDescription(s string) {
-	m.description = &s
+// SetEnabled sets the "enabled" field.
+func (m *WebhookTriggerMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TaskMutation) Description() (r string, exists bool) {
-	v := m.description
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookTriggerMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookTriggerMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *TaskMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[task.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TaskMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[task.FieldDescription]
-	return ok
+	return oldValue.Enabled, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TaskMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, task.FieldDescription)
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookTriggerMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
 // SetAgentID sets the "agent_id" field.
-func (m *TaskMutation) SetAgentID(u uuid.UUID) {
+func (m *WebhookTriggerMutation) SetAgentID(u uuid.UUID) {
 	m.agent = &u
 }
 
 // AgentID returns the value of the "agent_id" field in the mutation.
-func (m *TaskMutation) AgentID() (r uuid.UUID, exists bool) {
+func (m *WebhookTriggerMutation) AgentID() (r uuid.UUID, exists bool) {
 	v := m.agent
 	if v == nil {
 		return
@@ -4928,10 +15285,10 @@ func (m *TaskMutation) AgentID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldAgentID returns the old "agent_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldAgentID returns the old "agent_id" field's value of the WebhookTrigger entity.
+// If the WebhookTrigger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookTriggerMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
 	}
@@ -4946,113 +15303,113 @@ func (m *TaskMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error)
 }
 
 // ClearAgentID clears the value of the "agent_id" field.
-func (m *TaskMutation) ClearAgentID() {
+func (m *WebhookTriggerMutation) ClearAgentID() {
 	m.agent = nil
-	m.clearedFields[task.FieldAgentID] = struct{}{}
+	m.clearedFields[webhooktrigger.FieldAgentID] = struct{}{}
 }
 
 // AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
-func (m *TaskMutation) AgentIDCleared() bool {
-	_, ok := m.clearedFields[task.FieldAgentID]
+func (m *WebhookTriggerMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[webhooktrigger.FieldAgentID]
 	return ok
 }
 
 // ResetAgentID resets all changes to the "agent_id" field.
-func (m *TaskMutation) ResetAgentID() {
+func (m *WebhookTriggerMutation) ResetAgentID() {
 	m.agent = nil
-	delete(m.clearedFields, task.FieldAgentID)
+	delete(m.clearedFields, webhooktrigger.FieldAgentID)
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *TaskMutation) AddMessageIDs(ids ...uuid.UUID) {
-	if m.messages == nil {
-		m.messages = make(map[uuid.UUID]struct{})
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *WebhookTriggerMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[webhooktrigger.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *WebhookTriggerMutation) AgentCleared() bool {
+	return m.AgentIDCleared() || m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *WebhookTriggerMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *WebhookTriggerMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookTriggerMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *TaskMutation) ClearMessages() {
-	m.clearedmessages = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookTriggerMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *TaskMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookTriggerMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *TaskMutation) RemoveMessageIDs(ids ...uuid.UUID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[uuid.UUID]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookTriggerMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *TaskMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmessages {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookTriggerMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *TaskMutation) MessagesIDs() (ids []uuid.UUID) {
-	for id := range m.messages {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookTriggerMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *TaskMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
-}
-
-// ClearAgent clears the "agent" edge to the Agent entity.
-func (m *TaskMutation) ClearAgent() {
-	m.clearedagent = true
-	m.clearedFields[task.FieldAgentID] = struct{}{}
-}
-
-// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
-func (m *TaskMutation) AgentCleared() bool {
-	return m.AgentIDCleared() || m.clearedagent
-}
-
-// AgentIDs returns the "agent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AgentID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) AgentIDs() (ids []uuid.UUID) {
-	if id := m.agent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetAgent resets all changes to the "agent" edge.
-func (m *TaskMutation) ResetAgent() {
-	m.agent = nil
-	m.clearedagent = false
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookTriggerMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
+// Where appends a list predicates to the WebhookTriggerMutation builder.
+func (m *WebhookTriggerMutation) Where(ps ...predicate.WebhookTrigger) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookTriggerMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
+func (m *WebhookTriggerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookTrigger, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5060,66 +15417,66 @@ func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskMutation) Op() Op {
+func (m *WebhookTriggerMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
+func (m *WebhookTriggerMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
+// Type returns the node type of this mutation (WebhookTrigger).
+func (m *WebhookTriggerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
+func (m *WebhookTriggerMutation) Fields() []string {
 	fields := make([]string, 0, 14)
 	if m.create_time != nil {
-		fields = append(fields, task.FieldCreateTime)
+		fields = append(fields, webhooktrigger.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, task.FieldUpdateTime)
+		fields = append(fields, webhooktrigger.FieldUpdateTime)
 	}
-	if m.project_directory != nil {
-		fields = append(fields, task.FieldProjectDirectory)
+	if m.owner != nil {
+		fields = append(fields, webhooktrigger.FieldOwner)
 	}
-	if m.input_tokens != nil {
-		fields = append(fields, task.FieldInputTokens)
+	if m.team != nil {
+		fields = append(fields, webhooktrigger.FieldTeam)
 	}
-	if m.output_tokens != nil {
-		fields = append(fields, task.FieldOutputTokens)
+	if m.name != nil {
+		fields = append(fields, webhooktrigger.FieldName)
 	}
-	if m.cache_write_tokens != nil {
-		fields = append(fields, task.FieldCacheWriteTokens)
+	if m.description != nil {
+		fields = append(fields, webhooktrigger.FieldDescription)
 	}
-	if m.cache_read_tokens != nil {
-		fields = append(fields, task.FieldCacheReadTokens)
+	if m.target != nil {
+		fields = append(fields, webhooktrigger.FieldTarget)
 	}
-	if m.cost != nil {
-		fields = append(fields, task.FieldCost)
+	if m.prompt_template != nil {
+		fields = append(fields, webhooktrigger.FieldPromptTemplate)
 	}
-	if m.turns != nil {
-		fields = append(fields, task.FieldTurns)
+	if m.task_id_template != nil {
+		fields = append(fields, webhooktrigger.FieldTaskIDTemplate)
 	}
-	if m.tool_uses != nil {
-		fields = append(fields, task.FieldToolUses)
+	if m.workspace != nil {
+		fields = append(fields, webhooktrigger.FieldWorkspace)
 	}
-	if m.desired_phase != nil {
-		fields = append(fields, task.FieldDesiredPhase)
+	if m.secret != nil {
+		fields = append(fields, webhooktrigger.FieldSecret)
 	}
-	if m.phase != nil {
-		fields = append(fields, task.FieldPhase)
+	if m.signature_header != nil {
+		fields = append(fields, webhooktrigger.FieldSignatureHeader)
 	}
-	if m.description != nil {
-		fields = append(fields, task.FieldDescription)
+	if m.enabled != nil {
+		fields = append(fields, webhooktrigger.FieldEnabled)
 	}
 	if m.agent != nil {
-		fields = append(fields, task.FieldAgentID)
+		fields = append(fields, webhooktrigger.FieldAgentID)
 	}
 	return fields
 }
@@ -5127,174 +15484,174 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookTriggerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldCreateTime:
+	case webhooktrigger.FieldCreateTime:
 		return m.CreateTime()
-	case task.FieldUpdateTime:
+	case webhooktrigger.FieldUpdateTime:
 		return m.UpdateTime()
-	case task.FieldProjectDirectory:
-		return m.ProjectDirectory()
-	case task.FieldInputTokens:
-		return m.InputTokens()
-	case task.FieldOutputTokens:
-		return m.OutputTokens()
-	case task.FieldCacheWriteTokens:
-		return m.CacheWriteTokens()
-	case task.FieldCacheReadTokens:
-		return m.CacheReadTokens()
-	case task.FieldCost:
-		return m.Cost()
-	case task.FieldTurns:
-		return m.Turns()
-	case task.FieldToolUses:
-		return m.ToolUses()
-	case task.FieldDesiredPhase:
-		return m.DesiredPhase()
-	case task.FieldPhase:
-		return m.Phase()
-	case task.FieldDescription:
+	case webhooktrigger.FieldOwner:
+		return m.Owner()
+	case webhooktrigger.FieldTeam:
+		return m.Team()
+	case webhooktrigger.FieldName:
+		return m.Name()
+	case webhooktrigger.FieldDescription:
 		return m.Description()
-	case task.FieldAgentID:
+	case webhooktrigger.FieldTarget:
+		return m.Target()
+	case webhooktrigger.FieldPromptTemplate:
+		return m.PromptTemplate()
+	case webhooktrigger.FieldTaskIDTemplate:
+		return m.TaskIDTemplate()
+	case webhooktrigger.FieldWorkspace:
+		return m.Workspace()
+	case webhooktrigger.FieldSecret:
+		return m.Secret()
+	case webhooktrigger.FieldSignatureHeader:
+		return m.SignatureHeader()
+	case webhooktrigger.FieldEnabled:
+		return m.Enabled()
+	case webhooktrigger.FieldAgentID:
 		return m.AgentID()
 	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case task.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case task.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case task.FieldProjectDirectory:
-		return m.OldProjectDirectory(ctx)
-	case task.FieldInputTokens:
-		return m.OldInputTokens(ctx)
-	case task.FieldOutputTokens:
-		return m.OldOutputTokens(ctx)
-	case task.FieldCacheWriteTokens:
-		return m.OldCacheWriteTokens(ctx)
-	case task.FieldCacheReadTokens:
-		return m.OldCacheReadTokens(ctx)
-	case task.FieldCost:
-		return m.OldCost(ctx)
-	case task.FieldTurns:
-		return m.OldTurns(ctx)
-	case task.FieldToolUses:
-		return m.OldToolUses(ctx)
-	case task.FieldDesiredPhase:
-		return m.OldDesiredPhase(ctx)
-	case task.FieldPhase:
-		return m.OldPhase(ctx)
-	case task.FieldDescription:
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookTriggerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhooktrigger.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case webhooktrigger.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case webhooktrigger.FieldOwner:
+		return m.OldOwner(ctx)
+	case webhooktrigger.FieldTeam:
+		return m.OldTeam(ctx)
+	case webhooktrigger.FieldName:
+		return m.OldName(ctx)
+	case webhooktrigger.FieldDescription:
 		return m.OldDescription(ctx)
-	case task.FieldAgentID:
+	case webhooktrigger.FieldTarget:
+		return m.OldTarget(ctx)
+	case webhooktrigger.FieldPromptTemplate:
+		return m.OldPromptTemplate(ctx)
+	case webhooktrigger.FieldTaskIDTemplate:
+		return m.OldTaskIDTemplate(ctx)
+	case webhooktrigger.FieldWorkspace:
+		return m.OldWorkspace(ctx)
+	case webhooktrigger.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhooktrigger.FieldSignatureHeader:
+		return m.OldSignatureHeader(ctx)
+	case webhooktrigger.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhooktrigger.FieldAgentID:
 		return m.OldAgentID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
+	return nil, fmt.Errorf("unknown WebhookTrigger field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookTriggerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldCreateTime:
+	case webhooktrigger.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case task.FieldUpdateTime:
+	case webhooktrigger.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case task.FieldProjectDirectory:
+	case webhooktrigger.FieldOwner:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProjectDirectory(v)
+		m.SetOwner(v)
 		return nil
-	case task.FieldInputTokens:
-		v, ok := value.(int64)
+	case webhooktrigger.FieldTeam:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInputTokens(v)
+		m.SetTeam(v)
 		return nil
-	case task.FieldOutputTokens:
-		v, ok := value.(int64)
+	case webhooktrigger.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOutputTokens(v)
+		m.SetName(v)
 		return nil
-	case task.FieldCacheWriteTokens:
-		v, ok := value.(int64)
+	case webhooktrigger.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheWriteTokens(v)
+		m.SetDescription(v)
 		return nil
-	case task.FieldCacheReadTokens:
-		v, ok := value.(int64)
+	case webhooktrigger.FieldTarget:
+		v, ok := value.(types.WebhookTarget)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheReadTokens(v)
+		m.SetTarget(v)
 		return nil
-	case task.FieldCost:
-		v, ok := value.(float64)
+	case webhooktrigger.FieldPromptTemplate:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCost(v)
+		m.SetPromptTemplate(v)
 		return nil
-	case task.FieldTurns:
-		v, ok := value.(int64)
+	case webhooktrigger.FieldTaskIDTemplate:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTurns(v)
+		m.SetTaskIDTemplate(v)
 		return nil
-	case task.FieldToolUses:
-		v, ok := value.(map[string]int64)
+	case webhooktrigger.FieldWorkspace:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToolUses(v)
+		m.SetWorkspace(v)
 		return nil
-	case task.FieldDesiredPhase:
-		v, ok := value.(types.TaskPhase)
+	case webhooktrigger.FieldSecret:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDesiredPhase(v)
+		m.SetSecret(v)
 		return nil
-	case task.FieldPhase:
-		v, ok := value.(types.TaskPhase)
+	case webhooktrigger.FieldSignatureHeader:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPhase(v)
+		m.SetSignatureHeader(v)
 		return nil
-	case task.FieldDescription:
-		v, ok := value.(string)
+	case webhooktrigger.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetEnabled(v)
 		return nil
-	case task.FieldAgentID:
+	case webhooktrigger.FieldAgentID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5302,272 +15659,185 @@ func (m *TaskMutation) SetField(name string, value ent.Value) error {
 		m.SetAgentID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown WebhookTrigger field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
-	var fields []string
-	if m.addinput_tokens != nil {
-		fields = append(fields, task.FieldInputTokens)
-	}
-	if m.addoutput_tokens != nil {
-		fields = append(fields, task.FieldOutputTokens)
-	}
-	if m.addcache_write_tokens != nil {
-		fields = append(fields, task.FieldCacheWriteTokens)
-	}
-	if m.addcache_read_tokens != nil {
-		fields = append(fields, task.FieldCacheReadTokens)
-	}
-	if m.addcost != nil {
-		fields = append(fields, task.FieldCost)
-	}
-	if m.addturns != nil {
-		fields = append(fields, task.FieldTurns)
-	}
-	return fields
+func (m *WebhookTriggerMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case task.FieldInputTokens:
-		return m.AddedInputTokens()
-	case task.FieldOutputTokens:
-		return m.AddedOutputTokens()
-	case task.FieldCacheWriteTokens:
-		return m.AddedCacheWriteTokens()
-	case task.FieldCacheReadTokens:
-		return m.AddedCacheReadTokens()
-	case task.FieldCost:
-		return m.AddedCost()
-	case task.FieldTurns:
-		return m.AddedTurns()
-	}
+func (m *WebhookTriggerMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookTriggerMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldInputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddInputTokens(v)
-		return nil
-	case task.FieldOutputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOutputTokens(v)
-		return nil
-	case task.FieldCacheWriteTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheWriteTokens(v)
-		return nil
-	case task.FieldCacheReadTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheReadTokens(v)
-		return nil
-	case task.FieldCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCost(v)
-		return nil
-	case task.FieldTurns:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTurns(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown WebhookTrigger numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *WebhookTriggerMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldProjectDirectory) {
-		fields = append(fields, task.FieldProjectDirectory)
+	if m.FieldCleared(webhooktrigger.FieldOwner) {
+		fields = append(fields, webhooktrigger.FieldOwner)
 	}
-	if m.FieldCleared(task.FieldInputTokens) {
-		fields = append(fields, task.FieldInputTokens)
-	}
-	if m.FieldCleared(task.FieldOutputTokens) {
-		fields = append(fields, task.FieldOutputTokens)
-	}
-	if m.FieldCleared(task.FieldCacheWriteTokens) {
-		fields = append(fields, task.FieldCacheWriteTokens)
+	if m.FieldCleared(webhooktrigger.FieldTeam) {
+		fields = append(fields, webhooktrigger.FieldTeam)
 	}
-	if m.FieldCleared(task.FieldCacheReadTokens) {
-		fields = append(fields, task.FieldCacheReadTokens)
+	if m.FieldCleared(webhooktrigger.FieldDescription) {
+		fields = append(fields, webhooktrigger.FieldDescription)
 	}
-	if m.FieldCleared(task.FieldCost) {
-		fields = append(fields, task.FieldCost)
+	if m.FieldCleared(webhooktrigger.FieldTaskIDTemplate) {
+		fields = append(fields, webhooktrigger.FieldTaskIDTemplate)
 	}
-	if m.FieldCleared(task.FieldDescription) {
-		fields = append(fields, task.FieldDescription)
+	if m.FieldCleared(webhooktrigger.FieldWorkspace) {
+		fields = append(fields, webhooktrigger.FieldWorkspace)
 	}
-	if m.FieldCleared(task.FieldAgentID) {
-		fields = append(fields, task.FieldAgentID)
+	if m.FieldCleared(webhooktrigger.FieldAgentID) {
+		fields = append(fields, webhooktrigger.FieldAgentID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *WebhookTriggerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *WebhookTriggerMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldProjectDirectory:
-		m.ClearProjectDirectory()
-		return nil
-	case task.FieldInputTokens:
-		m.ClearInputTokens()
-		return nil
-	case task.FieldOutputTokens:
-		m.ClearOutputTokens()
+	case webhooktrigger.FieldOwner:
+		m.ClearOwner()
 		return nil
-	case task.FieldCacheWriteTokens:
-		m.ClearCacheWriteTokens()
+	case webhooktrigger.FieldTeam:
+		m.ClearTeam()
 		return nil
-	case task.FieldCacheReadTokens:
-		m.ClearCacheReadTokens()
+	case webhooktrigger.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case task.FieldCost:
-		m.ClearCost()
+	case webhooktrigger.FieldTaskIDTemplate:
+		m.ClearTaskIDTemplate()
 		return nil
-	case task.FieldDescription:
-		m.ClearDescription()
+	case webhooktrigger.FieldWorkspace:
+		m.ClearWorkspace()
 		return nil
-	case task.FieldAgentID:
+	case webhooktrigger.FieldAgentID:
 		m.ClearAgentID()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown WebhookTrigger nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *WebhookTriggerMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldCreateTime:
+	case webhooktrigger.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case task.FieldUpdateTime:
+	case webhooktrigger.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case task.FieldProjectDirectory:
-		m.ResetProjectDirectory()
+	case webhooktrigger.FieldOwner:
+		m.ResetOwner()
 		return nil
-	case task.FieldInputTokens:
-		m.ResetInputTokens()
+	case webhooktrigger.FieldTeam:
+		m.ResetTeam()
 		return nil
-	case task.FieldOutputTokens:
-		m.ResetOutputTokens()
+	case webhooktrigger.FieldName:
+		m.ResetName()
 		return nil
-	case task.FieldCacheWriteTokens:
-		m.ResetCacheWriteTokens()
+	case webhooktrigger.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case task.FieldCacheReadTokens:
-		m.ResetCacheReadTokens()
+	case webhooktrigger.FieldTarget:
+		m.ResetTarget()
 		return nil
-	case task.FieldCost:
-		m.ResetCost()
+	case webhooktrigger.FieldPromptTemplate:
+		m.ResetPromptTemplate()
 		return nil
-	case task.FieldTurns:
-		m.ResetTurns()
+	case webhooktrigger.FieldTaskIDTemplate:
+		m.ResetTaskIDTemplate()
 		return nil
-	case task.FieldToolUses:
-		m.ResetToolUses()
+	case webhooktrigger.FieldWorkspace:
+		m.ResetWorkspace()
 		return nil
-	case task.FieldDesiredPhase:
-		m.ResetDesiredPhase()
+	case webhooktrigger.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case task.FieldPhase:
-		m.ResetPhase()
+	case webhooktrigger.FieldSignatureHeader:
+		m.ResetSignatureHeader()
 		return nil
-	case task.FieldDescription:
-		m.ResetDescription()
+	case webhooktrigger.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case task.FieldAgentID:
+	case webhooktrigger.FieldAgentID:
 		m.ResetAgentID()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown WebhookTrigger field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
+func (m *WebhookTriggerMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.messages != nil {
-		edges = append(edges, task.EdgeMessages)
-	}
 	if m.agent != nil {
-		edges = append(edges, task.EdgeAgent)
+		edges = append(edges, webhooktrigger.EdgeAgent)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, webhooktrigger.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookTriggerMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	case task.EdgeAgent:
+	case webhooktrigger.EdgeAgent:
 		if id := m.agent; id != nil {
 			return []ent.Value{*id}
 		}
+	case webhooktrigger.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
+func (m *WebhookTriggerMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, task.EdgeMessages)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhooktrigger.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookTriggerMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
+	case webhooktrigger.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5576,50 +15846,50 @@ func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
+func (m *WebhookTriggerMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedmessages {
-		edges = append(edges, task.EdgeMessages)
-	}
 	if m.clearedagent {
-		edges = append(edges, task.EdgeAgent)
+		edges = append(edges, webhooktrigger.EdgeAgent)
+	}
+	if m.cleareddeliveries {
+		edges = append(edges, webhooktrigger.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *WebhookTriggerMutation) EdgeCleared(name string) bool {
 	switch name {
-	case task.EdgeMessages:
-		return m.clearedmessages
-	case task.EdgeAgent:
+	case webhooktrigger.EdgeAgent:
 		return m.clearedagent
+	case webhooktrigger.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
+func (m *WebhookTriggerMutation) ClearEdge(name string) error {
 	switch name {
-	case task.EdgeAgent:
+	case webhooktrigger.EdgeAgent:
 		m.ClearAgent()
 		return nil
 	}
-	return fmt.Errorf("unknown Task unique edge %s", name)
+	return fmt.Errorf("unknown WebhookTrigger unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
+func (m *WebhookTriggerMutation) ResetEdge(name string) error {
 	switch name {
-	case task.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case task.EdgeAgent:
+	case webhooktrigger.EdgeAgent:
 		m.ResetAgent()
 		return nil
+	case webhooktrigger.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
 	}
-	return fmt.Errorf("unknown Task edge %s", name)
+	return fmt.Errorf("unknown WebhookTrigger edge %s", name)
 }
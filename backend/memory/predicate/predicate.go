@@ -9,6 +9,12 @@ import (
 // Agent is the predicate function for agent builders.
 type Agent func(*sql.Selector)
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// FileChange is the predicate function for filechange builders.
+type FileChange func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
@@ -18,5 +24,23 @@ type Model func(*sql.Selector)
 // ModelProvider is the predicate function for modelprovider builders.
 type ModelProvider func(*sql.Selector)
 
+// NotificationSink is the predicate function for notificationsink builders.
+type NotificationSink func(*sql.Selector)
+
+// Schedule is the predicate function for schedule builders.
+type Schedule func(*sql.Selector)
+
+// ScheduleRun is the predicate function for schedulerun builders.
+type ScheduleRun func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
+
+// Token is the predicate function for token builders.
+type Token func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookTrigger is the predicate function for webhooktrigger builders.
+type WebhookTrigger func(*sql.Selector)
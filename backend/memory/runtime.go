@@ -6,11 +6,19 @@ import (
 	"time"
 
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/filechange"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/notificationsink"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
 	"github.com/furisto/construct/backend/memory/schema"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/token"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/furisto/construct/backend/memory/webhooktrigger"
 	"github.com/google/uuid"
 )
 
@@ -45,6 +53,43 @@ func init() {
 	agentDescID := agentFields[0].Descriptor()
 	// agent.DefaultID holds the default value on creation for the id field.
 	agent.DefaultID = agentDescID.Default.(func() uuid.UUID)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescSequence is the schema descriptor for sequence field.
+	auditeventDescSequence := auditeventFields[1].Descriptor()
+	// auditevent.SequenceValidator is a validator for the "sequence" field. It is called by the builders before save.
+	auditevent.SequenceValidator = auditeventDescSequence.Validators[0].(func(int64) error)
+	// auditeventDescAction is the schema descriptor for action field.
+	auditeventDescAction := auditeventFields[5].Descriptor()
+	// auditevent.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditevent.ActionValidator = auditeventDescAction.Validators[0].(func(string) error)
+	// auditeventDescHash is the schema descriptor for hash field.
+	auditeventDescHash := auditeventFields[16].Descriptor()
+	// auditevent.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	auditevent.HashValidator = auditeventDescHash.Validators[0].(func([]byte) error)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
+	filechangeMixin := schema.FileChange{}.Mixin()
+	filechangeMixinFields0 := filechangeMixin[0].Fields()
+	_ = filechangeMixinFields0
+	filechangeFields := schema.FileChange{}.Fields()
+	_ = filechangeFields
+	// filechangeDescCreateTime is the schema descriptor for create_time field.
+	filechangeDescCreateTime := filechangeMixinFields0[0].Descriptor()
+	// filechange.DefaultCreateTime holds the default value on creation for the create_time field.
+	filechange.DefaultCreateTime = filechangeDescCreateTime.Default.(func() time.Time)
+	// filechangeDescUpdateTime is the schema descriptor for update_time field.
+	filechangeDescUpdateTime := filechangeMixinFields0[1].Descriptor()
+	// filechange.DefaultUpdateTime holds the default value on creation for the update_time field.
+	filechange.DefaultUpdateTime = filechangeDescUpdateTime.Default.(func() time.Time)
+	// filechange.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	filechange.UpdateDefaultUpdateTime = filechangeDescUpdateTime.UpdateDefault.(func() time.Time)
+	// filechangeDescID is the schema descriptor for id field.
+	filechangeDescID := filechangeFields[0].Descriptor()
+	// filechange.DefaultID holds the default value on creation for the id field.
+	filechange.DefaultID = filechangeDescID.Default.(func() uuid.UUID)
 	messageMixin := schema.Message{}.Mixin()
 	messageMixinFields0 := messageMixin[0].Fields()
 	_ = messageMixinFields0
@@ -142,6 +187,87 @@ func init() {
 	modelproviderDescID := modelproviderFields[0].Descriptor()
 	// modelprovider.DefaultID holds the default value on creation for the id field.
 	modelprovider.DefaultID = modelproviderDescID.Default.(func() uuid.UUID)
+	notificationsinkMixin := schema.NotificationSink{}.Mixin()
+	notificationsinkMixinFields0 := notificationsinkMixin[0].Fields()
+	_ = notificationsinkMixinFields0
+	notificationsinkFields := schema.NotificationSink{}.Fields()
+	_ = notificationsinkFields
+	// notificationsinkDescCreateTime is the schema descriptor for create_time field.
+	notificationsinkDescCreateTime := notificationsinkMixinFields0[0].Descriptor()
+	// notificationsink.DefaultCreateTime holds the default value on creation for the create_time field.
+	notificationsink.DefaultCreateTime = notificationsinkDescCreateTime.Default.(func() time.Time)
+	// notificationsinkDescUpdateTime is the schema descriptor for update_time field.
+	notificationsinkDescUpdateTime := notificationsinkMixinFields0[1].Descriptor()
+	// notificationsink.DefaultUpdateTime holds the default value on creation for the update_time field.
+	notificationsink.DefaultUpdateTime = notificationsinkDescUpdateTime.Default.(func() time.Time)
+	// notificationsink.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	notificationsink.UpdateDefaultUpdateTime = notificationsinkDescUpdateTime.UpdateDefault.(func() time.Time)
+	// notificationsinkDescName is the schema descriptor for name field.
+	notificationsinkDescName := notificationsinkFields[1].Descriptor()
+	// notificationsink.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	notificationsink.NameValidator = notificationsinkDescName.Validators[0].(func(string) error)
+	// notificationsinkDescEnabled is the schema descriptor for enabled field.
+	notificationsinkDescEnabled := notificationsinkFields[11].Descriptor()
+	// notificationsink.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationsink.DefaultEnabled = notificationsinkDescEnabled.Default.(bool)
+	// notificationsinkDescID is the schema descriptor for id field.
+	notificationsinkDescID := notificationsinkFields[0].Descriptor()
+	// notificationsink.DefaultID holds the default value on creation for the id field.
+	notificationsink.DefaultID = notificationsinkDescID.Default.(func() uuid.UUID)
+	scheduleMixin := schema.Schedule{}.Mixin()
+	scheduleMixinFields0 := scheduleMixin[0].Fields()
+	_ = scheduleMixinFields0
+	scheduleFields := schema.Schedule{}.Fields()
+	_ = scheduleFields
+	// scheduleDescCreateTime is the schema descriptor for create_time field.
+	scheduleDescCreateTime := scheduleMixinFields0[0].Descriptor()
+	// schedule.DefaultCreateTime holds the default value on creation for the create_time field.
+	schedule.DefaultCreateTime = scheduleDescCreateTime.Default.(func() time.Time)
+	// scheduleDescUpdateTime is the schema descriptor for update_time field.
+	scheduleDescUpdateTime := scheduleMixinFields0[1].Descriptor()
+	// schedule.DefaultUpdateTime holds the default value on creation for the update_time field.
+	schedule.DefaultUpdateTime = scheduleDescUpdateTime.Default.(func() time.Time)
+	// schedule.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	schedule.UpdateDefaultUpdateTime = scheduleDescUpdateTime.UpdateDefault.(func() time.Time)
+	// scheduleDescName is the schema descriptor for name field.
+	scheduleDescName := scheduleFields[1].Descriptor()
+	// schedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	schedule.NameValidator = scheduleDescName.Validators[0].(func(string) error)
+	// scheduleDescCronExpression is the schema descriptor for cron_expression field.
+	scheduleDescCronExpression := scheduleFields[2].Descriptor()
+	// schedule.CronExpressionValidator is a validator for the "cron_expression" field. It is called by the builders before save.
+	schedule.CronExpressionValidator = scheduleDescCronExpression.Validators[0].(func(string) error)
+	// scheduleDescPromptTemplate is the schema descriptor for prompt_template field.
+	scheduleDescPromptTemplate := scheduleFields[3].Descriptor()
+	// schedule.PromptTemplateValidator is a validator for the "prompt_template" field. It is called by the builders before save.
+	schedule.PromptTemplateValidator = scheduleDescPromptTemplate.Validators[0].(func(string) error)
+	// scheduleDescEnabled is the schema descriptor for enabled field.
+	scheduleDescEnabled := scheduleFields[7].Descriptor()
+	// schedule.DefaultEnabled holds the default value on creation for the enabled field.
+	schedule.DefaultEnabled = scheduleDescEnabled.Default.(bool)
+	// scheduleDescID is the schema descriptor for id field.
+	scheduleDescID := scheduleFields[0].Descriptor()
+	// schedule.DefaultID holds the default value on creation for the id field.
+	schedule.DefaultID = scheduleDescID.Default.(func() uuid.UUID)
+	schedulerunMixin := schema.ScheduleRun{}.Mixin()
+	schedulerunMixinFields0 := schedulerunMixin[0].Fields()
+	_ = schedulerunMixinFields0
+	schedulerunFields := schema.ScheduleRun{}.Fields()
+	_ = schedulerunFields
+	// schedulerunDescCreateTime is the schema descriptor for create_time field.
+	schedulerunDescCreateTime := schedulerunMixinFields0[0].Descriptor()
+	// schedulerun.DefaultCreateTime holds the default value on creation for the create_time field.
+	schedulerun.DefaultCreateTime = schedulerunDescCreateTime.Default.(func() time.Time)
+	// schedulerunDescUpdateTime is the schema descriptor for update_time field.
+	schedulerunDescUpdateTime := schedulerunMixinFields0[1].Descriptor()
+	// schedulerun.DefaultUpdateTime holds the default value on creation for the update_time field.
+	schedulerun.DefaultUpdateTime = schedulerunDescUpdateTime.Default.(func() time.Time)
+	// schedulerun.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	schedulerun.UpdateDefaultUpdateTime = schedulerunDescUpdateTime.UpdateDefault.(func() time.Time)
+	// schedulerunDescID is the schema descriptor for id field.
+	schedulerunDescID := schedulerunFields[0].Descriptor()
+	// schedulerun.DefaultID holds the default value on creation for the id field.
+	schedulerun.DefaultID = schedulerunDescID.Default.(func() uuid.UUID)
 	taskMixin := schema.Task{}.Mixin()
 	taskMixinFields0 := taskMixin[0].Fields()
 	_ = taskMixinFields0
@@ -165,8 +291,97 @@ func init() {
 	taskDescToolUses := taskFields[8].Descriptor()
 	// task.DefaultToolUses holds the default value on creation for the tool_uses field.
 	task.DefaultToolUses = taskDescToolUses.Default.(map[string]int64)
+	// taskDescReviewEdits is the schema descriptor for review_edits field.
+	taskDescReviewEdits := taskFields[13].Descriptor()
+	// task.DefaultReviewEdits holds the default value on creation for the review_edits field.
+	task.DefaultReviewEdits = taskDescReviewEdits.Default.(bool)
 	// taskDescID is the schema descriptor for id field.
 	taskDescID := taskFields[0].Descriptor()
 	// task.DefaultID holds the default value on creation for the id field.
 	task.DefaultID = taskDescID.Default.(func() uuid.UUID)
+	tokenMixin := schema.Token{}.Mixin()
+	tokenMixinFields0 := tokenMixin[0].Fields()
+	_ = tokenMixinFields0
+	tokenFields := schema.Token{}.Fields()
+	_ = tokenFields
+	// tokenDescCreateTime is the schema descriptor for create_time field.
+	tokenDescCreateTime := tokenMixinFields0[0].Descriptor()
+	// token.DefaultCreateTime holds the default value on creation for the create_time field.
+	token.DefaultCreateTime = tokenDescCreateTime.Default.(func() time.Time)
+	// tokenDescUpdateTime is the schema descriptor for update_time field.
+	tokenDescUpdateTime := tokenMixinFields0[1].Descriptor()
+	// token.DefaultUpdateTime holds the default value on creation for the update_time field.
+	token.DefaultUpdateTime = tokenDescUpdateTime.Default.(func() time.Time)
+	// token.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	token.UpdateDefaultUpdateTime = tokenDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tokenDescName is the schema descriptor for name field.
+	tokenDescName := tokenFields[1].Descriptor()
+	// token.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	token.NameValidator = tokenDescName.Validators[0].(func(string) error)
+	// tokenDescHash is the schema descriptor for hash field.
+	tokenDescHash := tokenFields[2].Descriptor()
+	// token.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	token.HashValidator = tokenDescHash.Validators[0].(func([]byte) error)
+	// tokenDescID is the schema descriptor for id field.
+	tokenDescID := tokenFields[0].Descriptor()
+	// token.DefaultID holds the default value on creation for the id field.
+	token.DefaultID = tokenDescID.Default.(func() uuid.UUID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreateTime is the schema descriptor for create_time field.
+	webhookdeliveryDescCreateTime := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdelivery.DefaultCreateTime = webhookdeliveryDescCreateTime.Default.(func() time.Time)
+	// webhookdeliveryDescUpdateTime is the schema descriptor for update_time field.
+	webhookdeliveryDescUpdateTime := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhookdelivery.DefaultUpdateTime = webhookdeliveryDescUpdateTime.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhookdelivery.UpdateDefaultUpdateTime = webhookdeliveryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhooktriggerMixin := schema.WebhookTrigger{}.Mixin()
+	webhooktriggerMixinFields0 := webhooktriggerMixin[0].Fields()
+	_ = webhooktriggerMixinFields0
+	webhooktriggerFields := schema.WebhookTrigger{}.Fields()
+	_ = webhooktriggerFields
+	// webhooktriggerDescCreateTime is the schema descriptor for create_time field.
+	webhooktriggerDescCreateTime := webhooktriggerMixinFields0[0].Descriptor()
+	// webhooktrigger.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhooktrigger.DefaultCreateTime = webhooktriggerDescCreateTime.Default.(func() time.Time)
+	// webhooktriggerDescUpdateTime is the schema descriptor for update_time field.
+	webhooktriggerDescUpdateTime := webhooktriggerMixinFields0[1].Descriptor()
+	// webhooktrigger.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhooktrigger.DefaultUpdateTime = webhooktriggerDescUpdateTime.Default.(func() time.Time)
+	// webhooktrigger.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhooktrigger.UpdateDefaultUpdateTime = webhooktriggerDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhooktriggerDescName is the schema descriptor for name field.
+	webhooktriggerDescName := webhooktriggerFields[1].Descriptor()
+	// webhooktrigger.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhooktrigger.NameValidator = webhooktriggerDescName.Validators[0].(func(string) error)
+	// webhooktriggerDescPromptTemplate is the schema descriptor for prompt_template field.
+	webhooktriggerDescPromptTemplate := webhooktriggerFields[4].Descriptor()
+	// webhooktrigger.PromptTemplateValidator is a validator for the "prompt_template" field. It is called by the builders before save.
+	webhooktrigger.PromptTemplateValidator = webhooktriggerDescPromptTemplate.Validators[0].(func(string) error)
+	// webhooktriggerDescSecret is the schema descriptor for secret field.
+	webhooktriggerDescSecret := webhooktriggerFields[7].Descriptor()
+	// webhooktrigger.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooktrigger.SecretValidator = webhooktriggerDescSecret.Validators[0].(func([]byte) error)
+	// webhooktriggerDescSignatureHeader is the schema descriptor for signature_header field.
+	webhooktriggerDescSignatureHeader := webhooktriggerFields[8].Descriptor()
+	// webhooktrigger.SignatureHeaderValidator is a validator for the "signature_header" field. It is called by the builders before save.
+	webhooktrigger.SignatureHeaderValidator = webhooktriggerDescSignatureHeader.Validators[0].(func(string) error)
+	// webhooktriggerDescEnabled is the schema descriptor for enabled field.
+	webhooktriggerDescEnabled := webhooktriggerFields[9].Descriptor()
+	// webhooktrigger.DefaultEnabled holds the default value on creation for the enabled field.
+	webhooktrigger.DefaultEnabled = webhooktriggerDescEnabled.Default.(bool)
+	// webhooktriggerDescID is the schema descriptor for id field.
+	webhooktriggerDescID := webhooktriggerFields[0].Descriptor()
+	// webhooktrigger.DefaultID holds the default value on creation for the id field.
+	webhooktrigger.DefaultID = webhooktriggerDescID.Default.(func() uuid.UUID)
 }
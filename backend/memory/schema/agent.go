@@ -20,6 +20,8 @@ func (Agent) Fields() []ent.Field {
 		field.String("description").Optional(),
 		field.String("instructions"),
 		field.Bool("builtin").Default(false),
+		// tools are the names of the custom tools the agent can use in addition to the built-in tools.
+		field.Strings("tools").Optional(),
 
 		field.UUID("model_id", uuid.UUID{}).Optional(),
 	}
@@ -30,6 +32,9 @@ func (Agent) Edges() []ent.Edge {
 		edge.To("model", Model.Type).Field("model_id").Unique(),
 		edge.From("tasks", Task.Type).Ref("agent"),
 		edge.From("messages", Message.Type).Ref("agent"),
+		edge.From("schedules", Schedule.Type).Ref("agent"),
+		edge.From("webhook_triggers", WebhookTrigger.Type).Ref("agent"),
+		edge.From("notification_sinks", NotificationSink.Type).Ref("agent"),
 	}
 }
 
@@ -43,5 +48,6 @@ func (Agent) Indexes() []ent.Index {
 func (Agent) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.Time{},
+		OwnerMixin{},
 	}
 }
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// AuditEvent is an entry of the append-only audit log. Every event stores the hash of its
+// predecessor, so that changing or removing an event breaks the chain. Events reference
+// tasks only by ID because they have to outlive them.
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Int64("sequence").Positive().Immutable(),
+		field.Time("time").Immutable(),
+		field.Enum("kind").GoType(types.AuditEventKind("")).Immutable(),
+		field.String("actor").Optional().Immutable(),
+		field.String("action").NotEmpty().Immutable(),
+		field.String("resource").Optional().Immutable(),
+		field.UUID("task_id", uuid.UUID{}).Optional().Immutable(),
+		field.String("workspace").Optional().Immutable(),
+		field.String("command").Optional().Immutable(),
+		field.String("path").Optional().Immutable(),
+		field.String("diff_hash").Optional().Immutable(),
+		field.Int("exit_code").Optional().Nillable().Immutable(),
+		field.Int64("duration_ms").Immutable(),
+		field.String("error").Optional().Immutable(),
+		field.Bytes("previous_hash").Optional().Immutable(),
+		field.Bytes("hash").NotEmpty().Immutable(),
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sequence").
+			Unique(),
+		index.Fields("actor", "sequence"),
+		index.Fields("task_id"),
+	}
+}
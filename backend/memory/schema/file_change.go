@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// FileChange is an edit of an agent to a file that is staged for the review of the user.
+// All edits of a task to the same file are combined into one pending change, which keeps
+// the content the file had before the first edit.
+type FileChange struct {
+	ent.Schema
+}
+
+func (FileChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("path"),
+		// The content before the first edit, nil if the agent created the file.
+		field.Text("original_content").Optional().Nillable(),
+		// The content after the last edit.
+		field.Text("content"),
+		field.Enum("status").GoType(types.FileChangeStatus("")).Default(string(types.FileChangeStatusPending)),
+		field.String("reason").Optional(),
+
+		field.UUID("task_id", uuid.UUID{}),
+	}
+}
+
+func (FileChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("task", Task.Type).Field("task_id").Unique().Required().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+	}
+}
+
+func (FileChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "status"),
+	}
+}
+
+func (FileChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
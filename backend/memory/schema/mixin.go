@@ -29,3 +29,23 @@ func (AgentMixin) Indexes() []ent.Index {
 		index.Fields("agent_id"),
 	}
 }
+
+// OwnerMixin records the user that created a resource and the team of that user. Members
+// of the team can read the resource, only the owner can change it. Resources created over
+// the Unix socket have no owner.
+type OwnerMixin struct {
+	mixin.Schema
+}
+
+func (OwnerMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner").Optional(),
+		field.String("team").Optional(),
+	}
+}
+
+func (OwnerMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner"),
+	}
+}
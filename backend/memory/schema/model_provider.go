@@ -33,5 +33,6 @@ func (ModelProvider) Edges() []ent.Edge {
 func (ModelProvider) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.Time{},
+		OwnerMixin{},
 	}
 }
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+type NotificationSink struct {
+	ent.Schema
+}
+
+func (NotificationSink) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("description").Optional(),
+		field.Enum("kind").GoType(types.NotificationSinkKind("")),
+		field.JSON("events", []types.NotificationEvent{}),
+		field.Float("budget").Optional().Nillable(),
+		field.String("url").Optional(),
+		field.JSON("email", &types.NotificationEmailConfig{}).Optional(),
+		field.Bytes("secret").Optional().Sensitive(),
+		field.String("title_template").Optional(),
+		field.String("body_template").Optional(),
+		field.Bool("enabled").Default(true),
+
+		field.UUID("agent_id", uuid.UUID{}).Optional(),
+	}
+}
+
+func (NotificationSink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("agent", Agent.Type).Field("agent_id").Unique().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+	}
+}
+
+func (NotificationSink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+	}
+}
+
+func (NotificationSink) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		OwnerMixin{},
+	}
+}
@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+type Schedule struct {
+	ent.Schema
+}
+
+func (Schedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("cron_expression").NotEmpty(),
+		field.String("prompt_template").NotEmpty(),
+		field.String("workspace").Optional(),
+		field.Enum("overlap_policy").GoType(types.ScheduleOverlapPolicy("")).Default(string(types.ScheduleOverlapPolicySkip)),
+		field.Enum("catch_up_policy").GoType(types.ScheduleCatchUpPolicy("")).Default(string(types.ScheduleCatchUpPolicyRunOnce)),
+		field.Bool("enabled").Default(true),
+		field.Time("last_run_time").Optional(),
+		field.Time("next_run_time").Optional(),
+
+		field.UUID("agent_id", uuid.UUID{}),
+	}
+}
+
+func (Schedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("agent", Agent.Type).Field("agent_id").Unique().Required().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+		edge.From("runs", ScheduleRun.Type).Ref("schedule"),
+	}
+}
+
+func (Schedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+		index.Fields("next_run_time"),
+	}
+}
+
+func (Schedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		OwnerMixin{},
+	}
+}
+
+type ScheduleRun struct {
+	ent.Schema
+}
+
+func (ScheduleRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Enum("trigger").GoType(types.ScheduleRunTrigger("")),
+		field.Enum("status").GoType(types.ScheduleRunStatus("")),
+		field.Time("scheduled_time"),
+		field.String("error").Optional(),
+
+		field.UUID("schedule_id", uuid.UUID{}),
+		field.UUID("task_id", uuid.UUID{}).Optional(),
+	}
+}
+
+func (ScheduleRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("schedule", Schedule.Type).Field("schedule_id").Unique().Required().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+		edge.To("task", Task.Type).Field("task_id").Unique(),
+	}
+}
+
+func (ScheduleRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schedule_id", "scheduled_time"),
+	}
+}
+
+func (ScheduleRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
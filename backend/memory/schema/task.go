@@ -30,13 +30,27 @@ func (Task) Fields() []ent.Field {
 
 		field.String("description").Optional(),
 		field.UUID("agent_id", uuid.UUID{}).Optional(),
+		// Edits to files are staged as file changes for the review of the user.
+		field.Bool("review_edits").Default(false),
+		// Free-form key/value pairs that the user attaches to organize tasks.
+		field.JSON("labels", map[string]string{}).Optional(),
+
+		// The task and the last of its messages that this task was forked from.
+		field.UUID("forked_from_id", uuid.UUID{}).Optional(),
+		field.UUID("forked_from_message_id", uuid.UUID{}).Optional(),
+
+		// The daemon replica that currently reconciles the task and when its claim runs out.
+		field.String("lease_owner").Optional(),
+		field.Time("lease_expire_time").Optional(),
 	}
 }
 
 func (Task) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("messages", Message.Type).Ref("task"),
+		edge.From("file_changes", FileChange.Type).Ref("task"),
 		edge.To("agent", Agent.Type).Field("agent_id").Unique(),
+		edge.To("forks", Task.Type).From("forked_from").Field("forked_from_id").Unique(),
 	}
 }
 
@@ -50,5 +64,6 @@ func (Task) Indexes() []ent.Index {
 func (Task) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.Time{},
+		OwnerMixin{},
 	}
 }
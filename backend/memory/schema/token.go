@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// Token is an API token that authenticates clients connecting to the daemon over TCP.
+// Only the SHA-256 hash of the token is stored.
+type Token struct {
+	ent.Schema
+}
+
+func (Token) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.Bytes("hash").NotEmpty().Sensitive().Immutable(),
+		field.Enum("scope").GoType(types.TokenScope("")),
+		// user is the identity requests with the token are made on behalf of. Tokens created
+		// before users existed act as the user named like the token.
+		field.String("user").Optional(),
+		field.String("team").Optional(),
+		field.Time("expire_time").Optional().Nillable(),
+		field.Time("last_used_time").Optional().Nillable(),
+	}
+}
+
+func (Token) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+		index.Fields("hash").
+			Unique(),
+	}
+}
+
+func (Token) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
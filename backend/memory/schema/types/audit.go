@@ -0,0 +1,17 @@
+package types
+
+type AuditEventKind string
+
+const (
+	// AuditEventKindToolCall is a side-effecting tool call of an agent.
+	AuditEventKindToolCall AuditEventKind = "tool_call"
+	// AuditEventKindAPICall is a call to an API operation that changes state.
+	AuditEventKindAPICall AuditEventKind = "api_call"
+)
+
+func (k AuditEventKind) Values() []string {
+	return []string{
+		string(AuditEventKindToolCall),
+		string(AuditEventKindAPICall),
+	}
+}
@@ -35,7 +35,8 @@ func (d *Decimal) Scan(value interface{}) error {
 
 func DecimalSchemaType() map[string]string {
 	return map[string]string{
-		dialect.SQLite: "NUMERIC",
+		dialect.SQLite:   "NUMERIC",
+		dialect.Postgres: "numeric",
 	}
 }
 
@@ -0,0 +1,20 @@
+package types
+
+type FileChangeStatus string
+
+const (
+	// FileChangeStatusPending is a change that waits for the review of the user.
+	FileChangeStatusPending FileChangeStatus = "pending"
+	// FileChangeStatusAccepted is a change that the user kept.
+	FileChangeStatusAccepted FileChangeStatus = "accepted"
+	// FileChangeStatusRejected is a change that was reverted.
+	FileChangeStatusRejected FileChangeStatus = "rejected"
+)
+
+func (s FileChangeStatus) Values() []string {
+	return []string{
+		string(FileChangeStatusPending),
+		string(FileChangeStatusAccepted),
+		string(FileChangeStatusRejected),
+	}
+}
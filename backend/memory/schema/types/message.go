@@ -8,6 +8,7 @@ const (
 	MessageBlockKindNativeToolResult      MessageBlockKind = "native_tool_result"
 	MessageBlockKindCodeInterpreterCall   MessageBlockKind = "code_interpreter_call"
 	MessageBlockKindCodeInterpreterResult MessageBlockKind = "code_interpreter_result"
+	MessageBlockKindFileAttachment        MessageBlockKind = "file_attachment"
 )
 
 type MessageContent struct {
@@ -19,6 +20,16 @@ type MessageBlock struct {
 	Payload string           `json:"payload"`
 }
 
+// FileAttachment is the payload of a file_attachment block. The content is read from the
+// workspace when the message is created, so that the conversation does not change when the
+// file does.
+type FileAttachment struct {
+	Path      string `json:"path"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+	Content   string `json:"content"`
+}
+
 type MessageSource string
 
 const (
@@ -0,0 +1,53 @@
+package types
+
+type NotificationSinkKind string
+
+const (
+	// NotificationSinkKindWebhook posts a JSON document to a URL.
+	NotificationSinkKindWebhook NotificationSinkKind = "webhook"
+	// NotificationSinkKindDesktop shows a desktop notification through notify-send.
+	NotificationSinkKindDesktop NotificationSinkKind = "desktop"
+	// NotificationSinkKindEmail sends an email through an SMTP server.
+	NotificationSinkKindEmail NotificationSinkKind = "email"
+)
+
+func (k NotificationSinkKind) Values() []string {
+	return []string{
+		string(NotificationSinkKindWebhook),
+		string(NotificationSinkKindDesktop),
+		string(NotificationSinkKindEmail),
+	}
+}
+
+type NotificationEvent string
+
+const (
+	// NotificationEventTaskCompleted fires when the agent gave its final response and the
+	// task waits for new input.
+	NotificationEventTaskCompleted NotificationEvent = "task_completed"
+	// NotificationEventTaskSuspended fires when a task is suspended.
+	NotificationEventTaskSuspended NotificationEvent = "task_suspended"
+	// NotificationEventTaskFailed fires when the reconciliation of a task failed.
+	NotificationEventTaskFailed NotificationEvent = "task_failed"
+	// NotificationEventInputRequested fires when the agent asks the user a question.
+	NotificationEventInputRequested NotificationEvent = "input_requested"
+	// NotificationEventBudgetExceeded fires once when the cost of a task crosses the budget of the sink.
+	NotificationEventBudgetExceeded NotificationEvent = "budget_exceeded"
+)
+
+func (e NotificationEvent) Values() []string {
+	return []string{
+		string(NotificationEventTaskCompleted),
+		string(NotificationEventTaskSuspended),
+		string(NotificationEventTaskFailed),
+		string(NotificationEventInputRequested),
+		string(NotificationEventBudgetExceeded),
+	}
+}
+
+type NotificationEmailConfig struct {
+	SMTPAddress string   `json:"smtp_address"`
+	Username    string   `json:"username,omitempty"`
+	From        string   `json:"from"`
+	To          []string `json:"to"`
+}
@@ -0,0 +1,73 @@
+package types
+
+type ScheduleOverlapPolicy string
+
+const (
+	// ScheduleOverlapPolicyAllow starts a new task even if the previous run is still in progress.
+	ScheduleOverlapPolicyAllow ScheduleOverlapPolicy = "allow"
+	// ScheduleOverlapPolicySkip skips the run if the previous run is still in progress.
+	ScheduleOverlapPolicySkip ScheduleOverlapPolicy = "skip"
+	// ScheduleOverlapPolicyReplace suspends the previous task and starts a new one.
+	ScheduleOverlapPolicyReplace ScheduleOverlapPolicy = "replace"
+)
+
+func (p ScheduleOverlapPolicy) Values() []string {
+	return []string{
+		string(ScheduleOverlapPolicyAllow),
+		string(ScheduleOverlapPolicySkip),
+		string(ScheduleOverlapPolicyReplace),
+	}
+}
+
+type ScheduleCatchUpPolicy string
+
+const (
+	// ScheduleCatchUpPolicySkip drops all runs that were missed while the daemon was down.
+	ScheduleCatchUpPolicySkip ScheduleCatchUpPolicy = "skip"
+	// ScheduleCatchUpPolicyRunOnce starts a single run for all missed runs.
+	ScheduleCatchUpPolicyRunOnce ScheduleCatchUpPolicy = "run_once"
+	// ScheduleCatchUpPolicyRunAll starts one run per missed run.
+	ScheduleCatchUpPolicyRunAll ScheduleCatchUpPolicy = "run_all"
+)
+
+func (p ScheduleCatchUpPolicy) Values() []string {
+	return []string{
+		string(ScheduleCatchUpPolicySkip),
+		string(ScheduleCatchUpPolicyRunOnce),
+		string(ScheduleCatchUpPolicyRunAll),
+	}
+}
+
+type ScheduleRunTrigger string
+
+const (
+	ScheduleRunTriggerScheduled ScheduleRunTrigger = "scheduled"
+	ScheduleRunTriggerCatchUp   ScheduleRunTrigger = "catch_up"
+	ScheduleRunTriggerManual    ScheduleRunTrigger = "manual"
+)
+
+func (t ScheduleRunTrigger) Values() []string {
+	return []string{
+		string(ScheduleRunTriggerScheduled),
+		string(ScheduleRunTriggerCatchUp),
+		string(ScheduleRunTriggerManual),
+	}
+}
+
+type ScheduleRunStatus string
+
+const (
+	ScheduleRunStatusStarted   ScheduleRunStatus = "started"
+	ScheduleRunStatusSkipped   ScheduleRunStatus = "skipped"
+	ScheduleRunStatusFailed    ScheduleRunStatus = "failed"
+	ScheduleRunStatusCompleted ScheduleRunStatus = "completed"
+)
+
+func (s ScheduleRunStatus) Values() []string {
+	return []string{
+		string(ScheduleRunStatusStarted),
+		string(ScheduleRunStatusSkipped),
+		string(ScheduleRunStatusFailed),
+		string(ScheduleRunStatusCompleted),
+	}
+}
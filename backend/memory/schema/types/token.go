@@ -0,0 +1,17 @@
+package types
+
+type TokenScope string
+
+const (
+	// TokenScopeRead allows calls that do not modify any state.
+	TokenScopeRead TokenScope = "read"
+	// TokenScopeFull allows every call.
+	TokenScopeFull TokenScope = "full"
+)
+
+func (s TokenScope) Values() []string {
+	return []string{
+		string(TokenScopeRead),
+		string(TokenScopeFull),
+	}
+}
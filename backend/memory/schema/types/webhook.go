@@ -0,0 +1,36 @@
+package types
+
+type WebhookTarget string
+
+const (
+	// WebhookTargetNewTask starts a new task for every delivery.
+	WebhookTargetNewTask WebhookTarget = "new_task"
+	// WebhookTargetMessage adds a message to an existing task.
+	WebhookTargetMessage WebhookTarget = "message"
+)
+
+func (t WebhookTarget) Values() []string {
+	return []string{
+		string(WebhookTargetNewTask),
+		string(WebhookTargetMessage),
+	}
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusAccepted means the delivery created a task or a message.
+	WebhookDeliveryStatusAccepted WebhookDeliveryStatus = "accepted"
+	// WebhookDeliveryStatusRejected means the delivery failed verification or could not be decoded.
+	WebhookDeliveryStatusRejected WebhookDeliveryStatus = "rejected"
+	// WebhookDeliveryStatusFailed means the delivery was valid but could not be processed.
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+func (s WebhookDeliveryStatus) Values() []string {
+	return []string{
+		string(WebhookDeliveryStatusAccepted),
+		string(WebhookDeliveryStatusRejected),
+		string(WebhookDeliveryStatusFailed),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+type WebhookTrigger struct {
+	ent.Schema
+}
+
+func (WebhookTrigger) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("description").Optional(),
+		field.Enum("target").GoType(types.WebhookTarget("")),
+		field.String("prompt_template").NotEmpty(),
+		field.String("task_id_template").Optional(),
+		field.String("workspace").Optional(),
+		field.Bytes("secret").NotEmpty().Sensitive(),
+		field.String("signature_header").NotEmpty(),
+		field.Bool("enabled").Default(true),
+
+		field.UUID("agent_id", uuid.UUID{}).Optional(),
+	}
+}
+
+func (WebhookTrigger) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("agent", Agent.Type).Field("agent_id").Unique().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+		edge.From("deliveries", WebhookDelivery.Type).Ref("trigger"),
+	}
+}
+
+func (WebhookTrigger) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+	}
+}
+
+func (WebhookTrigger) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		OwnerMixin{},
+	}
+}
+
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Enum("status").GoType(types.WebhookDeliveryStatus("")),
+		field.Int("status_code"),
+		field.String("error").Optional(),
+		field.Bytes("payload").Optional(),
+
+		field.UUID("trigger_id", uuid.UUID{}),
+		field.UUID("task_id", uuid.UUID{}).Optional(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("trigger", WebhookTrigger.Type).Field("trigger_id").Unique().Required().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+		edge.To("task", Task.Type).Field("task_id").Unique(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("trigger_id", "create_time"),
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
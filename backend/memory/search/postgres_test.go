@@ -0,0 +1,65 @@
+//go:build postgres
+
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIndexPostgres(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewPostgresDatabase(t)
+
+	parser := db.Task.Create().SetDescription("Refactor the parser").SaveX(ctx)
+	createMessage(t, db, parser.ID, types.MessageBlock{Kind: types.MessageBlockKindText, Payload: "The tokenizer drops trailing commas"})
+
+	// the index is created on every start of the daemon
+	for range 2 {
+		if err := EnsureIndex(ctx, db); err != nil {
+			t.Fatalf("failed to create index: %v", err)
+		}
+	}
+
+	createMessage(t, db, parser.ID, types.MessageBlock{
+		Kind:    types.MessageBlockKindNativeToolCall,
+		Payload: `{"id":"call_1","tool":"edit_file","args":{"path":"parser/lexer.go"}}`,
+	})
+	createMessage(t, db, parser.ID, types.MessageBlock{
+		Kind:    types.MessageBlockKindNativeToolResult,
+		Payload: `{"id":"call_1","name":"edit_file","result":"lexer updated"}`,
+	})
+
+	tests := []struct {
+		query    string
+		messages []string
+		tasks    []string
+	}{
+		{query: "tokeniz", messages: []string{"The tokenizer drops trailing commas"}},
+		{query: "TRAILING drops", messages: []string{"The tokenizer drops trailing commas"}},
+		{query: "lexer", messages: []string{`edit_file {"path":"parser/lexer.go"}`}},
+		{query: "pars", messages: []string{`edit_file {"path":"parser/lexer.go"}`}, tasks: []string{"Refactor the parser"}},
+		{query: "updated"},
+		{query: "commas parser"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if diff := cmp.Diff(tt.messages, searchMessages(t, db, tt.query)); diff != "" {
+				t.Errorf("messages mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.tasks, searchTasks(t, db, tt.query)); diff != "" {
+				t.Errorf("tasks mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	db.Task.UpdateOne(parser).SetDescription("Speed up the lexer").ExecX(ctx)
+	if got := searchTasks(t, db, "lexer"); len(got) != 1 {
+		t.Errorf("expected the new description to be found, got %v", got)
+	}
+}
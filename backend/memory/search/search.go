@@ -0,0 +1,340 @@
+// Package search maintains the full-text index of the descriptions of tasks and the text and
+// tool calls of messages, and provides the predicates that query it.
+//
+// The index is derived from the tables of ent and is created by EnsureIndex instead of a
+// migration: SQLite drops the triggers that keep the index current whenever a migration
+// rebuilds the messages or tasks table, and databases adopted from the automatic migration
+// of earlier versions never ran the migrations. EnsureIndex notices both and rebuilds it.
+package search
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/task"
+)
+
+// sqliteMessageText extracts the indexed text from the content of a row of messages: the
+// text blocks and the names and arguments of tool calls, one block per line.
+const sqliteMessageText = `(SELECT group_concat(CASE json_extract(value, '$.kind') WHEN 'text' THEN json_extract(value, '$.payload') ELSE json_extract(json_extract(value, '$.payload'), '$.tool') || ' ' || json_extract(json_extract(value, '$.payload'), '$.args') END, char(10)) FROM json_each(%[1]s.content, '$.blocks') WHERE json_extract(value, '$.kind') IN ('text', 'native_tool_call', 'code_interpreter_call'))`
+
+// sqliteObjects are the tables and triggers of the index. If any of them is missing, the
+// index is rebuilt.
+var sqliteObjects = []string{
+	"message_search", "message_search_insert", "message_search_update", "message_search_delete",
+	"task_search", "task_search_insert", "task_search_update", "task_search_delete",
+}
+
+var sqliteIndex = []string{
+	`CREATE VIRTUAL TABLE message_search USING fts5(text, message_id UNINDEXED, tokenize = 'unicode61 remove_diacritics 0')`,
+	`CREATE TRIGGER message_search_insert AFTER INSERT ON messages BEGIN
+		INSERT INTO message_search (rowid, text, message_id) VALUES (new.rowid, ` + fmt.Sprintf(sqliteMessageText, "new") + `, new.id);
+	END`,
+	`CREATE TRIGGER message_search_update AFTER UPDATE OF content ON messages BEGIN
+		DELETE FROM message_search WHERE rowid = old.rowid;
+		INSERT INTO message_search (rowid, text, message_id) VALUES (new.rowid, ` + fmt.Sprintf(sqliteMessageText, "new") + `, new.id);
+	END`,
+	`CREATE TRIGGER message_search_delete AFTER DELETE ON messages BEGIN
+		DELETE FROM message_search WHERE rowid = old.rowid;
+	END`,
+	`INSERT INTO message_search (rowid, text, message_id) SELECT rowid, ` + fmt.Sprintf(sqliteMessageText, "messages") + `, id FROM messages`,
+
+	`CREATE VIRTUAL TABLE task_search USING fts5(text, task_id UNINDEXED, tokenize = 'unicode61 remove_diacritics 0')`,
+	`CREATE TRIGGER task_search_insert AFTER INSERT ON tasks BEGIN
+		INSERT INTO task_search (rowid, text, task_id) VALUES (new.rowid, coalesce(new.description, ''), new.id);
+	END`,
+	`CREATE TRIGGER task_search_update AFTER UPDATE OF description ON tasks BEGIN
+		DELETE FROM task_search WHERE rowid = old.rowid;
+		INSERT INTO task_search (rowid, text, task_id) VALUES (new.rowid, coalesce(new.description, ''), new.id);
+	END`,
+	`CREATE TRIGGER task_search_delete AFTER DELETE ON tasks BEGIN
+		DELETE FROM task_search WHERE rowid = old.rowid;
+	END`,
+	`INSERT INTO task_search (rowid, text, task_id) SELECT rowid, coalesce(description, ''), id FROM tasks`,
+}
+
+// postgresWords indexes the words of a text the way the tokenizer of SQLite splits them. The
+// parser of Postgres keeps paths, hosts and URLs in a single token, so that a search for
+// lexer would not find parser/lexer.go.
+const postgresWords = `to_tsvector('simple', regexp_replace(%s, '[^[:alnum:]]+', ' ', 'g'))`
+
+var postgresIndex = []string{
+	`CREATE OR REPLACE FUNCTION message_search_text(content jsonb) RETURNS text LANGUAGE sql IMMUTABLE AS $$
+		SELECT coalesce(string_agg(CASE block->>'kind' WHEN 'text' THEN block->>'payload'
+			ELSE ((block->>'payload')::jsonb->>'tool') || ' ' || ((block->>'payload')::jsonb->>'args') END, E'\n'), '')
+		FROM jsonb_array_elements(content->'blocks') AS block
+		WHERE block->>'kind' IN ('text', 'native_tool_call', 'code_interpreter_call')
+	$$`,
+	// indexes of earlier versions that did not split words
+	`DROP INDEX IF EXISTS message_search`,
+	`DROP INDEX IF EXISTS task_search`,
+	`CREATE INDEX IF NOT EXISTS message_search_words ON messages USING gin (` + fmt.Sprintf(postgresWords, "message_search_text(content)") + `)`,
+	`CREATE INDEX IF NOT EXISTS task_search_words ON tasks USING gin (` + fmt.Sprintf(postgresWords, "coalesce(description, '')") + `)`,
+}
+
+// EnsureIndex creates the index if it does not exist. On SQLite, it also rebuilds the index
+// if a migration dropped its triggers or it lost track of messages.
+func EnsureIndex(ctx context.Context, client *memory.Client) error {
+	db := client.MustDB()
+	switch client.Driver().Dialect() {
+	case dialect.SQLite:
+		current, err := sqliteIndexCurrent(ctx, db)
+		if err != nil {
+			return fmt.Errorf("failed to inspect search index: %w", err)
+		}
+		if current {
+			return nil
+		}
+
+		statements := make([]string, 0, 2*len(sqliteObjects)+len(sqliteIndex))
+		for _, name := range sqliteObjects {
+			if strings.HasSuffix(name, "_search") {
+				statements = append(statements, "DROP TABLE IF EXISTS "+name)
+			} else {
+				statements = append(statements, "DROP TRIGGER IF EXISTS "+name)
+			}
+		}
+		return execTx(ctx, db, append(statements, sqliteIndex...))
+	case dialect.Postgres:
+		return execTx(ctx, db, postgresIndex)
+	default:
+		return fmt.Errorf("search is not supported for dialect %q", client.Driver().Dialect())
+	}
+}
+
+func sqliteIndexCurrent(ctx context.Context, db *sql.DB) (bool, error) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(sqliteObjects)), ", ")
+	args := make([]any, 0, len(sqliteObjects))
+	for _, name := range sqliteObjects {
+		args = append(args, name)
+	}
+
+	var objects int
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master WHERE name IN ("+placeholders+")", args...).Scan(&objects); err != nil {
+		return false, err
+	}
+	if objects != len(sqliteObjects) {
+		return false, nil
+	}
+
+	// rowids that changed, e.g. by a VACUUM, let the triggers remove the wrong rows
+	var indexed, messages int
+	err := db.QueryRowContext(ctx, "SELECT (SELECT count(*) FROM message_search), (SELECT count(*) FROM messages)").Scan(&indexed, &messages)
+	if err != nil {
+		return false, err
+	}
+	return indexed == messages, nil
+}
+
+func execTx(ctx context.Context, db *sql.DB, statements []string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to create search index: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// Terms splits a query into the lower-cased words that are searched for. Words are runs of
+// letters and digits, like the tokens of the index.
+func Terms(query string) []string {
+	var terms []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(query), isSeparator) {
+		if !slices.Contains(terms, word) {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// MessageMatches matches the messages that contain a word starting with each of the terms.
+func MessageMatches(terms []string) predicate.Message {
+	return func(s *entsql.Selector) {
+		s.Where(matches(s, s.C(message.FieldID), s.C(message.FieldContent), "message_search", "message_id", "message_search_text(%s)", terms))
+	}
+}
+
+// TaskMatches matches the tasks whose description contains a word starting with each of
+// the terms.
+func TaskMatches(terms []string) predicate.Task {
+	return func(s *entsql.Selector) {
+		s.Where(matches(s, s.C(task.FieldID), s.C(task.FieldDescription), "task_search", "task_id", "coalesce(%s, '')", terms))
+	}
+}
+
+func matches(s *entsql.Selector, id, column, table, key, text string, terms []string) *entsql.Predicate {
+	return entsql.P(func(b *entsql.Builder) {
+		switch s.Dialect() {
+		case dialect.Postgres:
+			prefixes := make([]string, 0, len(terms))
+			for _, term := range terms {
+				prefixes = append(prefixes, term+":*")
+			}
+			b.WriteString(fmt.Sprintf(postgresWords, fmt.Sprintf(text, column))).WriteString(" @@ to_tsquery('simple', ").
+				Arg(strings.Join(prefixes, " & ")).WriteString(")")
+		default:
+			prefixes := make([]string, 0, len(terms))
+			for _, term := range terms {
+				prefixes = append(prefixes, `"`+term+`"*`)
+			}
+			b.WriteString(id).WriteString(" IN (SELECT ").WriteString(key).WriteString(" FROM ").WriteString(table).
+				WriteString(" WHERE ").WriteString(table).WriteString(" MATCH ").Arg(strings.Join(prefixes, " "))
+			b.WriteString(")")
+		}
+	})
+}
+
+// Block is a part of a message that is indexed.
+type Block struct {
+	Kind types.MessageBlockKind
+	Text string
+}
+
+// MessageBlocks returns the parts of a message that are indexed: the text blocks and the
+// names and arguments of tool calls.
+func MessageBlocks(content *types.MessageContent) []Block {
+	if content == nil {
+		return nil
+	}
+
+	var blocks []Block
+	for _, block := range content.Blocks {
+		switch block.Kind {
+		case types.MessageBlockKindText:
+			blocks = append(blocks, Block{Kind: block.Kind, Text: block.Payload})
+		case types.MessageBlockKindNativeToolCall, types.MessageBlockKindCodeInterpreterCall:
+			var call struct {
+				Tool string          `json:"tool"`
+				Args json.RawMessage `json:"args"`
+			}
+			if err := json.Unmarshal([]byte(block.Payload), &call); err != nil {
+				continue
+			}
+			blocks = append(blocks, Block{Kind: block.Kind, Text: call.Tool + " " + string(call.Args)})
+		}
+	}
+	return blocks
+}
+
+// Highlight is the byte range [Start, End) of a matched word.
+type Highlight struct {
+	Start int
+	End   int
+}
+
+// Snippet is an excerpt of a text around the first match of the terms.
+type Snippet struct {
+	Text       string
+	Highlights []Highlight
+	// Matches is the number of words of the whole text that match a term.
+	Matches int
+}
+
+const (
+	snippetLength = 160
+	snippetBefore = 40
+	ellipsis      = "…"
+)
+
+// NewSnippet returns an excerpt of about 160 characters of the text, starting shortly before
+// the first word that matches a term. Whitespace is collapsed to single spaces.
+func NewSnippet(text string, terms []string) Snippet {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+
+	// offsets maps the index of a rune to the index of its first byte
+	offsets := make([]int, len(runes)+1)
+	for i, r := range runes {
+		offsets[i+1] = offsets[i] + utf8.RuneLen(r)
+	}
+
+	var matched []span
+	for _, word := range words(runes) {
+		lower := strings.ToLower(string(runes[word.start:word.end]))
+		if slices.ContainsFunc(terms, func(term string) bool { return strings.HasPrefix(lower, term) }) {
+			matched = append(matched, word)
+		}
+	}
+
+	start := 0
+	if len(matched) > 0 && matched[0].start > snippetBefore {
+		start = matched[0].start - snippetBefore
+		for start < matched[0].start && runes[start-1] != ' ' {
+			start++
+		}
+	}
+	end := min(len(runes), start+snippetLength)
+	for i := end; end < len(runes) && i > start; i-- {
+		if runes[i] == ' ' {
+			end = i
+			break
+		}
+	}
+
+	var builder strings.Builder
+	if start > 0 {
+		builder.WriteString(ellipsis)
+	}
+	shift := builder.Len() - offsets[start]
+	builder.WriteString(string(runes[start:end]))
+	if end < len(runes) {
+		builder.WriteString(ellipsis)
+	}
+
+	snippet := Snippet{Text: builder.String(), Matches: len(matched)}
+	for _, word := range matched {
+		if word.start >= start && word.end <= end {
+			snippet.Highlights = append(snippet.Highlights, Highlight{
+				Start: shift + offsets[word.start],
+				End:   shift + offsets[word.end],
+			})
+		}
+	}
+	return snippet
+}
+
+// span is the range [start, end) of runes of a word.
+type span struct {
+	start int
+	end   int
+}
+
+func words(runes []rune) []span {
+	var spans []span
+	start := -1
+	for i, r := range runes {
+		if isSeparator(r) {
+			if start >= 0 {
+				spans = append(spans, span{start: start, end: i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, span{start: start, end: len(runes)})
+	}
+	return spans
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
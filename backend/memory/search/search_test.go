@@ -0,0 +1,176 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestIndex(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+
+	parser := db.Task.Create().SetDescription("Refactor the parser").SaveX(ctx)
+	createMessage(t, db, parser.ID, types.MessageBlock{Kind: types.MessageBlockKindText, Payload: "The tokenizer drops trailing commas"})
+
+	// messages that exist before the index are indexed when it is created
+	if err := EnsureIndex(ctx, db); err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+
+	toolCall := createMessage(t, db, parser.ID, types.MessageBlock{
+		Kind:    types.MessageBlockKindNativeToolCall,
+		Payload: `{"id":"call_1","tool":"edit_file","args":{"path":"parser/lexer.go"}}`,
+	})
+	result := createMessage(t, db, parser.ID, types.MessageBlock{
+		Kind:    types.MessageBlockKindNativeToolResult,
+		Payload: `{"id":"call_1","name":"edit_file","result":"lexer updated"}`,
+	})
+
+	tests := []struct {
+		query    string
+		messages []string
+		tasks    []string
+	}{
+		{query: "tokeniz", messages: []string{"The tokenizer drops trailing commas"}},
+		{query: "TRAILING drops", messages: []string{"The tokenizer drops trailing commas"}},
+		{query: "lexer", messages: []string{`edit_file {"path":"parser/lexer.go"}`}},
+		{query: "pars", messages: []string{`edit_file {"path":"parser/lexer.go"}`}, tasks: []string{"Refactor the parser"}},
+		{query: "updated"},
+		{query: "commas parser"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if diff := cmp.Diff(tt.messages, searchMessages(t, db, tt.query)); diff != "" {
+				t.Errorf("messages mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.tasks, searchTasks(t, db, tt.query)); diff != "" {
+				t.Errorf("tasks mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	db.Task.UpdateOne(parser).SetDescription("Speed up the lexer").ExecX(ctx)
+	db.Message.DeleteOne(toolCall).ExecX(ctx)
+	db.Message.DeleteOne(result).ExecX(ctx)
+	if got := searchTasks(t, db, "lexer"); len(got) != 1 {
+		t.Errorf("expected the new description to be indexed, got %v", got)
+	}
+	if got := searchMessages(t, db, "lexer"); len(got) != 0 {
+		t.Errorf("expected deleted messages to be removed from the index, got %v", got)
+	}
+
+	// a migration that rebuilds the messages table drops the triggers
+	if _, err := db.MustDB().ExecContext(ctx, "DROP TRIGGER message_search_insert"); err != nil {
+		t.Fatalf("failed to drop trigger: %v", err)
+	}
+	createMessage(t, db, parser.ID, types.MessageBlock{Kind: types.MessageBlockKindText, Payload: "Benchmark the lexer"})
+	if err := EnsureIndex(ctx, db); err != nil {
+		t.Fatalf("failed to rebuild index: %v", err)
+	}
+	if got := searchMessages(t, db, "benchmark"); len(got) != 1 {
+		t.Errorf("expected the index to be rebuilt, got %v", got)
+	}
+}
+
+func TestNewSnippet(t *testing.T) {
+	long := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua. "
+
+	tests := []struct {
+		name       string
+		text       string
+		terms      []string
+		expected   string
+		highlights []string
+	}{
+		{
+			name:       "short text",
+			text:       "Fix the failing\n\ntests in parser_test.go",
+			terms:      []string{"pars", "test"},
+			expected:   "Fix the failing tests in parser_test.go",
+			highlights: []string{"tests", "parser", "test"},
+		},
+		{
+			name:       "match in the middle of a long text",
+			text:       long + "The tokenizer drops trailing commas. " + long,
+			terms:      []string{"tokenizer"},
+			expected:   "…ut labore et dolore magna aliqua. The tokenizer drops trailing commas. Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt…",
+			highlights: []string{"tokenizer"},
+		},
+		{
+			name:       "multi-byte characters",
+			text:       "Überprüfe die Größe",
+			terms:      []string{"größe"},
+			expected:   "Überprüfe die Größe",
+			highlights: []string{"Größe"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snippet := NewSnippet(tt.text, tt.terms)
+			if snippet.Text != tt.expected {
+				t.Errorf("expected snippet %q, got %q", tt.expected, snippet.Text)
+			}
+
+			var highlights []string
+			for _, highlight := range snippet.Highlights {
+				highlights = append(highlights, snippet.Text[highlight.Start:highlight.End])
+			}
+			if diff := cmp.Diff(tt.highlights, highlights); diff != "" {
+				t.Errorf("highlights mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	if diff := cmp.Diff([]string{"fix", "parser", "go"}, Terms(`Fix "parser.go" fix*`)); diff != "" {
+		t.Errorf("terms mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func createMessage(t *testing.T, db *memory.Client, taskID uuid.UUID, block types.MessageBlock) *memory.Message {
+	t.Helper()
+	return db.Message.Create().
+		SetTaskID(taskID).
+		SetSource(types.MessageSourceAssistant).
+		SetContent(&types.MessageContent{Blocks: []types.MessageBlock{block}}).
+		SaveX(context.Background())
+}
+
+func searchMessages(t *testing.T, db *memory.Client, query string) []string {
+	t.Helper()
+	messages, err := db.Message.Query().Where(MessageMatches(Terms(query))).Order(message.ByCreateTime()).All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to search messages: %v", err)
+	}
+
+	var texts []string
+	for _, m := range messages {
+		texts = append(texts, MessageBlocks(m.Content)[0].Text)
+	}
+	return texts
+}
+
+func searchTasks(t *testing.T, db *memory.Client, query string) []string {
+	t.Helper()
+	tasks, err := db.Task.Query().Where(TaskMatches(Terms(query))).Order(task.ByCreateTime()).All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to search tasks: %v", err)
+	}
+
+	var descriptions []string
+	for _, found := range tasks {
+		descriptions = append(descriptions, found.Description)
+	}
+	return descriptions
+}
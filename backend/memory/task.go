@@ -25,6 +25,10 @@ type Task struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Team holds the value of the "team" field.
+	Team string `json:"team,omitempty"`
 	// ProjectDirectory holds the value of the "project_directory" field.
 	ProjectDirectory string `json:"project_directory,omitempty"`
 	// InputTokens holds the value of the "input_tokens" field.
@@ -49,6 +53,18 @@ type Task struct {
 	Description string `json:"description,omitempty"`
 	// AgentID holds the value of the "agent_id" field.
 	AgentID uuid.UUID `json:"agent_id,omitempty"`
+	// ReviewEdits holds the value of the "review_edits" field.
+	ReviewEdits bool `json:"review_edits,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `json:"labels,omitempty"`
+	// ForkedFromID holds the value of the "forked_from_id" field.
+	ForkedFromID uuid.UUID `json:"forked_from_id,omitempty"`
+	// ForkedFromMessageID holds the value of the "forked_from_message_id" field.
+	ForkedFromMessageID uuid.UUID `json:"forked_from_message_id,omitempty"`
+	// LeaseOwner holds the value of the "lease_owner" field.
+	LeaseOwner string `json:"lease_owner,omitempty"`
+	// LeaseExpireTime holds the value of the "lease_expire_time" field.
+	LeaseExpireTime time.Time `json:"lease_expire_time,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -59,11 +75,17 @@ type Task struct {
 type TaskEdges struct {
 	// Messages holds the value of the messages edge.
 	Messages []*Message `json:"messages,omitempty"`
+	// FileChanges holds the value of the file_changes edge.
+	FileChanges []*FileChange `json:"file_changes,omitempty"`
 	// Agent holds the value of the agent edge.
 	Agent *Agent `json:"agent,omitempty"`
+	// ForkedFrom holds the value of the forked_from edge.
+	ForkedFrom *Task `json:"forked_from,omitempty"`
+	// Forks holds the value of the forks edge.
+	Forks []*Task `json:"forks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // MessagesOrErr returns the Messages value or an error if the edge
@@ -75,33 +97,64 @@ func (e TaskEdges) MessagesOrErr() ([]*Message, error) {
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// FileChangesOrErr returns the FileChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) FileChangesOrErr() ([]*FileChange, error) {
+	if e.loadedTypes[1] {
+		return e.FileChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "file_changes"}
+}
+
 // AgentOrErr returns the Agent value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e TaskEdges) AgentOrErr() (*Agent, error) {
 	if e.Agent != nil {
 		return e.Agent, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: agent.Label}
 	}
 	return nil, &NotLoadedError{edge: "agent"}
 }
 
+// ForkedFromOrErr returns the ForkedFrom value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) ForkedFromOrErr() (*Task, error) {
+	if e.ForkedFrom != nil {
+		return e.ForkedFrom, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "forked_from"}
+}
+
+// ForksOrErr returns the Forks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ForksOrErr() ([]*Task, error) {
+	if e.loadedTypes[4] {
+		return e.Forks, nil
+	}
+	return nil, &NotLoadedError{edge: "forks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldToolUses:
+		case task.FieldToolUses, task.FieldLabels:
 			values[i] = new([]byte)
+		case task.FieldReviewEdits:
+			values[i] = new(sql.NullBool)
 		case task.FieldCost:
 			values[i] = new(sql.NullFloat64)
 		case task.FieldInputTokens, task.FieldOutputTokens, task.FieldCacheWriteTokens, task.FieldCacheReadTokens, task.FieldTurns:
 			values[i] = new(sql.NullInt64)
-		case task.FieldProjectDirectory, task.FieldDesiredPhase, task.FieldPhase, task.FieldDescription:
+		case task.FieldOwner, task.FieldTeam, task.FieldProjectDirectory, task.FieldDesiredPhase, task.FieldPhase, task.FieldDescription, task.FieldLeaseOwner:
 			values[i] = new(sql.NullString)
-		case task.FieldCreateTime, task.FieldUpdateTime:
+		case task.FieldCreateTime, task.FieldUpdateTime, task.FieldLeaseExpireTime:
 			values[i] = new(sql.NullTime)
-		case task.FieldID, task.FieldAgentID:
+		case task.FieldID, task.FieldAgentID, task.FieldForkedFromID, task.FieldForkedFromMessageID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -136,6 +189,18 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.UpdateTime = value.Time
 			}
+		case task.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				t.Owner = value.String
+			}
+		case task.FieldTeam:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field team", values[i])
+			} else if value.Valid {
+				t.Team = value.String
+			}
 		case task.FieldProjectDirectory:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field project_directory", values[i])
@@ -210,6 +275,44 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				t.AgentID = *value
 			}
+		case task.FieldReviewEdits:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field review_edits", values[i])
+			} else if value.Valid {
+				t.ReviewEdits = value.Bool
+			}
+		case task.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case task.FieldForkedFromID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field forked_from_id", values[i])
+			} else if value != nil {
+				t.ForkedFromID = *value
+			}
+		case task.FieldForkedFromMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field forked_from_message_id", values[i])
+			} else if value != nil {
+				t.ForkedFromMessageID = *value
+			}
+		case task.FieldLeaseOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_owner", values[i])
+			} else if value.Valid {
+				t.LeaseOwner = value.String
+			}
+		case task.FieldLeaseExpireTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expire_time", values[i])
+			} else if value.Valid {
+				t.LeaseExpireTime = value.Time
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -228,11 +331,26 @@ func (t *Task) QueryMessages() *MessageQuery {
 	return NewTaskClient(t.config).QueryMessages(t)
 }
 
+// QueryFileChanges queries the "file_changes" edge of the Task entity.
+func (t *Task) QueryFileChanges() *FileChangeQuery {
+	return NewTaskClient(t.config).QueryFileChanges(t)
+}
+
 // QueryAgent queries the "agent" edge of the Task entity.
 func (t *Task) QueryAgent() *AgentQuery {
 	return NewTaskClient(t.config).QueryAgent(t)
 }
 
+// QueryForkedFrom queries the "forked_from" edge of the Task entity.
+func (t *Task) QueryForkedFrom() *TaskQuery {
+	return NewTaskClient(t.config).QueryForkedFrom(t)
+}
+
+// QueryForks queries the "forks" edge of the Task entity.
+func (t *Task) QueryForks() *TaskQuery {
+	return NewTaskClient(t.config).QueryForks(t)
+}
+
 // Update returns a builder for updating this Task.
 // Note that you need to call Task.Unwrap() before calling this method if this Task
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -262,6 +380,12 @@ func (t *Task) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(t.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(t.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("team=")
+	builder.WriteString(t.Team)
+	builder.WriteString(", ")
 	builder.WriteString("project_directory=")
 	builder.WriteString(t.ProjectDirectory)
 	builder.WriteString(", ")
@@ -297,6 +421,24 @@ func (t *Task) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("agent_id=")
 	builder.WriteString(fmt.Sprintf("%v", t.AgentID))
+	builder.WriteString(", ")
+	builder.WriteString("review_edits=")
+	builder.WriteString(fmt.Sprintf("%v", t.ReviewEdits))
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", t.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("forked_from_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.ForkedFromID))
+	builder.WriteString(", ")
+	builder.WriteString("forked_from_message_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.ForkedFromMessageID))
+	builder.WriteString(", ")
+	builder.WriteString("lease_owner=")
+	builder.WriteString(t.LeaseOwner)
+	builder.WriteString(", ")
+	builder.WriteString("lease_expire_time=")
+	builder.WriteString(t.LeaseExpireTime.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -21,6 +21,10 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldTeam holds the string denoting the team field in the database.
+	FieldTeam = "team"
 	// FieldProjectDirectory holds the string denoting the project_directory field in the database.
 	FieldProjectDirectory = "project_directory"
 	// FieldInputTokens holds the string denoting the input_tokens field in the database.
@@ -45,10 +49,28 @@ const (
 	FieldDescription = "description"
 	// FieldAgentID holds the string denoting the agent_id field in the database.
 	FieldAgentID = "agent_id"
+	// FieldReviewEdits holds the string denoting the review_edits field in the database.
+	FieldReviewEdits = "review_edits"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldForkedFromID holds the string denoting the forked_from_id field in the database.
+	FieldForkedFromID = "forked_from_id"
+	// FieldForkedFromMessageID holds the string denoting the forked_from_message_id field in the database.
+	FieldForkedFromMessageID = "forked_from_message_id"
+	// FieldLeaseOwner holds the string denoting the lease_owner field in the database.
+	FieldLeaseOwner = "lease_owner"
+	// FieldLeaseExpireTime holds the string denoting the lease_expire_time field in the database.
+	FieldLeaseExpireTime = "lease_expire_time"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
 	EdgeMessages = "messages"
+	// EdgeFileChanges holds the string denoting the file_changes edge name in mutations.
+	EdgeFileChanges = "file_changes"
 	// EdgeAgent holds the string denoting the agent edge name in mutations.
 	EdgeAgent = "agent"
+	// EdgeForkedFrom holds the string denoting the forked_from edge name in mutations.
+	EdgeForkedFrom = "forked_from"
+	// EdgeForks holds the string denoting the forks edge name in mutations.
+	EdgeForks = "forks"
 	// Table holds the table name of the task in the database.
 	Table = "tasks"
 	// MessagesTable is the table that holds the messages relation/edge.
@@ -58,6 +80,13 @@ const (
 	MessagesInverseTable = "messages"
 	// MessagesColumn is the table column denoting the messages relation/edge.
 	MessagesColumn = "task_id"
+	// FileChangesTable is the table that holds the file_changes relation/edge.
+	FileChangesTable = "file_changes"
+	// FileChangesInverseTable is the table name for the FileChange entity.
+	// It exists in this package in order to avoid circular dependency with the "filechange" package.
+	FileChangesInverseTable = "file_changes"
+	// FileChangesColumn is the table column denoting the file_changes relation/edge.
+	FileChangesColumn = "task_id"
 	// AgentTable is the table that holds the agent relation/edge.
 	AgentTable = "tasks"
 	// AgentInverseTable is the table name for the Agent entity.
@@ -65,6 +94,14 @@ const (
 	AgentInverseTable = "agents"
 	// AgentColumn is the table column denoting the agent relation/edge.
 	AgentColumn = "agent_id"
+	// ForkedFromTable is the table that holds the forked_from relation/edge.
+	ForkedFromTable = "tasks"
+	// ForkedFromColumn is the table column denoting the forked_from relation/edge.
+	ForkedFromColumn = "forked_from_id"
+	// ForksTable is the table that holds the forks relation/edge.
+	ForksTable = "tasks"
+	// ForksColumn is the table column denoting the forks relation/edge.
+	ForksColumn = "forked_from_id"
 )
 
 // Columns holds all SQL columns for task fields.
@@ -72,6 +109,8 @@ var Columns = []string{
 	FieldID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldOwner,
+	FieldTeam,
 	FieldProjectDirectory,
 	FieldInputTokens,
 	FieldOutputTokens,
@@ -84,6 +123,12 @@ var Columns = []string{
 	FieldPhase,
 	FieldDescription,
 	FieldAgentID,
+	FieldReviewEdits,
+	FieldLabels,
+	FieldForkedFromID,
+	FieldForkedFromMessageID,
+	FieldLeaseOwner,
+	FieldLeaseExpireTime,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -107,6 +152,8 @@ var (
 	DefaultTurns int64
 	// DefaultToolUses holds the default value on creation for the "tool_uses" field.
 	DefaultToolUses map[string]int64
+	// DefaultReviewEdits holds the default value on creation for the "review_edits" field.
+	DefaultReviewEdits bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -153,6 +200,16 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByTeam orders the results by the team field.
+func ByTeam(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeam, opts...).ToFunc()
+}
+
 // ByProjectDirectory orders the results by the project_directory field.
 func ByProjectDirectory(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProjectDirectory, opts...).ToFunc()
@@ -208,6 +265,31 @@ func ByAgentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAgentID, opts...).ToFunc()
 }
 
+// ByReviewEdits orders the results by the review_edits field.
+func ByReviewEdits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewEdits, opts...).ToFunc()
+}
+
+// ByForkedFromID orders the results by the forked_from_id field.
+func ByForkedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForkedFromID, opts...).ToFunc()
+}
+
+// ByForkedFromMessageID orders the results by the forked_from_message_id field.
+func ByForkedFromMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForkedFromMessageID, opts...).ToFunc()
+}
+
+// ByLeaseOwner orders the results by the lease_owner field.
+func ByLeaseOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseOwner, opts...).ToFunc()
+}
+
+// ByLeaseExpireTime orders the results by the lease_expire_time field.
+func ByLeaseExpireTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseExpireTime, opts...).ToFunc()
+}
+
 // ByMessagesCount orders the results by messages count.
 func ByMessagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -222,12 +304,47 @@ func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByFileChangesCount orders the results by file_changes count.
+func ByFileChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFileChangesStep(), opts...)
+	}
+}
+
+// ByFileChanges orders the results by file_changes terms.
+func ByFileChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFileChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAgentField orders the results by agent field.
 func ByAgentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newAgentStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByForkedFromField orders the results by forked_from field.
+func ByForkedFromField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newForkedFromStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByForksCount orders the results by forks count.
+func ByForksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newForksStep(), opts...)
+	}
+}
+
+// ByForks orders the results by forks terms.
+func ByForks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newForksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMessagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -235,6 +352,13 @@ func newMessagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, MessagesTable, MessagesColumn),
 	)
 }
+func newFileChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FileChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, FileChangesTable, FileChangesColumn),
+	)
+}
 func newAgentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -242,3 +366,17 @@ func newAgentStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, AgentTable, AgentColumn),
 	)
 }
+func newForkedFromStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ForkedFromTable, ForkedFromColumn),
+	)
+}
+func newForksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ForksTable, ForksColumn),
+	)
+}
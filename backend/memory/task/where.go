@@ -67,6 +67,16 @@ func UpdateTime(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOwner, v))
+}
+
+// Team applies equality check predicate on the "team" field. It's identical to TeamEQ.
+func Team(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTeam, v))
+}
+
 // ProjectDirectory applies equality check predicate on the "project_directory" field. It's identical to ProjectDirectoryEQ.
 func ProjectDirectory(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldProjectDirectory, v))
@@ -112,6 +122,31 @@ func AgentID(v uuid.UUID) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldAgentID, v))
 }
 
+// ReviewEdits applies equality check predicate on the "review_edits" field. It's identical to ReviewEditsEQ.
+func ReviewEdits(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldReviewEdits, v))
+}
+
+// ForkedFromID applies equality check predicate on the "forked_from_id" field. It's identical to ForkedFromIDEQ.
+func ForkedFromID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldForkedFromID, v))
+}
+
+// ForkedFromMessageID applies equality check predicate on the "forked_from_message_id" field. It's identical to ForkedFromMessageIDEQ.
+func ForkedFromMessageID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldForkedFromMessageID, v))
+}
+
+// LeaseOwner applies equality check predicate on the "lease_owner" field. It's identical to LeaseOwnerEQ.
+func LeaseOwner(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldLeaseOwner, v))
+}
+
+// LeaseExpireTime applies equality check predicate on the "lease_expire_time" field. It's identical to LeaseExpireTimeEQ.
+func LeaseExpireTime(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldLeaseExpireTime, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreateTime, v))
@@ -192,6 +227,156 @@ func UpdateTimeLTE(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// TeamEQ applies the EQ predicate on the "team" field.
+func TeamEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTeam, v))
+}
+
+// TeamNEQ applies the NEQ predicate on the "team" field.
+func TeamNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldTeam, v))
+}
+
+// TeamIn applies the In predicate on the "team" field.
+func TeamIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldTeam, vs...))
+}
+
+// TeamNotIn applies the NotIn predicate on the "team" field.
+func TeamNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldTeam, vs...))
+}
+
+// TeamGT applies the GT predicate on the "team" field.
+func TeamGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldTeam, v))
+}
+
+// TeamGTE applies the GTE predicate on the "team" field.
+func TeamGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldTeam, v))
+}
+
+// TeamLT applies the LT predicate on the "team" field.
+func TeamLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldTeam, v))
+}
+
+// TeamLTE applies the LTE predicate on the "team" field.
+func TeamLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldTeam, v))
+}
+
+// TeamContains applies the Contains predicate on the "team" field.
+func TeamContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldTeam, v))
+}
+
+// TeamHasPrefix applies the HasPrefix predicate on the "team" field.
+func TeamHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldTeam, v))
+}
+
+// TeamHasSuffix applies the HasSuffix predicate on the "team" field.
+func TeamHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldTeam, v))
+}
+
+// TeamIsNil applies the IsNil predicate on the "team" field.
+func TeamIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldTeam))
+}
+
+// TeamNotNil applies the NotNil predicate on the "team" field.
+func TeamNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldTeam))
+}
+
+// TeamEqualFold applies the EqualFold predicate on the "team" field.
+func TeamEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldTeam, v))
+}
+
+// TeamContainsFold applies the ContainsFold predicate on the "team" field.
+func TeamContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldTeam, v))
+}
+
 // ProjectDirectoryEQ applies the EQ predicate on the "project_directory" field.
 func ProjectDirectoryEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldProjectDirectory, v))
@@ -722,6 +907,231 @@ func AgentIDNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldAgentID))
 }
 
+// ReviewEditsEQ applies the EQ predicate on the "review_edits" field.
+func ReviewEditsEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldReviewEdits, v))
+}
+
+// ReviewEditsNEQ applies the NEQ predicate on the "review_edits" field.
+func ReviewEditsNEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldReviewEdits, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldLabels))
+}
+
+// ForkedFromIDEQ applies the EQ predicate on the "forked_from_id" field.
+func ForkedFromIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldForkedFromID, v))
+}
+
+// ForkedFromIDNEQ applies the NEQ predicate on the "forked_from_id" field.
+func ForkedFromIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldForkedFromID, v))
+}
+
+// ForkedFromIDIn applies the In predicate on the "forked_from_id" field.
+func ForkedFromIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldForkedFromID, vs...))
+}
+
+// ForkedFromIDNotIn applies the NotIn predicate on the "forked_from_id" field.
+func ForkedFromIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldForkedFromID, vs...))
+}
+
+// ForkedFromIDIsNil applies the IsNil predicate on the "forked_from_id" field.
+func ForkedFromIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldForkedFromID))
+}
+
+// ForkedFromIDNotNil applies the NotNil predicate on the "forked_from_id" field.
+func ForkedFromIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldForkedFromID))
+}
+
+// ForkedFromMessageIDEQ applies the EQ predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldForkedFromMessageID, v))
+}
+
+// ForkedFromMessageIDNEQ applies the NEQ predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldForkedFromMessageID, v))
+}
+
+// ForkedFromMessageIDIn applies the In predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldForkedFromMessageID, vs...))
+}
+
+// ForkedFromMessageIDNotIn applies the NotIn predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldForkedFromMessageID, vs...))
+}
+
+// ForkedFromMessageIDGT applies the GT predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDGT(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldForkedFromMessageID, v))
+}
+
+// ForkedFromMessageIDGTE applies the GTE predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDGTE(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldForkedFromMessageID, v))
+}
+
+// ForkedFromMessageIDLT applies the LT predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDLT(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldForkedFromMessageID, v))
+}
+
+// ForkedFromMessageIDLTE applies the LTE predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDLTE(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldForkedFromMessageID, v))
+}
+
+// ForkedFromMessageIDIsNil applies the IsNil predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldForkedFromMessageID))
+}
+
+// ForkedFromMessageIDNotNil applies the NotNil predicate on the "forked_from_message_id" field.
+func ForkedFromMessageIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldForkedFromMessageID))
+}
+
+// LeaseOwnerEQ applies the EQ predicate on the "lease_owner" field.
+func LeaseOwnerEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerNEQ applies the NEQ predicate on the "lease_owner" field.
+func LeaseOwnerNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerIn applies the In predicate on the "lease_owner" field.
+func LeaseOwnerIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldLeaseOwner, vs...))
+}
+
+// LeaseOwnerNotIn applies the NotIn predicate on the "lease_owner" field.
+func LeaseOwnerNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldLeaseOwner, vs...))
+}
+
+// LeaseOwnerGT applies the GT predicate on the "lease_owner" field.
+func LeaseOwnerGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerGTE applies the GTE predicate on the "lease_owner" field.
+func LeaseOwnerGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerLT applies the LT predicate on the "lease_owner" field.
+func LeaseOwnerLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerLTE applies the LTE predicate on the "lease_owner" field.
+func LeaseOwnerLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerContains applies the Contains predicate on the "lease_owner" field.
+func LeaseOwnerContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerHasPrefix applies the HasPrefix predicate on the "lease_owner" field.
+func LeaseOwnerHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerHasSuffix applies the HasSuffix predicate on the "lease_owner" field.
+func LeaseOwnerHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerIsNil applies the IsNil predicate on the "lease_owner" field.
+func LeaseOwnerIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldLeaseOwner))
+}
+
+// LeaseOwnerNotNil applies the NotNil predicate on the "lease_owner" field.
+func LeaseOwnerNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldLeaseOwner))
+}
+
+// LeaseOwnerEqualFold applies the EqualFold predicate on the "lease_owner" field.
+func LeaseOwnerEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerContainsFold applies the ContainsFold predicate on the "lease_owner" field.
+func LeaseOwnerContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldLeaseOwner, v))
+}
+
+// LeaseExpireTimeEQ applies the EQ predicate on the "lease_expire_time" field.
+func LeaseExpireTimeEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldLeaseExpireTime, v))
+}
+
+// LeaseExpireTimeNEQ applies the NEQ predicate on the "lease_expire_time" field.
+func LeaseExpireTimeNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldLeaseExpireTime, v))
+}
+
+// LeaseExpireTimeIn applies the In predicate on the "lease_expire_time" field.
+func LeaseExpireTimeIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldLeaseExpireTime, vs...))
+}
+
+// LeaseExpireTimeNotIn applies the NotIn predicate on the "lease_expire_time" field.
+func LeaseExpireTimeNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldLeaseExpireTime, vs...))
+}
+
+// LeaseExpireTimeGT applies the GT predicate on the "lease_expire_time" field.
+func LeaseExpireTimeGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldLeaseExpireTime, v))
+}
+
+// LeaseExpireTimeGTE applies the GTE predicate on the "lease_expire_time" field.
+func LeaseExpireTimeGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldLeaseExpireTime, v))
+}
+
+// LeaseExpireTimeLT applies the LT predicate on the "lease_expire_time" field.
+func LeaseExpireTimeLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldLeaseExpireTime, v))
+}
+
+// LeaseExpireTimeLTE applies the LTE predicate on the "lease_expire_time" field.
+func LeaseExpireTimeLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldLeaseExpireTime, v))
+}
+
+// LeaseExpireTimeIsNil applies the IsNil predicate on the "lease_expire_time" field.
+func LeaseExpireTimeIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldLeaseExpireTime))
+}
+
+// LeaseExpireTimeNotNil applies the NotNil predicate on the "lease_expire_time" field.
+func LeaseExpireTimeNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldLeaseExpireTime))
+}
+
 // HasMessages applies the HasEdge predicate on the "messages" edge.
 func HasMessages() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -745,6 +1155,29 @@ func HasMessagesWith(preds ...predicate.Message) predicate.Task {
 	})
 }
 
+// HasFileChanges applies the HasEdge predicate on the "file_changes" edge.
+func HasFileChanges() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, FileChangesTable, FileChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFileChangesWith applies the HasEdge predicate on the "file_changes" edge with a given conditions (other predicates).
+func HasFileChangesWith(preds ...predicate.FileChange) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newFileChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAgent applies the HasEdge predicate on the "agent" edge.
 func HasAgent() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -768,6 +1201,52 @@ func HasAgentWith(preds ...predicate.Agent) predicate.Task {
 	})
 }
 
+// HasForkedFrom applies the HasEdge predicate on the "forked_from" edge.
+func HasForkedFrom() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ForkedFromTable, ForkedFromColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasForkedFromWith applies the HasEdge predicate on the "forked_from" edge with a given conditions (other predicates).
+func HasForkedFromWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newForkedFromStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasForks applies the HasEdge predicate on the "forks" edge.
+func HasForks() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ForksTable, ForksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasForksWith applies the HasEdge predicate on the "forks" edge with a given conditions (other predicates).
+func HasForksWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newForksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/filechange"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
@@ -52,6 +53,34 @@ func (tc *TaskCreate) SetNillableUpdateTime(t *time.Time) *TaskCreate {
 	return tc
 }
 
+// SetOwner sets the "owner" field.
+func (tc *TaskCreate) SetOwner(s string) *TaskCreate {
+	tc.mutation.SetOwner(s)
+	return tc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableOwner(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetOwner(*s)
+	}
+	return tc
+}
+
+// SetTeam sets the "team" field.
+func (tc *TaskCreate) SetTeam(s string) *TaskCreate {
+	tc.mutation.SetTeam(s)
+	return tc
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableTeam(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetTeam(*s)
+	}
+	return tc
+}
+
 // SetProjectDirectory sets the "project_directory" field.
 func (tc *TaskCreate) SetProjectDirectory(s string) *TaskCreate {
 	tc.mutation.SetProjectDirectory(s)
@@ -212,6 +241,82 @@ func (tc *TaskCreate) SetNillableAgentID(u *uuid.UUID) *TaskCreate {
 	return tc
 }
 
+// SetReviewEdits sets the "review_edits" field.
+func (tc *TaskCreate) SetReviewEdits(b bool) *TaskCreate {
+	tc.mutation.SetReviewEdits(b)
+	return tc
+}
+
+// SetNillableReviewEdits sets the "review_edits" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableReviewEdits(b *bool) *TaskCreate {
+	if b != nil {
+		tc.SetReviewEdits(*b)
+	}
+	return tc
+}
+
+// SetLabels sets the "labels" field.
+func (tc *TaskCreate) SetLabels(m map[string]string) *TaskCreate {
+	tc.mutation.SetLabels(m)
+	return tc
+}
+
+// SetForkedFromID sets the "forked_from_id" field.
+func (tc *TaskCreate) SetForkedFromID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetForkedFromID(u)
+	return tc
+}
+
+// SetNillableForkedFromID sets the "forked_from_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableForkedFromID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetForkedFromID(*u)
+	}
+	return tc
+}
+
+// SetForkedFromMessageID sets the "forked_from_message_id" field.
+func (tc *TaskCreate) SetForkedFromMessageID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetForkedFromMessageID(u)
+	return tc
+}
+
+// SetNillableForkedFromMessageID sets the "forked_from_message_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableForkedFromMessageID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetForkedFromMessageID(*u)
+	}
+	return tc
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (tc *TaskCreate) SetLeaseOwner(s string) *TaskCreate {
+	tc.mutation.SetLeaseOwner(s)
+	return tc
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableLeaseOwner(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetLeaseOwner(*s)
+	}
+	return tc
+}
+
+// SetLeaseExpireTime sets the "lease_expire_time" field.
+func (tc *TaskCreate) SetLeaseExpireTime(t time.Time) *TaskCreate {
+	tc.mutation.SetLeaseExpireTime(t)
+	return tc
+}
+
+// SetNillableLeaseExpireTime sets the "lease_expire_time" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableLeaseExpireTime(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetLeaseExpireTime(*t)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TaskCreate) SetID(u uuid.UUID) *TaskCreate {
 	tc.mutation.SetID(u)
@@ -241,11 +346,46 @@ func (tc *TaskCreate) AddMessages(m ...*Message) *TaskCreate {
 	return tc.AddMessageIDs(ids...)
 }
 
+// AddFileChangeIDs adds the "file_changes" edge to the FileChange entity by IDs.
+func (tc *TaskCreate) AddFileChangeIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddFileChangeIDs(ids...)
+	return tc
+}
+
+// AddFileChanges adds the "file_changes" edges to the FileChange entity.
+func (tc *TaskCreate) AddFileChanges(f ...*FileChange) *TaskCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return tc.AddFileChangeIDs(ids...)
+}
+
 // SetAgent sets the "agent" edge to the Agent entity.
 func (tc *TaskCreate) SetAgent(a *Agent) *TaskCreate {
 	return tc.SetAgentID(a.ID)
 }
 
+// SetForkedFrom sets the "forked_from" edge to the Task entity.
+func (tc *TaskCreate) SetForkedFrom(t *Task) *TaskCreate {
+	return tc.SetForkedFromID(t.ID)
+}
+
+// AddForkIDs adds the "forks" edge to the Task entity by IDs.
+func (tc *TaskCreate) AddForkIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddForkIDs(ids...)
+	return tc
+}
+
+// AddForks adds the "forks" edges to the Task entity.
+func (tc *TaskCreate) AddForks(t ...*Task) *TaskCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddForkIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tc *TaskCreate) Mutation() *TaskMutation {
 	return tc.mutation
@@ -305,6 +445,10 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultPhase
 		tc.mutation.SetPhase(v)
 	}
+	if _, ok := tc.mutation.ReviewEdits(); !ok {
+		v := task.DefaultReviewEdits
+		tc.mutation.SetReviewEdits(v)
+	}
 	if _, ok := tc.mutation.ID(); !ok {
 		v := task.DefaultID()
 		tc.mutation.SetID(v)
@@ -341,6 +485,9 @@ func (tc *TaskCreate) check() error {
 			return &ValidationError{Name: "phase", err: fmt.Errorf(`memory: validator failed for field "Task.phase": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.ReviewEdits(); !ok {
+		return &ValidationError{Name: "review_edits", err: errors.New(`memory: missing required field "Task.review_edits"`)}
+	}
 	return nil
 }
 
@@ -384,6 +531,14 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := tc.mutation.Owner(); ok {
+		_spec.SetField(task.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := tc.mutation.Team(); ok {
+		_spec.SetField(task.FieldTeam, field.TypeString, value)
+		_node.Team = value
+	}
 	if value, ok := tc.mutation.ProjectDirectory(); ok {
 		_spec.SetField(task.FieldProjectDirectory, field.TypeString, value)
 		_node.ProjectDirectory = value
@@ -428,6 +583,26 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := tc.mutation.ReviewEdits(); ok {
+		_spec.SetField(task.FieldReviewEdits, field.TypeBool, value)
+		_node.ReviewEdits = value
+	}
+	if value, ok := tc.mutation.Labels(); ok {
+		_spec.SetField(task.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := tc.mutation.ForkedFromMessageID(); ok {
+		_spec.SetField(task.FieldForkedFromMessageID, field.TypeUUID, value)
+		_node.ForkedFromMessageID = value
+	}
+	if value, ok := tc.mutation.LeaseOwner(); ok {
+		_spec.SetField(task.FieldLeaseOwner, field.TypeString, value)
+		_node.LeaseOwner = value
+	}
+	if value, ok := tc.mutation.LeaseExpireTime(); ok {
+		_spec.SetField(task.FieldLeaseExpireTime, field.TypeTime, value)
+		_node.LeaseExpireTime = value
+	}
 	if nodes := tc.mutation.MessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -444,6 +619,22 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.FileChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.FileChangesTable,
+			Columns: []string{task.FileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tc.mutation.AgentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -461,6 +652,39 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_node.AgentID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.ForkedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ForkedFromTable,
+			Columns: []string{task.ForkedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ForkedFromID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ForksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ForksTable,
+			Columns: []string{task.ForksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/filechange"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/task"
@@ -22,13 +23,16 @@ import (
 // TaskQuery is the builder for querying Task entities.
 type TaskQuery struct {
 	config
-	ctx          *QueryContext
-	order        []task.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Task
-	withMessages *MessageQuery
-	withAgent    *AgentQuery
-	modifiers    []func(*sql.Selector)
+	ctx             *QueryContext
+	order           []task.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Task
+	withMessages    *MessageQuery
+	withFileChanges *FileChangeQuery
+	withAgent       *AgentQuery
+	withForkedFrom  *TaskQuery
+	withForks       *TaskQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +91,28 @@ func (tq *TaskQuery) QueryMessages() *MessageQuery {
 	return query
 }
 
+// QueryFileChanges chains the current query on the "file_changes" edge.
+func (tq *TaskQuery) QueryFileChanges() *FileChangeQuery {
+	query := (&FileChangeClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(filechange.Table, filechange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, task.FileChangesTable, task.FileChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAgent chains the current query on the "agent" edge.
 func (tq *TaskQuery) QueryAgent() *AgentQuery {
 	query := (&AgentClient{config: tq.config}).Query()
@@ -109,6 +135,50 @@ func (tq *TaskQuery) QueryAgent() *AgentQuery {
 	return query
 }
 
+// QueryForkedFrom chains the current query on the "forked_from" edge.
+func (tq *TaskQuery) QueryForkedFrom() *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.ForkedFromTable, task.ForkedFromColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryForks chains the current query on the "forks" edge.
+func (tq *TaskQuery) QueryForks() *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ForksTable, task.ForksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Task entity from the query.
 // Returns a *NotFoundError when no Task was found.
 func (tq *TaskQuery) First(ctx context.Context) (*Task, error) {
@@ -296,13 +366,16 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		return nil
 	}
 	return &TaskQuery{
-		config:       tq.config,
-		ctx:          tq.ctx.Clone(),
-		order:        append([]task.OrderOption{}, tq.order...),
-		inters:       append([]Interceptor{}, tq.inters...),
-		predicates:   append([]predicate.Task{}, tq.predicates...),
-		withMessages: tq.withMessages.Clone(),
-		withAgent:    tq.withAgent.Clone(),
+		config:          tq.config,
+		ctx:             tq.ctx.Clone(),
+		order:           append([]task.OrderOption{}, tq.order...),
+		inters:          append([]Interceptor{}, tq.inters...),
+		predicates:      append([]predicate.Task{}, tq.predicates...),
+		withMessages:    tq.withMessages.Clone(),
+		withFileChanges: tq.withFileChanges.Clone(),
+		withAgent:       tq.withAgent.Clone(),
+		withForkedFrom:  tq.withForkedFrom.Clone(),
+		withForks:       tq.withForks.Clone(),
 		// clone intermediate query.
 		sql:       tq.sql.Clone(),
 		path:      tq.path,
@@ -321,6 +394,17 @@ func (tq *TaskQuery) WithMessages(opts ...func(*MessageQuery)) *TaskQuery {
 	return tq
 }
 
+// WithFileChanges tells the query-builder to eager-load the nodes that are connected to
+// the "file_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithFileChanges(opts ...func(*FileChangeQuery)) *TaskQuery {
+	query := (&FileChangeClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withFileChanges = query
+	return tq
+}
+
 // WithAgent tells the query-builder to eager-load the nodes that are connected to
 // the "agent" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TaskQuery) WithAgent(opts ...func(*AgentQuery)) *TaskQuery {
@@ -332,6 +416,28 @@ func (tq *TaskQuery) WithAgent(opts ...func(*AgentQuery)) *TaskQuery {
 	return tq
 }
 
+// WithForkedFrom tells the query-builder to eager-load the nodes that are connected to
+// the "forked_from" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithForkedFrom(opts ...func(*TaskQuery)) *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withForkedFrom = query
+	return tq
+}
+
+// WithForks tells the query-builder to eager-load the nodes that are connected to
+// the "forks" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithForks(opts ...func(*TaskQuery)) *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withForks = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,9 +516,12 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 	var (
 		nodes       = []*Task{}
 		_spec       = tq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			tq.withMessages != nil,
+			tq.withFileChanges != nil,
 			tq.withAgent != nil,
+			tq.withForkedFrom != nil,
+			tq.withForks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,12 +552,32 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 			return nil, err
 		}
 	}
+	if query := tq.withFileChanges; query != nil {
+		if err := tq.loadFileChanges(ctx, query, nodes,
+			func(n *Task) { n.Edges.FileChanges = []*FileChange{} },
+			func(n *Task, e *FileChange) { n.Edges.FileChanges = append(n.Edges.FileChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tq.withAgent; query != nil {
 		if err := tq.loadAgent(ctx, query, nodes, nil,
 			func(n *Task, e *Agent) { n.Edges.Agent = e }); err != nil {
 			return nil, err
 		}
 	}
+	if query := tq.withForkedFrom; query != nil {
+		if err := tq.loadForkedFrom(ctx, query, nodes, nil,
+			func(n *Task, e *Task) { n.Edges.ForkedFrom = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withForks; query != nil {
+		if err := tq.loadForks(ctx, query, nodes,
+			func(n *Task) { n.Edges.Forks = []*Task{} },
+			func(n *Task, e *Task) { n.Edges.Forks = append(n.Edges.Forks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -482,6 +611,36 @@ func (tq *TaskQuery) loadMessages(ctx context.Context, query *MessageQuery, node
 	}
 	return nil
 }
+func (tq *TaskQuery) loadFileChanges(ctx context.Context, query *FileChangeQuery, nodes []*Task, init func(*Task), assign func(*Task, *FileChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(filechange.FieldTaskID)
+	}
+	query.Where(predicate.FileChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.FileChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (tq *TaskQuery) loadAgent(ctx context.Context, query *AgentQuery, nodes []*Task, init func(*Task), assign func(*Task, *Agent)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Task)
@@ -511,6 +670,65 @@ func (tq *TaskQuery) loadAgent(ctx context.Context, query *AgentQuery, nodes []*
 	}
 	return nil
 }
+func (tq *TaskQuery) loadForkedFrom(ctx context.Context, query *TaskQuery, nodes []*Task, init func(*Task), assign func(*Task, *Task)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Task)
+	for i := range nodes {
+		fk := nodes[i].ForkedFromID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "forked_from_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TaskQuery) loadForks(ctx context.Context, query *TaskQuery, nodes []*Task, init func(*Task), assign func(*Task, *Task)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(task.FieldForkedFromID)
+	}
+	query.Where(predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.ForksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ForkedFromID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "forked_from_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
@@ -543,6 +761,9 @@ func (tq *TaskQuery) querySpec() *sqlgraph.QuerySpec {
 		if tq.withAgent != nil {
 			_spec.Node.AddColumnOnce(task.FieldAgentID)
 		}
+		if tq.withForkedFrom != nil {
+			_spec.Node.AddColumnOnce(task.FieldForkedFromID)
+		}
 	}
 	if ps := tq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/filechange"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/schema/types"
@@ -39,6 +40,46 @@ func (tu *TaskUpdate) SetUpdateTime(t time.Time) *TaskUpdate {
 	return tu
 }
 
+// SetOwner sets the "owner" field.
+func (tu *TaskUpdate) SetOwner(s string) *TaskUpdate {
+	tu.mutation.SetOwner(s)
+	return tu
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableOwner(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetOwner(*s)
+	}
+	return tu
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (tu *TaskUpdate) ClearOwner() *TaskUpdate {
+	tu.mutation.ClearOwner()
+	return tu
+}
+
+// SetTeam sets the "team" field.
+func (tu *TaskUpdate) SetTeam(s string) *TaskUpdate {
+	tu.mutation.SetTeam(s)
+	return tu
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableTeam(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetTeam(*s)
+	}
+	return tu
+}
+
+// ClearTeam clears the value of the "team" field.
+func (tu *TaskUpdate) ClearTeam() *TaskUpdate {
+	tu.mutation.ClearTeam()
+	return tu
+}
+
 // SetProjectDirectory sets the "project_directory" field.
 func (tu *TaskUpdate) SetProjectDirectory(s string) *TaskUpdate {
 	tu.mutation.SetProjectDirectory(s)
@@ -289,6 +330,112 @@ func (tu *TaskUpdate) ClearAgentID() *TaskUpdate {
 	return tu
 }
 
+// SetReviewEdits sets the "review_edits" field.
+func (tu *TaskUpdate) SetReviewEdits(b bool) *TaskUpdate {
+	tu.mutation.SetReviewEdits(b)
+	return tu
+}
+
+// SetNillableReviewEdits sets the "review_edits" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableReviewEdits(b *bool) *TaskUpdate {
+	if b != nil {
+		tu.SetReviewEdits(*b)
+	}
+	return tu
+}
+
+// SetLabels sets the "labels" field.
+func (tu *TaskUpdate) SetLabels(m map[string]string) *TaskUpdate {
+	tu.mutation.SetLabels(m)
+	return tu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (tu *TaskUpdate) ClearLabels() *TaskUpdate {
+	tu.mutation.ClearLabels()
+	return tu
+}
+
+// SetForkedFromID sets the "forked_from_id" field.
+func (tu *TaskUpdate) SetForkedFromID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetForkedFromID(u)
+	return tu
+}
+
+// SetNillableForkedFromID sets the "forked_from_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableForkedFromID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetForkedFromID(*u)
+	}
+	return tu
+}
+
+// ClearForkedFromID clears the value of the "forked_from_id" field.
+func (tu *TaskUpdate) ClearForkedFromID() *TaskUpdate {
+	tu.mutation.ClearForkedFromID()
+	return tu
+}
+
+// SetForkedFromMessageID sets the "forked_from_message_id" field.
+func (tu *TaskUpdate) SetForkedFromMessageID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetForkedFromMessageID(u)
+	return tu
+}
+
+// SetNillableForkedFromMessageID sets the "forked_from_message_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableForkedFromMessageID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetForkedFromMessageID(*u)
+	}
+	return tu
+}
+
+// ClearForkedFromMessageID clears the value of the "forked_from_message_id" field.
+func (tu *TaskUpdate) ClearForkedFromMessageID() *TaskUpdate {
+	tu.mutation.ClearForkedFromMessageID()
+	return tu
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (tu *TaskUpdate) SetLeaseOwner(s string) *TaskUpdate {
+	tu.mutation.SetLeaseOwner(s)
+	return tu
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableLeaseOwner(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetLeaseOwner(*s)
+	}
+	return tu
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (tu *TaskUpdate) ClearLeaseOwner() *TaskUpdate {
+	tu.mutation.ClearLeaseOwner()
+	return tu
+}
+
+// SetLeaseExpireTime sets the "lease_expire_time" field.
+func (tu *TaskUpdate) SetLeaseExpireTime(t time.Time) *TaskUpdate {
+	tu.mutation.SetLeaseExpireTime(t)
+	return tu
+}
+
+// SetNillableLeaseExpireTime sets the "lease_expire_time" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableLeaseExpireTime(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetLeaseExpireTime(*t)
+	}
+	return tu
+}
+
+// ClearLeaseExpireTime clears the value of the "lease_expire_time" field.
+func (tu *TaskUpdate) ClearLeaseExpireTime() *TaskUpdate {
+	tu.mutation.ClearLeaseExpireTime()
+	return tu
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (tu *TaskUpdate) AddMessageIDs(ids ...uuid.UUID) *TaskUpdate {
 	tu.mutation.AddMessageIDs(ids...)
@@ -304,11 +451,46 @@ func (tu *TaskUpdate) AddMessages(m ...*Message) *TaskUpdate {
 	return tu.AddMessageIDs(ids...)
 }
 
+// AddFileChangeIDs adds the "file_changes" edge to the FileChange entity by IDs.
+func (tu *TaskUpdate) AddFileChangeIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddFileChangeIDs(ids...)
+	return tu
+}
+
+// AddFileChanges adds the "file_changes" edges to the FileChange entity.
+func (tu *TaskUpdate) AddFileChanges(f ...*FileChange) *TaskUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return tu.AddFileChangeIDs(ids...)
+}
+
 // SetAgent sets the "agent" edge to the Agent entity.
 func (tu *TaskUpdate) SetAgent(a *Agent) *TaskUpdate {
 	return tu.SetAgentID(a.ID)
 }
 
+// SetForkedFrom sets the "forked_from" edge to the Task entity.
+func (tu *TaskUpdate) SetForkedFrom(t *Task) *TaskUpdate {
+	return tu.SetForkedFromID(t.ID)
+}
+
+// AddForkIDs adds the "forks" edge to the Task entity by IDs.
+func (tu *TaskUpdate) AddForkIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddForkIDs(ids...)
+	return tu
+}
+
+// AddForks adds the "forks" edges to the Task entity.
+func (tu *TaskUpdate) AddForks(t ...*Task) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddForkIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tu *TaskUpdate) Mutation() *TaskMutation {
 	return tu.mutation
@@ -335,12 +517,60 @@ func (tu *TaskUpdate) RemoveMessages(m ...*Message) *TaskUpdate {
 	return tu.RemoveMessageIDs(ids...)
 }
 
+// ClearFileChanges clears all "file_changes" edges to the FileChange entity.
+func (tu *TaskUpdate) ClearFileChanges() *TaskUpdate {
+	tu.mutation.ClearFileChanges()
+	return tu
+}
+
+// RemoveFileChangeIDs removes the "file_changes" edge to FileChange entities by IDs.
+func (tu *TaskUpdate) RemoveFileChangeIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveFileChangeIDs(ids...)
+	return tu
+}
+
+// RemoveFileChanges removes "file_changes" edges to FileChange entities.
+func (tu *TaskUpdate) RemoveFileChanges(f ...*FileChange) *TaskUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return tu.RemoveFileChangeIDs(ids...)
+}
+
 // ClearAgent clears the "agent" edge to the Agent entity.
 func (tu *TaskUpdate) ClearAgent() *TaskUpdate {
 	tu.mutation.ClearAgent()
 	return tu
 }
 
+// ClearForkedFrom clears the "forked_from" edge to the Task entity.
+func (tu *TaskUpdate) ClearForkedFrom() *TaskUpdate {
+	tu.mutation.ClearForkedFrom()
+	return tu
+}
+
+// ClearForks clears all "forks" edges to the Task entity.
+func (tu *TaskUpdate) ClearForks() *TaskUpdate {
+	tu.mutation.ClearForks()
+	return tu
+}
+
+// RemoveForkIDs removes the "forks" edge to Task entities by IDs.
+func (tu *TaskUpdate) RemoveForkIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveForkIDs(ids...)
+	return tu
+}
+
+// RemoveForks removes "forks" edges to Task entities.
+func (tu *TaskUpdate) RemoveForks(t ...*Task) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveForkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
 	tu.defaults()
@@ -413,6 +643,18 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.UpdateTime(); ok {
 		_spec.SetField(task.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := tu.mutation.Owner(); ok {
+		_spec.SetField(task.FieldOwner, field.TypeString, value)
+	}
+	if tu.mutation.OwnerCleared() {
+		_spec.ClearField(task.FieldOwner, field.TypeString)
+	}
+	if value, ok := tu.mutation.Team(); ok {
+		_spec.SetField(task.FieldTeam, field.TypeString, value)
+	}
+	if tu.mutation.TeamCleared() {
+		_spec.ClearField(task.FieldTeam, field.TypeString)
+	}
 	if value, ok := tu.mutation.ProjectDirectory(); ok {
 		_spec.SetField(task.FieldProjectDirectory, field.TypeString, value)
 	}
@@ -485,6 +727,33 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.DescriptionCleared() {
 		_spec.ClearField(task.FieldDescription, field.TypeString)
 	}
+	if value, ok := tu.mutation.ReviewEdits(); ok {
+		_spec.SetField(task.FieldReviewEdits, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.Labels(); ok {
+		_spec.SetField(task.FieldLabels, field.TypeJSON, value)
+	}
+	if tu.mutation.LabelsCleared() {
+		_spec.ClearField(task.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.ForkedFromMessageID(); ok {
+		_spec.SetField(task.FieldForkedFromMessageID, field.TypeUUID, value)
+	}
+	if tu.mutation.ForkedFromMessageIDCleared() {
+		_spec.ClearField(task.FieldForkedFromMessageID, field.TypeUUID)
+	}
+	if value, ok := tu.mutation.LeaseOwner(); ok {
+		_spec.SetField(task.FieldLeaseOwner, field.TypeString, value)
+	}
+	if tu.mutation.LeaseOwnerCleared() {
+		_spec.ClearField(task.FieldLeaseOwner, field.TypeString)
+	}
+	if value, ok := tu.mutation.LeaseExpireTime(); ok {
+		_spec.SetField(task.FieldLeaseExpireTime, field.TypeTime, value)
+	}
+	if tu.mutation.LeaseExpireTimeCleared() {
+		_spec.ClearField(task.FieldLeaseExpireTime, field.TypeTime)
+	}
 	if tu.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -530,6 +799,51 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.FileChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.FileChangesTable,
+			Columns: []string{task.FileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filechange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedFileChangesIDs(); len(nodes) > 0 && !tu.mutation.FileChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.FileChangesTable,
+			Columns: []string{task.FileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.FileChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.FileChangesTable,
+			Columns: []string{task.FileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tu.mutation.AgentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -559,6 +873,80 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.ForkedFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ForkedFromTable,
+			Columns: []string{task.ForkedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ForkedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ForkedFromTable,
+			Columns: []string{task.ForkedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ForksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ForksTable,
+			Columns: []string{task.ForksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedForksIDs(); len(nodes) > 0 && !tu.mutation.ForksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ForksTable,
+			Columns: []string{task.ForksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ForksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ForksTable,
+			Columns: []string{task.ForksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(tu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -587,6 +975,46 @@ func (tuo *TaskUpdateOne) SetUpdateTime(t time.Time) *TaskUpdateOne {
 	return tuo
 }
 
+// SetOwner sets the "owner" field.
+func (tuo *TaskUpdateOne) SetOwner(s string) *TaskUpdateOne {
+	tuo.mutation.SetOwner(s)
+	return tuo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableOwner(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetOwner(*s)
+	}
+	return tuo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (tuo *TaskUpdateOne) ClearOwner() *TaskUpdateOne {
+	tuo.mutation.ClearOwner()
+	return tuo
+}
+
+// SetTeam sets the "team" field.
+func (tuo *TaskUpdateOne) SetTeam(s string) *TaskUpdateOne {
+	tuo.mutation.SetTeam(s)
+	return tuo
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableTeam(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetTeam(*s)
+	}
+	return tuo
+}
+
+// ClearTeam clears the value of the "team" field.
+func (tuo *TaskUpdateOne) ClearTeam() *TaskUpdateOne {
+	tuo.mutation.ClearTeam()
+	return tuo
+}
+
 // SetProjectDirectory sets the "project_directory" field.
 func (tuo *TaskUpdateOne) SetProjectDirectory(s string) *TaskUpdateOne {
 	tuo.mutation.SetProjectDirectory(s)
@@ -837,6 +1265,112 @@ func (tuo *TaskUpdateOne) ClearAgentID() *TaskUpdateOne {
 	return tuo
 }
 
+// SetReviewEdits sets the "review_edits" field.
+func (tuo *TaskUpdateOne) SetReviewEdits(b bool) *TaskUpdateOne {
+	tuo.mutation.SetReviewEdits(b)
+	return tuo
+}
+
+// SetNillableReviewEdits sets the "review_edits" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableReviewEdits(b *bool) *TaskUpdateOne {
+	if b != nil {
+		tuo.SetReviewEdits(*b)
+	}
+	return tuo
+}
+
+// SetLabels sets the "labels" field.
+func (tuo *TaskUpdateOne) SetLabels(m map[string]string) *TaskUpdateOne {
+	tuo.mutation.SetLabels(m)
+	return tuo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (tuo *TaskUpdateOne) ClearLabels() *TaskUpdateOne {
+	tuo.mutation.ClearLabels()
+	return tuo
+}
+
+// SetForkedFromID sets the "forked_from_id" field.
+func (tuo *TaskUpdateOne) SetForkedFromID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetForkedFromID(u)
+	return tuo
+}
+
+// SetNillableForkedFromID sets the "forked_from_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableForkedFromID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetForkedFromID(*u)
+	}
+	return tuo
+}
+
+// ClearForkedFromID clears the value of the "forked_from_id" field.
+func (tuo *TaskUpdateOne) ClearForkedFromID() *TaskUpdateOne {
+	tuo.mutation.ClearForkedFromID()
+	return tuo
+}
+
+// SetForkedFromMessageID sets the "forked_from_message_id" field.
+func (tuo *TaskUpdateOne) SetForkedFromMessageID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetForkedFromMessageID(u)
+	return tuo
+}
+
+// SetNillableForkedFromMessageID sets the "forked_from_message_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableForkedFromMessageID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetForkedFromMessageID(*u)
+	}
+	return tuo
+}
+
+// ClearForkedFromMessageID clears the value of the "forked_from_message_id" field.
+func (tuo *TaskUpdateOne) ClearForkedFromMessageID() *TaskUpdateOne {
+	tuo.mutation.ClearForkedFromMessageID()
+	return tuo
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (tuo *TaskUpdateOne) SetLeaseOwner(s string) *TaskUpdateOne {
+	tuo.mutation.SetLeaseOwner(s)
+	return tuo
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableLeaseOwner(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetLeaseOwner(*s)
+	}
+	return tuo
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (tuo *TaskUpdateOne) ClearLeaseOwner() *TaskUpdateOne {
+	tuo.mutation.ClearLeaseOwner()
+	return tuo
+}
+
+// SetLeaseExpireTime sets the "lease_expire_time" field.
+func (tuo *TaskUpdateOne) SetLeaseExpireTime(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetLeaseExpireTime(t)
+	return tuo
+}
+
+// SetNillableLeaseExpireTime sets the "lease_expire_time" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableLeaseExpireTime(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetLeaseExpireTime(*t)
+	}
+	return tuo
+}
+
+// ClearLeaseExpireTime clears the value of the "lease_expire_time" field.
+func (tuo *TaskUpdateOne) ClearLeaseExpireTime() *TaskUpdateOne {
+	tuo.mutation.ClearLeaseExpireTime()
+	return tuo
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (tuo *TaskUpdateOne) AddMessageIDs(ids ...uuid.UUID) *TaskUpdateOne {
 	tuo.mutation.AddMessageIDs(ids...)
@@ -852,11 +1386,46 @@ func (tuo *TaskUpdateOne) AddMessages(m ...*Message) *TaskUpdateOne {
 	return tuo.AddMessageIDs(ids...)
 }
 
+// AddFileChangeIDs adds the "file_changes" edge to the FileChange entity by IDs.
+func (tuo *TaskUpdateOne) AddFileChangeIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddFileChangeIDs(ids...)
+	return tuo
+}
+
+// AddFileChanges adds the "file_changes" edges to the FileChange entity.
+func (tuo *TaskUpdateOne) AddFileChanges(f ...*FileChange) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return tuo.AddFileChangeIDs(ids...)
+}
+
 // SetAgent sets the "agent" edge to the Agent entity.
 func (tuo *TaskUpdateOne) SetAgent(a *Agent) *TaskUpdateOne {
 	return tuo.SetAgentID(a.ID)
 }
 
+// SetForkedFrom sets the "forked_from" edge to the Task entity.
+func (tuo *TaskUpdateOne) SetForkedFrom(t *Task) *TaskUpdateOne {
+	return tuo.SetForkedFromID(t.ID)
+}
+
+// AddForkIDs adds the "forks" edge to the Task entity by IDs.
+func (tuo *TaskUpdateOne) AddForkIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddForkIDs(ids...)
+	return tuo
+}
+
+// AddForks adds the "forks" edges to the Task entity.
+func (tuo *TaskUpdateOne) AddForks(t ...*Task) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddForkIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
 	return tuo.mutation
@@ -883,12 +1452,60 @@ func (tuo *TaskUpdateOne) RemoveMessages(m ...*Message) *TaskUpdateOne {
 	return tuo.RemoveMessageIDs(ids...)
 }
 
+// ClearFileChanges clears all "file_changes" edges to the FileChange entity.
+func (tuo *TaskUpdateOne) ClearFileChanges() *TaskUpdateOne {
+	tuo.mutation.ClearFileChanges()
+	return tuo
+}
+
+// RemoveFileChangeIDs removes the "file_changes" edge to FileChange entities by IDs.
+func (tuo *TaskUpdateOne) RemoveFileChangeIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveFileChangeIDs(ids...)
+	return tuo
+}
+
+// RemoveFileChanges removes "file_changes" edges to FileChange entities.
+func (tuo *TaskUpdateOne) RemoveFileChanges(f ...*FileChange) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return tuo.RemoveFileChangeIDs(ids...)
+}
+
 // ClearAgent clears the "agent" edge to the Agent entity.
 func (tuo *TaskUpdateOne) ClearAgent() *TaskUpdateOne {
 	tuo.mutation.ClearAgent()
 	return tuo
 }
 
+// ClearForkedFrom clears the "forked_from" edge to the Task entity.
+func (tuo *TaskUpdateOne) ClearForkedFrom() *TaskUpdateOne {
+	tuo.mutation.ClearForkedFrom()
+	return tuo
+}
+
+// ClearForks clears all "forks" edges to the Task entity.
+func (tuo *TaskUpdateOne) ClearForks() *TaskUpdateOne {
+	tuo.mutation.ClearForks()
+	return tuo
+}
+
+// RemoveForkIDs removes the "forks" edge to Task entities by IDs.
+func (tuo *TaskUpdateOne) RemoveForkIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveForkIDs(ids...)
+	return tuo
+}
+
+// RemoveForks removes "forks" edges to Task entities.
+func (tuo *TaskUpdateOne) RemoveForks(t ...*Task) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveForkIDs(ids...)
+}
+
 // Where appends a list predicates to the TaskUpdate builder.
 func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -991,6 +1608,18 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if value, ok := tuo.mutation.UpdateTime(); ok {
 		_spec.SetField(task.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := tuo.mutation.Owner(); ok {
+		_spec.SetField(task.FieldOwner, field.TypeString, value)
+	}
+	if tuo.mutation.OwnerCleared() {
+		_spec.ClearField(task.FieldOwner, field.TypeString)
+	}
+	if value, ok := tuo.mutation.Team(); ok {
+		_spec.SetField(task.FieldTeam, field.TypeString, value)
+	}
+	if tuo.mutation.TeamCleared() {
+		_spec.ClearField(task.FieldTeam, field.TypeString)
+	}
 	if value, ok := tuo.mutation.ProjectDirectory(); ok {
 		_spec.SetField(task.FieldProjectDirectory, field.TypeString, value)
 	}
@@ -1063,6 +1692,33 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.DescriptionCleared() {
 		_spec.ClearField(task.FieldDescription, field.TypeString)
 	}
+	if value, ok := tuo.mutation.ReviewEdits(); ok {
+		_spec.SetField(task.FieldReviewEdits, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.Labels(); ok {
+		_spec.SetField(task.FieldLabels, field.TypeJSON, value)
+	}
+	if tuo.mutation.LabelsCleared() {
+		_spec.ClearField(task.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.ForkedFromMessageID(); ok {
+		_spec.SetField(task.FieldForkedFromMessageID, field.TypeUUID, value)
+	}
+	if tuo.mutation.ForkedFromMessageIDCleared() {
+		_spec.ClearField(task.FieldForkedFromMessageID, field.TypeUUID)
+	}
+	if value, ok := tuo.mutation.LeaseOwner(); ok {
+		_spec.SetField(task.FieldLeaseOwner, field.TypeString, value)
+	}
+	if tuo.mutation.LeaseOwnerCleared() {
+		_spec.ClearField(task.FieldLeaseOwner, field.TypeString)
+	}
+	if value, ok := tuo.mutation.LeaseExpireTime(); ok {
+		_spec.SetField(task.FieldLeaseExpireTime, field.TypeTime, value)
+	}
+	if tuo.mutation.LeaseExpireTimeCleared() {
+		_spec.ClearField(task.FieldLeaseExpireTime, field.TypeTime)
+	}
 	if tuo.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1108,6 +1764,51 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.FileChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.FileChangesTable,
+			Columns: []string{task.FileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filechange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedFileChangesIDs(); len(nodes) > 0 && !tuo.mutation.FileChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.FileChangesTable,
+			Columns: []string{task.FileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.FileChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.FileChangesTable,
+			Columns: []string{task.FileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tuo.mutation.AgentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1137,6 +1838,80 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.ForkedFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ForkedFromTable,
+			Columns: []string{task.ForkedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ForkedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ForkedFromTable,
+			Columns: []string{task.ForkedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ForksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ForksTable,
+			Columns: []string{task.ForksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedForksIDs(); len(nodes) > 0 && !tuo.mutation.ForksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ForksTable,
+			Columns: []string{task.ForksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ForksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ForksTable,
+			Columns: []string{task.ForksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Task{config: tuo.config}
 	_spec.Assign = _node.assignValues
@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/schema/types"
@@ -65,6 +66,13 @@ func (b *ModelProviderBuilder) WithProviderType(providerType types.ModelProvider
 	return b
 }
 
+// WithSecret sets the stored secret. The secret is stored as is, so callers have to
+// encrypt it themselves if it is read back through the encryption client.
+func (b *ModelProviderBuilder) WithSecret(secret []byte) *ModelProviderBuilder {
+	b.secret = secret
+	return b
+}
+
 func (b *ModelProviderBuilder) WithEnabled(enabled bool) *ModelProviderBuilder {
 	b.enabled = enabled
 	return b
@@ -168,6 +176,7 @@ type AgentBuilder struct {
 	description  string
 	defaultModel uuid.UUID
 	instructions string
+	tools        []string
 }
 
 func NewAgentBuilder(t *testing.T, id uuid.UUID, db *memory.Client, defaultModel *memory.Model) *AgentBuilder {
@@ -205,14 +214,23 @@ func (b *AgentBuilder) WithInstructions(instructions string) *AgentBuilder {
 	return b
 }
 
+func (b *AgentBuilder) WithTools(tools ...string) *AgentBuilder {
+	b.tools = tools
+	return b
+}
+
 func (b *AgentBuilder) Build(ctx context.Context) *memory.Agent {
-	agent, err := b.db.Agent.Create().
+	create := b.db.Agent.Create().
 		SetID(b.agentID).
 		SetName(b.name).
 		SetDescription(b.description).
 		SetModelID(b.defaultModel).
-		SetInstructions(b.instructions).
-		Save(ctx)
+		SetInstructions(b.instructions)
+	if len(b.tools) > 0 {
+		create.SetTools(b.tools)
+	}
+
+	agent, err := create.Save(ctx)
 
 	if err != nil {
 		b.t.Fatalf("failed to create agent: %v", err)
@@ -226,6 +244,7 @@ type TaskBuilder struct {
 	taskID uuid.UUID
 
 	agentID uuid.UUID
+	owner   string
 }
 
 func NewTaskBuilder(t *testing.T, id uuid.UUID, db *memory.Client, agent *memory.Agent) *TaskBuilder {
@@ -241,10 +260,16 @@ func (b *TaskBuilder) WithID(id uuid.UUID) *TaskBuilder {
 	return b
 }
 
+func (b *TaskBuilder) WithOwner(owner string) *TaskBuilder {
+	b.owner = owner
+	return b
+}
+
 func (b *TaskBuilder) Build(ctx context.Context) *memory.Task {
 	task, err := b.db.Task.Create().
 		SetID(b.taskID).
 		SetAgentID(b.agentID).
+		SetOwner(b.owner).
 		Save(ctx)
 
 	if err != nil {
@@ -325,3 +350,330 @@ func (b *MessageBuilder) Build(ctx context.Context) *memory.Message {
 
 	return message
 }
+
+type ScheduleBuilder struct {
+	*entityBuilder
+	scheduleID uuid.UUID
+
+	agentID        uuid.UUID
+	name           string
+	cronExpression string
+	promptTemplate string
+	workspace      string
+	overlapPolicy  types.ScheduleOverlapPolicy
+	catchUpPolicy  types.ScheduleCatchUpPolicy
+	enabled        bool
+	nextRunTime    time.Time
+	owner          string
+	team           string
+}
+
+func NewScheduleBuilder(t *testing.T, id uuid.UUID, db *memory.Client, agent *memory.Agent) *ScheduleBuilder {
+	if agent == nil {
+		t.Fatal("agent is required")
+	}
+
+	return &ScheduleBuilder{
+		entityBuilder:  newEntityBuilder(t, db),
+		scheduleID:     id,
+		agentID:        agent.ID,
+		name:           "nightly",
+		cronExpression: "0 2 * * *",
+		promptTemplate: "Run the nightly checks",
+		workspace:      "/tmp/test",
+		overlapPolicy:  types.ScheduleOverlapPolicySkip,
+		catchUpPolicy:  types.ScheduleCatchUpPolicyRunOnce,
+		enabled:        true,
+	}
+}
+
+func (b *ScheduleBuilder) WithName(name string) *ScheduleBuilder {
+	b.name = name
+	return b
+}
+
+func (b *ScheduleBuilder) WithCronExpression(cronExpression string) *ScheduleBuilder {
+	b.cronExpression = cronExpression
+	return b
+}
+
+func (b *ScheduleBuilder) WithPromptTemplate(promptTemplate string) *ScheduleBuilder {
+	b.promptTemplate = promptTemplate
+	return b
+}
+
+func (b *ScheduleBuilder) WithOverlapPolicy(policy types.ScheduleOverlapPolicy) *ScheduleBuilder {
+	b.overlapPolicy = policy
+	return b
+}
+
+func (b *ScheduleBuilder) WithCatchUpPolicy(policy types.ScheduleCatchUpPolicy) *ScheduleBuilder {
+	b.catchUpPolicy = policy
+	return b
+}
+
+func (b *ScheduleBuilder) WithEnabled(enabled bool) *ScheduleBuilder {
+	b.enabled = enabled
+	return b
+}
+
+func (b *ScheduleBuilder) WithNextRunTime(nextRunTime time.Time) *ScheduleBuilder {
+	b.nextRunTime = nextRunTime
+	return b
+}
+
+func (b *ScheduleBuilder) WithOwner(owner, team string) *ScheduleBuilder {
+	b.owner = owner
+	b.team = team
+	return b
+}
+
+func (b *ScheduleBuilder) Build(ctx context.Context) *memory.Schedule {
+	create := b.db.Schedule.Create().
+		SetID(b.scheduleID).
+		SetAgentID(b.agentID).
+		SetName(b.name).
+		SetCronExpression(b.cronExpression).
+		SetPromptTemplate(b.promptTemplate).
+		SetWorkspace(b.workspace).
+		SetOverlapPolicy(b.overlapPolicy).
+		SetCatchUpPolicy(b.catchUpPolicy).
+		SetEnabled(b.enabled).
+		SetOwner(b.owner).
+		SetTeam(b.team)
+
+	if !b.nextRunTime.IsZero() {
+		create.SetNextRunTime(b.nextRunTime)
+	}
+
+	schedule, err := create.Save(ctx)
+	if err != nil {
+		b.t.Fatalf("failed to create schedule: %v", err)
+	}
+
+	return schedule
+}
+
+type WebhookTriggerBuilder struct {
+	*entityBuilder
+	triggerID uuid.UUID
+
+	agentID         uuid.UUID
+	name            string
+	target          types.WebhookTarget
+	promptTemplate  string
+	taskIDTemplate  string
+	workspace       string
+	secret          []byte
+	signatureHeader string
+	enabled         bool
+	owner           string
+}
+
+func NewWebhookTriggerBuilder(t *testing.T, id uuid.UUID, db *memory.Client, agent *memory.Agent) *WebhookTriggerBuilder {
+	builder := &WebhookTriggerBuilder{
+		entityBuilder:   newEntityBuilder(t, db),
+		triggerID:       id,
+		name:            "push",
+		target:          types.WebhookTargetNewTask,
+		promptTemplate:  "Review the push to {{.Payload.ref}}",
+		workspace:       "/tmp/test",
+		secret:          []byte("mock-secret"),
+		signatureHeader: "X-Construct-Signature",
+		enabled:         true,
+	}
+
+	if agent != nil {
+		builder.agentID = agent.ID
+	}
+
+	return builder
+}
+
+func (b *WebhookTriggerBuilder) WithName(name string) *WebhookTriggerBuilder {
+	b.name = name
+	return b
+}
+
+func (b *WebhookTriggerBuilder) WithTarget(target types.WebhookTarget) *WebhookTriggerBuilder {
+	b.target = target
+	return b
+}
+
+func (b *WebhookTriggerBuilder) WithPromptTemplate(promptTemplate string) *WebhookTriggerBuilder {
+	b.promptTemplate = promptTemplate
+	return b
+}
+
+func (b *WebhookTriggerBuilder) WithTaskIDTemplate(taskIDTemplate string) *WebhookTriggerBuilder {
+	b.taskIDTemplate = taskIDTemplate
+	return b
+}
+
+// WithSecret sets the stored secret. The secret is stored as is, so callers have to
+// encrypt it if the trigger receives deliveries.
+func (b *WebhookTriggerBuilder) WithSecret(secret []byte) *WebhookTriggerBuilder {
+	b.secret = secret
+	return b
+}
+
+func (b *WebhookTriggerBuilder) WithSignatureHeader(header string) *WebhookTriggerBuilder {
+	b.signatureHeader = header
+	return b
+}
+
+func (b *WebhookTriggerBuilder) WithEnabled(enabled bool) *WebhookTriggerBuilder {
+	b.enabled = enabled
+	return b
+}
+
+func (b *WebhookTriggerBuilder) WithOwner(owner string) *WebhookTriggerBuilder {
+	b.owner = owner
+	return b
+}
+
+func (b *WebhookTriggerBuilder) Build(ctx context.Context) *memory.WebhookTrigger {
+	create := b.db.WebhookTrigger.Create().
+		SetID(b.triggerID).
+		SetName(b.name).
+		SetTarget(b.target).
+		SetPromptTemplate(b.promptTemplate).
+		SetWorkspace(b.workspace).
+		SetSecret(b.secret).
+		SetSignatureHeader(b.signatureHeader).
+		SetEnabled(b.enabled).
+		SetOwner(b.owner)
+
+	if b.agentID != uuid.Nil {
+		create.SetAgentID(b.agentID)
+	}
+
+	if b.taskIDTemplate != "" {
+		create.SetTaskIDTemplate(b.taskIDTemplate)
+	}
+
+	trigger, err := create.Save(ctx)
+	if err != nil {
+		b.t.Fatalf("failed to create webhook trigger: %v", err)
+	}
+
+	return trigger
+}
+
+type NotificationSinkBuilder struct {
+	*entityBuilder
+	sinkID uuid.UUID
+
+	agentID       uuid.UUID
+	name          string
+	kind          types.NotificationSinkKind
+	events        []types.NotificationEvent
+	budget        *float64
+	url           string
+	titleTemplate string
+	bodyTemplate  string
+	secret        []byte
+	enabled       bool
+	owner         string
+}
+
+func NewNotificationSinkBuilder(t *testing.T, id uuid.UUID, db *memory.Client, agent *memory.Agent) *NotificationSinkBuilder {
+	builder := &NotificationSinkBuilder{
+		entityBuilder: newEntityBuilder(t, db),
+		sinkID:        id,
+		name:          "ci",
+		kind:          types.NotificationSinkKindWebhook,
+		events:        []types.NotificationEvent{types.NotificationEventTaskCompleted},
+		url:           "http://localhost:8080/notify",
+		enabled:       true,
+	}
+
+	if agent != nil {
+		builder.agentID = agent.ID
+	}
+
+	return builder
+}
+
+func (b *NotificationSinkBuilder) WithName(name string) *NotificationSinkBuilder {
+	b.name = name
+	return b
+}
+
+func (b *NotificationSinkBuilder) WithKind(kind types.NotificationSinkKind) *NotificationSinkBuilder {
+	b.kind = kind
+	return b
+}
+
+func (b *NotificationSinkBuilder) WithEvents(events ...types.NotificationEvent) *NotificationSinkBuilder {
+	b.events = events
+	return b
+}
+
+func (b *NotificationSinkBuilder) WithBudget(budget float64) *NotificationSinkBuilder {
+	b.budget = &budget
+	return b
+}
+
+func (b *NotificationSinkBuilder) WithURL(url string) *NotificationSinkBuilder {
+	b.url = url
+	return b
+}
+
+func (b *NotificationSinkBuilder) WithTemplates(titleTemplate, bodyTemplate string) *NotificationSinkBuilder {
+	b.titleTemplate = titleTemplate
+	b.bodyTemplate = bodyTemplate
+	return b
+}
+
+// WithSecret sets the stored secret. The secret is stored as is, so callers have to
+// encrypt it if notifications are sent to the sink.
+func (b *NotificationSinkBuilder) WithSecret(secret []byte) *NotificationSinkBuilder {
+	b.secret = secret
+	return b
+}
+
+func (b *NotificationSinkBuilder) WithEnabled(enabled bool) *NotificationSinkBuilder {
+	b.enabled = enabled
+	return b
+}
+
+func (b *NotificationSinkBuilder) WithOwner(owner string) *NotificationSinkBuilder {
+	b.owner = owner
+	return b
+}
+
+func (b *NotificationSinkBuilder) Build(ctx context.Context) *memory.NotificationSink {
+	create := b.db.NotificationSink.Create().
+		SetID(b.sinkID).
+		SetName(b.name).
+		SetKind(b.kind).
+		SetEvents(b.events).
+		SetNillableBudget(b.budget).
+		SetURL(b.url).
+		SetEnabled(b.enabled).
+		SetOwner(b.owner)
+
+	if b.agentID != uuid.Nil {
+		create.SetAgentID(b.agentID)
+	}
+
+	if b.titleTemplate != "" {
+		create.SetTitleTemplate(b.titleTemplate)
+	}
+
+	if b.bodyTemplate != "" {
+		create.SetBodyTemplate(b.bodyTemplate)
+	}
+
+	if b.secret != nil {
+		create.SetSecret(b.secret)
+	}
+
+	sink, err := create.Save(ctx)
+	if err != nil {
+		b.t.Fatalf("failed to create notification sink: %v", err)
+	}
+
+	return sink
+}
@@ -0,0 +1,44 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/migration"
+	"github.com/google/uuid"
+
+	_ "modernc.org/sqlite"
+)
+
+// NewDatabase returns an in-memory SQLite database with the schema of the daemon. The
+// schema is built by the versioned migrations, so that every test that uses the database
+// also runs them.
+func NewDatabase(t *testing.T) *memory.Client {
+	t.Helper()
+
+	db, err := memory.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", uuid.NewString()))
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	Migrate(t, db)
+	return db
+}
+
+// Migrate applies the versioned migrations to db.
+func Migrate(t *testing.T, db *memory.Client) {
+	t.Helper()
+
+	migrations, err := migration.For(db.Driver().Dialect())
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+
+	if _, err := migration.NewMigrator(db, migrations).Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build postgres
+
+package test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// PostgresDSNEnv is the environment variable with the connection string of the Postgres
+// server that the tests built with the postgres tag run against.
+const PostgresDSNEnv = "CONSTRUCT_TEST_POSTGRES_DSN"
+
+// NewPostgresDatabase returns a Postgres database with the schema of the daemon, built by
+// the versioned migrations. The test is skipped if CONSTRUCT_TEST_POSTGRES_DSN is not set.
+func NewPostgresDatabase(t *testing.T) *memory.Client {
+	t.Helper()
+
+	db := NewEmptyPostgresDatabase(t)
+	Migrate(t, db)
+	return db
+}
+
+// NewEmptyPostgresDatabase returns a Postgres database without any tables. Every test gets
+// its own schema, which is dropped when the test ends, so that tests do not see each other's
+// data.
+func NewEmptyPostgresDatabase(t *testing.T) *memory.Client {
+	t.Helper()
+
+	dsn := os.Getenv(PostgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", PostgresDSNEnv)
+	}
+
+	ctx := context.Background()
+	admin := openPostgres(t, dsn, "")
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := admin.ExecContext(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		admin.ExecContext(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		admin.Close()
+	})
+
+	db := memory.NewClient(memory.Driver(entsql.OpenDB(dialect.Postgres, openPostgres(t, dsn, schema))))
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// openPostgres connects to the server in dsn. If schema is set, unqualified names refer to it.
+func openPostgres(t *testing.T, dsn string, schema string) *sql.DB {
+	t.Helper()
+
+	config, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("invalid %s: %v", PostgresDSNEnv, err)
+	}
+	if schema != "" {
+		config.RuntimeParams["search_path"] = schema
+	}
+
+	return stdlib.OpenDB(*config)
+}
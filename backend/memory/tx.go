@@ -14,14 +14,30 @@ type Tx struct {
 	config
 	// Agent is the client for interacting with the Agent builders.
 	Agent *AgentClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// FileChange is the client for interacting with the FileChange builders.
+	FileChange *FileChangeClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
 	// Model is the client for interacting with the Model builders.
 	Model *ModelClient
 	// ModelProvider is the client for interacting with the ModelProvider builders.
 	ModelProvider *ModelProviderClient
+	// NotificationSink is the client for interacting with the NotificationSink builders.
+	NotificationSink *NotificationSinkClient
+	// Schedule is the client for interacting with the Schedule builders.
+	Schedule *ScheduleClient
+	// ScheduleRun is the client for interacting with the ScheduleRun builders.
+	ScheduleRun *ScheduleRunClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// Token is the client for interacting with the Token builders.
+	Token *TokenClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookTrigger is the client for interacting with the WebhookTrigger builders.
+	WebhookTrigger *WebhookTriggerClient
 
 	// lazily loaded.
 	client     *Client
@@ -154,10 +170,18 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Agent = NewAgentClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.FileChange = NewFileChangeClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
 	tx.Model = NewModelClient(tx.config)
 	tx.ModelProvider = NewModelProviderClient(tx.config)
+	tx.NotificationSink = NewNotificationSinkClient(tx.config)
+	tx.Schedule = NewScheduleClient(tx.config)
+	tx.ScheduleRun = NewScheduleRunClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.Token = NewTokenClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookTrigger = NewWebhookTriggerClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
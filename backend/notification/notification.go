@@ -0,0 +1,400 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	memory_message "github.com/furisto/construct/backend/memory/message"
+	memory_notificationsink "github.com/furisto/construct/backend/memory/notificationsink"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_task "github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+const (
+	// TestEvent is the event of notifications sent by Notifier.Test.
+	TestEvent types.NotificationEvent = "test"
+
+	DefaultTitleTemplate = `{{.Headline}}: {{.Task}}`
+	DefaultBodyTemplate  = `{{if .Error}}{{.Error}}{{else if .Question}}{{.Question}}{{else}}{{.Summary}}{{end}}
+
+Agent: {{.Agent}}
+Cost:  ${{printf "%.4f" .Cost}}{{if .Budget}} of ${{printf "%.2f" .Budget}}{{end}}
+Task:  {{.TaskID}}`
+
+	// maxSummaryLength caps the summary taken from the last response of the agent.
+	maxSummaryLength = 2000
+)
+
+var headlines = map[types.NotificationEvent]string{
+	types.NotificationEventTaskCompleted:  "Task completed",
+	types.NotificationEventTaskSuspended:  "Task suspended",
+	types.NotificationEventTaskFailed:     "Task failed",
+	types.NotificationEventInputRequested: "Input requested",
+	types.NotificationEventBudgetExceeded: "Budget exceeded",
+	TestEvent:                             "Test notification",
+}
+
+// Data is the data available to the title and body templates of a notification sink.
+type Data struct {
+	Event        types.NotificationEvent `json:"event"`
+	Headline     string                  `json:"headline"`
+	SinkName     string                  `json:"sink_name"`
+	TaskID       string                  `json:"task_id"`
+	Task         string                  `json:"task"`
+	Summary      string                  `json:"summary,omitempty"`
+	Agent        string                  `json:"agent,omitempty"`
+	Workspace    string                  `json:"workspace,omitempty"`
+	Cost         float64                 `json:"cost"`
+	InputTokens  int64                   `json:"input_tokens"`
+	OutputTokens int64                   `json:"output_tokens"`
+	Budget       float64                 `json:"budget,omitempty"`
+	Error        string                  `json:"error,omitempty"`
+	Question     string                  `json:"question,omitempty"`
+	Time         time.Time               `json:"time"`
+}
+
+// Notification is a rendered notification that is handed to a sender.
+type Notification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Data  Data   `json:"data"`
+}
+
+type Options struct {
+	Senders map[types.NotificationSinkKind]Sender
+	Clock   func() time.Time
+}
+
+type Option func(*Options)
+
+// WithSender replaces the sender used for sinks of the given kind.
+func WithSender(kind types.NotificationSinkKind, sender Sender) Option {
+	return func(o *Options) {
+		o.Senders[kind] = sender
+	}
+}
+
+func WithClock(clock func() time.Time) Option {
+	return func(o *Options) {
+		o.Clock = clock
+	}
+}
+
+// Notifier subscribes to the task events on the bus and sends a notification to every
+// enabled sink whose rules select the event.
+type Notifier struct {
+	memory     *memory.Client
+	encryption *secret.Encryption
+	bus        *event.Bus
+	senders    map[types.NotificationSinkKind]Sender
+	now        func() time.Time
+	logger     *slog.Logger
+}
+
+func NewNotifier(memory *memory.Client, encryption *secret.Encryption, bus *event.Bus, opts ...Option) *Notifier {
+	options := &Options{
+		Senders: map[types.NotificationSinkKind]Sender{
+			types.NotificationSinkKindWebhook: NewWebhookSender(),
+			types.NotificationSinkKindDesktop: NewDesktopSender(),
+			types.NotificationSinkKindEmail:   NewEmailSender(),
+		},
+		Clock: time.Now,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &Notifier{
+		memory:     memory,
+		encryption: encryption,
+		bus:        bus,
+		senders:    options.Senders,
+		now:        options.Clock,
+		logger:     slog.With("component", "notifier"),
+	}
+}
+
+// ParseTemplate parses the title or body template of a notification sink.
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// Subscribe registers the notifier on the bus. The returned function removes the subscriptions.
+func (n *Notifier) Subscribe() func() {
+	subscriptions := []*event.Subscription{
+		event.Subscribe(n.bus, func(ctx context.Context, e event.TaskCompletedEvent) {
+			n.notify(ctx, types.NotificationEventTaskCompleted, e.TaskID, nil)
+		}, nil),
+		event.Subscribe(n.bus, func(ctx context.Context, e event.TaskSuspendedEvent) {
+			n.notify(ctx, types.NotificationEventTaskSuspended, e.TaskID, nil)
+		}, nil),
+		event.Subscribe(n.bus, func(ctx context.Context, e event.TaskFailedEvent) {
+			n.notify(ctx, types.NotificationEventTaskFailed, e.TaskID, func(_ *memory.NotificationSink, data *Data) bool {
+				data.Error = e.Error
+				return true
+			})
+		}, nil),
+		event.Subscribe(n.bus, func(ctx context.Context, e event.TaskInputRequestedEvent) {
+			n.notify(ctx, types.NotificationEventInputRequested, e.TaskID, func(_ *memory.NotificationSink, data *Data) bool {
+				data.Question = e.Question
+				return true
+			})
+		}, nil),
+		event.Subscribe(n.bus, func(ctx context.Context, e event.TaskUsageEvent) {
+			n.notify(ctx, types.NotificationEventBudgetExceeded, e.TaskID, func(sink *memory.NotificationSink, data *Data) bool {
+				// Only the invocation that crosses the budget notifies, so that every sink
+				// fires at most once per task.
+				return sink.Budget != nil && e.PreviousCost < *sink.Budget && e.Cost >= *sink.Budget
+			})
+		}, nil),
+	}
+
+	return func() {
+		for _, sub := range subscriptions {
+			sub.Unsubscribe()
+		}
+	}
+}
+
+func (n *Notifier) Run(ctx context.Context) error {
+	n.logger.InfoContext(ctx, "notifier starting")
+
+	unsubscribe := n.Subscribe()
+	defer unsubscribe()
+
+	<-ctx.Done()
+	n.logger.InfoContext(ctx, "notifier stopped")
+	return nil
+}
+
+// Test sends a test notification to the sink, regardless of its rules and whether it is enabled.
+func (n *Notifier) Test(ctx context.Context, sinkID uuid.UUID) error {
+	sink, err := n.memory.NotificationSink.Get(ctx, sinkID)
+	if err != nil {
+		return err
+	}
+
+	data := Data{
+		Event:    TestEvent,
+		Headline: headlines[TestEvent],
+		SinkName: sink.Name,
+		TaskID:   uuid.Nil.String(),
+		Task:     "Test task",
+		Summary:  "This is a test notification from construct.",
+		Agent:    "test",
+		Time:     n.now(),
+	}
+	if sink.Budget != nil {
+		data.Budget = *sink.Budget
+	}
+
+	return n.send(ctx, sink, data)
+}
+
+// matches is called for every sink that selects the event. It can enrich the data of the
+// notification and returns false to skip the sink.
+type matches func(sink *memory.NotificationSink, data *Data) bool
+
+func (n *Notifier) notify(ctx context.Context, evt types.NotificationEvent, taskID uuid.UUID, match matches) {
+	logger := n.logger.With("event", evt, "task_id", taskID)
+
+	sinks, err := n.memory.NotificationSink.Query().
+		Where(memory_notificationsink.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to query notification sinks", "error", err)
+		return
+	}
+
+	sinks = slices.DeleteFunc(sinks, func(sink *memory.NotificationSink) bool {
+		return !slices.Contains(sink.Events, evt)
+	})
+	if len(sinks) == 0 {
+		return
+	}
+
+	task, err := n.memory.Task.Query().
+		Where(memory_task.ID(taskID)).
+		WithAgent().
+		Only(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to fetch task", "error", err)
+		return
+	}
+
+	summary, err := n.summary(ctx, taskID)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to summarize task", "error", err)
+	}
+
+	for _, sink := range sinks {
+		if sink.AgentID != uuid.Nil && sink.AgentID != task.AgentID {
+			continue
+		}
+
+		// sinks created with a token only notify their owner about the owner's own tasks
+		if sink.Owner != "" && sink.Owner != task.Owner {
+			continue
+		}
+
+		data := Data{
+			Event:        evt,
+			Headline:     headlines[evt],
+			SinkName:     sink.Name,
+			TaskID:       task.ID.String(),
+			Task:         task.Description,
+			Summary:      summary,
+			Workspace:    task.ProjectDirectory,
+			Cost:         task.Cost,
+			InputTokens:  task.InputTokens,
+			OutputTokens: task.OutputTokens,
+			Time:         n.now(),
+		}
+		if data.Task == "" {
+			data.Task = task.ID.String()
+		}
+		if task.Edges.Agent != nil {
+			data.Agent = task.Edges.Agent.Name
+		}
+		if sink.Budget != nil {
+			data.Budget = *sink.Budget
+		}
+
+		if match != nil && !match(sink, &data) {
+			continue
+		}
+
+		if err := n.send(ctx, sink, data); err != nil {
+			logger.ErrorContext(ctx, "failed to send notification",
+				"sink_id", sink.ID,
+				"sink_kind", sink.Kind,
+				"error", err,
+			)
+		}
+	}
+}
+
+func (n *Notifier) send(ctx context.Context, sink *memory.NotificationSink, data Data) error {
+	sender, ok := n.senders[sink.Kind]
+	if !ok {
+		return fmt.Errorf("unsupported notification sink kind: %s", sink.Kind)
+	}
+
+	notification, err := Render(sink, data)
+	if err != nil {
+		return err
+	}
+
+	var key []byte
+	if len(sink.Secret) > 0 {
+		key, err = n.encryption.Decrypt(sink.Secret, secret.NotificationSinkAssociated(sink.ID))
+		if err != nil {
+			return fmt.Errorf("failed to decrypt notification sink secret")
+		}
+	}
+
+	return sender.Send(ctx, sink, key, notification)
+}
+
+// summary returns the text of the last response of the agent.
+func (n *Notifier) summary(ctx context.Context, taskID uuid.UUID) (string, error) {
+	messages, err := n.memory.Message.Query().
+		Where(
+			memory_message.TaskID(taskID),
+			memory_message.SourceEQ(types.MessageSourceAssistant),
+		).
+		Order(memory_message.ByCreateTime(sql.OrderDesc())).
+		Limit(10).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, message := range messages {
+		if message.Content == nil {
+			continue
+		}
+
+		var text []string
+		for _, block := range message.Content.Blocks {
+			if block.Kind == types.MessageBlockKindText && strings.TrimSpace(block.Payload) != "" {
+				text = append(text, strings.TrimSpace(block.Payload))
+			}
+		}
+
+		if len(text) > 0 {
+			return truncate(strings.Join(text, "\n\n"), maxSummaryLength), nil
+		}
+	}
+
+	return "", nil
+}
+
+// Render renders the title and body of a notification with the templates of the sink,
+// falling back to the default templates.
+func Render(sink *memory.NotificationSink, data Data) (*Notification, error) {
+	titleTemplate := sink.TitleTemplate
+	if titleTemplate == "" {
+		titleTemplate = DefaultTitleTemplate
+	}
+
+	bodyTemplate := sink.BodyTemplate
+	if bodyTemplate == "" {
+		bodyTemplate = DefaultBodyTemplate
+	}
+
+	title, err := render("title", titleTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := render("body", bodyTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		Title: strings.TrimSpace(title),
+		Body:  strings.TrimSpace(body),
+		Data:  data,
+	}, nil
+}
+
+func render(name, text string, data Data) (string, error) {
+	tmpl, err := ParseTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", name, err)
+	}
+
+	return buf.String(), nil
+}
+
+func truncate(s string, length int) string {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+
+	return string(runes[:length]) + "…"
+}
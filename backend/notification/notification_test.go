@@ -0,0 +1,224 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/furisto/construct/backend/webhook"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+type recordingSender struct {
+	mu   sync.Mutex
+	sent []string
+}
+
+func (s *recordingSender) Send(ctx context.Context, sink *memory.NotificationSink, secret []byte, notification *Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, fmt.Sprintf("%s: %s | %s", sink.Name, notification.Title, notification.Body))
+	return nil
+}
+
+func TestNotify(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	taskID := uuid.New()
+
+	type sinkSpec struct {
+		name     string
+		events   []types.NotificationEvent
+		budget   float64
+		enabled  bool
+		ownAgent bool
+		owner    string
+	}
+
+	tests := []struct {
+		name     string
+		event    types.NotificationEvent
+		match    matches
+		sinks    []sinkSpec
+		expected []string
+	}{
+		{
+			name:  "task completed",
+			event: types.NotificationEventTaskCompleted,
+			sinks: []sinkSpec{
+				{name: "completed", events: []types.NotificationEvent{types.NotificationEventTaskCompleted}, enabled: true},
+				{name: "failed", events: []types.NotificationEvent{types.NotificationEventTaskFailed}, enabled: true},
+				{name: "disabled", events: []types.NotificationEvent{types.NotificationEventTaskCompleted}, enabled: false},
+			},
+			expected: []string{"completed: Task completed: Fix the build | The build is green again. | $0.5000"},
+		},
+		{
+			name:  "sink of another agent",
+			event: types.NotificationEventTaskCompleted,
+			sinks: []sinkSpec{
+				{name: "other", events: []types.NotificationEvent{types.NotificationEventTaskCompleted}, enabled: true, ownAgent: true},
+			},
+		},
+		{
+			name:  "sinks of other users",
+			event: types.NotificationEventTaskCompleted,
+			sinks: []sinkSpec{
+				{name: "alice", events: []types.NotificationEvent{types.NotificationEventTaskCompleted}, enabled: true, owner: "alice"},
+				{name: "bob", events: []types.NotificationEvent{types.NotificationEventTaskCompleted}, enabled: true, owner: "bob"},
+				{name: "local", events: []types.NotificationEvent{types.NotificationEventTaskCompleted}, enabled: true},
+			},
+			expected: []string{
+				"alice: Task completed: Fix the build | The build is green again. | $0.5000",
+				"local: Task completed: Fix the build | The build is green again. | $0.5000",
+			},
+		},
+		{
+			name:  "task failed",
+			event: types.NotificationEventTaskFailed,
+			match: func(_ *memory.NotificationSink, data *Data) bool {
+				data.Error = "model provider unavailable"
+				return true
+			},
+			sinks: []sinkSpec{
+				{name: "failed", events: []types.NotificationEvent{types.NotificationEventTaskFailed}, enabled: true},
+			},
+			expected: []string{"failed: Task failed: Fix the build | model provider unavailable | $0.5000"},
+		},
+		{
+			name:  "budget crossed",
+			event: types.NotificationEventBudgetExceeded,
+			match: func(sink *memory.NotificationSink, data *Data) bool {
+				return *sink.Budget > 0.25 && *sink.Budget <= 0.5
+			},
+			sinks: []sinkSpec{
+				{name: "crossed", events: []types.NotificationEvent{types.NotificationEventBudgetExceeded}, budget: 0.4, enabled: true},
+				{name: "not-crossed", events: []types.NotificationEvent{types.NotificationEventBudgetExceeded}, budget: 1, enabled: true},
+			},
+			expected: []string{"crossed: Budget exceeded: Fix the build | The build is green again. | $0.5000 of $0.40"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := test.NewDatabase(t)
+
+			modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+			model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+			agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+			otherAgent := test.NewAgentBuilder(t, uuid.New(), db, model).WithName("other").Build(ctx)
+			task := test.NewTaskBuilder(t, taskID, db, agent).WithOwner("alice").Build(ctx)
+			db.Task.UpdateOne(task).SetDescription("Fix the build").SetCost(0.5).ExecX(ctx)
+			test.NewMessageBuilder(t, uuid.New(), db, task).
+				WithAgent(agent).
+				WithContent(&types.MessageContent{Blocks: []types.MessageBlock{
+					{Kind: types.MessageBlockKindText, Payload: "The build is green again."},
+				}}).
+				Build(ctx)
+
+			for _, spec := range tt.sinks {
+				var sinkAgent *memory.Agent
+				if spec.ownAgent {
+					sinkAgent = otherAgent
+				}
+
+				builder := test.NewNotificationSinkBuilder(t, uuid.New(), db, sinkAgent).
+					WithName(spec.name).
+					WithEvents(spec.events...).
+					WithTemplates("", `{{if .Error}}{{.Error}}{{else}}{{.Summary}}{{end}} | ${{printf "%.4f" .Cost}}{{if .Budget}} of ${{printf "%.2f" .Budget}}{{end}}`).
+					WithEnabled(spec.enabled).
+					WithOwner(spec.owner)
+				if spec.budget > 0 {
+					builder = builder.WithBudget(spec.budget)
+				}
+				builder.Build(ctx)
+			}
+
+			sender := &recordingSender{}
+			notifier := NewNotifier(db, newTestEncryption(t), event.NewBus(nil),
+				WithSender(types.NotificationSinkKindWebhook, sender),
+				WithClock(func() time.Time { return now }),
+			)
+
+			notifier.notify(ctx, tt.event, taskID, tt.match)
+
+			if diff := cmp.Diff(tt.expected, sender.sent); diff != "" {
+				t.Errorf("notifications mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWebhookSender(t *testing.T) {
+	signingSecret := []byte("s3cr3t")
+
+	var received Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read body: %v", err)
+		}
+
+		if !webhook.Verify(signingSecret, body, r.Header.Get(webhook.DefaultSignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Errorf("failed to decode notification: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := &memory.NotificationSink{Name: "ci", URL: server.URL}
+	notification := &Notification{
+		Title: "Task completed: Fix the build",
+		Body:  "The build is green again.",
+		Data: Data{
+			Event:  types.NotificationEventTaskCompleted,
+			TaskID: uuid.NewString(),
+			Cost:   0.5,
+		},
+	}
+
+	sender := NewWebhookSender()
+	if err := sender.Send(context.Background(), sink, signingSecret, notification); err != nil {
+		t.Fatalf("failed to send notification: %v", err)
+	}
+
+	if diff := cmp.Diff(*notification, received); diff != "" {
+		t.Errorf("notification mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := sender.Send(context.Background(), sink, []byte("wrong"), notification); err == nil {
+		t.Error("expected error for rejected notification")
+	}
+}
+
+func newTestEncryption(t *testing.T) *secret.Encryption {
+	t.Helper()
+
+	keyset, err := secret.GenerateKeyset()
+	if err != nil {
+		t.Fatalf("failed generating keyset: %v", err)
+	}
+
+	encryption, err := secret.NewClient(keyset)
+	if err != nil {
+		t.Fatalf("failed creating encryption client: %v", err)
+	}
+
+	return encryption
+}
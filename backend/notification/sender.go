@@ -0,0 +1,139 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/webhook"
+)
+
+// Sender delivers a rendered notification to a sink. The secret is the decrypted secret
+// of the sink and empty if none is configured.
+type Sender interface {
+	Send(ctx context.Context, sink *memory.NotificationSink, secret []byte, notification *Notification) error
+}
+
+// WebhookSender posts the notification as JSON to the URL of the sink. If the sink has a
+// secret, the body is signed the same way as inbound webhook deliveries.
+type WebhookSender struct {
+	client *http.Client
+}
+
+func NewWebhookSender() *WebhookSender {
+	return &WebhookSender{
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *WebhookSender) Send(ctx context.Context, sink *memory.NotificationSink, secret []byte, notification *Notification) error {
+	if sink.URL == "" {
+		return fmt.Errorf("webhook sink %s has no URL", sink.Name)
+	}
+
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(secret) > 0 {
+		req.Header.Set(webhook.DefaultSignatureHeader, webhook.Sign(secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// DesktopSender shows the notification through notify-send, which talks to the
+// notification daemon of the desktop over D-Bus.
+type DesktopSender struct {
+	command string
+}
+
+func NewDesktopSender() *DesktopSender {
+	return &DesktopSender{
+		command: "notify-send",
+	}
+}
+
+func (s *DesktopSender) Send(ctx context.Context, sink *memory.NotificationSink, _ []byte, notification *Notification) error {
+	output, err := exec.CommandContext(ctx, s.command, "--app-name=construct", notification.Title, notification.Body).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s failed: %w: %s", s.command, err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// EmailSender sends the notification as a plain text email. The secret of the sink is the
+// password used to authenticate against the SMTP server.
+type EmailSender struct {
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewEmailSender() *EmailSender {
+	return &EmailSender{
+		sendMail: smtp.SendMail,
+	}
+}
+
+func (s *EmailSender) Send(ctx context.Context, sink *memory.NotificationSink, secret []byte, notification *Notification) error {
+	config := sink.Email
+	if config == nil || config.SMTPAddress == "" || config.From == "" || len(config.To) == 0 {
+		return fmt.Errorf("email sink %s is missing its SMTP address, sender or recipients", sink.Name)
+	}
+
+	var auth smtp.Auth
+	if config.Username != "" {
+		host, _, err := net.SplitHostPort(config.SMTPAddress)
+		if err != nil {
+			return fmt.Errorf("invalid SMTP address %q: %w", config.SMTPAddress, err)
+		}
+		auth = smtp.PlainAuth("", config.Username, string(secret), host)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", config.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(config.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", sanitizeHeader(notification.Title))
+	fmt.Fprintf(&msg, "Date: %s\r\n", notification.Data.Time.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(notification.Body, "\n", "\r\n"))
+	msg.WriteString("\r\n")
+
+	if err := s.sendMail(config.SMTPAddress, auth, config.From, config.To, []byte(msg.String())); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
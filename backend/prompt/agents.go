@@ -9,3 +9,6 @@ var Plan string
 
 //go:embed edit.md
 var Edit string
+
+//go:embed summary.md
+var Summary string
@@ -0,0 +1,135 @@
+package redact
+
+import (
+	"math"
+	"regexp"
+	"unicode"
+)
+
+// DefaultEntropyThreshold flags tokens that are about as random as base64 encoded keys.
+// Hex strings such as commit hashes stay below it, since their alphabet only allows
+// 4 bits per character.
+const DefaultEntropyThreshold = 4.3
+
+// minEntropyTokenLength is the shortest token the entropy detector looks at.
+const minEntropyTokenLength = 24
+
+type match struct {
+	start int
+	end   int
+	kind  string
+}
+
+type detector interface {
+	find(s string) []match
+}
+
+// patternDetector redacts the matches of a regular expression, or only its first
+// capturing group if it has one.
+type patternDetector struct {
+	kind    string
+	pattern *regexp.Regexp
+}
+
+func newPatternDetector(kind string, pattern *regexp.Regexp) *patternDetector {
+	return &patternDetector{
+		kind:    kind,
+		pattern: pattern,
+	}
+}
+
+func (d *patternDetector) find(s string) []match {
+	var matches []match
+	for _, loc := range d.pattern.FindAllStringSubmatchIndex(s, -1) {
+		start, end := loc[0], loc[1]
+		if len(loc) >= 4 {
+			if loc[2] < 0 {
+				continue
+			}
+			start, end = loc[2], loc[3]
+		}
+		matches = append(matches, match{start: start, end: end, kind: d.kind})
+	}
+	return matches
+}
+
+func builtinDetectors() []detector {
+	patterns := []struct {
+		kind    string
+		pattern string
+	}{
+		{"private-key", `-----BEGIN[A-Z ]*PRIVATE KEY-----[\s\S]*?-----END[A-Z ]*PRIVATE KEY-----`},
+		{"aws-access-key", `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`},
+		{"github-token", `\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`},
+		{"anthropic-key", `\bsk-ant-[A-Za-z0-9_-]{20,}`},
+		{"openai-key", `\bsk-(?:proj-)?[A-Za-z0-9_-]{20,}`},
+		{"slack-token", `\bxox[abprs]-[A-Za-z0-9-]{10,}`},
+		{"google-api-key", `\bAIza[0-9A-Za-z_-]{35}\b`},
+		{"stripe-key", `\b[rs]k_(?:live|test)_[0-9A-Za-z]{16,}\b`},
+		{"jwt", `\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`},
+		{"url-password", `[a-zA-Z][a-zA-Z0-9+.-]*://[^\s:/@]+:([^\s:/@]{3,})@`},
+		{"bearer-token", `(?i)\b(?:authorization:\s*)?bearer\s+([A-Za-z0-9._~+/-]{16,}=*)`},
+		// assignments like API_KEY=..., "password": "..." or secret: ... in env, JSON and YAML files
+		{"secret", `(?i)[A-Za-z0-9_.-]*(?:password|passwd|secret|token|api[_-]?key|access[_-]?key|private[_-]?key|credentials?)[A-Za-z0-9_.-]*["']?\s*[:=]\s*["']?([^\s"'<>,;{}\[\]()]{8,})`},
+	}
+
+	detectors := make([]detector, 0, len(patterns))
+	for _, p := range patterns {
+		detectors = append(detectors, newPatternDetector(p.kind, regexp.MustCompile(p.pattern)))
+	}
+	return detectors
+}
+
+// tokenPattern splits text into candidates for the entropy detector.
+var tokenPattern = regexp.MustCompile(`[A-Za-z0-9+/_-]+=*`)
+
+// entropyDetector redacts long tokens of mixed case letters and digits whose characters
+// are distributed like those of random keys.
+type entropyDetector struct {
+	threshold float64
+}
+
+func (d *entropyDetector) find(s string) []match {
+	var matches []match
+	for _, loc := range tokenPattern.FindAllStringIndex(s, -1) {
+		token := s[loc[0]:loc[1]]
+		if len(token) < minEntropyTokenLength || !mixedCharacters(token) {
+			continue
+		}
+		if entropy(token) >= d.threshold {
+			matches = append(matches, match{start: loc[0], end: loc[1], kind: "high-entropy"})
+		}
+	}
+	return matches
+}
+
+func mixedCharacters(s string) bool {
+	var upper, lower, digit bool
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		}
+	}
+	return upper && lower && digit
+}
+
+// entropy returns the Shannon entropy of s in bits per character.
+func entropy(s string) float64 {
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+
+	var entropy float64
+	n := float64(len(s))
+	for _, count := range counts {
+		p := float64(count) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,69 @@
+package redact
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Handler redacts the message and attributes of log records before passing them on.
+type Handler struct {
+	inner    slog.Handler
+	redactor *Redactor
+}
+
+var _ slog.Handler = (*Handler)(nil)
+
+func NewHandler(inner slog.Handler, redactor *Redactor) *Handler {
+	return &Handler{
+		inner:    inner,
+		redactor: redactor,
+	}
+}
+
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, h.redactor.Redact(record.Message), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redactAttr(attr))
+		return true
+	})
+	return h.inner.Handle(ctx, redacted)
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redacted[i] = h.redactAttr(attr)
+	}
+	return NewHandler(h.inner.WithAttrs(redacted), h.redactor)
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return NewHandler(h.inner.WithGroup(name), h.redactor)
+}
+
+func (h *Handler) redactAttr(attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+	switch value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, h.redactor.Redact(value.String()))
+	case slog.KindGroup:
+		group := value.Group()
+		redacted := make([]any, len(group))
+		for i, a := range group {
+			redacted[i] = h.redactAttr(a)
+		}
+		return slog.Group(attr.Key, redacted...)
+	case slog.KindAny:
+		switch v := value.Any().(type) {
+		case error:
+			return slog.String(attr.Key, h.redactor.Redact(v.Error()))
+		case []byte:
+			return slog.String(attr.Key, h.redactor.Redact(string(v)))
+		}
+	}
+	return slog.Attr{Key: attr.Key, Value: value}
+}
@@ -0,0 +1,334 @@
+// Package redact removes secrets from text before it is shown to a model, persisted or
+// logged. Every secret is replaced by a marker like [REDACTED:aws-access-key:3f9a0c12b4e1].
+// The redactor remembers the secret behind each marker, so that markers in the input of
+// tools that act on the local machine can be restored to the real value. The mapping only
+// lives in the memory of the process and never leaves it.
+package redact
+
+import (
+	"container/list"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// markerPattern matches the markers that replace secrets.
+var markerPattern = regexp.MustCompile(`\[REDACTED:([a-z0-9-]+):([0-9a-f]{12})\]`)
+
+// minKnownSecretLength is the length a secret must have to be replaced wherever it occurs
+// once it has been detected. Shorter values are too likely to occur by chance.
+const minKnownSecretLength = 8
+
+// DefaultMaxSecrets is the number of secrets a redactor remembers by default.
+const DefaultMaxSecrets = 10000
+
+type Options struct {
+	Patterns         []*regexp.Regexp
+	EntropyThreshold float64
+	Key              []byte
+	MaxSecrets       int
+}
+
+func DefaultOptions() *Options {
+	return &Options{
+		EntropyThreshold: DefaultEntropyThreshold,
+		MaxSecrets:       DefaultMaxSecrets,
+	}
+}
+
+type Option func(*Options)
+
+// WithPatterns adds user-defined patterns. If a pattern has a capturing group, only the
+// first group is redacted, otherwise the whole match.
+func WithPatterns(patterns ...*regexp.Regexp) Option {
+	return func(o *Options) {
+		o.Patterns = append(o.Patterns, patterns...)
+	}
+}
+
+// WithEntropyThreshold sets the Shannon entropy in bits per character above which a
+// random looking token is redacted. A threshold of 0 disables the entropy detector.
+func WithEntropyThreshold(threshold float64) Option {
+	return func(o *Options) {
+		o.EntropyThreshold = threshold
+	}
+}
+
+// WithKey sets the key that derives markers from secrets. Without it, a random key is
+// generated and markers are only stable for the lifetime of the redactor.
+func WithKey(key []byte) Option {
+	return func(o *Options) {
+		o.Key = key
+	}
+}
+
+// WithMaxSecrets sets the number of secrets the redactor remembers. Once the limit is
+// reached, the secret that was least recently redacted or restored is forgotten: its
+// marker can no longer be restored and it is only redacted again if a detector finds it.
+func WithMaxSecrets(max int) Option {
+	return func(o *Options) {
+		o.MaxSecrets = max
+	}
+}
+
+// Redactor detects secrets in text and replaces them with markers.
+type Redactor struct {
+	detectors  []detector
+	key        []byte
+	maxSecrets int
+
+	mu       sync.RWMutex
+	recent   *list.List
+	secrets  map[string]*list.Element
+	markers  map[string]*list.Element
+	replacer *strings.Replacer
+}
+
+// secret is an entry of the recently used list of a redactor.
+type secret struct {
+	value  string
+	marker string
+}
+
+func NewRedactor(opts ...Option) *Redactor {
+	options := DefaultOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	key := options.Key
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		rand.Read(key)
+	}
+
+	detectors := builtinDetectors()
+	for _, pattern := range options.Patterns {
+		detectors = append(detectors, newPatternDetector("custom", pattern))
+	}
+	if options.EntropyThreshold > 0 {
+		detectors = append(detectors, &entropyDetector{threshold: options.EntropyThreshold})
+	}
+
+	return &Redactor{
+		detectors:  detectors,
+		key:        key,
+		maxSecrets: options.MaxSecrets,
+		recent:     list.New(),
+		secrets:    make(map[string]*list.Element),
+		markers:    make(map[string]*list.Element),
+	}
+}
+
+// Redact replaces all secrets in s with markers. Secrets that have been redacted before
+// are replaced wherever they occur, even if no detector matches them in s.
+func (r *Redactor) Redact(s string) string {
+	if s == "" {
+		return s
+	}
+
+	s = r.replaceKnown(s)
+
+	existing := markerPattern.FindAllStringIndex(s, -1)
+	var found []match
+	for _, d := range r.detectors {
+		for _, m := range d.find(s) {
+			if m.start < m.end && !overlapsAny(m, existing) {
+				found = append(found, m)
+			}
+		}
+	}
+	if len(found) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range merge(found) {
+		b.WriteString(s[last:m.start])
+		b.WriteString(r.marker(m.kind, s[m.start:m.end]))
+		last = m.end
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// Restore replaces the markers in s with the secrets they stand for. Markers that were
+// not issued by this redactor are left as they are.
+func (r *Redactor) Restore(s string) string {
+	if !strings.Contains(s, "[REDACTED:") {
+		return s
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return markerPattern.ReplaceAllStringFunc(s, func(marker string) string {
+		if element, ok := r.markers[marker]; ok {
+			r.recent.MoveToFront(element)
+			return element.Value.(*secret).value
+		}
+		return marker
+	})
+}
+
+// HasMarkers reports whether s contains a marker.
+func HasMarkers(s string) bool {
+	return markerPattern.MatchString(s)
+}
+
+// RedactFields redacts every string reachable from v in place. v has to be a pointer,
+// a map, or a slice; other values cannot be changed and are left alone.
+func (r *Redactor) RedactFields(v any) {
+	r.redactValue(reflect.ValueOf(v))
+}
+
+func (r *Redactor) redactValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		elem := v.Elem()
+		if v.Kind() == reflect.Interface && elem.Kind() == reflect.String {
+			if v.CanSet() {
+				v.Set(reflect.ValueOf(r.Redact(elem.String())).Convert(elem.Type()))
+			}
+			return
+		}
+		r.redactValue(elem)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				r.redactValue(v.Field(i))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			r.redactValue(v.Index(i))
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			value := v.MapIndex(key)
+			redacted := reflect.New(value.Type()).Elem()
+			redacted.Set(value)
+			r.redactValue(redacted)
+			v.SetMapIndex(key, redacted)
+		}
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(r.Redact(v.String()))
+		}
+	}
+}
+
+// marker returns the marker for a secret and remembers the secret behind it. A secret
+// keeps the marker it got first, even if another detector finds it later.
+func (r *Redactor) marker(kind, value string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if element, ok := r.secrets[value]; ok {
+		r.recent.MoveToFront(element)
+		return element.Value.(*secret).marker
+	}
+
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(value))
+	marker := "[REDACTED:" + kind + ":" + hex.EncodeToString(mac.Sum(nil))[:12] + "]"
+
+	element := r.recent.PushFront(&secret{value: value, marker: marker})
+	r.secrets[value] = element
+	r.markers[marker] = element
+	if len(value) >= minKnownSecretLength {
+		r.replacer = nil
+	}
+
+	for r.maxSecrets > 0 && r.recent.Len() > r.maxSecrets {
+		oldest := r.recent.Remove(r.recent.Back()).(*secret)
+		delete(r.secrets, oldest.value)
+		delete(r.markers, oldest.marker)
+		if len(oldest.value) >= minKnownSecretLength {
+			r.replacer = nil
+		}
+	}
+	return marker
+}
+
+// replaceKnown replaces the secrets the redactor remembers in a single pass over s. The
+// replacer is rebuilt whenever the set of secrets long enough to be replaced changes.
+func (r *Redactor) replaceKnown(s string) string {
+	r.mu.RLock()
+	replacer := r.replacer
+	r.mu.RUnlock()
+
+	if replacer == nil {
+		r.mu.Lock()
+		if r.replacer == nil {
+			r.replacer = r.buildReplacer()
+		}
+		replacer = r.replacer
+		r.mu.Unlock()
+	}
+	return replacer.Replace(s)
+}
+
+// buildReplacer returns a replacer for the secrets that are long enough to be replaced
+// wherever they occur. The caller must hold the write lock.
+func (r *Redactor) buildReplacer() *strings.Replacer {
+	var known []*secret
+	for element := r.recent.Front(); element != nil; element = element.Next() {
+		if s := element.Value.(*secret); len(s.value) >= minKnownSecretLength {
+			known = append(known, s)
+		}
+	}
+	// the replacer prefers earlier pairs on a tie, so longer secrets come first to
+	// replace a secret that contains another whole
+	sort.SliceStable(known, func(i, j int) bool {
+		return len(known[i].value) > len(known[j].value)
+	})
+
+	pairs := make([]string, 0, 2*len(known))
+	for _, s := range known {
+		pairs = append(pairs, s.value, s.marker)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+func overlapsAny(m match, spans [][]int) bool {
+	for _, span := range spans {
+		if m.start < span[1] && span[0] < m.end {
+			return true
+		}
+	}
+	return false
+}
+
+// merge sorts matches by position and combines overlapping ones, keeping the kind of
+// the match that starts first. Of matches with the same span, the one of the detector
+// that comes first wins.
+func merge(matches []match) []match {
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].start != matches[j].start {
+			return matches[i].start < matches[j].start
+		}
+		return matches[i].end > matches[j].end
+	})
+
+	merged := []match{matches[0]}
+	for _, m := range matches[1:] {
+		last := &merged[len(merged)-1]
+		if m.start < last.end {
+			last.end = max(last.end, m.end)
+			continue
+		}
+		merged = append(merged, m)
+	}
+	return merged
+}
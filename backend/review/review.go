@@ -0,0 +1,248 @@
+// Package review stages the edits of agents to files for the review of the user. Staged
+// edits are not written to the workspace: they are kept in the database until the user
+// accepts them, and only then written to the file. Rejecting a change discards it and tells
+// the agent why it was rejected.
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/filechange"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/google/uuid"
+	diff "github.com/sourcegraph/go-diff-patch"
+	"github.com/spf13/afero"
+)
+
+var (
+	// ErrNotPending is returned for changes that were already accepted or rejected.
+	ErrNotPending = errors.New("the change was already reviewed")
+	// ErrConflict is returned if a file was changed in the workspace since the agent first
+	// edited it.
+	ErrConflict = errors.New("the file was changed since the agent edited it")
+)
+
+// Stager records the edits of agents and writes them to the workspace when they are accepted.
+type Stager struct {
+	db *memory.Client
+	fs afero.Fs
+}
+
+func NewStager(db *memory.Client, fs afero.Fs) *Stager {
+	return &Stager{
+		db: db,
+		fs: fs,
+	}
+}
+
+// Stage records that a task changed a file to content. original is the content before the
+// change, nil if the file did not exist. If the task already has a pending change of the
+// file, the change is updated and keeps its original content. A change that restores the
+// original content is dropped.
+func (s *Stager) Stage(ctx context.Context, taskID uuid.UUID, path string, original *string, content string) (*memory.FileChange, error) {
+	return memory.Transaction(ctx, s.db, func(tx *memory.Client) (*memory.FileChange, error) {
+		pending, err := tx.FileChange.Query().
+			Where(
+				filechange.TaskID(taskID),
+				filechange.Path(path),
+				filechange.StatusEQ(types.FileChangeStatusPending),
+			).
+			Only(ctx)
+		if err != nil && !memory.IsNotFound(err) {
+			return nil, err
+		}
+
+		if pending == nil {
+			if original != nil && *original == content {
+				return nil, nil
+			}
+			return tx.FileChange.Create().
+				SetTaskID(taskID).
+				SetPath(path).
+				SetNillableOriginalContent(original).
+				SetContent(content).
+				Save(ctx)
+		}
+
+		if pending.OriginalContent != nil && *pending.OriginalContent == content {
+			return nil, tx.FileChange.DeleteOne(pending).Exec(ctx)
+		}
+		return tx.FileChange.UpdateOne(pending).SetContent(content).Save(ctx)
+	})
+}
+
+// Overlay returns a file system that shows the pending changes of a task on top of base.
+// Writes go to memory and never reach base, so that the edits of the agent can be staged
+// with the content it wrote.
+func (s *Stager) Overlay(ctx context.Context, taskID uuid.UUID, base afero.Fs) (afero.Fs, error) {
+	pending, err := s.db.FileChange.Query().
+		Where(filechange.TaskID(taskID), filechange.StatusEQ(types.FileChangeStatusPending)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	layer := afero.NewMemMapFs()
+	for _, change := range pending {
+		if err := layer.MkdirAll(filepath.Dir(change.Path), 0755); err != nil {
+			return nil, err
+		}
+		if err := afero.WriteFile(layer, change.Path, []byte(change.Content), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(base), layer), nil
+}
+
+// Accept writes a pending change to the workspace. Unless force is set, the change is only
+// written if the file still has the content it had before the agent edited it, so that
+// edits made outside of the review are not lost.
+func (s *Stager) Accept(ctx context.Context, id uuid.UUID, force bool) (*memory.FileChange, error) {
+	return memory.Transaction(ctx, s.db, func(tx *memory.Client) (*memory.FileChange, error) {
+		change, err := tx.FileChange.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if change.Status != types.FileChangeStatusPending {
+			return nil, ErrNotPending
+		}
+
+		if !force {
+			if err := s.checkUnchanged(change); err != nil {
+				return nil, err
+			}
+		}
+
+		change, err = tx.FileChange.UpdateOne(change).SetStatus(types.FileChangeStatusAccepted).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// the file is written last, so that a failure leaves the change pending
+		if err := s.write(change); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", change.Path, err)
+		}
+		return change, nil
+	})
+}
+
+// Reject discards a pending change and adds a message to the conversation of the task,
+// which tells the agent that its change was rejected and why. The workspace is not touched.
+func (s *Stager) Reject(ctx context.Context, id uuid.UUID, reason string) (*memory.FileChange, error) {
+	return memory.Transaction(ctx, s.db, func(tx *memory.Client) (*memory.FileChange, error) {
+		change, err := tx.FileChange.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if change.Status != types.FileChangeStatusPending {
+			return nil, ErrNotPending
+		}
+
+		change, err = tx.FileChange.UpdateOne(change).
+			SetStatus(types.FileChangeStatusRejected).
+			SetReason(reason).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := tx.Task.Query().Where(task.ID(change.TaskID)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if t.DesiredPhase == types.TaskPhaseSuspended {
+			if err := tx.Task.UpdateOne(t).SetDesiredPhase(types.TaskPhaseRunning).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = tx.Message.Create().
+			SetTaskID(change.TaskID).
+			SetSource(types.MessageSourceUser).
+			SetContent(&types.MessageContent{
+				Blocks: []types.MessageBlock{
+					{
+						Kind:    types.MessageBlockKindText,
+						Payload: RejectionMessage(change),
+					},
+				},
+			}).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return change, nil
+	})
+}
+
+// checkUnchanged returns ErrConflict if the file of a change no longer has the content it
+// had before the first edit of the agent, or exists although the agent created it.
+func (s *Stager) checkUnchanged(change *memory.FileChange) error {
+	current, err := afero.ReadFile(s.fs, change.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	exists := err == nil
+	switch {
+	case change.OriginalContent == nil && exists:
+		return ErrConflict
+	case change.OriginalContent != nil && (!exists || string(current) != *change.OriginalContent):
+		return ErrConflict
+	}
+	return nil
+}
+
+func (s *Stager) write(change *memory.FileChange) error {
+	mode := os.FileMode(0644)
+	if info, err := s.fs.Stat(change.Path); err == nil {
+		mode = info.Mode()
+	}
+	if err := s.fs.MkdirAll(filepath.Dir(change.Path), 0755); err != nil {
+		return err
+	}
+	return afero.WriteFile(s.fs, change.Path, []byte(change.Content), mode)
+}
+
+// RejectionMessage is the message that tells the agent that the user rejected its change.
+func RejectionMessage(change *memory.FileChange) string {
+	var builder strings.Builder
+	if change.OriginalContent == nil {
+		fmt.Fprintf(&builder, "I rejected the file %s that you created. It was not written to the workspace.", change.Path)
+	} else {
+		fmt.Fprintf(&builder, "I rejected your changes to %s. The file keeps the content it had before you edited it.", change.Path)
+	}
+	if change.Reason != "" {
+		fmt.Fprintf(&builder, "\n\nReason: %s", change.Reason)
+	}
+	builder.WriteString("\n\nTake the reason into account before you continue.")
+	return builder.String()
+}
+
+// Patch returns the unified diff of a change and the number of added and removed lines.
+func Patch(change *memory.FileChange) (string, int, int) {
+	var original string
+	if change.OriginalContent != nil {
+		original = *change.OriginalContent
+	}
+
+	patch := diff.GeneratePatch(filepath.Base(change.Path), original, change.Content)
+	added, removed := 0, 0
+	for _, line := range strings.Split(patch, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		case strings.HasPrefix(line, "+"):
+			added++
+		case strings.HasPrefix(line, "-"):
+			removed++
+		}
+	}
+	return patch, added, removed
+}
@@ -0,0 +1,272 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/filechange"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestStage(t *testing.T) {
+	ctx := context.Background()
+	db, task := newTestDatabase(t)
+	stager := NewStager(db, afero.NewMemMapFs())
+
+	change, err := stager.Stage(ctx, task.ID, "/workspace/main.go", ptr("package main\n"), "package main\n\nfunc main() {}\n")
+	if err != nil {
+		t.Fatalf("failed to stage: %v", err)
+	}
+
+	// a second edit of the same file keeps the content before the first edit
+	second, err := stager.Stage(ctx, task.ID, "/workspace/main.go", ptr("package main\n\nfunc main() {}\n"), "package app\n")
+	if err != nil {
+		t.Fatalf("failed to stage: %v", err)
+	}
+	if second.ID != change.ID {
+		t.Errorf("expected the pending change to be updated, got a new change")
+	}
+	if *second.OriginalContent != "package main\n" || second.Content != "package app\n" {
+		t.Errorf("unexpected change: original %q, content %q", *second.OriginalContent, second.Content)
+	}
+
+	// an edit that restores the original content drops the change
+	if _, err := stager.Stage(ctx, task.ID, "/workspace/main.go", ptr("package app\n"), "package main\n"); err != nil {
+		t.Fatalf("failed to stage: %v", err)
+	}
+	if count := db.FileChange.Query().CountX(ctx); count != 0 {
+		t.Errorf("expected the change to be dropped, got %d changes", count)
+	}
+
+	unchanged, err := stager.Stage(ctx, task.ID, "/workspace/main.go", ptr("package main\n"), "package main\n")
+	if err != nil {
+		t.Fatalf("failed to stage: %v", err)
+	}
+	if unchanged != nil {
+		t.Errorf("expected no change for an edit that does not change the file, got %v", unchanged)
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	ctx := context.Background()
+	db, task := newTestDatabase(t)
+
+	workspace := afero.NewMemMapFs()
+	if err := afero.WriteFile(workspace, "/workspace/main.go", []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	stager := NewStager(db, workspace)
+
+	if _, err := stager.Stage(ctx, task.ID, "/workspace/main.go", ptr("package main\n"), "package app\n"); err != nil {
+		t.Fatalf("failed to stage: %v", err)
+	}
+	rejected, err := stager.Stage(ctx, task.ID, "/workspace/old.go", nil, "package old\n")
+	if err != nil {
+		t.Fatalf("failed to stage: %v", err)
+	}
+	if _, err := stager.Reject(ctx, rejected.ID, ""); err != nil {
+		t.Fatalf("failed to reject: %v", err)
+	}
+
+	overlay, err := stager.Overlay(ctx, task.ID, workspace)
+	if err != nil {
+		t.Fatalf("failed to create overlay: %v", err)
+	}
+
+	if content, _ := afero.ReadFile(overlay, "/workspace/main.go"); string(content) != "package app\n" {
+		t.Errorf("expected the overlay to show the pending change, got %q", content)
+	}
+	if exists, _ := afero.Exists(overlay, "/workspace/old.go"); exists {
+		t.Errorf("expected the overlay not to show rejected changes")
+	}
+
+	if err := afero.WriteFile(overlay, "/workspace/new.go", []byte("package new\n"), 0644); err != nil {
+		t.Fatalf("failed to write to overlay: %v", err)
+	}
+	if content, _ := afero.ReadFile(workspace, "/workspace/main.go"); string(content) != "package main\n" {
+		t.Errorf("expected the workspace to keep its content, got %q", content)
+	}
+	if exists, _ := afero.Exists(workspace, "/workspace/new.go"); exists {
+		t.Errorf("expected writes to the overlay not to reach the workspace")
+	}
+}
+
+func TestAccept(t *testing.T) {
+	tests := []struct {
+		name        string
+		original    *string
+		current     *string
+		force       bool
+		wantErr     error
+		wantContent string
+	}{
+		{
+			name:        "writes modified file",
+			original:    ptr("package main\n"),
+			current:     ptr("package main\n"),
+			wantContent: "package app\n",
+		},
+		{
+			name:        "writes created file",
+			wantContent: "package app\n",
+		},
+		{
+			name:        "conflict with later edit",
+			original:    ptr("package main\n"),
+			current:     ptr("package edited\n"),
+			wantErr:     ErrConflict,
+			wantContent: "package edited\n",
+		},
+		{
+			name:        "conflict with file created since",
+			current:     ptr("package edited\n"),
+			wantErr:     ErrConflict,
+			wantContent: "package edited\n",
+		},
+		{
+			name:        "force overrides later edit",
+			original:    ptr("package main\n"),
+			current:     ptr("package edited\n"),
+			force:       true,
+			wantContent: "package app\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db, task := newTestDatabase(t)
+
+			fs := afero.NewMemMapFs()
+			stager := NewStager(db, fs)
+			if tt.current != nil {
+				if err := afero.WriteFile(fs, "/workspace/main.go", []byte(*tt.current), 0644); err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			change, err := stager.Stage(ctx, task.ID, "/workspace/main.go", tt.original, "package app\n")
+			if err != nil {
+				t.Fatalf("failed to stage: %v", err)
+			}
+
+			accepted, err := stager.Accept(ctx, change.ID, tt.force)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if content, _ := afero.ReadFile(fs, "/workspace/main.go"); string(content) != tt.wantContent {
+				t.Errorf("expected content %q, got %q", tt.wantContent, content)
+			}
+
+			if tt.wantErr != nil {
+				stored := db.FileChange.Query().Where(filechange.ID(change.ID)).OnlyX(ctx)
+				if stored.Status != types.FileChangeStatusPending {
+					t.Errorf("expected the change to stay pending, got %s", stored.Status)
+				}
+				return
+			}
+
+			if accepted.Status != types.FileChangeStatusAccepted {
+				t.Errorf("expected status accepted, got %s", accepted.Status)
+			}
+			if _, err := stager.Accept(ctx, change.ID, false); !errors.Is(err, ErrNotPending) {
+				t.Errorf("expected ErrNotPending, got %v", err)
+			}
+
+			// later edits of the file start a new change
+			next, err := stager.Stage(ctx, task.ID, "/workspace/main.go", ptr("package app\n"), "package next\n")
+			if err != nil {
+				t.Fatalf("failed to stage: %v", err)
+			}
+			if next.ID == change.ID {
+				t.Errorf("expected a new change after the accepted one")
+			}
+		})
+	}
+}
+
+func TestReject(t *testing.T) {
+	ctx := context.Background()
+	db, task := newTestDatabase(t)
+	db.Task.UpdateOne(task).SetDesiredPhase(types.TaskPhaseSuspended).ExecX(ctx)
+
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, "/workspace/main.go", []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	stager := NewStager(db, fs)
+
+	change, err := stager.Stage(ctx, task.ID, "/workspace/main.go", ptr("package main\n"), "package app\n")
+	if err != nil {
+		t.Fatalf("failed to stage: %v", err)
+	}
+
+	rejected, err := stager.Reject(ctx, change.ID, "keep the package name")
+	if err != nil {
+		t.Fatalf("failed to reject: %v", err)
+	}
+	if rejected.Status != types.FileChangeStatusRejected || rejected.Reason != "keep the package name" {
+		t.Errorf("unexpected rejected change: status %s, reason %q", rejected.Status, rejected.Reason)
+	}
+	if _, err := stager.Reject(ctx, change.ID, ""); !errors.Is(err, ErrNotPending) {
+		t.Errorf("expected ErrNotPending, got %v", err)
+	}
+
+	if content, _ := afero.ReadFile(fs, "/workspace/main.go"); string(content) != "package main\n" {
+		t.Errorf("expected the file to keep its content, got %q", content)
+	}
+
+	messages := db.Message.Query().AllX(ctx)
+	if len(messages) != 1 || messages[0].Source != types.MessageSourceUser {
+		t.Fatalf("expected a user message for the agent, got %v", messages)
+	}
+	if text := messages[0].Content.Blocks[0].Payload; !strings.Contains(text, "Reason: keep the package name") {
+		t.Errorf("expected the message to contain the reason, got %q", text)
+	}
+
+	updated := db.Task.GetX(ctx, task.ID)
+	if updated.DesiredPhase != types.TaskPhaseRunning {
+		t.Errorf("expected the task to be resumed, got desired phase %s", updated.DesiredPhase)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	change := &memory.FileChange{
+		Path:            "/workspace/main.go",
+		OriginalContent: ptr("package main\n\nfunc main() {}\n"),
+		Content:         "package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n",
+	}
+
+	patch, added, removed := Patch(change)
+	if added != 3 || removed != 1 {
+		t.Errorf("expected 3 added and 1 removed lines, got %d and %d", added, removed)
+	}
+	if !strings.Contains(patch, "+\tprintln(\"hello\")") {
+		t.Errorf("expected the patch to contain the added line, got:\n%s", patch)
+	}
+}
+
+func newTestDatabase(t *testing.T) (*memory.Client, *memory.Task) {
+	t.Helper()
+	ctx := context.Background()
+
+	db := test.NewDatabase(t)
+
+	provider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, provider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	task := test.NewTaskBuilder(t, uuid.New(), db, agent).Build(ctx)
+
+	return db, task
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,522 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"text/template"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	memory_message "github.com/furisto/construct/backend/memory/message"
+	memory_schedule "github.com/furisto/construct/backend/memory/schedule"
+	memory_schedulerun "github.com/furisto/construct/backend/memory/schedulerun"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	DefaultInterval = 15 * time.Second
+
+	// MaxCatchUpRuns caps the number of runs started for a single schedule with the
+	// run_all catch-up policy, so that a long downtime does not flood the daemon with tasks.
+	MaxCatchUpRuns = 25
+)
+
+// PromptData is the data available to the prompt template of a schedule.
+type PromptData struct {
+	ScheduleID    string
+	ScheduleName  string
+	ScheduledTime time.Time
+	Trigger       string
+	Workspace     string
+}
+
+type Options struct {
+	Interval time.Duration
+	Clock    func() time.Time
+}
+
+type Option func(*Options)
+
+func WithInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.Interval = interval
+	}
+}
+
+func WithClock(clock func() time.Time) Option {
+	return func(o *Options) {
+		o.Clock = clock
+	}
+}
+
+// Scheduler starts tasks for schedules whose cron expression fired and records every
+// run in the schedule history.
+type Scheduler struct {
+	memory   *memory.Client
+	bus      *event.Bus
+	interval time.Duration
+	now      func() time.Time
+	logger   *slog.Logger
+
+	// mu serializes ticks and manual triggers so that the overlap policy is evaluated
+	// against a consistent view of the previous runs. Schedulers of other daemons sharing
+	// the database are kept apart by claiming each due schedule, see claim.
+	mu sync.Mutex
+}
+
+func NewScheduler(memory *memory.Client, bus *event.Bus, opts ...Option) *Scheduler {
+	options := &Options{
+		Interval: DefaultInterval,
+		Clock:    time.Now,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &Scheduler{
+		memory:   memory,
+		bus:      bus,
+		interval: options.Interval,
+		now:      options.Clock,
+		logger:   slog.With("component", "scheduler"),
+	}
+}
+
+// ParseCronExpression parses a standard five field cron expression. Descriptors like
+// @daily and a CRON_TZ= prefix are supported as well.
+func ParseCronExpression(expression string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expression, err)
+	}
+
+	return schedule, nil
+}
+
+// NextRunTime returns the first time after the given time at which the cron expression fires.
+func NextRunTime(expression string, after time.Time) (time.Time, error) {
+	schedule, err := ParseCronExpression(expression)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return schedule.Next(after), nil
+}
+
+// ParsePromptTemplate parses the prompt template of a schedule.
+func ParsePromptTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("prompt").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prompt template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.logger.InfoContext(ctx, "scheduler starting", "interval", s.interval)
+
+	unsubscribe := s.Subscribe()
+	defer unsubscribe()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Tick(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "scheduler tick failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			s.logger.InfoContext(ctx, "scheduler stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Subscribe registers the scheduler on the bus, so that started runs are marked as completed
+// or failed when their task finishes. The returned function removes the subscriptions.
+func (s *Scheduler) Subscribe() func() {
+	subscriptions := []*event.Subscription{
+		event.Subscribe(s.bus, func(ctx context.Context, e event.TaskCompletedEvent) {
+			s.finish(ctx, e.TaskID, types.ScheduleRunStatusCompleted, "")
+		}, nil),
+		event.Subscribe(s.bus, func(ctx context.Context, e event.TaskFailedEvent) {
+			s.finish(ctx, e.TaskID, types.ScheduleRunStatusFailed, e.Error)
+		}, nil),
+	}
+
+	return func() {
+		for _, sub := range subscriptions {
+			sub.Unsubscribe()
+		}
+	}
+}
+
+// Tick starts all runs that are due. Runs that were missed because the daemon was not
+// running are handled according to the catch-up policy of the schedule.
+func (s *Scheduler) Tick(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	due, err := s.memory.Schedule.Query().
+		Where(
+			memory_schedule.Enabled(true),
+			memory_schedule.NextRunTimeNotNil(),
+			memory_schedule.NextRunTimeLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query due schedules: %w", err)
+	}
+
+	for _, schedule := range due {
+		if err := s.fire(ctx, schedule, now); err != nil {
+			s.logger.ErrorContext(ctx, "failed to fire schedule",
+				"schedule_id", schedule.ID,
+				"error", err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Trigger starts a run of the schedule immediately, regardless of its cron expression
+// and whether it is enabled.
+func (s *Scheduler) Trigger(ctx context.Context, scheduleID uuid.UUID) (*memory.ScheduleRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, err := s.memory.Schedule.Get(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	run, err := s.start(ctx, schedule, now, types.ScheduleRunTriggerManual)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.memory.Schedule.UpdateOneID(schedule.ID).SetLastRunTime(now).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+type plannedRun struct {
+	scheduledTime time.Time
+	trigger       types.ScheduleRunTrigger
+}
+
+func (s *Scheduler) fire(ctx context.Context, schedule *memory.Schedule, now time.Time) error {
+	cronSchedule, err := ParseCronExpression(schedule.CronExpression)
+	if err != nil {
+		return err
+	}
+
+	runs := s.plan(schedule, cronSchedule, now)
+	claimed, err := s.claim(ctx, schedule, cronSchedule.Next(now), runs)
+	if err != nil {
+		return err
+	}
+
+	if !claimed {
+		s.logger.DebugContext(ctx, "schedule was fired by another scheduler",
+			"schedule_id", schedule.ID,
+		)
+		return nil
+	}
+
+	for _, run := range runs {
+		_, err := s.start(ctx, schedule, run.scheduledTime, run.trigger)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to start scheduled run",
+				"schedule_id", schedule.ID,
+				"scheduled_time", run.scheduledTime,
+				"error", err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// claim advances the next run time of the schedule, but only if no other scheduler
+// sharing the database did so since the schedule was loaded. Only the scheduler that
+// claimed a due schedule starts its runs, so every fire time is started exactly once.
+func (s *Scheduler) claim(ctx context.Context, schedule *memory.Schedule, next time.Time, runs []plannedRun) (bool, error) {
+	update := s.memory.Schedule.Update().
+		Where(
+			memory_schedule.ID(schedule.ID),
+			memory_schedule.NextRunTime(schedule.NextRunTime),
+		)
+	if !next.IsZero() {
+		update = update.SetNextRunTime(next)
+	} else {
+		update = update.ClearNextRunTime()
+	}
+
+	if len(runs) > 0 {
+		update = update.SetLastRunTime(runs[len(runs)-1].scheduledTime)
+	}
+
+	affected, err := update.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim schedule: %w", err)
+	}
+
+	return affected == 1, nil
+}
+
+// plan decides which runs to start for a due schedule. A fire time within two scheduler
+// intervals of now is on time; everything older was missed and is subject to the
+// catch-up policy.
+func (s *Scheduler) plan(schedule *memory.Schedule, cronSchedule cron.Schedule, now time.Time) []plannedRun {
+	var missed []time.Time
+	for t := schedule.NextRunTime; !t.IsZero() && !t.After(now); t = cronSchedule.Next(t) {
+		missed = append(missed, t)
+		if len(missed) > MaxCatchUpRuns {
+			missed = missed[1:]
+		}
+	}
+
+	if len(missed) == 0 {
+		return nil
+	}
+
+	latest := missed[len(missed)-1]
+	onTime := now.Sub(latest) <= 2*s.interval
+
+	switch schedule.CatchUpPolicy {
+	case types.ScheduleCatchUpPolicySkip:
+		if onTime {
+			return []plannedRun{{scheduledTime: latest, trigger: types.ScheduleRunTriggerScheduled}}
+		}
+		return nil
+	case types.ScheduleCatchUpPolicyRunAll:
+		runs := make([]plannedRun, 0, len(missed))
+		for i, t := range missed {
+			trigger := types.ScheduleRunTriggerCatchUp
+			if onTime && i == len(missed)-1 {
+				trigger = types.ScheduleRunTriggerScheduled
+			}
+			runs = append(runs, plannedRun{scheduledTime: t, trigger: trigger})
+		}
+		return runs
+	default:
+		trigger := types.ScheduleRunTriggerCatchUp
+		if onTime {
+			trigger = types.ScheduleRunTriggerScheduled
+		}
+		return []plannedRun{{scheduledTime: latest, trigger: trigger}}
+	}
+}
+
+func (s *Scheduler) start(ctx context.Context, schedule *memory.Schedule, scheduledTime time.Time, trigger types.ScheduleRunTrigger) (*memory.ScheduleRun, error) {
+	activeTaskID, err := s.activeTask(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	if activeTaskID != uuid.Nil {
+		switch schedule.OverlapPolicy {
+		case types.ScheduleOverlapPolicySkip:
+			return s.recordRun(ctx, schedule, scheduledTime, trigger, types.ScheduleRunStatusSkipped,
+				fmt.Sprintf("previous run is still in progress (task %s)", activeTaskID))
+		case types.ScheduleOverlapPolicyReplace:
+			if err := s.suspendTask(ctx, activeTaskID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	prompt, err := renderPrompt(schedule, scheduledTime, trigger)
+	if err != nil {
+		return s.recordRun(ctx, schedule, scheduledTime, trigger, types.ScheduleRunStatusFailed, err.Error())
+	}
+
+	run, err := memory.Transaction(ctx, s.memory, func(tx *memory.Client) (*memory.ScheduleRun, error) {
+		taskCreate := tx.Task.Create().
+			SetAgentID(schedule.AgentID).
+			SetOwner(schedule.Owner).
+			SetTeam(schedule.Team).
+			SetDescription(fmt.Sprintf("%s (%s)", schedule.Name, scheduledTime.Format(time.RFC3339)))
+		if schedule.Workspace != "" {
+			taskCreate = taskCreate.SetProjectDirectory(schedule.Workspace)
+		}
+
+		task, err := taskCreate.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Message.Create().
+			SetTask(task).
+			SetContent(&types.MessageContent{
+				Blocks: []types.MessageBlock{
+					{
+						Kind:    types.MessageBlockKindText,
+						Payload: prompt,
+					},
+				},
+			}).
+			SetSource(types.MessageSourceUser).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return tx.ScheduleRun.Create().
+			SetScheduleID(schedule.ID).
+			SetTask(task).
+			SetTrigger(trigger).
+			SetStatus(types.ScheduleRunStatusStarted).
+			SetScheduledTime(scheduledTime).
+			Save(ctx)
+	})
+
+	if err != nil {
+		return s.recordRun(ctx, schedule, scheduledTime, trigger, types.ScheduleRunStatusFailed, err.Error())
+	}
+
+	s.logger.InfoContext(ctx, "scheduled run started",
+		"schedule_id", schedule.ID,
+		"task_id", run.TaskID,
+		"trigger", trigger,
+	)
+
+	event.Publish(s.bus, event.TaskEvent{
+		TaskID: run.TaskID,
+	})
+
+	return run, nil
+}
+
+// activeTask returns the task of the latest started run if it is still in progress, i.e.
+// it has not been suspended and still has messages the reconciler has not processed.
+func (s *Scheduler) activeTask(ctx context.Context, schedule *memory.Schedule) (uuid.UUID, error) {
+	lastRun, err := s.memory.ScheduleRun.Query().
+		Where(
+			memory_schedulerun.ScheduleID(schedule.ID),
+			memory_schedulerun.StatusEQ(types.ScheduleRunStatusStarted),
+			memory_schedulerun.TaskIDNotNil(),
+		).
+		Order(memory_schedulerun.ByCreateTime(sql.OrderDesc())).
+		WithTask().
+		First(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			return uuid.Nil, nil
+		}
+		return uuid.Nil, err
+	}
+
+	if lastRun.Edges.Task == nil || lastRun.Edges.Task.DesiredPhase == types.TaskPhaseSuspended {
+		return uuid.Nil, nil
+	}
+
+	inProgress, err := s.memory.Message.Query().
+		Where(
+			memory_message.TaskID(lastRun.TaskID),
+			memory_message.ProcessedTimeIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if !inProgress {
+		return uuid.Nil, nil
+	}
+
+	return lastRun.TaskID, nil
+}
+
+// finish sets the status of the started run of the task. Tasks that were not started by a
+// schedule have no run and are ignored.
+func (s *Scheduler) finish(ctx context.Context, taskID uuid.UUID, status types.ScheduleRunStatus, reason string) {
+	update := s.memory.ScheduleRun.Update().
+		Where(
+			memory_schedulerun.TaskID(taskID),
+			memory_schedulerun.StatusEQ(types.ScheduleRunStatusStarted),
+		).
+		SetStatus(status)
+	if reason != "" {
+		update = update.SetError(reason)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		s.logger.ErrorContext(ctx, "failed to finish scheduled run",
+			"task_id", taskID,
+			"status", status,
+			"error", err,
+		)
+	}
+}
+
+func (s *Scheduler) suspendTask(ctx context.Context, taskID uuid.UUID) error {
+	err := s.memory.Task.UpdateOneID(taskID).
+		SetDesiredPhase(types.TaskPhaseSuspended).
+		SetPhase(types.TaskPhaseSuspended).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	event.Publish(s.bus, event.TaskSuspendedEvent{
+		TaskID: taskID,
+	})
+
+	return nil
+}
+
+func (s *Scheduler) recordRun(ctx context.Context, schedule *memory.Schedule, scheduledTime time.Time, trigger types.ScheduleRunTrigger, status types.ScheduleRunStatus, reason string) (*memory.ScheduleRun, error) {
+	s.logger.InfoContext(ctx, "scheduled run not started",
+		"schedule_id", schedule.ID,
+		"status", status,
+		"reason", reason,
+	)
+
+	return s.memory.ScheduleRun.Create().
+		SetScheduleID(schedule.ID).
+		SetTrigger(trigger).
+		SetStatus(status).
+		SetScheduledTime(scheduledTime).
+		SetError(reason).
+		Save(ctx)
+}
+
+func renderPrompt(schedule *memory.Schedule, scheduledTime time.Time, trigger types.ScheduleRunTrigger) (string, error) {
+	tmpl, err := ParsePromptTemplate(schedule.PromptTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, PromptData{
+		ScheduleID:    schedule.ID.String(),
+		ScheduleName:  schedule.Name,
+		ScheduledTime: scheduledTime,
+		Trigger:       string(trigger),
+		Workspace:     schedule.Workspace,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render prompt template: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,309 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	memory_schedulerun "github.com/furisto/construct/backend/memory/schedulerun"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+type runSummary struct {
+	Trigger       types.ScheduleRunTrigger
+	Status        types.ScheduleRunStatus
+	ScheduledTime time.Time
+}
+
+func TestTick(t *testing.T) {
+	now := time.Date(2025, 6, 10, 12, 0, 5, 0, time.UTC)
+	hour := func(h int) time.Time {
+		return time.Date(2025, 6, 10, h, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name          string
+		cron          string
+		catchUpPolicy types.ScheduleCatchUpPolicy
+		overlapPolicy types.ScheduleOverlapPolicy
+		nextRunTime   time.Time
+		ticks         int
+		expected      []runSummary
+		expectedNext  time.Time
+	}{
+		{
+			name:          "on time run",
+			catchUpPolicy: types.ScheduleCatchUpPolicySkip,
+			overlapPolicy: types.ScheduleOverlapPolicyAllow,
+			nextRunTime:   hour(12),
+			ticks:         1,
+			expected: []runSummary{
+				{Trigger: types.ScheduleRunTriggerScheduled, Status: types.ScheduleRunStatusStarted, ScheduledTime: hour(12)},
+			},
+			expectedNext: hour(13),
+		},
+		{
+			name:          "missed runs are skipped",
+			catchUpPolicy: types.ScheduleCatchUpPolicySkip,
+			overlapPolicy: types.ScheduleOverlapPolicyAllow,
+			nextRunTime:   hour(9),
+			ticks:         1,
+			expected: []runSummary{
+				{Trigger: types.ScheduleRunTriggerScheduled, Status: types.ScheduleRunStatusStarted, ScheduledTime: hour(12)},
+			},
+			expectedNext: hour(13),
+		},
+		{
+			name:          "missed runs are caught up once",
+			cron:          "0 2 * * *",
+			catchUpPolicy: types.ScheduleCatchUpPolicyRunOnce,
+			overlapPolicy: types.ScheduleOverlapPolicyAllow,
+			nextRunTime:   hour(2).AddDate(0, 0, -2),
+			ticks:         2,
+			expected: []runSummary{
+				{Trigger: types.ScheduleRunTriggerCatchUp, Status: types.ScheduleRunStatusStarted, ScheduledTime: hour(2)},
+			},
+			expectedNext: hour(2).AddDate(0, 0, 1),
+		},
+		{
+			name:          "every missed run is caught up",
+			catchUpPolicy: types.ScheduleCatchUpPolicyRunAll,
+			overlapPolicy: types.ScheduleOverlapPolicyAllow,
+			nextRunTime:   hour(10),
+			ticks:         1,
+			expected: []runSummary{
+				{Trigger: types.ScheduleRunTriggerCatchUp, Status: types.ScheduleRunStatusStarted, ScheduledTime: hour(10)},
+				{Trigger: types.ScheduleRunTriggerCatchUp, Status: types.ScheduleRunStatusStarted, ScheduledTime: hour(11)},
+				{Trigger: types.ScheduleRunTriggerScheduled, Status: types.ScheduleRunStatusStarted, ScheduledTime: hour(12)},
+			},
+			expectedNext: hour(13),
+		},
+		{
+			name:          "overlapping runs are skipped",
+			catchUpPolicy: types.ScheduleCatchUpPolicyRunAll,
+			overlapPolicy: types.ScheduleOverlapPolicySkip,
+			nextRunTime:   hour(11),
+			ticks:         1,
+			expected: []runSummary{
+				{Trigger: types.ScheduleRunTriggerCatchUp, Status: types.ScheduleRunStatusStarted, ScheduledTime: hour(11)},
+				{Trigger: types.ScheduleRunTriggerScheduled, Status: types.ScheduleRunStatusSkipped, ScheduledTime: hour(12)},
+			},
+			expectedNext: hour(13),
+		},
+		{
+			name:          "not due",
+			catchUpPolicy: types.ScheduleCatchUpPolicyRunOnce,
+			overlapPolicy: types.ScheduleOverlapPolicyAllow,
+			nextRunTime:   hour(13),
+			ticks:         2,
+			expected:      []runSummary{},
+			expectedNext:  hour(13),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := test.NewDatabase(t)
+
+			modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+			model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+			agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+			cron := tt.cron
+			if cron == "" {
+				cron = "0 * * * *"
+			}
+
+			schedule := test.NewScheduleBuilder(t, uuid.New(), db, agent).
+				WithCronExpression(cron).
+				WithCatchUpPolicy(tt.catchUpPolicy).
+				WithOverlapPolicy(tt.overlapPolicy).
+				WithNextRunTime(tt.nextRunTime).
+				Build(ctx)
+
+			scheduler := NewScheduler(db, event.NewBus(nil), WithClock(func() time.Time { return now }))
+			for range tt.ticks {
+				if err := scheduler.Tick(ctx); err != nil {
+					t.Fatalf("tick failed: %v", err)
+				}
+			}
+
+			runs, err := db.ScheduleRun.Query().
+				Where(memory_schedulerun.ScheduleID(schedule.ID)).
+				Order(memory_schedulerun.ByScheduledTime(sql.OrderAsc())).
+				All(ctx)
+			if err != nil {
+				t.Fatalf("failed to query runs: %v", err)
+			}
+
+			actual := make([]runSummary, 0, len(runs))
+			for _, run := range runs {
+				actual = append(actual, runSummary{
+					Trigger:       run.Trigger,
+					Status:        run.Status,
+					ScheduledTime: run.ScheduledTime.UTC(),
+				})
+			}
+
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Errorf("runs mismatch (-want +got):\n%s", diff)
+			}
+
+			schedule, err = db.Schedule.Get(ctx, schedule.ID)
+			if err != nil {
+				t.Fatalf("failed to get schedule: %v", err)
+			}
+
+			if !schedule.NextRunTime.Equal(tt.expectedNext) {
+				t.Errorf("next run time mismatch: want %v, got %v", tt.expectedNext, schedule.NextRunTime)
+			}
+		})
+	}
+}
+
+func TestTickSharedDatabase(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+	now := time.Date(2025, 6, 10, 12, 0, 5, 0, time.UTC)
+
+	modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	schedule := test.NewScheduleBuilder(t, uuid.New(), db, agent).
+		WithCronExpression("0 * * * *").
+		WithCatchUpPolicy(types.ScheduleCatchUpPolicyRunAll).
+		WithOverlapPolicy(types.ScheduleOverlapPolicyAllow).
+		WithNextRunTime(time.Date(2025, 6, 10, 11, 0, 0, 0, time.UTC)).
+		Build(ctx)
+
+	// Both schedulers saw the schedule as due before either of them fired it, as two
+	// daemons ticking at the same time would.
+	due, err := db.Schedule.Get(ctx, schedule.ID)
+	if err != nil {
+		t.Fatalf("failed to get schedule: %v", err)
+	}
+
+	for range 2 {
+		scheduler := NewScheduler(db, event.NewBus(nil), WithClock(func() time.Time { return now }))
+		if err := scheduler.fire(ctx, due, now); err != nil {
+			t.Fatalf("fire failed: %v", err)
+		}
+	}
+
+	runs, err := db.ScheduleRun.Query().Where(memory_schedulerun.ScheduleID(schedule.ID)).Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to count runs: %v", err)
+	}
+	if runs != 2 {
+		t.Errorf("expected 2 runs, got %d", runs)
+	}
+
+	tasks, err := db.Task.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to count tasks: %v", err)
+	}
+	if tasks != 2 {
+		t.Errorf("expected 2 tasks, got %d", tasks)
+	}
+}
+
+func TestFinish(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+
+	modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	schedule := test.NewScheduleBuilder(t, uuid.New(), db, agent).
+		WithOverlapPolicy(types.ScheduleOverlapPolicyAllow).
+		Build(ctx)
+
+	scheduler := NewScheduler(db, event.NewBus(nil))
+
+	completed, err := scheduler.Trigger(ctx, schedule.ID)
+	if err != nil {
+		t.Fatalf("failed to trigger schedule: %v", err)
+	}
+	failed, err := scheduler.Trigger(ctx, schedule.ID)
+	if err != nil {
+		t.Fatalf("failed to trigger schedule: %v", err)
+	}
+	running, err := scheduler.Trigger(ctx, schedule.ID)
+	if err != nil {
+		t.Fatalf("failed to trigger schedule: %v", err)
+	}
+
+	scheduler.finish(ctx, completed.TaskID, types.ScheduleRunStatusCompleted, "")
+	scheduler.finish(ctx, failed.TaskID, types.ScheduleRunStatusFailed, "model unavailable")
+	// tasks that were not started by a schedule have no run
+	scheduler.finish(ctx, uuid.New(), types.ScheduleRunStatusCompleted, "")
+
+	expected := map[uuid.UUID]struct {
+		Status types.ScheduleRunStatus
+		Error  string
+	}{
+		completed.ID: {Status: types.ScheduleRunStatusCompleted},
+		failed.ID:    {Status: types.ScheduleRunStatusFailed, Error: "model unavailable"},
+		running.ID:   {Status: types.ScheduleRunStatusStarted},
+	}
+
+	for id, want := range expected {
+		run, err := db.ScheduleRun.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("failed to get run: %v", err)
+		}
+		if run.Status != want.Status || run.Error != want.Error {
+			t.Errorf("run %s: expected status %q and error %q, got %q and %q", id, want.Status, want.Error, run.Status, run.Error)
+		}
+	}
+}
+
+func TestStartOwner(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+
+	modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	schedule := test.NewScheduleBuilder(t, uuid.New(), db, agent).
+		WithOwner("alice", "platform").
+		Build(ctx)
+
+	run, err := NewScheduler(db, event.NewBus(nil)).Trigger(ctx, schedule.ID)
+	if err != nil {
+		t.Fatalf("failed to trigger schedule: %v", err)
+	}
+
+	task, err := db.Task.Get(ctx, run.TaskID)
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if task.Owner != "alice" || task.Team != "platform" {
+		t.Errorf("expected task to be owned by alice of team platform, got %q and %q", task.Owner, task.Team)
+	}
+}
+
+func TestRenderPrompt(t *testing.T) {
+	schedule := &memory.Schedule{
+		ID:             uuid.New(),
+		Name:           "nightly",
+		Workspace:      "/src/project",
+		PromptTemplate: "{{.ScheduleName}} in {{.Workspace}} on {{.ScheduledTime.Format \"2006-01-02\"}} ({{.Trigger}})",
+	}
+
+	prompt, err := renderPrompt(schedule, time.Date(2025, 6, 10, 2, 0, 0, 0, time.UTC), types.ScheduleRunTriggerCatchUp)
+	if err != nil {
+		t.Fatalf("failed to render prompt: %v", err)
+	}
+
+	expected := "nightly in /src/project on 2025-06-10 (catch_up)"
+	if prompt != expected {
+		t.Errorf("prompt mismatch: want %q, got %q", expected, prompt)
+	}
+}
@@ -0,0 +1,127 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/afero"
+	"golang.org/x/crypto/argon2"
+)
+
+// ErrWrongPassphrase is returned if a secret cannot be opened with the passphrase of the
+// provider.
+var ErrWrongPassphrase = errors.New("wrong passphrase")
+
+const kdfArgon2id = "argon2id"
+
+// sealedSecret is the content of a secret file of the EncryptedFileProvider. The key is
+// derived from the passphrase with Argon2id and a salt that is unique to the file.
+type sealedSecret struct {
+	KDF        string `json:"kdf"`
+	Salt       []byte `json:"salt"`
+	Time       uint32 `json:"time"`
+	Memory     uint32 `json:"memory"`
+	Threads    uint8  `json:"threads"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptedFileProvider stores every secret in a file like the FileProvider, but sealed
+// with AES-256-GCM under a key derived from a passphrase. The name of the secret is bound
+// to its file as associated data, so that files cannot be swapped.
+type EncryptedFileProvider struct {
+	files      *FileProvider
+	passphrase string
+}
+
+func NewEncryptedFileProvider(basePath string, fs afero.Fs, passphrase string) (*EncryptedFileProvider, error) {
+	if passphrase == "" {
+		return nil, fmt.Errorf("a passphrase is required for encrypted secret files")
+	}
+
+	files, err := NewFileProvider(basePath, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EncryptedFileProvider{
+		files:      files,
+		passphrase: passphrase,
+	}, nil
+}
+
+func (p *EncryptedFileProvider) Get(key string) (string, error) {
+	data, err := p.files.Get(key)
+	if err != nil {
+		return "", err
+	}
+
+	var sealed sealedSecret
+	if err := json.Unmarshal([]byte(data), &sealed); err != nil {
+		return "", fmt.Errorf("secret file %s is not encrypted: %w", key, err)
+	}
+	if sealed.KDF != kdfArgon2id {
+		return "", fmt.Errorf("secret file %s uses unknown key derivation %q", key, sealed.KDF)
+	}
+
+	aead, err := p.aead(&sealed)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed.Ciphertext) < aead.NonceSize() {
+		return "", fmt.Errorf("secret file %s is too short", key)
+	}
+
+	nonce, ciphertext := sealed.Ciphertext[:aead.NonceSize()], sealed.Ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(key))
+	if err != nil {
+		return "", fmt.Errorf("failed to open secret %s: %w", key, ErrWrongPassphrase)
+	}
+	return string(plaintext), nil
+}
+
+func (p *EncryptedFileProvider) Set(key string, value string) error {
+	sealed := sealedSecret{
+		KDF:     kdfArgon2id,
+		Salt:    make([]byte, 16),
+		Time:    1,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+	if _, err := rand.Read(sealed.Salt); err != nil {
+		return err
+	}
+
+	aead, err := p.aead(&sealed)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	sealed.Ciphertext = aead.Seal(nonce, nonce, []byte(value), []byte(key))
+
+	data, err := json.Marshal(sealed)
+	if err != nil {
+		return &ErrSecretMarshal{Key: key, Err: err}
+	}
+	return p.files.Set(key, string(data))
+}
+
+func (p *EncryptedFileProvider) Delete(key string) error {
+	return p.files.Delete(key)
+}
+
+func (p *EncryptedFileProvider) aead(sealed *sealedSecret) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(p.passphrase), sealed.Salt, sealed.Time, sealed.Memory, sealed.Threads, 32)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
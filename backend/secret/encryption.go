@@ -3,6 +3,7 @@ package secret
 import (
 	"bytes"
 	"fmt"
+	"sync"
 
 	"github.com/tink-crypto/tink-go/aead"
 	"github.com/tink-crypto/tink-go/insecurecleartextkeyset"
@@ -14,7 +15,10 @@ func GenerateKeyset() (*keyset.Handle, error) {
 	return keyset.NewHandle(aead.AES256GCMKeyTemplate())
 }
 
+// Encryption encrypts and decrypts secrets with the primary key of a keyset. The keyset can
+// be replaced while the client is in use, which is how keys are rotated in a running daemon.
 type Encryption struct {
+	mu     sync.RWMutex
 	keyset *keyset.Handle
 	aead   tink.AEAD
 }
@@ -31,12 +35,38 @@ func NewClient(keysetHandle *keyset.Handle) (*Encryption, error) {
 	}, nil
 }
 
+// Keyset returns the keyset the client currently uses.
+func (c *Encryption) Keyset() *keyset.Handle {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.keyset
+}
+
+// SetKeyset replaces the keyset of the client. Secrets encrypted afterwards use the primary
+// key of the new keyset.
+func (c *Encryption) SetKeyset(keysetHandle *keyset.Handle) error {
+	aeadPrimitive, err := aead.New(keysetHandle)
+	if err != nil {
+		return fmt.Errorf("aead.New failed: %v", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.keyset = keysetHandle
+	c.aead = aeadPrimitive
+	return nil
+}
+
 func (c *Encryption) Encrypt(plaintext []byte, associatedData []byte) ([]byte, error) {
 	if plaintext == nil {
 		return nil, fmt.Errorf("plaintext cannot be nil")
 	}
 
+	c.mu.RLock()
 	ciphertext, err := c.aead.Encrypt(plaintext, associatedData)
+	c.mu.RUnlock()
 	if err != nil {
 		return nil, fmt.Errorf("encryption failed: %v", err)
 	}
@@ -49,7 +79,9 @@ func (c *Encryption) Decrypt(ciphertext []byte, associatedData []byte) ([]byte,
 		return nil, fmt.Errorf("ciphertext cannot be nil")
 	}
 
+	c.mu.RLock()
 	plaintext, err := c.aead.Decrypt(ciphertext, associatedData)
+	c.mu.RUnlock()
 	if err != nil {
 		return nil, fmt.Errorf("decryption failed: %v", err)
 	}
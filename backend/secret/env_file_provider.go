@@ -0,0 +1,141 @@
+package secret
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// EnvFileProvider stores secrets as variables in a file in dotenv format, which can be
+// shared with systemd's EnvironmentFile or mounted from a Kubernetes secret. The secret
+// encryption_key is stored in the variable CONSTRUCT_ENCRYPTION_KEY.
+type EnvFileProvider struct {
+	path string
+	fs   afero.Fs
+}
+
+func NewEnvFileProvider(path string, fs afero.Fs) *EnvFileProvider {
+	return &EnvFileProvider{
+		path: path,
+		fs:   fs,
+	}
+}
+
+// EnvFileVariable returns the name of the variable that holds a secret.
+func EnvFileVariable(key string) string {
+	return "CONSTRUCT_" + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(key))
+}
+
+func (p *EnvFileProvider) Get(key string) (string, error) {
+	lines, err := p.read()
+	if err != nil {
+		return "", err
+	}
+
+	variable := EnvFileVariable(key)
+	for _, line := range lines {
+		name, value, ok := parseEnvLine(line)
+		if ok && name == variable {
+			return value, nil
+		}
+	}
+	return "", &ErrSecretNotFound{Key: key, Err: fmt.Errorf("%s is not set in %s", variable, p.path)}
+}
+
+func (p *EnvFileProvider) Set(key string, value string) error {
+	lines, err := p.read()
+	if err != nil {
+		return err
+	}
+
+	variable := EnvFileVariable(key)
+	entry := variable + "=" + strconv.Quote(value)
+
+	replaced := false
+	for i, line := range lines {
+		if name, _, ok := parseEnvLine(line); ok && name == variable {
+			lines[i] = entry
+			replaced = true
+		}
+	}
+	if !replaced {
+		lines = append(lines, entry)
+	}
+	return p.write(lines)
+}
+
+func (p *EnvFileProvider) Delete(key string) error {
+	lines, err := p.read()
+	if err != nil {
+		return err
+	}
+
+	variable := EnvFileVariable(key)
+	kept := lines[:0]
+	for _, line := range lines {
+		if name, _, ok := parseEnvLine(line); ok && name == variable {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return p.write(kept)
+}
+
+func (p *EnvFileProvider) read() ([]string, error) {
+	data, err := afero.ReadFile(p.fs, p.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read env file: %w", err)
+	}
+
+	content := strings.TrimSuffix(string(data), "\n")
+	if content == "" {
+		return nil, nil
+	}
+	return strings.Split(content, "\n"), nil
+}
+
+func (p *EnvFileProvider) write(lines []string) error {
+	content := strings.Join(lines, "\n")
+	if content != "" {
+		content += "\n"
+	}
+
+	if err := afero.WriteFile(p.fs, p.path, []byte(content), 0600); err != nil {
+		return fmt.Errorf("failed to write env file: %w", err)
+	}
+	return nil
+}
+
+// parseEnvLine parses a NAME=value line. Values may be wrapped in double quotes with Go
+// escapes or in single quotes, which are taken literally.
+func parseEnvLine(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false
+	}
+	line = strings.TrimPrefix(line, "export ")
+
+	name, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return "", "", false
+	}
+	name = strings.TrimSpace(name)
+	value = strings.TrimSpace(value)
+
+	switch {
+	case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+	case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+		value = value[1 : len(value)-1]
+	}
+	return name, value, true
+}
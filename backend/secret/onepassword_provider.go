@@ -0,0 +1,98 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/shared"
+)
+
+// opCommandTimeout bounds every invocation of the 1Password CLI.
+const opCommandTimeout = 30 * time.Second
+
+// OnePasswordProvider stores secrets as password items in a 1Password vault, using the op
+// CLI. The CLI has to be signed in, for example with a service account token in
+// OP_SERVICE_ACCOUNT_TOKEN. Item titles are the keys of the secrets.
+type OnePasswordProvider struct {
+	vault  string
+	runner shared.CommandRunner
+}
+
+func NewOnePasswordProvider(vault string, runner shared.CommandRunner) (*OnePasswordProvider, error) {
+	if vault == "" {
+		return nil, fmt.Errorf("1password vault is required")
+	}
+	if runner == nil {
+		runner = &shared.DefaultCommandRunner{}
+	}
+
+	return &OnePasswordProvider{
+		vault:  vault,
+		runner: runner,
+	}, nil
+}
+
+func (p *OnePasswordProvider) Get(key string) (string, error) {
+	output, err := p.op("read", "--no-newline", fmt.Sprintf("op://%s/%s/password", p.vault, key))
+	if err != nil {
+		return "", p.toError(key, output, err)
+	}
+	return output, nil
+}
+
+// Set creates the item of the secret or updates its password. The op CLI only takes field
+// values as arguments, so the value is briefly visible in the process list of the machine.
+func (p *OnePasswordProvider) Set(key string, value string) error {
+	output, err := p.op("item", "get", key, "--vault", p.vault, "--format", "json")
+	if err == nil {
+		output, err := p.op("item", "edit", key, "--vault", p.vault, "password="+value)
+		if err != nil {
+			return p.toError(key, output, err)
+		}
+		return nil
+	}
+	if err := p.toError(key, output, err); !errors.Is(err, &ErrSecretNotFound{}) {
+		return err
+	}
+
+	output, err = p.op("item", "create", "--category", "password", "--vault", p.vault, "--title", key, "password="+value)
+	if err != nil {
+		return p.toError(key, output, err)
+	}
+	return nil
+}
+
+func (p *OnePasswordProvider) Delete(key string) error {
+	output, err := p.op("item", "delete", key, "--vault", p.vault)
+	if err != nil {
+		err = p.toError(key, output, err)
+		if errors.Is(err, &ErrSecretNotFound{}) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (p *OnePasswordProvider) op(args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), opCommandTimeout)
+	defer cancel()
+
+	return p.runner.Run(ctx, "op", args...)
+}
+
+func (p *OnePasswordProvider) toError(key, output string, err error) error {
+	output = strings.TrimSpace(output)
+	// only the messages of op for missing items count, a missing CLI must not be taken for
+	// a missing encryption key
+	if strings.Contains(output, "isn't an item") || strings.Contains(output, "could not be found") {
+		return &ErrSecretNotFound{Key: key, Err: errors.New(output)}
+	}
+	if output != "" {
+		return fmt.Errorf("op failed for %s: %s: %w", key, output, err)
+	}
+	return fmt.Errorf("op failed for %s: %w", key, err)
+}
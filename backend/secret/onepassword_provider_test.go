@@ -0,0 +1,120 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeOp simulates the op CLI for a single vault.
+type fakeOp struct {
+	items map[string]string
+	calls [][]string
+}
+
+func (f *fakeOp) Run(ctx context.Context, command string, args ...string) (string, error) {
+	f.calls = append(f.calls, args)
+	failed := errors.New("exit status 1")
+
+	switch {
+	case args[0] == "read":
+		ref := strings.TrimSuffix(strings.TrimPrefix(args[2], "op://construct/"), "/password")
+		value, ok := f.items[ref]
+		if !ok {
+			return fmt.Sprintf(`[ERROR] "%s" isn't an item in the "construct" vault.`, ref), failed
+		}
+		return value, nil
+	case args[0] == "item" && args[1] == "get":
+		if _, ok := f.items[args[2]]; !ok {
+			return fmt.Sprintf(`[ERROR] "%s" isn't an item in the "construct" vault.`, args[2]), failed
+		}
+		return "{}", nil
+	case args[0] == "item" && args[1] == "create":
+		f.items[args[7]] = strings.TrimPrefix(args[8], "password=")
+		return "", nil
+	case args[0] == "item" && args[1] == "edit":
+		f.items[args[2]] = strings.TrimPrefix(args[5], "password=")
+		return "", nil
+	case args[0] == "item" && args[1] == "delete":
+		if _, ok := f.items[args[2]]; !ok {
+			return fmt.Sprintf(`[ERROR] "%s" isn't an item in the "construct" vault.`, args[2]), failed
+		}
+		delete(f.items, args[2])
+		return "", nil
+	}
+	return "unknown command", failed
+}
+
+func TestOnePasswordProvider(t *testing.T) {
+	op := &fakeOp{items: make(map[string]string)}
+	provider, err := NewOnePasswordProvider("construct", op)
+	if err != nil {
+		t.Fatalf("failed to create 1password provider: %v", err)
+	}
+
+	if _, err := provider.Get(EncryptionKeySecret()); !errors.Is(err, &ErrSecretNotFound{}) {
+		t.Fatalf("expected ErrSecretNotFound for a missing item, got %v", err)
+	}
+
+	if err := provider.Set(EncryptionKeySecret(), "first"); err != nil {
+		t.Fatalf("failed to create secret: %v", err)
+	}
+	if err := provider.Set(EncryptionKeySecret(), "second"); err != nil {
+		t.Fatalf("failed to update secret: %v", err)
+	}
+
+	value, err := provider.Get(EncryptionKeySecret())
+	if err != nil {
+		t.Fatalf("failed to get secret: %v", err)
+	}
+	if value != "second" {
+		t.Errorf("expected the updated value, got %q", value)
+	}
+
+	if err := provider.Delete(EncryptionKeySecret()); err != nil {
+		t.Fatalf("failed to delete secret: %v", err)
+	}
+	if err := provider.Delete(EncryptionKeySecret()); err != nil {
+		t.Errorf("expected deleting a missing item to succeed, got %v", err)
+	}
+
+	expected := [][]string{
+		{"read", "--no-newline", "op://construct/encryption_key/password"},
+		{"item", "get", "encryption_key", "--vault", "construct", "--format", "json"},
+		{"item", "create", "--category", "password", "--vault", "construct", "--title", "encryption_key", "password=first"},
+		{"item", "get", "encryption_key", "--vault", "construct", "--format", "json"},
+		{"item", "edit", "encryption_key", "--vault", "construct", "password=second"},
+		{"read", "--no-newline", "op://construct/encryption_key/password"},
+		{"item", "delete", "encryption_key", "--vault", "construct"},
+		{"item", "delete", "encryption_key", "--vault", "construct"},
+	}
+	if diff := cmp.Diff(expected, op.calls); diff != "" {
+		t.Errorf("op calls mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestOnePasswordProviderCLIMissing(t *testing.T) {
+	provider, err := NewOnePasswordProvider("construct", runnerFunc(func(ctx context.Context, command string, args ...string) (string, error) {
+		return "", errors.New(`exec: "op": executable file not found in $PATH`)
+	}))
+	if err != nil {
+		t.Fatalf("failed to create 1password provider: %v", err)
+	}
+
+	if _, err := provider.Get(EncryptionKeySecret()); err == nil || errors.Is(err, &ErrSecretNotFound{}) {
+		t.Errorf("expected a missing CLI not to be reported as a missing secret, got %v", err)
+	}
+	if err := provider.Set(EncryptionKeySecret(), "value"); err == nil {
+		t.Errorf("expected set to fail without the CLI")
+	}
+}
+
+type runnerFunc func(ctx context.Context, command string, args ...string) (string, error)
+
+func (f runnerFunc) Run(ctx context.Context, command string, args ...string) (string, error) {
+	return f(ctx, command, args...)
+}
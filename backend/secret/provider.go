@@ -22,6 +22,14 @@ func ModelProviderAssociated(id uuid.UUID) []byte {
 	return []byte(fmt.Sprintf("model_provider:%s", id))
 }
 
+func WebhookTriggerAssociated(id uuid.UUID) []byte {
+	return []byte(fmt.Sprintf("webhook_trigger:%s", id))
+}
+
+func NotificationSinkAssociated(id uuid.UUID) []byte {
+	return []byte(fmt.Sprintf("notification_sink:%s", id))
+}
+
 func EncryptionKeySecret() string {
 	return "encryption_key"
 }
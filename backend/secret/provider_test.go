@@ -1,7 +1,10 @@
 package secret
 
 import (
+	"errors"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/spf13/afero"
@@ -110,3 +113,124 @@ func TestFileProviderNonExistentKey(t *testing.T) {
 		t.Errorf("Expected ErrSecretNotFound for non-existent key, got %v", err)
 	}
 }
+
+func TestEncryptedFileProvider(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	basePath := "/secrets"
+
+	provider, err := NewEncryptedFileProvider(basePath, fs, "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("Failed to create encrypted file provider: %v", err)
+	}
+
+	testValue := "test_encrypted_value_123"
+	if err := provider.Set("first", testValue); err != nil {
+		t.Fatalf("Failed to set secret: %v", err)
+	}
+	if err := provider.Set("second", "other_value"); err != nil {
+		t.Fatalf("Failed to set secret: %v", err)
+	}
+
+	retrieved, err := provider.Get("first")
+	if err != nil {
+		t.Fatalf("Failed to get secret: %v", err)
+	}
+	if retrieved != testValue {
+		t.Errorf("Expected %q, got %q", testValue, retrieved)
+	}
+
+	content, err := afero.ReadFile(fs, filepath.Join(basePath, "first"))
+	if err != nil {
+		t.Fatalf("Failed to read secret file: %v", err)
+	}
+	if strings.Contains(string(content), testValue) {
+		t.Error("Secret file should not contain the plaintext")
+	}
+
+	wrong, err := NewEncryptedFileProvider(basePath, fs, "wrong passphrase")
+	if err != nil {
+		t.Fatalf("Failed to create encrypted file provider: %v", err)
+	}
+	if _, err := wrong.Get("first"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("Expected ErrWrongPassphrase, got %v", err)
+	}
+
+	// a file copied over another secret must not open under the other name
+	other, err := afero.ReadFile(fs, filepath.Join(basePath, "second"))
+	if err != nil {
+		t.Fatalf("Failed to read secret file: %v", err)
+	}
+	if err := afero.WriteFile(fs, filepath.Join(basePath, "first"), other, 0600); err != nil {
+		t.Fatalf("Failed to write secret file: %v", err)
+	}
+	if _, err := provider.Get("first"); err == nil {
+		t.Error("Expected a swapped secret file to fail")
+	}
+
+	if err := provider.Delete("second"); err != nil {
+		t.Fatalf("Failed to delete secret: %v", err)
+	}
+	if _, err := provider.Get("second"); !errors.Is(err, &ErrSecretNotFound{}) {
+		t.Errorf("Expected ErrSecretNotFound after deletion, got %v", err)
+	}
+}
+
+func TestEnvFileProvider(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	path := "/etc/construct/secrets.env"
+
+	existing := "# managed by ops\nexport HTTP_PROXY=http://proxy:3128\nCONSTRUCT_OTHER='literal \\n'\n"
+	if err := afero.WriteFile(fs, path, []byte(existing), 0600); err != nil {
+		t.Fatalf("Failed to write env file: %v", err)
+	}
+
+	provider := NewEnvFileProvider(path, fs)
+
+	if _, err := provider.Get(EncryptionKeySecret()); !errors.Is(err, &ErrSecretNotFound{}) {
+		t.Fatalf("Expected ErrSecretNotFound for a missing variable, got %v", err)
+	}
+
+	other, err := provider.Get("other")
+	if err != nil {
+		t.Fatalf("Failed to get secret: %v", err)
+	}
+	if other != `literal \n` {
+		t.Errorf("Expected single quoted value to be taken literally, got %q", other)
+	}
+
+	testValue := "{\"primaryKeyId\":1}\nline \"two\""
+	if err := provider.Set(EncryptionKeySecret(), "stale"); err != nil {
+		t.Fatalf("Failed to set secret: %v", err)
+	}
+	if err := provider.Set(EncryptionKeySecret(), testValue); err != nil {
+		t.Fatalf("Failed to set secret: %v", err)
+	}
+
+	retrieved, err := provider.Get(EncryptionKeySecret())
+	if err != nil {
+		t.Fatalf("Failed to get secret: %v", err)
+	}
+	if retrieved != testValue {
+		t.Errorf("Expected %q, got %q", testValue, retrieved)
+	}
+
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		t.Fatalf("Failed to read env file: %v", err)
+	}
+	expected := existing + "CONSTRUCT_ENCRYPTION_KEY=" + strconv.Quote(testValue) + "\n"
+	if string(content) != expected {
+		t.Errorf("Expected env file\n%s\ngot\n%s", expected, content)
+	}
+
+	if err := provider.Delete(EncryptionKeySecret()); err != nil {
+		t.Fatalf("Failed to delete secret: %v", err)
+	}
+	content, err = afero.ReadFile(fs, path)
+	if err != nil {
+		t.Fatalf("Failed to read env file: %v", err)
+	}
+	if string(content) != existing {
+		t.Errorf("Expected other lines to be preserved, got\n%s", content)
+	}
+}
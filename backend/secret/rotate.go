@@ -0,0 +1,265 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/tink-crypto/tink-go/aead"
+	"github.com/tink-crypto/tink-go/keyset"
+	tinkpb "github.com/tink-crypto/tink-go/proto/tink_go_proto"
+)
+
+// ReencryptResult counts the secrets that were re-encrypted, by resource.
+type ReencryptResult struct {
+	ModelProviders    int
+	WebhookTriggers   int
+	NotificationSinks int
+}
+
+// Reencrypt decrypts every secret stored in the database with from and encrypts it again
+// with to, in a single transaction. Before the transaction is committed, beforeCommit is
+// called to store the new keyset; if it fails, the database is left unchanged.
+func Reencrypt(ctx context.Context, db *memory.Client, from, to *Encryption, beforeCommit func() error) (*ReencryptResult, error) {
+	return memory.Transaction(ctx, db, func(tx *memory.Client) (*ReencryptResult, error) {
+		result, err := reencryptAll(ctx, tx, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		if beforeCommit != nil {
+			if err := beforeCommit(); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	})
+}
+
+// RotationResult describes a rotation of the encryption keyset.
+type RotationResult struct {
+	ReencryptResult
+	// PrimaryKeyID is the ID of the new primary key.
+	PrimaryKeyID uint32
+	// DisabledKeyIDs are the IDs of the keys that were disabled.
+	DisabledKeyIDs []uint32
+	// DryRun is set if neither the keyset nor the database were changed.
+	DryRun bool
+}
+
+// RotateOptions configure a rotation of the encryption keyset.
+type RotateOptions struct {
+	// DryRun re-encrypts every secret in a transaction that is rolled back and stores nothing.
+	DryRun bool
+}
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+// Rotate adds a new primary key to the keyset of encryption, re-encrypts every secret in
+// the database with it and disables all other keys. The keyset with both the old and the
+// new keys is stored in provider and used by encryption before any secret is re-encrypted,
+// so that no secret becomes unreadable if the rotation is interrupted.
+func Rotate(ctx context.Context, db *memory.Client, encryption *Encryption, provider Provider, options RotateOptions) (*RotationResult, error) {
+	rotated, primaryKeyID, err := addPrimaryKey(encryption.Keyset())
+	if err != nil {
+		return nil, err
+	}
+	final, disabledKeyIDs, err := disableSecondaryKeys(rotated)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := NewClient(rotated)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.DryRun {
+		var result *ReencryptResult
+		_, err := memory.Transaction(ctx, db, func(tx *memory.Client) (*ReencryptResult, error) {
+			reencrypted, err := reencryptAll(ctx, tx, encryption, to)
+			if err != nil {
+				return nil, err
+			}
+			result = reencrypted
+			return nil, errDryRun
+		})
+		if !errors.Is(err, errDryRun) {
+			return nil, err
+		}
+
+		return &RotationResult{
+			ReencryptResult: *result,
+			PrimaryKeyID:    primaryKeyID,
+			DisabledKeyIDs:  disabledKeyIDs,
+			DryRun:          true,
+		}, nil
+	}
+
+	rotatedJSON, err := KeysetToJSON(rotated)
+	if err != nil {
+		return nil, err
+	}
+	finalJSON, err := KeysetToJSON(final)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.Set(EncryptionKeySecret(), rotatedJSON); err != nil {
+		return nil, fmt.Errorf("failed to store encryption key secret: %w", err)
+	}
+	if err := encryption.SetKeyset(rotated); err != nil {
+		return nil, err
+	}
+
+	result, err := Reencrypt(ctx, db, to, to, func() error {
+		if err := provider.Set(EncryptionKeySecret(), finalJSON); err != nil {
+			return fmt.Errorf("failed to store encryption key secret: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// the old keys may still be needed if the commit failed after the final keyset was stored
+		if restoreErr := provider.Set(EncryptionKeySecret(), rotatedJSON); restoreErr != nil {
+			return nil, fmt.Errorf("%w; restoring the keyset with the previous keys also failed: %v", err, restoreErr)
+		}
+		return nil, err
+	}
+
+	if err := encryption.SetKeyset(final); err != nil {
+		return nil, err
+	}
+
+	return &RotationResult{
+		ReencryptResult: *result,
+		PrimaryKeyID:    primaryKeyID,
+		DisabledKeyIDs:  disabledKeyIDs,
+	}, nil
+}
+
+// addPrimaryKey returns a copy of the keyset with a new key that is made the primary key.
+func addPrimaryKey(handle *keyset.Handle) (*keyset.Handle, uint32, error) {
+	manager, err := copyKeyset(handle)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	keyID, err := manager.Add(aead.AES256GCMKeyTemplate())
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to add key: %w", err)
+	}
+	if err := manager.SetPrimary(keyID); err != nil {
+		return nil, 0, fmt.Errorf("failed to set primary key: %w", err)
+	}
+
+	rotated, err := manager.Handle()
+	if err != nil {
+		return nil, 0, err
+	}
+	return rotated, keyID, nil
+}
+
+// disableSecondaryKeys returns a copy of the keyset in which every enabled key except the
+// primary key is disabled, together with the IDs of those keys.
+func disableSecondaryKeys(handle *keyset.Handle) (*keyset.Handle, []uint32, error) {
+	manager, err := copyKeyset(handle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := handle.KeysetInfo()
+	var disabled []uint32
+	for _, key := range info.GetKeyInfo() {
+		if key.GetKeyId() == info.GetPrimaryKeyId() || key.GetStatus() != tinkpb.KeyStatusType_ENABLED {
+			continue
+		}
+		if err := manager.Disable(key.GetKeyId()); err != nil {
+			return nil, nil, fmt.Errorf("failed to disable key %d: %w", key.GetKeyId(), err)
+		}
+		disabled = append(disabled, key.GetKeyId())
+	}
+
+	final, err := manager.Handle()
+	if err != nil {
+		return nil, nil, err
+	}
+	return final, disabled, nil
+}
+
+// copyKeyset returns a manager for a copy of the keyset, since a manager changes the keyset
+// of the handle it was created from.
+func copyKeyset(handle *keyset.Handle) (*keyset.Manager, error) {
+	serialized, err := KeysetToJSON(handle)
+	if err != nil {
+		return nil, err
+	}
+	copied, err := KeysetFromJSON(serialized)
+	if err != nil {
+		return nil, err
+	}
+	return keyset.NewManagerFromHandle(copied), nil
+}
+
+func reencryptAll(ctx context.Context, tx *memory.Client, from, to *Encryption) (*ReencryptResult, error) {
+	var result ReencryptResult
+
+	providers, err := tx.ModelProvider.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list model providers: %w", err)
+	}
+	for _, provider := range providers {
+		sealed, err := reencrypt(from, to, provider.Secret, ModelProviderAssociated(provider.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to re-encrypt secret of model provider %s: %w", provider.Name, err)
+		}
+		if err := tx.ModelProvider.UpdateOne(provider).SetSecret(sealed).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update model provider %s: %w", provider.Name, err)
+		}
+		result.ModelProviders++
+	}
+
+	triggers, err := tx.WebhookTrigger.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook triggers: %w", err)
+	}
+	for _, trigger := range triggers {
+		sealed, err := reencrypt(from, to, trigger.Secret, WebhookTriggerAssociated(trigger.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to re-encrypt secret of webhook trigger %s: %w", trigger.Name, err)
+		}
+		if err := tx.WebhookTrigger.UpdateOne(trigger).SetSecret(sealed).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update webhook trigger %s: %w", trigger.Name, err)
+		}
+		result.WebhookTriggers++
+	}
+
+	sinks, err := tx.NotificationSink.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification sinks: %w", err)
+	}
+	for _, sink := range sinks {
+		if len(sink.Secret) == 0 {
+			continue
+		}
+		sealed, err := reencrypt(from, to, sink.Secret, NotificationSinkAssociated(sink.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to re-encrypt secret of notification sink %s: %w", sink.Name, err)
+		}
+		if err := tx.NotificationSink.UpdateOne(sink).SetSecret(sealed).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update notification sink %s: %w", sink.Name, err)
+		}
+		result.NotificationSinks++
+	}
+
+	return &result, nil
+}
+
+func reencrypt(from, to *Encryption, ciphertext, associatedData []byte) ([]byte, error) {
+	plaintext, err := from.Decrypt(ciphertext, associatedData)
+	if err != nil {
+		return nil, err
+	}
+	return to.Encrypt(plaintext, associatedData)
+}
@@ -0,0 +1,260 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	tinkpb "github.com/tink-crypto/tink-go/proto/tink_go_proto"
+)
+
+func TestReencrypt(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+
+	from := newTestEncryption(t)
+	to := newTestEncryption(t)
+
+	providerIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	for i, id := range providerIDs {
+		sealed, err := from.Encrypt([]byte(fmt.Sprintf("sk-%d", i)), ModelProviderAssociated(id))
+		if err != nil {
+			t.Fatalf("failed to encrypt secret: %v", err)
+		}
+		test.NewModelProviderBuilder(t, id, db).WithName(fmt.Sprintf("provider-%d", i)).WithSecret(sealed).Build(ctx)
+	}
+
+	storeErr := errors.New("provider unavailable")
+	_, err := Reencrypt(ctx, db, from, to, func() error { return storeErr })
+	if !errors.Is(err, storeErr) {
+		t.Fatalf("expected the error of beforeCommit, got %v", err)
+	}
+	for i, id := range providerIDs {
+		assertSecret(t, db, from, id, fmt.Sprintf("sk-%d", i))
+	}
+
+	stored := false
+	result, err := Reencrypt(ctx, db, from, to, func() error {
+		stored = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to re-encrypt secrets: %v", err)
+	}
+	if !stored {
+		t.Error("expected beforeCommit to be called")
+	}
+	if result.ModelProviders != len(providerIDs) {
+		t.Errorf("expected %d re-encrypted model providers, got %d", len(providerIDs), result.ModelProviders)
+	}
+	for i, id := range providerIDs {
+		assertSecret(t, db, to, id, fmt.Sprintf("sk-%d", i))
+	}
+
+	if _, err := Reencrypt(ctx, db, from, to, nil); err == nil {
+		t.Error("expected re-encryption with the old keyset to fail")
+	}
+}
+
+func TestRotate(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+
+	encryption := newTestEncryption(t)
+	original := encryption.Keyset().KeysetInfo()
+	originalJSON, err := KeysetToJSON(encryption.Keyset())
+	if err != nil {
+		t.Fatalf("failed to convert keyset to JSON: %v", err)
+	}
+
+	provider, err := NewFileProvider("/secrets", afero.NewMemMapFs())
+	if err != nil {
+		t.Fatalf("failed to create file provider: %v", err)
+	}
+	if err := provider.Set(EncryptionKeySecret(), originalJSON); err != nil {
+		t.Fatalf("failed to store keyset: %v", err)
+	}
+
+	providerID := uuid.New()
+	sealed, err := encryption.Encrypt([]byte("sk-rotate"), ModelProviderAssociated(providerID))
+	if err != nil {
+		t.Fatalf("failed to encrypt secret: %v", err)
+	}
+	test.NewModelProviderBuilder(t, providerID, db).WithSecret(sealed).Build(ctx)
+
+	dryRun, err := Rotate(ctx, db, encryption, provider, RotateOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("failed to rotate in dry run: %v", err)
+	}
+	if !dryRun.DryRun || dryRun.ModelProviders != 1 {
+		t.Errorf("expected a dry run over 1 model provider, got %+v", dryRun)
+	}
+	stored, _ := provider.Get(EncryptionKeySecret())
+	if stored != originalJSON || encryption.Keyset().KeysetInfo().GetPrimaryKeyId() != original.GetPrimaryKeyId() {
+		t.Error("expected a dry run not to change the keyset")
+	}
+	unchanged, err := db.ModelProvider.Get(ctx, providerID)
+	if err != nil {
+		t.Fatalf("failed to get model provider: %v", err)
+	}
+	if string(unchanged.Secret) != string(sealed) {
+		t.Error("expected a dry run not to change the database")
+	}
+
+	result, err := Rotate(ctx, db, encryption, provider, RotateOptions{})
+	if err != nil {
+		t.Fatalf("failed to rotate: %v", err)
+	}
+	if result.DryRun || result.ModelProviders != 1 {
+		t.Errorf("expected a rotation of 1 model provider, got %+v", result)
+	}
+	if len(result.DisabledKeyIDs) != 1 || result.DisabledKeyIDs[0] != original.GetPrimaryKeyId() {
+		t.Errorf("expected the previous primary key to be disabled, got %v", result.DisabledKeyIDs)
+	}
+	if encryption.Keyset().KeysetInfo().GetPrimaryKeyId() != result.PrimaryKeyID {
+		t.Errorf("expected the client to use the new primary key %d", result.PrimaryKeyID)
+	}
+	assertSecret(t, db, encryption, providerID, "sk-rotate")
+
+	stored, err = provider.Get(EncryptionKeySecret())
+	if err != nil {
+		t.Fatalf("failed to get stored keyset: %v", err)
+	}
+	handle, err := KeysetFromJSON(stored)
+	if err != nil {
+		t.Fatalf("failed to load stored keyset: %v", err)
+	}
+	for _, key := range handle.KeysetInfo().GetKeyInfo() {
+		enabled := key.GetStatus() == tinkpb.KeyStatusType_ENABLED
+		if enabled != (key.GetKeyId() == result.PrimaryKeyID) {
+			t.Errorf("expected only the primary key to be enabled, key %d is %s", key.GetKeyId(), key.GetStatus())
+		}
+	}
+	storedClient, err := NewClient(handle)
+	if err != nil {
+		t.Fatalf("failed to create encryption client: %v", err)
+	}
+	assertSecret(t, db, storedClient, providerID, "sk-rotate")
+
+	previous, err := KeysetFromJSON(originalJSON)
+	if err != nil {
+		t.Fatalf("failed to load previous keyset: %v", err)
+	}
+	previousClient, err := NewClient(previous)
+	if err != nil {
+		t.Fatalf("failed to create encryption client: %v", err)
+	}
+	rotated, err := db.ModelProvider.Get(ctx, providerID)
+	if err != nil {
+		t.Fatalf("failed to get model provider: %v", err)
+	}
+	if _, err := previousClient.Decrypt(rotated.Secret, ModelProviderAssociated(providerID)); err == nil {
+		t.Error("expected the previous keyset not to decrypt rotated secrets")
+	}
+}
+
+func TestRotateFailure(t *testing.T) {
+	ctx := context.Background()
+	db := test.NewDatabase(t)
+	encryption := newTestEncryption(t)
+
+	providerID := uuid.New()
+	sealed, err := encryption.Encrypt([]byte("sk-rotate"), ModelProviderAssociated(providerID))
+	if err != nil {
+		t.Fatalf("failed to encrypt secret: %v", err)
+	}
+	test.NewModelProviderBuilder(t, providerID, db).WithSecret(sealed).Build(ctx)
+
+	// the provider accepts the keyset with both keys but fails to store the final keyset
+	provider := &failingProvider{failAfter: 1, secrets: make(map[string]string)}
+	if _, err := Rotate(ctx, db, encryption, provider, RotateOptions{}); err == nil {
+		t.Fatal("expected rotation to fail")
+	}
+
+	unchanged, err := db.ModelProvider.Get(ctx, providerID)
+	if err != nil {
+		t.Fatalf("failed to get model provider: %v", err)
+	}
+	if string(unchanged.Secret) != string(sealed) {
+		t.Error("expected a failed rotation not to change the database")
+	}
+
+	handle, err := KeysetFromJSON(provider.secrets[EncryptionKeySecret()])
+	if err != nil {
+		t.Fatalf("failed to load stored keyset: %v", err)
+	}
+	storedClient, err := NewClient(handle)
+	if err != nil {
+		t.Fatalf("failed to create encryption client: %v", err)
+	}
+	assertSecret(t, db, storedClient, providerID, "sk-rotate")
+	assertSecret(t, db, encryption, providerID, "sk-rotate")
+}
+
+// failingProvider keeps secrets in memory and fails every Set after the first failAfter
+// calls, except for restoring a value it has stored before.
+type failingProvider struct {
+	failAfter int
+	sets      int
+	secrets   map[string]string
+	history   []string
+}
+
+func (p *failingProvider) Get(key string) (string, error) {
+	value, ok := p.secrets[key]
+	if !ok {
+		return "", &ErrSecretNotFound{Key: key}
+	}
+	return value, nil
+}
+
+func (p *failingProvider) Set(key string, value string) error {
+	p.sets++
+	if p.sets > p.failAfter && !slices.Contains(p.history, value) {
+		return errors.New("provider unavailable")
+	}
+	p.secrets[key] = value
+	p.history = append(p.history, value)
+	return nil
+}
+
+func (p *failingProvider) Delete(key string) error {
+	delete(p.secrets, key)
+	return nil
+}
+
+func assertSecret(t *testing.T, db *memory.Client, encryption *Encryption, id uuid.UUID, expected string) {
+	t.Helper()
+
+	provider, err := db.ModelProvider.Get(context.Background(), id)
+	if err != nil {
+		t.Fatalf("failed to get model provider: %v", err)
+	}
+	plaintext, err := encryption.Decrypt(provider.Secret, ModelProviderAssociated(id))
+	if err != nil {
+		t.Fatalf("failed to decrypt secret of model provider %s: %v", provider.Name, err)
+	}
+	if string(plaintext) != expected {
+		t.Errorf("expected secret %q, got %q", expected, plaintext)
+	}
+}
+
+func newTestEncryption(t *testing.T) *Encryption {
+	t.Helper()
+
+	handle, err := GenerateKeyset()
+	if err != nil {
+		t.Fatalf("failed to generate keyset: %v", err)
+	}
+	encryption, err := NewClient(handle)
+	if err != nil {
+		t.Fatalf("failed to create encryption client: %v", err)
+	}
+	return encryption
+}
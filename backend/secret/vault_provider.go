@@ -0,0 +1,172 @@
+package secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// vaultRequestTimeout bounds every request to Vault, since the Provider interface does not
+// take a context.
+const vaultRequestTimeout = 10 * time.Second
+
+// VaultConfig configures the connection to a HashiCorp Vault server.
+type VaultConfig struct {
+	// Address is the URL of the Vault server, e.g. https://vault.internal:8200.
+	Address string
+	// Token authenticates the requests.
+	Token string
+	// Namespace is the Vault Enterprise namespace, if any.
+	Namespace string
+	// Mount is the path the KV version 2 secrets engine is mounted at. Defaults to "secret".
+	Mount string
+	// Path is the prefix under which secrets are stored. Defaults to "construct".
+	Path string
+}
+
+// VaultProvider stores secrets in a KV version 2 secrets engine of HashiCorp Vault. Every
+// secret is a separate entry with the value in its "value" field.
+type VaultProvider struct {
+	config VaultConfig
+	client *http.Client
+}
+
+func NewVaultProvider(config VaultConfig) (*VaultProvider, error) {
+	if config.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+	if config.Token == "" {
+		return nil, fmt.Errorf("vault token is required")
+	}
+	if config.Mount == "" {
+		config.Mount = "secret"
+	}
+	if config.Path == "" {
+		config.Path = "construct"
+	}
+	config.Address = strings.TrimSuffix(config.Address, "/")
+
+	return &VaultProvider{
+		config: config,
+		client: &http.Client{Timeout: vaultRequestTimeout},
+	}, nil
+}
+
+type vaultData struct {
+	Data map[string]string `json:"data"`
+}
+
+type vaultReadResponse struct {
+	Data vaultData `json:"data"`
+}
+
+type vaultErrorResponse struct {
+	Errors []string `json:"errors"`
+}
+
+func (v *VaultProvider) Get(key string) (string, error) {
+	resp, err := v.do(http.MethodGet, "data", key, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", &ErrSecretNotFound{Key: key, Err: errors.New("no such entry in vault")}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", vaultError(key, resp)
+	}
+
+	var read vaultReadResponse
+	if err := json.NewDecoder(resp.Body).Decode(&read); err != nil {
+		return "", fmt.Errorf("failed to decode vault response for %s: %w", key, err)
+	}
+
+	// a deleted version is returned without data
+	value, ok := read.Data.Data["value"]
+	if !ok {
+		return "", &ErrSecretNotFound{Key: key, Err: errors.New("vault entry has no value")}
+	}
+	return value, nil
+}
+
+func (v *VaultProvider) Set(key string, value string) error {
+	body, err := json.Marshal(vaultData{Data: map[string]string{"value": value}})
+	if err != nil {
+		return &ErrSecretMarshal{Key: key, Err: err}
+	}
+
+	resp, err := v.do(http.MethodPost, "data", key, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return vaultError(key, resp)
+	}
+	return nil
+}
+
+// Delete removes all versions of the secret.
+func (v *VaultProvider) Delete(key string) error {
+	resp, err := v.do(http.MethodDelete, "metadata", key, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return vaultError(key, resp)
+	}
+	return nil
+}
+
+func (v *VaultProvider) do(method, kind, key string, body []byte) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), vaultRequestTimeout)
+	defer cancel()
+
+	endpoint := v.config.Address + "/v1/" + path.Join(v.config.Mount, kind, v.config.Path, url.PathEscape(key))
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", v.config.Token)
+	if v.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.config.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach vault: %w", err)
+	}
+
+	// read the body before the request context is cancelled
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return resp, nil
+}
+
+func vaultError(key string, resp *http.Response) error {
+	var body vaultErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err == nil && len(body.Errors) > 0 {
+		return fmt.Errorf("vault returned %s for %s: %s", resp.Status, key, strings.Join(body.Errors, "; "))
+	}
+	return fmt.Errorf("vault returned %s for %s", resp.Status, key)
+}
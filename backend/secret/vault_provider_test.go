@@ -0,0 +1,111 @@
+package secret
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeVault implements the parts of the KV version 2 API of Vault that the provider uses.
+type fakeVault struct {
+	mu      sync.Mutex
+	token   string
+	entries map[string]string
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != f.token {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string][]string{"errors": {"permission denied"}})
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/data/"):
+		name := strings.TrimPrefix(r.URL.Path, "/v1/kv/data/")
+		switch r.Method {
+		case http.MethodGet:
+			value, ok := f.entries[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string][]string{"errors": {}})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"data":     map[string]string{"value": value},
+					"metadata": map[string]any{"version": 1},
+				},
+			})
+		case http.MethodPost:
+			var body struct {
+				Data map[string]string `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.entries[name] = body.Data["value"]
+			json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"version": 1}})
+		}
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/metadata/") && r.Method == http.MethodDelete:
+		delete(f.entries, strings.TrimPrefix(r.URL.Path, "/v1/kv/metadata/"))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestVaultProvider(t *testing.T) {
+	vault := &fakeVault{token: "s.root", entries: make(map[string]string)}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	provider, err := NewVaultProvider(VaultConfig{Address: server.URL, Token: "s.root", Mount: "kv", Path: "teams/platform"})
+	if err != nil {
+		t.Fatalf("failed to create vault provider: %v", err)
+	}
+
+	if _, err := provider.Get(EncryptionKeySecret()); !errors.Is(err, &ErrSecretNotFound{}) {
+		t.Fatalf("expected ErrSecretNotFound for a missing secret, got %v", err)
+	}
+
+	keyset := `{"primaryKeyId":1,"key":[{"keyData":{}}]}`
+	if err := provider.Set(EncryptionKeySecret(), keyset); err != nil {
+		t.Fatalf("failed to set secret: %v", err)
+	}
+	if vault.entries["teams/platform/encryption_key"] != keyset {
+		t.Fatalf("expected the secret to be stored under the path, got %v", vault.entries)
+	}
+
+	value, err := provider.Get(EncryptionKeySecret())
+	if err != nil {
+		t.Fatalf("failed to get secret: %v", err)
+	}
+	if value != keyset {
+		t.Errorf("expected %q, got %q", keyset, value)
+	}
+
+	if err := provider.Delete(EncryptionKeySecret()); err != nil {
+		t.Fatalf("failed to delete secret: %v", err)
+	}
+	if _, err := provider.Get(EncryptionKeySecret()); !errors.Is(err, &ErrSecretNotFound{}) {
+		t.Errorf("expected ErrSecretNotFound after deletion, got %v", err)
+	}
+
+	unauthorized, err := NewVaultProvider(VaultConfig{Address: server.URL, Token: "s.wrong", Mount: "kv"})
+	if err != nil {
+		t.Fatalf("failed to create vault provider: %v", err)
+	}
+	_, err = unauthorized.Get(EncryptionKeySecret())
+	if err == nil || errors.Is(err, &ErrSecretNotFound{}) || !strings.Contains(err.Error(), "permission denied") {
+		t.Errorf("expected a permission error, got %v", err)
+	}
+}
@@ -14,4 +14,5 @@ const (
 	ToolNamePrint           = "print"
 	ToolNameAskUser         = "ask_user"
 	ToolNameFetch           = "fetch"
+	ToolNameRemember        = "remember"
 )
@@ -6,14 +6,12 @@ import (
 	"os"
 	"testing"
 
-	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql/schema"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/test"
 	"github.com/furisto/construct/shared"
 	"github.com/google/go-cmp/cmp"
 	"github.com/spf13/afero"
-
-	_ "modernc.org/sqlite"
 )
 
 type ToolTestSetup[ToolInput any, ToolResult any] struct {
@@ -105,15 +103,7 @@ func (s *ToolTestSetup[ToolInput, ToolResult]) RunToolTests(t *testing.T, scenar
 func (s *ToolTestSetup[ToolInput, ToolOutput]) SetupDatabase(t *testing.T) *memory.Client {
 	t.Helper()
 
-	db, err := memory.Open(dialect.SQLite, "file:construct_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
-	if err != nil {
-		t.Fatalf("failed opening connection to sqlite: %v", err)
-	}
-
-	err = db.Schema.Create(t.Context())
-	if err != nil {
-		t.Fatalf("failed to create schema: %v", err)
-	}
+	db := test.NewDatabase(t)
 
 	if s.Debug {
 		s.DebugSchema(t.Context(), t, db)
@@ -71,6 +71,11 @@ func UnsetValue(s *Session, key string) {
 type Task struct {
 	ID               uuid.UUID
 	ProjectDirectory string
+	Owner            string
+	// ReviewEdits stages the files that the agent changes for the review of the user.
+	ReviewEdits bool
+	// CustomTools are the names of the custom tools the agent of the task can use.
+	CustomTools []string
 }
 
 type CodeActToolHandler func(session *Session) func(call sobek.FunctionCall) sobek.Value
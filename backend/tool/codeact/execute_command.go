@@ -185,7 +185,7 @@ func executeCommandHandler(session *Session) func(call sobek.FunctionCall) sobek
 		}
 		input := rawInput.(*system.ExecuteCommandInput)
 
-		result, err := system.ExecuteCommand(input)
+		result, err := system.ExecuteCommand(session.Context, input)
 		if err != nil {
 			session.Throw(err)
 		}
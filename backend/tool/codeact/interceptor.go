@@ -1,18 +1,31 @@
 package codeact
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"time"
 
 	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/audit"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/redact"
 	"github.com/furisto/construct/backend/tool/base"
 	"github.com/furisto/construct/backend/tool/communication"
 	"github.com/furisto/construct/backend/tool/filesystem"
+	"github.com/furisto/construct/backend/tool/plugin"
+	"github.com/furisto/construct/backend/tool/project"
 	"github.com/furisto/construct/backend/tool/system"
 	"github.com/furisto/construct/backend/tool/web"
 	"github.com/furisto/construct/shared"
 	"github.com/google/uuid"
 	"github.com/grafana/sobek"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -44,6 +57,8 @@ type FunctionCallInput struct {
 	AskUser        *communication.AskUserInput      `json:"ask_user,omitempty"`
 	Handoff        *communication.HandoffInput      `json:"handoff,omitempty"`
 	Fetch          *web.FetchInput                  `json:"fetch,omitempty"`
+	Remember       *project.RememberInput           `json:"remember,omitempty"`
+	Custom         *plugin.CallInput                `json:"custom,omitempty"`
 }
 
 type FunctionCallOutput struct {
@@ -57,6 +72,8 @@ type FunctionCallOutput struct {
 	SubmitReport   *communication.SubmitReportResult `json:"submit_report,omitempty"`
 	AskUser        *communication.AskUserResult      `json:"ask_user,omitempty"`
 	Fetch          *web.FetchResult                  `json:"fetch,omitempty"`
+	Remember       *project.RememberResult           `json:"remember,omitempty"`
+	Custom         *plugin.CallResult                `json:"custom,omitempty"`
 }
 
 type FunctionCall struct {
@@ -126,7 +143,15 @@ func convertToFunctionCallInput(toolName string, input any) FunctionCallInput {
 		if v, ok := input.(*web.FetchInput); ok {
 			result.Fetch = v
 		}
+	case base.ToolNameRemember:
+		if v, ok := input.(*project.RememberInput); ok {
+			result.Remember = v
+		}
 	default:
+		if v, ok := input.(*plugin.CallInput); ok {
+			result.Custom = v
+			break
+		}
 		slog.Error("unknown tool name", "tool_name", toolName)
 	}
 
@@ -177,7 +202,15 @@ func convertToFunctionCallOutput(toolName string, output any) FunctionCallOutput
 		if v, ok := output.(*web.FetchResult); ok {
 			result.Fetch = v
 		}
+	case base.ToolNameRemember:
+		if v, ok := output.(*project.RememberResult); ok {
+			result.Remember = v
+		}
 	default:
+		if v, ok := output.(*plugin.CallResult); ok {
+			result.Custom = v
+			break
+		}
 		slog.Error("unknown tool name", "tool_name", toolName)
 	}
 
@@ -305,6 +338,270 @@ func (p *ToolEventPublisher) publishToolEvent(taskID uuid.UUID, part *v1.Message
 	})
 }
 
+// TracingInterceptor records a span for every tool call. The span is set on the context
+// of the session while the tool runs, so that work done by the tool becomes its child.
+// It sees the arguments before the RedactionInterceptor restores secrets into them, so
+// the command line it records for execute_command only contains markers.
+type TracingInterceptor struct {
+	tracer trace.Tracer
+}
+
+func NewTracingInterceptor(provider trace.TracerProvider) *TracingInterceptor {
+	return &TracingInterceptor{
+		tracer: provider.Tracer("github.com/furisto/construct/backend/tool/codeact"),
+	}
+}
+
+func (i *TracingInterceptor) Intercept(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		if tool.Name() == base.ToolNamePrint {
+			return inner(call)
+		}
+
+		attributes := []attribute.KeyValue{
+			attribute.String("construct.tool.name", tool.Name()),
+			attribute.String("construct.task.id", session.Task.ID.String()),
+		}
+		if tool.Name() == base.ToolNameExecuteCommand {
+			if input, err := tool.Input(session, call.Arguments); err == nil {
+				if input, ok := input.(*system.ExecuteCommandInput); ok {
+					attributes = append(attributes, attribute.String("process.command_line", input.Command))
+				}
+			}
+		}
+
+		parent := session.Context
+		ctx, span := i.tracer.Start(parent, "codeact."+tool.Name(), trace.WithAttributes(attributes...))
+		session.Context = ctx
+
+		defer func() {
+			session.Context = parent
+			if r := recover(); r != nil {
+				span.SetStatus(codes.Error, fmt.Sprint(r))
+				span.End()
+				panic(r)
+			}
+			span.End()
+		}()
+
+		return inner(call)
+	}
+}
+
+type AuditLog interface {
+	Append(ctx context.Context, record audit.Record) (*memory.AuditEvent, error)
+}
+
+// AuditInterceptor appends every call of a tool that changes the workspace to the audit
+// log, whether it succeeds or fails. Custom tools may do anything, so all of their calls
+// are recorded, with the hash of their arguments in place of a diff.
+type AuditInterceptor struct {
+	log AuditLog
+}
+
+func NewAuditInterceptor(log AuditLog) *AuditInterceptor {
+	return &AuditInterceptor{
+		log: log,
+	}
+}
+
+func (i *AuditInterceptor) Intercept(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		_, custom := tool.(*pluginTool)
+		switch tool.Name() {
+		case base.ToolNameCreateFile, base.ToolNameEditFile, base.ToolNameExecuteCommand:
+		default:
+			if !custom {
+				return inner(call)
+			}
+		}
+
+		record := audit.Record{
+			Kind:      types.AuditEventKindToolCall,
+			Actor:     session.Task.Owner,
+			Action:    tool.Name(),
+			TaskID:    &session.Task.ID,
+			Workspace: session.Task.ProjectDirectory,
+		}
+		input, err := tool.Input(session, call.Arguments)
+		if err != nil {
+			slog.Error("failed to get tool input", "error", err)
+		}
+		switch input := input.(type) {
+		case *filesystem.CreateFileInput:
+			record.Path = input.Path
+			record.DiffHash = audit.DiffHash(input.Content)
+		case *filesystem.EditFileInput:
+			record.Path = input.Path
+		case *system.ExecuteCommandInput:
+			record.Command = input.Command
+		case *plugin.CallInput:
+			record.DiffHash = audit.DiffHash(string(input.Arguments))
+		}
+
+		start := time.Now()
+		defer func() {
+			r := recover()
+			if r != nil {
+				err := panicError(r)
+				record.Error = err.Error()
+
+				var toolErr *ToolError
+				if errors.As(err, &toolErr) {
+					if exitCode, ok := toolErr.Details["exit_code"].(int); ok {
+						record.ExitCode = &exitCode
+					}
+				}
+			}
+
+			record.Duration = time.Since(start)
+			switch result := sessionResult(session).(type) {
+			case *filesystem.EditFileResult:
+				record.DiffHash = audit.DiffHash(result.PatchInfo.Patch)
+			case *system.ExecuteCommandResult:
+				record.ExitCode = &result.ExitCode
+			}
+
+			if _, err := i.log.Append(session.Context, record); err != nil {
+				slog.Error("failed to audit tool call", "tool", tool.Name(), "error", err)
+			}
+			if r != nil {
+				panic(r)
+			}
+		}()
+
+		return inner(call)
+	}
+}
+
+// RedactionInterceptor keeps secrets away from the model. It replaces the secrets in the
+// results and errors of tools with markers and restores the markers in the input of the
+// tools that act on the local machine, so that an agent can use a secret without seeing
+// it. It has to wrap the tools directly, so that all other interceptors only get to see
+// redacted results.
+type RedactionInterceptor struct {
+	redactor *redact.Redactor
+}
+
+func NewRedactionInterceptor(redactor *redact.Redactor) *RedactionInterceptor {
+	return &RedactionInterceptor{
+		redactor: redactor,
+	}
+}
+
+// restoringTools are the tools whose input may refer to secrets by their marker.
+var restoringTools = map[string]bool{
+	base.ToolNameCreateFile:     true,
+	base.ToolNameEditFile:       true,
+	base.ToolNameExecuteCommand: true,
+}
+
+func (i *RedactionInterceptor) Intercept(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		if tool.Name() == base.ToolNamePrint {
+			return inner(call)
+		}
+
+		if restoringTools[tool.Name()] {
+			call.Arguments = i.restoreArguments(session, call.Arguments)
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				panic(i.redactPanic(session, r))
+			}
+		}()
+
+		result := inner(call)
+		raw, ok := GetValue[any](session, "result")
+		if !ok {
+			return result
+		}
+
+		i.redactor.RedactFields(raw)
+		return session.VM.ToValue(raw)
+	}
+}
+
+// restoreArguments returns a copy of the arguments with markers replaced by secrets. The
+// arguments themselves are left alone, so the secrets never become visible in the script.
+func (i *RedactionInterceptor) restoreArguments(session *Session, args []sobek.Value) []sobek.Value {
+	restored := make([]sobek.Value, len(args))
+	for n, arg := range args {
+		restored[n] = arg
+		if arg == nil || sobek.IsUndefined(arg) || sobek.IsNull(arg) {
+			continue
+		}
+		if value, changed := i.restoreValue(arg.Export()); changed {
+			restored[n] = session.VM.ToValue(value)
+		}
+	}
+	return restored
+}
+
+func (i *RedactionInterceptor) restoreValue(value any) (any, bool) {
+	switch value := value.(type) {
+	case string:
+		restored := i.redactor.Restore(value)
+		return restored, restored != value
+	case []any:
+		restored := make([]any, len(value))
+		var changed bool
+		for n, v := range value {
+			var c bool
+			restored[n], c = i.restoreValue(v)
+			changed = changed || c
+		}
+		return restored, changed
+	case map[string]any:
+		restored := make(map[string]any, len(value))
+		var changed bool
+		for k, v := range value {
+			var c bool
+			restored[k], c = i.restoreValue(v)
+			changed = changed || c
+		}
+		return restored, changed
+	}
+	return value, false
+}
+
+// redactPanic redacts the error a tool threw. The error is thrown anew, because the
+// message of the original exception was fixed when it was created.
+func (i *RedactionInterceptor) redactPanic(session *Session, r any) any {
+	obj, ok := r.(*sobek.Object)
+	if !ok {
+		return r
+	}
+	err, ok := obj.Get("value").Export().(error)
+	if !ok {
+		return r
+	}
+
+	var toolErr *ToolError
+	if errors.As(err, &toolErr) {
+		i.redactor.RedactFields(toolErr)
+		return session.VM.NewGoError(toolErr)
+	}
+	return session.VM.NewGoError(errors.New(i.redactor.Redact(err.Error())))
+}
+
+func sessionResult(session *Session) any {
+	raw, _ := GetValue[any](session, "result")
+	return raw
+}
+
+// panicError extracts the error a tool threw into the VM.
+func panicError(r any) error {
+	if obj, ok := r.(*sobek.Object); ok {
+		if err, ok := obj.Get("value").Export().(error); ok {
+			return err
+		}
+		return errors.New(obj.String())
+	}
+	return fmt.Errorf("%v", r)
+}
+
 func convertArgumentsToProtoToolCall(tooCall Tool, arguments []sobek.Value, session *Session) (*v1.MessagePart, error) {
 	toolCall := &v1.ToolCall{
 		ToolName: tooCall.Name(),
@@ -414,6 +711,18 @@ func convertArgumentsToProtoToolCall(tooCall Tool, arguments []sobek.Value, sess
 				Timeout: int32(input.Timeout),
 			},
 		}
+	case *project.RememberInput:
+		toolCall.Input = &v1.ToolCall_Remember{
+			Remember: &v1.ToolCall_RememberInput{
+				Learning: input.Learning,
+			},
+		}
+	case *plugin.CallInput:
+		toolCall.Input = &v1.ToolCall_Custom{
+			Custom: &v1.ToolCall_CustomInput{
+				Arguments: string(input.Arguments),
+			},
+		}
 	default:
 		return nil, shared.Errorf(shared.ErrorSourceSystem, "unknown tool input type: %T", input)
 	}
@@ -525,6 +834,21 @@ func convertResultToProtoToolResult(toolName string, result any) (*v1.MessagePar
 				Truncated: result.Truncated,
 			},
 		}
+	case *project.RememberResult:
+		toolResult.Result = &v1.ToolResult_Remember{
+			Remember: &v1.ToolResult_RememberResult{
+				Path:      result.Path,
+				Learning:  result.Learning,
+				Duplicate: result.Duplicate,
+				Entries:   int32(result.Entries),
+			},
+		}
+	case *plugin.CallResult:
+		toolResult.Result = &v1.ToolResult_Custom{
+			Custom: &v1.ToolResult_CustomResult{
+				Output: string(result.Output),
+			},
+		}
 	case nil:
 		// Some tools like handoff don't return a result, only an error
 		return nil, nil
@@ -538,3 +862,76 @@ func convertResultToProtoToolResult(toolName string, result any) (*v1.MessagePar
 		},
 	}, nil
 }
+
+type FileChangeStager interface {
+	Stage(ctx context.Context, taskID uuid.UUID, path string, original *string, content string) (*memory.FileChange, error)
+	Overlay(ctx context.Context, taskID uuid.UUID, base afero.Fs) (afero.Fs, error)
+}
+
+// ReviewInterceptor stages the files that create_file and edit_file change for the review
+// of the user, if the task asks for it. The tools write to an overlay instead of the
+// workspace, and the content they wrote is staged until the user accepts it. read_file,
+// list_files and find_file see the pending changes through the same overlay, so that the
+// agent can continue with its edits. Everything else sees the workspace as it is: commands
+// of execute_command, and grep, do not see pending changes, and files they write, e.g. with
+// sed -i or a redirect, are not staged and not reviewed. Failed calls are not staged.
+type ReviewInterceptor struct {
+	stager FileChangeStager
+}
+
+func NewReviewInterceptor(stager FileChangeStager) *ReviewInterceptor {
+	return &ReviewInterceptor{
+		stager: stager,
+	}
+}
+
+func (i *ReviewInterceptor) Intercept(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		if !session.Task.ReviewEdits {
+			return inner(call)
+		}
+
+		var path string
+		input, err := tool.Input(session, call.Arguments)
+		switch input := input.(type) {
+		case *filesystem.CreateFileInput:
+			path = input.Path
+		case *filesystem.EditFileInput:
+			path = input.Path
+		case *filesystem.ReadFileInput, *filesystem.ListFilesInput, *filesystem.FindFileInput:
+		default:
+			return inner(call)
+		}
+		if err != nil {
+			return inner(call)
+		}
+
+		workspace := session.FS
+		overlay, err := i.stager.Overlay(session.Context, session.Task.ID, workspace)
+		if err != nil {
+			session.Throw(fmt.Errorf("failed to load the changes pending review: %w", err))
+		}
+		session.FS = overlay
+		defer func() { session.FS = workspace }()
+
+		result := inner(call)
+		if path == "" {
+			return result
+		}
+
+		var original *string
+		if content, err := afero.ReadFile(workspace, path); err == nil {
+			text := string(content)
+			original = &text
+		}
+
+		content, err := afero.ReadFile(overlay, path)
+		if err != nil {
+			session.Throw(fmt.Errorf("failed to read %s for review: %w", path, err))
+		}
+		if _, err := i.stager.Stage(session.Context, session.Task.ID, path, original, string(content)); err != nil {
+			session.Throw(fmt.Errorf("failed to stage the change to %s for review: %w", path, err))
+		}
+		return result
+	}
+}
@@ -0,0 +1,393 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/furisto/construct/backend/audit"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/redact"
+	"github.com/furisto/construct/backend/tool/plugin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingInterceptor(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer provider.Shutdown(context.Background())
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "reconcile")
+
+	interpreter := NewInterpreter(
+		[]Tool{NewExecuteCommandTool(), NewPrintTool()},
+		[]Interceptor{NewTracingInterceptor(provider)},
+	)
+
+	input, err := json.Marshal(InterpreterInput{
+		Script: `const result = execute_command("echo traced");
+print(result.stdout);`,
+	})
+	if err != nil {
+		t.Fatalf("error marshalling input: %v", err)
+	}
+
+	output, err := interpreter.Interpret(ctx, afero.NewMemMapFs(), input, &Task{
+		ID:               uuid.New(),
+		ProjectDirectory: t.TempDir(),
+	})
+	if err != nil {
+		t.Fatalf("error running interpreter: %v", err)
+	}
+	parent.End()
+
+	if output.ConsoleOutput != "traced\n\n" {
+		t.Errorf("unexpected console output %q", output.ConsoleOutput)
+	}
+
+	spans := exporter.GetSpans()
+	byName := make(map[string]tracetest.SpanStub)
+	var names []string
+	for _, span := range spans {
+		byName[span.Name] = span
+		names = append(names, span.Name)
+	}
+	expected := []string{"system.ExecuteCommand", "codeact.execute_command", "reconcile"}
+	if diff := cmp.Diff(expected, names); diff != "" {
+		t.Fatalf("spans mismatch (-want +got):\n%s", diff)
+	}
+
+	tool := byName["codeact.execute_command"]
+	if tool.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("tool span is not a child of the parent span")
+	}
+
+	command := byName["system.ExecuteCommand"]
+	if command.Parent.SpanID() != tool.SpanContext.SpanID() {
+		t.Errorf("command span is not a child of the tool span")
+	}
+
+	if !hasAttribute(command.Attributes, attribute.Int("process.exit.code", 0)) {
+		t.Errorf("command span is missing the exit code, got %v", command.Attributes)
+	}
+	if !hasAttribute(tool.Attributes, attribute.String("process.command_line", "echo traced")) {
+		t.Errorf("tool span is missing the command line, got %v", tool.Attributes)
+	}
+}
+
+type recordingAuditLog struct {
+	records []audit.Record
+}
+
+func (l *recordingAuditLog) Append(ctx context.Context, record audit.Record) (*memory.AuditEvent, error) {
+	l.records = append(l.records, record)
+	return &memory.AuditEvent{}, nil
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	lookupIssue, err := NewPluginTool(&plugin.Plugin{
+		Manifest: plugin.Manifest{
+			Name:        "lookup_issue",
+			Description: "Looks up an issue",
+			InputSchema: map[string]any{"type": "object", "required": []any{"id"}},
+			Runtime:     plugin.RuntimeJavaScript,
+			Entrypoint:  "index.js",
+			Timeout:     plugin.DefaultTimeout,
+		},
+		Dir:    "/tools/issues",
+		Source: `module.exports = (args) => ({ id: args.id, state: "open" });`,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin tool: %v", err)
+	}
+
+	log := &recordingAuditLog{}
+	interpreter := NewInterpreter(
+		[]Tool{NewExecuteCommandTool(), NewCreateFileTool(), NewPrintTool()},
+		[]Interceptor{InterceptorFunc(ResetTemporarySessionValuesInterceptor), NewAuditInterceptor(log)},
+	)
+	interpreter.CustomTools = []Tool{lookupIssue}
+
+	workspace := t.TempDir()
+	path := filepath.Join(workspace, "main.go")
+	input, err := json.Marshal(InterpreterInput{
+		Script: fmt.Sprintf(`create_file(%q, "package main");
+lookup_issue({ id: "ISSUE-1" });
+try {
+  lookup_issue({});
+} catch (e) {}
+execute_command("exit 3");`, path),
+	})
+	if err != nil {
+		t.Fatalf("error marshalling input: %v", err)
+	}
+
+	task := &Task{
+		ID:               uuid.New(),
+		ProjectDirectory: workspace,
+		Owner:            "alice",
+		CustomTools:      []string{"lookup_issue"},
+	}
+	if _, err := interpreter.Interpret(context.Background(), afero.NewMemMapFs(), input, task); err == nil {
+		t.Fatalf("expected the failing command to stop the script")
+	}
+
+	exitCode := 3
+	expected := []audit.Record{
+		{
+			Kind:      types.AuditEventKindToolCall,
+			Actor:     "alice",
+			Action:    "create_file",
+			TaskID:    &task.ID,
+			Workspace: workspace,
+			Path:      path,
+			DiffHash:  audit.DiffHash("package main"),
+		},
+		{
+			Kind:      types.AuditEventKindToolCall,
+			Actor:     "alice",
+			Action:    "lookup_issue",
+			TaskID:    &task.ID,
+			Workspace: workspace,
+			DiffHash:  audit.DiffHash(`{"id":"ISSUE-1"}`),
+		},
+		{
+			Kind:      types.AuditEventKindToolCall,
+			Actor:     "alice",
+			Action:    "lookup_issue",
+			TaskID:    &task.ID,
+			Workspace: workspace,
+			DiffHash:  audit.DiffHash(`{}`),
+		},
+		{
+			Kind:      types.AuditEventKindToolCall,
+			Actor:     "alice",
+			Action:    "execute_command",
+			TaskID:    &task.ID,
+			Workspace: workspace,
+			Command:   "exit 3",
+			ExitCode:  &exitCode,
+		},
+	}
+	ignore := cmpopts.IgnoreFields(audit.Record{}, "Duration", "Error")
+	if diff := cmp.Diff(expected, log.records, ignore); diff != "" {
+		t.Errorf("records mismatch (-want +got):\n%s", diff)
+	}
+	if len(log.records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(log.records))
+	}
+	if log.records[1].Error != "" {
+		t.Errorf("expected the successful custom tool call to have no error, got %q", log.records[1].Error)
+	}
+	if !strings.Contains(log.records[2].Error, "lookup_issue is missing required properties: id") {
+		t.Errorf("expected the error of the custom tool to be recorded, got %q", log.records[2].Error)
+	}
+	if !strings.Contains(log.records[3].Error, "exit status 3") {
+		t.Errorf("expected the error of the command to be recorded, got %q", log.records[3].Error)
+	}
+}
+
+type stagedChange struct {
+	Path     string
+	Original *string
+	Content  string
+}
+
+// recordingStager records the staged changes and shows the latest content of each file in
+// its overlay, like a pending change would.
+type recordingStager struct {
+	changes []stagedChange
+}
+
+func (s *recordingStager) Stage(ctx context.Context, taskID uuid.UUID, path string, original *string, content string) (*memory.FileChange, error) {
+	s.changes = append(s.changes, stagedChange{Path: path, Original: original, Content: content})
+	return &memory.FileChange{}, nil
+}
+
+func (s *recordingStager) Overlay(ctx context.Context, taskID uuid.UUID, base afero.Fs) (afero.Fs, error) {
+	layer := afero.NewMemMapFs()
+	for _, change := range s.changes {
+		if err := afero.WriteFile(layer, change.Path, []byte(change.Content), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(base), layer), nil
+}
+
+func TestReviewInterceptor(t *testing.T) {
+	workspace := t.TempDir()
+	path := filepath.Join(workspace, "main.go")
+	input, err := json.Marshal(InterpreterInput{
+		Script: fmt.Sprintf(`create_file(%[1]q, "package main");
+edit_file(%[1]q, [{old: "main", new: "app"}]);
+print(read_file(%[1]q).content);`, path),
+	})
+	if err != nil {
+		t.Fatalf("error marshalling input: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		reviewEdits bool
+		expected    []stagedChange
+		onDisk      bool
+	}{
+		{
+			name:   "review disabled",
+			onDisk: true,
+		},
+		{
+			// the edit and the read see the staged file, the workspace does not
+			name:        "review enabled",
+			reviewEdits: true,
+			expected: []stagedChange{
+				{Path: path, Content: "package main"},
+				{Path: path, Content: "package app"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			stager := &recordingStager{}
+			interpreter := NewInterpreter(
+				[]Tool{NewCreateFileTool(), NewEditFileTool(), NewReadFileTool(), NewPrintTool()},
+				[]Interceptor{NewReviewInterceptor(stager)},
+			)
+
+			task := &Task{
+				ID:               uuid.New(),
+				ProjectDirectory: workspace,
+				ReviewEdits:      tt.reviewEdits,
+			}
+			output, err := interpreter.Interpret(context.Background(), fs, input, task)
+			if err != nil {
+				t.Fatalf("error running interpreter: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.expected, stager.changes); diff != "" {
+				t.Errorf("staged changes mismatch (-want +got):\n%s", diff)
+			}
+			if !strings.Contains(output.ConsoleOutput, "package app") {
+				t.Errorf("expected read_file to see the edited file, got %q", output.ConsoleOutput)
+			}
+
+			content, err := afero.ReadFile(fs, path)
+			switch {
+			case tt.onDisk && string(content) != "package app":
+				t.Errorf("expected the edit to be written to the workspace, got %q, %v", content, err)
+			case !tt.onDisk && err == nil:
+				t.Errorf("expected the staged file not to be written to the workspace, got %q", content)
+			}
+		})
+	}
+}
+
+func TestRedactionInterceptor(t *testing.T) {
+	const secret = "sk-ant-REDACTED"
+
+	workspace := t.TempDir()
+	fs := afero.NewOsFs()
+	if err := afero.WriteFile(fs, filepath.Join(workspace, ".env"), []byte("ANTHROPIC_API_KEY="+secret+"\n"), 0600); err != nil {
+		t.Fatalf("failed to write env file: %v", err)
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer provider.Shutdown(context.Background())
+
+	log := &recordingAuditLog{}
+	interpreter := NewInterpreter(
+		[]Tool{NewExecuteCommandTool(), NewCreateFileTool(), NewPrintTool()},
+		[]Interceptor{
+			NewRedactionInterceptor(redact.NewRedactor()),
+			InterceptorFunc(DurableFunctionInterceptor),
+			InterceptorFunc(ResetTemporarySessionValuesInterceptor),
+			NewAuditInterceptor(log),
+			NewTracingInterceptor(provider),
+		},
+	)
+
+	input, err := json.Marshal(InterpreterInput{
+		Script: fmt.Sprintf(`const env = execute_command("cat %[1]s/.env");
+print(env.stdout);
+const marker = env.stdout.trim().split("=")[1];
+create_file("%[1]s/copy.env", "KEY=" + marker);
+print(execute_command("echo " + marker).stdout);
+try {
+  execute_command("echo " + marker + " && exit 1");
+} catch (e) {
+  print(String(e));
+}`, workspace),
+	})
+	if err != nil {
+		t.Fatalf("error marshalling input: %v", err)
+	}
+
+	output, err := interpreter.Interpret(context.Background(), fs, input, &Task{ID: uuid.New(), ProjectDirectory: workspace})
+	if err != nil {
+		t.Fatalf("error running interpreter: %v", err)
+	}
+
+	if strings.Contains(output.ConsoleOutput, secret) || !strings.Contains(output.ConsoleOutput, "[REDACTED:anthropic-key:") {
+		t.Errorf("expected the secret to be redacted from the console output, got %q", output.ConsoleOutput)
+	}
+	if !strings.Contains(output.ConsoleOutput, "exit status 1") {
+		t.Errorf("expected the error of the failing command in the console output, got %q", output.ConsoleOutput)
+	}
+
+	calls, err := json.Marshal(output.FunctionCalls)
+	if err != nil {
+		t.Fatalf("failed to marshal function calls: %v", err)
+	}
+	if strings.Contains(string(calls), secret) {
+		t.Errorf("expected the secret to be redacted from the function calls, got %s", calls)
+	}
+	for _, record := range log.records {
+		if strings.Contains(record.Command, secret) || strings.Contains(record.Error, secret) {
+			t.Errorf("expected the secret to be redacted from the audit log, got %+v", record)
+		}
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) == 0 {
+		t.Fatalf("expected the tool calls to be traced")
+	}
+	for _, span := range spans {
+		recorded, err := json.Marshal(span)
+		if err != nil {
+			t.Fatalf("failed to marshal span: %v", err)
+		}
+		if strings.Contains(string(recorded), secret) {
+			t.Errorf("expected the secret to be kept out of span %s, got %s", span.Name, recorded)
+		}
+	}
+
+	written, err := afero.ReadFile(fs, filepath.Join(workspace, "copy.env"))
+	if err != nil {
+		t.Fatalf("failed to read written file: %v", err)
+	}
+	if string(written) != "KEY="+secret {
+		t.Errorf("expected the marker to be restored in the written file, got %q", written)
+	}
+}
+
+func hasAttribute(attributes []attribute.KeyValue, expected attribute.KeyValue) bool {
+	for _, attr := range attributes {
+		if attr == expected {
+			return true
+		}
+	}
+	return false
+}
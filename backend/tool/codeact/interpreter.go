@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -25,7 +26,10 @@ type InterpreterOutput struct {
 }
 
 type Interpreter struct {
-	Tools        []Tool
+	Tools []Tool
+	// CustomTools are the tools of plugins. They are only available to the agents that
+	// enable them.
+	CustomTools  []Tool
 	Interceptors []Interceptor
 
 	inputSchema map[string]any
@@ -92,7 +96,7 @@ func (c *Interpreter) Interpret(ctx context.Context, fsys afero.Fs, input json.R
 	var stdout bytes.Buffer
 	session := NewSession(ctx, task, vm, &stdout, &stdout, fsys, &shared.DefaultCommandRunner{})
 
-	for _, tool := range c.Tools {
+	for _, tool := range c.ToolsFor(task.CustomTools) {
 		vm.Set(tool.Name(), c.intercept(session, tool, tool.ToolHandler(session)))
 	}
 
@@ -138,6 +142,18 @@ func (c *Interpreter) Interpret(ctx context.Context, fsys afero.Fs, input json.R
 	}, err
 }
 
+// ToolsFor returns the tools of the interpreter and the custom tools with the given names.
+// Unknown names are skipped.
+func (c *Interpreter) ToolsFor(customTools []string) []Tool {
+	tools := slices.Clone(c.Tools)
+	for _, tool := range c.CustomTools {
+		if slices.Contains(customTools, tool.Name()) {
+			tools = append(tools, tool)
+		}
+	}
+	return tools
+}
+
 func (c *Interpreter) handleScriptError(err error) error {
 	exception, ok := err.(*sobek.Exception)
 	if !ok {
@@ -0,0 +1,235 @@
+package codeact
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/plugin"
+	"github.com/grafana/sobek"
+)
+
+var pluginDescription = `
+## Description
+%[2]s
+
+## Parameters
+Call %[3]s with a single object that matches the following JSON schema:
+%[1]sjson
+%[4]s
+%[1]s
+
+## Expected Output
+Returns the value that the tool produces, usually an object.
+
+## Usage Example
+%[1]s
+const result = %[3]s({ /* parameters */ });
+print(result);
+%[1]s
+`
+
+type pluginTool struct {
+	plugin  *plugin.Plugin
+	program *sobek.Program
+}
+
+// NewPluginTool returns the tool that calls a plugin. JavaScript plugins run in the
+// interpreter of the agent and can call the other tools of the agent, executables run in
+// the workspace of the task.
+func NewPluginTool(p *plugin.Plugin) (Tool, error) {
+	tool := &pluginTool{plugin: p}
+
+	if p.Runtime == plugin.RuntimeJavaScript {
+		program, err := sobek.Compile(p.EntrypointPath(), "(function (module, exports) {\n"+p.Source+"\n})", true)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s: failed to compile %s: %w", p.Name, p.Entrypoint, err)
+		}
+		tool.program = program
+	}
+
+	return tool, nil
+}
+
+func (t *pluginTool) Name() string {
+	return t.plugin.Name
+}
+
+func (t *pluginTool) Description() string {
+	schema := t.plugin.InputSchema
+	if schema == nil {
+		schema = map[string]any{"type": "object"}
+	}
+	formatted, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		formatted = []byte(`{"type": "object"}`)
+	}
+
+	return fmt.Sprintf(pluginDescription, "```", strings.TrimSpace(t.plugin.Description), t.plugin.Name, formatted)
+}
+
+func (t *pluginTool) Input(session *Session, args []sobek.Value) (any, error) {
+	arguments := json.RawMessage("{}")
+	if len(args) > 0 && !sobek.IsUndefined(args[0]) {
+		encoded, err := json.Marshal(args[0].Export())
+		if err != nil {
+			return nil, base.NewCustomError(fmt.Sprintf("the argument of %s cannot be encoded as JSON", t.plugin.Name), []string{
+				"Pass an object with plain values, functions are not supported",
+			}, "error", err)
+		}
+		arguments = encoded
+	}
+
+	return &plugin.CallInput{
+		Tool:      t.plugin.Name,
+		Arguments: arguments,
+	}, nil
+}
+
+func (t *pluginTool) ToolHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rawInput, err := t.Input(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+		input := rawInput.(*plugin.CallInput)
+
+		var result *plugin.CallResult
+		switch t.plugin.Runtime {
+		case plugin.RuntimeExecutable:
+			result, err = plugin.Execute(session.Context, t.plugin, session.Task.ProjectDirectory, input)
+		case plugin.RuntimeJavaScript:
+			result, err = t.runJavaScript(session, input)
+		default:
+			err = fmt.Errorf("plugin %s has an unknown runtime %s", t.plugin.Name, t.plugin.Runtime)
+		}
+		if err != nil {
+			session.Throw(err)
+		}
+
+		var value any
+		if err := json.Unmarshal(result.Output, &value); err != nil {
+			session.Throw(err)
+		}
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(value)
+	}
+}
+
+// runJavaScript runs the module of a JavaScript plugin in the VM of the session. The module
+// has to export a function, which is called with the argument of the call. A call that
+// exceeds the timeout of the plugin is interrupted and fails like any other tool call, the
+// script of the agent continues.
+func (t *pluginTool) runJavaScript(session *Session, input *plugin.CallInput) (*plugin.CallResult, error) {
+	if err := t.plugin.ValidateArguments(input.Arguments); err != nil {
+		return nil, err
+	}
+
+	var args any
+	if err := json.Unmarshal(input.Arguments, &args); err != nil {
+		return nil, err
+	}
+
+	// mu keeps the timer from interrupting the VM once the call returned, which would abort
+	// the script of the agent instead
+	var (
+		mu       sync.Mutex
+		done     bool
+		timedOut bool
+	)
+	timeout := time.AfterFunc(time.Duration(t.plugin.Timeout)*time.Second, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if !done {
+			timedOut = true
+			session.VM.Interrupt(fmt.Sprintf("%s timed out after %d seconds", t.plugin.Name, t.plugin.Timeout))
+		}
+	})
+
+	result, err := t.runModule(session, args)
+
+	timeout.Stop()
+	mu.Lock()
+	done = true
+	mu.Unlock()
+
+	if timedOut {
+		session.VM.ClearInterrupt()
+
+		var interrupted *sobek.InterruptedError
+		if errors.As(err, &interrupted) {
+			return nil, base.NewCustomError(fmt.Sprintf("%s timed out after %d seconds", t.plugin.Name, t.plugin.Timeout), []string{
+				"Call the tool with a smaller input",
+			}, "tool", t.plugin.Name)
+		}
+	}
+
+	return result, err
+}
+
+func (t *pluginTool) runModule(session *Session, args any) (*plugin.CallResult, error) {
+	wrapper, err := session.VM.RunProgram(t.program)
+	if err != nil {
+		return nil, t.pluginError(err)
+	}
+	load, ok := sobek.AssertFunction(wrapper)
+	if !ok {
+		return nil, fmt.Errorf("plugin %s: module wrapper is not a function", t.plugin.Name)
+	}
+
+	module := session.VM.NewObject()
+	exports := session.VM.NewObject()
+	if err := module.Set("exports", exports); err != nil {
+		return nil, err
+	}
+	if _, err := load(sobek.Undefined(), module, exports); err != nil {
+		return nil, t.pluginError(err)
+	}
+
+	run, ok := sobek.AssertFunction(module.Get("exports"))
+	if !ok {
+		return nil, base.NewCustomError(fmt.Sprintf("plugin %s does not export a function", t.plugin.Name), []string{
+			"The tool is broken, report the problem to the user",
+		}, "entrypoint", t.plugin.EntrypointPath())
+	}
+
+	value, err := run(sobek.Undefined(), session.VM.ToValue(args))
+	if err != nil {
+		return nil, t.pluginError(err)
+	}
+
+	output := json.RawMessage("null")
+	if value != nil && !sobek.IsUndefined(value) {
+		output, err = json.Marshal(value.Export())
+		if err != nil {
+			return nil, base.NewCustomError(fmt.Sprintf("the result of %s cannot be encoded as JSON", t.plugin.Name), []string{
+				"The tool is broken, report the problem to the user",
+			}, "error", err)
+		}
+	}
+
+	return &plugin.CallResult{Output: output}, nil
+}
+
+// pluginError unwraps the errors of tools that the plugin called, so that their details
+// reach the agent.
+func (t *pluginTool) pluginError(err error) error {
+	var exception *sobek.Exception
+	if errors.As(err, &exception) && exception.Unwrap() != nil {
+		return exception.Unwrap()
+	}
+
+	var interrupted *sobek.InterruptedError
+	if errors.As(err, &interrupted) {
+		return err
+	}
+
+	return base.NewCustomError(fmt.Sprintf("%s failed", t.plugin.Name), []string{
+		"Check the error message and correct the arguments",
+	}, "tool", t.plugin.Name, "error", err)
+}
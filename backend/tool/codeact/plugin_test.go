@@ -0,0 +1,157 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/plugin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestPluginTool(t *testing.T) {
+	lookupIssue := &plugin.Plugin{
+		Manifest: plugin.Manifest{
+			Name:        "lookup_issue",
+			Description: "Looks up an issue",
+			InputSchema: map[string]any{"type": "object", "required": []any{"id"}},
+			Runtime:     plugin.RuntimeJavaScript,
+			Entrypoint:  "index.js",
+			Timeout:     plugin.DefaultTimeout,
+		},
+		Dir: "/tools/issues",
+		Source: `module.exports = function (args) {
+			print("looking up " + args.id);
+			return { id: args.id, state: "open" };
+		};`,
+	}
+
+	tests := []struct {
+		name          string
+		script        string
+		customTools   []string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:        "plugin calls built-in tools and returns a value",
+			script:      `const issue = lookup_issue({ id: "ISSUE-1" }); print(issue.id + " is " + issue.state);`,
+			customTools: []string{"lookup_issue"},
+			expected:    "looking up ISSUE-1\nISSUE-1 is open\n",
+		},
+		{
+			name:          "missing required property",
+			script:        `lookup_issue({});`,
+			customTools:   []string{"lookup_issue"},
+			expectedError: "lookup_issue is missing required properties: id",
+		},
+		{
+			name:          "plugin is not enabled for the agent",
+			script:        `lookup_issue({ id: "ISSUE-1" });`,
+			expectedError: "lookup_issue is not defined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool, err := NewPluginTool(lookupIssue)
+			if err != nil {
+				t.Fatalf("failed to create plugin tool: %v", err)
+			}
+
+			interpreter := NewInterpreter([]Tool{NewPrintTool()}, []Interceptor{InterceptorFunc(DurableFunctionInterceptor)})
+			interpreter.CustomTools = []Tool{tool}
+
+			input, err := json.Marshal(InterpreterInput{Script: tt.script})
+			if err != nil {
+				t.Fatalf("failed to marshal input: %v", err)
+			}
+
+			output, err := interpreter.Interpret(context.Background(), afero.NewMemMapFs(), input, &Task{
+				ID:               uuid.New(),
+				ProjectDirectory: "/workspace",
+				CustomTools:      tt.customTools,
+			})
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.expected, output.ConsoleOutput); diff != "" {
+				t.Errorf("console output mismatch (-want +got):\n%s", diff)
+			}
+
+			expectedCalls := []FunctionCall{
+				{
+					ToolName: "lookup_issue",
+					Input: FunctionCallInput{
+						Custom: &plugin.CallInput{Tool: "lookup_issue", Arguments: json.RawMessage(`{"id":"ISSUE-1"}`)},
+					},
+					Output: FunctionCallOutput{
+						Custom: &plugin.CallResult{Output: json.RawMessage(`{"id":"ISSUE-1","state":"open"}`)},
+					},
+				},
+			}
+			if diff := cmp.Diff(expectedCalls, output.FunctionCalls); diff != "" {
+				t.Errorf("function calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPluginToolTimeout(t *testing.T) {
+	spin, err := NewPluginTool(&plugin.Plugin{
+		Manifest: plugin.Manifest{
+			Name:        "spin",
+			Description: "Never returns",
+			Runtime:     plugin.RuntimeJavaScript,
+			Entrypoint:  "index.js",
+			Timeout:     1,
+		},
+		Dir:    "/tools/spin",
+		Source: `module.exports = function () { for (;;) {} };`,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin tool: %v", err)
+	}
+
+	interpreter := NewInterpreter([]Tool{NewPrintTool()}, []Interceptor{InterceptorFunc(DurableFunctionInterceptor)})
+	interpreter.CustomTools = []Tool{spin}
+
+	// only the call of the plugin fails, the script continues after it
+	input, err := json.Marshal(InterpreterInput{Script: `try {
+  spin({});
+} catch (e) {
+  print(String(e));
+}
+let sum = 0;
+for (let i = 0; i < 1000; i++) { sum += i; }
+print("continued " + sum);`})
+	if err != nil {
+		t.Fatalf("failed to marshal input: %v", err)
+	}
+
+	output, err := interpreter.Interpret(context.Background(), afero.NewMemMapFs(), input, &Task{
+		ID:               uuid.New(),
+		ProjectDirectory: "/workspace",
+		CustomTools:      []string{"spin"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(output.ConsoleOutput, "spin timed out after 1 seconds") {
+		t.Errorf("expected the timeout of the plugin in the console output, got %q", output.ConsoleOutput)
+	}
+	if !strings.HasSuffix(output.ConsoleOutput, "continued 499500\n") {
+		t.Errorf("expected the script to continue after the timeout, got %q", output.ConsoleOutput)
+	}
+}
@@ -0,0 +1,97 @@
+package codeact
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/project"
+	"github.com/grafana/sobek"
+)
+
+var rememberDescription = `
+## Description
+Records a durable learning about the project in the project memory. The project memory is part of the instructions of every future task in this project, so use it for facts that will still be true and useful next time.
+
+## Parameters
+- **learning** (*string*, required): A single, self-contained fact about the project, at most 500 characters. Line breaks are joined into one line.
+
+## Expected Output
+Returns an object describing the stored learning:
+%[1]s
+{
+  "path": "/path/to/project/.construct/memory.md",
+  "learning": "Use pnpm instead of npm, the lockfile is pnpm-lock.yaml",
+  "duplicate": false,
+  "entries": 4
+}
+%[1]s
+
+**Details:**
+- **path**: The memory file at the root of the repository
+- **learning**: The learning as it was stored
+- **duplicate**: Whether the learning was already recorded, in which case it was not added again
+- **entries**: The number of learnings in the project memory
+
+## CRITICAL REQUIREMENTS
+- **Durable facts only**: Record conventions, commands, pitfalls and preferences of the user that apply beyond the current task. Do not record progress, plans or details of the current task.
+- **Self-contained**: The learning will be read without the context of this conversation. Name files, commands and tools explicitly.
+- **Limited space**: The project memory holds at most 16 KB. If it is full, edit the memory file to remove outdated entries before adding new ones.
+
+## When to use
+- The user corrects you or states a preference that applies to the whole project ("always use pnpm", "never touch generated/")
+- You discover a non-obvious command or workflow, e.g. how to run a single test
+- You hit a pitfall that cost time and is likely to come up again
+
+## Usage Examples
+
+%[1]s
+remember("Run tests with 'make test', plain 'go test' misses the generated mocks");
+%[1]s
+
+%[1]s
+const result = remember("Files in internal/gen are generated by 'make generate', never edit them by hand");
+if (result.duplicate) {
+  print("Already known");
+}
+%[1]s
+`
+
+func NewRememberTool() Tool {
+	return NewOnDemandTool(
+		"remember",
+		fmt.Sprintf(rememberDescription, "```"),
+		rememberInput,
+		rememberHandler,
+	)
+}
+
+func rememberInput(session *Session, args []sobek.Value) (any, error) {
+	if len(args) != 1 || args[0] == nil || sobek.IsUndefined(args[0]) || args[0].ExportType() != reflect.TypeOf("") {
+		return nil, base.NewCustomError("remember requires the learning as a string", []string{
+			"Call remember with a single string argument, e.g. remember(\"Use pnpm instead of npm\")",
+		})
+	}
+
+	return &project.RememberInput{
+		Learning: args[0].String(),
+	}, nil
+}
+
+func rememberHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rawInput, err := rememberInput(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+		input := rawInput.(*project.RememberInput)
+
+		result, err := project.Remember(session.FS, session.Task.ProjectDirectory, input)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result)
+	}
+}
@@ -0,0 +1,158 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+// maxOutputSize bounds the output of an executable plugin.
+const maxOutputSize = 1 << 20
+
+// baseEnvironment are the variables of the daemon environment that every executable plugin
+// receives. Others, e.g. the credentials of the daemon, have to be listed in the manifest.
+var baseEnvironment = []string{"PATH", "HOME", "TMPDIR"}
+
+type CallInput struct {
+	Tool string `json:"tool"`
+	// Arguments is the JSON encoded argument of the call.
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+type CallResult struct {
+	// Output is the JSON encoded return value of the call.
+	Output json.RawMessage `json:"output"`
+}
+
+// ValidateArguments checks that the arguments of a call are a JSON object that has the
+// required properties of the input schema of the plugin.
+func (p *Plugin) ValidateArguments(arguments json.RawMessage) error {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(arguments, &object); err != nil || object == nil {
+		return base.NewCustomError(fmt.Sprintf("%s expects an object as argument", p.Name), []string{
+			fmt.Sprintf("Call %s with a single object, e.g. %s({ ... })", p.Name, p.Name),
+		}, "arguments", string(arguments))
+	}
+
+	required, _ := p.InputSchema["required"].([]any)
+	var missing []string
+	for _, property := range required {
+		name, ok := property.(string)
+		if !ok {
+			continue
+		}
+		if _, ok := object[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return base.NewCustomError(fmt.Sprintf("%s is missing required properties: %s", p.Name, strings.Join(missing, ", ")), []string{
+			"Check the parameters in the description of the tool",
+		}, "missing", missing)
+	}
+
+	return nil
+}
+
+// Execute runs an executable plugin. The arguments are written to its stdin and its stdout
+// has to be a JSON value, which is the result of the call. The plugin runs in the workspace
+// of the task and fails if it exits with a non-zero code. It does not inherit the
+// environment of the daemon, see environment.
+func Execute(ctx context.Context, p *Plugin, workspace string, input *CallInput) (*CallResult, error) {
+	if p.Runtime != RuntimeExecutable {
+		return nil, fmt.Errorf("plugin %s is not an executable", p.Name)
+	}
+
+	if err := p.ValidateArguments(input.Arguments); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(p.Timeout)*time.Second)
+	defer cancel()
+
+	var stdout, stderr limitedBuffer
+	cmd := exec.CommandContext(ctx, p.EntrypointPath())
+	cmd.Dir = workspace
+	cmd.Env = environment(p, workspace)
+	cmd.Stdin = bytes.NewReader(input.Arguments)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, base.NewCustomError(fmt.Sprintf("%s timed out after %d seconds", p.Name, p.Timeout), []string{
+			"Call the tool with a smaller input",
+		}, "tool", p.Name)
+	}
+	if err != nil {
+		exitCode := -1
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			exitCode = exitErr.ExitCode()
+		}
+		return nil, base.NewCustomError(fmt.Sprintf("%s failed", p.Name), []string{
+			"Check the error output of the tool and correct the arguments",
+		}, "tool", p.Name, "error", err, "exit_code", exitCode, "stderr", stderr.String())
+	}
+
+	if stdout.truncated {
+		return nil, base.NewCustomError(fmt.Sprintf("the output of %s exceeds %d bytes", p.Name, maxOutputSize), []string{
+			"Call the tool with arguments that produce less output",
+		}, "tool", p.Name)
+	}
+
+	output := bytes.TrimSpace(stdout.Bytes())
+	if len(output) == 0 {
+		output = []byte("null")
+	}
+	if !json.Valid(output) {
+		return nil, base.NewCustomError(fmt.Sprintf("%s did not write JSON to stdout", p.Name), []string{
+			"The tool is broken, report the problem to the user",
+		}, "tool", p.Name, "stdout", string(output))
+	}
+
+	return &CallResult{Output: output}, nil
+}
+
+// environment returns the environment of an executable plugin: the base variables and the
+// variables its manifest asks for, if the daemon has them, and the CONSTRUCT_ variables
+// that tell the plugin where it runs.
+func environment(p *Plugin, workspace string) []string {
+	var env []string
+	for _, name := range append(slices.Clone(baseEnvironment), p.Env...) {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+
+	return append(env,
+		"CONSTRUCT_WORKSPACE="+workspace,
+		"CONSTRUCT_PLUGIN_DIR="+p.Dir,
+	)
+}
+
+// limitedBuffer keeps the first maxOutputSize bytes written to it.
+type limitedBuffer struct {
+	bytes.Buffer
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := maxOutputSize - b.Len(); len(p) > remaining {
+		b.Buffer.Write(p[:max(remaining, 0)])
+		b.truncated = true
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,129 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExecute(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable plugins are shell scripts in this test")
+	}
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("DAEMON_SECRET", "secret")
+	t.Setenv("ISSUE_TRACKER_TOKEN", "token")
+
+	tests := []struct {
+		name      string
+		script    string
+		schema    map[string]any
+		env       []string
+		arguments string
+		expected  *CallResult
+		error     string
+	}{
+		{
+			name:      "output is the result",
+			script:    "#!/bin/sh\nread input\necho \"{\\\"input\\\": $input, \\\"cwd\\\": \\\"$(basename \"$PWD\")\\\", \\\"workspace\\\": \\\"$(basename \"$CONSTRUCT_WORKSPACE\")\\\"}\"\n",
+			arguments: `{"id": "ISSUE-1"}`,
+			expected:  &CallResult{Output: json.RawMessage(`{"input": {"id": "ISSUE-1"}, "cwd": "workspace", "workspace": "workspace"}`)},
+		},
+		{
+			name:      "environment of the daemon is not inherited",
+			script:    "#!/bin/sh\necho \"{\\\"secret\\\": \\\"${DAEMON_SECRET:-}\\\", \\\"token\\\": \\\"${ISSUE_TRACKER_TOKEN:-}\\\", \\\"home\\\": \\\"${HOME:+set}\\\"}\"\n",
+			env:       []string{"ISSUE_TRACKER_TOKEN"},
+			arguments: `{}`,
+			expected:  &CallResult{Output: json.RawMessage(`{"secret": "", "token": "token", "home": "set"}`)},
+		},
+		{
+			name:      "empty output is null",
+			script:    "#!/bin/sh\nexit 0\n",
+			arguments: `{}`,
+			expected:  &CallResult{Output: json.RawMessage("null")},
+		},
+		{
+			name:      "non-zero exit code",
+			script:    "#!/bin/sh\necho 'issue not found' >&2\nexit 3\n",
+			arguments: `{}`,
+			error:     "lookup_issue failed",
+		},
+		{
+			name:      "output is not JSON",
+			script:    "#!/bin/sh\necho 'done'\n",
+			arguments: `{}`,
+			error:     "lookup_issue did not write JSON to stdout",
+		},
+		{
+			name:      "arguments are not an object",
+			script:    "#!/bin/sh\necho '{}'\n",
+			arguments: `["ISSUE-1"]`,
+			error:     "lookup_issue expects an object as argument",
+		},
+		{
+			name:      "required properties are missing",
+			script:    "#!/bin/sh\necho '{}'\n",
+			schema:    map[string]any{"type": "object", "required": []any{"project", "id"}},
+			arguments: `{"title": "crash"}`,
+			error:     "lookup_issue is missing required properties: id, project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			pluginDir := filepath.Join(dir, "plugin")
+			workspace := filepath.Join(dir, "workspace")
+			for _, d := range []string{pluginDir, workspace} {
+				if err := os.Mkdir(d, 0755); err != nil {
+					t.Fatalf("failed to create %s: %v", d, err)
+				}
+			}
+			if err := os.WriteFile(filepath.Join(pluginDir, "run"), []byte(tt.script), 0755); err != nil {
+				t.Fatalf("failed to write script: %v", err)
+			}
+
+			p := &Plugin{
+				Manifest: Manifest{
+					Name:        "lookup_issue",
+					Description: "Looks up an issue",
+					InputSchema: tt.schema,
+					Runtime:     RuntimeExecutable,
+					Entrypoint:  "run",
+					Timeout:     DefaultTimeout,
+					Env:         tt.env,
+				},
+				Dir: pluginDir,
+			}
+
+			actual, err := Execute(context.Background(), p, workspace, &CallInput{
+				Tool:      p.Name,
+				Arguments: json.RawMessage(tt.arguments),
+			})
+			if tt.error != "" {
+				var toolErr *base.ToolError
+				if !errors.As(err, &toolErr) {
+					t.Fatalf("expected a tool error, got %v", err)
+				}
+				if toolErr.Message != tt.error {
+					t.Errorf("expected error %q, got %q", tt.error, toolErr.Message)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Errorf("Execute() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Package plugin loads tools that users define outside of construct. A plugin is a directory
+// with a tool.json manifest and an entrypoint, which is either a JavaScript module that runs
+// in the interpreter of the agent or an executable that speaks JSON on stdin and stdout.
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	// ManifestFileName is the name of the manifest in the directory of a plugin.
+	ManifestFileName = "tool.json"
+
+	// DefaultTimeout is the timeout in seconds of a call if the manifest does not set one.
+	DefaultTimeout = 60
+	// MaxTimeout is the longest timeout in seconds a manifest can set.
+	MaxTimeout = 600
+)
+
+type Runtime string
+
+const (
+	RuntimeJavaScript Runtime = "javascript"
+	RuntimeExecutable Runtime = "executable"
+)
+
+// namePattern restricts tool names to valid JavaScript identifiers that are easy to type.
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type Manifest struct {
+	// Name is the name of the function that agents call.
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// InputSchema is the JSON schema of the argument of the function.
+	InputSchema map[string]any `json:"input_schema,omitempty"`
+	Runtime     Runtime        `json:"runtime"`
+	// Entrypoint is the path of the module or executable relative to the plugin directory.
+	Entrypoint string `json:"entrypoint"`
+	// Timeout of a call in seconds.
+	Timeout int `json:"timeout,omitempty"`
+	// Env names the variables of the daemon environment that an executable receives in
+	// addition to PATH, HOME and TMPDIR.
+	Env []string `json:"env,omitempty"`
+}
+
+type Plugin struct {
+	Manifest
+	// Dir is the directory of the plugin.
+	Dir string
+	// Source is the content of the entrypoint of JavaScript plugins.
+	Source string
+}
+
+// EntrypointPath returns the absolute path of the entrypoint.
+func (p *Plugin) EntrypointPath() string {
+	return filepath.Join(p.Dir, p.Entrypoint)
+}
+
+// Load loads the plugins in the directories below dir, sorted by name. Plugins that cannot be
+// loaded are skipped and reported in the returned error. A missing dir has no plugins.
+func Load(fsys afero.Fs, dir string) ([]*Plugin, error) {
+	entries, err := afero.ReadDir(fsys, dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read plugin directory %s: %w", dir, err)
+	}
+
+	var (
+		plugins []*Plugin
+		errs    []error
+	)
+	names := make(map[string]string)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		pluginDir := filepath.Join(dir, entry.Name())
+		if _, err := fsys.Stat(filepath.Join(pluginDir, ManifestFileName)); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		plugin, err := LoadPlugin(fsys, pluginDir)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if other, ok := names[plugin.Name]; ok {
+			errs = append(errs, fmt.Errorf("plugin %s: tool %s is already defined by %s", pluginDir, plugin.Name, other))
+			continue
+		}
+		names[plugin.Name] = pluginDir
+		plugins = append(plugins, plugin)
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return plugins, errors.Join(errs...)
+}
+
+// LoadPlugin loads the plugin in dir and validates its manifest.
+func LoadPlugin(fsys afero.Fs, dir string) (*Plugin, error) {
+	content, err := afero.ReadFile(fsys, filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: failed to read manifest: %w", dir, err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid manifest: %w", dir, err)
+	}
+
+	if err := validateManifest(&manifest); err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", dir, err)
+	}
+
+	plugin := &Plugin{
+		Manifest: manifest,
+		Dir:      dir,
+	}
+
+	info, err := fsys.Stat(plugin.EntrypointPath())
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: entrypoint %s: %w", dir, manifest.Entrypoint, err)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("plugin %s: entrypoint %s is a directory", dir, manifest.Entrypoint)
+	}
+
+	if manifest.Runtime == RuntimeJavaScript {
+		source, err := afero.ReadFile(fsys, plugin.EntrypointPath())
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s: failed to read entrypoint: %w", dir, err)
+		}
+		plugin.Source = string(source)
+	}
+
+	return plugin, nil
+}
+
+func validateManifest(manifest *Manifest) error {
+	if !namePattern.MatchString(manifest.Name) {
+		return fmt.Errorf("invalid name %q: must start with a lowercase letter and have at most 64 lowercase letters, digits or '_'", manifest.Name)
+	}
+
+	if strings.TrimSpace(manifest.Description) == "" {
+		return fmt.Errorf("description is required")
+	}
+
+	switch manifest.Runtime {
+	case RuntimeJavaScript, RuntimeExecutable:
+	default:
+		return fmt.Errorf("invalid runtime %q: must be %s or %s", manifest.Runtime, RuntimeJavaScript, RuntimeExecutable)
+	}
+
+	if manifest.Entrypoint == "" {
+		return fmt.Errorf("entrypoint is required")
+	}
+	if filepath.IsAbs(manifest.Entrypoint) || !filepath.IsLocal(manifest.Entrypoint) {
+		return fmt.Errorf("entrypoint %s must be a path within the plugin directory", manifest.Entrypoint)
+	}
+
+	if manifest.Timeout < 0 || manifest.Timeout > MaxTimeout {
+		return fmt.Errorf("invalid timeout %d: must be between 1 and %d seconds", manifest.Timeout, MaxTimeout)
+	}
+	if manifest.Timeout == 0 {
+		manifest.Timeout = DefaultTimeout
+	}
+
+	if len(manifest.Env) > 0 && manifest.Runtime != RuntimeExecutable {
+		return fmt.Errorf("env is only supported by the %s runtime", RuntimeExecutable)
+	}
+	for _, name := range manifest.Env {
+		if !envNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid env variable name %q", name)
+		}
+	}
+
+	if manifest.InputSchema != nil {
+		if schemaType, ok := manifest.InputSchema["type"]; ok && schemaType != "object" {
+			return fmt.Errorf("input_schema must describe an object, got type %v", schemaType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected []*Plugin
+		errors   []string
+	}{
+		{
+			name:     "missing directory has no plugins",
+			files:    map[string]string{},
+			expected: nil,
+		},
+		{
+			name: "plugins are loaded and sorted by name",
+			files: map[string]string{
+				"/tools/status/tool.json":  `{"name": "deploy_status", "description": "Reports the status of a deployment", "runtime": "executable", "entrypoint": "bin/status", "timeout": 30, "env": ["DEPLOY_TOKEN"]}`,
+				"/tools/status/bin/status": "#!/bin/sh",
+				"/tools/issues/tool.json": `{
+					"name": "lookup_issue",
+					"description": "Looks up an issue",
+					"input_schema": {"type": "object", "properties": {"id": {"type": "string"}}, "required": ["id"]},
+					"runtime": "javascript",
+					"entrypoint": "index.js"
+				}`,
+				"/tools/issues/index.js": "module.exports = (args) => ({ id: args.id });",
+				"/tools/README.md":       "not a plugin",
+				"/tools/drafts/notes.md": "no manifest",
+			},
+			expected: []*Plugin{
+				{
+					Manifest: Manifest{
+						Name:        "deploy_status",
+						Description: "Reports the status of a deployment",
+						Runtime:     RuntimeExecutable,
+						Entrypoint:  "bin/status",
+						Timeout:     30,
+						Env:         []string{"DEPLOY_TOKEN"},
+					},
+					Dir: "/tools/status",
+				},
+				{
+					Manifest: Manifest{
+						Name:        "lookup_issue",
+						Description: "Looks up an issue",
+						InputSchema: map[string]any{
+							"type":       "object",
+							"properties": map[string]any{"id": map[string]any{"type": "string"}},
+							"required":   []any{"id"},
+						},
+						Runtime:    RuntimeJavaScript,
+						Entrypoint: "index.js",
+						Timeout:    DefaultTimeout,
+					},
+					Dir:    "/tools/issues",
+					Source: "module.exports = (args) => ({ id: args.id });",
+				},
+			},
+		},
+		{
+			name: "invalid plugins are skipped and reported",
+			files: map[string]string{
+				"/tools/valid/tool.json":       `{"name": "valid", "description": "Works", "runtime": "executable", "entrypoint": "run"}`,
+				"/tools/valid/run":             "#!/bin/sh",
+				"/tools/name/tool.json":        `{"name": "Deploy-Status", "description": "Bad name", "runtime": "executable", "entrypoint": "run"}`,
+				"/tools/runtime/tool.json":     `{"name": "python_tool", "description": "Bad runtime", "runtime": "python", "entrypoint": "run.py"}`,
+				"/tools/escape/tool.json":      `{"name": "escape", "description": "Bad entrypoint", "runtime": "executable", "entrypoint": "../valid/run"}`,
+				"/tools/missing/tool.json":     `{"name": "missing", "description": "No entrypoint", "runtime": "executable", "entrypoint": "run"}`,
+				"/tools/timeout/tool.json":     `{"name": "slow", "description": "Too slow", "runtime": "executable", "entrypoint": "run", "timeout": 3600}`,
+				"/tools/timeout/run":           "#!/bin/sh",
+				"/tools/schema/tool.json":      `{"name": "schema", "description": "Bad schema", "runtime": "executable", "entrypoint": "run", "input_schema": {"type": "string"}}`,
+				"/tools/schema/run":            "#!/bin/sh",
+				"/tools/undescribed/tool.json": `{"name": "undescribed", "runtime": "executable", "entrypoint": "run"}`,
+				"/tools/env/tool.json":         `{"name": "env", "description": "Bad env", "runtime": "executable", "entrypoint": "run", "env": ["API-TOKEN"]}`,
+				"/tools/env/run":               "#!/bin/sh",
+				"/tools/jsenv/tool.json":       `{"name": "jsenv", "description": "Env in JavaScript", "runtime": "javascript", "entrypoint": "index.js", "env": ["API_TOKEN"]}`,
+				"/tools/jsenv/index.js":        "module.exports = () => null;",
+				"/tools/malformed/tool.json":   `{"name": `,
+				"/tools/zduplicate/tool.json":  `{"name": "valid", "description": "Same name", "runtime": "executable", "entrypoint": "run"}`,
+				"/tools/zduplicate/run":        "#!/bin/sh",
+			},
+			expected: []*Plugin{
+				{
+					Manifest: Manifest{
+						Name:        "valid",
+						Description: "Works",
+						Runtime:     RuntimeExecutable,
+						Entrypoint:  "run",
+						Timeout:     DefaultTimeout,
+					},
+					Dir: "/tools/valid",
+				},
+			},
+			errors: []string{
+				"plugin /tools/env: invalid env variable name \"API-TOKEN\"",
+				"plugin /tools/escape: entrypoint ../valid/run must be a path within the plugin directory",
+				"plugin /tools/jsenv: env is only supported by the executable runtime",
+				"plugin /tools/malformed: invalid manifest",
+				"plugin /tools/missing: entrypoint run",
+				"plugin /tools/name: invalid name \"Deploy-Status\"",
+				"plugin /tools/runtime: invalid runtime \"python\"",
+				"plugin /tools/schema: input_schema must describe an object",
+				"plugin /tools/timeout: invalid timeout 3600",
+				"plugin /tools/undescribed: description is required",
+				"plugin /tools/zduplicate: tool valid is already defined by /tools/valid",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			for path, content := range tt.files {
+				if err := afero.WriteFile(fs, path, []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write %s: %v", path, err)
+				}
+			}
+
+			actual, err := Load(fs, "/tools")
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Errorf("Load() mismatch (-want +got):\n%s", diff)
+			}
+
+			var messages []string
+			if err != nil {
+				messages = strings.Split(err.Error(), "\n")
+			}
+			if len(messages) != len(tt.errors) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tt.errors), len(messages), err)
+			}
+			for i, message := range messages {
+				if !strings.HasPrefix(message, tt.errors[i]) {
+					t.Errorf("error %d: expected prefix %q, got %q", i, tt.errors[i], message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package project
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/spf13/afero"
+)
+
+const (
+	// MaxMemorySize bounds the memory file of a project, so that it stays small enough to be
+	// part of every system prompt.
+	MaxMemorySize = 16 * 1024
+	// maxLearningLength bounds a single entry of the memory file.
+	maxLearningLength = 500
+
+	memoryHeader = "# Project Memory\n\nLearnings recorded by agents. Edit or remove entries that are no longer accurate.\n"
+)
+
+type RememberInput struct {
+	Learning string
+}
+
+type RememberResult struct {
+	Path      string `json:"path"`
+	Learning  string `json:"learning"`
+	Duplicate bool   `json:"duplicate"`
+	Entries   int    `json:"entries"`
+}
+
+// Root returns the root of the repository that contains dir, which is the closest ancestor
+// with a .git entry. If dir is not in a repository, dir itself is the root.
+func Root(fsys afero.Fs, dir string) string {
+	dir = filepath.Clean(dir)
+	for current := dir; ; {
+		if _, err := fsys.Stat(filepath.Join(current, ".git")); err == nil {
+			return current
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+		current = parent
+	}
+}
+
+// MemoryPath returns the path of the memory file of the project that contains dir.
+func MemoryPath(fsys afero.Fs, dir string) string {
+	return filepath.Join(Root(fsys, dir), ".construct", "memory.md")
+}
+
+// Remember appends a learning to the memory file of the project that contains dir. Learnings
+// that are already recorded are not added again.
+func Remember(fsys afero.Fs, dir string, input *RememberInput) (*RememberResult, error) {
+	learning := strings.Join(strings.Fields(input.Learning), " ")
+	if learning == "" {
+		return nil, base.NewCustomError("learning is required", []string{
+			"Provide a short, self-contained fact about the project that will help with future tasks",
+		})
+	}
+	if len(learning) > maxLearningLength {
+		return nil, base.NewCustomError(fmt.Sprintf("learning is longer than %d characters", maxLearningLength), []string{
+			"Condense the learning to a single sentence",
+			"Split unrelated facts into separate calls",
+		}, "length", len(learning))
+	}
+
+	path := MemoryPath(fsys, dir)
+	content, err := afero.ReadFile(fsys, path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, base.NewCustomError("could not read the project memory", []string{
+			"Verify that you have the permission to read the file",
+		}, "path", path, "error", err)
+	}
+
+	entries := memoryEntries(string(content))
+	for _, entry := range entries {
+		if strings.EqualFold(entry, learning) {
+			return &RememberResult{Path: path, Learning: learning, Duplicate: true, Entries: len(entries)}, nil
+		}
+	}
+
+	updated := string(content)
+	if updated == "" {
+		updated = memoryHeader + "\n"
+	} else if !strings.HasSuffix(updated, "\n") {
+		updated += "\n"
+	}
+	updated += "- " + learning + "\n"
+
+	if len(updated) > MaxMemorySize {
+		return nil, base.NewCustomError("the project memory is full", []string{
+			fmt.Sprintf("Edit %s to remove entries that are outdated or merge related ones", path),
+		}, "path", path, "size_bytes", len(content))
+	}
+
+	if err := fsys.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, base.NewCustomError("could not create the directory of the project memory", []string{
+			"Verify that you have the permissions to create the directory",
+		}, "path", path, "error", err)
+	}
+
+	if err := afero.WriteFile(fsys, path, []byte(updated), 0644); err != nil {
+		return nil, base.NewCustomError("error writing the project memory", []string{
+			"Ensure that you have the permission to write to the file",
+		}, "path", path, "error", err)
+	}
+
+	slog.Info("learning remembered", "path", path, "entries", len(entries)+1)
+	return &RememberResult{Path: path, Learning: learning, Entries: len(entries) + 1}, nil
+}
+
+func memoryEntries(content string) []string {
+	var entries []string
+	for _, line := range strings.Split(content, "\n") {
+		if entry, ok := strings.CutPrefix(strings.TrimSpace(line), "- "); ok {
+			entries = append(entries, strings.TrimSpace(entry))
+		}
+	}
+	return entries
+}
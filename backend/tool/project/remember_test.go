@@ -0,0 +1,126 @@
+package project
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/spf13/afero"
+)
+
+func TestRemember(t *testing.T) {
+	setup := &base.ToolTestSetup[*RememberInput, *RememberResult]{
+		Call: func(ctx context.Context, services *base.ToolTestServices, input *RememberInput) (*RememberResult, error) {
+			return Remember(services.FS, "/workspace/repo/backend", input)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions"),
+		},
+	}
+
+	seedRepository := func(ctx context.Context, fs afero.Fs) {
+		fs.MkdirAll("/workspace/repo/.git", 0755)
+		fs.MkdirAll("/workspace/repo/backend", 0755)
+	}
+
+	queryMemory := func(fs afero.Fs) (any, error) {
+		content, err := afero.ReadFile(fs, "/workspace/repo/.construct/memory.md")
+		if err != nil {
+			return nil, err
+		}
+		return string(content), nil
+	}
+
+	setup.RunToolTests(t, []base.ToolTestScenario[*RememberInput, *RememberResult]{
+		{
+			Name:            "first learning creates the memory at the repository root",
+			TestInput:       &RememberInput{Learning: "Use pnpm instead of npm"},
+			SeedFilesystem:  seedRepository,
+			QueryFilesystem: queryMemory,
+			Expected: base.ToolTestExpectation[*RememberResult]{
+				Result: &RememberResult{
+					Path:     "/workspace/repo/.construct/memory.md",
+					Learning: "Use pnpm instead of npm",
+					Entries:  1,
+				},
+				Filesystem: memoryHeader + "\n- Use pnpm instead of npm\n",
+			},
+		},
+		{
+			Name:      "learning is appended and whitespace is collapsed",
+			TestInput: &RememberInput{Learning: "  Never edit files in\n  generated/ by hand "},
+			SeedFilesystem: func(ctx context.Context, fs afero.Fs) {
+				seedRepository(ctx, fs)
+				afero.WriteFile(fs, "/workspace/repo/.construct/memory.md", []byte("- Use pnpm instead of npm"), 0644)
+			},
+			QueryFilesystem: queryMemory,
+			Expected: base.ToolTestExpectation[*RememberResult]{
+				Result: &RememberResult{
+					Path:     "/workspace/repo/.construct/memory.md",
+					Learning: "Never edit files in generated/ by hand",
+					Entries:  2,
+				},
+				Filesystem: "- Use pnpm instead of npm\n- Never edit files in generated/ by hand\n",
+			},
+		},
+		{
+			Name:      "duplicate learning is not added again",
+			TestInput: &RememberInput{Learning: "use PNPM instead of npm"},
+			SeedFilesystem: func(ctx context.Context, fs afero.Fs) {
+				seedRepository(ctx, fs)
+				afero.WriteFile(fs, "/workspace/repo/.construct/memory.md", []byte("- Use pnpm instead of npm\n"), 0644)
+			},
+			QueryFilesystem: queryMemory,
+			Expected: base.ToolTestExpectation[*RememberResult]{
+				Result: &RememberResult{
+					Path:      "/workspace/repo/.construct/memory.md",
+					Learning:  "use PNPM instead of npm",
+					Duplicate: true,
+					Entries:   1,
+				},
+				Filesystem: "- Use pnpm instead of npm\n",
+			},
+		},
+		{
+			Name:      "directory outside of a repository is the root",
+			TestInput: &RememberInput{Learning: "Run make test before committing"},
+			Expected: base.ToolTestExpectation[*RememberResult]{
+				Result: &RememberResult{
+					Path:     "/workspace/repo/backend/.construct/memory.md",
+					Learning: "Run make test before committing",
+					Entries:  1,
+				},
+			},
+		},
+		{
+			Name:           "empty learning",
+			TestInput:      &RememberInput{Learning: " \n "},
+			SeedFilesystem: seedRepository,
+			Expected: base.ToolTestExpectation[*RememberResult]{
+				Error: base.NewCustomError("learning is required", nil),
+			},
+		},
+		{
+			Name:           "learning is too long",
+			TestInput:      &RememberInput{Learning: strings.Repeat("a", maxLearningLength+1)},
+			SeedFilesystem: seedRepository,
+			Expected: base.ToolTestExpectation[*RememberResult]{
+				Error: base.NewCustomError("learning is longer than 500 characters", nil, "length", maxLearningLength+1),
+			},
+		},
+		{
+			Name:      "memory is full",
+			TestInput: &RememberInput{Learning: "Use pnpm instead of npm"},
+			SeedFilesystem: func(ctx context.Context, fs afero.Fs) {
+				seedRepository(ctx, fs)
+				afero.WriteFile(fs, "/workspace/repo/.construct/memory.md", []byte(strings.Repeat("x", MaxMemorySize)), 0644)
+			},
+			Expected: base.ToolTestExpectation[*RememberResult]{
+				Error: base.NewCustomError("the project memory is full", nil, "path", "/workspace/repo/.construct/memory.md", "size_bytes", MaxMemorySize),
+			},
+		},
+	})
+}
@@ -1,10 +1,14 @@
 package system
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 
 	"github.com/furisto/construct/backend/tool/base"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ExecuteCommandInput struct {
@@ -19,11 +23,20 @@ type ExecuteCommandResult struct {
 	Command  string `json:"command"`
 }
 
-func ExecuteCommand(input *ExecuteCommandInput) (*ExecuteCommandResult, error) {
+func ExecuteCommand(ctx context.Context, input *ExecuteCommandInput) (*ExecuteCommandResult, error) {
 	if input.Command == "" {
 		return nil, base.NewError(base.InvalidInput, "command", "command is required")
 	}
 
+	// the subprocess is traced with the provider of the calling tool span, if any. The
+	// command line is left out because secrets may have been restored into it, the tool
+	// span records it as the agent wrote it.
+	tracer := trace.SpanFromContext(ctx).TracerProvider().Tracer("github.com/furisto/construct/backend/tool/system")
+	_, span := tracer.Start(ctx, "system.ExecuteCommand", trace.WithAttributes(
+		attribute.String("process.working_directory", input.WorkingDirectory),
+	))
+	defer span.End()
+
 	script := fmt.Sprintf(`#!/bin/sh
 		set -eu
 		%s
@@ -36,11 +49,17 @@ func ExecuteCommand(input *ExecuteCommandInput) (*ExecuteCommandResult, error) {
 		cmd.Dir = input.WorkingDirectory
 	}
 	output, err := cmd.CombinedOutput()
+	exitCode := -1
+	if cmd.ProcessState != nil {
+		exitCode = cmd.ProcessState.ExitCode()
+		span.SetAttributes(attribute.Int("process.exit.code", exitCode))
+	}
 	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
 		return nil, base.NewCustomError("error executing command", []string{
 			"Check if the command is valid and executable.",
 			"Ensure the command is properly formatted for the target operating system.",
-		}, "command", input.Command, "error", err, "output", string(output))
+		}, "command", input.Command, "error", err, "exit_code", exitCode, "output", string(output))
 	}
 
 	return &ExecuteCommandResult{
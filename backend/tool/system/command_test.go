@@ -14,7 +14,7 @@ func TestExecuteCommand(t *testing.T) {
 
 	setup := &base.ToolTestSetup[*ExecuteCommandInput, *ExecuteCommandResult]{
 		Call: func(ctx context.Context, services *base.ToolTestServices, input *ExecuteCommandInput) (*ExecuteCommandResult, error) {
-			return ExecuteCommand(input)
+			return ExecuteCommand(ctx, input)
 		},
 		CmpOptions: []cmp.Option{
 			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions"),
@@ -56,6 +56,7 @@ func TestExecuteCommand(t *testing.T) {
 				},
 					"command", "false",
 					"error", "exit status 1",
+					"exit_code", 1,
 					"output", "",
 				),
 			},
@@ -108,6 +109,7 @@ func TestExecuteCommand(t *testing.T) {
 				},
 					"command", "exit 2",
 					"error", "exit status 2",
+					"exit_code", 2,
 					"output", "",
 				),
 			},
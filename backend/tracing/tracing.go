@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Config configures the export of traces. Tracing is disabled if no endpoint is set.
+type Config struct {
+	// Endpoint is the OTLP/HTTP traces endpoint, e.g. http://localhost:4318/v1/traces.
+	Endpoint string
+	// SampleRatio is the fraction of traces that are recorded. Zero records all traces.
+	SampleRatio float64
+}
+
+func (c Config) Enabled() bool {
+	return c.Endpoint != ""
+}
+
+// NewTracerProvider creates a tracer provider that exports spans to the configured
+// endpoint. If tracing is disabled, a no-op provider is returned. The returned function
+// flushes pending spans and must be called on shutdown.
+func NewTracerProvider(ctx context.Context, config Config) (trace.TracerProvider, func(context.Context) error, error) {
+	if !config.Enabled() {
+		return noop.NewTracerProvider(), func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(config.Endpoint))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create otlp trace exporter: %w", err)
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if config.SampleRatio > 0 && config.SampleRatio < 1 {
+		sampler = sdktrace.TraceIDRatioBased(config.SampleRatio)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName("construct"))),
+	)
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider, provider.Shutdown, nil
+}
@@ -0,0 +1,53 @@
+package transcript
+
+import (
+	_ "embed"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+//go:embed transcript.html
+var htmlTemplate string
+
+var htmlTranscript = template.Must(template.New("transcript").Funcs(template.FuncMap{
+	"title": title,
+	"usage": formatUsage,
+	"role":  roleLabel,
+	"json":  indentJSON,
+	"time": func(t time.Time) string {
+		return t.Format(time.RFC3339)
+	},
+	"diff": diffLines,
+}).Parse(htmlTemplate))
+
+// renderHTML writes a self-contained page without external styles or scripts, so that it
+// can be attached to pull requests and opened offline.
+func renderHTML(w io.Writer, t *Transcript) error {
+	return htmlTranscript.Execute(w, t)
+}
+
+type diffLine struct {
+	Class string
+	Text  string
+}
+
+func diffLines(patch string) []diffLine {
+	var lines []diffLine
+	for _, line := range strings.Split(strings.TrimRight(patch, "\n"), "\n") {
+		class := ""
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			class = "file"
+		case strings.HasPrefix(line, "@@"):
+			class = "hunk"
+		case strings.HasPrefix(line, "+"):
+			class = "add"
+		case strings.HasPrefix(line, "-"):
+			class = "del"
+		}
+		lines = append(lines, diffLine{Class: class, Text: line})
+	}
+	return lines
+}
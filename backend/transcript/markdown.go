@@ -0,0 +1,101 @@
+package transcript
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+func renderMarkdown(w io.Writer, t *Transcript) error {
+	b := bufio.NewWriter(w)
+
+	fmt.Fprintf(b, "# %s\n\n", title(t))
+	fmt.Fprintf(b, "- **Task:** `%s`\n", t.TaskID)
+	if t.Agent != "" {
+		fmt.Fprintf(b, "- **Agent:** %s\n", t.Agent)
+	}
+	if t.Workspace != "" {
+		fmt.Fprintf(b, "- **Workspace:** `%s`\n", t.Workspace)
+	}
+	fmt.Fprintf(b, "- **Created:** %s\n", t.CreateTime.Format(time.RFC3339))
+	fmt.Fprintf(b, "- **Usage:** %s\n", formatUsage(t.Usage))
+
+	for _, turn := range t.Turns {
+		fmt.Fprintf(b, "\n## %s · %s\n", roleLabel(turn), turn.CreateTime.Format(time.RFC3339))
+
+		for _, entry := range turn.Entries {
+			b.WriteString("\n")
+			switch entry.Kind {
+			case EntryKindText:
+				fmt.Fprintf(b, "%s\n", strings.TrimSpace(entry.Text))
+			case EntryKindScript:
+				if entry.Script != "" {
+					b.WriteString("**Script**\n\n")
+					writeCodeBlock(b, "javascript", entry.Script)
+				}
+				for _, call := range entry.Calls {
+					b.WriteString("\n")
+					writeMarkdownCall(b, call)
+				}
+				writeMarkdownResult(b, entry)
+			case EntryKindTool:
+				for _, call := range entry.Calls {
+					writeMarkdownCall(b, call)
+				}
+				writeMarkdownResult(b, entry)
+			}
+		}
+
+		if turn.Usage != nil {
+			fmt.Fprintf(b, "\n*%s*\n", formatUsage(*turn.Usage))
+		}
+	}
+
+	return b.Flush()
+}
+
+func writeMarkdownCall(b *bufio.Writer, call *Call) {
+	fmt.Fprintf(b, "**Tool call `%s`**\n\n", call.Tool)
+	if input := indentJSON(call.Input); input != "" {
+		b.WriteString("Input:\n\n")
+		writeCodeBlock(b, "json", input)
+	}
+	if output := indentJSON(call.Output); output != "" {
+		b.WriteString("\nOutput:\n\n")
+		writeCodeBlock(b, "json", output)
+	}
+	if call.Patch != nil {
+		fmt.Fprintf(b, "\nChanges to `%s` (+%d −%d):\n\n", call.Patch.Path, call.Patch.LinesAdded, call.Patch.LinesRemoved)
+		writeCodeBlock(b, "diff", call.Patch.Diff)
+	}
+}
+
+func writeMarkdownResult(b *bufio.Writer, entry *Entry) {
+	if entry.Output != "" {
+		b.WriteString("\n**Output**\n\n")
+		writeCodeBlock(b, "", entry.Output)
+	}
+	if entry.Error != "" {
+		b.WriteString("\n**Error**\n\n")
+		writeCodeBlock(b, "", entry.Error)
+	}
+}
+
+// writeCodeBlock writes a fenced code block whose fence is longer than any run of
+// backticks in the content.
+func writeCodeBlock(b *bufio.Writer, language, content string) {
+	longest, run := 0, 0
+	for _, r := range content {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+
+	fence := strings.Repeat("`", max(3, longest+1))
+	fmt.Fprintf(b, "%s%s\n%s\n%s\n", fence, language, strings.TrimRight(content, "\n"), fence)
+}
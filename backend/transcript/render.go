@@ -0,0 +1,89 @@
+package transcript
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+	FormatJSON     Format = "json"
+)
+
+// ContentType is the media type of a transcript rendered in the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	case FormatJSON:
+		return "application/json"
+	default:
+		return "text/markdown; charset=utf-8"
+	}
+}
+
+// Render writes the transcript in the given format.
+func Render(w io.Writer, t *Transcript, format Format) error {
+	switch format {
+	case FormatMarkdown:
+		return renderMarkdown(w, t)
+	case FormatHTML:
+		return renderHTML(w, t)
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(t)
+	default:
+		return fmt.Errorf("unsupported transcript format: %s", format)
+	}
+}
+
+func title(t *Transcript) string {
+	if t.Description != "" {
+		return t.Description
+	}
+	return "Task " + t.TaskID.String()
+}
+
+func formatUsage(u Usage) string {
+	return fmt.Sprintf("%d input, %d output, %d cache write, %d cache read tokens · $%.4f",
+		u.InputTokens, u.OutputTokens, u.CacheWriteTokens, u.CacheReadTokens, u.Cost)
+}
+
+func roleLabel(turn *Turn) string {
+	switch turn.Role {
+	case types.MessageSourceUser:
+		return "User"
+	case types.MessageSourceAssistant:
+		label := "Assistant"
+		if turn.Agent != "" {
+			label = turn.Agent
+		}
+		if turn.Model != "" {
+			label += " (" + turn.Model + ")"
+		}
+		return label
+	default:
+		return "System"
+	}
+}
+
+// indentJSON formats a raw JSON value for display.
+func indentJSON(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var b bytes.Buffer
+	if err := json.Indent(&b, raw, "", "  "); err != nil {
+		return string(raw)
+	}
+	return b.String()
+}
@@ -0,0 +1,300 @@
+// Package transcript renders the conversation of a task as a document that can be shared,
+// e.g. attached to a pull request. A transcript contains the text of every message, the
+// CodeAct scripts and native tool calls of the agent with their inputs and outputs, the
+// patches of edited files, and the tokens and cost of every turn.
+package transcript
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/model"
+	toolbase "github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/google/uuid"
+)
+
+type Transcript struct {
+	TaskID      uuid.UUID `json:"task_id"`
+	Description string    `json:"description,omitempty"`
+	Agent       string    `json:"agent,omitempty"`
+	Workspace   string    `json:"workspace,omitempty"`
+	CreateTime  time.Time `json:"create_time"`
+	Usage       Usage     `json:"usage"`
+	Turns       []*Turn   `json:"turns"`
+}
+
+type Usage struct {
+	InputTokens      int64   `json:"input_tokens"`
+	OutputTokens     int64   `json:"output_tokens"`
+	CacheWriteTokens int64   `json:"cache_write_tokens"`
+	CacheReadTokens  int64   `json:"cache_read_tokens"`
+	Cost             float64 `json:"cost"`
+}
+
+// Turn is a single message of the conversation. Tool results are attached to the calls
+// that produced them instead of being turns of their own.
+type Turn struct {
+	MessageID  uuid.UUID           `json:"message_id"`
+	Role       types.MessageSource `json:"role"`
+	CreateTime time.Time           `json:"create_time"`
+	Agent      string              `json:"agent,omitempty"`
+	Model      string              `json:"model,omitempty"`
+	Usage      *Usage              `json:"usage,omitempty"`
+	Entries    []*Entry            `json:"entries"`
+}
+
+type EntryKind string
+
+const (
+	EntryKindText   EntryKind = "text"
+	EntryKindScript EntryKind = "script"
+	EntryKindTool   EntryKind = "tool"
+)
+
+// Entry is a part of a turn. Text entries carry Text. Script entries carry the CodeAct
+// script, its console output and the function calls it made. Tool entries carry a single
+// native tool call in Calls and its result in Output.
+type Entry struct {
+	Kind   EntryKind `json:"kind"`
+	Text   string    `json:"text,omitempty"`
+	Script string    `json:"script,omitempty"`
+	Output string    `json:"output,omitempty"`
+	Error  string    `json:"error,omitempty"`
+	Calls  []*Call   `json:"calls,omitempty"`
+
+	toolID string
+	result bool
+}
+
+// Call is a function call of a script or a native tool call.
+type Call struct {
+	Tool   string          `json:"tool"`
+	Input  json.RawMessage `json:"input,omitempty"`
+	Output json.RawMessage `json:"output,omitempty"`
+	Patch  *Patch          `json:"patch,omitempty"`
+}
+
+// Patch is the change an edit_file call made to a file.
+type Patch struct {
+	Path         string `json:"path"`
+	Diff         string `json:"diff"`
+	LinesAdded   int    `json:"lines_added"`
+	LinesRemoved int    `json:"lines_removed"`
+}
+
+// Load reads a task with its messages and builds its transcript.
+func Load(ctx context.Context, db *memory.Client, taskID uuid.UUID) (*Transcript, error) {
+	t, err := db.Task.Query().Where(task.ID(taskID)).WithAgent().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := db.Message.Query().
+		Where(message.TaskID(taskID)).
+		WithAgent().
+		WithModel().
+		Order(memory.Asc(message.FieldCreateTime), memory.Asc(message.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return Build(t, messages)
+}
+
+// Build creates the transcript of a task from its messages in chronological order. The
+// agents and models of the task and messages are used if they were loaded.
+func Build(t *memory.Task, messages []*memory.Message) (*Transcript, error) {
+	transcript := &Transcript{
+		TaskID:      t.ID,
+		Description: t.Description,
+		Workspace:   t.ProjectDirectory,
+		CreateTime:  t.CreateTime,
+		Usage: Usage{
+			InputTokens:      t.InputTokens,
+			OutputTokens:     t.OutputTokens,
+			CacheWriteTokens: t.CacheWriteTokens,
+			CacheReadTokens:  t.CacheReadTokens,
+			Cost:             t.Cost,
+		},
+		Turns: []*Turn{},
+	}
+	if t.Edges.Agent != nil {
+		transcript.Agent = t.Edges.Agent.Name
+	}
+
+	// results are stored in their own messages, attach them to the calls they belong to
+	pending := map[string]*Entry{}
+	for _, m := range messages {
+		turn := &Turn{
+			MessageID:  m.ID,
+			Role:       m.Source,
+			CreateTime: m.CreateTime,
+		}
+		if m.Edges.Agent != nil {
+			turn.Agent = m.Edges.Agent.Name
+		}
+		if m.Edges.Model != nil {
+			turn.Model = m.Edges.Model.Name
+		}
+		if m.Usage != nil {
+			turn.Usage = &Usage{
+				InputTokens:      m.Usage.InputTokens,
+				OutputTokens:     m.Usage.OutputTokens,
+				CacheWriteTokens: m.Usage.CacheWriteTokens,
+				CacheReadTokens:  m.Usage.CacheReadTokens,
+				Cost:             m.Usage.Cost,
+			}
+		}
+
+		if m.Content != nil {
+			for _, block := range m.Content.Blocks {
+				entry, err := convertBlock(block)
+				if err != nil {
+					return nil, fmt.Errorf("message %s: %w", m.ID, err)
+				}
+
+				if entry.result {
+					if call, ok := pending[entry.toolID]; ok {
+						call.Output, call.Error = entry.Output, entry.Error
+						if call.Kind == EntryKindScript {
+							call.Calls = entry.Calls
+						}
+						delete(pending, entry.toolID)
+						continue
+					}
+				} else if entry.toolID != "" {
+					pending[entry.toolID] = entry
+				}
+				turn.Entries = append(turn.Entries, entry)
+			}
+		}
+
+		if len(turn.Entries) > 0 {
+			transcript.Turns = append(transcript.Turns, turn)
+		}
+	}
+
+	return transcript, nil
+}
+
+func convertBlock(block types.MessageBlock) (*Entry, error) {
+	switch block.Kind {
+	case types.MessageBlockKindText:
+		return &Entry{Kind: EntryKindText, Text: block.Payload}, nil
+
+	case types.MessageBlockKindCodeInterpreterCall:
+		var call model.ToolCallBlock
+		if err := json.Unmarshal([]byte(block.Payload), &call); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal code interpreter call: %w", err)
+		}
+		var input codeact.InterpreterInput
+		if err := json.Unmarshal(call.Args, &input); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal code interpreter args: %w", err)
+		}
+		return &Entry{Kind: EntryKindScript, Script: input.Script, toolID: call.ID}, nil
+
+	case types.MessageBlockKindCodeInterpreterResult:
+		var result codeact.InterpreterToolResult
+		if err := json.Unmarshal([]byte(block.Payload), &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal code interpreter result: %w", err)
+		}
+
+		entry := &Entry{Kind: EntryKindScript, Output: result.Output, Error: result.Error, toolID: result.ID, result: true}
+		for _, fc := range result.FunctionCalls {
+			call, err := convertFunctionCall(fc)
+			if err != nil {
+				return nil, err
+			}
+			entry.Calls = append(entry.Calls, call)
+		}
+		return entry, nil
+
+	case types.MessageBlockKindNativeToolCall:
+		var call model.ToolCallBlock
+		if err := json.Unmarshal([]byte(block.Payload), &call); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal native tool call: %w", err)
+		}
+		return &Entry{
+			Kind:   EntryKindTool,
+			Calls:  []*Call{{Tool: call.Tool, Input: call.Args}},
+			toolID: call.ID,
+		}, nil
+
+	case types.MessageBlockKindNativeToolResult:
+		var result model.ToolResultBlock
+		if err := json.Unmarshal([]byte(block.Payload), &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal native tool result: %w", err)
+		}
+		entry := &Entry{Kind: EntryKindTool, Output: result.Result, toolID: result.ID, result: true}
+		if !result.Succeeded {
+			entry.Error = "the tool call failed"
+		}
+		return entry, nil
+
+	case types.MessageBlockKindFileAttachment:
+		var attachment types.FileAttachment
+		if err := json.Unmarshal([]byte(block.Payload), &attachment); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal file attachment: %w", err)
+		}
+		// the content of the file is left out, it was only context for the agent
+		return &Entry{Kind: EntryKindText, Text: fmt.Sprintf("Attached %s, lines %d-%d", attachment.Path, attachment.StartLine, attachment.EndLine)}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown message block kind: %s", block.Kind)
+	}
+}
+
+// convertFunctionCall extracts the input and output of the tool that was called. The patch
+// of an edit is moved out of the output so that it can be rendered as a diff.
+func convertFunctionCall(fc codeact.FunctionCall) (*Call, error) {
+	call := &Call{Tool: fc.ToolName}
+
+	output := fc.Output
+	if fc.ToolName == toolbase.ToolNameEditFile && output.EditFile != nil {
+		edit := *output.EditFile
+		if edit.PatchInfo.Patch != "" {
+			call.Patch = &Patch{
+				Path:         edit.Path,
+				Diff:         edit.PatchInfo.Patch,
+				LinesAdded:   edit.PatchInfo.LinesAdded,
+				LinesRemoved: edit.PatchInfo.LinesRemoved,
+			}
+		}
+		edit.PatchInfo.Patch = ""
+		output.EditFile = &edit
+	}
+
+	var err error
+	if call.Input, err = unwrap(fc.Input); err != nil {
+		return nil, fmt.Errorf("failed to marshal input of %s: %w", fc.ToolName, err)
+	}
+	if call.Output, err = unwrap(output); err != nil {
+		return nil, fmt.Errorf("failed to marshal output of %s: %w", fc.ToolName, err)
+	}
+	return call, nil
+}
+
+// unwrap marshals the single field set in a FunctionCallInput or FunctionCallOutput.
+func unwrap(v any) (json.RawMessage, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	for _, field := range fields {
+		return field, nil
+	}
+	return nil, nil
+}
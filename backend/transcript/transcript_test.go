@@ -0,0 +1,201 @@
+package transcript
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/model"
+	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/furisto/construct/backend/tool/filesystem"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+const testPatch = `--- main.go
++++ main.go
+@@ -1 +1 @@
+-fmt.Println("hello")
++fmt.Println("hello, world")
+`
+
+func TestBuild(t *testing.T) {
+	transcript := testTranscript(t)
+
+	expected := []*Turn{
+		{
+			Role:    types.MessageSourceUser,
+			Entries: []*Entry{{Kind: EntryKindText, Text: "Greet the world"}},
+		},
+		{
+			Role:  types.MessageSourceAssistant,
+			Agent: "coder",
+			Model: "claude",
+			Usage: &Usage{InputTokens: 100, OutputTokens: 20, Cost: 0.01},
+			Entries: []*Entry{
+				{Kind: EntryKindText, Text: "Let me fix the greeting."},
+				{
+					Kind:   EntryKindScript,
+					Script: `edit_file("/src/main.go", [{old: "hello", new: "hello, world"}])`,
+					Output: "done",
+					Calls: []*Call{
+						{
+							Tool:   "edit_file",
+							Input:  json.RawMessage(`{"path":"/src/main.go","diffs":[{"old":"hello","new":"hello, world"}]}`),
+							Output: json.RawMessage(`{"success":true,"path":"/src/main.go","replacements_made":1,"expected_replacements":1,"patch_info":{"patch":"","lines_added":1,"lines_removed":1}}`),
+							Patch:  &Patch{Path: "/src/main.go", Diff: testPatch, LinesAdded: 1, LinesRemoved: 1},
+						},
+					},
+				},
+				{
+					Kind:  EntryKindTool,
+					Error: "the tool call failed",
+					Calls: []*Call{{Tool: "ask_user", Input: json.RawMessage(`{"question":"Anything else?"}`)}},
+				},
+			},
+		},
+	}
+
+	options := []cmp.Option{
+		cmpopts.IgnoreFields(Turn{}, "MessageID", "CreateTime"),
+		cmpopts.IgnoreUnexported(Entry{}),
+		cmp.Transformer("json", func(raw json.RawMessage) string { return string(raw) }),
+	}
+	if diff := cmp.Diff(expected, transcript.Turns, options...); diff != "" {
+		t.Errorf("turns mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRender(t *testing.T) {
+	transcript := testTranscript(t)
+
+	tests := []struct {
+		format   Format
+		contains []string
+	}{
+		{
+			format: FormatMarkdown,
+			contains: []string{
+				"# Fix the greeting\n",
+				"## User · ",
+				"## coder (claude) · ",
+				"```javascript\nedit_file(",
+				"**Tool call `edit_file`**",
+				"Changes to `/src/main.go` (+1 −1):\n\n```diff\n--- main.go",
+				"*100 input, 20 output, 0 cache write, 0 cache read tokens · $0.0100*",
+			},
+		},
+		{
+			format: FormatHTML,
+			contains: []string{
+				"<title>Fix the greeting</title>",
+				"<summary>Script · 1 tool calls</summary>",
+				`<span class="add">&#43;fmt.Println(&#34;hello, world&#34;)</span>`,
+				"<summary>Tool call ask_user · failed</summary>",
+			},
+		},
+		{
+			format: FormatJSON,
+			contains: []string{
+				`"kind": "script"`,
+				`"lines_added": 1`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var b bytes.Buffer
+			if err := Render(&b, transcript, tt.format); err != nil {
+				t.Fatalf("failed to render transcript: %v", err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(b.String(), s) {
+					t.Errorf("expected output to contain %q, got:\n%s", s, b.String())
+				}
+			}
+		})
+	}
+}
+
+func testTranscript(t *testing.T) *Transcript {
+	t.Helper()
+
+	agent := &memory.Agent{Name: "coder"}
+	claude := &memory.Model{Name: "claude"}
+	task := &memory.Task{ID: uuid.New(), Description: "Fix the greeting", CreateTime: time.Now()}
+	task.Edges.Agent = agent
+
+	script, _ := json.Marshal(codeact.InterpreterInput{Script: `edit_file("/src/main.go", [{old: "hello", new: "hello, world"}])`})
+	result := codeact.InterpreterToolResult{
+		ID:     "call-1",
+		Output: "done",
+		FunctionCalls: []codeact.FunctionCall{
+			{
+				ToolName: "edit_file",
+				Input: codeact.FunctionCallInput{EditFile: &filesystem.EditFileInput{
+					Path:  "/src/main.go",
+					Diffs: []filesystem.DiffPair{{Old: "hello", New: "hello, world"}},
+				}},
+				Output: codeact.FunctionCallOutput{EditFile: &filesystem.EditFileResult{
+					Success:              true,
+					Path:                 "/src/main.go",
+					ReplacementsMade:     1,
+					ExpectedReplacements: 1,
+					PatchInfo:            filesystem.PatchInfo{Patch: testPatch, LinesAdded: 1, LinesRemoved: 1},
+				}},
+			},
+		},
+	}
+
+	assistant := &memory.Message{
+		ID:     uuid.New(),
+		Source: types.MessageSourceAssistant,
+		Usage:  &types.MessageUsage{InputTokens: 100, OutputTokens: 20, Cost: 0.01},
+		Content: &types.MessageContent{Blocks: []types.MessageBlock{
+			{Kind: types.MessageBlockKindText, Payload: "Let me fix the greeting."},
+			block(t, types.MessageBlockKindCodeInterpreterCall, model.ToolCallBlock{ID: "call-1", Tool: "code_interpreter", Args: script}),
+			block(t, types.MessageBlockKindNativeToolCall, model.ToolCallBlock{ID: "call-2", Tool: "ask_user", Args: json.RawMessage(`{"question":"Anything else?"}`)}),
+		}},
+	}
+	assistant.Edges.Agent = agent
+	assistant.Edges.Model = claude
+
+	messages := []*memory.Message{
+		{
+			ID:      uuid.New(),
+			Source:  types.MessageSourceUser,
+			Content: &types.MessageContent{Blocks: []types.MessageBlock{{Kind: types.MessageBlockKindText, Payload: "Greet the world"}}},
+		},
+		assistant,
+		{
+			ID:     uuid.New(),
+			Source: types.MessageSourceSystem,
+			Content: &types.MessageContent{Blocks: []types.MessageBlock{
+				block(t, types.MessageBlockKindCodeInterpreterResult, result),
+				block(t, types.MessageBlockKindNativeToolResult, model.ToolResultBlock{ID: "call-2", Name: "ask_user"}),
+			}},
+		},
+	}
+
+	transcript, err := Build(task, messages)
+	if err != nil {
+		t.Fatalf("failed to build transcript: %v", err)
+	}
+	return transcript
+}
+
+func block(t *testing.T, kind types.MessageBlockKind, payload any) types.MessageBlock {
+	t.Helper()
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("failed to marshal block: %v", err)
+	}
+	return types.MessageBlock{Kind: kind, Payload: string(raw)}
+}
@@ -0,0 +1,648 @@
+"use strict";
+
+// The UI talks to the ConnectRPC services of the daemon with the Connect protocol and the
+// JSON codec. Unary calls are plain POST requests, server streams are length-prefixed
+// envelopes in the response body.
+
+const TOKEN_KEY = "construct.token";
+const PAGE_SIZE = 100;
+// The cost dashboard aggregates at most this many of the most recent tasks.
+const COST_TASK_LIMIT = 2000;
+
+class APIError extends Error {
+  constructor(code, message) {
+    super(message || code);
+    this.code = code;
+  }
+}
+
+function requestHeaders(contentType) {
+  const headers = { "Content-Type": contentType, "Connect-Protocol-Version": "1" };
+  const token = localStorage.getItem(TOKEN_KEY);
+  if (token) {
+    headers.Authorization = "Bearer " + token;
+  }
+  return headers;
+}
+
+async function responseError(resp) {
+  try {
+    const body = await resp.json();
+    return new APIError(body.code || String(resp.status), body.message);
+  } catch {
+    return new APIError(String(resp.status), resp.statusText);
+  }
+}
+
+async function call(service, method, request) {
+  const resp = await fetch(`/api/construct.v1.${service}/${method}`, {
+    method: "POST",
+    headers: requestHeaders("application/json"),
+    body: JSON.stringify(request || {}),
+  });
+  if (!resp.ok) {
+    throw await responseError(resp);
+  }
+  return resp.json();
+}
+
+async function* stream(service, method, request, signal) {
+  const payload = new TextEncoder().encode(JSON.stringify(request || {}));
+  const envelope = new Uint8Array(5 + payload.length);
+  new DataView(envelope.buffer).setUint32(1, payload.length);
+  envelope.set(payload, 5);
+
+  const resp = await fetch(`/api/construct.v1.${service}/${method}`, {
+    method: "POST",
+    headers: requestHeaders("application/connect+json"),
+    body: envelope,
+    signal,
+  });
+  if (!resp.ok) {
+    throw await responseError(resp);
+  }
+
+  const reader = resp.body.getReader();
+  const decoder = new TextDecoder();
+  let buffer = new Uint8Array(0);
+  for (;;) {
+    while (buffer.length >= 5) {
+      const flags = buffer[0];
+      const length = new DataView(buffer.buffer, buffer.byteOffset).getUint32(1);
+      if (buffer.length < 5 + length) {
+        break;
+      }
+      const data = JSON.parse(decoder.decode(buffer.subarray(5, 5 + length)));
+      buffer = buffer.slice(5 + length);
+
+      // The end of the stream carries the error of the call, if there was one.
+      if (flags & 0x02) {
+        if (data.error) {
+          throw new APIError(data.error.code, data.error.message);
+        }
+        return;
+      }
+      yield data;
+    }
+
+    const { value, done } = await reader.read();
+    if (done) {
+      return;
+    }
+    const next = new Uint8Array(buffer.length + value.length);
+    next.set(buffer);
+    next.set(value, buffer.length);
+    buffer = next;
+  }
+}
+
+async function listAll(service, method, request, field, limit) {
+  const items = [];
+  let pageToken = "";
+  do {
+    const resp = await call(service, method, { ...request, pageSize: PAGE_SIZE, pageToken });
+    items.push(...(resp[field] || []));
+    pageToken = resp.nextPageToken || "";
+  } while (pageToken && (!limit || items.length < limit));
+  return items;
+}
+
+// h creates an element. Text is always inserted as text nodes, so content from the API
+// cannot inject markup.
+function h(tag, attrs, ...children) {
+  const el = document.createElement(tag);
+  for (const [key, value] of Object.entries(attrs || {})) {
+    if (value === undefined || value === null || value === false) {
+      continue;
+    }
+    if (key.startsWith("on")) {
+      el.addEventListener(key.slice(2), value);
+    } else if (key === "class") {
+      el.className = value;
+    } else if (value === true) {
+      el.setAttribute(key, "");
+    } else {
+      el.setAttribute(key, value);
+    }
+  }
+  for (const child of children.flat()) {
+    if (child === undefined || child === null || child === false) {
+      continue;
+    }
+    el.append(child instanceof Node ? child : String(child));
+  }
+  return el;
+}
+
+function shortID(id) {
+  return (id || "").slice(0, 8);
+}
+
+function enumLabel(value, prefix) {
+  if (!value) {
+    return "unknown";
+  }
+  return value.replace(prefix, "").toLowerCase().replaceAll("_", " ");
+}
+
+function formatCost(cost) {
+  return "$" + (cost || 0).toFixed(cost >= 100 ? 0 : 2);
+}
+
+function formatTokens(tokens) {
+  const n = Number(tokens || 0);
+  if (n >= 1e6) {
+    return (n / 1e6).toFixed(1) + "M";
+  }
+  if (n >= 1e3) {
+    return (n / 1e3).toFixed(1) + "k";
+  }
+  return String(n);
+}
+
+function formatTime(timestamp) {
+  return timestamp ? new Date(timestamp).toLocaleString() : "";
+}
+
+// oneofCase returns the name and value of the field that is set in a oneof, skipping the
+// fields that are not part of it.
+function oneofCase(obj, ...skip) {
+  for (const [key, value] of Object.entries(obj || {})) {
+    if (!skip.includes(key)) {
+      return [key, value];
+    }
+  }
+  return ["", undefined];
+}
+
+let currentView = { dispose() {} };
+
+function render(...children) {
+  document.getElementById("view").replaceChildren(...children);
+}
+
+function renderError(err) {
+  if (err instanceof APIError && err.code === "unauthenticated") {
+    renderSignIn(localStorage.getItem(TOKEN_KEY) ? "The token was rejected." : "");
+    return;
+  }
+  render(h("p", { class: "error" }, "Error: " + err.message));
+}
+
+function renderSignIn(message) {
+  const token = h("input", { type: "password", name: "token", required: true, autocomplete: "off" });
+  render(
+    h("form", {
+      class: "card sign-in",
+      onsubmit: (e) => {
+        e.preventDefault();
+        localStorage.setItem(TOKEN_KEY, token.value.trim());
+        route();
+      },
+    },
+      h("h1", {}, "Sign in"),
+      h("p", { class: "muted" }, "Create an API token with ", h("code", {}, "construct token create <name>"), " on the machine the daemon runs on."),
+      message && h("p", { class: "error" }, message),
+      h("label", {}, "API token", token),
+      h("button", { class: "primary", type: "submit" }, "Sign in"),
+    ),
+  );
+  updateSignOut();
+}
+
+function updateSignOut() {
+  document.getElementById("sign-out").hidden = !localStorage.getItem(TOKEN_KEY);
+}
+
+async function agentNames() {
+  const agents = await listAll("AgentService", "ListAgents", {}, "agents");
+  return new Map(agents.map((a) => [a.metadata.id, a.spec.name]));
+}
+
+// Tasks
+
+async function renderTasks() {
+  const names = await agentNames();
+  const body = h("tbody");
+  const more = h("button", { hidden: true }, "Load more");
+  let pageToken = "";
+
+  const load = async () => {
+    const resp = await call("TaskService", "ListTasks", {
+      pageSize: 50,
+      pageToken,
+      sortField: "SORT_FIELD_UPDATED_AT",
+      sortOrder: "SORT_ORDER_DESC",
+    });
+    for (const task of resp.tasks || []) {
+      const status = task.status || {};
+      body.append(h("tr", { class: "clickable", onclick: () => (location.hash = "#/tasks/" + task.metadata.id) },
+        h("td", { class: "mono" }, shortID(task.metadata.id)),
+        h("td", {}, task.spec.description || h("span", { class: "muted" }, task.spec.workspace || "")),
+        h("td", {}, names.get(task.spec.agentId) || shortID(task.spec.agentId)),
+        h("td", {}, h("span", { class: "phase" }, enumLabel(status.phase, "TASK_PHASE_"))),
+        h("td", { class: "number" }, status.messageCount || 0),
+        h("td", { class: "number" }, formatCost(status.usage?.cost)),
+        h("td", {}, formatTime(task.metadata.updatedAt)),
+      ));
+    }
+    pageToken = resp.nextPageToken || "";
+    more.hidden = !pageToken;
+  };
+  more.addEventListener("click", () => load().catch(renderError));
+
+  await load();
+  render(
+    h("h1", {}, "Tasks"),
+    h("table", {},
+      h("thead", {}, h("tr", {},
+        h("th", {}, "ID"), h("th", {}, "Description"), h("th", {}, "Agent"), h("th", {}, "Phase"),
+        h("th", { class: "number" }, "Messages"), h("th", { class: "number" }, "Cost"), h("th", {}, "Updated"),
+      )),
+      body,
+    ),
+    h("div", { class: "toolbar" }, more),
+  );
+}
+
+// Task transcript and tool call inspector
+
+async function renderTask(taskID) {
+  const controller = new AbortController();
+  currentView = { dispose: () => controller.abort() };
+
+  const [taskResp, names] = await Promise.all([
+    call("TaskService", "GetTask", { id: taskID }),
+    agentNames(),
+  ]);
+  const messages = await listAll("MessageService", "ListMessages", {
+    filter: { taskIds: taskID },
+    sortField: "SORT_FIELD_CREATED_AT",
+    sortOrder: "SORT_ORDER_ASC",
+  }, "messages");
+
+  const summary = h("div", { class: "card" });
+  const transcript = h("div", { class: "transcript" });
+  const inspector = h("div", { class: "card inspector" }, h("p", { class: "muted" }, "Select a tool call to inspect it."));
+  const connection = h("span", { class: "muted" }, "live");
+
+  const byID = new Map(messages.map((m) => [m.metadata.id, m]));
+  let selected = "";
+
+  const renderSummary = (task) => {
+    const status = task.status || {};
+    const usage = status.usage || {};
+    summary.replaceChildren(
+      h("div", { class: "toolbar" },
+        h("strong", {}, task.spec.description || "Task " + shortID(task.metadata.id)),
+        h("span", { class: "phase" }, enumLabel(status.phase, "TASK_PHASE_")),
+        connection,
+      ),
+      h("div", { class: "muted" },
+        `${names.get(task.spec.agentId) || shortID(task.spec.agentId)} · ${task.spec.workspace || ""} · turn ${status.turn || 0} · `,
+        `${formatTokens(usage.inputTokens)} in / ${formatTokens(usage.outputTokens)} out · ${formatCost(usage.cost)}`,
+      ),
+    );
+  };
+
+  const results = () => {
+    const found = new Map();
+    for (const message of byID.values()) {
+      for (const part of message.spec.content || []) {
+        if (part.toolResult) {
+          found.set(part.toolResult.id, part.toolResult);
+        }
+      }
+    }
+    return found;
+  };
+
+  const inspect = (toolCall) => {
+    selected = toolCall.id;
+    const [name, input] = oneofCase(toolCall, "id");
+    const result = results().get(toolCall.id);
+    inspector.replaceChildren(
+      h("h2", {}, name),
+      h("div", { class: "muted mono" }, toolCall.id),
+      h("h2", {}, "Input"),
+      name === "editFile" ? renderEdit(input) : h("pre", {}, JSON.stringify(input, null, 2)),
+      h("h2", {}, "Result"),
+      result ? renderToolResult(result) : h("p", { class: "muted" }, "No result yet."),
+    );
+    renderTranscript();
+  };
+
+  const renderTranscript = () => {
+    const sorted = [...byID.values()].sort((a, b) => new Date(a.metadata.createdAt) - new Date(b.metadata.createdAt));
+    transcript.replaceChildren(...sorted.map((message) => {
+      const role = enumLabel(message.metadata.role, "MESSAGE_ROLE_");
+      const parts = (message.spec.content || []).map((part) => {
+        if (part.text) {
+          return h("pre", {}, part.text.content);
+        }
+        if (part.toolCall) {
+          const [name] = oneofCase(part.toolCall, "id");
+          return h("button", {
+            class: "tool-call" + (part.toolCall.id === selected ? " selected" : ""),
+            onclick: () => inspect(part.toolCall),
+          }, "⚙ " + name);
+        }
+        if (part.error) {
+          return h("pre", { class: "error" }, part.error.message);
+        }
+        if (part.fileAttachment) {
+          return h("div", { class: "muted" }, "📎 " + part.fileAttachment.path);
+        }
+        return null;
+      }).filter(Boolean);
+      // Messages that only carry tool results are shown through the inspector.
+      if (parts.length === 0) {
+        return null;
+      }
+      return h("div", { class: "message " + role },
+        h("div", { class: "role" }, `${role} · ${formatTime(message.metadata.createdAt)}`),
+        parts,
+      );
+    }).filter(Boolean));
+  };
+
+  renderSummary(taskResp.task);
+  renderTranscript();
+  render(
+    h("div", { class: "toolbar" }, h("a", { href: "#/tasks" }, "← Tasks")),
+    summary,
+    h("div", { class: "task" }, transcript, inspector),
+  );
+
+  (async () => {
+    try {
+      for await (const event of stream("TaskService", "Subscribe", { taskId: taskID }, controller.signal)) {
+        if (event.message && event.message.metadata.taskId === taskID) {
+          byID.set(event.message.metadata.id, event.message);
+          renderTranscript();
+        } else if (event.taskEvent && event.taskEvent.taskId === taskID) {
+          const resp = await call("TaskService", "GetTask", { id: taskID });
+          renderSummary(resp.task);
+        }
+      }
+      connection.textContent = "disconnected";
+    } catch (err) {
+      if (!controller.signal.aborted) {
+        connection.textContent = "disconnected: " + err.message;
+      }
+    }
+  })();
+}
+
+function renderEdit(input) {
+  return h("div", { class: "diff" },
+    h("div", { class: "mono" }, input.path),
+    (input.diffs || []).map((diff) => [
+      h("pre", { class: "removed" }, diff.old),
+      h("pre", { class: "added" }, diff.new),
+    ]),
+  );
+}
+
+function renderToolResult(result) {
+  if (result.error) {
+    return h("pre", { class: "error" }, result.error.message);
+  }
+  const [, value] = oneofCase(result, "id", "error");
+  return h("pre", {}, JSON.stringify(value, null, 2));
+}
+
+// Agents
+
+async function renderAgents() {
+  const [agents, models] = await Promise.all([
+    listAll("AgentService", "ListAgents", {}, "agents"),
+    listAll("ModelService", "ListModels", {}, "models"),
+  ]);
+  const modelNames = new Map(models.map((m) => [m.metadata.id, m.spec.alias || m.spec.name]));
+  const editor = h("div");
+
+  const edit = (agent) => {
+    const spec = agent?.spec || {};
+    const name = h("input", { name: "name", required: true, value: spec.name || "" });
+    const description = h("input", { name: "description", value: spec.description || "" });
+    const model = h("select", { name: "model", required: true },
+      models.map((m) => h("option", { value: m.metadata.id, selected: m.metadata.id === spec.modelId }, modelNames.get(m.metadata.id))),
+    );
+    const instructions = h("textarea", { name: "instructions", required: true });
+    instructions.value = spec.instructions || "";
+    const error = h("p", { class: "error" });
+
+    editor.replaceChildren(h("form", {
+      class: "card",
+      onsubmit: async (e) => {
+        e.preventDefault();
+        const fields = {
+          name: name.value,
+          description: description.value,
+          instructions: instructions.value,
+          modelId: model.value,
+        };
+        try {
+          if (agent) {
+            await call("AgentService", "UpdateAgent", { id: agent.metadata.id, ...fields });
+          } else {
+            await call("AgentService", "CreateAgent", fields);
+          }
+          route();
+        } catch (err) {
+          error.textContent = err.message;
+        }
+      },
+    },
+      h("h2", {}, agent ? "Edit " + spec.name : "New agent"),
+      h("label", {}, "Name", name),
+      h("label", {}, "Description", description),
+      h("label", {}, "Model", model),
+      h("label", {}, "Instructions", instructions),
+      error,
+      h("div", { class: "toolbar" },
+        h("button", { class: "primary", type: "submit" }, agent ? "Save" : "Create"),
+        h("button", { type: "button", onclick: () => editor.replaceChildren() }, "Cancel"),
+      ),
+    ));
+  };
+
+  const remove = async (agent) => {
+    if (!confirm(`Delete the agent ${agent.spec.name}?`)) {
+      return;
+    }
+    try {
+      await call("AgentService", "DeleteAgent", { id: agent.metadata.id });
+      route();
+    } catch (err) {
+      alert(err.message);
+    }
+  };
+
+  render(
+    h("h1", {}, "Agents"),
+    h("div", { class: "toolbar" }, h("button", { class: "primary", onclick: () => edit(null) }, "New agent")),
+    editor,
+    h("table", {},
+      h("thead", {}, h("tr", {}, h("th", {}, "Name"), h("th", {}, "Model"), h("th", {}, "Description"), h("th", {}))),
+      h("tbody", {}, agents.map((agent) => h("tr", {},
+        h("td", {}, agent.spec.name),
+        h("td", {}, modelNames.get(agent.spec.modelId) || shortID(agent.spec.modelId)),
+        h("td", { class: "muted" }, agent.spec.description || ""),
+        h("td", {},
+          h("button", { class: "link", onclick: () => edit(agent) }, "Edit"),
+          h("button", { class: "link danger", onclick: () => remove(agent) }, "Delete"),
+        ),
+      ))),
+    ),
+  );
+}
+
+// Models
+
+async function renderModels() {
+  const [models, providers] = await Promise.all([
+    listAll("ModelService", "ListModels", {}, "models"),
+    listAll("ModelProviderService", "ListModelProviders", {}, "modelProviders"),
+  ]);
+  const providerNames = new Map(providers.map((p) => [p.metadata.id, p.spec?.name || shortID(p.metadata.id)]));
+
+  const toggle = async (model, enabled, checkbox) => {
+    try {
+      await call("ModelService", "UpdateModel", { id: model.metadata.id, enabled });
+    } catch (err) {
+      checkbox.checked = !enabled;
+      alert(err.message);
+    }
+  };
+
+  render(
+    h("h1", {}, "Models"),
+    h("table", {},
+      h("thead", {}, h("tr", {},
+        h("th", {}, "Name"), h("th", {}, "Alias"), h("th", {}, "Provider"),
+        h("th", { class: "number" }, "Context window"), h("th", {}, "Enabled"),
+      )),
+      h("tbody", {}, models.map((model) => {
+        const checkbox = h("input", { type: "checkbox", checked: !!model.spec.enabled });
+        checkbox.addEventListener("change", () => toggle(model, checkbox.checked, checkbox));
+        return h("tr", {},
+          h("td", { class: "mono" }, model.spec.name),
+          h("td", {}, model.spec.alias || ""),
+          h("td", {}, providerNames.get(model.metadata.modelProviderId) || shortID(model.metadata.modelProviderId)),
+          h("td", { class: "number" }, formatTokens(model.spec.contextWindow)),
+          h("td", {}, checkbox),
+        );
+      })),
+    ),
+  );
+}
+
+// Costs
+
+async function renderCosts() {
+  const [tasks, names] = await Promise.all([
+    listAll("TaskService", "ListTasks", { sortField: "SORT_FIELD_CREATED_AT", sortOrder: "SORT_ORDER_DESC" }, "tasks", COST_TASK_LIMIT),
+    agentNames(),
+  ]);
+
+  const total = { cost: 0, inputTokens: 0, outputTokens: 0, tasks: tasks.length };
+  const byAgent = new Map();
+  const byDay = new Map();
+  const add = (groups, key, usage) => {
+    const group = groups.get(key) || { cost: 0, inputTokens: 0, outputTokens: 0, tasks: 0 };
+    group.cost += usage.cost || 0;
+    group.inputTokens += Number(usage.inputTokens || 0);
+    group.outputTokens += Number(usage.outputTokens || 0);
+    group.tasks++;
+    groups.set(key, group);
+  };
+
+  for (const task of tasks) {
+    const usage = task.status?.usage || {};
+    total.cost += usage.cost || 0;
+    total.inputTokens += Number(usage.inputTokens || 0);
+    total.outputTokens += Number(usage.outputTokens || 0);
+    add(byAgent, names.get(task.spec.agentId) || shortID(task.spec.agentId), usage);
+    add(byDay, (task.metadata.createdAt || "").slice(0, 10), usage);
+  }
+
+  const breakdown = (title, groups, sort) => {
+    const rows = [...groups.entries()].sort(sort);
+    const max = Math.max(...rows.map(([, g]) => g.cost), 0);
+    return [
+      h("h2", {}, title),
+      h("table", {},
+        h("thead", {}, h("tr", {},
+          h("th", {}, ""), h("th", { class: "number" }, "Tasks"), h("th", { class: "number" }, "Input"),
+          h("th", { class: "number" }, "Output"), h("th", { class: "number" }, "Cost"), h("th", {}),
+        )),
+        h("tbody", {}, rows.map(([key, group]) => {
+          const bar = h("div", { class: "bar" });
+          bar.style.width = (max > 0 ? (group.cost / max) * 100 : 0) + "%";
+          return h("tr", {},
+            h("td", {}, key),
+            h("td", { class: "number" }, group.tasks),
+            h("td", { class: "number" }, formatTokens(group.inputTokens)),
+            h("td", { class: "number" }, formatTokens(group.outputTokens)),
+            h("td", { class: "number" }, formatCost(group.cost)),
+            h("td", {}, bar),
+          );
+        })),
+      ),
+    ];
+  };
+
+  const stat = (label, value) => h("div", { class: "card" }, h("div", { class: "muted" }, label), h("div", { class: "value" }, value));
+
+  render(
+    h("h1", {}, "Costs"),
+    tasks.length >= COST_TASK_LIMIT && h("p", { class: "muted" }, `Showing the ${COST_TASK_LIMIT} most recent tasks.`),
+    h("div", { class: "stats" },
+      stat("Total cost", formatCost(total.cost)),
+      stat("Tasks", total.tasks),
+      stat("Input tokens", formatTokens(total.inputTokens)),
+      stat("Output tokens", formatTokens(total.outputTokens)),
+    ),
+    breakdown("By agent", byAgent, (a, b) => b[1].cost - a[1].cost),
+    breakdown("By day", byDay, (a, b) => b[0].localeCompare(a[0])),
+  );
+}
+
+// Routing
+
+const routes = [
+  [/^#\/tasks\/([0-9a-f-]+)$/, renderTask],
+  [/^#\/agents$/, renderAgents],
+  [/^#\/models$/, renderModels],
+  [/^#\/costs$/, renderCosts],
+  [/^#\/tasks$/, renderTasks],
+];
+
+function route() {
+  currentView.dispose();
+  currentView = { dispose() {} };
+  updateSignOut();
+
+  const hash = location.hash || "#/tasks";
+  for (const link of document.querySelectorAll("header nav a")) {
+    link.classList.toggle("active", hash.startsWith(link.getAttribute("href")));
+  }
+
+  for (const [pattern, view] of routes) {
+    const match = hash.match(pattern);
+    if (match) {
+      view(...match.slice(1)).catch(renderError);
+      return;
+    }
+  }
+  location.hash = "#/tasks";
+}
+
+document.getElementById("sign-out").addEventListener("click", () => {
+  localStorage.removeItem(TOKEN_KEY);
+  renderSignIn("");
+});
+window.addEventListener("hashchange", route);
+route();
@@ -0,0 +1,32 @@
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed static
+var static embed.FS
+
+// Handler serves the web UI. The UI is a single page that talks to the ConnectRPC services
+// under /api with the JSON codec, so it needs no API of its own. Clients on TCP listeners
+// sign in with an API token, which the page sends as a bearer token.
+func Handler() http.Handler {
+	assets, err := fs.Sub(static, "static")
+	if err != nil {
+		panic(err)
+	}
+	files := http.FileServerFS(assets)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := w.Header()
+		header.Set("Content-Security-Policy", "default-src 'self'; frame-ancestors 'none'")
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("Referrer-Policy", "no-referrer")
+		// The assets are embedded in the binary, so a cached copy can be stale after an upgrade.
+		header.Set("Cache-Control", "no-cache")
+
+		files.ServeHTTP(w, r)
+	})
+}
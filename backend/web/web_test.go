@@ -0,0 +1,65 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		status      int
+		contentType string
+		contains    string
+	}{
+		{
+			name:        "index",
+			path:        "/",
+			status:      http.StatusOK,
+			contentType: "text/html",
+			contains:    `<script src="app.js" defer></script>`,
+		},
+		{
+			name:        "script",
+			path:        "/app.js",
+			status:      http.StatusOK,
+			contentType: "text/javascript",
+			contains:    "TaskService",
+		},
+		{
+			name:        "stylesheet",
+			path:        "/style.css",
+			status:      http.StatusOK,
+			contentType: "text/css",
+		},
+		{
+			name:   "unknown file",
+			path:   "/missing.js",
+			status: http.StatusNotFound,
+		},
+	}
+
+	handler := Handler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rec.Code != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, rec.Code)
+			}
+			if csp := rec.Header().Get("Content-Security-Policy"); !strings.Contains(csp, "default-src 'self'") {
+				t.Errorf("expected a content security policy, got %q", csp)
+			}
+			if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, tt.contentType) {
+				t.Errorf("expected content type %q, got %q", tt.contentType, got)
+			}
+			if !strings.Contains(rec.Body.String(), tt.contains) {
+				t.Errorf("expected the body to contain %q", tt.contains)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_webhooktrigger "github.com/furisto/construct/backend/memory/webhooktrigger"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultSignatureHeader is the header that carries the signature of a delivery unless
+	// the trigger configures a different one.
+	DefaultSignatureHeader = "X-Construct-Signature"
+
+	// MaxPayloadSize is the largest request body accepted for a single delivery.
+	MaxPayloadSize = 1 << 20
+
+	signaturePrefix = "sha256="
+)
+
+// PromptData is the data available to the templates of a webhook trigger.
+type PromptData struct {
+	TriggerName string
+	Payload     any
+	Headers     map[string]string
+}
+
+// Response is the JSON body returned to the sender of a delivery.
+type Response struct {
+	DeliveryID string `json:"delivery_id,omitempty"`
+	TaskID     string `json:"task_id,omitempty"`
+	MessageID  string `json:"message_id,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Handler accepts deliveries on POST /webhooks/{name}, verifies their signature and turns
+// them into a new task or a message on an existing task. Every delivery for a known trigger
+// is recorded in its delivery log.
+type Handler struct {
+	memory     *memory.Client
+	encryption *secret.Encryption
+	bus        *event.Bus
+	logger     *slog.Logger
+}
+
+func NewHandler(memory *memory.Client, encryption *secret.Encryption, bus *event.Bus) *Handler {
+	return &Handler{
+		memory:     memory,
+		encryption: encryption,
+		bus:        bus,
+		logger:     slog.With("component", "webhook"),
+	}
+}
+
+// GenerateSecret returns a random hex encoded secret for signing deliveries.
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+// Sign returns the signature of the payload in the format expected by the handler.
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature matches the payload. The sha256= prefix used by
+// GitHub and Gitea is optional.
+func Verify(secret, payload []byte, signature string) bool {
+	expected, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), signaturePrefix))
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// ParseTemplate parses one of the templates of a webhook trigger.
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// deliveryError is an error that is reported back to the sender with the given status code.
+type deliveryError struct {
+	status     types.WebhookDeliveryStatus
+	statusCode int
+	err        error
+}
+
+func (e *deliveryError) Error() string {
+	return e.err.Error()
+}
+
+func rejected(statusCode int, format string, args ...any) *deliveryError {
+	return &deliveryError{status: types.WebhookDeliveryStatusRejected, statusCode: statusCode, err: fmt.Errorf(format, args...)}
+}
+
+func failed(statusCode int, format string, args ...any) *deliveryError {
+	return &deliveryError{status: types.WebhookDeliveryStatusFailed, statusCode: statusCode, err: fmt.Errorf(format, args...)}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := r.PathValue("name")
+
+	trigger, err := h.memory.WebhookTrigger.Query().Where(memory_webhooktrigger.Name(name)).Only(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			writeResponse(w, http.StatusNotFound, Response{Error: "webhook trigger not found"})
+			return
+		}
+		h.logger.ErrorContext(ctx, "failed to look up webhook trigger", "name", name, "error", err)
+		writeResponse(w, http.StatusInternalServerError, Response{Error: "internal error"})
+		return
+	}
+
+	payload, derr := h.authenticate(ctx, trigger, w, r)
+	if derr != nil {
+		h.logger.InfoContext(ctx, "webhook delivery not authenticated",
+			"trigger_id", trigger.ID,
+			"error", derr.err,
+		)
+		writeResponse(w, derr.statusCode, Response{Error: derr.Error()})
+		return
+	}
+
+	result, derr := h.deliver(ctx, trigger, payload, r)
+
+	delivery := h.memory.WebhookDelivery.Create().
+		SetTriggerID(trigger.ID).
+		SetPayload(payload)
+
+	if derr != nil {
+		delivery = delivery.
+			SetStatus(derr.status).
+			SetStatusCode(derr.statusCode).
+			SetError(derr.Error())
+	} else {
+		delivery = delivery.
+			SetStatus(types.WebhookDeliveryStatusAccepted).
+			SetStatusCode(http.StatusAccepted).
+			SetTaskID(result.taskID)
+	}
+
+	record, err := delivery.Save(ctx)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to record webhook delivery", "trigger_id", trigger.ID, "error", err)
+	}
+
+	response := Response{}
+	if record != nil {
+		response.DeliveryID = record.ID.String()
+	}
+
+	if derr != nil {
+		h.logger.InfoContext(ctx, "webhook delivery not accepted",
+			"trigger_id", trigger.ID,
+			"status", derr.status,
+			"error", derr.err,
+		)
+		response.Error = derr.Error()
+		writeResponse(w, derr.statusCode, response)
+		return
+	}
+
+	response.TaskID = result.taskID.String()
+	response.MessageID = result.messageID.String()
+	writeResponse(w, http.StatusAccepted, response)
+}
+
+type deliveryResult struct {
+	taskID    uuid.UUID
+	messageID uuid.UUID
+}
+
+// authenticate reads the payload of a delivery and verifies its signature. Requests that
+// fail are not recorded, so that unauthenticated senders cannot fill up the delivery log.
+func (h *Handler) authenticate(ctx context.Context, trigger *memory.WebhookTrigger, w http.ResponseWriter, r *http.Request) ([]byte, *deliveryError) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxPayloadSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, rejected(http.StatusRequestEntityTooLarge, "payload exceeds %d bytes", MaxPayloadSize)
+		}
+		return nil, rejected(http.StatusBadRequest, "failed to read payload: %w", err)
+	}
+
+	key, err := h.encryption.Decrypt(trigger.Secret, secret.WebhookTriggerAssociated(trigger.ID))
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to decrypt webhook secret", "trigger_id", trigger.ID, "error", err)
+		return nil, failed(http.StatusInternalServerError, "failed to decrypt webhook secret")
+	}
+
+	if !Verify(key, body, r.Header.Get(trigger.SignatureHeader)) {
+		return nil, rejected(http.StatusUnauthorized, "invalid signature")
+	}
+
+	return body, nil
+}
+
+// deliver processes a single authenticated delivery.
+func (h *Handler) deliver(ctx context.Context, trigger *memory.WebhookTrigger, body []byte, r *http.Request) (*deliveryResult, *deliveryError) {
+	if !trigger.Enabled {
+		return nil, rejected(http.StatusForbidden, "webhook trigger is disabled")
+	}
+
+	var payload any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, rejected(http.StatusBadRequest, "invalid JSON payload: %w", err)
+	}
+
+	data := PromptData{
+		TriggerName: trigger.Name,
+		Payload:     payload,
+		Headers:     make(map[string]string, len(r.Header)),
+	}
+	for header := range r.Header {
+		data.Headers[header] = r.Header.Get(header)
+	}
+
+	prompt, err := render("prompt", trigger.PromptTemplate, data)
+	if err != nil {
+		return nil, failed(http.StatusUnprocessableEntity, "%w", err)
+	}
+
+	var result *deliveryResult
+	switch trigger.Target {
+	case types.WebhookTargetMessage:
+		result, err = h.createMessage(ctx, trigger, data, prompt)
+	default:
+		result, err = h.createTask(ctx, trigger, prompt)
+	}
+
+	if err != nil {
+		var derr *deliveryError
+		if errors.As(err, &derr) {
+			return nil, derr
+		}
+		return nil, failed(http.StatusInternalServerError, "failed to process delivery: %w", err)
+	}
+
+	event.Publish(h.bus, event.TaskEvent{
+		TaskID: result.taskID,
+	})
+
+	return result, nil
+}
+
+func (h *Handler) createTask(ctx context.Context, trigger *memory.WebhookTrigger, prompt string) (*deliveryResult, error) {
+	if trigger.AgentID == uuid.Nil {
+		return nil, failed(http.StatusUnprocessableEntity, "webhook trigger has no agent")
+	}
+
+	return memory.Transaction(ctx, h.memory, func(tx *memory.Client) (*deliveryResult, error) {
+		taskCreate := tx.Task.Create().
+			SetAgentID(trigger.AgentID).
+			SetOwner(trigger.Owner).
+			SetTeam(trigger.Team).
+			SetDescription(fmt.Sprintf("%s (%s)", trigger.Name, time.Now().UTC().Format(time.RFC3339)))
+		if trigger.Workspace != "" {
+			taskCreate = taskCreate.SetProjectDirectory(trigger.Workspace)
+		}
+
+		task, err := taskCreate.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := createUserMessage(ctx, tx, task.ID, prompt)
+		if err != nil {
+			return nil, err
+		}
+
+		return &deliveryResult{taskID: task.ID, messageID: message.ID}, nil
+	})
+}
+
+func (h *Handler) createMessage(ctx context.Context, trigger *memory.WebhookTrigger, data PromptData, prompt string) (*deliveryResult, error) {
+	rendered, err := render("task ID", trigger.TaskIDTemplate, data)
+	if err != nil {
+		return nil, failed(http.StatusUnprocessableEntity, "%w", err)
+	}
+
+	taskID, err := uuid.Parse(strings.TrimSpace(rendered))
+	if err != nil {
+		return nil, failed(http.StatusUnprocessableEntity, "invalid task ID %q: %w", rendered, err)
+	}
+
+	return memory.Transaction(ctx, h.memory, func(tx *memory.Client) (*deliveryResult, error) {
+		task, err := tx.Task.Get(ctx, taskID)
+		if err != nil {
+			if memory.IsNotFound(err) {
+				return nil, failed(http.StatusUnprocessableEntity, "task %s not found", taskID)
+			}
+			return nil, err
+		}
+
+		// A trigger acts on behalf of the user that created it and may only post into their
+		// tasks. Triggers without an owner were created over the Unix socket.
+		if trigger.Owner != "" && !auth.CanChange(auth.Identity{User: trigger.Owner, Team: trigger.Team}, task.Owner) {
+			return nil, rejected(http.StatusForbidden, "task %s is not owned by the owner of the webhook trigger", taskID)
+		}
+
+		if task.DesiredPhase == types.TaskPhaseSuspended {
+			_, err = tx.Task.UpdateOneID(taskID).SetDesiredPhase(types.TaskPhaseRunning).Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		message, err := createUserMessage(ctx, tx, task.ID, prompt)
+		if err != nil {
+			return nil, err
+		}
+
+		return &deliveryResult{taskID: task.ID, messageID: message.ID}, nil
+	})
+}
+
+func createUserMessage(ctx context.Context, tx *memory.Client, taskID uuid.UUID, prompt string) (*memory.Message, error) {
+	return tx.Message.Create().
+		SetTaskID(taskID).
+		SetContent(&types.MessageContent{
+			Blocks: []types.MessageBlock{
+				{
+					Kind:    types.MessageBlockKindText,
+					Payload: prompt,
+				},
+			},
+		}).
+		SetSource(types.MessageSourceUser).
+		Save(ctx)
+}
+
+func render(name, text string, data PromptData) (string, error) {
+	tmpl, err := ParseTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", name, err)
+	}
+
+	return buf.String(), nil
+}
+
+func writeResponse(w http.ResponseWriter, statusCode int, response Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(response)
+}
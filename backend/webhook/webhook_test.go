@@ -0,0 +1,357 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/furisto/construct/backend/event"
+	memory_message "github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	memory_webhookdelivery "github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+type deliverySummary struct {
+	StatusCode int
+	Status     types.WebhookDeliveryStatus
+	Error      string
+	Prompt     string
+}
+
+func TestServeHTTP(t *testing.T) {
+	signingSecret := []byte("s3cr3t")
+	existingTaskID := uuid.New()
+
+	tests := []struct {
+		name      string
+		trigger   string
+		target    types.WebhookTarget
+		enabled   bool
+		owner     string
+		taskOwner string
+		payload   string
+		signature func(payload []byte) string
+		expected  deliverySummary
+	}{
+		{
+			name:      "new task",
+			trigger:   "push",
+			target:    types.WebhookTargetNewTask,
+			enabled:   true,
+			payload:   `{"ref": "refs/heads/main"}`,
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusAccepted,
+				Status:     types.WebhookDeliveryStatusAccepted,
+				Prompt:     "Review the push to refs/heads/main",
+			},
+		},
+		{
+			name:      "new task of an owned trigger",
+			trigger:   "push",
+			target:    types.WebhookTargetNewTask,
+			enabled:   true,
+			owner:     "alice",
+			payload:   `{"ref": "refs/heads/main"}`,
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusAccepted,
+				Status:     types.WebhookDeliveryStatusAccepted,
+				Prompt:     "Review the push to refs/heads/main",
+			},
+		},
+		{
+			name:    "message on existing task",
+			trigger: "push",
+			target:  types.WebhookTargetMessage,
+			enabled: true,
+			payload: fmt.Sprintf(`{"ref": "refs/heads/main", "task": "%s"}`, existingTaskID),
+			signature: func(payload []byte) string {
+				// Signatures without the sha256= prefix are accepted as well.
+				return Sign(signingSecret, payload)[len(signaturePrefix):]
+			},
+			expected: deliverySummary{
+				StatusCode: http.StatusAccepted,
+				Status:     types.WebhookDeliveryStatusAccepted,
+				Prompt:     "Review the push to refs/heads/main",
+			},
+		},
+		{
+			name:      "message on task of the trigger owner",
+			trigger:   "push",
+			target:    types.WebhookTargetMessage,
+			enabled:   true,
+			owner:     "alice",
+			taskOwner: "alice",
+			payload:   fmt.Sprintf(`{"ref": "refs/heads/main", "task": "%s"}`, existingTaskID),
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusAccepted,
+				Status:     types.WebhookDeliveryStatusAccepted,
+				Prompt:     "Review the push to refs/heads/main",
+			},
+		},
+		{
+			name:      "message on task of another user",
+			trigger:   "push",
+			target:    types.WebhookTargetMessage,
+			enabled:   true,
+			owner:     "alice",
+			taskOwner: "bob",
+			payload:   fmt.Sprintf(`{"ref": "refs/heads/main", "task": "%s"}`, existingTaskID),
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusForbidden,
+				Status:     types.WebhookDeliveryStatusRejected,
+				Error:      fmt.Sprintf("task %s is not owned by the owner of the webhook trigger", existingTaskID),
+			},
+		},
+		{
+			name:      "unowned task of an owned trigger",
+			trigger:   "push",
+			target:    types.WebhookTargetMessage,
+			enabled:   true,
+			owner:     "alice",
+			payload:   fmt.Sprintf(`{"ref": "refs/heads/main", "task": "%s"}`, existingTaskID),
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusForbidden,
+				Status:     types.WebhookDeliveryStatusRejected,
+				Error:      fmt.Sprintf("task %s is not owned by the owner of the webhook trigger", existingTaskID),
+			},
+		},
+		{
+			name:      "unknown trigger",
+			trigger:   "unknown",
+			target:    types.WebhookTargetNewTask,
+			enabled:   true,
+			payload:   `{}`,
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:      "invalid signature",
+			trigger:   "push",
+			target:    types.WebhookTargetNewTask,
+			enabled:   true,
+			payload:   `{"ref": "refs/heads/main"}`,
+			signature: func(payload []byte) string { return Sign([]byte("wrong"), payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:      "missing signature",
+			trigger:   "push",
+			target:    types.WebhookTargetNewTask,
+			enabled:   true,
+			payload:   `{"ref": "refs/heads/main"}`,
+			signature: func(payload []byte) string { return "" },
+			expected: deliverySummary{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:      "invalid signature on disabled trigger",
+			trigger:   "push",
+			target:    types.WebhookTargetNewTask,
+			enabled:   false,
+			payload:   `{"ref": "refs/heads/main"}`,
+			signature: func(payload []byte) string { return Sign([]byte("wrong"), payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:      "disabled trigger",
+			trigger:   "push",
+			target:    types.WebhookTargetNewTask,
+			enabled:   false,
+			payload:   `{"ref": "refs/heads/main"}`,
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusForbidden,
+				Status:     types.WebhookDeliveryStatusRejected,
+				Error:      "webhook trigger is disabled",
+			},
+		},
+		{
+			name:      "invalid JSON",
+			trigger:   "push",
+			target:    types.WebhookTargetNewTask,
+			enabled:   true,
+			payload:   `{"ref": `,
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusBadRequest,
+				Status:     types.WebhookDeliveryStatusRejected,
+				Error:      "invalid JSON payload: unexpected end of JSON input",
+			},
+		},
+		{
+			name:      "missing payload field",
+			trigger:   "push",
+			target:    types.WebhookTargetNewTask,
+			enabled:   true,
+			payload:   `{"branch": "main"}`,
+			signature: func(payload []byte) string { return Sign(signingSecret, payload) },
+			expected: deliverySummary{
+				StatusCode: http.StatusUnprocessableEntity,
+				Status:     types.WebhookDeliveryStatusFailed,
+				Error:      "failed to render prompt template: template: prompt:1:29: executing \"prompt\" at <.Payload.ref>: map has no entry for key \"ref\"",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := test.NewDatabase(t)
+			encryption := newTestEncryption(t)
+
+			modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+			model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+			agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+			test.NewTaskBuilder(t, existingTaskID, db, agent).WithOwner(tt.taskOwner).Build(ctx)
+
+			triggerID := uuid.New()
+			encryptedSecret, err := encryption.Encrypt(signingSecret, secret.WebhookTriggerAssociated(triggerID))
+			if err != nil {
+				t.Fatalf("failed to encrypt secret: %v", err)
+			}
+
+			test.NewWebhookTriggerBuilder(t, triggerID, db, agent).
+				WithTarget(tt.target).
+				WithTaskIDTemplate("{{.Payload.task}}").
+				WithSecret(encryptedSecret).
+				WithEnabled(tt.enabled).
+				WithOwner(tt.owner).
+				Build(ctx)
+
+			mux := http.NewServeMux()
+			mux.Handle("POST /webhooks/{name}", NewHandler(db, encryption, event.NewBus(nil)))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			payload := []byte(tt.payload)
+			req, err := http.NewRequest(http.MethodPost, server.URL+"/webhooks/"+tt.trigger, bytes.NewReader(payload))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(DefaultSignatureHeader, tt.signature(payload))
+
+			resp, err := server.Client().Do(req)
+			if err != nil {
+				t.Fatalf("failed to send request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			var response Response
+			if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			actual := deliverySummary{
+				StatusCode: resp.StatusCode,
+			}
+
+			if response.DeliveryID != "" {
+				delivery, err := db.WebhookDelivery.Query().
+					Where(memory_webhookdelivery.ID(uuid.MustParse(response.DeliveryID))).
+					Only(ctx)
+				if err != nil {
+					t.Fatalf("failed to query delivery: %v", err)
+				}
+				actual.Status = delivery.Status
+				actual.Error = delivery.Error
+
+				if delivery.StatusCode != resp.StatusCode {
+					t.Errorf("recorded status code mismatch: want %d, got %d", resp.StatusCode, delivery.StatusCode)
+				}
+			}
+
+			// only authenticated deliveries are recorded
+			recorded, err := db.WebhookDelivery.Query().Count(ctx)
+			if err != nil {
+				t.Fatalf("failed to count deliveries: %v", err)
+			}
+			expectedRecorded := 0
+			if tt.expected.Status != "" {
+				expectedRecorded = 1
+			}
+			if recorded != expectedRecorded {
+				t.Errorf("expected %d recorded deliveries, got %d", expectedRecorded, recorded)
+			}
+
+			if response.MessageID != "" {
+				message, err := db.Message.Query().
+					Where(memory_message.ID(uuid.MustParse(response.MessageID))).
+					Only(ctx)
+				if err != nil {
+					t.Fatalf("failed to query message: %v", err)
+				}
+				actual.Prompt = message.Content.Blocks[0].Payload
+
+				if tt.target == types.WebhookTargetMessage && message.TaskID != existingTaskID {
+					t.Errorf("message added to wrong task: want %s, got %s", existingTaskID, message.TaskID)
+				}
+
+				task, err := db.Task.Get(ctx, message.TaskID)
+				if err != nil {
+					t.Fatalf("failed to query task: %v", err)
+				}
+				if tt.target == types.WebhookTargetNewTask && task.Owner != tt.owner {
+					t.Errorf("task owner mismatch: want %q, got %q", tt.owner, task.Owner)
+				}
+			}
+
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Errorf("delivery mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	payload := []byte(`{"ref": "refs/heads/main"}`)
+	signature := Sign([]byte("s3cr3t"), payload)
+
+	if !Verify([]byte("s3cr3t"), payload, signature) {
+		t.Error("expected signature to be valid")
+	}
+
+	if Verify([]byte("s3cr3t"), []byte(`{"ref": "refs/heads/dev"}`), signature) {
+		t.Error("expected signature of a different payload to be invalid")
+	}
+
+	if Verify([]byte("s3cr3t"), payload, "") {
+		t.Error("expected empty signature to be invalid")
+	}
+}
+
+func newTestEncryption(t *testing.T) *secret.Encryption {
+	t.Helper()
+
+	keyset, err := secret.GenerateKeyset()
+	if err != nil {
+		t.Fatalf("failed generating keyset: %v", err)
+	}
+
+	encryption, err := secret.NewClient(keyset)
+	if err != nil {
+		t.Fatalf("failed creating encryption client: %v", err)
+	}
+
+	return encryption
+}
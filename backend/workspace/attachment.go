@@ -0,0 +1,119 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/afero"
+)
+
+// maxAttachmentSize bounds the content of a file that is attached to a message.
+const maxAttachmentSize = 256 * 1024
+
+// ErrBinaryFile is returned for files that cannot be attached because they are not text.
+var ErrBinaryFile = errors.New("file is not a text file")
+
+// Attachment is the content of a file, or of a range of its lines, that is attached to a
+// message.
+type Attachment struct {
+	// Path is relative to the root of the workspace.
+	Path string
+	// StartLine and EndLine are the 1-based range of lines of the content, inclusive.
+	StartLine int
+	EndLine   int
+	Content   string
+	// Truncated is set if the content was cut at the size limit.
+	Truncated bool
+}
+
+// ReadAttachment reads the lines startLine to endLine of a file in the workspace. A zero
+// startLine starts at the first line, a zero endLine ends at the last line.
+func (w *Workspace) ReadAttachment(path string, startLine, endLine int) (*Attachment, error) {
+	resolved, err := w.Resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := w.fs.Stat(resolved)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", path)
+	}
+
+	content, err := afero.ReadFile(w.fs, resolved)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.Valid(content) || strings.ContainsRune(string(content), 0) {
+		return nil, fmt.Errorf("%s: %w", path, ErrBinaryFile)
+	}
+
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) == 0 {
+		return &Attachment{Path: w.relative(resolved)}, nil
+	}
+
+	if startLine <= 0 {
+		startLine = 1
+	}
+	if endLine <= 0 || endLine > len(lines) {
+		endLine = len(lines)
+	}
+	if startLine > endLine {
+		return nil, fmt.Errorf("line range %d-%d is outside of %s, which has %d lines", startLine, endLine, path, len(lines))
+	}
+
+	attachment := &Attachment{
+		Path:      w.relative(resolved),
+		StartLine: startLine,
+		EndLine:   startLine - 1,
+	}
+
+	var builder strings.Builder
+	for _, line := range lines[startLine-1 : endLine] {
+		if builder.Len()+len(line) > maxAttachmentSize {
+			attachment.Truncated = true
+			break
+		}
+		builder.WriteString(line)
+		attachment.EndLine++
+	}
+	attachment.Content = builder.String()
+
+	return attachment, nil
+}
+
+func (w *Workspace) relative(path string) string {
+	rel, err := filepath.Rel(w.root, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// Format renders the attachment for the model, with the path and line range in the
+// opening tag.
+func (a *Attachment) Format() string {
+	return FormatAttachment(a.Path, a.StartLine, a.EndLine, a.Content)
+}
+
+// FormatAttachment renders the content of an attached file for the model.
+func FormatAttachment(path string, startLine, endLine int, content string) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "<attachment path=%q lines=\"%d-%d\">\n", path, startLine, endLine)
+	builder.WriteString(content)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		builder.WriteString("\n")
+	}
+	builder.WriteString("</attachment>")
+	return builder.String()
+}
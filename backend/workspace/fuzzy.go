@@ -0,0 +1,97 @@
+package workspace
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Match reports whether the characters of query appear in candidate in order, ignoring case,
+// and scores the match. Consecutive characters, characters at the start of a word and
+// matches within the base name of a path score higher, long candidates score lower.
+func Match(query, candidate string) (int, bool) {
+	if query == "" {
+		return -len(candidate), true
+	}
+
+	score, ok := matchScore(query, candidate)
+	if !ok {
+		return 0, false
+	}
+
+	// prefer matches within the file name over matches spread across directories
+	base := path.Base(candidate)
+	if baseScore, ok := matchScore(query, base); ok {
+		score += baseScore + 10
+	}
+
+	return score - len(candidate)/4, true
+}
+
+func matchScore(query, candidate string) (int, bool) {
+	q := []rune(strings.ToLower(query))
+	c := []rune(candidate)
+
+	score := 0
+	qi := 0
+	previous := -2
+	for ci := 0; ci < len(c) && qi < len(q); ci++ {
+		if unicode.ToLower(c[ci]) != q[qi] {
+			continue
+		}
+
+		score++
+		if ci == previous+1 {
+			score += 5
+		}
+		if isWordStart(c, ci) {
+			score += 8
+		}
+		previous = ci
+		qi++
+	}
+
+	return score, qi == len(q)
+}
+
+func isWordStart(c []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+	switch c[i-1] {
+	case '/', '.', '_', '-', ' ':
+		return true
+	}
+	return unicode.IsUpper(c[i]) && unicode.IsLower(c[i-1])
+}
+
+// Rank returns up to limit candidates that match query, best match first. Candidates with
+// the same score keep their order.
+func Rank(query string, candidates []string, limit int) []string {
+	type scored struct {
+		candidate string
+		score     int
+	}
+
+	var matches []scored
+	for _, candidate := range candidates {
+		if score, ok := Match(query, candidate); ok {
+			matches = append(matches, scored{candidate: candidate, score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	ranked := make([]string, 0, len(matches))
+	for _, match := range matches {
+		ranked = append(ranked, match.candidate)
+	}
+	return ranked
+}
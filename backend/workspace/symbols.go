@@ -0,0 +1,331 @@
+package workspace
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	// maxScannedFiles bounds the number of files that are scanned for definitions.
+	maxScannedFiles = 5000
+	// maxScannedFileSize skips large files, which are usually generated.
+	maxScannedFileSize = 1 << 20
+	// maxDefinitionLines bounds the lines of a definition that are attached.
+	maxDefinitionLines = 200
+)
+
+// Symbol is the definition of a function, type or class in the workspace.
+type Symbol struct {
+	Name string
+	Kind string
+	// Path is relative to the root of the workspace.
+	Path string
+	// Line is the 1-based line of the definition.
+	Line int
+	// EndLine is the estimated last line of the definition.
+	EndLine int
+
+	// pattern is the search pattern of a ctags entry without a line number.
+	pattern string
+}
+
+// tagFiles are the names of ctags files that are used instead of scanning the workspace.
+var tagFiles = []string{"tags", ".tags"}
+
+type definitionPattern struct {
+	kind    string
+	pattern *regexp.Regexp
+}
+
+// definitionPatterns recognize definitions in the common languages by their first line.
+var definitionPatterns = []definitionPattern{
+	{kind: "function", pattern: regexp.MustCompile(`^func\s+(?:\([^)]*\)\s*)?([A-Za-z_]\w*)`)},
+	{kind: "type", pattern: regexp.MustCompile(`^type\s+([A-Za-z_]\w*)\s`)},
+	{kind: "function", pattern: regexp.MustCompile(`^\s*(?:export\s+)?(?:default\s+)?(?:async\s+)?function\s*\*?\s*([A-Za-z_$][\w$]*)`)},
+	{kind: "class", pattern: regexp.MustCompile(`^\s*(?:export\s+)?(?:default\s+)?(?:abstract\s+)?(?:public\s+|private\s+|protected\s+)?(?:final\s+)?(?:static\s+)?class\s+([A-Za-z_$][\w$]*)`)},
+	{kind: "interface", pattern: regexp.MustCompile(`^\s*(?:export\s+)?(?:public\s+)?interface\s+([A-Za-z_$][\w$]*)`)},
+	{kind: "function", pattern: regexp.MustCompile(`^\s*(?:async\s+)?def\s+([A-Za-z_]\w*[?!]?)`)},
+	{kind: "function", pattern: regexp.MustCompile(`^\s*(?:pub(?:\([^)]*\))?\s+)?(?:async\s+)?(?:unsafe\s+)?fn\s+([A-Za-z_]\w*)`)},
+	{kind: "type", pattern: regexp.MustCompile(`^\s*(?:pub(?:\([^)]*\))?\s+)?(?:struct|enum|trait)\s+([A-Za-z_]\w*)`)},
+}
+
+// sourceExtensions are the files that are scanned for definitions.
+var sourceExtensions = map[string]bool{
+	".go": true, ".py": true, ".js": true, ".jsx": true, ".ts": true, ".tsx": true, ".mjs": true,
+	".java": true, ".kt": true, ".rs": true, ".rb": true, ".php": true, ".cs": true, ".swift": true,
+	".scala": true,
+}
+
+// FindSymbols returns the definitions whose names fuzzy match query, best match first. The
+// definitions are read from a ctags file in the root of the workspace if there is one,
+// otherwise the source files are scanned for definitions.
+func (w *Workspace) FindSymbols(ctx context.Context, query string, limit int) ([]Symbol, error) {
+	symbols, found, err := w.readTags()
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		symbols, err = w.scanSymbols(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	type scored struct {
+		symbol Symbol
+		score  int
+	}
+	var matches []scored
+	for _, symbol := range symbols {
+		if score, ok := matchScore(query, symbol.Name); ok {
+			// exact names come first, shorter names before longer ones
+			if strings.EqualFold(symbol.Name, query) {
+				score += 100
+			}
+			matches = append(matches, scored{symbol: symbol, score: score - len(symbol.Name)})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	result := make([]Symbol, 0, len(matches))
+	for _, match := range matches {
+		symbol := match.symbol
+		if err := w.locateDefinition(&symbol); err != nil {
+			continue
+		}
+		result = append(result, symbol)
+	}
+	return result, nil
+}
+
+func (w *Workspace) scanSymbols(ctx context.Context) ([]Symbol, error) {
+	files, err := w.Files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		symbols []Symbol
+		scanned int
+	)
+	for _, file := range files {
+		if !sourceExtensions[filepath.Ext(file)] {
+			continue
+		}
+		if scanned >= maxScannedFiles || ctx.Err() != nil {
+			break
+		}
+		scanned++
+
+		path := filepath.Join(w.root, filepath.FromSlash(file))
+		info, err := w.fs.Stat(path)
+		if err != nil || info.Size() > maxScannedFileSize {
+			continue
+		}
+		content, err := afero.ReadFile(w.fs, path)
+		if err != nil {
+			continue
+		}
+
+		for i, line := range strings.Split(string(content), "\n") {
+			for _, definition := range definitionPatterns {
+				if match := definition.pattern.FindStringSubmatch(line); match != nil {
+					symbols = append(symbols, Symbol{Name: match[1], Kind: definition.kind, Path: file, Line: i + 1})
+					break
+				}
+			}
+		}
+	}
+	return symbols, nil
+}
+
+// readTags reads the symbols of a ctags file. Entries that have neither a line number nor
+// a search pattern are skipped.
+func (w *Workspace) readTags() ([]Symbol, bool, error) {
+	for _, name := range tagFiles {
+		file, err := w.fs.Open(filepath.Join(w.root, name))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, false, err
+		}
+		defer file.Close()
+
+		var symbols []Symbol
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+		for scanner.Scan() {
+			if symbol, ok := parseTag(scanner.Text()); ok {
+				symbols = append(symbols, symbol)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, false, err
+		}
+		return symbols, true, nil
+	}
+	return nil, false, nil
+}
+
+// parseTag parses a line of a ctags file: name, file, address and extension fields,
+// separated by tabs.
+func parseTag(line string) (Symbol, bool) {
+	if strings.HasPrefix(line, "!_") {
+		return Symbol{}, false
+	}
+	fields := strings.Split(line, "\t")
+	if len(fields) < 3 {
+		return Symbol{}, false
+	}
+
+	symbol := Symbol{Name: fields[0], Path: filepath.ToSlash(fields[1])}
+	address, extensions, _ := strings.Cut(strings.Join(fields[2:], "\t"), `;"`)
+
+	if line, err := strconv.Atoi(strings.TrimSpace(address)); err == nil {
+		symbol.Line = line
+	}
+	for _, field := range strings.Split(extensions, "\t") {
+		field = strings.TrimSpace(field)
+		switch {
+		case strings.HasPrefix(field, "line:"):
+			if line, err := strconv.Atoi(strings.TrimPrefix(field, "line:")); err == nil {
+				symbol.Line = line
+			}
+		case strings.HasPrefix(field, "kind:"):
+			symbol.Kind = strings.TrimPrefix(field, "kind:")
+		case len(field) == 1:
+			symbol.Kind = tagKinds[field]
+		}
+	}
+
+	if symbol.Line == 0 {
+		pattern := strings.TrimSpace(address)
+		if len(pattern) < 2 || pattern[0] != '/' {
+			return Symbol{}, false
+		}
+		// the pattern is resolved to a line when the symbol is located
+		symbol.pattern = pattern
+	}
+	return symbol, true
+}
+
+// tagKinds maps the single letter kinds of ctags to names.
+var tagKinds = map[string]string{
+	"f": "function",
+	"m": "method",
+	"c": "class",
+	"s": "struct",
+	"i": "interface",
+	"t": "type",
+	"v": "variable",
+	"e": "enum",
+}
+
+// locateDefinition resolves the search pattern of a ctags entry to a line and estimates the
+// end of the definition.
+func (w *Workspace) locateDefinition(symbol *Symbol) error {
+	path, err := w.Resolve(symbol.Path)
+	if err != nil {
+		return err
+	}
+	content, err := afero.ReadFile(w.fs, path)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(content), "\n")
+
+	if symbol.pattern != "" {
+		symbol.Line = findTagPattern(lines, symbol.pattern)
+		symbol.pattern = ""
+		if symbol.Line == 0 {
+			return errors.New("definition not found")
+		}
+	}
+	if symbol.Line < 1 || symbol.Line > len(lines) {
+		return errors.New("definition is outside of the file")
+	}
+
+	symbol.EndLine = definitionEnd(lines, symbol.Line)
+	return nil
+}
+
+// findTagPattern returns the 1-based line that matches a ctags search pattern like
+// /^func main() {$/.
+func findTagPattern(lines []string, pattern string) int {
+	pattern = strings.TrimPrefix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchoredStart := strings.HasPrefix(pattern, "^")
+	anchoredEnd := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(strings.TrimPrefix(pattern, "^"), "$")
+	pattern = strings.NewReplacer(`\/`, "/", `\\`, `\`).Replace(pattern)
+
+	for i, line := range lines {
+		switch {
+		case anchoredStart && anchoredEnd && line == pattern,
+			anchoredStart && !anchoredEnd && strings.HasPrefix(line, pattern),
+			!anchoredStart && strings.Contains(line, pattern):
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// definitionEnd estimates the last line of the definition that starts at line. Definitions
+// with braces end where the braces are balanced again, indented blocks end at the next line
+// that is not indented deeper than the definition.
+func definitionEnd(lines []string, line int) int {
+	start := line - 1
+	last := min(len(lines), start+maxDefinitionLines) - 1
+
+	first := lines[start]
+	if strings.Contains(first, "{") || (!strings.HasSuffix(strings.TrimSpace(first), ":") && start+1 < len(lines) && strings.TrimSpace(lines[start+1]) == "{") {
+		depth := 0
+		opened := false
+		for i := start; i <= last; i++ {
+			depth += strings.Count(lines[i], "{") - strings.Count(lines[i], "}")
+			if strings.Contains(lines[i], "{") {
+				opened = true
+			}
+			if opened && depth <= 0 {
+				return i + 1
+			}
+		}
+		return last + 1
+	}
+
+	if strings.HasSuffix(strings.TrimSpace(first), ":") {
+		indent := indentation(first)
+		end := start
+		for i := start + 1; i <= last; i++ {
+			if strings.TrimSpace(lines[i]) == "" {
+				continue
+			}
+			if indentation(lines[i]) <= indent {
+				break
+			}
+			end = i
+		}
+		return end + 1
+	}
+
+	return line
+}
+
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
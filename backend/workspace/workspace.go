@@ -0,0 +1,192 @@
+// Package workspace gives clients access to the files of the workspace of a task, so that
+// they can be mentioned and attached to messages even if the daemon runs on another machine.
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// maxFiles bounds the number of files that are listed in a workspace.
+const maxFiles = 50000
+
+// ErrOutsideWorkspace is returned for paths that resolve to a location outside of the workspace.
+var ErrOutsideWorkspace = errors.New("path is outside of the workspace")
+
+// skippedDirs are not listed if ripgrep is not available to apply the ignore files.
+var skippedDirs = map[string]bool{
+	".git":         true,
+	".hg":          true,
+	".svn":         true,
+	"node_modules": true,
+}
+
+type Workspace struct {
+	root       string
+	fs         afero.Fs
+	useRipgrep bool
+	// realRoot is the root with symlinks evaluated. It is empty if fs has no symlinks.
+	realRoot string
+}
+
+// New returns the workspace at root. Files are listed with ripgrep if it is installed and fs
+// is the file system of the operating system, so that .gitignore files are respected.
+func New(root string, fsys afero.Fs) (*Workspace, error) {
+	if !filepath.IsAbs(root) {
+		return nil, fmt.Errorf("workspace %s is not an absolute path", root)
+	}
+
+	root = filepath.Clean(root)
+	_, isOsFs := fsys.(*afero.OsFs)
+	_, rgErr := exec.LookPath("rg")
+
+	var realRoot string
+	if isOsFs {
+		var err error
+		realRoot, err = filepath.EvalSymlinks(root)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve workspace %s: %w", root, err)
+		}
+	}
+
+	return &Workspace{
+		root:       root,
+		fs:         fsys,
+		useRipgrep: isOsFs && rgErr == nil,
+		realRoot:   realRoot,
+	}, nil
+}
+
+func (w *Workspace) Root() string {
+	return w.root
+}
+
+// Resolve returns the absolute path of a path that is relative to the workspace or absolute
+// within it. Paths that lead outside of the workspace through a symlink are rejected as well.
+func (w *Workspace) Resolve(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(w.root, path)
+	}
+	path = filepath.Clean(path)
+
+	if !within(w.root, path) {
+		return "", fmt.Errorf("%s: %w", path, ErrOutsideWorkspace)
+	}
+
+	if w.realRoot != "" {
+		real, err := filepath.EvalSymlinks(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		// paths that do not exist fail when they are read, dangling symlinks included
+		if err == nil && !within(w.realRoot, real) {
+			return "", fmt.Errorf("%s: %w", path, ErrOutsideWorkspace)
+		}
+	}
+	return path, nil
+}
+
+// within reports whether path is root or below it. Both paths have to be clean.
+func within(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// Files returns the paths of all files in the workspace relative to its root, sorted.
+func (w *Workspace) Files(ctx context.Context) ([]string, error) {
+	var (
+		files []string
+		err   error
+	)
+	if w.useRipgrep {
+		files, err = w.ripgrepFiles(ctx)
+	} else {
+		files, err = w.walkFiles(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func (w *Workspace) ripgrepFiles(ctx context.Context) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "rg", "--files", "--null", "--hidden", "--glob", "!.git")
+	cmd.Dir = w.root
+
+	output, err := cmd.Output()
+	if err != nil {
+		// ripgrep exits with 1 if there are no files
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list files with ripgrep: %w", err)
+	}
+
+	var files []string
+	for _, file := range strings.Split(strings.TrimRight(string(output), "\x00"), "\x00") {
+		if file == "" {
+			continue
+		}
+		files = append(files, filepath.ToSlash(file))
+		if len(files) >= maxFiles {
+			break
+		}
+	}
+	return files, nil
+}
+
+var errMaxFiles = errors.New("too many files")
+
+func (w *Workspace) walkFiles(ctx context.Context) ([]string, error) {
+	var files []string
+	err := afero.Walk(w.fs, w.root, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			// unreadable directories are skipped instead of failing the listing
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if info.IsDir() {
+			if path != w.root && skippedDirs[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(w.root, path)
+		if err != nil {
+			return nil
+		}
+		files = append(files, filepath.ToSlash(rel))
+		if len(files) >= maxFiles {
+			return errMaxFiles
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errMaxFiles) {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+	return files, nil
+}
+
+// FindFiles returns the files of the workspace that fuzzy match query, best match first.
+func (w *Workspace) FindFiles(ctx context.Context, query string, limit int) ([]string, error) {
+	files, err := w.Files(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Rank(query, files, limit), nil
+}
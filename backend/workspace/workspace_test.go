@@ -0,0 +1,335 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+func newTestWorkspace(t *testing.T, files map[string]string) *Workspace {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+	for path, content := range files {
+		if err := afero.WriteFile(fs, filepath.Join("/workspace", path), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+
+	w, err := New("/workspace", fs)
+	if err != nil {
+		t.Fatalf("failed to create workspace: %v", err)
+	}
+	return w
+}
+
+func TestResolve(t *testing.T) {
+	w := newTestWorkspace(t, nil)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr error
+	}{
+		{name: "relative", path: "src/main.go", want: "/workspace/src/main.go"},
+		{name: "absolute", path: "/workspace/main.go", want: "/workspace/main.go"},
+		{name: "relative escape", path: "../etc/passwd", wantErr: ErrOutsideWorkspace},
+		{name: "absolute outside", path: "/etc/passwd", wantErr: ErrOutsideWorkspace},
+		{name: "sibling with common prefix", path: "/workspace-other/main.go", wantErr: ErrOutsideWorkspace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := w.Resolve(tt.path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resolve(%q) error = %v, want %v", tt.path, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Resolve(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "workspace")
+	outside := filepath.Join(dir, "outside")
+	for _, d := range []string{filepath.Join(root, "src"), outside} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range []string{filepath.Join(root, "src", "main.go"), filepath.Join(outside, "secret.txt")} {
+		if err := os.WriteFile(f, []byte("content\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{
+		"secret.txt":    filepath.Join(outside, "secret.txt"),
+		"outside":       outside,
+		"main.go":       filepath.Join(root, "src", "main.go"),
+		"dangling.txt":  filepath.Join(outside, "missing.txt"),
+		"relative.txt":  "../outside/secret.txt",
+		"src/inside.go": "main.go",
+	}
+	for link, target := range links {
+		if err := os.Symlink(target, filepath.Join(root, link)); err != nil {
+			t.Skipf("symlinks are not supported: %v", err)
+		}
+	}
+
+	// the root itself is reached through a symlink
+	linkedRoot := filepath.Join(dir, "linked")
+	if err := os.Symlink(root, linkedRoot); err != nil {
+		t.Skipf("symlinks are not supported: %v", err)
+	}
+	w, err := New(linkedRoot, afero.NewOsFs())
+	if err != nil {
+		t.Fatalf("failed to create workspace: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr error
+	}{
+		{name: "regular file", path: "src/main.go"},
+		{name: "symlink to file inside", path: "main.go"},
+		{name: "relative symlink inside", path: "src/inside.go"},
+		{name: "not existing", path: "src/new.go"},
+		{name: "symlink to file outside", path: "secret.txt", wantErr: ErrOutsideWorkspace},
+		{name: "relative symlink outside", path: "relative.txt", wantErr: ErrOutsideWorkspace},
+		{name: "file in symlinked directory outside", path: "outside/secret.txt", wantErr: ErrOutsideWorkspace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := w.Resolve(tt.path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resolve(%q) error = %v, want %v", tt.path, err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := w.ReadAttachment("secret.txt", 0, 0); !errors.Is(err, ErrOutsideWorkspace) {
+		t.Errorf("ReadAttachment() error = %v, want %v", err, ErrOutsideWorkspace)
+	}
+	if _, err := w.ReadAttachment("dangling.txt", 0, 0); err == nil {
+		t.Errorf("expected reading a dangling symlink to fail")
+	}
+}
+
+func TestFindFiles(t *testing.T) {
+	w := newTestWorkspace(t, map[string]string{
+		"main.go":                     "",
+		"backend/api/task.go":         "",
+		"backend/api/task_test.go":    "",
+		"backend/agent/runtime.go":    "",
+		"frontend/cli/cmd/task.go":    "",
+		"node_modules/left-pad/a.js":  "",
+		".git/HEAD":                   "",
+		"docs/architecture/tasks.md":  "",
+		"backend/memory/schema/x.go":  "",
+		"backend/api/conv/convert.go": "",
+	})
+
+	files, err := w.Files(context.Background())
+	if err != nil {
+		t.Fatalf("Files() error = %v", err)
+	}
+	for _, file := range files {
+		if filepath.Dir(file) == ".git" || file == "node_modules/left-pad/a.js" {
+			t.Errorf("Files() lists skipped file %s", file)
+		}
+	}
+	if len(files) != 8 {
+		t.Errorf("Files() returned %d files, want 8: %v", len(files), files)
+	}
+
+	got, err := w.FindFiles(context.Background(), "apitask", 2)
+	if err != nil {
+		t.Fatalf("FindFiles() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"backend/api/task.go", "backend/api/task_test.go"}, got); diff != "" {
+		t.Errorf("FindFiles() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRank(t *testing.T) {
+	candidates := []string{"internal/readme.txt", "cmd/root.go", "README.md", "pkg/router/route.go"}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "", want: []string{"README.md", "cmd/root.go", "internal/readme.txt", "pkg/router/route.go"}},
+		{query: "readme", want: []string{"README.md", "internal/readme.txt"}},
+		{query: "route", want: []string{"pkg/router/route.go"}},
+		{query: "xyz", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got := Rank(tt.query, candidates, 0)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Rank(%q) mismatch (-want +got):\n%s", tt.query, diff)
+			}
+		})
+	}
+}
+
+func TestFindSymbols(t *testing.T) {
+	files := map[string]string{
+		"server.go": `package main
+
+type Server struct {
+	addr string
+}
+
+func (s *Server) Start() error {
+	if s.addr == "" {
+		return nil
+	}
+	return nil
+}
+`,
+		"tools/build.py": `import os
+
+def build_project(path):
+    os.chdir(path)
+
+    return True
+
+def clean():
+    pass
+`,
+		"web/app.ts": `export class AppController {
+  start() {}
+}
+`,
+	}
+
+	tests := []struct {
+		name  string
+		tags  string
+		query string
+		want  []Symbol
+	}{
+		{
+			name:  "go method",
+			query: "Start",
+			want:  []Symbol{{Name: "Start", Kind: "function", Path: "server.go", Line: 7, EndLine: 12}},
+		},
+		{
+			name:  "go type",
+			query: "Server",
+			want:  []Symbol{{Name: "Server", Kind: "type", Path: "server.go", Line: 3, EndLine: 5}},
+		},
+		{
+			name:  "python function",
+			query: "build_proj",
+			want:  []Symbol{{Name: "build_project", Kind: "function", Path: "tools/build.py", Line: 3, EndLine: 6}},
+		},
+		{
+			name:  "typescript class",
+			query: "AppCtrl",
+			want:  []Symbol{{Name: "AppController", Kind: "class", Path: "web/app.ts", Line: 1, EndLine: 3}},
+		},
+		{
+			name: "ctags",
+			tags: "!_TAG_FILE_FORMAT\t2\t/extended format/\n" +
+				"Start\tserver.go\t/^func (s *Server) Start() error {$/;\"\tf\n" +
+				"Server\tserver.go\t3;\"\tkind:struct\n",
+			query: "Start",
+			want:  []Symbol{{Name: "Start", Kind: "function", Path: "server.go", Line: 7, EndLine: 12}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceFiles := make(map[string]string)
+			for path, content := range files {
+				workspaceFiles[path] = content
+			}
+			if tt.tags != "" {
+				workspaceFiles["tags"] = tt.tags
+			}
+			w := newTestWorkspace(t, workspaceFiles)
+
+			got, err := w.FindSymbols(context.Background(), tt.query, 1)
+			if err != nil {
+				t.Fatalf("FindSymbols() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Symbol{})); diff != "" {
+				t.Errorf("FindSymbols(%q) mismatch (-want +got):\n%s", tt.query, diff)
+			}
+		})
+	}
+}
+
+func TestReadAttachment(t *testing.T) {
+	w := newTestWorkspace(t, map[string]string{
+		"notes.txt":  "one\ntwo\nthree\nfour\n",
+		"binary.bin": "\x00\x01\x02",
+	})
+
+	tests := []struct {
+		name      string
+		path      string
+		startLine int
+		endLine   int
+		want      *Attachment
+		wantErr   bool
+	}{
+		{
+			name: "whole file",
+			path: "notes.txt",
+			want: &Attachment{Path: "notes.txt", StartLine: 1, EndLine: 4, Content: "one\ntwo\nthree\nfour\n"},
+		},
+		{
+			name:      "line range",
+			path:      "/workspace/notes.txt",
+			startLine: 2,
+			endLine:   3,
+			want:      &Attachment{Path: "notes.txt", StartLine: 2, EndLine: 3, Content: "two\nthree\n"},
+		},
+		{
+			name:      "end beyond file",
+			path:      "notes.txt",
+			startLine: 4,
+			endLine:   10,
+			want:      &Attachment{Path: "notes.txt", StartLine: 4, EndLine: 4, Content: "four\n"},
+		},
+		{name: "start beyond file", path: "notes.txt", startLine: 5, wantErr: true},
+		{name: "outside workspace", path: "../secrets.txt", wantErr: true},
+		{name: "binary", path: "binary.bin", wantErr: true},
+		{name: "missing", path: "missing.txt", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := w.ReadAttachment(tt.path, tt.startLine, tt.endLine)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadAttachment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ReadAttachment() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatAttachment(t *testing.T) {
+	got := FormatAttachment("main.go", 3, 4, "func main() {\n}")
+	want := "<attachment path=\"main.go\" lines=\"3-4\">\nfunc main() {\n}\n</attachment>"
+	if got != want {
+		t.Errorf("FormatAttachment() = %q, want %q", got, want)
+	}
+}
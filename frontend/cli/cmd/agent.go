@@ -30,12 +30,14 @@ func NewAgentCmd() *cobra.Command {
 }
 
 type AgentDisplay struct {
-	ID           string `json:"id" yaml:"id" detail:"default"`
-	Name         string `json:"name" yaml:"name" detail:"default"`
-	Description  string `json:"description,omitempty" yaml:"description,omitempty" detail:"default"`
-	Instructions string `json:"instructions" yaml:"instructions"`
-	Model        string `json:"model" yaml:"model" detail:"default"`
-	CreatedAt    string `json:"created_at" yaml:"created_at" detail:"full"`
+	ID           string   `json:"id" yaml:"id" detail:"default"`
+	Name         string   `json:"name" yaml:"name" detail:"default"`
+	Description  string   `json:"description,omitempty" yaml:"description,omitempty" detail:"default"`
+	Instructions string   `json:"instructions" yaml:"instructions"`
+	Model        string   `json:"model" yaml:"model" detail:"default"`
+	Tools        []string `json:"tools,omitempty" yaml:"tools,omitempty" detail:"full"`
+	Owner        string   `json:"owner,omitempty" yaml:"owner,omitempty" detail:"full"`
+	CreatedAt    string   `json:"created_at" yaml:"created_at" detail:"full"`
 }
 
 func ConvertAgentToDisplay(agent *v1.Agent, modelName string) *AgentDisplay {
@@ -53,6 +55,8 @@ func ConvertAgentToDisplay(agent *v1.Agent, modelName string) *AgentDisplay {
 		Description:  agent.Spec.Description,
 		Instructions: agent.Spec.Instructions,
 		Model:        modelName,
+		Tools:        agent.Spec.Tools,
+		Owner:        agent.Metadata.Owner,
 		CreatedAt:    agent.Metadata.CreatedAt.AsTime().Format("2006-01-02 15:04:05"),
 	}
 }
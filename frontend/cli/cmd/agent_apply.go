@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 
 	"connectrpc.com/connect"
 	api "github.com/furisto/construct/api/go/client"
@@ -19,11 +20,12 @@ type agentApplyOptions struct {
 
 // AgentSpec represents the YAML structure for agent apply
 type AgentSpec struct {
-	ID           string `yaml:"id,omitempty"`
-	Name         string `yaml:"name"`
-	Description  string `yaml:"description,omitempty"`
-	Instructions string `yaml:"instructions"`
-	Model        string `yaml:"model"`
+	ID           string   `yaml:"id,omitempty"`
+	Name         string   `yaml:"name"`
+	Description  string   `yaml:"description,omitempty"`
+	Instructions string   `yaml:"instructions"`
+	Model        string   `yaml:"model"`
+	Tools        []string `yaml:"tools,omitempty"`
 }
 
 func NewAgentApplyCmd() *cobra.Command {
@@ -121,6 +123,7 @@ func createAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 			Description:  spec.Description,
 			Instructions: spec.Instructions,
 			ModelId:      modelID,
+			Tools:        spec.Tools,
 		},
 	})
 	if err != nil {
@@ -178,6 +181,9 @@ func updateAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 	if modelID != currentAgent.Spec.ModelId {
 		updateReq.ModelId = &modelID
 	}
+	if !slices.Equal(spec.Tools, currentAgent.Spec.Tools) {
+		updateReq.Tools = &v1.ToolList{Names: spec.Tools}
+	}
 
 	// Apply the update
 	_, err = client.Agent().UpdateAgent(ctx, &connect.Request[v1.UpdateAgentRequest]{
@@ -18,6 +18,7 @@ type agentCreateOptions struct {
 	PromptFile   string
 	PromptStdin  bool
 	Model        string
+	Tools        []string
 }
 
 func NewAgentCreateCmd() *cobra.Command {
@@ -44,6 +45,12 @@ or by piping it via --prompt-stdin.`,
     --model "claude-3-5-sonnet" \
     --prompt-file ./prompts/sql.txt
 
+  # Create an agent that can call the custom tools deploy_status and lookup_issue
+  construct agent create "ops" \
+    --model "gpt-4o" \
+    --prompt-file ./prompts/ops.txt \
+    --tool deploy_status --tool lookup_issue
+
   # Create an agent by piping the prompt
   echo "You are a security expert reviewing code for vulnerabilities." | \
     construct agent create "reviewer" --model "gpt-4o" --prompt-stdin`,
@@ -72,6 +79,7 @@ or by piping it via --prompt-stdin.`,
 					Description:  options.Description,
 					Instructions: systemPrompt,
 					ModelId:      options.Model,
+					Tools:        options.Tools,
 				},
 			})
 
@@ -90,6 +98,8 @@ or by piping it via --prompt-stdin.`,
 	cmd.Flags().BoolVar(&options.PromptStdin, "prompt-stdin", false, "Read the system prompt from standard input (stdin)")
 	cmd.Flags().StringVarP(&options.Model, "model", "m", "", "The AI model the agent will use (e.g., gpt-4o) (required)")
 
+	cmd.Flags().StringSliceVar(&options.Tools, "tool", nil, "A custom tool the agent can call (can be repeated)")
+
 	cmd.MarkFlagRequired("model")
 
 	return cmd
@@ -42,6 +42,16 @@ func TestAgentCreate(t *testing.T) {
 				Stdout: conv.Ptr(fmt.Sprintln(agentID)),
 			},
 		},
+		{
+			Name:    "success with custom tools",
+			Command: []string{"agent", "create", "ops", "--prompt", "Keeps deployments healthy", "--model", modelID, "--tool", "deploy_status", "--tool", "lookup_issue"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupAgentCreationMock(mockClient, "ops", "Keeps deployments healthy", "", modelID, agentID, "deploy_status", "lookup_issue")
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(agentID)),
+			},
+		},
 		{
 			Name:    "success with model ID",
 			Command: []string{"agent", "create", "coder", "--prompt", "A helpful coding assistant", "--model", modelID},
@@ -204,12 +214,13 @@ func setupModelLookupMock(mockClient *api_client.MockClient, modelName, modelID
 	}, nil)
 }
 
-func setupAgentCreationMock(mockClient *api_client.MockClient, agentName, instructions, description, modelID, agentID string) {
+func setupAgentCreationMock(mockClient *api_client.MockClient, agentName, instructions, description, modelID, agentID string, tools ...string) {
 	req := &v1.CreateAgentRequest{
 		Name:         agentName,
 		Instructions: instructions,
 		ModelId:      modelID,
 		Description:  description,
+		Tools:        tools,
 	}
 
 	mockClient.Agent.EXPECT().CreateAgent(
@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"slices"
 
 	"connectrpc.com/connect"
 	api "github.com/furisto/construct/api/go/client"
@@ -17,10 +18,11 @@ import (
 )
 
 type AgentEditSpec struct {
-	Name         string `yaml:"name"`
-	Description  string `yaml:"description"`
-	Instructions string `yaml:"instructions"`
-	Model        string `yaml:"model"`
+	Name         string   `yaml:"name"`
+	Description  string   `yaml:"description"`
+	Instructions string   `yaml:"instructions"`
+	Model        string   `yaml:"model"`
+	Tools        []string `yaml:"tools,omitempty"`
 }
 
 func NewAgentEditCmd() *cobra.Command {
@@ -67,6 +69,7 @@ a fast and powerful way to modify an agent's prompt, model, or description. The
 				Description:  agentResp.Msg.Agent.Spec.Description,
 				Instructions: agentResp.Msg.Agent.Spec.Instructions,
 				Model:        modelResp.Msg.Model.Spec.Name,
+				Tools:        agentResp.Msg.Agent.Spec.Tools,
 			}
 
 			originalSpec := *editSpec
@@ -211,6 +214,9 @@ func applyAgentChanges(ctx context.Context, client *api.Client, currentAgent *v1
 	if modelID != currentAgent.Spec.ModelId {
 		updateReq.ModelId = &modelID
 	}
+	if !slices.Equal(editedSpec.Tools, currentAgent.Spec.Tools) {
+		updateReq.Tools = &v1.ToolList{Names: editedSpec.Tools}
+	}
 
 	_, err := client.Agent().UpdateAgent(ctx, &connect.Request[v1.UpdateAgentRequest]{
 		Msg: updateReq,
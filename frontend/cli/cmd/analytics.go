@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewAnalyticsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "analytics",
+		Short:   "Inspect the usage analytics sent by the daemon",
+		GroupID: "system",
+	}
+
+	cmd.AddCommand(NewAnalyticsShowCmd())
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"github.com/furisto/construct/backend/analytics"
+	"github.com/spf13/cobra"
+)
+
+type analyticsShowOptions struct {
+	RenderOptions RenderOptions
+}
+
+type DisplayAnalyticsEvent struct {
+	Event       string         `json:"event" yaml:"event" detail:"default"`
+	Sink        string         `json:"sink" yaml:"sink" detail:"default"`
+	Destination string         `json:"destination,omitempty" yaml:"destination,omitempty" detail:"default"`
+	Properties  map[string]any `json:"properties" yaml:"properties" detail:"default"`
+}
+
+func NewAnalyticsShowCmd() *cobra.Command {
+	var options analyticsShowOptions
+
+	cmd := &cobra.Command{
+		Use:   "show [flags]",
+		Short: "Show every analytics event and where it would be sent",
+		Long: `Show every analytics event and where it would be sent.
+
+Lists each event the daemon can emit together with its properties, using placeholders
+in place of real values, and the sink and destination configured through the
+analytics.* configuration keys.`,
+		Args: cobra.NoArgs,
+		Example: `  # Show all analytics events
+  construct analytics show
+
+  # Show all analytics events as JSON
+  construct analytics show --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			analyticsConfig, err := getAnalyticsConfig(getConfigStore(cmd.Context()), getUserInfo(cmd.Context()))
+			if err != nil {
+				return err
+			}
+
+			destination := analyticsConfig.Endpoint
+			switch analyticsConfig.Sink {
+			case analytics.SinkPostHog:
+				if destination == "" {
+					destination = analytics.DefaultPostHogEndpoint
+				}
+			case analytics.SinkFile:
+				destination = analyticsConfig.File
+			case analytics.SinkNone:
+				destination = ""
+			}
+
+			var events []*DisplayAnalyticsEvent
+			for _, event := range analytics.Catalog() {
+				events = append(events, &DisplayAnalyticsEvent{
+					Event:       event.Event,
+					Sink:        string(analyticsConfig.Sink),
+					Destination: destination,
+					Properties:  event.Properties,
+				})
+			}
+
+			return getRenderer(cmd.Context()).Render(events, &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/furisto/construct/backend/analytics"
+	"github.com/furisto/construct/shared/mocks"
+	"github.com/spf13/afero"
+)
+
+func TestAnalyticsShow(t *testing.T) {
+	setup := &TestSetup{}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "default sink",
+			Command: []string{"analytics", "show"},
+			Expected: TestExpectation{
+				DisplayedObjects: expectedAnalyticsEvents("posthog", analytics.DefaultPostHogEndpoint),
+			},
+		},
+		{
+			Name:    "file sink with default path",
+			Command: []string{"analytics", "show"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/home/user/.construct/config.yaml", []byte("analytics:\n  sink: file\n"), 0600)
+			},
+			SetupUserInfo: func(userInfo *mocks.MockUserInfo) {
+				userInfo.EXPECT().ConstructDataDir().Return("/home/user/.local/share/construct", nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: expectedAnalyticsEvents("file", "/home/user/.local/share/construct/analytics.jsonl"),
+			},
+		},
+		{
+			Name:    "otlp sink",
+			Command: []string{"analytics", "show"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/home/user/.construct/config.yaml", []byte("analytics:\n  sink: otlp\n  endpoint: http://collector:4318/v1/logs\n"), 0600)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: expectedAnalyticsEvents("otlp", "http://collector:4318/v1/logs"),
+			},
+		},
+		{
+			Name:    "disabled",
+			Command: []string{"analytics", "show"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/home/user/.construct/config.yaml", []byte("analytics:\n  sink: off\n"), 0600)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: expectedAnalyticsEvents("none", ""),
+			},
+		},
+		{
+			Name:    "error for unknown sink",
+			Command: []string{"analytics", "show"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/home/user/.construct/config.yaml", []byte("analytics:\n  sink: statsd\n"), 0600)
+			},
+			Expected: TestExpectation{
+				Error: `unknown analytics sink "statsd", must be one of posthog, file, otlp, none`,
+			},
+		},
+	})
+}
+
+func expectedAnalyticsEvents(sink, destination string) []*DisplayAnalyticsEvent {
+	var events []*DisplayAnalyticsEvent
+	for _, event := range analytics.Catalog() {
+		events = append(events, &DisplayAnalyticsEvent{
+			Event:       event.Event,
+			Sink:        sink,
+			Destination: destination,
+			Properties:  event.Properties,
+		})
+	}
+	return events
+}
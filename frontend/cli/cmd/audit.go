@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect the audit log of tool calls and API mutations",
+		Long: `Inspect the audit log of tool calls and API mutations.
+
+The daemon records every command an agent runs and every file it writes, with the
+workspace, exit code and duration, and every API call that changes state. The log is
+append-only and every event carries the hash of its predecessor, so that changed or
+removed events are detected by 'construct audit verify'.`,
+		GroupID: "system",
+	}
+
+	cmd.AddCommand(NewAuditTailCmd())
+	cmd.AddCommand(NewAuditExportCmd())
+	cmd.AddCommand(NewAuditVerifyCmd())
+
+	return cmd
+}
+
+type DisplayAuditEvent struct {
+	Sequence int64  `json:"sequence" yaml:"sequence" detail:"default"`
+	Time     string `json:"time" yaml:"time" detail:"default"`
+	Kind     string `json:"kind" yaml:"kind" detail:"default"`
+	Actor    string `json:"actor,omitempty" yaml:"actor,omitempty" detail:"default"`
+	Action   string `json:"action" yaml:"action" detail:"default"`
+	Target   string `json:"target,omitempty" yaml:"target,omitempty" detail:"default"`
+	ExitCode *int32 `json:"exit_code,omitempty" yaml:"exit_code,omitempty" detail:"default"`
+	Duration string `json:"duration" yaml:"duration" detail:"default"`
+	TaskID   string `json:"task_id,omitempty" yaml:"task_id,omitempty" detail:"full"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty" detail:"full"`
+	Hash     string `json:"hash" yaml:"hash" detail:"full"`
+}
+
+func ConvertAuditEventToDisplay(event *v1.AuditEvent) *DisplayAuditEvent {
+	if event == nil {
+		return nil
+	}
+
+	display := &DisplayAuditEvent{
+		Sequence: event.Sequence,
+		Kind:     strings.ToLower(strings.TrimPrefix(event.Kind.String(), "AUDIT_EVENT_KIND_")),
+		Actor:    event.Actor,
+		Action:   event.Action,
+		ExitCode: event.ExitCode,
+		Duration: (time.Duration(event.DurationMs) * time.Millisecond).String(),
+		TaskID:   PtrToString(event.TaskId),
+		Error:    event.Error,
+		Hash:     event.Hash,
+	}
+
+	switch {
+	case event.Command != "":
+		display.Target = event.Command
+	case event.Path != "":
+		display.Target = event.Path
+	default:
+		display.Target = event.Resource
+	}
+
+	if event.Time != nil {
+		display.Time = event.Time.AsTime().Local().Format(time.DateTime)
+	}
+
+	return display
+}
+
+// auditFilterOptions holds the flags shared by the commands that read the audit log.
+type auditFilterOptions struct {
+	Actor  string
+	Kind   AuditEventKind
+	TaskID string
+	Action string
+	Since  string
+	Until  string
+}
+
+func addAuditFilterFlags(cmd *cobra.Command, options *auditFilterOptions) {
+	cmd.Flags().StringVar(&options.Actor, "actor", "", "Only show events of this user")
+	cmd.Flags().Var(&options.Kind, "kind", "Only show events of this kind (tool, api)")
+	cmd.Flags().StringVar(&options.TaskID, "task", "", "Only show events of this task")
+	cmd.Flags().StringVar(&options.Action, "action", "", "Only show events of this tool or API procedure")
+	cmd.Flags().StringVar(&options.Since, "since", "", "Only show events recorded at or after this time (RFC 3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&options.Until, "until", "", "Only show events recorded before this time (RFC 3339 or YYYY-MM-DD)")
+}
+
+func (o *auditFilterOptions) ToAPI() (*v1.ListEventsRequest_Filter, error) {
+	filter := &v1.ListEventsRequest_Filter{}
+	if o.Actor != "" {
+		filter.Actor = &o.Actor
+	}
+	if o.Kind != "" {
+		filter.Kind = o.Kind.ToAPI().Enum()
+	}
+	if o.TaskID != "" {
+		filter.TaskId = &o.TaskID
+	}
+	if o.Action != "" {
+		filter.Action = &o.Action
+	}
+
+	if o.Since != "" {
+		since, err := parseArchiveTime(o.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --since: %w", err)
+		}
+		filter.CreatedAfter = timestamppb.New(since)
+	}
+
+	if o.Until != "" {
+		until, err := parseArchiveTime(o.Until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --until: %w", err)
+		}
+		filter.CreatedBefore = timestamppb.New(until)
+	}
+
+	return filter, nil
+}
+
+type AuditEventKind string
+
+const (
+	AuditEventKindTool AuditEventKind = "tool"
+	AuditEventKindAPI  AuditEventKind = "api"
+)
+
+func (e *AuditEventKind) String() string {
+	return string(*e)
+}
+
+func (e *AuditEventKind) Set(v string) error {
+	switch AuditEventKind(strings.ToLower(strings.TrimSpace(v))) {
+	case AuditEventKindTool, AuditEventKindAPI:
+		*e = AuditEventKind(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "tool","api"`)
+	}
+}
+
+func (e *AuditEventKind) Type() string {
+	return "kind"
+}
+
+func (e *AuditEventKind) ToAPI() v1.AuditEventKind {
+	switch *e {
+	case AuditEventKindTool:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL
+	case AuditEventKindAPI:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_API_CALL
+	default:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// auditExportPageSize is the number of events fetched per request during an export.
+const auditExportPageSize = 1000
+
+type auditExportOptions struct {
+	File   string
+	Filter auditFilterOptions
+}
+
+func NewAuditExportCmd() *cobra.Command {
+	var options auditExportOptions
+
+	cmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: "Export the audit log as JSON Lines",
+		Long: `Export the audit log as JSON Lines.
+
+Every line holds one event, oldest first, including the hash of the event and of its
+predecessor. An export without filters contains the complete chain and can be checked
+independently of the daemon.`,
+		Args: cobra.NoArgs,
+		Example: `  # Export the whole audit log to a file
+  construct audit export --file audit.jsonl
+
+  # Export the events of last month
+  construct audit export --since 2025-06-01 --until 2025-07-01`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			filter, err := options.Filter.ToAPI()
+			if err != nil {
+				return err
+			}
+
+			var (
+				out   bytes.Buffer
+				after int64
+			)
+			for {
+				resp, err := client.Audit().ListEvents(cmd.Context(), connect.NewRequest(&v1.ListEventsRequest{
+					Filter:        filter,
+					AfterSequence: after,
+					PageSize:      api.Ptr(int32(auditExportPageSize)),
+				}))
+				if err != nil {
+					return fmt.Errorf("failed to list audit events: %w", err)
+				}
+
+				for _, event := range resp.Msg.Events {
+					line, err := protojson.Marshal(event)
+					if err != nil {
+						return fmt.Errorf("failed to marshal audit event %d: %w", event.Sequence, err)
+					}
+					// protojson does not guarantee stable whitespace
+					if err := json.Compact(&out, line); err != nil {
+						return fmt.Errorf("failed to marshal audit event %d: %w", event.Sequence, err)
+					}
+					out.WriteByte('\n')
+					after = event.Sequence
+				}
+
+				if len(resp.Msg.Events) < auditExportPageSize {
+					break
+				}
+			}
+
+			if options.File == "" {
+				cmd.Print(out.String())
+				return nil
+			}
+
+			err = getFileSystem(cmd.Context()).WriteFile(options.File, out.Bytes(), 0600)
+			if err != nil {
+				return fmt.Errorf("failed to write audit log to %s: %w", options.File, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&options.File, "file", "", "Write the events to a file instead of stdout")
+	addAuditFilterFlags(cmd, &options.Filter)
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestAuditExport(t *testing.T) {
+	setup := &TestSetup{}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - all events as json lines",
+			Command: []string{"audit", "export", "--actor", "alice"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Audit.EXPECT().ListEvents(
+					gomock.Any(),
+					CmpEqual(&connect.Request[v1.ListEventsRequest]{
+						Msg: &v1.ListEventsRequest{
+							Filter:   &v1.ListEventsRequest_Filter{Actor: api_client.Ptr("alice")},
+							PageSize: api_client.Ptr(int32(auditExportPageSize)),
+						},
+					}, protocmp.Transform(),
+						cmpopts.IgnoreUnexported(connect.Request[v1.ListEventsRequest]{}),
+					),
+				).Return(connect.NewResponse(&v1.ListEventsResponse{
+					Events: []*v1.AuditEvent{
+						{Sequence: 1, Kind: v1.AuditEventKind_AUDIT_EVENT_KIND_API_CALL, Actor: "alice", Action: "/construct.v1.AgentService/CreateAgent", Hash: "ab"},
+						{Sequence: 2, Kind: v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL, Actor: "alice", Action: "execute_command", Command: "ls", PreviousHash: "ab", Hash: "cd"},
+					},
+				}), nil)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(`{"sequence":"1","kind":"AUDIT_EVENT_KIND_API_CALL","actor":"alice","action":"/construct.v1.AgentService/CreateAgent","hash":"ab"}
+{"sequence":"2","kind":"AUDIT_EVENT_KIND_TOOL_CALL","actor":"alice","action":"execute_command","command":"ls","previousHash":"ab","hash":"cd"}
+`),
+			},
+		},
+		{
+			Name:    "error - invalid since",
+			Command: []string{"audit", "export", "--since", "yesterday"},
+			Expected: TestExpectation{
+				Error: "invalid --since: \"yesterday\" is neither an RFC 3339 timestamp nor a date (YYYY-MM-DD)",
+			},
+		},
+	})
+}
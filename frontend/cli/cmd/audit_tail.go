@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+// auditFollowInterval is the time between two polls for new events in follow mode.
+const auditFollowInterval = 2 * time.Second
+
+type auditTailOptions struct {
+	Lines         int32
+	Follow        bool
+	Filter        auditFilterOptions
+	RenderOptions RenderOptions
+}
+
+func NewAuditTailCmd() *cobra.Command {
+	options := auditTailOptions{
+		Lines: 20,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "tail [flags]",
+		Short: "Show the latest events of the audit log",
+		Args:  cobra.NoArgs,
+		Example: `  # Show the last 20 events
+  construct audit tail
+
+  # Follow the commands agents run in a task
+  construct audit tail --task 01974c1d-0be8-70e1-88b4-ad9462fff25e --action execute_command --follow
+
+  # Show the last 100 API mutations of a user
+  construct audit tail --kind api --actor alice -n 100`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			filter, err := options.Filter.ToAPI()
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Audit().ListEvents(cmd.Context(), connect.NewRequest(&v1.ListEventsRequest{
+				Filter:    filter,
+				PageSize:  &options.Lines,
+				SortOrder: v1.SortOrder_SORT_ORDER_DESC.Enum(),
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to list audit events: %w", err)
+			}
+
+			events := resp.Msg.Events
+			slices.Reverse(events)
+			if err := renderAuditEvents(cmd, events, &options.RenderOptions); err != nil {
+				return err
+			}
+			if !options.Follow {
+				return nil
+			}
+
+			var after int64
+			if len(events) > 0 {
+				after = events[len(events)-1].Sequence
+			}
+			options.RenderOptions.NoHeaders = true
+
+			ticker := time.NewTicker(auditFollowInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-cmd.Context().Done():
+					return nil
+				case <-ticker.C:
+				}
+
+				resp, err := client.Audit().ListEvents(cmd.Context(), connect.NewRequest(&v1.ListEventsRequest{
+					Filter:        filter,
+					AfterSequence: after,
+				}))
+				if err != nil {
+					if cmd.Context().Err() != nil {
+						return nil
+					}
+					return fmt.Errorf("failed to list audit events: %w", err)
+				}
+				if len(resp.Msg.Events) == 0 {
+					continue
+				}
+
+				if err := renderAuditEvents(cmd, resp.Msg.Events, &options.RenderOptions); err != nil {
+					return err
+				}
+				after = resp.Msg.Events[len(resp.Msg.Events)-1].Sequence
+			}
+		},
+	}
+
+	cmd.Flags().Int32VarP(&options.Lines, "lines", "n", options.Lines, "The number of events to show")
+	cmd.Flags().BoolVarP(&options.Follow, "follow", "f", false, "Keep showing new events as they are recorded")
+	addAuditFilterFlags(cmd, &options.Filter)
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
+
+func renderAuditEvents(cmd *cobra.Command, events []*v1.AuditEvent, options *RenderOptions) error {
+	displayEvents := make([]*DisplayAuditEvent, len(events))
+	for i, event := range events {
+		displayEvents[i] = ConvertAuditEventToDisplay(event)
+	}
+
+	return getRenderer(cmd.Context()).Render(displayEvents, options)
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestAuditTail(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(DisplayAuditEvent{}, "Time"),
+		},
+	}
+
+	taskID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - latest events oldest first",
+			Command: []string{"audit", "tail", "-n", "2", "--task", taskID, "--kind", "tool"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Audit.EXPECT().ListEvents(
+					gomock.Any(),
+					CmpEqual(&connect.Request[v1.ListEventsRequest]{
+						Msg: &v1.ListEventsRequest{
+							Filter: &v1.ListEventsRequest_Filter{
+								TaskId: &taskID,
+								Kind:   v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL.Enum(),
+							},
+							PageSize:  api_client.Ptr(int32(2)),
+							SortOrder: v1.SortOrder_SORT_ORDER_DESC.Enum(),
+						},
+					}, protocmp.Transform(),
+						cmpopts.IgnoreUnexported(connect.Request[v1.ListEventsRequest]{}),
+					),
+				).Return(connect.NewResponse(&v1.ListEventsResponse{
+					Events: []*v1.AuditEvent{
+						{
+							Sequence: 8,
+							Kind:     v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL,
+							Actor:    "alice",
+							Action:   "edit_file",
+							TaskId:   &taskID,
+							Path:     "/src/main.go",
+							Hash:     "cd",
+						},
+						{
+							Sequence:   7,
+							Kind:       v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL_CALL,
+							Actor:      "alice",
+							Action:     "execute_command",
+							TaskId:     &taskID,
+							Command:    "go test ./...",
+							ExitCode:   api_client.Ptr(int32(1)),
+							DurationMs: 1500,
+							Hash:       "ab",
+						},
+					},
+				}), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayAuditEvent{
+					{
+						Sequence: 7,
+						Kind:     "tool_call",
+						Actor:    "alice",
+						Action:   "execute_command",
+						Target:   "go test ./...",
+						ExitCode: api_client.Ptr(int32(1)),
+						Duration: "1.5s",
+						TaskID:   taskID,
+						Hash:     "ab",
+					},
+					{
+						Sequence: 8,
+						Kind:     "tool_call",
+						Actor:    "alice",
+						Action:   "edit_file",
+						Target:   "/src/main.go",
+						Duration: "0s",
+						TaskID:   taskID,
+						Hash:     "cd",
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - invalid kind",
+			Command: []string{"audit", "tail", "--kind", "file"},
+			Expected: TestExpectation{
+				Error: "invalid argument \"file\" for \"--kind\" flag: must be one of \"tool\",\"api\"",
+			},
+		},
+		{
+			Name:    "error - list events",
+			Command: []string{"audit", "tail"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Audit.EXPECT().ListEvents(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("permission denied"))
+			},
+			Expected: TestExpectation{
+				Error: "failed to list audit events: permission denied",
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/furisto/construct/backend/audit"
+	"github.com/spf13/cobra"
+)
+
+func NewAuditVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check that the audit log has not been tampered with",
+		Long: `Check that the audit log has not been tampered with.
+
+Walks the hash chain of the audit log from the first event and fails at the first
+event that has been changed, removed or reordered. The check runs on the machine the
+daemon runs on, directly against its database.`,
+		Example: `  # Verify the audit log
+  construct audit verify`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openLocalDatabase(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			verified, err := audit.Verify(cmd.Context(), db)
+			if err != nil {
+				if errors.Is(err, audit.ErrTampered) {
+					return fmt.Errorf("%w (%d events verified before)", err, verified)
+				}
+				return err
+			}
+
+			cmd.Printf("%d events verified\n", verified)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -19,6 +19,74 @@ var configDescriptions = map[string]configDescription{
 		Type:        "String (Agent Name or ID)",
 		Example:     "construct config set defaults.agent \"my-favorite-agent\"",
 	},
+	"analytics.sink": {
+		Description: "Selects where the daemon sends usage analytics. \"posthog\" sends events to PostHog,\n  \"file\" appends them as JSON lines to a local file, \"otlp\" exports them as\n  OpenTelemetry log records and \"none\" disables analytics entirely.",
+		Type:        "String (posthog, file, otlp, none)",
+		Example:     "construct config set analytics.sink file",
+		Default:     "posthog",
+	},
+	"analytics.endpoint": {
+		Description: "The PostHog instance for the posthog sink or the OTLP/HTTP logs endpoint for the\n  otlp sink.",
+		Type:        "String (URL)",
+		Example:     "construct config set analytics.endpoint http://collector.internal:4318/v1/logs",
+		Default:     "https://eu.i.posthog.com",
+	},
+	"analytics.file": {
+		Description: "The file the file sink appends events to.",
+		Type:        "String (Path)",
+		Example:     "construct config set analytics.file /var/log/construct/analytics.jsonl",
+		Default:     "<data dir>/analytics.jsonl",
+	},
+	"tracing.endpoint": {
+		Description: "The OTLP/HTTP endpoint the daemon exports traces to. Traces cover API requests,\n  task reconciliation, model invocations and tool calls. Tracing is disabled if unset.",
+		Type:        "String (URL)",
+		Example:     "construct config set tracing.endpoint http://localhost:4318/v1/traces",
+	},
+	"tracing.sample-ratio": {
+		Description: "The fraction of traces that are recorded, between 0 and 1.",
+		Type:        "Float",
+		Example:     "construct config set tracing.sample-ratio 0.1",
+		Default:     "1",
+	},
+	"storage.driver": {
+		Description: "The database the daemon stores agents, tasks and messages in. \"sqlite\" keeps them in a\n  local file, \"postgres\" in a PostgreSQL database that several daemons can share.\n  Run `construct daemon migrate` after switching to prepare the database.",
+		Type:        "String (sqlite, postgres)",
+		Example:     "construct config set storage.driver postgres",
+		Default:     "sqlite",
+	},
+	"storage.dsn": {
+		Description: "The connection string of the database. Required for postgres. The password can be\n  omitted and provided through PGPASSWORD or a .pgpass file instead.",
+		Type:        "String (DSN)",
+		Example:     "construct config set storage.dsn \"postgres://construct@db.internal:5432/construct?sslmode=require\"",
+		Default:     "file:<data dir>/construct.db",
+	},
+	"daemon.tls.cert": {
+		Description: "The PEM encoded certificate the daemon serves on TCP listeners. Requires daemon.tls.key.\n  Without it, the daemon serves plain HTTP.",
+		Type:        "String (path)",
+		Example:     "construct config set daemon.tls.cert /etc/construct/tls/server.crt",
+	},
+	"daemon.tls.key": {
+		Description: "The PEM encoded private key of daemon.tls.cert.",
+		Type:        "String (path)",
+		Example:     "construct config set daemon.tls.key /etc/construct/tls/server.key",
+	},
+	"daemon.tls.client-ca": {
+		Description: "The PEM encoded CA that signs client certificates. If set, TCP clients have to present\n  a certificate signed by it (mutual TLS) in addition to an API token.",
+		Type:        "String (path)",
+		Example:     "construct config set daemon.tls.client-ca /etc/construct/tls/clients.crt",
+	},
+	"daemon.web-ui": {
+		Description: "Serve the web UI under /ui/ on TCP listeners. Browsers sign in with an API token.\n  The --web-ui flag of daemon run takes precedence.",
+		Type:        "Boolean",
+		Example:     "construct config set daemon.web-ui true",
+		Default:     "false",
+	},
+	"daemon.tool-dir": {
+		Description: "The directory the daemon loads custom tools from. Every subdirectory with a tool.json\n  manifest defines a tool that agents can enable. Restart the daemon to pick up changes.",
+		Type:        "String (path)",
+		Example:     "construct config set daemon.tool-dir ~/construct-tools",
+		Default:     "<config dir>/tools",
+	},
 }
 
 func NewConfigExplainCmd() *cobra.Command {
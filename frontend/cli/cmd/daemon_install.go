@@ -80,6 +80,12 @@ on macOS, systemd on Linux). The daemon is required for most construct operation
 			}
 
 			setupComplete, err := checkConnectionAndSetupStatus(cmd.Context(), out, *endpointContext)
+			if connect.CodeOf(err) == connect.CodeUnauthenticated {
+				// The daemon is up, but TCP listeners only accept requests with an API token.
+				fmt.Fprintf(out, "%s Daemon installed successfully\n", terminal.SuccessSymbol)
+				fmt.Fprintf(out, "%s Next: Create an API token with 'construct token create %s --context %s'\n", terminal.ContinueSymbol, options.Name, options.Name)
+				return nil
+			}
 			if err != nil {
 				troubleshooting := buildTroubleshootingMessage(cmd.Context(), endpointContext)
 				return fail.NewUserFacingError(fmt.Sprintf("Connection to daemon failed: %s", err), err, troubleshooting, "",
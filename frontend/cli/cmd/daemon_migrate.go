@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory/migration"
+	"github.com/spf13/cobra"
+)
+
+type daemonMigrateOptions struct {
+	DryRun   bool
+	Rollback bool
+	Steps    int
+}
+
+func NewDaemonMigrateCmd() *cobra.Command {
+	options := daemonMigrateOptions{}
+	cmd := &cobra.Command{
+		Use:   "migrate [flags]",
+		Short: "Migrate the database schema",
+		Long: `Migrate the database schema.
+
+Applies the pending schema migrations to the database of the daemon, or reverts the
+most recent ones with --rollback. A copy of a SQLite database is written to the backups
+directory in the data directory before the schema is changed. The daemon migrates the
+database on start, so this is only needed to inspect or revert migrations. Stop the
+daemon before running it.`,
+		Example: `  # Show the pending migrations without applying them
+  construct daemon migrate --dry-run
+
+  # Apply the pending migrations
+  construct daemon migrate
+
+  # Revert the last two migrations
+  construct daemon migrate --rollback --steps 2`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dataDir, err := getUserInfo(cmd.Context()).ConstructDataDir()
+			if err != nil {
+				return fmt.Errorf("failed to get construct data directory: %w", err)
+			}
+
+			db, err := openDatabase(getConfigStore(cmd.Context()), dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			migrator, err := newMigrator(db, dataDir)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			status, err := migrator.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Database version: %d (latest: %d)\n", status.Version, status.Latest)
+
+			if options.Rollback {
+				plan, err := migrator.RollbackPlan(cmd.Context(), options.Steps)
+				if err != nil {
+					return err
+				}
+
+				if options.DryRun {
+					printMigrations(cmd, "Would revert", plan, func(m migration.Migration) string { return m.Down })
+					return nil
+				}
+
+				reverted, err := migrator.Rollback(cmd.Context(), options.Steps)
+				if err != nil {
+					return err
+				}
+				printMigrations(cmd, "Reverted", reverted, nil)
+				return nil
+			}
+
+			if options.DryRun {
+				if status.Unversioned {
+					fmt.Fprintln(out, "The database has no schema version and will be adopted at the latest version.")
+					return nil
+				}
+				printMigrations(cmd, "Would apply", status.Pending, func(m migration.Migration) string { return m.Up })
+				return nil
+			}
+
+			applied, err := migrator.Up(cmd.Context())
+			if err != nil {
+				return err
+			}
+			printMigrations(cmd, "Applied", applied, nil)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "Print the statements without changing the database")
+	cmd.Flags().BoolVar(&options.Rollback, "rollback", false, "Revert the most recent migrations instead of applying pending ones")
+	cmd.Flags().IntVar(&options.Steps, "steps", 1, "Number of migrations to revert with --rollback")
+
+	return cmd
+}
+
+func printMigrations(cmd *cobra.Command, verb string, migrations []migration.Migration, statements func(migration.Migration) string) {
+	out := cmd.OutOrStdout()
+	if len(migrations) == 0 {
+		fmt.Fprintln(out, "Nothing to do.")
+		return
+	}
+
+	for _, m := range migrations {
+		fmt.Fprintf(out, "%s %s\n", verb, m)
+		if statements != nil {
+			fmt.Fprintf(out, "\n%s\n", statements(m))
+		}
+	}
+}
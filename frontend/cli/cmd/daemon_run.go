@@ -3,19 +3,30 @@ package cmd
 import (
 	"context"
 	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net"
+	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/furisto/construct/backend/agent"
 	"github.com/furisto/construct/backend/analytics"
 	"github.com/furisto/construct/backend/memory"
-	"github.com/furisto/construct/backend/memory/migrate"
+	"github.com/furisto/construct/backend/memory/migration"
+	"github.com/furisto/construct/backend/redact"
 	"github.com/furisto/construct/backend/secret"
 	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/furisto/construct/backend/tool/plugin"
+	"github.com/furisto/construct/backend/tracing"
 	"github.com/furisto/construct/shared"
 	"github.com/furisto/construct/shared/config"
 	"github.com/furisto/construct/shared/listener"
@@ -27,6 +38,7 @@ import (
 type daemonRunOptions struct {
 	HTTPAddress string
 	UnixSocket  string
+	WebUI       bool
 }
 
 func NewDaemonRunCmd() *cobra.Command {
@@ -43,18 +55,26 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 			config := getConfigStore(cmd.Context())
 			fs := getFileSystem(cmd.Context())
 
+			redactor, err := getRedactor(config)
+			if err != nil {
+				return err
+			}
+			if redactor != nil {
+				slog.SetDefault(slog.New(redact.NewHandler(slog.Default().Handler(), redactor)))
+			}
+
 			dataDir, err := userInfo.ConstructDataDir()
 			if err != nil {
 				return fmt.Errorf("failed to get construct data directory: %w", err)
 			}
 
-			db, err := memory.Open(dialect.SQLite, "file:"+filepath.Join(dataDir, "construct.db")+"?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+			db, err := openDatabase(config, dataDir)
 			if err != nil {
-				return fmt.Errorf("failed to open database: %w", err)
+				return err
 			}
 			defer db.Close()
 
-			err = setupMemory(cmd.Context(), db)
+			err = setupMemory(cmd.Context(), db, dataDir)
 			if err != nil {
 				return fmt.Errorf("failed to setup memory/database schema: %w", err)
 			}
@@ -79,22 +99,72 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 				return fmt.Errorf("failed to create listener: %w", err)
 			}
 
+			scheme := "http"
+			if listener.Addr().Network() != "unix" {
+				tlsConfig, err := getListenerTLSConfig(config)
+				if err != nil {
+					return err
+				}
+				if tlsConfig != nil {
+					listener = tls.NewListener(listener, tlsConfig)
+					scheme = "https"
+				}
+			}
+
 			if explicitLaunch(provider.ActivationType()) {
+				kind, address := "unix", listener.Addr().String()
+				if provider.ActivationType() == "tcp" {
+					kind, address = "http", scheme+"://"+address
+				}
+
 				contextManager := shared.NewContextManager(getFileSystem(cmd.Context()), getUserInfo(cmd.Context()))
 				contextName := generateContextName(provider.ActivationType(), listener)
-				_, err = contextManager.UpsertContext(contextName, provider.ActivationType(), listener.Addr().String(), true)
+				_, err = contextManager.UpsertContext(contextName, kind, address, true)
 				if err != nil {
 					return fmt.Errorf("failed to upsert context: %w", err)
 				}
 			}
 
+			webUI := options.WebUI
+			if !cmd.Flags().Changed("web-ui") {
+				webUIValue, _ := config.Get("daemon.web-ui")
+				webUI, _ = webUIValue.Bool()
+			}
+			if webUI {
+				if listener.Addr().Network() == "unix" {
+					slog.Warn("the web ui is not reachable by browsers on a unix socket, listen on a tcp address instead")
+				} else {
+					fmt.Fprintf(cmd.OutOrStdout(), "🌐 Web UI at %s://%s/ui/\n", scheme, listener.Addr())
+				}
+			}
+
 			var analyticsClient analytics.Client
-			analyticsClient, err = analytics.NewPostHogClient()
+			analyticsConfig, err := getAnalyticsConfig(config, userInfo)
+			if err == nil {
+				analyticsClient, err = analytics.NewClient(analyticsConfig)
+			}
 			if err != nil {
 				slog.Error("failed to create analytics client", "error", err)
 				analyticsClient = analytics.NewNoopClient()
 			}
 
+			tracerProvider, shutdownTracing, err := tracing.NewTracerProvider(cmd.Context(), getTracingConfig(config))
+			if err != nil {
+				return fmt.Errorf("failed to create tracer provider: %w", err)
+			}
+			defer func() {
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := shutdownTracing(shutdownCtx); err != nil {
+					slog.Error("failed to flush traces", "error", err)
+				}
+			}()
+
+			customTools, err := loadCustomTools(config, userInfo)
+			if err != nil {
+				return err
+			}
+
 			runtime, err := agent.NewRuntime(
 				db,
 				encryption,
@@ -108,10 +178,16 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 					codeact.NewFindFileTool(),
 					codeact.NewExecuteCommandTool(),
 					codeact.NewFetchTool(),
+					codeact.NewRememberTool(),
 					// codeact.NewSubmitReportTool(),
 					codeact.NewPrintTool(),
 				),
+				agent.WithCustomTools(customTools...),
 				agent.WithAnalytics(analyticsClient),
+				agent.WithTracerProvider(tracerProvider),
+				agent.WithRedactor(redactor),
+				agent.WithSecretProvider(secretProvider),
+				agent.WithWebUI(webUI),
 			)
 
 			if err != nil {
@@ -129,6 +205,7 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 
 	cmd.Flags().StringVar(&options.HTTPAddress, "listen-http", "", "The address and port to listen on (e.g., 127.0.0.1:8080)")
 	cmd.Flags().StringVar(&options.UnixSocket, "listen-unix", "", "The path to listen on for Unix socket requests")
+	cmd.Flags().BoolVar(&options.WebUI, "web-ui", false, "Serve the web UI under /ui/ (default from daemon.web-ui)")
 
 	return cmd
 }
@@ -175,24 +252,342 @@ func getEncryptionClient(secretProvider secret.Provider) (*secret.Encryption, er
 	return secret.NewClient(keyHandle)
 }
 
+// secretPassphraseEnvVar holds the passphrase of the encrypted-file secret provider.
+const secretPassphraseEnvVar = "CONSTRUCT_SECRET_PASSPHRASE"
+
 func getSecretProvider(cfg *config.Store, userInfo shared.UserInfo, fs afero.Fs) (secret.Provider, error) {
 	provider, _ := cfg.Get("secret.provider")
 	value, _ := provider.String()
 
-	if value == "file" {
+	pathValue, _ := cfg.Get("secret.path")
+	path, _ := pathValue.String()
+
+	switch value {
+	case "file", "encrypted-file":
+		if path == "" {
+			dataDir, err := userInfo.ConstructDataDir()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get construct data directory: %w", err)
+			}
+			path = filepath.Join(dataDir, "secrets")
+		}
+		if value == "file" {
+			return secret.NewFileProvider(path, fs)
+		}
+
+		passphrase, err := readSecretFromEnvOrFile(cfg, fs, secretPassphraseEnvVar, "secret.passphrase-file")
+		if err != nil {
+			return nil, err
+		}
+		if passphrase == "" {
+			return nil, fmt.Errorf("the encrypted-file secret provider requires a passphrase in %s or secret.passphrase-file", secretPassphraseEnvVar)
+		}
+		return secret.NewEncryptedFileProvider(path, fs, passphrase)
+	case "env-file":
+		if path == "" {
+			dataDir, err := userInfo.ConstructDataDir()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get construct data directory: %w", err)
+			}
+			path = filepath.Join(dataDir, "secrets.env")
+		}
+		return secret.NewEnvFileProvider(path, fs), nil
+	case "vault":
+		vaultConfig := secret.VaultConfig{
+			Address:   os.Getenv("VAULT_ADDR"),
+			Namespace: os.Getenv("VAULT_NAMESPACE"),
+		}
+		for key, target := range map[string]*string{
+			"secret.vault.address":   &vaultConfig.Address,
+			"secret.vault.namespace": &vaultConfig.Namespace,
+			"secret.vault.mount":     &vaultConfig.Mount,
+			"secret.vault.path":      &vaultConfig.Path,
+		} {
+			configValue, _ := cfg.Get(key)
+			if v, ok := configValue.String(); ok && v != "" {
+				*target = v
+			}
+		}
+
+		token, err := readSecretFromEnvOrFile(cfg, fs, "VAULT_TOKEN", "secret.vault.token-file")
+		if err != nil {
+			return nil, err
+		}
+		vaultConfig.Token = token
+		return secret.NewVaultProvider(vaultConfig)
+	case "1password":
+		vaultValue, _ := cfg.Get("secret.1password.vault")
+		vault, _ := vaultValue.String()
+		return secret.NewOnePasswordProvider(vault, nil)
+	case "", "keyring":
+		return secret.NewKeyringProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown secret provider %q, must be one of keyring, file, encrypted-file, env-file, vault or 1password", value)
+	}
+}
+
+// readSecretFromEnvOrFile returns the value of the environment variable, or else the content
+// of the file configured under fileKey without surrounding whitespace.
+func readSecretFromEnvOrFile(cfg *config.Store, fs afero.Fs, envVar, fileKey string) (string, error) {
+	if value := os.Getenv(envVar); value != "" {
+		return value, nil
+	}
+
+	fileValue, _ := cfg.Get(fileKey)
+	file, _ := fileValue.String()
+	if file == "" {
+		return "", nil
+	}
+
+	content, err := afero.ReadFile(fs, file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", fileKey, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func getAnalyticsConfig(cfg *config.Store, userInfo shared.UserInfo) (analytics.Config, error) {
+	sinkValue, _ := cfg.Get("analytics.sink")
+	rawSink, _ := sinkValue.String()
+	sink, err := analytics.ParseSink(rawSink)
+	if err != nil {
+		return analytics.Config{}, err
+	}
+
+	endpointValue, _ := cfg.Get("analytics.endpoint")
+	endpoint, _ := endpointValue.String()
+
+	fileValue, _ := cfg.Get("analytics.file")
+	file, _ := fileValue.String()
+	if sink == analytics.SinkFile && file == "" {
 		dataDir, err := userInfo.ConstructDataDir()
 		if err != nil {
-			return nil, fmt.Errorf("failed to get construct data directory: %w", err)
+			return analytics.Config{}, fmt.Errorf("failed to get construct data directory: %w", err)
+		}
+		file = filepath.Join(dataDir, "analytics.jsonl")
+	}
+
+	return analytics.Config{
+		Sink:     sink,
+		Endpoint: endpoint,
+		File:     file,
+	}, nil
+}
+
+// loadCustomTools loads the plugins in the tool directory. Plugins that cannot be loaded
+// are logged and skipped, so that a broken plugin does not keep the daemon from starting.
+func loadCustomTools(cfg *config.Store, userInfo shared.UserInfo) ([]codeact.Tool, error) {
+	dirValue, _ := cfg.Get("daemon.tool-dir")
+	dir, _ := dirValue.String()
+	if dir == "" {
+		configDir, err := userInfo.ConstructConfigDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get construct config directory: %w", err)
+		}
+		dir = filepath.Join(configDir, "tools")
+	}
+
+	plugins, err := plugin.Load(afero.NewOsFs(), dir)
+	if err != nil {
+		for _, err := range unwrapErrors(err) {
+			slog.Error("failed to load custom tool", "dir", dir, "error", err)
+		}
+	}
+
+	var tools []codeact.Tool
+	for _, p := range plugins {
+		tool, err := codeact.NewPluginTool(p)
+		if err != nil {
+			slog.Error("failed to load custom tool", "dir", dir, "error", err)
+			continue
+		}
+		tools = append(tools, tool)
+	}
+
+	return tools, nil
+}
+
+func unwrapErrors(err error) []error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+func getTracingConfig(cfg *config.Store) tracing.Config {
+	endpointValue, _ := cfg.Get("tracing.endpoint")
+	endpoint, _ := endpointValue.String()
+
+	ratioValue, _ := cfg.Get("tracing.sample-ratio")
+	ratio, ok := ratioValue.Float()
+	if !ok {
+		if i, ok := ratioValue.Int(); ok {
+			ratio = float64(i)
+		}
+	}
+
+	return tracing.Config{
+		Endpoint:    endpoint,
+		SampleRatio: ratio,
+	}
+}
+
+// getRedactor returns the redactor configured by the redaction section, or nil if
+// redaction is turned off. Patterns are regular expressions; with a capturing group,
+// only the group is redacted.
+func getRedactor(cfg *config.Store) (*redact.Redactor, error) {
+	enabledValue, _ := cfg.Get("redaction.enabled")
+	if enabled, ok := enabledValue.Bool(); ok && !enabled {
+		return nil, nil
+	}
+
+	var patterns []string
+	patternsValue, _ := cfg.Get("redaction.patterns")
+	switch raw := patternsValue.Raw().(type) {
+	case string:
+		patterns = append(patterns, raw)
+	case []any:
+		for _, pattern := range raw {
+			pattern, ok := pattern.(string)
+			if !ok {
+				return nil, fmt.Errorf("redaction.patterns must be a list of regular expressions")
+			}
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	var opts []redact.Option
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", pattern, err)
+		}
+		opts = append(opts, redact.WithPatterns(re))
+	}
+
+	thresholdValue, _ := cfg.Get("redaction.entropy-threshold")
+	threshold, ok := thresholdValue.Float()
+	if !ok {
+		if i, isInt := thresholdValue.Int(); isInt {
+			threshold, ok = float64(i), true
+		}
+	}
+	if ok {
+		opts = append(opts, redact.WithEntropyThreshold(threshold))
+	}
+
+	return redact.NewRedactor(opts...), nil
+}
+
+// getListenerTLSConfig returns the TLS configuration for TCP listeners, or nil if no
+// certificate is configured. With a client CA, clients have to present a certificate
+// signed by it in addition to their API token.
+func getListenerTLSConfig(cfg *config.Store) (*tls.Config, error) {
+	certValue, _ := cfg.Get("daemon.tls.cert")
+	cert, _ := certValue.String()
+
+	keyValue, _ := cfg.Get("daemon.tls.key")
+	key, _ := keyValue.String()
+
+	clientCAValue, _ := cfg.Get("daemon.tls.client-ca")
+	clientCA, _ := clientCAValue.String()
+
+	if cert == "" && key == "" {
+		if clientCA != "" {
+			return nil, fmt.Errorf("daemon.tls.client-ca requires daemon.tls.cert and daemon.tls.key")
+		}
+		return nil, nil
+	}
+	if cert == "" || key == "" {
+		return nil, fmt.Errorf("daemon.tls.cert and daemon.tls.key must be set together")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cert, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tls certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCA != "" {
+		pem, err := os.ReadFile(clientCA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls client ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in tls client ca %s", clientCA)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// openDatabase opens the database selected by the storage section of the configuration.
+// Without one, the daemon keeps its data in a SQLite file in the data directory.
+func openDatabase(cfg *config.Store, dataDir string) (*memory.Client, error) {
+	driverValue, _ := cfg.Get("storage.driver")
+	driver, _ := driverValue.String()
+
+	dsnValue, _ := cfg.Get("storage.dsn")
+	dsn, _ := dsnValue.String()
+
+	switch driver {
+	case "", "sqlite":
+		if dsn == "" {
+			dsn = "file:" + filepath.Join(dataDir, "construct.db") + "?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)"
+		}
+
+		db, err := memory.Open(dialect.SQLite, dsn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open database: %w", err)
+		}
+		return db, nil
+	case "postgres":
+		if dsn == "" {
+			return nil, fmt.Errorf("storage.dsn is required for the postgres driver")
 		}
-		return secret.NewFileProvider(filepath.Join(dataDir, "secrets"), fs)
+
+		db, err := sql.Open("pgx", dsn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open database: %w", err)
+		}
+		return memory.NewClient(memory.Driver(entsql.OpenDB(dialect.Postgres, db))), nil
+	default:
+		return nil, fmt.Errorf("unsupported storage driver %q, expected sqlite or postgres", driver)
+	}
+}
+
+func newMigrator(db *memory.Client, dataDir string) (*migration.Migrator, error) {
+	migrations, err := migration.For(db.Driver().Dialect())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
 	}
 
-	return secret.NewKeyringProvider(), nil
+	return migration.NewMigrator(db, migrations, migration.WithBackupDir(filepath.Join(dataDir, "backups"))), nil
 }
 
-func setupMemory(ctx context.Context, db *memory.Client) error {
-	return db.Schema.Create(ctx,
-		migrate.WithDropColumn(true),
-		migrate.WithDropIndex(true),
-	)
+func setupMemory(ctx context.Context, db *memory.Client, dataDir string) error {
+	migrator, err := newMigrator(db, dataDir)
+	if err != nil {
+		return err
+	}
+
+	applied, err := migrator.Up(ctx)
+	if errors.Is(err, migration.ErrDatabaseNewer) {
+		return fmt.Errorf("%w. Upgrade construct or restore a backup from %s", err, filepath.Join(dataDir, "backups"))
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, m := range applied {
+		slog.Info("applied database migration", "migration", m.String())
+	}
+	return nil
 }
@@ -54,6 +54,7 @@ type execOptions struct {
 	MaxTurns  int
 	Continue  string
 	Files     []string
+	Labels    map[string]string
 	Format    execOutputFormat
 }
 
@@ -91,7 +92,10 @@ inspected or resumed later with construct resume.`,
   # Give the agent more turns to complete a complex task
   construct exec "Draft a project proposal based on the attached spec" \
     --file ./specs/project-spec.md \
-    --max-turns 10`,
+    --max-turns 10
+
+  # Label a CI run to find it later with construct task list --label
+  construct exec "Fix the failing tests" --label env=ci,pipeline=nightly`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var question string
 			if len(args) > 0 {
@@ -110,6 +114,7 @@ func setupFlags(cmd *cobra.Command, options *execOptions) {
 	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "Set the agent's working directory")
 	cmd.Flags().IntVar(&options.MaxTurns, "max-turns", 5, "Set a maximum number of conversational turns for the agent to complete the task")
 	cmd.Flags().StringSliceVarP(&options.Files, "file", "f", []string{}, "Add a file to the agent's context. Can be used multiple times")
+	cmd.Flags().StringToStringVar(&options.Labels, "label", nil, "Label the new task with key=value pairs, comma-separated or repeated")
 	cmd.Flags().StringVarP(&options.Continue, "continue", "c", "", "Continue the most recent task with this new question")
 	cmd.Flags().VarP(&options.Format, "output", "o", "The format to output the result in")
 	cmd.Flags().Lookup("continue").NoOptDefVal = "last"
@@ -217,7 +222,7 @@ func setupTask(ctx context.Context, cmd *cobra.Command, client *client.Client, o
 		return continueTask(ctx, options, client)
 	}
 
-	return createTask(ctx, client, agentID, workspace)
+	return createTask(ctx, client, agentID, workspace, options.Labels)
 }
 
 func continueTask(ctx context.Context, options execOptions, client *client.Client) (*v1.Task, error) {
@@ -252,11 +257,12 @@ func continueTask(ctx context.Context, options execOptions, client *client.Clien
 	}
 }
 
-func createTask(ctx context.Context, client *client.Client, agentID, workspace string) (*v1.Task, error) {
+func createTask(ctx context.Context, client *client.Client, agentID, workspace string, labels map[string]string) (*v1.Task, error) {
 	taskResp, err := client.Task().CreateTask(ctx, &connect.Request[v1.CreateTaskRequest]{
 		Msg: &v1.CreateTaskRequest{
 			AgentId:          agentID,
 			ProjectDirectory: workspace,
+			Labels:           labels,
 		},
 	})
 	if err != nil {
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// archivePassphraseEnvVar holds the passphrase that encrypts the secrets of an archive.
+const archivePassphraseEnvVar = "CONSTRUCT_ARCHIVE_PASSPHRASE"
+
+type exportOptions struct {
+	File           string
+	Since          string
+	Until          string
+	Workspace      string
+	IncludeSecrets bool
+}
+
+func NewExportCmd() *cobra.Command {
+	var options exportOptions
+
+	cmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: "Export agents, models and task history into an archive",
+		Long: `Export agents, models and task history into an archive.
+
+The archive is a gzip compressed tar file with a manifest and one JSON Lines file
+per resource. It contains all model providers, models and agents, and the tasks
+selected by --since, --until and --workspace together with their messages. Import
+it into another daemon with 'construct import'.
+
+The credentials of model providers are left out unless --include-secrets is given.
+They are then encrypted with a passphrase that is read from the
+CONSTRUCT_ARCHIVE_PASSPHRASE environment variable or prompted for.`,
+		Args: cobra.NoArgs,
+		Example: `  # Export everything
+  construct export
+
+  # Export the tasks of the last month in the current workspace
+  construct export --since 2025-06-01 --workspace . -f june.tar.gz
+
+  # Export including encrypted credentials
+  construct export --include-secrets`,
+		GroupID: "system",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			fs := getFileSystem(cmd.Context())
+
+			req := &v1.ExportArchiveRequest{
+				Secrets: v1.ArchiveSecrets_ARCHIVE_SECRETS_EXCLUDED,
+			}
+
+			if options.Since != "" {
+				since, err := parseArchiveTime(options.Since)
+				if err != nil {
+					return fmt.Errorf("invalid --since: %w", err)
+				}
+				req.CreatedAfter = timestamppb.New(since)
+			}
+
+			if options.Until != "" {
+				until, err := parseArchiveTime(options.Until)
+				if err != nil {
+					return fmt.Errorf("invalid --until: %w", err)
+				}
+				req.CreatedBefore = timestamppb.New(until)
+			}
+
+			if options.Workspace != "" {
+				workspace, err := filepath.Abs(options.Workspace)
+				if err != nil {
+					return fmt.Errorf("failed to get absolute path of workspace directory %s: %w", options.Workspace, err)
+				}
+				req.Workspace = &workspace
+			}
+
+			if options.IncludeSecrets {
+				passphrase, err := getArchivePassphrase()
+				if err != nil {
+					return err
+				}
+				req.Secrets = v1.ArchiveSecrets_ARCHIVE_SECRETS_ENCRYPTED
+				req.Passphrase = passphrase
+			}
+
+			file := options.File
+			if file == "" {
+				file = fmt.Sprintf("construct-export-%s.tar.gz", time.Now().Format("2006-01-02"))
+			}
+
+			stream, err := client.Archive().ExportArchive(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return fmt.Errorf("failed to export archive: %w", err)
+			}
+			defer stream.Close()
+
+			out, err := fs.Create(file)
+			if err != nil {
+				return fmt.Errorf("failed to create archive file %s: %w", file, err)
+			}
+			defer out.Close()
+
+			for stream.Receive() {
+				if _, err := out.Write(stream.Msg().Chunk); err != nil {
+					return fmt.Errorf("failed to write archive file %s: %w", file, err)
+				}
+			}
+			if err := stream.Err(); err != nil {
+				fs.Remove(file)
+				return fmt.Errorf("failed to export archive: %w", err)
+			}
+
+			cmd.Println(file)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.File, "file", "f", "", "The archive file to write (default construct-export-<date>.tar.gz)")
+	cmd.Flags().StringVar(&options.Since, "since", "", "Only export tasks created at or after this time (RFC 3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&options.Until, "until", "", "Only export tasks created before this time (RFC 3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "Only export tasks in this workspace directory or below it")
+	cmd.Flags().BoolVar(&options.IncludeSecrets, "include-secrets", false, "Include model provider credentials, encrypted with a passphrase")
+
+	return cmd
+}
+
+func parseArchiveTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither an RFC 3339 timestamp nor a date (YYYY-MM-DD)", value)
+	}
+	return t, nil
+}
+
+func getArchivePassphrase() (string, error) {
+	if passphrase := os.Getenv(archivePassphraseEnvVar); passphrase != "" {
+		return passphrase, nil
+	}
+
+	fmt.Print("Enter archive passphrase: ")
+	passphrase, err := readPasswordSecurely()
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase: %w\n\nPlease set the %s environment variable", err, archivePassphraseEnvVar)
+	}
+	if strings.TrimSpace(passphrase) == "" {
+		return "", fmt.Errorf("passphrase cannot be empty")
+	}
+	return passphrase, nil
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/archive"
+	"github.com/spf13/cobra"
+)
+
+type importOptions struct {
+	OnConflict    ConflictStrategy
+	DryRun        bool
+	RenderOptions RenderOptions
+}
+
+type DisplayImportResult struct {
+	Resource string `json:"resource" yaml:"resource" detail:"default"`
+	Created  int64  `json:"created" yaml:"created" detail:"default"`
+	Updated  int64  `json:"updated" yaml:"updated" detail:"default"`
+	Skipped  int64  `json:"skipped" yaml:"skipped" detail:"default"`
+	Remapped int64  `json:"remapped" yaml:"remapped" detail:"default"`
+}
+
+func NewImportCmd() *cobra.Command {
+	options := importOptions{
+		OnConflict: ConflictStrategySkip,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import <file> [flags]",
+		Short: "Import an archive created by 'construct export'",
+		Long: `Import an archive created by 'construct export'.
+
+The archive is imported in a single transaction. Model providers, models and agents
+that already exist with the same ID or name are reused, tasks and messages are
+matched by ID. The conflict strategy decides what happens to records that already
+exist: skip keeps the existing resource, overwrite replaces it with the record, and
+remap imports tasks and messages under new IDs.
+
+If the archive contains encrypted credentials, the passphrase is read from the
+CONSTRUCT_ARCHIVE_PASSPHRASE environment variable or prompted for. Model providers
+that were exported without credentials are imported disabled.`,
+		Args: cobra.ExactArgs(1),
+		Example: `  # Import an archive, keeping existing resources
+  construct import construct-export-2025-07-01.tar.gz
+
+  # Preview an import that overwrites existing resources
+  construct import backup.tar.gz --on-conflict overwrite --dry-run
+
+  # Import tasks next to existing copies of them
+  construct import backup.tar.gz --on-conflict remap`,
+		GroupID: "system",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			fs := getFileSystem(cmd.Context())
+
+			content, err := fs.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read archive file %s: %w", args[0], err)
+			}
+
+			imported, err := archive.Read(bytes.NewReader(content))
+			if err != nil {
+				return fmt.Errorf("failed to read archive file %s: %w", args[0], err)
+			}
+
+			var passphrase string
+			if imported.Manifest.Secrets == archive.SecretsEncrypted {
+				passphrase, err = getArchivePassphrase()
+				if err != nil {
+					return err
+				}
+			}
+
+			resp, err := client.Archive().ImportArchive(cmd.Context(), connect.NewRequest(&v1.ImportArchiveRequest{
+				Archive:          content,
+				ConflictStrategy: options.OnConflict.ToAPI(),
+				Passphrase:       passphrase,
+				DryRun:           options.DryRun,
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to import archive: %w", err)
+			}
+
+			for _, warning := range resp.Msg.Warnings {
+				cmd.PrintErrln("Warning:", warning)
+			}
+
+			results := make([]*DisplayImportResult, 0, len(resp.Msg.Results))
+			for _, result := range resp.Msg.Results {
+				results = append(results, &DisplayImportResult{
+					Resource: result.Resource,
+					Created:  result.Created,
+					Updated:  result.Updated,
+					Skipped:  result.Skipped,
+					Remapped: result.Remapped,
+				})
+			}
+
+			return getRenderer(cmd.Context()).Render(results, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Var(&options.OnConflict, "on-conflict", "What to do with records that already exist (skip, overwrite, remap)")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "Report the outcome without changing any data")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
+
+type ConflictStrategy string
+
+const (
+	ConflictStrategySkip      ConflictStrategy = "skip"
+	ConflictStrategyOverwrite ConflictStrategy = "overwrite"
+	ConflictStrategyRemap     ConflictStrategy = "remap"
+)
+
+func (e *ConflictStrategy) String() string {
+	return string(*e)
+}
+
+func (e *ConflictStrategy) Set(v string) error {
+	switch ConflictStrategy(strings.ToLower(strings.TrimSpace(v))) {
+	case ConflictStrategySkip, ConflictStrategyOverwrite, ConflictStrategyRemap:
+		*e = ConflictStrategy(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "skip","overwrite","remap"`)
+	}
+}
+
+func (e *ConflictStrategy) Type() string {
+	return "strategy"
+}
+
+func (e *ConflictStrategy) ToAPI() v1.ImportConflictStrategy {
+	switch *e {
+	case ConflictStrategyOverwrite:
+		return v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_OVERWRITE
+	case ConflictStrategyRemap:
+		return v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_REMAP
+	default:
+		return v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_SKIP
+	}
+}
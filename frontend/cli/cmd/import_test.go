@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/archive"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImport(t *testing.T) {
+	setup := &TestSetup{}
+
+	content := testArchive(t, archive.SecretsExcluded)
+	encrypted := testArchive(t, archive.SecretsEncrypted)
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success with default conflict strategy",
+			Command: []string{"import", "backup.tar.gz"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("backup.tar.gz", content, 0644)
+			},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Archive.EXPECT().ImportArchive(
+					gomock.Any(),
+					connect.NewRequest(&v1.ImportArchiveRequest{
+						Archive:          content,
+						ConflictStrategy: v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_SKIP,
+					}),
+				).Return(connect.NewResponse(&v1.ImportArchiveResponse{
+					Results: []*v1.ImportResourceResult{
+						{Resource: "agents", Created: 1},
+						{Resource: "tasks", Skipped: 2},
+					},
+				}), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayImportResult{
+					{Resource: "agents", Created: 1},
+					{Resource: "tasks", Skipped: 2},
+				},
+			},
+		},
+		{
+			Name:    "success with dry run and remap",
+			Command: []string{"import", "backup.tar.gz", "--on-conflict", "remap", "--dry-run"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("backup.tar.gz", content, 0644)
+			},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Archive.EXPECT().ImportArchive(
+					gomock.Any(),
+					connect.NewRequest(&v1.ImportArchiveRequest{
+						Archive:          content,
+						ConflictStrategy: v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_REMAP,
+						DryRun:           true,
+					}),
+				).Return(connect.NewResponse(&v1.ImportArchiveResponse{
+					Results: []*v1.ImportResourceResult{
+						{Resource: "tasks", Remapped: 2},
+					},
+				}), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayImportResult{
+					{Resource: "tasks", Remapped: 2},
+				},
+			},
+		},
+		{
+			Name:    "success with passphrase from environment",
+			Command: []string{"import", "backup.tar.gz"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("backup.tar.gz", encrypted, 0644)
+			},
+			SetupEnv: map[string]string{
+				archivePassphraseEnvVar: "correct horse",
+			},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Archive.EXPECT().ImportArchive(
+					gomock.Any(),
+					connect.NewRequest(&v1.ImportArchiveRequest{
+						Archive:          encrypted,
+						ConflictStrategy: v1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_SKIP,
+						Passphrase:       "correct horse",
+					}),
+				).Return(connect.NewResponse(&v1.ImportArchiveResponse{}), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayImportResult{},
+			},
+		},
+		{
+			Name:    "error - invalid conflict strategy",
+			Command: []string{"import", "backup.tar.gz", "--on-conflict", "merge"},
+			Expected: TestExpectation{
+				Error: "invalid argument \"merge\" for \"--on-conflict\" flag: must be one of \"skip\",\"overwrite\",\"remap\"",
+			},
+		},
+		{
+			Name:    "error - not an archive",
+			Command: []string{"import", "backup.tar.gz"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("backup.tar.gz", []byte("not an archive"), 0644)
+			},
+			Expected: TestExpectation{
+				Error: "failed to read archive file backup.tar.gz: unsupported archive: not a gzip file",
+			},
+		},
+		{
+			Name:    "error - import fails",
+			Command: []string{"import", "backup.tar.gz"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("backup.tar.gz", content, 0644)
+			},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Archive.EXPECT().ImportArchive(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("database is locked"))
+			},
+			Expected: TestExpectation{
+				Error: "failed to import archive: database is locked",
+			},
+		},
+	})
+}
+
+func testArchive(t *testing.T, secrets archive.Secrets) []byte {
+	t.Helper()
+
+	a := &archive.Archive{
+		Manifest: archive.Manifest{Secrets: secrets},
+	}
+
+	var b bytes.Buffer
+	if err := a.Write(&b); err != nil {
+		t.Fatalf("failed to write archive: %v", err)
+	}
+	return b.Bytes()
+}
@@ -149,6 +149,7 @@ type ModelProviderDisplay struct {
 	Name         string            `json:"name" detail:"default"`
 	ProviderType ModelProviderType `json:"provider_type" detail:"default"`
 	Enabled      bool              `json:"enabled" detail:"full"`
+	Owner        string            `json:"owner,omitempty" detail:"full"`
 }
 
 func ConvertModelProviderToDisplay(modelProvider *v1.ModelProvider) *ModelProviderDisplay {
@@ -157,6 +158,7 @@ func ConvertModelProviderToDisplay(modelProvider *v1.ModelProvider) *ModelProvid
 		Name:         modelProvider.Spec.Name,
 		ProviderType: ConvertModelProviderTypeToDisplay(modelProvider.Metadata.ProviderType),
 		Enabled:      modelProvider.Spec.Enabled,
+		Owner:        modelProvider.Metadata.Owner,
 	}
 }
 
@@ -102,7 +102,7 @@ func getAPIKey(options *modelProviderCreateOptions, providerType ModelProviderTy
 	fmt.Printf("Enter %s API key for %s: ", displayName, name)
 	apiKey, err := readPasswordSecurely()
 	if err != nil {
-		return "", fmt.Errorf("failed to read API key: %w", err)
+		return "", fmt.Errorf("failed to read API key: %w\n\nPlease use --api-key flag or set the %s environment variable", err, envVar)
 	}
 
 	if strings.TrimSpace(apiKey) == "" {
@@ -114,7 +114,7 @@ func getAPIKey(options *modelProviderCreateOptions, providerType ModelProviderTy
 
 func readPasswordSecurely() (string, error) {
 	if !term.IsTerminal(int(syscall.Stdin)) {
-		return "", fmt.Errorf("cannot prompt for API key in non-interactive terminal\n\nPlease use --api-key flag or set environment variable")
+		return "", fmt.Errorf("cannot prompt in non-interactive terminal")
 	}
 
 	bytePassword, err := term.ReadPassword(int(syscall.Stdin))
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"path/filepath"
 
 	"connectrpc.com/connect"
 	tea "github.com/charmbracelet/bubbletea"
@@ -19,6 +20,7 @@ import (
 type newOptions struct {
 	agent     string
 	workspace string
+	review    bool
 }
 
 func NewNewCmd() *cobra.Command {
@@ -31,7 +33,17 @@ func NewNewCmd() *cobra.Command {
 
 Starts a real-time, interactive conversation with an AI agent in your terminal. 
 This is the primary command for collaborative tasks like coding, debugging, and 
-code reviews.`,
+code reviews.
+
+Type / in the session to open the command palette with commands like /model, /agent,
+/cost, /export, /rewind, /compact, /files, /diff, /changes and /clear. Markdown files in
+the commands directory of the config directory or in .construct/commands of the workspace
+add custom commands that send their content as a prompt template.
+
+With --review the files the agent creates or edits are staged for your review and only
+written to the workspace once you accept them. Files changed by commands the agent runs
+are not staged. Type /changes to step through the pending changes and accept or reject
+them. Rejecting a change discards it and tells the agent why.`,
 		Example: `  # Start a chat with the default agent
   construct new
 
@@ -39,7 +51,10 @@ code reviews.`,
   construct new --agent coder
 
   # Start a chat with an agent sandboxed in a different directory
-  construct new --workspace /path/to/project`,
+  construct new --workspace /path/to/project
+
+  # Review every change the agent makes to files before accepting it
+  construct new --review`,
 		GroupID: "core",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			userInfo := getUserInfo(cmd.Context())
@@ -63,6 +78,7 @@ code reviews.`,
 
 	cmd.Flags().StringVar(&options.agent, "agent", "", "Start the session with a specific agent. Defaults to the last used agent")
 	cmd.Flags().StringVar(&options.workspace, "workspace", "", "Set the agent's working directory. Defaults to the current directory")
+	cmd.Flags().BoolVar(&options.review, "review", false, "Stage the file changes of the agent for review before accepting them")
 
 	return cmd
 }
@@ -90,6 +106,7 @@ func handleNewCommand(ctx context.Context, apiClient *api.Client, options *newOp
 		Msg: &v1.CreateTaskRequest{
 			AgentId:          agent.Metadata.Id,
 			ProjectDirectory: options.workspace,
+			ReviewEdits:      options.review,
 		},
 	})
 
@@ -102,6 +119,7 @@ func handleNewCommand(ctx context.Context, apiClient *api.Client, options *newOp
 	if verbose {
 		model.Verbose = true
 	}
+	model.RegisterSlashCommands(loadCustomCommands(ctx, resp.Msg.Task.Spec.Workspace)...)
 
 	program := tea.NewProgram(
 		model,
@@ -142,3 +160,21 @@ func handleNewCommand(ctx context.Context, apiClient *api.Client, options *newOp
 
 	return nil
 }
+
+// loadCustomCommands loads the slash commands of the user from the commands directory in the
+// config directory and those of the project from .construct/commands in the workspace.
+func loadCustomCommands(ctx context.Context, workspace string) []*terminal.SlashCommand {
+	var dirs []string
+	if configDir, err := getUserInfo(ctx).ConstructConfigDir(); err == nil {
+		dirs = append(dirs, filepath.Join(configDir, "commands"))
+	}
+	if workspace != "" {
+		dirs = append(dirs, filepath.Join(workspace, ".construct", "commands"))
+	}
+
+	commands, err := terminal.LoadCustomCommands(getFileSystem(ctx), dirs...)
+	if err != nil {
+		slog.Warn("failed to load custom commands", "error", err)
+	}
+	return commands
+}
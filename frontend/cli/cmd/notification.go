@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func NewNotificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "notification",
+		Short:   "Manage notification sinks that report on tasks",
+		Aliases: []string{"notifications", "notify"},
+		GroupID: "resource",
+	}
+
+	cmd.AddCommand(NewNotificationCreateCmd())
+	cmd.AddCommand(NewNotificationGetCmd())
+	cmd.AddCommand(NewNotificationListCmd())
+	cmd.AddCommand(NewNotificationTestCmd())
+	cmd.AddCommand(NewNotificationDeleteCmd())
+
+	return cmd
+}
+
+type DisplayNotificationSink struct {
+	ID            string   `json:"id" yaml:"id" detail:"default"`
+	Name          string   `json:"name" yaml:"name" detail:"default"`
+	Description   string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Kind          string   `json:"kind" yaml:"kind" detail:"default"`
+	Events        []string `json:"events" yaml:"events" detail:"default"`
+	AgentID       string   `json:"agent_id,omitempty" yaml:"agent_id,omitempty" detail:"default"`
+	Budget        float64  `json:"budget,omitempty" yaml:"budget,omitempty"`
+	Destination   string   `json:"destination,omitempty" yaml:"destination,omitempty" detail:"default"`
+	TitleTemplate string   `json:"title_template,omitempty" yaml:"title_template,omitempty" detail:"full"`
+	BodyTemplate  string   `json:"body_template,omitempty" yaml:"body_template,omitempty" detail:"full"`
+	Enabled       bool     `json:"enabled" yaml:"enabled" detail:"default"`
+}
+
+func ConvertNotificationSinkToDisplay(sink *v1.NotificationSink) *DisplayNotificationSink {
+	if sink == nil || sink.Metadata == nil || sink.Spec == nil {
+		return nil
+	}
+
+	events := make([]string, 0, len(sink.Spec.Events))
+	for _, e := range sink.Spec.Events {
+		events = append(events, string(ConvertNotificationEventFromAPI(e)))
+	}
+
+	display := &DisplayNotificationSink{
+		ID:            sink.Metadata.Id,
+		Name:          sink.Spec.Name,
+		Description:   sink.Spec.Description,
+		Kind:          string(ConvertNotificationSinkKindFromAPI(sink.Spec.Kind)),
+		Events:        events,
+		AgentID:       PtrToString(sink.Spec.AgentId),
+		Budget:        sink.Spec.GetBudget(),
+		TitleTemplate: sink.Spec.TitleTemplate,
+		BodyTemplate:  sink.Spec.BodyTemplate,
+		Enabled:       sink.Spec.Enabled,
+	}
+
+	switch sink.Spec.Kind {
+	case v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK:
+		display.Destination = sink.Spec.Url
+	case v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_EMAIL:
+		display.Destination = strings.Join(sink.Spec.GetEmail().GetTo(), ", ")
+	}
+
+	return display
+}
+
+type NotificationSinkKind string
+
+const (
+	NotificationSinkKindWebhook NotificationSinkKind = "webhook"
+	NotificationSinkKindDesktop NotificationSinkKind = "desktop"
+	NotificationSinkKindEmail   NotificationSinkKind = "email"
+)
+
+func (e *NotificationSinkKind) String() string {
+	return string(*e)
+}
+
+func (e *NotificationSinkKind) Set(v string) error {
+	switch NotificationSinkKind(strings.ToLower(strings.TrimSpace(v))) {
+	case NotificationSinkKindWebhook, NotificationSinkKindDesktop, NotificationSinkKindEmail:
+		*e = NotificationSinkKind(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "webhook","desktop","email"`)
+	}
+}
+
+func (e *NotificationSinkKind) Type() string {
+	return "kind"
+}
+
+func (e *NotificationSinkKind) ToAPI() v1.NotificationSinkKind {
+	switch *e {
+	case NotificationSinkKindWebhook:
+		return v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK
+	case NotificationSinkKindDesktop:
+		return v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_DESKTOP
+	case NotificationSinkKindEmail:
+		return v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_EMAIL
+	default:
+		return v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_UNSPECIFIED
+	}
+}
+
+func ConvertNotificationSinkKindFromAPI(kind v1.NotificationSinkKind) NotificationSinkKind {
+	switch kind {
+	case v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK:
+		return NotificationSinkKindWebhook
+	case v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_DESKTOP:
+		return NotificationSinkKindDesktop
+	case v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_EMAIL:
+		return NotificationSinkKindEmail
+	default:
+		return ""
+	}
+}
+
+type NotificationEvent string
+
+const (
+	NotificationEventTaskCompleted  NotificationEvent = "task-completed"
+	NotificationEventTaskSuspended  NotificationEvent = "task-suspended"
+	NotificationEventTaskFailed     NotificationEvent = "task-failed"
+	NotificationEventInputRequested NotificationEvent = "input-requested"
+	NotificationEventBudgetExceeded NotificationEvent = "budget-exceeded"
+)
+
+var notificationEvents = []NotificationEvent{
+	NotificationEventTaskCompleted,
+	NotificationEventTaskSuspended,
+	NotificationEventTaskFailed,
+	NotificationEventInputRequested,
+	NotificationEventBudgetExceeded,
+}
+
+// NotificationEvents is a comma separated list of events that can be repeated on the command line.
+type NotificationEvents []NotificationEvent
+
+func (e *NotificationEvents) String() string {
+	events := make([]string, len(*e))
+	for i, event := range *e {
+		events[i] = string(event)
+	}
+	return strings.Join(events, ",")
+}
+
+func (e *NotificationEvents) Set(v string) error {
+	for _, part := range strings.Split(v, ",") {
+		event := NotificationEvent(strings.ToLower(strings.TrimSpace(part)))
+		found := false
+		for _, known := range notificationEvents {
+			if event == known {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.New(`must be one of "task-completed","task-suspended","task-failed","input-requested","budget-exceeded"`)
+		}
+		*e = append(*e, event)
+	}
+
+	return nil
+}
+
+func (e *NotificationEvents) Type() string {
+	return "events"
+}
+
+func (e *NotificationEvents) ToAPI() []v1.NotificationEvent {
+	events := make([]v1.NotificationEvent, 0, len(*e))
+	for _, event := range *e {
+		switch event {
+		case NotificationEventTaskCompleted:
+			events = append(events, v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED)
+		case NotificationEventTaskSuspended:
+			events = append(events, v1.NotificationEvent_NOTIFICATION_EVENT_TASK_SUSPENDED)
+		case NotificationEventTaskFailed:
+			events = append(events, v1.NotificationEvent_NOTIFICATION_EVENT_TASK_FAILED)
+		case NotificationEventInputRequested:
+			events = append(events, v1.NotificationEvent_NOTIFICATION_EVENT_INPUT_REQUESTED)
+		case NotificationEventBudgetExceeded:
+			events = append(events, v1.NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED)
+		}
+	}
+
+	return events
+}
+
+func ConvertNotificationEventFromAPI(event v1.NotificationEvent) NotificationEvent {
+	switch event {
+	case v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED:
+		return NotificationEventTaskCompleted
+	case v1.NotificationEvent_NOTIFICATION_EVENT_TASK_SUSPENDED:
+		return NotificationEventTaskSuspended
+	case v1.NotificationEvent_NOTIFICATION_EVENT_TASK_FAILED:
+		return NotificationEventTaskFailed
+	case v1.NotificationEvent_NOTIFICATION_EVENT_INPUT_REQUESTED:
+		return NotificationEventInputRequested
+	case v1.NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED:
+		return NotificationEventBudgetExceeded
+	default:
+		return ""
+	}
+}
+
+func getNotificationSinkID(ctx context.Context, client *api.Client, idOrName string) (string, error) {
+	_, err := uuid.Parse(idOrName)
+	if err == nil {
+		return idOrName, nil
+	}
+
+	sinkResp, err := client.Notification().ListNotificationSinks(ctx, &connect.Request[v1.ListNotificationSinksRequest]{
+		Msg: &v1.ListNotificationSinksRequest{
+			Filter: &v1.ListNotificationSinksRequest_Filter{
+				Names: []string{idOrName},
+			},
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to list notification sinks: %w", err)
+	}
+
+	if len(sinkResp.Msg.Sinks) == 0 {
+		return "", fmt.Errorf("notification sink %s not found", idOrName)
+	}
+
+	if len(sinkResp.Msg.Sinks) > 1 {
+		return "", fmt.Errorf("multiple notification sinks found for %s", idOrName)
+	}
+
+	return sinkResp.Msg.Sinks[0].Metadata.Id, nil
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type notificationCreateOptions struct {
+	Description   string
+	Kind          NotificationSinkKind
+	Events        NotificationEvents
+	Agent         string
+	Budget        float64
+	URL           string
+	SMTPAddress   string
+	SMTPUsername  string
+	From          string
+	To            []string
+	Secret        string
+	TitleTemplate string
+	BodyTemplate  string
+}
+
+func NewNotificationCreateCmd() *cobra.Command {
+	options := notificationCreateOptions{
+		Kind: NotificationSinkKindDesktop,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name> --on <events> [flags]",
+		Short: "Create a notification sink that reports on tasks",
+		Args:  cobra.ExactArgs(1),
+		Long: `Create a notification sink that reports on tasks.
+
+The daemon notifies the sink whenever one of the selected events happens:
+
+  task-completed   the agent gave its final response and waits for input
+  task-suspended   the task was suspended
+  task-failed      the execution of the task failed
+  input-requested  the agent asked a question
+  budget-exceeded  the cost of the task crossed the budget (requires --budget)
+
+Desktop sinks use notify-send. Webhook sinks post the notification as JSON and 
+sign the body with the secret like inbound webhooks. Email sinks send through 
+an SMTP server and use the secret as the SMTP password.
+
+Title and body are Go templates with access to {{.Headline}}, {{.Task}}, 
+{{.TaskID}}, {{.Summary}}, {{.Agent}}, {{.Workspace}}, {{.Cost}}, {{.Budget}}, 
+{{.Error}} and {{.Question}}.`,
+		Example: `  # Show a desktop notification when a task finishes or needs input
+  construct notification create desktop --on task-completed,input-requested
+
+  # Post failures of the reviewer agent to a chat bot
+  construct notification create chat --kind webhook --on task-failed \
+    --agent reviewer --url https://chat.example.com/hooks/construct
+
+  # Send an email when a task costs more than $5
+  construct notification create budget --kind email --on budget-exceeded --budget 5 \
+    --smtp-address smtp.example.com:587 --smtp-username bot --secret "$SMTP_PASSWORD" \
+    --from construct@example.com --to me@example.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			if len(options.Events) == 0 {
+				return fmt.Errorf("at least one event is required (use --on)")
+			}
+
+			req := &v1.CreateNotificationSinkRequest{
+				Name:          args[0],
+				Description:   options.Description,
+				Kind:          options.Kind.ToAPI(),
+				Events:        options.Events.ToAPI(),
+				Url:           options.URL,
+				Secret:        options.Secret,
+				TitleTemplate: options.TitleTemplate,
+				BodyTemplate:  options.BodyTemplate,
+			}
+
+			if cmd.Flags().Changed("budget") {
+				req.Budget = &options.Budget
+			}
+
+			if options.Kind == NotificationSinkKindEmail {
+				req.Email = &v1.NotificationEmailConfig{
+					SmtpAddress: options.SMTPAddress,
+					Username:    options.SMTPUsername,
+					From:        options.From,
+					To:          options.To,
+				}
+			}
+
+			if options.Agent != "" {
+				agentID, err := getAgentID(cmd.Context(), client, options.Agent)
+				if err != nil {
+					return fmt.Errorf("failed to resolve agent %s: %w", options.Agent, err)
+				}
+				req.AgentId = &agentID
+			}
+
+			resp, err := client.Notification().CreateNotificationSink(cmd.Context(), &connect.Request[v1.CreateNotificationSinkRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create notification sink: %w", err)
+			}
+
+			cmd.Println(resp.Msg.Sink.Metadata.Id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Description, "description", "d", "", "A description of the notification sink")
+	cmd.Flags().Var(&options.Kind, "kind", "How notifications are delivered (webhook, desktop, email)")
+	cmd.Flags().Var(&options.Events, "on", "The events that trigger a notification, comma separated. Can be used multiple times")
+	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "Only notify about tasks of this agent")
+	cmd.Flags().Float64Var(&options.Budget, "budget", 0, "The task cost in USD at which budget-exceeded fires")
+	cmd.Flags().StringVar(&options.URL, "url", "", "The URL webhook notifications are posted to")
+	cmd.Flags().StringVar(&options.SMTPAddress, "smtp-address", "", "The host:port of the SMTP server")
+	cmd.Flags().StringVar(&options.SMTPUsername, "smtp-username", "", "The username for the SMTP server")
+	cmd.Flags().StringVar(&options.From, "from", "", "The sender address of email notifications")
+	cmd.Flags().StringSliceVar(&options.To, "to", []string{}, "The recipients of email notifications. Can be used multiple times")
+	cmd.Flags().StringVar(&options.Secret, "secret", "", "The webhook signing secret or the SMTP password")
+	cmd.Flags().StringVar(&options.TitleTemplate, "title-template", "", "The template of the notification title")
+	cmd.Flags().StringVar(&options.BodyTemplate, "body-template", "", "The template of the notification body")
+
+	return cmd
+}
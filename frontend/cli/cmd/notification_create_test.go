@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNotificationCreate(t *testing.T) {
+	setup := &TestSetup{}
+
+	sinkID := uuid.NewString()
+	agentID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - desktop sink with default kind",
+			Command: []string{"notification", "create", "desktop", "--on", "task-completed,input-requested"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupNotificationCreateMock(mockClient, &v1.CreateNotificationSinkRequest{
+					Name: "desktop",
+					Kind: v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_DESKTOP,
+					Events: []v1.NotificationEvent{
+						v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED,
+						v1.NotificationEvent_NOTIFICATION_EVENT_INPUT_REQUESTED,
+					},
+				}, sinkID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(sinkID + "\n"),
+			},
+		},
+		{
+			Name: "success - webhook sink for agent",
+			Command: []string{"notification", "create", "chat", "--kind", "webhook", "--on", "task-failed", "--on", "budget-exceeded",
+				"--budget", "2.5", "--agent", "reviewer", "--url", "https://chat.example.com/hooks/construct", "--secret", "s3cr3t"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupAgentLookupForTaskCreateMock(mockClient, "reviewer", agentID)
+				setupNotificationCreateMock(mockClient, &v1.CreateNotificationSinkRequest{
+					Name: "chat",
+					Kind: v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_WEBHOOK,
+					Events: []v1.NotificationEvent{
+						v1.NotificationEvent_NOTIFICATION_EVENT_TASK_FAILED,
+						v1.NotificationEvent_NOTIFICATION_EVENT_BUDGET_EXCEEDED,
+					},
+					AgentId: &agentID,
+					Budget:  conv.Ptr(2.5),
+					Url:     "https://chat.example.com/hooks/construct",
+					Secret:  "s3cr3t",
+				}, sinkID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(sinkID + "\n"),
+			},
+		},
+		{
+			Name: "success - email sink",
+			Command: []string{"notification", "create", "mail", "--kind", "email", "--on", "task-completed",
+				"--smtp-address", "smtp.example.com:587", "--from", "construct@example.com", "--to", "a@example.com,b@example.com"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupNotificationCreateMock(mockClient, &v1.CreateNotificationSinkRequest{
+					Name:   "mail",
+					Kind:   v1.NotificationSinkKind_NOTIFICATION_SINK_KIND_EMAIL,
+					Events: []v1.NotificationEvent{v1.NotificationEvent_NOTIFICATION_EVENT_TASK_COMPLETED},
+					Email: &v1.NotificationEmailConfig{
+						SmtpAddress: "smtp.example.com:587",
+						From:        "construct@example.com",
+						To:          []string{"a@example.com", "b@example.com"},
+					},
+				}, sinkID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(sinkID + "\n"),
+			},
+		},
+		{
+			Name:    "error - missing events",
+			Command: []string{"notification", "create", "desktop"},
+			Expected: TestExpectation{
+				Error: "at least one event is required (use --on)",
+			},
+		},
+		{
+			Name:    "error - invalid event",
+			Command: []string{"notification", "create", "desktop", "--on", "task-started"},
+			Expected: TestExpectation{
+				Error: "invalid argument \"task-started\" for \"--on\" flag: must be one of \"task-completed\",\"task-suspended\",\"task-failed\",\"input-requested\",\"budget-exceeded\"",
+			},
+		},
+		{
+			Name:    "error - create notification sink API failure",
+			Command: []string{"notification", "create", "chat", "--kind", "webhook", "--on", "task-failed"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Notification.EXPECT().CreateNotificationSink(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("URL is required for webhook sinks")))
+			},
+			Expected: TestExpectation{
+				Error: "failed to create notification sink: invalid_argument: URL is required for webhook sinks",
+			},
+		},
+	})
+}
+
+func setupNotificationCreateMock(mockClient *api_client.MockClient, req *v1.CreateNotificationSinkRequest, sinkID string) {
+	mockClient.Notification.EXPECT().CreateNotificationSink(
+		gomock.Any(),
+		CmpEqual(&connect.Request[v1.CreateNotificationSinkRequest]{
+			Msg: req,
+		}, protocmp.Transform(),
+			cmpopts.IgnoreUnexported(connect.Request[v1.CreateNotificationSinkRequest]{}),
+		),
+	).Return(&connect.Response[v1.CreateNotificationSinkResponse]{
+		Msg: &v1.CreateNotificationSinkResponse{
+			Sink: &v1.NotificationSink{
+				Metadata: &v1.NotificationSinkMetadata{
+					Id:        sinkID,
+					CreatedAt: timestamppb.Now(),
+					UpdatedAt: timestamppb.Now(),
+				},
+				Spec: &v1.NotificationSinkSpec{
+					Name: req.Name,
+				},
+			},
+		},
+	}, nil)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type notificationDeleteOptions struct {
+	Force bool
+}
+
+func NewNotificationDeleteCmd() *cobra.Command {
+	options := new(notificationDeleteOptions)
+	cmd := &cobra.Command{
+		Use:     "delete <name|id>... [flags]",
+		Short:   "Permanently delete one or more notification sinks",
+		Aliases: []string{"rm"},
+		Example: `  # Delete a single notification sink
+  construct notification delete desktop
+
+  # Force delete without a confirmation prompt
+  construct notification rm desktop chat --force`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			var sinkIDs = make(map[string]string)
+			for _, idOrName := range args {
+				sinkID, err := getNotificationSinkID(cmd.Context(), client, idOrName)
+				if err != nil {
+					return err
+				}
+
+				sinkIDs[idOrName] = sinkID
+			}
+
+			if !options.Force && !confirmDeletion(cmd.InOrStdin(), cmd.OutOrStdout(), "notification sink", args) {
+				return nil
+			}
+
+			for idOrName, sinkID := range sinkIDs {
+				_, err := client.Notification().DeleteNotificationSink(cmd.Context(), &connect.Request[v1.DeleteNotificationSinkRequest]{
+					Msg: &v1.DeleteNotificationSinkRequest{Id: sinkID},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to delete notification sink %s: %w", idOrName, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Force, "force", "f", false, "Skip the confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type notificationGetOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewNotificationGetCmd() *cobra.Command {
+	var options notificationGetOptions
+
+	cmd := &cobra.Command{
+		Use:   "get <name|id> [flags]",
+		Short: "Inspect the details of a specific notification sink",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Get details for the 'chat' notification sink
+  construct notification get chat
+
+  # Get details and format as YAML
+  construct notification get chat --output yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			sinkID, err := getNotificationSinkID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve notification sink %s: %w", idOrName, err)
+			}
+
+			resp, err := client.Notification().GetNotificationSink(cmd.Context(), &connect.Request[v1.GetNotificationSinkRequest]{
+				Msg: &v1.GetNotificationSinkRequest{Id: sinkID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get notification sink %s: %w", idOrName, err)
+			}
+
+			return getRenderer(cmd.Context()).Render(ConvertNotificationSinkToDisplay(resp.Msg.Sink), &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/frontend/cli/pkg/fail"
+	"github.com/spf13/cobra"
+)
+
+type notificationListOptions struct {
+	Limit         int32
+	RenderOptions RenderOptions
+}
+
+func NewNotificationListCmd() *cobra.Command {
+	var options notificationListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List all notification sinks",
+		Aliases: []string{"ls"},
+		Example: `  # List all notification sinks
+  construct notification list
+
+  # List notification sinks as JSON
+  construct notification ls --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			req := &v1.ListNotificationSinksRequest{}
+			if options.Limit > 0 {
+				req.PageSize = &options.Limit
+			}
+
+			resp, err := client.Notification().ListNotificationSinks(cmd.Context(), &connect.Request[v1.ListNotificationSinksRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fail.HandleError(cmd, fmt.Errorf("failed to list notification sinks: %w", err))
+			}
+
+			displaySinks := make([]*DisplayNotificationSink, len(resp.Msg.Sinks))
+			for i, sink := range resp.Msg.Sinks {
+				displaySinks[i] = ConvertNotificationSinkToDisplay(sink)
+			}
+
+			return getRenderer(cmd.Context()).Render(displaySinks, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "l", 0, "Limit the number of results returned")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewNotificationTestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test <name|id>",
+		Short: "Send a test notification to a notification sink",
+		Long: `Send a test notification to a notification sink.
+
+The notification is sent regardless of the events the sink selects and whether
+it is enabled. Use this to check the URL, the SMTP settings or the templates.`,
+		Args: cobra.ExactArgs(1),
+		Example: `  # Check that desktop notifications show up
+  construct notification test desktop`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			sinkID, err := getNotificationSinkID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve notification sink %s: %w", idOrName, err)
+			}
+
+			_, err = client.Notification().TestNotificationSink(cmd.Context(), &connect.Request[v1.TestNotificationSinkRequest]{
+				Msg: &v1.TestNotificationSinkRequest{Id: sinkID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to send test notification to %s: %w", idOrName, err)
+			}
+
+			cmd.Printf("Test notification sent to %s\n", idOrName)
+			return nil
+		},
+	}
+
+	return cmd
+}
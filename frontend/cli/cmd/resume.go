@@ -226,8 +226,11 @@ func resolveTaskID(ctx context.Context, apiClient *api.Client, taskID string) (*
 }
 
 func startInteractiveSession(ctx context.Context, apiClient *api.Client, task *v1.Task, agent *v1.Agent) error {
+	session := terminal.NewSession(ctx, apiClient, task, agent)
+	session.RegisterSlashCommands(loadCustomCommands(ctx, task.Spec.Workspace)...)
+
 	program := tea.NewProgram(
-		terminal.NewSession(ctx, apiClient, task, agent),
+		session,
 		tea.WithAltScreen(),
 	)
 
@@ -16,6 +16,7 @@ import (
 
 	"github.com/common-nighthawk/go-figure"
 	"github.com/getsentry/sentry-go"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/spf13/cobra"
 	"gopkg.in/natefinch/lumberjack.v2"
 	_ "modernc.org/sqlite"
@@ -99,15 +100,25 @@ func NewRootCmd() *cobra.Command {
 	cmd.AddCommand(NewNewCmd())
 	cmd.AddCommand(NewResumeCmd())
 	cmd.AddCommand(NewExecCmd())
+	cmd.AddCommand(NewSearchCmd())
 
 	cmd.AddCommand(NewAgentCmd())
 	cmd.AddCommand(NewTaskCmd())
 	cmd.AddCommand(NewMessageCmd())
 	cmd.AddCommand(NewModelCmd())
 	cmd.AddCommand(NewModelProviderCmd())
+	cmd.AddCommand(NewScheduleCmd())
+	cmd.AddCommand(NewWebhookCmd())
+	cmd.AddCommand(NewNotificationCmd())
 
+	cmd.AddCommand(NewExportCmd())
+	cmd.AddCommand(NewImportCmd())
 	cmd.AddCommand(NewConfigCmd())
+	cmd.AddCommand(NewAnalyticsCmd())
 	cmd.AddCommand(NewDaemonCmd())
+	cmd.AddCommand(NewTokenCmd())
+	cmd.AddCommand(NewAuditCmd())
+	cmd.AddCommand(NewSecretCmd())
 	cmd.AddCommand(NewInfoCmd())
 	cmd.AddCommand(NewUpdateCmd())
 	return cmd
@@ -173,7 +184,7 @@ func setAPIClient(ctx context.Context, cmd *cobra.Command) error {
 }
 
 func requiresContext(cmd *cobra.Command) bool {
-	skipCommands := []string{"info", "help", "update", "daemon.", "config."}
+	skipCommands := []string{"info", "help", "update", "daemon.", "config.", "analytics.", "token."}
 	for _, skipCmd := range skipCommands {
 		cmdName := cmd.Name()
 		parentCmd := cmd.Parent()
@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func NewScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule",
+		Short:   "Manage schedules that start tasks on a recurring basis",
+		Aliases: []string{"schedules"},
+		GroupID: "resource",
+	}
+
+	cmd.AddCommand(NewScheduleCreateCmd())
+	cmd.AddCommand(NewScheduleGetCmd())
+	cmd.AddCommand(NewScheduleListCmd())
+	cmd.AddCommand(NewSchedulePauseCmd())
+	cmd.AddCommand(NewScheduleResumeCmd())
+	cmd.AddCommand(NewScheduleTriggerCmd())
+	cmd.AddCommand(NewScheduleRunsCmd())
+	cmd.AddCommand(NewScheduleDeleteCmd())
+
+	return cmd
+}
+
+type DisplaySchedule struct {
+	ID             string `json:"id" yaml:"id" detail:"default"`
+	Name           string `json:"name" yaml:"name" detail:"default"`
+	CronExpression string `json:"cron_expression" yaml:"cron_expression" detail:"default"`
+	AgentID        string `json:"agent_id" yaml:"agent_id" detail:"default"`
+	Workspace      string `json:"workspace,omitempty" yaml:"workspace,omitempty"`
+	PromptTemplate string `json:"prompt_template" yaml:"prompt_template"`
+	OverlapPolicy  string `json:"overlap_policy" yaml:"overlap_policy" detail:"full"`
+	CatchUpPolicy  string `json:"catch_up_policy" yaml:"catch_up_policy" detail:"full"`
+	Enabled        bool   `json:"enabled" yaml:"enabled" detail:"default"`
+	LastRun        string `json:"last_run,omitempty" yaml:"last_run,omitempty" detail:"default"`
+	NextRun        string `json:"next_run,omitempty" yaml:"next_run,omitempty" detail:"default"`
+}
+
+func ConvertScheduleToDisplay(schedule *v1.Schedule) *DisplaySchedule {
+	if schedule == nil || schedule.Metadata == nil || schedule.Spec == nil {
+		return nil
+	}
+
+	display := &DisplaySchedule{
+		ID:             schedule.Metadata.Id,
+		Name:           schedule.Spec.Name,
+		CronExpression: schedule.Spec.CronExpression,
+		AgentID:        schedule.Spec.AgentId,
+		Workspace:      schedule.Spec.Workspace,
+		PromptTemplate: schedule.Spec.PromptTemplate,
+		OverlapPolicy:  string(ConvertOverlapPolicyFromAPI(schedule.Spec.OverlapPolicy)),
+		CatchUpPolicy:  string(ConvertCatchUpPolicyFromAPI(schedule.Spec.CatchUpPolicy)),
+		Enabled:        schedule.Spec.Enabled,
+	}
+
+	if schedule.Status != nil {
+		if schedule.Status.LastRunTime != nil {
+			display.LastRun = schedule.Status.LastRunTime.AsTime().Local().Format(time.DateTime)
+		}
+		if schedule.Status.NextRunTime != nil {
+			display.NextRun = schedule.Status.NextRunTime.AsTime().Local().Format(time.DateTime)
+		}
+	}
+
+	return display
+}
+
+type DisplayScheduleRun struct {
+	ID            string `json:"id" yaml:"id" detail:"default"`
+	TaskID        string `json:"task_id,omitempty" yaml:"task_id,omitempty" detail:"default"`
+	Trigger       string `json:"trigger" yaml:"trigger" detail:"default"`
+	Status        string `json:"status" yaml:"status" detail:"default"`
+	ScheduledTime string `json:"scheduled_time" yaml:"scheduled_time" detail:"default"`
+	Error         string `json:"error,omitempty" yaml:"error,omitempty" detail:"default"`
+}
+
+func ConvertScheduleRunToDisplay(run *v1.ScheduleRun) *DisplayScheduleRun {
+	if run == nil {
+		return nil
+	}
+
+	display := &DisplayScheduleRun{
+		ID:      run.Id,
+		TaskID:  PtrToString(run.TaskId),
+		Trigger: strings.ToLower(strings.TrimPrefix(run.Trigger.String(), "SCHEDULE_RUN_TRIGGER_")),
+		Status:  strings.ToLower(strings.TrimPrefix(run.Status.String(), "SCHEDULE_RUN_STATUS_")),
+		Error:   run.Error,
+	}
+
+	if run.ScheduledTime != nil {
+		display.ScheduledTime = run.ScheduledTime.AsTime().Local().Format(time.DateTime)
+	}
+
+	return display
+}
+
+type OverlapPolicy string
+
+const (
+	OverlapPolicyAllow   OverlapPolicy = "allow"
+	OverlapPolicySkip    OverlapPolicy = "skip"
+	OverlapPolicyReplace OverlapPolicy = "replace"
+)
+
+func (e *OverlapPolicy) String() string {
+	return string(*e)
+}
+
+func (e *OverlapPolicy) Set(v string) error {
+	switch OverlapPolicy(strings.ToLower(strings.TrimSpace(v))) {
+	case OverlapPolicyAllow, OverlapPolicySkip, OverlapPolicyReplace:
+		*e = OverlapPolicy(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "allow","skip","replace"`)
+	}
+}
+
+func (e *OverlapPolicy) Type() string {
+	return "policy"
+}
+
+func (e *OverlapPolicy) ToAPI() v1.ScheduleOverlapPolicy {
+	switch *e {
+	case OverlapPolicyAllow:
+		return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW
+	case OverlapPolicySkip:
+		return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP
+	case OverlapPolicyReplace:
+		return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_REPLACE
+	default:
+		return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+	}
+}
+
+func ConvertOverlapPolicyFromAPI(policy v1.ScheduleOverlapPolicy) OverlapPolicy {
+	switch policy {
+	case v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW:
+		return OverlapPolicyAllow
+	case v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP:
+		return OverlapPolicySkip
+	case v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_REPLACE:
+		return OverlapPolicyReplace
+	default:
+		return ""
+	}
+}
+
+type CatchUpPolicy string
+
+const (
+	CatchUpPolicySkip    CatchUpPolicy = "skip"
+	CatchUpPolicyRunOnce CatchUpPolicy = "run-once"
+	CatchUpPolicyRunAll  CatchUpPolicy = "run-all"
+)
+
+func (e *CatchUpPolicy) String() string {
+	return string(*e)
+}
+
+func (e *CatchUpPolicy) Set(v string) error {
+	switch CatchUpPolicy(strings.ToLower(strings.TrimSpace(v))) {
+	case CatchUpPolicySkip, CatchUpPolicyRunOnce, CatchUpPolicyRunAll:
+		*e = CatchUpPolicy(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "skip","run-once","run-all"`)
+	}
+}
+
+func (e *CatchUpPolicy) Type() string {
+	return "policy"
+}
+
+func (e *CatchUpPolicy) ToAPI() v1.ScheduleCatchUpPolicy {
+	switch *e {
+	case CatchUpPolicySkip:
+		return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_SKIP
+	case CatchUpPolicyRunOnce:
+		return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE
+	case CatchUpPolicyRunAll:
+		return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ALL
+	default:
+		return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_UNSPECIFIED
+	}
+}
+
+func ConvertCatchUpPolicyFromAPI(policy v1.ScheduleCatchUpPolicy) CatchUpPolicy {
+	switch policy {
+	case v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_SKIP:
+		return CatchUpPolicySkip
+	case v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE:
+		return CatchUpPolicyRunOnce
+	case v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ALL:
+		return CatchUpPolicyRunAll
+	default:
+		return ""
+	}
+}
+
+func getScheduleID(ctx context.Context, client *api.Client, idOrName string) (string, error) {
+	_, err := uuid.Parse(idOrName)
+	if err == nil {
+		return idOrName, nil
+	}
+
+	scheduleResp, err := client.Schedule().ListSchedules(ctx, &connect.Request[v1.ListSchedulesRequest]{
+		Msg: &v1.ListSchedulesRequest{
+			Filter: &v1.ListSchedulesRequest_Filter{
+				Names: []string{idOrName},
+			},
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	if len(scheduleResp.Msg.Schedules) == 0 {
+		return "", fmt.Errorf("schedule %s not found", idOrName)
+	}
+
+	if len(scheduleResp.Msg.Schedules) > 1 {
+		return "", fmt.Errorf("multiple schedules found for %s", idOrName)
+	}
+
+	return scheduleResp.Msg.Schedules[0].Metadata.Id, nil
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type scheduleCreateOptions struct {
+	Cron          string
+	Agent         string
+	Workspace     string
+	Prompt        string
+	PromptFile    string
+	OverlapPolicy OverlapPolicy
+	CatchUpPolicy CatchUpPolicy
+}
+
+func NewScheduleCreateCmd() *cobra.Command {
+	options := scheduleCreateOptions{
+		OverlapPolicy: OverlapPolicySkip,
+		CatchUpPolicy: CatchUpPolicyRunOnce,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: "Create a schedule that starts tasks at recurring times",
+		Args:  cobra.ExactArgs(1),
+		Long: `Create a schedule that starts tasks at recurring times.
+
+Every time the cron expression fires, the daemon creates a new task for the agent 
+in the given workspace and sends the rendered prompt template as the first message.
+The prompt is a Go template with access to {{.ScheduleName}}, {{.ScheduledTime}}, 
+{{.Trigger}} and {{.Workspace}}.
+
+The overlap policy decides what happens if the previous run is still in progress 
+(allow, skip, replace). The catch-up policy decides what happens with runs that 
+were missed while the daemon was not running (skip, run-once, run-all).`,
+		Example: `  # Review open changes every weekday at 9am
+  construct schedule create morning-review --cron "0 9 * * 1-5" \
+    --agent reviewer --workspace . \
+    --prompt "Review the changes committed since yesterday"
+
+  # Run a nightly maintenance task in a specific timezone, catching up all missed runs
+  construct schedule create nightly --cron "CRON_TZ=Europe/Vienna 0 2 * * *" \
+    --agent coder --prompt-file ./prompts/nightly.md --catch-up run-all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			fs := getFileSystem(cmd.Context())
+
+			prompt, err := getPromptTemplate(options.Prompt, options.PromptFile, fs)
+			if err != nil {
+				return err
+			}
+
+			if options.Workspace != "" {
+				exists, err := afero.Exists(fs, options.Workspace)
+				if err != nil {
+					return fmt.Errorf("failed to check if workspace directory %s exists: %w", options.Workspace, err)
+				}
+				if !exists {
+					return fmt.Errorf("workspace directory %s does not exist", options.Workspace)
+				}
+
+				absPath, err := filepath.Abs(options.Workspace)
+				if err != nil {
+					return fmt.Errorf("failed to get absolute path of workspace directory %s: %w", options.Workspace, err)
+				}
+				options.Workspace = absPath
+			}
+
+			agentID, err := getAgentID(cmd.Context(), client, options.Agent)
+			if err != nil {
+				return fmt.Errorf("failed to resolve agent %s: %w", options.Agent, err)
+			}
+
+			resp, err := client.Schedule().CreateSchedule(cmd.Context(), &connect.Request[v1.CreateScheduleRequest]{
+				Msg: &v1.CreateScheduleRequest{
+					Name:           args[0],
+					CronExpression: options.Cron,
+					AgentId:        agentID,
+					Workspace:      options.Workspace,
+					PromptTemplate: prompt,
+					OverlapPolicy:  options.OverlapPolicy.ToAPI(),
+					CatchUpPolicy:  options.CatchUpPolicy.ToAPI(),
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create schedule: %w", err)
+			}
+
+			cmd.Println(resp.Msg.Schedule.Metadata.Id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Cron, "cron", "c", "", "The cron expression that decides when the schedule fires (required)")
+	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "The agent that executes the tasks (required)")
+	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "The workspace directory for the tasks")
+	cmd.Flags().StringVarP(&options.Prompt, "prompt", "p", "", "The prompt template sent as the first message of every task")
+	cmd.Flags().StringVar(&options.PromptFile, "prompt-file", "", "Read the prompt template from a file")
+	cmd.Flags().Var(&options.OverlapPolicy, "overlap", "What to do if the previous run is still in progress (allow, skip, replace)")
+	cmd.Flags().Var(&options.CatchUpPolicy, "catch-up", "What to do with runs missed while the daemon was down (skip, run-once, run-all)")
+
+	cmd.MarkFlagRequired("cron")
+	cmd.MarkFlagRequired("agent")
+	cmd.MarkFlagsMutuallyExclusive("prompt", "prompt-file")
+
+	return cmd
+}
+
+func getPromptTemplate(prompt, promptFile string, fs *afero.Afero) (string, error) {
+	if prompt != "" {
+		return prompt, nil
+	}
+
+	if promptFile != "" {
+		content, err := fs.ReadFile(promptFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read prompt file %s: %w", promptFile, err)
+		}
+		if len(content) == 0 {
+			return "", fmt.Errorf("prompt file %s is empty", promptFile)
+		}
+		return string(content), nil
+	}
+
+	return "", fmt.Errorf("prompt is required (use --prompt or --prompt-file)")
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduleCreate(t *testing.T) {
+	setup := &TestSetup{}
+
+	scheduleID := uuid.NewString()
+	agentID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - create schedule with default policies",
+			Command: []string{"schedule", "create", "nightly", "--cron", "0 2 * * *", "--agent", "coder", "--prompt", "Run the nightly checks"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupAgentLookupForTaskCreateMock(mockClient, "coder", agentID)
+				setupScheduleCreateMock(mockClient, &v1.CreateScheduleRequest{
+					Name:           "nightly",
+					CronExpression: "0 2 * * *",
+					AgentId:        agentID,
+					PromptTemplate: "Run the nightly checks",
+					OverlapPolicy:  v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP,
+					CatchUpPolicy:  v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE,
+				}, scheduleID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(scheduleID)),
+			},
+		},
+		{
+			Name: "success - create schedule with prompt file, workspace and policies",
+			Command: []string{"schedule", "create", "nightly", "-c", "@daily", "-a", agentID, "-w", "/path/to/repo",
+				"--prompt-file", "/prompts/nightly.md", "--overlap", "replace", "--catch-up", "run-all"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleCreateMock(mockClient, &v1.CreateScheduleRequest{
+					Name:           "nightly",
+					CronExpression: "@daily",
+					AgentId:        agentID,
+					Workspace:      "/path/to/repo",
+					PromptTemplate: "Summarize {{.ScheduledTime}}",
+					OverlapPolicy:  v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_REPLACE,
+					CatchUpPolicy:  v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ALL,
+				}, scheduleID)
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/path/to/repo", 0755)
+				fs.WriteFile("/prompts/nightly.md", []byte("Summarize {{.ScheduledTime}}"), 0644)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(scheduleID)),
+			},
+		},
+		{
+			Name:    "error - cron not provided",
+			Command: []string{"schedule", "create", "nightly", "--agent", "coder", "--prompt", "Run"},
+			Expected: TestExpectation{
+				Error: "required flag(s) \"cron\" not set",
+			},
+		},
+		{
+			Name:    "error - prompt not provided",
+			Command: []string{"schedule", "create", "nightly", "--cron", "@hourly", "--agent", "coder"},
+			Expected: TestExpectation{
+				Error: "prompt is required (use --prompt or --prompt-file)",
+			},
+		},
+		{
+			Name:    "error - invalid overlap policy",
+			Command: []string{"schedule", "create", "nightly", "--cron", "@hourly", "--agent", "coder", "--prompt", "Run", "--overlap", "queue"},
+			Expected: TestExpectation{
+				Error: "invalid argument \"queue\" for \"--overlap\" flag: must be one of \"allow\",\"skip\",\"replace\"",
+			},
+		},
+		{
+			Name:    "error - create schedule API failure",
+			Command: []string{"schedule", "create", "nightly", "--cron", "every night", "--agent", agentID, "--prompt", "Run"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cron expression")))
+			},
+			Expected: TestExpectation{
+				Error: "failed to create schedule: invalid_argument: invalid cron expression",
+			},
+		},
+	})
+}
+
+func setupScheduleCreateMock(mockClient *api_client.MockClient, req *v1.CreateScheduleRequest, scheduleID string) {
+	mockClient.Schedule.EXPECT().CreateSchedule(
+		gomock.Any(),
+		CmpEqual(&connect.Request[v1.CreateScheduleRequest]{
+			Msg: req,
+		}, protocmp.Transform(),
+			cmpopts.IgnoreUnexported(connect.Request[v1.CreateScheduleRequest]{}),
+		),
+	).Return(&connect.Response[v1.CreateScheduleResponse]{
+		Msg: &v1.CreateScheduleResponse{
+			Schedule: &v1.Schedule{
+				Metadata: &v1.ScheduleMetadata{
+					Id:        scheduleID,
+					CreatedAt: timestamppb.Now(),
+					UpdatedAt: timestamppb.Now(),
+				},
+				Spec: &v1.ScheduleSpec{
+					Name: req.Name,
+				},
+			},
+		},
+	}, nil)
+}
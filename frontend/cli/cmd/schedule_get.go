@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type scheduleGetOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewScheduleGetCmd() *cobra.Command {
+	var options scheduleGetOptions
+
+	cmd := &cobra.Command{
+		Use:   "get <name|id> [flags]",
+		Short: "Inspect the details of a specific schedule",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Get details for the 'nightly' schedule
+  construct schedule get nightly
+
+  # Get details and format as YAML
+  construct schedule get nightly --output yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			scheduleID, err := getScheduleID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve schedule %s: %w", idOrName, err)
+			}
+
+			resp, err := client.Schedule().GetSchedule(cmd.Context(), &connect.Request[v1.GetScheduleRequest]{
+				Msg: &v1.GetScheduleRequest{Id: scheduleID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get schedule %s: %w", idOrName, err)
+			}
+
+			return getRenderer(cmd.Context()).Render(ConvertScheduleToDisplay(resp.Msg.Schedule), &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/frontend/cli/pkg/fail"
+	"github.com/spf13/cobra"
+)
+
+type scheduleListOptions struct {
+	Agent         string
+	Paused        bool
+	Limit         int32
+	RenderOptions RenderOptions
+}
+
+func NewScheduleListCmd() *cobra.Command {
+	var options scheduleListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List all schedules",
+		Aliases: []string{"ls"},
+		Example: `  # List all schedules
+  construct schedule list
+
+  # List all paused schedules of the 'coder' agent
+  construct schedule ls --agent coder --paused`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			schedules, err := scheduleList(cmd, options, client)
+			if err != nil {
+				return fail.HandleError(cmd, err)
+			}
+
+			return getRenderer(cmd.Context()).Render(schedules, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "Show only schedules of this agent")
+	cmd.Flags().BoolVar(&options.Paused, "paused", false, "Show only paused schedules")
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "l", 0, "Limit the number of results returned")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
+
+func scheduleList(cmd *cobra.Command, options scheduleListOptions, client *api.Client) ([]*DisplaySchedule, error) {
+	ctx := cmd.Context()
+	filter := &v1.ListSchedulesRequest_Filter{}
+
+	if options.Agent != "" {
+		agentID, err := getAgentID(ctx, client, options.Agent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve agent %s: %w", options.Agent, err)
+		}
+		filter.AgentId = &agentID
+	}
+
+	if cmd.Flags().Changed("paused") {
+		enabled := !options.Paused
+		filter.Enabled = &enabled
+	}
+
+	req := &v1.ListSchedulesRequest{
+		Filter: filter,
+	}
+	if options.Limit > 0 {
+		req.PageSize = &options.Limit
+	}
+
+	resp, err := client.Schedule().ListSchedules(ctx, &connect.Request[v1.ListSchedulesRequest]{
+		Msg: req,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	displaySchedules := make([]*DisplaySchedule, len(resp.Msg.Schedules))
+	for i, schedule := range resp.Msg.Schedules {
+		displaySchedules[i] = ConvertScheduleToDisplay(schedule)
+	}
+
+	return displaySchedules, nil
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduleList(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(DisplaySchedule{}, "NextRun"),
+		},
+	}
+
+	scheduleID1 := uuid.NewString()
+	scheduleID2 := uuid.NewString()
+	agentID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - list all schedules",
+			Command: []string{"schedule", "list"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleListMock(mockClient, &v1.ListSchedulesRequest_Filter{}, []*v1.Schedule{
+					createTestSchedule(scheduleID1, "nightly", "0 2 * * *", agentID, true),
+					createTestSchedule(scheduleID2, "weekly", "@weekly", agentID, false),
+				})
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplaySchedule{
+					{
+						ID:             scheduleID1,
+						Name:           "nightly",
+						CronExpression: "0 2 * * *",
+						AgentID:        agentID,
+						PromptTemplate: "Run the checks",
+						OverlapPolicy:  "skip",
+						CatchUpPolicy:  "run-once",
+						Enabled:        true,
+					},
+					{
+						ID:             scheduleID2,
+						Name:           "weekly",
+						CronExpression: "@weekly",
+						AgentID:        agentID,
+						PromptTemplate: "Run the checks",
+						OverlapPolicy:  "skip",
+						CatchUpPolicy:  "run-once",
+						Enabled:        false,
+					},
+				},
+			},
+		},
+		{
+			Name:    "success - list paused schedules of an agent",
+			Command: []string{"schedule", "list", "--agent", agentID, "--paused"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleListMock(mockClient, &v1.ListSchedulesRequest_Filter{
+					AgentId: &agentID,
+					Enabled: api_client.Ptr(false),
+				}, []*v1.Schedule{
+					createTestSchedule(scheduleID2, "weekly", "@weekly", agentID, false),
+				})
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplaySchedule{
+					{
+						ID:             scheduleID2,
+						Name:           "weekly",
+						CronExpression: "@weekly",
+						AgentID:        agentID,
+						PromptTemplate: "Run the checks",
+						OverlapPolicy:  "skip",
+						CatchUpPolicy:  "run-once",
+						Enabled:        false,
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - list schedules API failure",
+			Command: []string{"schedule", "list"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().ListSchedules(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInternal, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to list schedules: internal",
+			},
+		},
+	})
+}
+
+func setupScheduleListMock(mockClient *api_client.MockClient, filter *v1.ListSchedulesRequest_Filter, schedules []*v1.Schedule) {
+	mockClient.Schedule.EXPECT().ListSchedules(
+		gomock.Any(),
+		CmpEqual(&connect.Request[v1.ListSchedulesRequest]{
+			Msg: &v1.ListSchedulesRequest{
+				Filter: filter,
+			},
+		}, protocmp.Transform(),
+			cmpopts.IgnoreUnexported(connect.Request[v1.ListSchedulesRequest]{}),
+		),
+	).Return(&connect.Response[v1.ListSchedulesResponse]{
+		Msg: &v1.ListSchedulesResponse{
+			Schedules: schedules,
+		},
+	}, nil)
+}
+
+func setupScheduleLookupMock(mockClient *api_client.MockClient, name, scheduleID string) {
+	setupScheduleListMock(mockClient, &v1.ListSchedulesRequest_Filter{
+		Names: []string{name},
+	}, []*v1.Schedule{
+		createTestSchedule(scheduleID, name, "@daily", uuid.NewString(), true),
+	})
+}
+
+func createTestSchedule(scheduleID, name, cronExpression, agentID string, enabled bool) *v1.Schedule {
+	schedule := &v1.Schedule{
+		Metadata: &v1.ScheduleMetadata{
+			Id:        scheduleID,
+			CreatedAt: timestamppb.Now(),
+			UpdatedAt: timestamppb.Now(),
+		},
+		Spec: &v1.ScheduleSpec{
+			Name:           name,
+			CronExpression: cronExpression,
+			AgentId:        agentID,
+			PromptTemplate: "Run the checks",
+			OverlapPolicy:  v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP,
+			CatchUpPolicy:  v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_RUN_ONCE,
+			Enabled:        enabled,
+		},
+		Status: &v1.ScheduleStatus{},
+	}
+
+	if enabled {
+		schedule.Status.NextRunTime = timestamppb.New(time.Now().Add(time.Hour))
+	}
+
+	return schedule
+}
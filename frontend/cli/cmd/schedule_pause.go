@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewSchedulePauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause <name|id>... [flags]",
+		Short: "Stop one or more schedules from firing",
+		Long: `Stop one or more schedules from firing.
+
+A paused schedule does not start any tasks until it is resumed. Runs that would have
+fired while the schedule was paused are not caught up. Paused schedules can still be
+started manually with construct schedule trigger.`,
+		Example: `  # Pause the nightly schedule
+  construct schedule pause nightly`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setScheduleEnabled(cmd, args, false)
+		},
+	}
+
+	return cmd
+}
+
+func NewScheduleResumeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume <name|id>... [flags]",
+		Short: "Resume one or more paused schedules",
+		Example: `  # Resume the nightly schedule
+  construct schedule resume nightly`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setScheduleEnabled(cmd, args, true)
+		},
+	}
+
+	return cmd
+}
+
+func setScheduleEnabled(cmd *cobra.Command, args []string, enabled bool) error {
+	client := getAPIClient(cmd.Context())
+
+	for _, idOrName := range args {
+		scheduleID, err := getScheduleID(cmd.Context(), client, idOrName)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Schedule().UpdateSchedule(cmd.Context(), &connect.Request[v1.UpdateScheduleRequest]{
+			Msg: &v1.UpdateScheduleRequest{
+				Id:      scheduleID,
+				Enabled: &enabled,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update schedule %s: %w", idOrName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestSchedulePause(t *testing.T) {
+	setup := &TestSetup{}
+
+	scheduleID1 := uuid.NewString()
+	scheduleID2 := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - pause multiple schedules",
+			Command: []string{"schedule", "pause", "nightly", scheduleID2},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleLookupMock(mockClient, "nightly", scheduleID1)
+				setupScheduleEnabledMock(mockClient, scheduleID1, false)
+				setupScheduleEnabledMock(mockClient, scheduleID2, false)
+			},
+			Expected: TestExpectation{},
+		},
+		{
+			Name:    "success - resume schedule",
+			Command: []string{"schedule", "resume", scheduleID1},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleEnabledMock(mockClient, scheduleID1, true)
+			},
+			Expected: TestExpectation{},
+		},
+		{
+			Name:    "error - update schedule API failure",
+			Command: []string{"schedule", "pause", scheduleID1},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().UpdateSchedule(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeNotFound, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to update schedule " + scheduleID1 + ": not_found",
+			},
+		},
+	})
+}
+
+func setupScheduleEnabledMock(mockClient *api_client.MockClient, scheduleID string, enabled bool) {
+	mockClient.Schedule.EXPECT().UpdateSchedule(
+		gomock.Any(),
+		CmpEqual(&connect.Request[v1.UpdateScheduleRequest]{
+			Msg: &v1.UpdateScheduleRequest{
+				Id:      scheduleID,
+				Enabled: &enabled,
+			},
+		}, protocmp.Transform(),
+			cmpopts.IgnoreUnexported(connect.Request[v1.UpdateScheduleRequest]{}),
+		),
+	).Return(&connect.Response[v1.UpdateScheduleResponse]{
+		Msg: &v1.UpdateScheduleResponse{},
+	}, nil)
+}
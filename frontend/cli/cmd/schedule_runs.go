@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type scheduleRunsOptions struct {
+	Limit         int32
+	RenderOptions RenderOptions
+}
+
+func NewScheduleRunsCmd() *cobra.Command {
+	var options scheduleRunsOptions
+
+	cmd := &cobra.Command{
+		Use:   "runs <name|id> [flags]",
+		Short: "Show the run history of a schedule",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Show the run history of the nightly schedule
+  construct schedule runs nightly
+
+  # Show the last five runs as JSON
+  construct schedule runs nightly --limit 5 --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			scheduleID, err := getScheduleID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return err
+			}
+
+			req := &v1.ListScheduleRunsRequest{
+				ScheduleId: scheduleID,
+			}
+			if options.Limit > 0 {
+				req.PageSize = &options.Limit
+			}
+
+			resp, err := client.Schedule().ListScheduleRuns(cmd.Context(), &connect.Request[v1.ListScheduleRunsRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list runs of schedule %s: %w", idOrName, err)
+			}
+
+			displayRuns := make([]*DisplayScheduleRun, len(resp.Msg.Runs))
+			for i, run := range resp.Msg.Runs {
+				displayRuns[i] = ConvertScheduleRunToDisplay(run)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayRuns, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "l", 0, "Limit the number of runs returned")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewScheduleTriggerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger <name|id> [flags]",
+		Short: "Start a run of a schedule immediately",
+		Long: `Start a run of a schedule immediately.
+
+The run is started independent of the cron expression and also works for paused 
+schedules. The overlap policy of the schedule still applies. On success the ID of 
+the started task is printed.`,
+		Example: `  # Run the nightly schedule now
+  construct schedule trigger nightly`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			scheduleID, err := getScheduleID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Schedule().TriggerSchedule(cmd.Context(), &connect.Request[v1.TriggerScheduleRequest]{
+				Msg: &v1.TriggerScheduleRequest{Id: scheduleID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to trigger schedule %s: %w", idOrName, err)
+			}
+
+			run := resp.Msg.Run
+			if run.Status != v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED {
+				return fmt.Errorf("schedule %s did not start a task: %s", idOrName, run.Error)
+			}
+
+			cmd.Println(PtrToString(run.TaskId))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduleTrigger(t *testing.T) {
+	setup := &TestSetup{}
+
+	scheduleID := uuid.NewString()
+	taskID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - trigger schedule by name",
+			Command: []string{"schedule", "trigger", "nightly"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleLookupMock(mockClient, "nightly", scheduleID)
+				setupScheduleTriggerMock(mockClient, scheduleID, &v1.ScheduleRun{
+					Id:            uuid.NewString(),
+					ScheduleId:    scheduleID,
+					TaskId:        &taskID,
+					Trigger:       v1.ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_MANUAL,
+					Status:        v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED,
+					ScheduledTime: timestamppb.Now(),
+					CreatedAt:     timestamppb.Now(),
+				})
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(taskID)),
+			},
+		},
+		{
+			Name:    "error - run skipped because of overlap policy",
+			Command: []string{"schedule", "trigger", scheduleID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleTriggerMock(mockClient, scheduleID, &v1.ScheduleRun{
+					Id:            uuid.NewString(),
+					ScheduleId:    scheduleID,
+					Trigger:       v1.ScheduleRunTrigger_SCHEDULE_RUN_TRIGGER_MANUAL,
+					Status:        v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED,
+					ScheduledTime: timestamppb.Now(),
+					CreatedAt:     timestamppb.Now(),
+					Error:         "previous run is still in progress",
+				})
+			},
+			Expected: TestExpectation{
+				Error: fmt.Sprintf("schedule %s did not start a task: previous run is still in progress", scheduleID),
+			},
+		},
+		{
+			Name:    "error - schedule not found",
+			Command: []string{"schedule", "trigger", "nightly"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleListMock(mockClient, &v1.ListSchedulesRequest_Filter{
+					Names: []string{"nightly"},
+				}, []*v1.Schedule{})
+			},
+			Expected: TestExpectation{
+				Error: "schedule nightly not found",
+			},
+		},
+	})
+}
+
+func setupScheduleTriggerMock(mockClient *api_client.MockClient, scheduleID string, run *v1.ScheduleRun) {
+	mockClient.Schedule.EXPECT().TriggerSchedule(
+		gomock.Any(),
+		&connect.Request[v1.TriggerScheduleRequest]{
+			Msg: &v1.TriggerScheduleRequest{Id: scheduleID},
+		},
+	).Return(&connect.Response[v1.TriggerScheduleResponse]{
+		Msg: &v1.TriggerScheduleResponse{
+			Run: run,
+		},
+	}, nil)
+}
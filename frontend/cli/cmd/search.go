@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/frontend/cli/pkg/fail"
+	"github.com/spf13/cobra"
+)
+
+type searchOptions struct {
+	Limit         int32
+	Resume        bool
+	Agent         string
+	RenderOptions RenderOptions
+}
+
+type DisplaySearchResult struct {
+	TaskID    string    `json:"task_id" yaml:"task_id" detail:"default"`
+	Task      string    `json:"task" yaml:"task" detail:"default"`
+	Match     string    `json:"match" yaml:"match" detail:"default"`
+	Snippet   string    `json:"snippet" yaml:"snippet" detail:"default"`
+	MessageID string    `json:"message_id,omitempty" yaml:"message_id,omitempty" detail:"full"`
+	Time      time.Time `json:"time" yaml:"time" detail:"full"`
+}
+
+func NewSearchCmd() *cobra.Command {
+	options := searchOptions{
+		Limit: 20,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "search <query> [flags]",
+		Short: "Search the history of all tasks",
+		Long: `Search the history of all tasks.
+
+Finds the tasks whose description, messages or tool calls contain every word of the
+query, newest first. Words match case-insensitively at the start of a word, so
+"pars" finds "parser" and "parse_test.go".
+
+Use --resume to continue the task of the newest match right away.`,
+		Args: cobra.ExactArgs(1),
+		Example: `  # Find the conversations about the parser
+  construct search "parser tests"
+
+  # Continue the task where the lexer was last discussed
+  construct search lexer --resume
+
+  # Print all matches as JSON
+  construct search "rate limit" --limit 100 --output json`,
+		GroupID: "core",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			resp, err := client.Search().Search(cmd.Context(), &connect.Request[v1.SearchRequest]{
+				Msg: &v1.SearchRequest{
+					Query:    args[0],
+					PageSize: &options.Limit,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to search for %q: %w", args[0], err)
+			}
+
+			if options.Resume {
+				if len(resp.Msg.Results) == 0 {
+					return fmt.Errorf("no task matches %q", args[0])
+				}
+				return fail.HandleError(cmd, resumeTaskByID(cmd.Context(), client, resp.Msg.Results[0].TaskId, options.Agent))
+			}
+
+			displayResults := make([]*DisplaySearchResult, len(resp.Msg.Results))
+			for i, result := range resp.Msg.Results {
+				displayResults[i] = ConvertSearchResultToDisplay(result)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayResults, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "l", options.Limit, "Maximum number of matches to show (1-100)")
+	cmd.Flags().BoolVarP(&options.Resume, "resume", "r", false, "Resume the task of the newest match instead of listing the matches")
+	cmd.Flags().StringVar(&options.Agent, "agent", "", "Resume the task with a specific agent")
+	addRenderOptions(cmd, &options.RenderOptions)
+	return cmd
+}
+
+func ConvertSearchResultToDisplay(result *v1.SearchResult) *DisplaySearchResult {
+	return &DisplaySearchResult{
+		TaskID:    result.TaskId,
+		Task:      result.TaskDescription,
+		Match:     convertSearchMatchToString(result),
+		Snippet:   result.Snippet.GetText(),
+		MessageID: PtrToString(result.MessageId),
+		Time:      result.Time.AsTime(),
+	}
+}
+
+func convertSearchMatchToString(result *v1.SearchResult) string {
+	switch result.Kind {
+	case v1.SearchMatchKind_SEARCH_MATCH_KIND_TITLE:
+		return "title"
+	case v1.SearchMatchKind_SEARCH_MATCH_KIND_TOOL_CALL:
+		return "tool call"
+	case v1.SearchMatchKind_SEARCH_MATCH_KIND_MESSAGE:
+		if result.Role != nil {
+			return ConvertMessageRoleToString(*result.Role) + " message"
+		}
+		return "message"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSearch(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.New().String()
+	messageID := uuid.New().String()
+	updatedAt := time.Now()
+	createdAt := updatedAt.Add(-time.Hour)
+
+	results := []*v1.SearchResult{
+		{
+			TaskId:          taskID,
+			TaskDescription: "Refactor the parser",
+			Kind:            v1.SearchMatchKind_SEARCH_MATCH_KIND_TITLE,
+			Snippet:         &v1.SearchSnippet{Text: "Refactor the parser", Highlights: []*v1.SearchHighlight{{Start: 13, End: 19}}},
+			Time:            timestamppb.New(updatedAt),
+		},
+		{
+			TaskId:          taskID,
+			TaskDescription: "Refactor the parser",
+			MessageId:       api_client.Ptr(messageID),
+			Role:            api_client.Ptr(v1.MessageRole_MESSAGE_ROLE_USER),
+			Kind:            v1.SearchMatchKind_SEARCH_MATCH_KIND_MESSAGE,
+			Snippet:         &v1.SearchSnippet{Text: "Please fix the failing parser tests", Highlights: []*v1.SearchHighlight{{Start: 23, End: 29}}},
+			Time:            timestamppb.New(createdAt),
+		},
+	}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - search",
+			Command: []string{"search", "parser"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupSearchMock(mockClient, "parser", 20, results, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplaySearchResult{
+					{
+						TaskID:  taskID,
+						Task:    "Refactor the parser",
+						Match:   "title",
+						Snippet: "Refactor the parser",
+						Time:    updatedAt,
+					},
+					{
+						TaskID:    taskID,
+						Task:      "Refactor the parser",
+						Match:     "user message",
+						Snippet:   "Please fix the failing parser tests",
+						MessageID: messageID,
+						Time:      createdAt,
+					},
+				},
+			},
+		},
+		{
+			Name:    "success - search with limit",
+			Command: []string{"search", "parser tests", "--limit", "1"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupSearchMock(mockClient, "parser tests", 1, results[1:], nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplaySearchResult{
+					{
+						TaskID:    taskID,
+						Task:      "Refactor the parser",
+						Match:     "user message",
+						Snippet:   "Please fix the failing parser tests",
+						MessageID: messageID,
+						Time:      createdAt,
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - nothing to resume",
+			Command: []string{"search", "lexer", "--resume"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupSearchMock(mockClient, "lexer", 20, nil, nil)
+			},
+			Expected: TestExpectation{
+				Error: `no task matches "lexer"`,
+			},
+		},
+		{
+			Name:    "error - search failed",
+			Command: []string{"search", "!!!"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupSearchMock(mockClient, "!!!", 20, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("query has no words to search for")))
+			},
+			Expected: TestExpectation{
+				Error: `failed to search for "!!!": invalid_argument: query has no words to search for`,
+			},
+		},
+	})
+}
+
+func setupSearchMock(mockClient *api_client.MockClient, query string, pageSize int32, results []*v1.SearchResult, err error) {
+	mockClient.Search.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+			if req.Msg.Query != query || req.Msg.GetPageSize() != pageSize {
+				return nil, fmt.Errorf("unexpected request: query %q, page size %d", req.Msg.Query, req.Msg.GetPageSize())
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &connect.Response[v1.SearchResponse]{
+				Msg: &v1.SearchResponse{Results: results},
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewSecretCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Manage the encryption of stored credentials",
+		Long: `Manage the encryption of stored credentials.
+
+The credentials of model providers, webhook triggers and notification sinks are
+encrypted in the database with a keyset that is kept by the secret provider, selected
+by the secret.provider setting.`,
+		Aliases: []string{"secrets"},
+		GroupID: "system",
+	}
+
+	cmd.AddCommand(NewSecretRotateCmd())
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type secretRotateOptions struct {
+	DryRun        bool
+	RenderOptions RenderOptions
+}
+
+type DisplayKeyRotation struct {
+	PrimaryKey        uint32 `json:"primary_key" yaml:"primary_key" detail:"default"`
+	DisabledKeys      string `json:"disabled_keys" yaml:"disabled_keys" detail:"default"`
+	ModelProviders    int32  `json:"model_providers" yaml:"model_providers" detail:"default"`
+	WebhookTriggers   int32  `json:"webhook_triggers" yaml:"webhook_triggers" detail:"default"`
+	NotificationSinks int32  `json:"notification_sinks" yaml:"notification_sinks" detail:"default"`
+	DryRun            bool   `json:"dry_run" yaml:"dry_run" detail:"default"`
+}
+
+func NewSecretRotateCmd() *cobra.Command {
+	var options secretRotateOptions
+
+	cmd := &cobra.Command{
+		Use:   "rotate [flags]",
+		Short: "Rotate the key that encrypts stored credentials",
+		Long: `Rotate the key that encrypts stored credentials.
+
+Adds a new primary key to the keyset, re-encrypts the credentials of all model
+providers, webhook triggers and notification sinks with it in a single transaction
+and then disables the previous keys. The daemon uses the new key right away. If any
+credential cannot be re-encrypted, the database is left unchanged.
+
+With --dry-run every credential is re-encrypted in a transaction that is rolled back,
+which shows whether the rotation would succeed without changing anything. Rotation
+covers the credentials of all users, so it is only allowed over the Unix socket.`,
+		Example: `  # Check that all credentials can be re-encrypted
+  construct secret rotate --dry-run
+
+  # Rotate the key
+  construct secret rotate`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			resp, err := client.Secret().RotateEncryptionKey(cmd.Context(), connect.NewRequest(&v1.RotateEncryptionKeyRequest{
+				DryRun: options.DryRun,
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to rotate encryption key: %w", err)
+			}
+
+			disabled := make([]string, len(resp.Msg.DisabledKeyIds))
+			for i, id := range resp.Msg.DisabledKeyIds {
+				disabled[i] = strconv.FormatUint(uint64(id), 10)
+			}
+
+			return getRenderer(cmd.Context()).Render(&DisplayKeyRotation{
+				PrimaryKey:        resp.Msg.PrimaryKeyId,
+				DisabledKeys:      strings.Join(disabled, ","),
+				ModelProviders:    resp.Msg.ModelProviders,
+				WebhookTriggers:   resp.Msg.WebhookTriggers,
+				NotificationSinks: resp.Msg.NotificationSinks,
+				DryRun:            resp.Msg.DryRun,
+			}, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "Check that all credentials can be re-encrypted without changing anything")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSecretRotate(t *testing.T) {
+	setup := &TestSetup{}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success",
+			Command: []string{"secret", "rotate"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Secret.EXPECT().RotateEncryptionKey(
+					gomock.Any(),
+					connect.NewRequest(&v1.RotateEncryptionKeyRequest{}),
+				).Return(connect.NewResponse(&v1.RotateEncryptionKeyResponse{
+					PrimaryKeyId:      3301495720,
+					DisabledKeyIds:    []uint32{1021504771, 2790154394},
+					ModelProviders:    2,
+					NotificationSinks: 1,
+				}), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &DisplayKeyRotation{
+					PrimaryKey:        3301495720,
+					DisabledKeys:      "1021504771,2790154394",
+					ModelProviders:    2,
+					NotificationSinks: 1,
+				},
+			},
+		},
+		{
+			Name:    "success with dry run",
+			Command: []string{"secret", "rotate", "--dry-run"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Secret.EXPECT().RotateEncryptionKey(
+					gomock.Any(),
+					connect.NewRequest(&v1.RotateEncryptionKeyRequest{DryRun: true}),
+				).Return(connect.NewResponse(&v1.RotateEncryptionKeyResponse{
+					PrimaryKeyId:    3301495720,
+					DisabledKeyIds:  []uint32{1021504771},
+					WebhookTriggers: 1,
+					DryRun:          true,
+				}), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &DisplayKeyRotation{
+					PrimaryKey:      3301495720,
+					DisabledKeys:    "1021504771",
+					WebhookTriggers: 1,
+					DryRun:          true,
+				},
+			},
+		},
+		{
+			Name:    "error - not allowed over TCP",
+			Command: []string{"secret", "rotate"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Secret.EXPECT().RotateEncryptionKey(
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil, connect.NewError(connect.CodePermissionDenied, errors.New("rotating the encryption key covers all users and is not allowed for alice")))
+			},
+			Expected: TestExpectation{
+				Error: "failed to rotate encryption key: permission_denied: rotating the encryption key covers all users and is not allowed for alice",
+			},
+		},
+	})
+}
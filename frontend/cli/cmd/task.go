@@ -1,6 +1,11 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	v1 "github.com/furisto/construct/api/go/v1"
@@ -19,6 +24,8 @@ func NewTaskCmd() *cobra.Command {
 	cmd.AddCommand(NewTaskGetCmd())
 	cmd.AddCommand(NewTaskListCmd())
 	cmd.AddCommand(NewTaskDeleteCmd())
+	cmd.AddCommand(NewTaskExportCmd())
+	cmd.AddCommand(NewTaskForkCmd())
 
 	return cmd
 }
@@ -28,6 +35,10 @@ type DisplayTask struct {
 	Description string           `json:"description,omitempty" yaml:"description,omitempty" detail:"default"`
 	AgentId     string           `json:"agent_id" yaml:"agent_id" detail:"default"`
 	Workspace   string           `json:"workspace" yaml:"workspace" detail:"default"`
+	Owner       string           `json:"owner,omitempty" yaml:"owner,omitempty" detail:"full"`
+	ForkedFrom  string           `json:"forked_from,omitempty" yaml:"forked_from,omitempty" detail:"full"`
+	ForkedAt    string           `json:"forked_at,omitempty" yaml:"forked_at,omitempty"`
+	Labels      string           `json:"labels,omitempty" yaml:"labels,omitempty" detail:"full"`
 	CreatedAt   time.Time        `json:"created_at" yaml:"created_at"`
 	UpdatedAt   time.Time        `json:"updated_at" yaml:"updated_at"`
 	Usage       DisplayTaskUsage `json:"usage" yaml:"usage"`
@@ -53,6 +64,10 @@ func ConvertTaskToDisplay(task *v1.Task) *DisplayTask {
 		Description: task.Spec.Description,
 		AgentId:     PtrToString(task.Spec.AgentId),
 		Workspace:   task.Spec.Workspace,
+		Owner:       task.Metadata.Owner,
+		ForkedFrom:  PtrToString(task.Metadata.ForkedFromTaskId),
+		ForkedAt:    PtrToString(task.Metadata.ForkedFromMessageId),
+		Labels:      formatLabels(task.Spec.Labels),
 		Usage:       usage,
 		CreatedAt:   task.Metadata.CreatedAt.AsTime(),
 		UpdatedAt:   task.Metadata.UpdatedAt.AsTime(),
@@ -72,3 +87,64 @@ func ConvertTaskUsageToDisplay(usage *v1.TaskUsage) DisplayTaskUsage {
 		ToolUses:         usage.ToolUses,
 	}
 }
+
+// formatLabels formats labels as a label selector, e.g. "env=ci,team=infra".
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		pairs = append(pairs, key+"="+labels[key])
+	}
+	return strings.Join(pairs, ",")
+}
+
+func parseTaskPhase(phase string) (v1.TaskPhase, error) {
+	switch strings.ToLower(strings.TrimSpace(phase)) {
+	case "awaiting":
+		return v1.TaskPhase_TASK_PHASE_AWAITING, nil
+	case "running":
+		return v1.TaskPhase_TASK_PHASE_RUNNING, nil
+	case "suspended":
+		return v1.TaskPhase_TASK_PHASE_SUSPENDED, nil
+	default:
+		return v1.TaskPhase_TASK_PHASE_UNSPECIFIED, fmt.Errorf(`invalid phase %q: must be one of "awaiting","running","suspended"`, phase)
+	}
+}
+
+type TaskSortField string
+
+const (
+	TaskSortFieldCreated TaskSortField = "created"
+	TaskSortFieldUpdated TaskSortField = "updated"
+	TaskSortFieldCost    TaskSortField = "cost"
+)
+
+func (f *TaskSortField) String() string {
+	return string(*f)
+}
+
+func (f *TaskSortField) Set(v string) error {
+	switch TaskSortField(strings.ToLower(strings.TrimSpace(v))) {
+	case TaskSortFieldCreated, TaskSortFieldUpdated, TaskSortFieldCost:
+		*f = TaskSortField(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "created","updated","cost"`)
+	}
+}
+
+func (f *TaskSortField) Type() string {
+	return "field"
+}
+
+func (f *TaskSortField) ToAPI() v1.SortField {
+	switch *f {
+	case TaskSortFieldCreated:
+		return v1.SortField_SORT_FIELD_CREATED_AT
+	case TaskSortFieldUpdated:
+		return v1.SortField_SORT_FIELD_UPDATED_AT
+	case TaskSortFieldCost:
+		return v1.SortField_SORT_FIELD_COST
+	default:
+		return v1.SortField_SORT_FIELD_UNSPECIFIED
+	}
+}
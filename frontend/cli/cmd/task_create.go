@@ -14,6 +14,7 @@ import (
 type taskCreateOptions struct {
 	Agent     string
 	Workspace string
+	Labels    map[string]string
 }
 
 func NewTaskCreateCmd() *cobra.Command {
@@ -31,7 +32,10 @@ use construct new.`,
   construct task create --agent coder
 
   # Create a task with a specific workspace
-  construct task create --agent sql-expert --workspace /path/to/db/repo`,
+  construct task create --agent sql-expert --workspace /path/to/db/repo
+
+  # Create a labeled task
+  construct task create --agent coder --label env=ci --label team=infra`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := getAPIClient(cmd.Context())
 			fs := getFileSystem(cmd.Context())
@@ -69,6 +73,7 @@ use construct new.`,
 				Msg: &v1.CreateTaskRequest{
 					AgentId:          agentID,
 					ProjectDirectory: options.Workspace,
+					Labels:           options.Labels,
 				},
 			}
 
@@ -84,6 +89,7 @@ use construct new.`,
 
 	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "The agent to assign to the task (required)")
 	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "The workspace directory for the task")
+	cmd.Flags().StringToStringVar(&options.Labels, "label", nil, "Label the task with key=value pairs, comma-separated or repeated")
 
 	cmd.MarkFlagRequired("agent")
 
@@ -8,9 +8,11 @@ import (
 	api_client "github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/shared/conv"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/spf13/afero"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -70,6 +72,28 @@ func TestTaskCreate(t *testing.T) {
 				Stdout: conv.Ptr(fmt.Sprintln(taskID1)),
 			},
 		},
+		{
+			Name:    "success - create task with labels",
+			Command: []string{"task", "create", "--agent", agentID1, "--label", "env=ci,team=infra", "--label", "run=42"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().CreateTask(
+					gomock.Any(),
+					CmpEqual(&connect.Request[v1.CreateTaskRequest]{
+						Msg: &v1.CreateTaskRequest{
+							AgentId: agentID1,
+							Labels:  map[string]string{"env": "ci", "team": "infra", "run": "42"},
+						},
+					}, protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Request[v1.CreateTaskRequest]{})),
+				).Return(&connect.Response[v1.CreateTaskResponse]{
+					Msg: &v1.CreateTaskResponse{
+						Task: &v1.Task{Metadata: &v1.TaskMetadata{Id: taskID1}, Spec: &v1.TaskSpec{}},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(taskID1)),
+			},
+		},
 		{
 			Name:    "error - agent not provided",
 			Command: []string{"task", "create", "-w", "/path/to/repo"},
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type taskExportOptions struct {
+	Format TranscriptFormat
+	File   string
+}
+
+func NewTaskExportCmd() *cobra.Command {
+	options := taskExportOptions{
+		Format: TranscriptFormatMarkdown,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export <task-id> [flags]",
+		Short: "Export the transcript of a task as Markdown, HTML or JSON",
+		Args:  cobra.ExactArgs(1),
+		Long: `Export the transcript of a task as Markdown, HTML or JSON.
+
+The transcript contains the messages of the task, every script the agent ran and
+every tool it called with inputs and outputs, the diffs of edited files, and the
+tokens and cost of each turn. The HTML page is self-contained with collapsible tool
+sections, so it can be attached to pull requests.`,
+		Example: `  # Print the transcript of a task as Markdown
+  construct task export 01974c1d-0be8-70e1-88b4-ad9462fff25e
+
+  # Write the transcript to an HTML page
+  construct task export 01974c1d-0be8-70e1-88b4-ad9462fff25e --format html --file transcript.html`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			taskID := args[0]
+
+			resp, err := client.Task().ExportTranscript(cmd.Context(), connect.NewRequest(&v1.ExportTranscriptRequest{
+				Id:     taskID,
+				Format: options.Format.ToAPI(),
+			}))
+			if err != nil {
+				return fmt.Errorf("failed to export task %s: %w", taskID, err)
+			}
+
+			if options.File == "" {
+				cmd.Print(resp.Msg.Content)
+				return nil
+			}
+
+			err = getFileSystem(cmd.Context()).WriteFile(options.File, []byte(resp.Msg.Content), 0644)
+			if err != nil {
+				return fmt.Errorf("failed to write transcript to %s: %w", options.File, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().VarP(&options.Format, "format", "f", "The format of the transcript (md, html, json)")
+	cmd.Flags().StringVar(&options.File, "file", "", "Write the transcript to a file instead of stdout")
+
+	return cmd
+}
+
+type TranscriptFormat string
+
+const (
+	TranscriptFormatMarkdown TranscriptFormat = "md"
+	TranscriptFormatHTML     TranscriptFormat = "html"
+	TranscriptFormatJSON     TranscriptFormat = "json"
+)
+
+func (e *TranscriptFormat) String() string {
+	return string(*e)
+}
+
+func (e *TranscriptFormat) Set(v string) error {
+	switch TranscriptFormat(strings.ToLower(strings.TrimSpace(v))) {
+	case TranscriptFormatMarkdown, TranscriptFormatHTML, TranscriptFormatJSON:
+		*e = TranscriptFormat(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "md","html","json"`)
+	}
+}
+
+func (e *TranscriptFormat) Type() string {
+	return "format"
+}
+
+func (e *TranscriptFormat) ToAPI() v1.TranscriptFormat {
+	switch *e {
+	case TranscriptFormatHTML:
+		return v1.TranscriptFormat_TRANSCRIPT_FORMAT_HTML
+	case TranscriptFormatJSON:
+		return v1.TranscriptFormat_TRANSCRIPT_FORMAT_JSON
+	default:
+		return v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN
+	}
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskExport(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success as markdown to stdout",
+			Command: []string{"task", "export", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTranscriptExportMock(mockClient, taskID, v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN, "# Fix the greeting\n")
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("# Fix the greeting\n"),
+			},
+		},
+		{
+			Name:    "success as html to file",
+			Command: []string{"task", "export", taskID, "--format", "html", "--file", "transcript.html"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTranscriptExportMock(mockClient, taskID, v1.TranscriptFormat_TRANSCRIPT_FORMAT_HTML, "<!DOCTYPE html>")
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(""),
+			},
+		},
+		{
+			Name:    "error - invalid format",
+			Command: []string{"task", "export", taskID, "--format", "pdf"},
+			Expected: TestExpectation{
+				Error: "invalid argument \"pdf\" for \"-f, --format\" flag: must be one of \"md\",\"html\",\"json\"",
+			},
+		},
+		{
+			Name:    "error - task not found",
+			Command: []string{"task", "export", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().ExportTranscript(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("task not found"))
+			},
+			Expected: TestExpectation{
+				Error: fmt.Sprintf("failed to export task %s: task not found", taskID),
+			},
+		},
+	})
+}
+
+func setupTranscriptExportMock(mockClient *api_client.MockClient, taskID string, format v1.TranscriptFormat, content string) {
+	mockClient.Task.EXPECT().ExportTranscript(
+		gomock.Any(),
+		connect.NewRequest(&v1.ExportTranscriptRequest{Id: taskID, Format: format}),
+	).Return(connect.NewResponse(&v1.ExportTranscriptResponse{Content: content}), nil)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type taskForkOptions struct {
+	At          string
+	Description string
+}
+
+func NewTaskForkCmd() *cobra.Command {
+	var options taskForkOptions
+
+	cmd := &cobra.Command{
+		Use:   "fork <task-id> [flags]",
+		Short: "Continue the conversation of a task in a new task",
+		Long: `Continue the conversation of a task in a new task.
+
+Copies the messages of the task up to and including the message given by --at
+into a new task with the same agent and workspace, so that you can try a different
+instruction without losing the original conversation. Without --at, all messages
+are copied. Files in the workspace are not restored.
+
+Find the IDs of messages with construct message list --task <task-id>, and the
+forks of a task with construct task get <task-id> --branches.`,
+		Args: cobra.ExactArgs(1),
+		Example: `  # Fork a task from one of its messages and continue in the new task
+  construct task fork 01974c1d-0be8-70e1-88b4-ad9462fff25e --at 01974c1f-5ab3-7c62-9d31-4f3be8e9b7a0
+  construct resume <new-task-id>
+
+  # Fork the whole conversation with a new description
+  construct task fork 01974c1d-0be8-70e1-88b4-ad9462fff25e --description "try without generics"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			req := &v1.ForkTaskRequest{
+				Id: args[0],
+			}
+			if options.At != "" {
+				req.MessageId = &options.At
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = &options.Description
+			}
+
+			resp, err := client.Task().ForkTask(cmd.Context(), &connect.Request[v1.ForkTaskRequest]{Msg: req})
+			if err != nil {
+				return fmt.Errorf("failed to fork task %s: %w", args[0], err)
+			}
+
+			cmd.Println(resp.Msg.Task.Metadata.Id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&options.At, "at", "", "The last message to copy into the new task (default: all messages)")
+	cmd.Flags().StringVarP(&options.Description, "description", "d", "", "The description of the new task (default: that of the original task)")
+
+	return cmd
+}
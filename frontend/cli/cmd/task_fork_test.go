@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskFork(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.NewString()
+	forkID := uuid.NewString()
+	messageID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - fork from message",
+			Command: []string{"task", "fork", taskID, "--at", messageID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskForkMock(mockClient, &v1.ForkTaskRequest{
+					Id:        taskID,
+					MessageId: &messageID,
+				}, forkID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(forkID)),
+			},
+		},
+		{
+			Name:    "success - fork whole conversation with description",
+			Command: []string{"task", "fork", taskID, "-d", "try without generics"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskForkMock(mockClient, &v1.ForkTaskRequest{
+					Id:          taskID,
+					Description: conv.Ptr("try without generics"),
+				}, forkID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(forkID)),
+			},
+		},
+		{
+			Name:    "error - message not found",
+			Command: []string{"task", "fork", taskID, "--at", messageID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().ForkTask(
+					gomock.Any(),
+					&connect.Request[v1.ForkTaskRequest]{
+						Msg: &v1.ForkTaskRequest{Id: taskID, MessageId: &messageID},
+					},
+				).Return(nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("message not found")))
+			},
+			Expected: TestExpectation{
+				Error: "failed to fork task " + taskID + ": not_found: message not found",
+			},
+		},
+		{
+			Name:    "error - missing task ID",
+			Command: []string{"task", "fork"},
+			Expected: TestExpectation{
+				Error: "accepts 1 arg(s), received 0",
+			},
+		},
+	})
+}
+
+func setupTaskForkMock(mockClient *api_client.MockClient, req *v1.ForkTaskRequest, forkID string) {
+	mockClient.Task.EXPECT().ForkTask(
+		gomock.Any(),
+		&connect.Request[v1.ForkTaskRequest]{Msg: req},
+	).Return(&connect.Response[v1.ForkTaskResponse]{
+		Msg: &v1.ForkTaskResponse{
+			Task: &v1.Task{
+				Metadata: &v1.TaskMetadata{Id: forkID, ForkedFromTaskId: &req.Id},
+				Spec:     &v1.TaskSpec{},
+			},
+		},
+	}, nil)
+}
@@ -1,14 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/spf13/cobra"
 )
 
 type taskGetOptions struct {
+	Branches      bool
 	RenderOptions RenderOptions
 }
 
@@ -23,7 +26,10 @@ func NewTaskGetCmd() *cobra.Command {
   construct task get 01974c1d-0be8-70e1-88b4-ad9462fff25e
 
   # Get task details and format as YAML
-  construct task get 01974c1d-0be8-70e1-88b4-ad9462fff25e --output yaml`,
+  construct task get 01974c1d-0be8-70e1-88b4-ad9462fff25e --output yaml
+
+  # Show the task it was forked from and all forks of the conversation
+  construct task get 01974c1d-0be8-70e1-88b4-ad9462fff25e --branches`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := getAPIClient(cmd.Context())
 			taskID := args[0]
@@ -37,11 +43,110 @@ func NewTaskGetCmd() *cobra.Command {
 				return fmt.Errorf("failed to get task %s: %w", taskID, err)
 			}
 
+			if options.Branches {
+				branches, err := listTaskBranches(cmd.Context(), client, resp.Msg.Task)
+				if err != nil {
+					return err
+				}
+				return getRenderer(cmd.Context()).Render(branches, &options.RenderOptions)
+			}
+
 			displayTask := ConvertTaskToDisplay(resp.Msg.Task)
 			return getRenderer(cmd.Context()).Render(displayTask, &options.RenderOptions)
 		},
 	}
 
+	cmd.Flags().BoolVar(&options.Branches, "branches", false, "Show the tree of forks that the task belongs to")
 	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
 	return cmd
 }
+
+type DisplayTaskBranch struct {
+	Branch      string `json:"-" yaml:"-" detail:"default"`
+	Id          string `json:"id" yaml:"id" detail:"full"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty" detail:"default"`
+	ForkedFrom  string `json:"forked_from,omitempty" yaml:"forked_from,omitempty"`
+	ForkedAt    string `json:"forked_at,omitempty" yaml:"forked_at,omitempty" detail:"default"`
+	Messages    int64  `json:"messages" yaml:"messages" detail:"default"`
+	Current     bool   `json:"current,omitempty" yaml:"current,omitempty"`
+}
+
+// maxForkDepth bounds the walk from a task to the root of its forks.
+const maxForkDepth = 100
+
+// listTaskBranches returns the tree of forks that the task belongs to, starting at the task
+// that was not forked from another, in the order of a depth-first walk.
+func listTaskBranches(ctx context.Context, client *api_client.Client, current *v1.Task) ([]*DisplayTaskBranch, error) {
+	root := current
+	for depth := 0; root.Metadata.ForkedFromTaskId != nil && depth < maxForkDepth; depth++ {
+		resp, err := client.Task().GetTask(ctx, &connect.Request[v1.GetTaskRequest]{
+			Msg: &v1.GetTaskRequest{Id: *root.Metadata.ForkedFromTaskId},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get task %s: %w", *root.Metadata.ForkedFromTaskId, err)
+		}
+		root = resp.Msg.Task
+	}
+
+	var branches []*DisplayTaskBranch
+	var walk func(task *v1.Task, prefix, connector string) error
+	walk = func(task *v1.Task, prefix, connector string) error {
+		branch := &DisplayTaskBranch{
+			Branch:      prefix + connector + shortTaskID(task.Metadata.Id),
+			Id:          task.Metadata.Id,
+			Description: task.Spec.Description,
+			ForkedFrom:  PtrToString(task.Metadata.ForkedFromTaskId),
+			ForkedAt:    PtrToString(task.Metadata.ForkedFromMessageId),
+			Current:     task.Metadata.Id == current.Metadata.Id,
+		}
+		if task.Status != nil {
+			branch.Messages = task.Status.MessageCount
+		}
+		if branch.Current {
+			branch.Branch += " *"
+		}
+		branches = append(branches, branch)
+
+		resp, err := client.Task().ListTasks(ctx, &connect.Request[v1.ListTasksRequest]{
+			Msg: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{
+					ForkedFromTaskId: &task.Metadata.Id,
+				},
+				SortField: api_client.Ptr(v1.SortField_SORT_FIELD_CREATED_AT),
+				SortOrder: api_client.Ptr(v1.SortOrder_SORT_ORDER_ASC),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list forks of task %s: %w", task.Metadata.Id, err)
+		}
+
+		switch connector {
+		case "├─ ":
+			prefix += "│  "
+		case "└─ ":
+			prefix += "   "
+		}
+		for i, fork := range resp.Msg.Tasks {
+			next := "├─ "
+			if i == len(resp.Msg.Tasks)-1 {
+				next = "└─ "
+			}
+			if err := walk(fork, prefix, next); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(root, "", ""); err != nil {
+		return nil, err
+	}
+	return branches, nil
+}
+
+func shortTaskID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
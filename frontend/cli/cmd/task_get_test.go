@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -17,6 +18,10 @@ func TestTaskGet(t *testing.T) {
 
 	taskID1 := uuid.New().String()
 	agentID1 := uuid.New().String()
+	forkID := uuid.New().String()
+	siblingID := uuid.New().String()
+	nestedID := uuid.New().String()
+	messageID := uuid.New().String()
 	createdAt := time.Now()
 	updatedAt := time.Now().Add(time.Hour)
 
@@ -93,6 +98,54 @@ func TestTaskGet(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:    "success - show branches",
+			Command: []string{"task", "get", forkID, "--branches"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				task := func(id string, forkedFrom *v1.Task, description string, messages int64) *v1.Task {
+					t := &v1.Task{
+						Metadata: &v1.TaskMetadata{Id: id},
+						Spec:     &v1.TaskSpec{Description: description},
+						Status:   &v1.TaskStatus{MessageCount: messages},
+					}
+					if forkedFrom != nil {
+						t.Metadata.ForkedFromTaskId = &forkedFrom.Metadata.Id
+						t.Metadata.ForkedFromMessageId = &messageID
+					}
+					return t
+				}
+				root := task(taskID1, nil, "refactor the parser", 12)
+				fork := task(forkID, root, "without generics", 5)
+				sibling := task(siblingID, root, "with a lexer", 7)
+				nested := task(nestedID, fork, "", 6)
+
+				for _, t := range []*v1.Task{fork, root} {
+					mockClient.Task.EXPECT().GetTask(
+						gomock.Any(),
+						&connect.Request[v1.GetTaskRequest]{Msg: &v1.GetTaskRequest{Id: t.Metadata.Id}},
+					).Return(&connect.Response[v1.GetTaskResponse]{Msg: &v1.GetTaskResponse{Task: t}}, nil)
+				}
+				forks := map[string][]*v1.Task{
+					taskID1: {fork, sibling},
+					forkID:  {nested},
+				}
+				mockClient.Task.EXPECT().ListTasks(gomock.Any(), gomock.Any()).Times(4).DoAndReturn(
+					func(_ context.Context, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+						return &connect.Response[v1.ListTasksResponse]{Msg: &v1.ListTasksResponse{
+							Tasks: forks[req.Msg.Filter.GetForkedFromTaskId()],
+						}}, nil
+					},
+				)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayTaskBranch{
+					{Branch: taskID1[:8], Id: taskID1, Description: "refactor the parser", Messages: 12},
+					{Branch: "├─ " + forkID[:8] + " *", Id: forkID, Description: "without generics", ForkedFrom: taskID1, ForkedAt: messageID, Messages: 5, Current: true},
+					{Branch: "│  └─ " + nestedID[:8], Id: nestedID, ForkedFrom: forkID, ForkedAt: messageID, Messages: 6},
+					{Branch: "└─ " + siblingID[:8], Id: siblingID, Description: "with a lexer", ForkedFrom: taskID1, ForkedAt: messageID, Messages: 7},
+				},
+			},
+		},
 		{
 			Name:    "error - get task API failure",
 			Command: []string{"task", "get", taskID1},
@@ -2,15 +2,31 @@ package cmd
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type taskListOptions struct {
 	Agent         string
+	Owner         string
+	Labels        []string
+	Workspace     string
+	Phases        []string
+	Since         string
+	Until         string
+	UpdatedSince  string
+	UpdatedUntil  string
+	MinCost       float64
+	MaxCost       float64
+	Sort          TaskSortField
+	Ascending     bool
 	Limit         int32
 	RenderOptions RenderOptions
 }
@@ -26,7 +42,19 @@ func NewTaskListCmd() *cobra.Command {
   construct task list
 
   # List tasks assigned to the 'coder' agent, in JSON format
-  construct task ls --agent "coder" --output json`,
+  construct task ls --agent "coder" --output json
+
+  # List the tasks of a teammate on a shared daemon
+  construct task list --owner alice
+
+  # List the CI runs of the infra team that failed to finish, most expensive first
+  construct task list --label env=ci,team=infra --phase suspended --sort cost
+
+  # List the tasks in the current directory that were active since June
+  construct task list --workspace . --updated-since 2025-06-01
+
+  # List the tasks that are not labeled as CI runs and cost more than a dollar
+  construct task list --label 'env!=ci' --min-cost 1`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := getAPIClient(cmd.Context())
 
@@ -45,6 +73,66 @@ func NewTaskListCmd() *cobra.Command {
 				filter.AgentId = &agentID
 			}
 
+			if options.Owner != "" {
+				filter.Owner = &options.Owner
+			}
+
+			if len(options.Labels) > 0 {
+				filter.LabelSelector = api_client.Ptr(strings.Join(options.Labels, ","))
+			}
+
+			if options.Workspace != "" {
+				workspace, err := filepath.Abs(options.Workspace)
+				if err != nil {
+					return fmt.Errorf("failed to get absolute path of workspace directory %s: %w", options.Workspace, err)
+				}
+				filter.WorkspacePrefix = &workspace
+			}
+
+			for _, p := range options.Phases {
+				phase, err := parseTaskPhase(p)
+				if err != nil {
+					return err
+				}
+				filter.Phases = append(filter.Phases, phase)
+			}
+
+			if options.Since != "" {
+				since, err := parseArchiveTime(options.Since)
+				if err != nil {
+					return fmt.Errorf("invalid --since: %w", err)
+				}
+				filter.CreatedAfter = timestamppb.New(since)
+			}
+			if options.Until != "" {
+				until, err := parseArchiveTime(options.Until)
+				if err != nil {
+					return fmt.Errorf("invalid --until: %w", err)
+				}
+				filter.CreatedBefore = timestamppb.New(until)
+			}
+			if options.UpdatedSince != "" {
+				since, err := parseArchiveTime(options.UpdatedSince)
+				if err != nil {
+					return fmt.Errorf("invalid --updated-since: %w", err)
+				}
+				filter.UpdatedAfter = timestamppb.New(since)
+			}
+			if options.UpdatedUntil != "" {
+				until, err := parseArchiveTime(options.UpdatedUntil)
+				if err != nil {
+					return fmt.Errorf("invalid --updated-until: %w", err)
+				}
+				filter.UpdatedBefore = timestamppb.New(until)
+			}
+
+			if cmd.Flags().Changed("min-cost") {
+				filter.MinCost = &options.MinCost
+			}
+			if cmd.Flags().Changed("max-cost") {
+				filter.MaxCost = &options.MaxCost
+			}
+
 			req := &connect.Request[v1.ListTasksRequest]{
 				Msg: &v1.ListTasksRequest{
 					Filter:   filter,
@@ -52,6 +140,13 @@ func NewTaskListCmd() *cobra.Command {
 				},
 			}
 
+			if options.Sort != "" {
+				req.Msg.SortField = options.Sort.ToAPI().Enum()
+			}
+			if options.Ascending {
+				req.Msg.SortOrder = v1.SortOrder_SORT_ORDER_ASC.Enum()
+			}
+
 			resp, err := client.Task().ListTasks(cmd.Context(), req)
 			if err != nil {
 				return fmt.Errorf("failed to list tasks: %w", err)
@@ -67,6 +162,18 @@ func NewTaskListCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "Filter tasks by the agent assigned to them")
+	cmd.Flags().StringVar(&options.Owner, "owner", "", "Filter tasks by the user that created them")
+	cmd.Flags().StringSliceVar(&options.Labels, "label", nil, "Filter tasks by labels: key=value, key!=value, key or !key, comma-separated or repeated")
+	cmd.Flags().StringVar(&options.Workspace, "workspace", "", "Filter tasks in this workspace directory or below it")
+	cmd.Flags().StringSliceVar(&options.Phases, "phase", nil, "Filter tasks by phase (awaiting, running, suspended), comma-separated or repeated")
+	cmd.Flags().StringVar(&options.Since, "since", "", "Filter tasks created at or after this time (RFC 3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&options.Until, "until", "", "Filter tasks created before this time (RFC 3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&options.UpdatedSince, "updated-since", "", "Filter tasks updated at or after this time (RFC 3339 or YYYY-MM-DD)")
+	cmd.Flags().StringVar(&options.UpdatedUntil, "updated-until", "", "Filter tasks updated before this time (RFC 3339 or YYYY-MM-DD)")
+	cmd.Flags().Float64Var(&options.MinCost, "min-cost", 0, "Filter tasks that cost at least this amount")
+	cmd.Flags().Float64Var(&options.MaxCost, "max-cost", 0, "Filter tasks that cost at most this amount")
+	cmd.Flags().Var(&options.Sort, "sort", "Sort tasks by this field (created, updated, cost), newest or most expensive first (default: created)")
+	cmd.Flags().BoolVar(&options.Ascending, "asc", false, "Sort tasks in ascending order")
 	cmd.Flags().Int32VarP(&options.Limit, "limit", "l", 0, "Limit the number of results returned")
 	addRenderOptions(cmd, &options.RenderOptions)
 	return cmd
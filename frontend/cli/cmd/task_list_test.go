@@ -119,6 +119,44 @@ func TestTaskList(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:    "success - list tasks filtered by owner",
+			Command: []string{"task", "list", "--owner", "alice"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				task := createTestTask(taskID1, agentID1, createdAt, updatedAt)
+				task.Metadata.Owner = "alice"
+
+				mockClient.Task.EXPECT().ListTasks(
+					gomock.Any(),
+					CmpEqual(&connect.Request[v1.ListTasksRequest]{
+						Msg: &v1.ListTasksRequest{
+							Filter:   &v1.ListTasksRequest_Filter{Owner: conv.Ptr("alice")},
+							PageSize: conv.Ptr(int32(0)),
+						},
+					}, protocmp.Transform(),
+						cmpopts.IgnoreUnexported(connect.Request[v1.ListTasksRequest]{}),
+					),
+				).Return(connect.NewResponse(&v1.ListTasksResponse{Tasks: []*v1.Task{task}}), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayTask{
+					{
+						Id:        taskID1,
+						AgentId:   agentID1,
+						Owner:     "alice",
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+						Usage: DisplayTaskUsage{
+							InputTokens:      1000,
+							OutputTokens:     500,
+							CacheWriteTokens: 100,
+							CacheReadTokens:  50,
+							Cost:             0.05,
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:    "success - list tasks with JSON output",
 			Command: []string{"task", "list", "--output", "json"},
@@ -181,6 +219,87 @@ func TestTaskList(t *testing.T) {
 				Error: "failed to resolve agent nonexistent: agent nonexistent not found",
 			},
 		},
+		{
+			Name: "success - list tasks with filters and sorting",
+			Command: []string{
+				"task", "list",
+				"--label", "env=ci,team!=web", "--label", "nightly",
+				"--workspace", "/src/construct",
+				"--phase", "running", "--phase", "suspended",
+				"--since", "2025-06-01T00:00:00Z", "--updated-until", "2025-07-01T00:00:00Z",
+				"--min-cost", "0.5", "--max-cost", "2",
+				"--sort", "cost", "--asc",
+			},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				labeled := createTestTask(taskID1, agentID1, createdAt, updatedAt)
+				labeled.Spec.Labels = map[string]string{"nightly": "true", "env": "ci"}
+
+				mockClient.Task.EXPECT().ListTasks(
+					gomock.Any(),
+					CmpEqual(&connect.Request[v1.ListTasksRequest]{
+						Msg: &v1.ListTasksRequest{
+							Filter: &v1.ListTasksRequest_Filter{
+								LabelSelector:   conv.Ptr("env=ci,team!=web,nightly"),
+								WorkspacePrefix: conv.Ptr("/src/construct"),
+								Phases:          []v1.TaskPhase{v1.TaskPhase_TASK_PHASE_RUNNING, v1.TaskPhase_TASK_PHASE_SUSPENDED},
+								CreatedAfter:    timestamppb.New(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+								UpdatedBefore:   timestamppb.New(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)),
+								MinCost:         conv.Ptr(0.5),
+								MaxCost:         conv.Ptr(2.0),
+							},
+							PageSize:  conv.Ptr(int32(0)),
+							SortField: v1.SortField_SORT_FIELD_COST.Enum(),
+							SortOrder: v1.SortOrder_SORT_ORDER_ASC.Enum(),
+						},
+					}, protocmp.Transform(),
+						cmpopts.IgnoreUnexported(connect.Request[v1.ListTasksRequest]{}),
+					),
+				).Return(&connect.Response[v1.ListTasksResponse]{
+					Msg: &v1.ListTasksResponse{
+						Tasks: []*v1.Task{labeled},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayTask{
+					{
+						Id:        taskID1,
+						AgentId:   agentID1,
+						Labels:    "env=ci,nightly=true",
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+						Usage: DisplayTaskUsage{
+							InputTokens:      1000,
+							OutputTokens:     500,
+							CacheWriteTokens: 100,
+							CacheReadTokens:  50,
+							Cost:             0.05,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - invalid phase",
+			Command: []string{"task", "list", "--phase", "done"},
+			Expected: TestExpectation{
+				Error: `invalid phase "done": must be one of "awaiting","running","suspended"`,
+			},
+		},
+		{
+			Name:    "error - invalid sort field",
+			Command: []string{"task", "list", "--sort", "name"},
+			Expected: TestExpectation{
+				Error: `invalid argument "name" for "--sort" flag: must be one of "created","updated","cost"`,
+			},
+		},
+		{
+			Name:    "error - invalid time",
+			Command: []string{"task", "list", "--updated-since", "yesterday"},
+			Expected: TestExpectation{
+				Error: `invalid --updated-since: "yesterday" is neither an RFC 3339 timestamp nor a date (YYYY-MM-DD)`,
+			},
+		},
 		{
 			Name:    "error - list tasks API failure",
 			Command: []string{"task", "list"},
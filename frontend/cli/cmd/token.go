@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/migration"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_token "github.com/furisto/construct/backend/memory/token"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func NewTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage API tokens for clients that connect to the daemon over TCP",
+		Long: `Manage API tokens for clients that connect to the daemon over TCP.
+
+Clients that connect over the Unix socket are not authenticated. Clients that connect
+over TCP have to send an API token. Tokens are managed on the machine the daemon runs
+on, directly in its database. The daemon must have started at least once with this
+version of construct, so that the schema of the database is current.`,
+		Aliases: []string{"tokens"},
+		GroupID: "system",
+	}
+
+	cmd.AddCommand(NewTokenCreateCmd())
+	cmd.AddCommand(NewTokenListCmd())
+	cmd.AddCommand(NewTokenRevokeCmd())
+
+	return cmd
+}
+
+type DisplayToken struct {
+	ID       string `json:"id" yaml:"id" detail:"default"`
+	Name     string `json:"name" yaml:"name" detail:"default"`
+	Scope    string `json:"scope" yaml:"scope" detail:"default"`
+	User     string `json:"user" yaml:"user" detail:"default"`
+	Team     string `json:"team,omitempty" yaml:"team,omitempty" detail:"default"`
+	Created  string `json:"created" yaml:"created" detail:"default"`
+	Expires  string `json:"expires,omitempty" yaml:"expires,omitempty" detail:"default"`
+	LastUsed string `json:"last_used,omitempty" yaml:"last_used,omitempty" detail:"default"`
+}
+
+func ConvertTokenToDisplay(token *memory.Token) *DisplayToken {
+	if token == nil {
+		return nil
+	}
+
+	display := &DisplayToken{
+		ID:      token.ID.String(),
+		Name:    token.Name,
+		Scope:   string(token.Scope),
+		User:    token.User,
+		Team:    token.Team,
+		Created: token.CreateTime.Local().Format(time.DateTime),
+	}
+	if display.User == "" {
+		display.User = token.Name
+	}
+	if token.ExpireTime != nil {
+		display.Expires = token.ExpireTime.Local().Format(time.DateTime)
+	}
+	if token.LastUsedTime != nil {
+		display.LastUsed = token.LastUsedTime.Local().Format(time.DateTime)
+	}
+
+	return display
+}
+
+type TokenScope string
+
+const (
+	TokenScopeRead TokenScope = "read"
+	TokenScopeFull TokenScope = "full"
+)
+
+func (e *TokenScope) String() string {
+	return string(*e)
+}
+
+func (e *TokenScope) Set(v string) error {
+	switch TokenScope(strings.ToLower(strings.TrimSpace(v))) {
+	case TokenScopeRead, TokenScopeFull:
+		*e = TokenScope(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "read","full"`)
+	}
+}
+
+func (e *TokenScope) Type() string {
+	return "scope"
+}
+
+func (e *TokenScope) ToMemory() types.TokenScope {
+	switch *e {
+	case TokenScopeRead:
+		return types.TokenScopeRead
+	default:
+		return types.TokenScopeFull
+	}
+}
+
+// openLocalDatabase opens the database of the daemon on this machine. The daemon may be
+// running, so the schema is never migrated here: it has to be at the version of this
+// binary already, which the daemon ensures on start.
+func openLocalDatabase(ctx context.Context) (*memory.Client, error) {
+	dataDir, err := getUserInfo(ctx).ConstructDataDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get construct data directory: %w", err)
+	}
+
+	db, err := openDatabase(getConfigStore(ctx), dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSchema(ctx, db, dataDir); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func checkSchema(ctx context.Context, db *memory.Client, dataDir string) error {
+	migrator, err := newMigrator(db, dataDir)
+	if err != nil {
+		return err
+	}
+
+	status, err := migrator.Status(ctx)
+	if errors.Is(err, migration.ErrDatabaseNewer) {
+		return fmt.Errorf("%w. Upgrade construct", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if status.Unversioned || len(status.Pending) > 0 {
+		return fmt.Errorf("database schema is at version %d, this version of construct needs version %d. Start the daemon or run `construct daemon migrate` first", status.Version, status.Latest)
+	}
+	return nil
+}
+
+func getToken(ctx context.Context, db *memory.Client, idOrName string) (*memory.Token, error) {
+	query := db.Token.Query()
+	if id, err := uuid.Parse(idOrName); err == nil {
+		query = query.Where(memory_token.ID(id))
+	} else {
+		query = query.Where(memory_token.Name(idOrName))
+	}
+
+	token, err := query.Only(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			return nil, fmt.Errorf("token %s not found", idOrName)
+		}
+		return nil, fmt.Errorf("failed to get token %s: %w", idOrName, err)
+	}
+
+	return token, nil
+}
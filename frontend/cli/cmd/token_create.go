@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/shared"
+	"github.com/spf13/cobra"
+)
+
+type tokenCreateOptions struct {
+	Scope     TokenScope
+	ExpiresIn time.Duration
+	Context   string
+	User      string
+	Team      string
+}
+
+func NewTokenCreateCmd() *cobra.Command {
+	options := tokenCreateOptions{
+		Scope: TokenScopeFull,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: "Create an API token",
+		Long: `Create an API token.
+
+The token is printed once and cannot be retrieved afterwards, the daemon only stores
+its hash. A read token can only call operations that do not change anything and
+cannot export archives. A full token can call every operation.
+
+Tasks, agents and model providers created with a token are owned by its user, which
+defaults to the name of the token. Other users only see them if they are in the same
+team, and only the owner can change them.
+
+With --context, the token is stored in the context instead of being printed, and
+the CLI sends it with every request to the daemon of that context.`,
+		Example: `  # Create a token for a remote machine
+  construct token create laptop
+
+  # Create a read-only token for a dashboard that expires in 30 days
+  construct token create dashboard --scope read --expires-in 720h
+
+  # Create a token for a member of the platform team
+  construct token create alice-laptop --user alice --team platform
+
+  # Create a token and use it for the http context
+  construct token create local --context http-3f2a1b`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.ExpiresIn < 0 {
+				return fmt.Errorf("--expires-in must not be negative")
+			}
+
+			contextManager := shared.NewContextManager(getFileSystem(cmd.Context()), getUserInfo(cmd.Context()))
+			if options.Context != "" {
+				endpointContexts, err := contextManager.LoadContext()
+				if err != nil {
+					return fmt.Errorf("failed to load contexts: %w", err)
+				}
+				if _, ok := endpointContexts.Contexts[options.Context]; !ok {
+					return fmt.Errorf("context %s not found", options.Context)
+				}
+			}
+
+			db, err := openLocalDatabase(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			token, err := auth.GenerateToken()
+			if err != nil {
+				return err
+			}
+
+			create := db.Token.Create().
+				SetName(args[0]).
+				SetHash(auth.Hash(token)).
+				SetScope(options.Scope.ToMemory())
+			if options.User != "" {
+				create.SetUser(options.User)
+			}
+			if options.Team != "" {
+				create.SetTeam(options.Team)
+			}
+			if options.ExpiresIn > 0 {
+				create.SetExpireTime(time.Now().Add(options.ExpiresIn))
+			}
+
+			created, err := create.Save(cmd.Context())
+			if err != nil {
+				if memory.IsConstraintError(err) {
+					return fmt.Errorf("token %s already exists", args[0])
+				}
+				return fmt.Errorf("failed to create token: %w", err)
+			}
+
+			cmd.Println(created.ID)
+			if options.Context != "" {
+				if err := contextManager.SetToken(options.Context, token); err != nil {
+					return fmt.Errorf("failed to store token in context %s: %w", options.Context, err)
+				}
+				cmd.Printf("Token stored in context '%s'\n", options.Context)
+				return nil
+			}
+
+			cmd.Printf("Token: %s\n", token)
+			return nil
+		},
+	}
+
+	cmd.Flags().Var(&options.Scope, "scope", "The operations the token may call (read, full)")
+	cmd.Flags().DurationVar(&options.ExpiresIn, "expires-in", 0, "Expire the token after this duration (e.g. 720h); never expires if unset")
+	cmd.Flags().StringVar(&options.User, "user", "", "The user that owns resources created with the token (default: the token name)")
+	cmd.Flags().StringVar(&options.Team, "team", "", "The team of the user, whose members can read each other's resources")
+	cmd.Flags().StringVar(&options.Context, "context", "", "Store the token in this context instead of printing it")
+
+	return cmd
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/memory/migration"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/shared/config"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/furisto/construct/shared/mocks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/spf13/afero"
+)
+
+var generatedTokenPattern = regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|` + auth.TokenPrefix + `[A-Za-z0-9_-]+`)
+
+func TestTokenCreate(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.AcyclicTransformer("redact", func(s string) string {
+				return generatedTokenPattern.ReplaceAllString(s, "<redacted>")
+			}),
+		},
+	}
+
+	existingDataDir := newTokenDataDir(t, "laptop")
+	migrations, err := migration.For(dialect.SQLite)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+
+	var contextFS *afero.Afero
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:          "success - print token",
+			Command:       []string{"token", "create", "laptop", "--scope", "read", "--expires-in", "720h"},
+			SetupUserInfo: withDataDir(newTokenDataDir(t)),
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("<redacted>\nToken: <redacted>\n"),
+			},
+		},
+		{
+			Name:          "success - token for team member",
+			Command:       []string{"token", "create", "alice-laptop", "--user", "alice", "--team", "platform"},
+			SetupUserInfo: withDataDir(newTokenDataDir(t)),
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("<redacted>\nToken: <redacted>\n"),
+			},
+		},
+		{
+			Name:          "success - store token in context",
+			Command:       []string{"token", "create", "laptop", "--context", "remote"},
+			SetupUserInfo: withDataDir(newTokenDataDir(t)),
+			SetupFileSystem: func(fs *afero.Afero) {
+				contextFS = fs
+				fs.WriteFile("/home/user/.construct/context.yaml", []byte("current: remote\ncontexts:\n  remote:\n    address: https://construct.internal:8443\n    kind: http\n"), 0600)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("<redacted>\nToken stored in context 'remote'\n"),
+			},
+		},
+		{
+			Name:    "error - unknown context",
+			Command: []string{"token", "create", "laptop", "--context", "remote"},
+			Expected: TestExpectation{
+				Error: "context remote not found",
+			},
+		},
+		{
+			Name:          "error - duplicate name",
+			Command:       []string{"token", "create", "laptop"},
+			SetupUserInfo: withDataDir(existingDataDir),
+			Expected: TestExpectation{
+				Error: "token laptop already exists",
+			},
+		},
+		{
+			Name:          "error - schema not current",
+			Command:       []string{"token", "create", "laptop"},
+			SetupUserInfo: withDataDir(t.TempDir()),
+			Expected: TestExpectation{
+				Error: fmt.Sprintf("database schema is at version 0, this version of construct needs version %d. Start the daemon or run `construct daemon migrate` first", len(migrations)),
+			},
+		},
+		{
+			Name:    "error - invalid scope",
+			Command: []string{"token", "create", "laptop", "--scope", "admin"},
+			Expected: TestExpectation{
+				Error: "invalid argument \"admin\" for \"--scope\" flag: must be one of \"read\",\"full\"",
+			},
+		},
+	})
+
+	content, err := contextFS.ReadFile("/home/user/.construct/context.yaml")
+	if err != nil {
+		t.Fatalf("failed to read context file: %v", err)
+	}
+	if !strings.Contains(string(content), "token: "+auth.TokenPrefix) {
+		t.Errorf("expected token to be stored in context, got:\n%s", content)
+	}
+}
+
+func withDataDir(dataDir string) func(userInfo *mocks.MockUserInfo) {
+	return func(userInfo *mocks.MockUserInfo) {
+		userInfo.EXPECT().ConstructDataDir().Return(dataDir, nil).AnyTimes()
+	}
+}
+
+// newTokenDataDir returns a data directory with a migrated database that contains full
+// tokens with the given names.
+func newTokenDataDir(t *testing.T, names ...string) string {
+	t.Helper()
+	ctx := context.Background()
+	dataDir := t.TempDir()
+
+	db, err := openDatabase(&config.Store{}, dataDir)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	if err := setupMemory(ctx, db, dataDir); err != nil {
+		t.Fatalf("failed to setup database: %v", err)
+	}
+
+	for _, name := range names {
+		token, err := auth.GenerateToken()
+		if err != nil {
+			t.Fatalf("failed to generate token: %v", err)
+		}
+		err = db.Token.Create().SetName(name).SetHash(auth.Hash(token)).SetScope(types.TokenScopeFull).Exec(ctx)
+		if err != nil {
+			t.Fatalf("failed to create token: %v", err)
+		}
+	}
+	return dataDir
+}
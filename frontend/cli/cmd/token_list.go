@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	memory_token "github.com/furisto/construct/backend/memory/token"
+	"github.com/spf13/cobra"
+)
+
+type tokenListOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewTokenListCmd() *cobra.Command {
+	var options tokenListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List all API tokens",
+		Aliases: []string{"ls"},
+		Example: `  # List all API tokens
+  construct token list`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openLocalDatabase(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			tokens, err := db.Token.Query().Order(memory_token.ByName()).All(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list tokens: %w", err)
+			}
+
+			displayTokens := make([]*DisplayToken, len(tokens))
+			for i, token := range tokens {
+				displayTokens[i] = ConvertTokenToDisplay(token)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayTokens, &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
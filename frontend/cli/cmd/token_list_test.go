@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestTokenList(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(DisplayToken{}, "ID", "Created"),
+		},
+	}
+
+	dataDir := newTokenDataDir(t, "laptop", "dashboard")
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:          "success - list tokens",
+			Command:       []string{"token", "list"},
+			SetupUserInfo: withDataDir(dataDir),
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayToken{
+					{Name: "dashboard", Scope: "full", User: "dashboard"},
+					{Name: "laptop", Scope: "full", User: "laptop"},
+				},
+			},
+		},
+		{
+			Name:          "success - no tokens",
+			Command:       []string{"token", "list"},
+			SetupUserInfo: withDataDir(newTokenDataDir(t)),
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayToken{},
+			},
+		},
+	})
+}
+
+func TestTokenRevoke(t *testing.T) {
+	setup := &TestSetup{}
+
+	dataDir := newTokenDataDir(t, "laptop", "dashboard")
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:          "success - revoke with confirmation",
+			Command:       []string{"token", "revoke", "laptop"},
+			Stdin:         "y\n",
+			SetupUserInfo: withDataDir(dataDir),
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("Are you sure you want to delete token laptop? (y/n): "),
+			},
+		},
+		{
+			Name:          "error - already revoked",
+			Command:       []string{"token", "revoke", "laptop", "--force"},
+			SetupUserInfo: withDataDir(dataDir),
+			Expected: TestExpectation{
+				Error: "token laptop not found",
+			},
+		},
+		{
+			Name:          "success - revoke without confirmation",
+			Command:       []string{"token", "revoke", "dashboard", "--force"},
+			SetupUserInfo: withDataDir(dataDir),
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(""),
+			},
+		},
+	})
+}
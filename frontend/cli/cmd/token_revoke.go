@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/spf13/cobra"
+)
+
+type tokenRevokeOptions struct {
+	Force bool
+}
+
+func NewTokenRevokeCmd() *cobra.Command {
+	options := new(tokenRevokeOptions)
+	cmd := &cobra.Command{
+		Use:   "revoke <name|id>... [flags]",
+		Short: "Revoke one or more API tokens",
+		Long: `Revoke one or more API tokens.
+
+Requests with a revoked token are rejected immediately, also on daemons that share
+the database.`,
+		Example: `  # Revoke a token
+  construct token revoke laptop
+
+  # Revoke without a confirmation prompt
+  construct token revoke laptop dashboard --force`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openLocalDatabase(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			tokens := make([]*memory.Token, len(args))
+			for i, idOrName := range args {
+				tokens[i], err = getToken(cmd.Context(), db, idOrName)
+				if err != nil {
+					return err
+				}
+			}
+
+			if !options.Force && !confirmDeletion(cmd.InOrStdin(), cmd.OutOrStdout(), "token", args) {
+				return nil
+			}
+
+			for _, token := range tokens {
+				if err := db.Token.DeleteOne(token).Exec(cmd.Context()); err != nil {
+					return fmt.Errorf("failed to revoke token %s: %w", token.Name, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Force, "force", "f", false, "Skip the confirmation prompt")
+
+	return cmd
+}
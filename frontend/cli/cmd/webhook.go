@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func NewWebhookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhook",
+		Short:   "Manage webhook triggers that start tasks from external events",
+		Aliases: []string{"webhooks"},
+		GroupID: "resource",
+	}
+
+	cmd.AddCommand(NewWebhookCreateCmd())
+	cmd.AddCommand(NewWebhookGetCmd())
+	cmd.AddCommand(NewWebhookListCmd())
+	cmd.AddCommand(NewWebhookDeliveriesCmd())
+	cmd.AddCommand(NewWebhookDeleteCmd())
+
+	return cmd
+}
+
+type DisplayWebhookTrigger struct {
+	ID              string `json:"id" yaml:"id" detail:"default"`
+	Name            string `json:"name" yaml:"name" detail:"default"`
+	Description     string `json:"description,omitempty" yaml:"description,omitempty"`
+	Target          string `json:"target" yaml:"target" detail:"default"`
+	AgentID         string `json:"agent_id,omitempty" yaml:"agent_id,omitempty" detail:"default"`
+	Workspace       string `json:"workspace,omitempty" yaml:"workspace,omitempty"`
+	PromptTemplate  string `json:"prompt_template" yaml:"prompt_template"`
+	TaskIDTemplate  string `json:"task_id_template,omitempty" yaml:"task_id_template,omitempty" detail:"full"`
+	SignatureHeader string `json:"signature_header" yaml:"signature_header" detail:"full"`
+	Path            string `json:"path" yaml:"path" detail:"default"`
+	Enabled         bool   `json:"enabled" yaml:"enabled" detail:"default"`
+}
+
+func ConvertWebhookTriggerToDisplay(trigger *v1.WebhookTrigger) *DisplayWebhookTrigger {
+	if trigger == nil || trigger.Metadata == nil || trigger.Spec == nil {
+		return nil
+	}
+
+	return &DisplayWebhookTrigger{
+		ID:              trigger.Metadata.Id,
+		Name:            trigger.Spec.Name,
+		Description:     trigger.Spec.Description,
+		Target:          string(ConvertWebhookTargetFromAPI(trigger.Spec.Target)),
+		AgentID:         PtrToString(trigger.Spec.AgentId),
+		Workspace:       trigger.Spec.Workspace,
+		PromptTemplate:  trigger.Spec.PromptTemplate,
+		TaskIDTemplate:  trigger.Spec.TaskIdTemplate,
+		SignatureHeader: trigger.Spec.SignatureHeader,
+		Path:            webhookPath(trigger.Spec.Name),
+		Enabled:         trigger.Spec.Enabled,
+	}
+}
+
+type DisplayWebhookDelivery struct {
+	ID         string `json:"id" yaml:"id" detail:"default"`
+	TaskID     string `json:"task_id,omitempty" yaml:"task_id,omitempty" detail:"default"`
+	Status     string `json:"status" yaml:"status" detail:"default"`
+	StatusCode int32  `json:"status_code" yaml:"status_code" detail:"default"`
+	Received   string `json:"received" yaml:"received" detail:"default"`
+	Error      string `json:"error,omitempty" yaml:"error,omitempty" detail:"default"`
+}
+
+func ConvertWebhookDeliveryToDisplay(delivery *v1.WebhookDelivery) *DisplayWebhookDelivery {
+	if delivery == nil {
+		return nil
+	}
+
+	display := &DisplayWebhookDelivery{
+		ID:         delivery.Id,
+		TaskID:     PtrToString(delivery.TaskId),
+		Status:     strings.ToLower(strings.TrimPrefix(delivery.Status.String(), "WEBHOOK_DELIVERY_STATUS_")),
+		StatusCode: delivery.StatusCode,
+		Error:      delivery.Error,
+	}
+
+	if delivery.CreatedAt != nil {
+		display.Received = delivery.CreatedAt.AsTime().Local().Format(time.DateTime)
+	}
+
+	return display
+}
+
+type WebhookTarget string
+
+const (
+	WebhookTargetNewTask WebhookTarget = "new-task"
+	WebhookTargetMessage WebhookTarget = "message"
+)
+
+func (e *WebhookTarget) String() string {
+	return string(*e)
+}
+
+func (e *WebhookTarget) Set(v string) error {
+	switch WebhookTarget(strings.ToLower(strings.TrimSpace(v))) {
+	case WebhookTargetNewTask, WebhookTargetMessage:
+		*e = WebhookTarget(strings.ToLower(strings.TrimSpace(v)))
+		return nil
+	default:
+		return errors.New(`must be one of "new-task","message"`)
+	}
+}
+
+func (e *WebhookTarget) Type() string {
+	return "target"
+}
+
+func (e *WebhookTarget) ToAPI() v1.WebhookTarget {
+	switch *e {
+	case WebhookTargetNewTask:
+		return v1.WebhookTarget_WEBHOOK_TARGET_NEW_TASK
+	case WebhookTargetMessage:
+		return v1.WebhookTarget_WEBHOOK_TARGET_MESSAGE
+	default:
+		return v1.WebhookTarget_WEBHOOK_TARGET_UNSPECIFIED
+	}
+}
+
+func ConvertWebhookTargetFromAPI(target v1.WebhookTarget) WebhookTarget {
+	switch target {
+	case v1.WebhookTarget_WEBHOOK_TARGET_NEW_TASK:
+		return WebhookTargetNewTask
+	case v1.WebhookTarget_WEBHOOK_TARGET_MESSAGE:
+		return WebhookTargetMessage
+	default:
+		return ""
+	}
+}
+
+func webhookPath(name string) string {
+	return "/webhooks/" + name
+}
+
+func getWebhookTriggerID(ctx context.Context, client *api.Client, idOrName string) (string, error) {
+	_, err := uuid.Parse(idOrName)
+	if err == nil {
+		return idOrName, nil
+	}
+
+	triggerResp, err := client.Webhook().ListWebhookTriggers(ctx, &connect.Request[v1.ListWebhookTriggersRequest]{
+		Msg: &v1.ListWebhookTriggersRequest{
+			Filter: &v1.ListWebhookTriggersRequest_Filter{
+				Names: []string{idOrName},
+			},
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to list webhook triggers: %w", err)
+	}
+
+	if len(triggerResp.Msg.Triggers) == 0 {
+		return "", fmt.Errorf("webhook trigger %s not found", idOrName)
+	}
+
+	if len(triggerResp.Msg.Triggers) > 1 {
+		return "", fmt.Errorf("multiple webhook triggers found for %s", idOrName)
+	}
+
+	return triggerResp.Msg.Triggers[0].Metadata.Id, nil
+}
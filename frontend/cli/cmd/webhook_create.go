@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type webhookCreateOptions struct {
+	Description     string
+	Target          WebhookTarget
+	Agent           string
+	Workspace       string
+	Prompt          string
+	PromptFile      string
+	TaskIDTemplate  string
+	Secret          string
+	SignatureHeader string
+}
+
+func NewWebhookCreateCmd() *cobra.Command {
+	options := webhookCreateOptions{
+		Target: WebhookTargetNewTask,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: "Create a webhook trigger that starts tasks from external events",
+		Args:  cobra.ExactArgs(1),
+		Long: `Create a webhook trigger that starts tasks from external events.
+
+The daemon accepts JSON deliveries for the trigger on POST /webhooks/<name>. Every 
+delivery must carry the hex encoded HMAC-SHA256 signature of the request body in 
+the signature header (X-Construct-Signature by default, an optional "sha256=" prefix 
+is accepted). The prompt is a Go template with access to {{.Payload}}, {{.Headers}} 
+and {{.TriggerName}}.
+
+With the new-task target, every delivery starts a new task for the agent. With the 
+message target, the task ID template is rendered to find the task the prompt is 
+sent to.
+
+If no secret is given, a random one is generated. The secret is only shown once.`,
+		Example: `  # Start a review task for every push to the self-hosted git server
+  construct webhook create push --agent reviewer --workspace . \
+    --prompt "Review the commits pushed to {{.Payload.ref}}" \
+    --signature-header X-Gitea-Signature
+
+  # Forward comments to the task referenced in the payload
+  construct webhook create comments --target message \
+    --task-id-template "{{.Payload.task_id}}" --prompt "{{.Payload.comment}}"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			fs := getFileSystem(cmd.Context())
+
+			prompt, err := getPromptTemplate(options.Prompt, options.PromptFile, fs)
+			if err != nil {
+				return err
+			}
+
+			if options.Target == WebhookTargetMessage && options.TaskIDTemplate == "" {
+				return fmt.Errorf("task ID template is required for the message target (use --task-id-template)")
+			}
+
+			if options.Target == WebhookTargetNewTask && options.Agent == "" {
+				return fmt.Errorf("agent is required for the new-task target (use --agent)")
+			}
+
+			if options.Workspace != "" {
+				exists, err := afero.Exists(fs, options.Workspace)
+				if err != nil {
+					return fmt.Errorf("failed to check if workspace directory %s exists: %w", options.Workspace, err)
+				}
+				if !exists {
+					return fmt.Errorf("workspace directory %s does not exist", options.Workspace)
+				}
+
+				absPath, err := filepath.Abs(options.Workspace)
+				if err != nil {
+					return fmt.Errorf("failed to get absolute path of workspace directory %s: %w", options.Workspace, err)
+				}
+				options.Workspace = absPath
+			}
+
+			req := &v1.CreateWebhookTriggerRequest{
+				Name:            args[0],
+				Description:     options.Description,
+				Target:          options.Target.ToAPI(),
+				Workspace:       options.Workspace,
+				PromptTemplate:  prompt,
+				TaskIdTemplate:  options.TaskIDTemplate,
+				Secret:          options.Secret,
+				SignatureHeader: options.SignatureHeader,
+			}
+
+			if options.Agent != "" {
+				agentID, err := getAgentID(cmd.Context(), client, options.Agent)
+				if err != nil {
+					return fmt.Errorf("failed to resolve agent %s: %w", options.Agent, err)
+				}
+				req.AgentId = &agentID
+			}
+
+			resp, err := client.Webhook().CreateWebhookTrigger(cmd.Context(), &connect.Request[v1.CreateWebhookTriggerRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create webhook trigger: %w", err)
+			}
+
+			cmd.Println(resp.Msg.Trigger.Metadata.Id)
+			cmd.Printf("Path:   %s\n", webhookPath(resp.Msg.Trigger.Spec.Name))
+			cmd.Printf("Secret: %s\n", resp.Msg.Secret)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Description, "description", "d", "", "A description of the webhook trigger")
+	cmd.Flags().Var(&options.Target, "target", "What a delivery creates (new-task, message)")
+	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "The agent that executes new tasks")
+	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "The workspace directory for new tasks")
+	cmd.Flags().StringVarP(&options.Prompt, "prompt", "p", "", "The prompt template rendered for every delivery")
+	cmd.Flags().StringVar(&options.PromptFile, "prompt-file", "", "Read the prompt template from a file")
+	cmd.Flags().StringVar(&options.TaskIDTemplate, "task-id-template", "", "The template that renders the task ID for the message target")
+	cmd.Flags().StringVar(&options.Secret, "secret", "", "The shared secret used to sign deliveries (generated if empty)")
+	cmd.Flags().StringVar(&options.SignatureHeader, "signature-header", "", "The header carrying the signature (default X-Construct-Signature)")
+
+	cmd.MarkFlagsMutuallyExclusive("prompt", "prompt-file")
+
+	return cmd
+}
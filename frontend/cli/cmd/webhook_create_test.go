@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWebhookCreate(t *testing.T) {
+	setup := &TestSetup{}
+
+	triggerID := uuid.NewString()
+	agentID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name: "success - create new task trigger",
+			Command: []string{"webhook", "create", "push", "--agent", "reviewer", "--workspace", "/path/to/repo",
+				"--prompt", "Review {{.Payload.ref}}", "--signature-header", "X-Gitea-Signature"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupAgentLookupForTaskCreateMock(mockClient, "reviewer", agentID)
+				setupWebhookCreateMock(mockClient, &v1.CreateWebhookTriggerRequest{
+					Name:            "push",
+					Target:          v1.WebhookTarget_WEBHOOK_TARGET_NEW_TASK,
+					AgentId:         &agentID,
+					Workspace:       "/path/to/repo",
+					PromptTemplate:  "Review {{.Payload.ref}}",
+					SignatureHeader: "X-Gitea-Signature",
+				}, triggerID, "generated-secret")
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/path/to/repo", 0755)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintf("%s\nPath:   /webhooks/push\nSecret: generated-secret\n", triggerID)),
+			},
+		},
+		{
+			Name: "success - create message trigger with prompt file",
+			Command: []string{"webhook", "create", "comments", "--target", "message", "--task-id-template", "{{.Payload.task_id}}",
+				"--prompt-file", "/prompts/comment.md", "--secret", "s3cr3t"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookCreateMock(mockClient, &v1.CreateWebhookTriggerRequest{
+					Name:           "comments",
+					Target:         v1.WebhookTarget_WEBHOOK_TARGET_MESSAGE,
+					PromptTemplate: "{{.Payload.comment}}",
+					TaskIdTemplate: "{{.Payload.task_id}}",
+					Secret:         "s3cr3t",
+				}, triggerID, "s3cr3t")
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/prompts/comment.md", []byte("{{.Payload.comment}}"), 0644)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintf("%s\nPath:   /webhooks/comments\nSecret: s3cr3t\n", triggerID)),
+			},
+		},
+		{
+			Name:    "error - message target without task ID template",
+			Command: []string{"webhook", "create", "comments", "--target", "message", "--prompt", "{{.Payload.comment}}"},
+			Expected: TestExpectation{
+				Error: "task ID template is required for the message target (use --task-id-template)",
+			},
+		},
+		{
+			Name:    "error - new task target without agent",
+			Command: []string{"webhook", "create", "push", "--prompt", "Review"},
+			Expected: TestExpectation{
+				Error: "agent is required for the new-task target (use --agent)",
+			},
+		},
+		{
+			Name:    "error - invalid target",
+			Command: []string{"webhook", "create", "push", "--prompt", "Review", "--target", "issue"},
+			Expected: TestExpectation{
+				Error: "invalid argument \"issue\" for \"--target\" flag: must be one of \"new-task\",\"message\"",
+			},
+		},
+		{
+			Name:    "error - create webhook trigger API failure",
+			Command: []string{"webhook", "create", "push", "--agent", agentID, "--prompt", "Review {{.Payload.ref"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().CreateWebhookTrigger(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid prompt template")))
+			},
+			Expected: TestExpectation{
+				Error: "failed to create webhook trigger: invalid_argument: invalid prompt template",
+			},
+		},
+	})
+}
+
+func setupWebhookCreateMock(mockClient *api_client.MockClient, req *v1.CreateWebhookTriggerRequest, triggerID, secret string) {
+	mockClient.Webhook.EXPECT().CreateWebhookTrigger(
+		gomock.Any(),
+		CmpEqual(&connect.Request[v1.CreateWebhookTriggerRequest]{
+			Msg: req,
+		}, protocmp.Transform(),
+			cmpopts.IgnoreUnexported(connect.Request[v1.CreateWebhookTriggerRequest]{}),
+		),
+	).Return(&connect.Response[v1.CreateWebhookTriggerResponse]{
+		Msg: &v1.CreateWebhookTriggerResponse{
+			Trigger: &v1.WebhookTrigger{
+				Metadata: &v1.WebhookTriggerMetadata{
+					Id:        triggerID,
+					CreatedAt: timestamppb.Now(),
+					UpdatedAt: timestamppb.Now(),
+				},
+				Spec: &v1.WebhookTriggerSpec{
+					Name: req.Name,
+				},
+			},
+			Secret: secret,
+		},
+	}, nil)
+}
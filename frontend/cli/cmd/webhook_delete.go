@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookDeleteOptions struct {
+	Force bool
+}
+
+func NewWebhookDeleteCmd() *cobra.Command {
+	options := new(webhookDeleteOptions)
+	cmd := &cobra.Command{
+		Use:     "delete <name|id>... [flags]",
+		Short:   "Permanently delete one or more webhook triggers",
+		Aliases: []string{"rm"},
+		Long: `Permanently delete one or more webhook triggers.
+
+The delivery log of the trigger is deleted as well. Tasks that were created by
+deliveries are kept.`,
+		Example: `  # Delete a single webhook trigger
+  construct webhook delete push
+
+  # Force delete without a confirmation prompt
+  construct webhook rm push comments --force`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			var triggerIDs = make(map[string]string)
+			for _, idOrName := range args {
+				triggerID, err := getWebhookTriggerID(cmd.Context(), client, idOrName)
+				if err != nil {
+					return err
+				}
+
+				triggerIDs[idOrName] = triggerID
+			}
+
+			if !options.Force && !confirmDeletion(cmd.InOrStdin(), cmd.OutOrStdout(), "webhook trigger", args) {
+				return nil
+			}
+
+			for idOrName, triggerID := range triggerIDs {
+				_, err := client.Webhook().DeleteWebhookTrigger(cmd.Context(), &connect.Request[v1.DeleteWebhookTriggerRequest]{
+					Msg: &v1.DeleteWebhookTriggerRequest{Id: triggerID},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to delete webhook trigger %s: %w", idOrName, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Force, "force", "f", false, "Skip the confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookDeliveriesOptions struct {
+	Limit         int32
+	RenderOptions RenderOptions
+}
+
+func NewWebhookDeliveriesCmd() *cobra.Command {
+	var options webhookDeliveriesOptions
+
+	cmd := &cobra.Command{
+		Use:   "deliveries <name|id> [flags]",
+		Short: "Show the delivery log of a webhook trigger",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Show the delivery log of the push webhook trigger
+  construct webhook deliveries push
+
+  # Show the last five deliveries as JSON
+  construct webhook deliveries push --limit 5 --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			triggerID, err := getWebhookTriggerID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return err
+			}
+
+			req := &v1.ListWebhookDeliveriesRequest{
+				TriggerId: triggerID,
+			}
+			if options.Limit > 0 {
+				req.PageSize = &options.Limit
+			}
+
+			resp, err := client.Webhook().ListWebhookDeliveries(cmd.Context(), &connect.Request[v1.ListWebhookDeliveriesRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list deliveries of webhook trigger %s: %w", idOrName, err)
+			}
+
+			displayDeliveries := make([]*DisplayWebhookDelivery, len(resp.Msg.Deliveries))
+			for i, delivery := range resp.Msg.Deliveries {
+				displayDeliveries[i] = ConvertWebhookDeliveryToDisplay(delivery)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayDeliveries, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "l", 0, "Limit the number of deliveries returned")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
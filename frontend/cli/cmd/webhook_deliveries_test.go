@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(DisplayWebhookDelivery{}, "Received"),
+		},
+	}
+
+	triggerID := uuid.NewString()
+	deliveryID1 := uuid.NewString()
+	deliveryID2 := uuid.NewString()
+	taskID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - list deliveries by trigger name",
+			Command: []string{"webhook", "deliveries", "push", "--limit", "2"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookLookupMock(mockClient, "push", triggerID)
+				mockClient.Webhook.EXPECT().ListWebhookDeliveries(
+					gomock.Any(),
+					CmpEqual(&connect.Request[v1.ListWebhookDeliveriesRequest]{
+						Msg: &v1.ListWebhookDeliveriesRequest{
+							TriggerId: triggerID,
+							PageSize:  api_client.Ptr(int32(2)),
+						},
+					}, protocmp.Transform(),
+						cmpopts.IgnoreUnexported(connect.Request[v1.ListWebhookDeliveriesRequest]{}),
+					),
+				).Return(&connect.Response[v1.ListWebhookDeliveriesResponse]{
+					Msg: &v1.ListWebhookDeliveriesResponse{
+						Deliveries: []*v1.WebhookDelivery{
+							{
+								Id:         deliveryID1,
+								TriggerId:  triggerID,
+								TaskId:     &taskID,
+								Status:     v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_ACCEPTED,
+								StatusCode: 202,
+								CreatedAt:  timestamppb.Now(),
+							},
+							{
+								Id:         deliveryID2,
+								TriggerId:  triggerID,
+								Status:     v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_REJECTED,
+								StatusCode: 401,
+								Error:      "invalid signature",
+								CreatedAt:  timestamppb.Now(),
+							},
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayWebhookDelivery{
+					{
+						ID:         deliveryID1,
+						TaskID:     taskID,
+						Status:     "accepted",
+						StatusCode: 202,
+					},
+					{
+						ID:         deliveryID2,
+						Status:     "rejected",
+						StatusCode: 401,
+						Error:      "invalid signature",
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - trigger not found",
+			Command: []string{"webhook", "deliveries", "unknown"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().ListWebhookTriggers(gomock.Any(), gomock.Any()).
+					Return(&connect.Response[v1.ListWebhookTriggersResponse]{
+						Msg: &v1.ListWebhookTriggersResponse{},
+					}, nil)
+			},
+			Expected: TestExpectation{
+				Error: "webhook trigger unknown not found",
+			},
+		},
+		{
+			Name:    "error - list deliveries API failure",
+			Command: []string{"webhook", "deliveries", triggerID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().ListWebhookDeliveries(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error")))
+			},
+			Expected: TestExpectation{
+				Error: fmt.Sprintf("failed to list deliveries of webhook trigger %s: internal: database error", triggerID),
+			},
+		},
+	})
+}
+
+func setupWebhookLookupMock(mockClient *api_client.MockClient, name, triggerID string) {
+	mockClient.Webhook.EXPECT().ListWebhookTriggers(
+		gomock.Any(),
+		CmpEqual(&connect.Request[v1.ListWebhookTriggersRequest]{
+			Msg: &v1.ListWebhookTriggersRequest{
+				Filter: &v1.ListWebhookTriggersRequest_Filter{
+					Names: []string{name},
+				},
+			},
+		}, protocmp.Transform(),
+			cmpopts.IgnoreUnexported(connect.Request[v1.ListWebhookTriggersRequest]{}),
+		),
+	).Return(&connect.Response[v1.ListWebhookTriggersResponse]{
+		Msg: &v1.ListWebhookTriggersResponse{
+			Triggers: []*v1.WebhookTrigger{
+				{
+					Metadata: &v1.WebhookTriggerMetadata{
+						Id:        triggerID,
+						CreatedAt: timestamppb.Now(),
+						UpdatedAt: timestamppb.Now(),
+					},
+					Spec: &v1.WebhookTriggerSpec{
+						Name:    name,
+						Target:  v1.WebhookTarget_WEBHOOK_TARGET_NEW_TASK,
+						Enabled: true,
+					},
+				},
+			},
+		},
+	}, nil)
+}
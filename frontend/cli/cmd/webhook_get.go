@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookGetOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewWebhookGetCmd() *cobra.Command {
+	var options webhookGetOptions
+
+	cmd := &cobra.Command{
+		Use:   "get <name|id> [flags]",
+		Short: "Inspect the details of a specific webhook trigger",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Get details for the 'push' webhook trigger
+  construct webhook get push
+
+  # Get details and format as YAML
+  construct webhook get push --output yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			triggerID, err := getWebhookTriggerID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve webhook trigger %s: %w", idOrName, err)
+			}
+
+			resp, err := client.Webhook().GetWebhookTrigger(cmd.Context(), &connect.Request[v1.GetWebhookTriggerRequest]{
+				Msg: &v1.GetWebhookTriggerRequest{Id: triggerID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get webhook trigger %s: %w", idOrName, err)
+			}
+
+			return getRenderer(cmd.Context()).Render(ConvertWebhookTriggerToDisplay(resp.Msg.Trigger), &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/frontend/cli/pkg/fail"
+	"github.com/spf13/cobra"
+)
+
+type webhookListOptions struct {
+	Limit         int32
+	RenderOptions RenderOptions
+}
+
+func NewWebhookListCmd() *cobra.Command {
+	var options webhookListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List all webhook triggers",
+		Aliases: []string{"ls"},
+		Example: `  # List all webhook triggers
+  construct webhook list
+
+  # List webhook triggers as JSON
+  construct webhook ls --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			req := &v1.ListWebhookTriggersRequest{}
+			if options.Limit > 0 {
+				req.PageSize = &options.Limit
+			}
+
+			resp, err := client.Webhook().ListWebhookTriggers(cmd.Context(), &connect.Request[v1.ListWebhookTriggersRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fail.HandleError(cmd, fmt.Errorf("failed to list webhook triggers: %w", err))
+			}
+
+			displayTriggers := make([]*DisplayWebhookTrigger, len(resp.Msg.Triggers))
+			for i, trigger := range resp.Msg.Triggers {
+				displayTriggers[i] = ConvertWebhookTriggerToDisplay(trigger)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayTriggers, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "l", 0, "Limit the number of results returned")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
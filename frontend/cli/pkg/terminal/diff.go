@@ -0,0 +1,478 @@
+package terminal
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	v1 "github.com/furisto/construct/api/go/v1"
+)
+
+type diffLineKind int
+
+const (
+	diffLineContext diffLineKind = iota
+	diffLineAdded
+	diffLineRemoved
+)
+
+type diffLine struct {
+	kind diffLineKind
+	text string
+	// oldLine and newLine are the numbers of the line before and after the change, zero for
+	// lines that exist only on the other side.
+	oldLine int
+	newLine int
+}
+
+type diffHunk struct {
+	header string
+	lines  []diffLine
+}
+
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// parsePatch splits a unified diff of a single file into its hunks.
+func parsePatch(patch string) []diffHunk {
+	var (
+		hunks            []diffHunk
+		oldLine, newLine int
+	)
+
+	for _, line := range strings.Split(strings.TrimSuffix(patch, "\n"), "\n") {
+		if match := hunkHeaderPattern.FindStringSubmatch(line); match != nil {
+			oldLine, _ = strconv.Atoi(match[1])
+			newLine, _ = strconv.Atoi(match[2])
+			hunks = append(hunks, diffHunk{header: line})
+			continue
+		}
+		if len(hunks) == 0 || strings.HasPrefix(line, `\`) {
+			// file headers before the first hunk and "\ No newline at end of file"
+			continue
+		}
+
+		hunk := &hunks[len(hunks)-1]
+		switch {
+		case strings.HasPrefix(line, "+"):
+			hunk.lines = append(hunk.lines, diffLine{kind: diffLineAdded, text: line[1:], newLine: newLine})
+			newLine++
+		case strings.HasPrefix(line, "-"):
+			hunk.lines = append(hunk.lines, diffLine{kind: diffLineRemoved, text: line[1:], oldLine: oldLine})
+			oldLine++
+		default:
+			hunk.lines = append(hunk.lines, diffLine{kind: diffLineContext, text: strings.TrimPrefix(line, " "), oldLine: oldLine, newLine: newLine})
+			oldLine++
+			newLine++
+		}
+	}
+
+	return hunks
+}
+
+// diffFile is a patch of a file that is shown in the diff viewer. change is set for changes
+// that are staged for review.
+type diffFile struct {
+	path    string
+	hunks   []diffHunk
+	added   int
+	removed int
+	change  *v1.FileChange
+}
+
+func newDiffFile(path, patch string) diffFile {
+	file := diffFile{
+		path:  path,
+		hunks: parsePatch(patch),
+	}
+	for _, hunk := range file.hunks {
+		for _, line := range hunk.lines {
+			switch line.kind {
+			case diffLineAdded:
+				file.added++
+			case diffLineRemoved:
+				file.removed++
+			}
+		}
+	}
+	return file
+}
+
+type DiffViewerKeyBindings struct {
+	NextHunk     key.Binding
+	PreviousHunk key.Binding
+	NextFile     key.Binding
+	PreviousFile key.Binding
+	ScrollUp     key.Binding
+	ScrollDown   key.Binding
+	Accept       key.Binding
+	ForceAccept  key.Binding
+	Reject       key.Binding
+	Close        key.Binding
+}
+
+func NewDiffViewerKeyBindings() DiffViewerKeyBindings {
+	return DiffViewerKeyBindings{
+		NextHunk: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "next hunk"),
+		),
+		PreviousHunk: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "previous hunk"),
+		),
+		NextFile: key.NewBinding(
+			key.WithKeys("]", "right"),
+			key.WithHelp("]", "next file"),
+		),
+		PreviousFile: key.NewBinding(
+			key.WithKeys("[", "left"),
+			key.WithHelp("[", "previous file"),
+		),
+		ScrollUp: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑", "scroll up"),
+		),
+		ScrollDown: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓", "scroll down"),
+		),
+		Accept: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "accept"),
+		),
+		ForceAccept: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "accept even if the file changed"),
+		),
+		Reject: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "reject"),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc", "close"),
+		),
+	}
+}
+
+// diffAction is what the user decided about the change that is shown in the diff viewer.
+type diffAction struct {
+	change *v1.FileChange
+	accept bool
+	reason string
+	force  bool
+}
+
+// diffViewer shows the patches of files hunk by hunk. In review mode the files are pending
+// changes that can be accepted or rejected.
+type diffViewer struct {
+	files       []diffFile
+	file        int
+	hunk        int
+	review      bool
+	status      string
+	keyBindings DiffViewerKeyBindings
+
+	// rejecting is set while the reason for a rejection is typed
+	rejecting bool
+	reason    textinput.Model
+
+	viewport    viewport.Model
+	hunkOffsets []int
+	width       int
+	height      int
+}
+
+func newDiffViewer(files []diffFile, review bool) *diffViewer {
+	reason := textinput.New()
+	reason.Placeholder = "Why do you reject the change? The agent gets your reason."
+	reason.Prompt = "Reason: "
+	reason.CharLimit = 4096
+
+	v := &diffViewer{
+		files:       files,
+		review:      review,
+		keyBindings: NewDiffViewerKeyBindings(),
+		reason:      reason,
+		viewport:    viewport.New(0, 0),
+	}
+	v.render()
+	return v
+}
+
+// Update handles a key while the viewer is open. It returns the decision about the current
+// change, if one was made, and whether the viewer was closed.
+func (v *diffViewer) Update(msg tea.KeyMsg) (*diffAction, bool) {
+	if v.rejecting {
+		return v.updateReason(msg), false
+	}
+
+	switch {
+	case key.Matches(msg, v.keyBindings.Close):
+		return nil, true
+	case key.Matches(msg, v.keyBindings.NextHunk):
+		v.moveHunk(1)
+	case key.Matches(msg, v.keyBindings.PreviousHunk):
+		v.moveHunk(-1)
+	case key.Matches(msg, v.keyBindings.NextFile):
+		v.selectFile(v.file + 1)
+	case key.Matches(msg, v.keyBindings.PreviousFile):
+		v.selectFile(v.file - 1)
+	case key.Matches(msg, v.keyBindings.ScrollUp):
+		v.viewport.LineUp(1)
+	case key.Matches(msg, v.keyBindings.ScrollDown):
+		v.viewport.LineDown(1)
+	case v.review && (key.Matches(msg, v.keyBindings.Accept) || key.Matches(msg, v.keyBindings.ForceAccept)):
+		return &diffAction{
+			change: v.current().change,
+			accept: true,
+			force:  key.Matches(msg, v.keyBindings.ForceAccept),
+		}, false
+	case v.review && key.Matches(msg, v.keyBindings.Reject):
+		v.rejecting = true
+		v.reason.Reset()
+		v.reason.Focus()
+	}
+	return nil, false
+}
+
+func (v *diffViewer) updateReason(msg tea.KeyMsg) *diffAction {
+	switch msg.Type {
+	case tea.KeyEsc:
+		v.rejecting = false
+		v.reason.Blur()
+		return nil
+	case tea.KeyEnter:
+		v.rejecting = false
+		v.reason.Blur()
+		return &diffAction{
+			change: v.current().change,
+			reason: strings.TrimSpace(v.reason.Value()),
+		}
+	}
+
+	v.reason, _ = v.reason.Update(msg)
+	return nil
+}
+
+// remove drops a reviewed change from the viewer and reports whether changes are left.
+func (v *diffViewer) remove(id string) bool {
+	for i, file := range v.files {
+		if file.change != nil && file.change.Id == id {
+			v.files = append(v.files[:i], v.files[i+1:]...)
+			break
+		}
+	}
+	if len(v.files) == 0 {
+		return false
+	}
+
+	v.selectFile(Min(v.file, len(v.files)-1))
+	return true
+}
+
+func (v *diffViewer) current() diffFile {
+	return v.files[v.file]
+}
+
+func (v *diffViewer) selectFile(file int) {
+	if file < 0 || file >= len(v.files) {
+		return
+	}
+	v.file = file
+	v.hunk = 0
+	v.render()
+	v.viewport.GotoTop()
+}
+
+// moveHunk selects the next or previous hunk and continues in the neighbouring file at the
+// first or last hunk of the current file.
+func (v *diffViewer) moveHunk(delta int) {
+	hunk := v.hunk + delta
+	switch {
+	case hunk >= len(v.current().hunks):
+		if v.file == len(v.files)-1 {
+			return
+		}
+		v.selectFile(v.file + 1)
+	case hunk < 0:
+		if v.file == 0 {
+			return
+		}
+		v.selectFile(v.file - 1)
+		v.hunk = Max(0, len(v.current().hunks)-1)
+	default:
+		v.hunk = hunk
+	}
+
+	v.render()
+	if v.hunk < len(v.hunkOffsets) {
+		v.viewport.SetYOffset(v.hunkOffsets[v.hunk])
+	}
+}
+
+func (v *diffViewer) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+	v.render()
+}
+
+// render renders the hunks of the current file into the viewport and remembers at which
+// line each hunk starts.
+func (v *diffViewer) render() {
+	v.viewport.Width = v.width
+	v.viewport.Height = Max(1, v.height-lipgloss.Height(v.titleView())-lipgloss.Height(v.footerView()))
+
+	if len(v.files) == 0 {
+		v.viewport.SetContent("")
+		return
+	}
+	file := v.current()
+	highlighter := newSyntaxHighlighter(file.path)
+
+	var lines []string
+	v.hunkOffsets = v.hunkOffsets[:0]
+	for i, hunk := range file.hunks {
+		v.hunkOffsets = append(v.hunkOffsets, len(lines))
+
+		marker := diffGutterStyle.Render("  ")
+		if i == v.hunk {
+			marker = diffSelectedHunkStyle.Render("▌ ")
+		}
+		lines = append(lines, marker+diffHunkHeaderStyle.Render(hunk.header))
+		for _, line := range hunk.lines {
+			lines = append(lines, marker+renderDiffLine(line, highlighter, v.width-2))
+		}
+		lines = append(lines, "")
+	}
+	if len(file.hunks) == 0 {
+		lines = append(lines, paletteDescriptionStyle.Render("The change does not modify any lines."))
+	}
+
+	v.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+func renderDiffLine(line diffLine, highlighter *syntaxHighlighter, width int) string {
+	oldLine, newLine := "", ""
+	if line.oldLine > 0 {
+		oldLine = strconv.Itoa(line.oldLine)
+	}
+	if line.newLine > 0 {
+		newLine = strconv.Itoa(line.newLine)
+	}
+	gutter := diffGutterStyle.Render(fmt.Sprintf("%4s %4s ", oldLine, newLine))
+
+	var (
+		sign                              = " "
+		background lipgloss.TerminalColor = lipgloss.NoColor{}
+	)
+	switch line.kind {
+	case diffLineAdded:
+		sign, background = "+", diffAddedBackground
+	case diffLineRemoved:
+		sign, background = "-", diffRemovedBackground
+	}
+
+	text := strings.ReplaceAll(line.text, "\t", "    ")
+	content := lipgloss.NewStyle().Background(background).Render(sign+" ") + highlighter.highlight(text, background)
+	if padding := width - lipgloss.Width(gutter) - lipgloss.Width(sign+" "+text); padding > 0 && line.kind != diffLineContext {
+		content += lipgloss.NewStyle().Background(background).Render(strings.Repeat(" ", padding))
+	}
+	return gutter + content
+}
+
+func (v *diffViewer) titleView() string {
+	if len(v.files) == 0 {
+		return ""
+	}
+	file := v.current()
+
+	title := agentNameStyle.Render(file.path)
+	if file.change != nil && file.change.Created {
+		title += paletteDescriptionStyle.Render(" (new file)")
+	}
+	stats := diffAddedStyle.Render(fmt.Sprintf("+%d", file.added)) + " " + diffRemovedStyle.Render(fmt.Sprintf("-%d", file.removed))
+	position := usageStyle.Render(fmt.Sprintf("file %d/%d • hunk %d/%d", v.file+1, len(v.files), Min(v.hunk+1, len(file.hunks)), len(file.hunks)))
+
+	return lipgloss.JoinHorizontal(lipgloss.Left, title, "  ", stats, "  ", position)
+}
+
+func (v *diffViewer) footerView() string {
+	if v.rejecting {
+		return v.reason.View() + "\n" + paletteDescriptionStyle.Render("enter reject • esc cancel")
+	}
+
+	bindings := []key.Binding{v.keyBindings.NextHunk, v.keyBindings.PreviousHunk, v.keyBindings.NextFile, v.keyBindings.PreviousFile}
+	if v.review {
+		bindings = append(bindings, v.keyBindings.Accept, v.keyBindings.ForceAccept, v.keyBindings.Reject)
+	}
+	bindings = append(bindings, v.keyBindings.Close)
+
+	help := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		help = append(help, fmt.Sprintf("%s %s", binding.Help().Key, binding.Help().Desc))
+	}
+
+	footer := paletteDescriptionStyle.Render(strings.Join(help, " • "))
+	if v.status != "" {
+		footer = infoMessageStyle.Render(v.status) + "\n" + footer
+	}
+	return footer
+}
+
+func (v *diffViewer) View() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		v.titleView(),
+		v.viewport.View(),
+		v.footerView(),
+	)
+}
+
+// syntaxHighlighter colours source code by the language of a file. Lines are highlighted one
+// at a time, so constructs that span lines, like block comments, are not recognised.
+type syntaxHighlighter struct {
+	lexer chroma.Lexer
+	style *chroma.Style
+}
+
+func newSyntaxHighlighter(path string) *syntaxHighlighter {
+	lexer := lexers.Match(path)
+	if lexer == nil {
+		return &syntaxHighlighter{}
+	}
+	return &syntaxHighlighter{
+		lexer: chroma.Coalesce(lexer),
+		style: styles.Get("monokai"),
+	}
+}
+
+func (h *syntaxHighlighter) highlight(text string, background lipgloss.TerminalColor) string {
+	base := lipgloss.NewStyle().Background(background)
+	if h.lexer == nil {
+		return base.Render(text)
+	}
+
+	iterator, err := h.lexer.Tokenise(nil, text)
+	if err != nil {
+		return base.Render(text)
+	}
+
+	var builder strings.Builder
+	for _, token := range iterator.Tokens() {
+		style := base
+		if entry := h.style.Get(token.Type); entry.Colour.IsSet() {
+			style = style.Foreground(lipgloss.Color(entry.Colour.String()))
+		}
+		builder.WriteString(style.Render(strings.TrimSuffix(token.Value, "\n")))
+	}
+	return builder.String()
+}
@@ -0,0 +1,131 @@
+package terminal
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testPatch = `--- main.go
++++ main.go
+@@ -1,3 +1,3 @@
+ package main
+-import "fmt"
++import "os"
+
+@@ -10,2 +10,3 @@
+ func main() {
++	os.Exit(0)
+ }
+\ No newline at end of file
+`
+
+func TestParsePatch(t *testing.T) {
+	expected := []diffHunk{
+		{
+			header: "@@ -1,3 +1,3 @@",
+			lines: []diffLine{
+				{kind: diffLineContext, text: "package main", oldLine: 1, newLine: 1},
+				{kind: diffLineRemoved, text: `import "fmt"`, oldLine: 2},
+				{kind: diffLineAdded, text: `import "os"`, newLine: 2},
+				{kind: diffLineContext, text: "", oldLine: 3, newLine: 3},
+			},
+		},
+		{
+			header: "@@ -10,2 +10,3 @@",
+			lines: []diffLine{
+				{kind: diffLineContext, text: "func main() {", oldLine: 10, newLine: 10},
+				{kind: diffLineAdded, text: "\tos.Exit(0)", newLine: 11},
+				{kind: diffLineContext, text: "}", oldLine: 11, newLine: 12},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, parsePatch(testPatch), cmp.AllowUnexported(diffHunk{}, diffLine{})); diff != "" {
+		t.Errorf("hunks mismatch (-want +got):\n%s", diff)
+	}
+
+	file := newDiffFile("main.go", testPatch)
+	if file.added != 2 || file.removed != 1 {
+		t.Errorf("expected 2 added and 1 removed lines, got %d and %d", file.added, file.removed)
+	}
+}
+
+func TestDiffViewerNavigation(t *testing.T) {
+	viewer := newDiffViewer([]diffFile{
+		newDiffFile("main.go", testPatch),
+		newDiffFile("util.go", "@@ -1 +1 @@\n-a\n+b\n"),
+	}, false)
+	viewer.SetSize(80, 20)
+
+	keys := func(s string) tea.KeyMsg {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+	}
+
+	steps := []struct {
+		key  string
+		file int
+		hunk int
+	}{
+		{key: "n", file: 0, hunk: 1},
+		// the next hunk after the last one of a file is the first of the next file
+		{key: "n", file: 1, hunk: 0},
+		{key: "n", file: 1, hunk: 0},
+		{key: "p", file: 0, hunk: 1},
+		{key: "]", file: 1, hunk: 0},
+		{key: "[", file: 0, hunk: 0},
+	}
+	for _, step := range steps {
+		viewer.Update(keys(step.key))
+		if viewer.file != step.file || viewer.hunk != step.hunk {
+			t.Fatalf("after %q expected file %d hunk %d, got file %d hunk %d", step.key, step.file, step.hunk, viewer.file, viewer.hunk)
+		}
+	}
+
+	// changes can only be accepted in review mode
+	if action, _ := viewer.Update(keys("a")); action != nil {
+		t.Errorf("expected no action outside of review mode, got %+v", action)
+	}
+	if _, closed := viewer.Update(tea.KeyMsg{Type: tea.KeyEsc}); !closed {
+		t.Errorf("expected esc to close the viewer")
+	}
+}
+
+func TestDiffViewerReview(t *testing.T) {
+	first := &v1.FileChange{Id: "1", Path: "main.go", Patch: testPatch}
+	second := &v1.FileChange{Id: "2", Path: "util.go", Patch: "@@ -1 +1 @@\n-a\n+b\n"}
+
+	files := []diffFile{newDiffFile(first.Path, first.Patch), newDiffFile(second.Path, second.Patch)}
+	files[0].change, files[1].change = first, second
+	viewer := newDiffViewer(files, true)
+
+	action, _ := viewer.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if action == nil || !action.accept || action.force || action.change != first {
+		t.Fatalf("expected to accept the first change, got %+v", action)
+	}
+	action, _ = viewer.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	if action == nil || !action.accept || !action.force {
+		t.Fatalf("expected A to accept the change even if the file changed, got %+v", action)
+	}
+	if !viewer.remove(first.Id) || viewer.current().change != second {
+		t.Fatalf("expected the second change to be shown after the first was reviewed")
+	}
+
+	viewer.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if !viewer.rejecting {
+		t.Fatalf("expected r to ask for a reason")
+	}
+	for _, r := range "use a" {
+		viewer.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	action, _ = viewer.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if action == nil || action.accept || action.reason != "use a" || action.change != second {
+		t.Fatalf("expected to reject the second change with a reason, got %+v", action)
+	}
+
+	if viewer.remove(second.Id) {
+		t.Errorf("expected no changes to be left")
+	}
+}
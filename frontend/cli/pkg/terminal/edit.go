@@ -0,0 +1,152 @@
+package terminal
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	v1 "github.com/furisto/construct/api/go/v1"
+)
+
+// editMessageMsg puts a user message into the input area to be edited and sent again.
+type editMessageMsg struct {
+	message *v1.Message
+}
+
+// onEditKeyEvent starts editing the last user message when up is pressed in the empty input
+// and, while a message is edited, regenerates the conversation from it on enter or cancels
+// the edit on esc. It reports whether the key was handled.
+func (m *Session) onEditKeyEvent(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if m.editing == nil {
+		if key.Matches(msg, m.keyBindings.EditLastMessage) && m.input.Value() == "" && !m.taskIsRunning() {
+			return m.executeEditLastMessage(), true
+		}
+		return nil, false
+	}
+
+	switch {
+	case key.Matches(msg, m.keyBindings.CancelEdit):
+		m.editing = nil
+		m.mentions = nil
+		m.input.Reset()
+	case key.Matches(msg, m.keyBindings.SendMessage):
+		return m.handleRegenerate(), true
+	default:
+		return nil, false
+	}
+
+	return nil, true
+}
+
+func (m *Session) executeEditLastMessage() tea.Cmd {
+	return func() tea.Msg {
+		messages, err := m.listTaskMessages()
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		for i := len(messages) - 1; i >= 0; i-- {
+			if messages[i].Metadata.Role == v1.MessageRole_MESSAGE_ROLE_USER {
+				return editMessageMsg{message: messages[i]}
+			}
+		}
+		return nil
+	}
+}
+
+func (m *Session) onEditMessage(msg editMessageMsg) {
+	// the input may have been typed into while the messages were loaded
+	if m.input.Value() != "" {
+		return
+	}
+
+	text := messageText(msg.message)
+	m.editing = msg.message
+	m.mentions = messageMentions(msg.message, text)
+	m.input.SetValue(text)
+	m.input.CursorEnd()
+}
+
+func (m *Session) handleRegenerate() tea.Cmd {
+	userInput := strings.TrimSpace(m.input.Value())
+	if userInput == "" {
+		return nil
+	}
+
+	if m.taskIsRunning() {
+		return errorCmd(fmt.Errorf("the agent is working, wait until it is done before sending the edited message"))
+	}
+
+	edited := m.editing
+	attachments := m.takeMentions(userInput)
+	m.editing = nil
+	m.input.Reset()
+
+	m.waitingForAgent = true
+	return func() tea.Msg {
+		content := []*v1.MessagePart{
+			{
+				Data: &v1.MessagePart_Text_{
+					Text: &v1.MessagePart_Text{
+						Content: userInput,
+					},
+				},
+			},
+		}
+
+		resp, err := m.apiClient.Message().RegenerateMessage(m.ctx, &connect.Request[v1.RegenerateMessageRequest]{
+			Msg: &v1.RegenerateMessageRequest{
+				Id:      edited.Metadata.Id,
+				Content: append(content, mentionAttachmentParts(attachments)...),
+			},
+		})
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		messages, err := m.listTaskMessages()
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		return conversationReloadedMsg{
+			messages: messages,
+			notice:   fmt.Sprintf("Removed %d messages after your edited message.", resp.Msg.RemovedMessages),
+		}
+	}
+}
+
+// messageMentions returns the files attached to a message as mentions, so that they stay
+// attached as long as their tokens are part of the edited message.
+func messageMentions(msg *v1.Message, text string) []mention {
+	var mentions []mention
+	for _, part := range msg.Spec.Content {
+		attachment, ok := part.Data.(*v1.MessagePart_FileAttachment_)
+		if !ok {
+			continue
+		}
+
+		file := attachment.FileAttachment
+		ranged := mention{
+			Token:     fmt.Sprintf("@%s:%d-%d", file.Path, file.StartLine, file.EndLine),
+			Path:      file.Path,
+			StartLine: file.StartLine,
+			EndLine:   file.EndLine,
+		}
+		if file.StartLine > 0 && strings.Contains(text, ranged.Token) {
+			mentions = append(mentions, ranged)
+		} else {
+			mentions = append(mentions, mention{Token: "@" + file.Path, Path: file.Path})
+		}
+	}
+	return mentions
+}
+
+func (m *Session) editView() string {
+	if m.editing == nil {
+		return ""
+	}
+	return paletteStyle.Render(paletteDescriptionStyle.Render("Editing your last message • enter to regenerate the response • esc to cancel"))
+}
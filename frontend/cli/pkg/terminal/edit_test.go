@@ -0,0 +1,64 @@
+package terminal
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMessageMentions(t *testing.T) {
+	msg := &v1.Message{
+		Spec: &v1.MessageSpec{
+			Content: []*v1.MessagePart{
+				{Data: &v1.MessagePart_Text_{Text: &v1.MessagePart_Text{Content: "compare @main.go and @server.go:7-9"}}},
+				{Data: &v1.MessagePart_FileAttachment_{FileAttachment: &v1.MessagePart_FileAttachment{Path: "main.go", StartLine: 1, EndLine: 5}}},
+				{Data: &v1.MessagePart_FileAttachment_{FileAttachment: &v1.MessagePart_FileAttachment{Path: "server.go", StartLine: 7, EndLine: 9}}},
+			},
+		},
+	}
+
+	expected := []mention{
+		{Token: "@main.go", Path: "main.go"},
+		{Token: "@server.go:7-9", Path: "server.go", StartLine: 7, EndLine: 9},
+	}
+	if diff := cmp.Diff(expected, messageMentions(msg, messageText(msg))); diff != "" {
+		t.Errorf("mentions mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEditMessage(t *testing.T) {
+	msg := &v1.Message{
+		Metadata: &v1.MessageMetadata{Id: "message-1", Role: v1.MessageRole_MESSAGE_ROLE_USER},
+		Spec: &v1.MessageSpec{
+			Content: []*v1.MessagePart{
+				{Data: &v1.MessagePart_Text_{Text: &v1.MessagePart_Text{Content: "fix the tests"}}},
+			},
+		},
+	}
+
+	session := &Session{input: textarea.New(), keyBindings: NewSessionKeyBindings()}
+	session.input.SetValue("draft")
+	if _, handled := session.onEditKeyEvent(tea.KeyMsg{Type: tea.KeyUp}); handled {
+		t.Fatalf("expected up to move the cursor while the input is not empty")
+	}
+
+	session.input.Reset()
+	if _, handled := session.onEditKeyEvent(tea.KeyMsg{Type: tea.KeyUp}); !handled {
+		t.Fatalf("expected up to edit the last message while the input is empty")
+	}
+
+	session.onEditMessage(editMessageMsg{message: msg})
+	if session.editing != msg || session.input.Value() != "fix the tests" {
+		t.Fatalf("expected the message to be edited, got %q", session.input.Value())
+	}
+
+	if _, handled := session.onEditKeyEvent(tea.KeyMsg{Type: tea.KeyEsc}); !handled {
+		t.Fatalf("expected esc to cancel the edit")
+	}
+	if session.editing != nil || session.input.Value() != "" {
+		t.Errorf("expected the edit to be cancelled, got %q", session.input.Value())
+	}
+}
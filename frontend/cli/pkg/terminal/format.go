@@ -36,6 +36,14 @@ func renderToolCallMessage(tool, input string, width int, margin bool) string {
 	return style.Render("◆ " + fmt.Sprintf("%s(%s)", boldStyle.Render(tool), input))
 }
 
+func renderInfoMessage(msg *infoMessage, width int, margin bool) string {
+	style := infoMessageStyle.Width(width - infoMessageStyle.GetHorizontalBorderSize())
+	if margin {
+		style = style.MarginBottom(1)
+	}
+	return style.Render(msg.content)
+}
+
 func formatAsMarkdown(content string, width int) string {
 	md, _ := glamour.NewTermRenderer(
 		glamour.WithStandardStyle("dark"), // avoid OSC background queries
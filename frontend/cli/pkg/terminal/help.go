@@ -21,9 +21,27 @@ func (m *Session) renderHelp() string {
 		helpItemStyle.Render("  Ctrl+R        - Reconnect to task"),
 		helpItemStyle.Render("  Tab           - Switch agent"),
 		"",
+		helpItemStyle.Render("Commands:"),
+		helpItemStyle.Render("  /             - Open the command palette"),
+		helpItemStyle.Render("  ↑↓, Tab       - Select and complete a command"),
+		helpItemStyle.Render("  /help         - List all commands"),
+		"",
+		helpItemStyle.Render("Attachments:"),
+		helpItemStyle.Render("  @             - Attach a file of the workspace"),
+		helpItemStyle.Render("  @#            - Attach the definition of a symbol"),
+		helpItemStyle.Render("  Esc           - Close the file picker"),
+		"",
+		helpItemStyle.Render("Diff Viewer (/diff, /changes):"),
+		helpItemStyle.Render("  n/p           - Next/previous hunk"),
+		helpItemStyle.Render("  ]/[           - Next/previous file"),
+		helpItemStyle.Render("  a             - Accept the change"),
+		helpItemStyle.Render("  r             - Reject the change and restore the file"),
+		helpItemStyle.Render("  Esc           - Close the diff viewer"),
+		"",
 		helpItemStyle.Render("Input Mode (F1):"),
 		helpItemStyle.Render("  Enter         - Send message"),
 		helpItemStyle.Render("  Ctrl+Enter    - New line"),
+		helpItemStyle.Render("  ↑             - Edit your last message and regenerate the response"),
 		helpItemStyle.Render("  F2            - Switch to scroll mode"),
 		"",
 		helpItemStyle.Render("Scroll Mode (F2):"),
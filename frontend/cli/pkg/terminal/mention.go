@@ -0,0 +1,274 @@
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+)
+
+const (
+	// maxMentionResults is the number of files or symbols that are requested for the picker.
+	maxMentionResults = 20
+	// symbolMentionPrefix starts a mention that searches symbols instead of files.
+	symbolMentionPrefix = "@#"
+)
+
+// mention is a file, or a range of its lines, that the user picked to attach to a message.
+// Token is the text that was inserted into the input for it.
+type mention struct {
+	Token     string
+	Path      string
+	StartLine uint32
+	EndLine   uint32
+}
+
+// mentionCandidate is an entry of the mention picker.
+type mentionCandidate struct {
+	label   string
+	detail  string
+	mention mention
+}
+
+type mentionResultsMsg struct {
+	query      string
+	candidates []mentionCandidate
+	err        error
+}
+
+// mentionQuery returns the @-mention that is being typed at the end of the input.
+func (m *Session) mentionQuery() string {
+	value := m.input.Value()
+	if value == "" || strings.HasPrefix(value, "/") && !strings.ContainsAny(value, " \t\n") {
+		return ""
+	}
+
+	token := value[strings.LastIndexAny(value, " \t\n")+1:]
+	if !strings.HasPrefix(token, "@") {
+		return ""
+	}
+	return token
+}
+
+// onMentionQueryChanged searches the workspace of the task for the mention that is being
+// typed. Results of earlier queries that arrive late are dropped.
+func (m *Session) onMentionQueryChanged(query string) tea.Cmd {
+	m.mentionQueryText = query
+	m.mentionSelected = 0
+	m.mentionCandidates = nil
+	m.mentionErr = nil
+
+	if query == "" || query == m.mentionDismissed {
+		return nil
+	}
+	m.mentionDismissed = ""
+
+	if strings.HasPrefix(query, symbolMentionPrefix) {
+		if len(query) == len(symbolMentionPrefix) {
+			return nil
+		}
+		return m.searchSymbols(query)
+	}
+	return m.searchFiles(query)
+}
+
+func (m *Session) searchFiles(query string) tea.Cmd {
+	taskID := m.task.Metadata.Id
+	return func() tea.Msg {
+		resp, err := m.apiClient.Task().ListWorkspaceFiles(m.ctx, &connect.Request[v1.ListWorkspaceFilesRequest]{
+			Msg: &v1.ListWorkspaceFilesRequest{
+				TaskId: taskID,
+				Query:  strings.TrimPrefix(query, "@"),
+				Limit:  api_client.Ptr(int32(maxMentionResults)),
+			},
+		})
+		if err != nil {
+			return mentionResultsMsg{query: query, err: err}
+		}
+
+		candidates := make([]mentionCandidate, 0, len(resp.Msg.Paths))
+		for _, path := range resp.Msg.Paths {
+			candidates = append(candidates, mentionCandidate{
+				label:   path,
+				mention: mention{Token: "@" + path, Path: path},
+			})
+		}
+		return mentionResultsMsg{query: query, candidates: candidates}
+	}
+}
+
+func (m *Session) searchSymbols(query string) tea.Cmd {
+	taskID := m.task.Metadata.Id
+	return func() tea.Msg {
+		resp, err := m.apiClient.Task().SearchWorkspaceSymbols(m.ctx, &connect.Request[v1.SearchWorkspaceSymbolsRequest]{
+			Msg: &v1.SearchWorkspaceSymbolsRequest{
+				TaskId: taskID,
+				Query:  strings.TrimPrefix(query, symbolMentionPrefix),
+				Limit:  api_client.Ptr(int32(maxMentionResults)),
+			},
+		})
+		if err != nil {
+			return mentionResultsMsg{query: query, err: err}
+		}
+
+		candidates := make([]mentionCandidate, 0, len(resp.Msg.Symbols))
+		for _, symbol := range resp.Msg.Symbols {
+			candidates = append(candidates, mentionCandidate{
+				label:  symbol.Name,
+				detail: fmt.Sprintf("%s %s:%d", symbol.Kind, symbol.Path, symbol.Line),
+				mention: mention{
+					Token:     fmt.Sprintf("@%s:%d-%d", symbol.Path, symbol.Line, symbol.EndLine),
+					Path:      symbol.Path,
+					StartLine: symbol.Line,
+					EndLine:   symbol.EndLine,
+				},
+			})
+		}
+		return mentionResultsMsg{query: query, candidates: candidates}
+	}
+}
+
+func (m *Session) onMentionResults(msg mentionResultsMsg) {
+	if msg.query != m.mentionQueryText {
+		return
+	}
+	m.mentionCandidates = msg.candidates
+	m.mentionErr = msg.err
+}
+
+// onMentionKeyEvent handles the keys that navigate the mention picker while it is open. It
+// reports whether the key was handled.
+func (m *Session) onMentionKeyEvent(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if m.mentionQueryText == "" || m.mentionQueryText == m.mentionDismissed {
+		return nil, false
+	}
+
+	if key.Matches(msg, m.keyBindings.PaletteClose) {
+		m.mentionDismissed = m.mentionQueryText
+		return nil, true
+	}
+
+	candidates := m.mentionCandidates
+	if len(candidates) == 0 {
+		return nil, false
+	}
+	selected := m.mentionSelected % len(candidates)
+
+	switch {
+	case key.Matches(msg, m.keyBindings.PaletteUp):
+		m.mentionSelected = (selected - 1 + len(candidates)) % len(candidates)
+	case key.Matches(msg, m.keyBindings.PaletteDown):
+		m.mentionSelected = (selected + 1) % len(candidates)
+	case key.Matches(msg, m.keyBindings.PaletteComplete), key.Matches(msg, m.keyBindings.SendMessage):
+		m.selectMention(candidates[selected].mention)
+	default:
+		return nil, false
+	}
+
+	return nil, true
+}
+
+// selectMention replaces the mention that is being typed with the token of the picked file
+// and remembers the file, so that it is attached when the message is sent.
+func (m *Session) selectMention(picked mention) {
+	value := m.input.Value()
+	value = value[:len(value)-len(m.mentionQueryText)] + picked.Token + " "
+	m.input.SetValue(value)
+	m.input.CursorEnd()
+
+	for _, existing := range m.mentions {
+		if existing.Token == picked.Token {
+			return
+		}
+	}
+	m.mentions = append(m.mentions, picked)
+}
+
+// takeMentions returns the picked mentions whose tokens are still part of the message and
+// forgets all picked mentions.
+func (m *Session) takeMentions(content string) []mention {
+	var attached []mention
+	for _, picked := range m.mentions {
+		if strings.Contains(content, picked.Token) {
+			attached = append(attached, picked)
+		}
+	}
+	m.mentions = nil
+	return attached
+}
+
+func (m *Session) mentionView() string {
+	if m.mentionQueryText == "" || m.mentionQueryText == m.mentionDismissed {
+		return ""
+	}
+
+	if m.mentionErr != nil {
+		return paletteStyle.Render(paletteDescriptionStyle.Render(mentionErrorText(m.mentionErr)))
+	}
+
+	candidates := m.mentionCandidates
+	if len(candidates) == 0 {
+		var hint string
+		switch {
+		case m.mentionQueryText == symbolMentionPrefix:
+			hint = "Type the name of a function, type or class"
+		case candidates == nil:
+			// the results of the query have not arrived yet
+			hint = "Searching..."
+		case strings.HasPrefix(m.mentionQueryText, symbolMentionPrefix):
+			hint = "No matching symbols"
+		default:
+			hint = "No matching files"
+		}
+		return paletteStyle.Render(paletteDescriptionStyle.Render(hint))
+	}
+	selected := m.mentionSelected % len(candidates)
+
+	start := Max(0, selected-maxPaletteItems+1)
+	end := Min(len(candidates), start+maxPaletteItems)
+
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		label := candidates[i].label
+		if i == selected {
+			label = paletteSelectedStyle.Render(label)
+		} else {
+			label = paletteItemStyle.Render(label)
+		}
+		if candidates[i].detail != "" {
+			label += "  " + paletteDescriptionStyle.Render(candidates[i].detail)
+		}
+		lines = append(lines, label)
+	}
+
+	return paletteStyle.Render(strings.Join(lines, "\n"))
+}
+
+func mentionErrorText(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
+	}
+	return err.Error()
+}
+
+func mentionAttachmentParts(mentions []mention) []*v1.MessagePart {
+	parts := make([]*v1.MessagePart, 0, len(mentions))
+	for _, attached := range mentions {
+		parts = append(parts, &v1.MessagePart{
+			Data: &v1.MessagePart_FileAttachment_{
+				FileAttachment: &v1.MessagePart_FileAttachment{
+					Path:      attached.Path,
+					StartLine: attached.StartLine,
+					EndLine:   attached.EndLine,
+				},
+			},
+		})
+	}
+	return parts
+}
@@ -0,0 +1,64 @@
+package terminal
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMentionQuery(t *testing.T) {
+	tests := []struct {
+		input string
+		query string
+	}{
+		{input: "", query: ""},
+		{input: "explain @", query: "@"},
+		{input: "explain @backend/api", query: "@backend/api"},
+		{input: "@#Compact", query: "@#Compact"},
+		{input: "explain @main.go ", query: ""},
+		{input: "mail me@example.com", query: ""},
+		{input: "/review @main", query: "@main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			session := &Session{input: textarea.New()}
+			session.input.SetValue(tt.input)
+
+			if query := session.mentionQuery(); query != tt.query {
+				t.Errorf("expected query %q, got %q", tt.query, query)
+			}
+		})
+	}
+}
+
+func TestSelectMention(t *testing.T) {
+	session := &Session{input: textarea.New()}
+	session.input.SetValue("compare @mai")
+	session.mentionQueryText = session.mentionQuery()
+
+	main := mention{Token: "@main.go", Path: "main.go"}
+	session.selectMention(main)
+	if value := session.input.Value(); value != "compare @main.go " {
+		t.Fatalf("expected the mention to be completed, got %q", value)
+	}
+
+	session.input.SetValue(session.input.Value() + "and @#Start")
+	session.mentionQueryText = session.mentionQuery()
+
+	start := mention{Token: "@server.go:7-9", Path: "server.go", StartLine: 7, EndLine: 9}
+	session.selectMention(start)
+	if value := session.input.Value(); value != "compare @main.go and @server.go:7-9 " {
+		t.Fatalf("expected the symbol to be completed, got %q", value)
+	}
+
+	// mentions that were removed from the message are not attached
+	attached := session.takeMentions("compare and @server.go:7-9")
+	if diff := cmp.Diff([]mention{start}, attached); diff != "" {
+		t.Errorf("attachments mismatch (-want +got):\n%s", diff)
+	}
+	if len(session.mentions) != 0 {
+		t.Errorf("expected the mentions to be reset, got %v", session.mentions)
+	}
+}
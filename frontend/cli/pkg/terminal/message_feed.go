@@ -101,6 +101,26 @@ func (m *MessageFeed) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case *Error:
 		m.upsertErrorMessage(msg)
 		m.updateViewportContent()
+
+	case *infoMessage:
+		m.messages = append(m.messages, msg)
+		m.updateViewportContent()
+
+	case clearFeedMsg:
+		m.messages = nil
+		m.partialMessage = ""
+		m.updateViewportContent()
+
+	case conversationReloadedMsg:
+		m.messages = nil
+		m.partialMessage = ""
+		for _, message := range msg.messages {
+			m.processMessage(message)
+		}
+		if msg.notice != "" {
+			m.messages = append(m.messages, newInfoMessage("%s", msg.notice))
+		}
+		m.updateViewportContent()
 	}
 
 	return m, tea.Batch(cmds...)
@@ -152,6 +172,8 @@ func (m *MessageFeed) renderInitialMessage() string {
 		"Press Ctrl + C to clear the input area.",
 		"Press Ctrl + C twice to exit.",
 		"Press Esc to stop the agent execution.",
+		"Type / to see the available commands.",
+		"Type @ to attach a file of the workspace.",
 		separator,
 		"",
 	}
@@ -235,6 +257,11 @@ func (m *MessageFeed) processMessage(msg *v1.Message) {
 			m.messages = append(m.messages, m.createToolCallMessage(data.ToolCall, msg.Metadata.CreatedAt.AsTime()))
 		case *v1.MessagePart_ToolResult:
 			m.messages = append(m.messages, m.createToolResultMessage(data.ToolResult, msg.Metadata.CreatedAt.AsTime()))
+		case *v1.MessagePart_FileAttachment_:
+			m.messages = append(m.messages, &fileAttachmentMessage{
+				Attachment: data.FileAttachment,
+				timestamp:  msg.Metadata.CreatedAt.AsTime(),
+			})
 		}
 	}
 }
@@ -315,6 +342,19 @@ func (m *MessageFeed) createToolCallMessage(toolCall *v1.ToolCall, timestamp tim
 			Input:     toolInput.Fetch,
 			timestamp: timestamp,
 		}
+	case *v1.ToolCall_Remember:
+		return &rememberToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.Remember,
+			timestamp: timestamp,
+		}
+	case *v1.ToolCall_Custom:
+		return &customToolCall{
+			ID:        toolCall.Id,
+			ToolName:  toolCall.ToolName,
+			Input:     toolInput.Custom,
+			timestamp: timestamp,
+		}
 	}
 
 	return nil
@@ -399,6 +439,13 @@ func formatMessages(messages []message, partialMessage string, width int) string
 		case *assistantTextMessage:
 			renderedMessages = append(renderedMessages, renderAssistantMessage(msg, width, addBottomMargin(i, messages)))
 
+		case *infoMessage:
+			renderedMessages = append(renderedMessages, renderInfoMessage(msg, width, addBottomMargin(i, messages)))
+
+		case *fileAttachmentMessage:
+			attachment := fmt.Sprintf("%s L%d-%d", msg.Attachment.Path, msg.Attachment.StartLine, msg.Attachment.EndLine)
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Attach", attachment, width, addBottomMargin(i, messages)))
+
 		case *readFileToolCall:
 			var readFileInput string
 			if msg.Input.StartLine != 0 && msg.Input.EndLine != 0 {
@@ -471,6 +518,12 @@ func formatMessages(messages []message, partialMessage string, width int) string
 		case *fetchResult:
 			renderedMessages = append(renderedMessages, renderToolCallMessage("Fetch", msg.Result.Url, width, addBottomMargin(i, messages)))
 
+		case *rememberToolCall:
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Remember", msg.Input.Learning, width, addBottomMargin(i, messages)))
+
+		case *customToolCall:
+			renderedMessages = append(renderedMessages, renderToolCallMessage(msg.ToolName, msg.Input.Arguments, width, addBottomMargin(i, messages)))
+
 		case *Error:
 			var message string
 			if msg != nil && msg.Error != nil {
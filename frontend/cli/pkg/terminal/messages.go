@@ -1,6 +1,7 @@
 package terminal
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -16,6 +17,7 @@ const (
 	MessageTypeAssistantTyping
 	MessageTypeSubmitReport
 	MessageTypeError
+	MessageTypeInfo
 )
 
 type message interface {
@@ -62,6 +64,46 @@ func (m *assistantTextMessage) Render(writer io.Writer) string {
 
 var _ message = (*assistantTextMessage)(nil)
 
+// infoMessage is the output of a slash command. It is only shown in the session and never
+// sent to the agent.
+type infoMessage struct {
+	content   string
+	timestamp time.Time
+}
+
+func newInfoMessage(format string, args ...any) *infoMessage {
+	return &infoMessage{
+		content:   fmt.Sprintf(format, args...),
+		timestamp: time.Now(),
+	}
+}
+
+func (m *infoMessage) Type() messageType {
+	return MessageTypeInfo
+}
+
+func (m *infoMessage) Timestamp() time.Time {
+	return m.timestamp
+}
+
+var _ message = (*infoMessage)(nil)
+
+// fileAttachmentMessage is a file that the user attached to a message with an @-mention.
+type fileAttachmentMessage struct {
+	Attachment *v1.MessagePart_FileAttachment
+	timestamp  time.Time
+}
+
+func (m *fileAttachmentMessage) Type() messageType {
+	return MessageTypeUser
+}
+
+func (m *fileAttachmentMessage) Timestamp() time.Time {
+	return m.timestamp
+}
+
+var _ message = (*fileAttachmentMessage)(nil)
+
 // TOOL CALL MESSAGES
 type createFileToolCall struct {
 	ID        string
@@ -371,3 +413,32 @@ func (m *fetchResult) Type() messageType {
 func (m *fetchResult) Timestamp() time.Time {
 	return m.timestamp
 }
+
+type rememberToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_RememberInput
+	timestamp time.Time
+}
+
+func (m *rememberToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *rememberToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type customToolCall struct {
+	ID        string
+	ToolName  string
+	Input     *v1.ToolCall_CustomInput
+	timestamp time.Time
+}
+
+func (m *customToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *customToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
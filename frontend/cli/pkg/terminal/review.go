@@ -0,0 +1,153 @@
+package terminal
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	tea "github.com/charmbracelet/bubbletea"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/frontend/cli/pkg/fail"
+)
+
+// onDiffViewerKeyEvent passes a key to the open diff viewer and executes the decision about
+// a pending change, if the user made one.
+func (m *Session) onDiffViewerKeyEvent(msg tea.KeyMsg) tea.Cmd {
+	action, closed := m.diffViewer.Update(msg)
+	if closed {
+		m.diffViewer = nil
+		return nil
+	}
+	if action == nil {
+		return nil
+	}
+
+	m.diffViewer.status = ""
+	if action.accept {
+		return m.executeAcceptFileChange(action.change, action.force)
+	}
+	return m.executeRejectFileChange(action.change, action.reason)
+}
+
+func (m *Session) onFileChanges(msg fileChangesMsg) tea.Cmd {
+	m.pendingChanges = len(msg.changes)
+	if len(msg.changes) == 0 {
+		return infoCmd("There are no changes to review.")
+	}
+
+	files := make([]diffFile, 0, len(msg.changes))
+	for _, change := range msg.changes {
+		file := newDiffFile(change.Path, change.Patch)
+		file.change = change
+		files = append(files, file)
+	}
+	m.diffViewer = newDiffViewer(files, true)
+	return nil
+}
+
+func (m *Session) onFileChangeReviewed(msg fileChangeReviewedMsg) tea.Cmd {
+	m.pendingChanges = Max(0, m.pendingChanges-1)
+
+	var notice string
+	switch msg.change.Status {
+	case v1.FileChangeStatus_FILE_CHANGE_STATUS_ACCEPTED:
+		notice = fmt.Sprintf("Accepted the changes to %s and wrote them to the workspace", msg.change.Path)
+	default:
+		notice = fmt.Sprintf("Rejected the changes to %s, the agent was told why", msg.change.Path)
+	}
+
+	if m.diffViewer != nil && !m.diffViewer.remove(msg.change.Id) {
+		m.diffViewer = nil
+	}
+	if m.diffViewer != nil {
+		m.diffViewer.status = notice
+	}
+	return infoCmd("%s", notice)
+}
+
+// onReviewError shows errors in the diff viewer, which hides the message feed while it is
+// open.
+func (m *Session) onReviewError(err *Error) {
+	if m.diffViewer == nil || err == nil || err.Error == nil {
+		return
+	}
+
+	message := mentionErrorText(err.Error)
+	if userErr, ok := err.Error.(*fail.UserFacingError); ok {
+		message = userErr.UserMessage
+	}
+	m.diffViewer.status = errorStyle.Render("Error: ") + message
+}
+
+func (m *Session) executeListFileChanges() tea.Cmd {
+	return func() tea.Msg {
+		changes, err := m.listPendingChanges()
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return fileChangesMsg{changes: changes}
+	}
+}
+
+func (m *Session) listPendingChanges() ([]*v1.FileChange, error) {
+	resp, err := m.apiClient.Task().ListFileChanges(m.ctx, &connect.Request[v1.ListFileChangesRequest]{
+		Msg: &v1.ListFileChangesRequest{
+			TaskId: m.task.Metadata.Id,
+			Status: api_client.Ptr(v1.FileChangeStatus_FILE_CHANGE_STATUS_PENDING),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.Changes, nil
+}
+
+func (m *Session) executeAcceptFileChange(change *v1.FileChange, force bool) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.apiClient.Task().AcceptFileChange(m.ctx, &connect.Request[v1.AcceptFileChangeRequest]{
+			Msg: &v1.AcceptFileChangeRequest{
+				Id:    change.Id,
+				Force: force,
+			},
+		})
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return fileChangeReviewedMsg{change: resp.Msg.Change}
+	}
+}
+
+func (m *Session) executeRejectFileChange(change *v1.FileChange, reason string) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.apiClient.Task().RejectFileChange(m.ctx, &connect.Request[v1.RejectFileChangeRequest]{
+			Msg: &v1.RejectFileChangeRequest{
+				Id:     change.Id,
+				Reason: reason,
+			},
+		})
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return fileChangeReviewedMsg{change: resp.Msg.Change}
+	}
+}
+
+func (m *Session) executeSetReviewEdits(enabled bool) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.apiClient.Task().UpdateTask(m.ctx, &connect.Request[v1.UpdateTaskRequest]{
+			Msg: &v1.UpdateTaskRequest{
+				Id:          m.task.Metadata.Id,
+				ReviewEdits: api_client.Ptr(enabled),
+			},
+		})
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		m.task = resp.Msg.Task
+		if enabled {
+			return newInfoMessage("Review is on. Changes of the agent to files wait for your review, type /changes to see them.")
+		}
+		return newInfoMessage("Review is off. Changes that are still pending stay pending until you review them.")
+	}
+}
@@ -31,6 +31,14 @@ type SessionKeyBindings struct {
 	SwitchAgent key.Binding
 	ClearOrQuit key.Binding
 	SuspendTask key.Binding
+
+	EditLastMessage key.Binding
+	CancelEdit      key.Binding
+
+	PaletteUp       key.Binding
+	PaletteDown     key.Binding
+	PaletteComplete key.Binding
+	PaletteClose    key.Binding
 }
 
 func NewSessionKeyBindings() SessionKeyBindings {
@@ -55,6 +63,30 @@ func NewSessionKeyBindings() SessionKeyBindings {
 			key.WithKeys("esc"),
 			key.WithHelp("esc", "suspend task execution"),
 		),
+		EditLastMessage: key.NewBinding(
+			key.WithKeys("up"),
+			key.WithHelp("↑", "edit your last message"),
+		),
+		CancelEdit: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel editing"),
+		),
+		PaletteUp: key.NewBinding(
+			key.WithKeys("up"),
+			key.WithHelp("↑", "previous command"),
+		),
+		PaletteDown: key.NewBinding(
+			key.WithKeys("down"),
+			key.WithHelp("↓", "next command"),
+		),
+		PaletteComplete: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "complete command"),
+		),
+		PaletteClose: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "close command palette"),
+		),
 	}
 }
 
@@ -84,6 +116,22 @@ type Session struct {
 
 	modelInfoCache   map[string]*modelInfo
 	currentModelInfo *modelInfo
+
+	slashCommands   *SlashCommandRegistry
+	paletteSelected int
+	paletteQuery    string
+
+	mentions          []mention
+	mentionQueryText  string
+	mentionDismissed  string
+	mentionSelected   int
+	mentionCandidates []mentionCandidate
+	mentionErr        error
+
+	diffViewer     *diffViewer
+	pendingChanges int
+
+	editing *v1.Message
 }
 
 type Usage struct {
@@ -117,6 +165,9 @@ func NewSession(ctx context.Context, apiClient *api_client.Client, task *v1.Task
 
 	workspacePath := getWorkspacePath(task)
 
+	slashCommands := NewSlashCommandRegistry()
+	slashCommands.Register(builtinSlashCommands()...)
+
 	return &Session{
 		width:            80,
 		height:           20,
@@ -136,6 +187,7 @@ func NewSession(ctx context.Context, apiClient *api_client.Client, task *v1.Task
 		keyBindings:      NewSessionKeyBindings(),
 		modelInfoCache:   make(map[string]*modelInfo),
 		currentModelInfo: nil,
+		slashCommands:    slashCommands,
 	}
 }
 
@@ -167,6 +219,25 @@ func (m *Session) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.diffViewer != nil && !m.showHelp {
+			cmd := m.onDiffViewerKeyEvent(msg)
+			m.layout()
+			return m, cmd
+		}
+		if !m.showHelp {
+			if cmd, handled := m.onPaletteKeyEvent(msg); handled {
+				m.layout()
+				return m, cmd
+			}
+			if cmd, handled := m.onMentionKeyEvent(msg); handled {
+				m.layout()
+				return m, cmd
+			}
+			if cmd, handled := m.onEditKeyEvent(msg); handled {
+				m.layout()
+				return m, cmd
+			}
+		}
 		cmds = append(cmds, m.onKeyEvent(msg)...)
 		if m.showHelp {
 			if msg.Type == tea.KeyEsc || msg.String() == "ctrl+?" {
@@ -186,7 +257,7 @@ func (m *Session) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case suspendTaskCmd:
 		cmds = append(cmds, m.executeSuspendTask())
 	case sendMessageCmd:
-		cmds = append(cmds, m.executeSendMessage(msg.content))
+		cmds = append(cmds, m.executeSendMessage(msg.content, msg.attachments))
 	case getTaskCmd:
 		cmds = append(cmds, m.executeGetTask(msg.taskId))
 	case getModelCmd:
@@ -195,6 +266,22 @@ func (m *Session) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, m.executeListAgents())
 	case switchAgentCmd:
 		cmds = append(cmds, m.executeSwitchAgent(msg.agentId))
+	case agentUpdatedMsg:
+		cmds = append(cmds, m.onAgentUpdated(msg))
+	case conversationReloadedMsg:
+		m.onConversationReloaded(msg)
+	case editMessageMsg:
+		m.onEditMessage(msg)
+	case mentionResultsMsg:
+		m.onMentionResults(msg)
+	case fileChangesMsg:
+		cmds = append(cmds, m.onFileChanges(msg))
+	case fileChangeReviewedMsg:
+		cmds = append(cmds, m.onFileChangeReviewed(msg))
+	case pendingChangesMsg:
+		m.pendingChanges = msg.count
+	case *Error:
+		m.onReviewError(msg)
 	case taskUpdatedMsg:
 		// task was already updated, just trigger re-render
 	}
@@ -213,6 +300,15 @@ func (m *Session) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.spinner, cmd = m.spinner.Update(msg)
 	cmds = append(cmds, cmd)
 
+	if value := m.input.Value(); value != m.paletteQuery {
+		m.paletteQuery = value
+		m.paletteSelected = 0
+	}
+	if query := m.mentionQuery(); query != m.mentionQueryText {
+		cmds = append(cmds, m.onMentionQueryChanged(query))
+	}
+	m.layout()
+
 	return m, tea.Batch(cmds...)
 }
 
@@ -257,9 +353,18 @@ func (m *Session) handleMessageSend() tea.Cmd {
 		userInput := strings.TrimSpace(m.input.Value())
 		m.input.Reset()
 
+		attachments := m.takeMentions(userInput)
+		if name, args, ok := ParseSlashCommand(userInput); ok {
+			return m.runSlashCommand(name, args)
+		}
+		// a leading double slash sends a message that starts with a slash
+		if strings.HasPrefix(userInput, "//") {
+			userInput = userInput[1:]
+		}
+
 		m.waitingForAgent = true
 		return func() tea.Msg {
-			return sendMessageCmd{content: userInput}
+			return sendMessageCmd{content: userInput, attachments: attachments}
 		}
 	}
 
@@ -285,16 +390,48 @@ func (m *Session) handleSwitchAgent() []tea.Cmd {
 		currentIdx = (currentIdx + 1) % len(m.agents)
 	}
 
-	m.activeAgent = m.agents[currentIdx]
+	return m.switchAgent(m.agents[currentIdx])
+}
+
+func (m *Session) switchAgent(agent *v1.Agent) []tea.Cmd {
+	m.activeAgent = agent
 
 	// Fetch model info for the new agent
 	return []tea.Cmd{func() tea.Msg {
-		return getModelCmd{modelId: m.activeAgent.Spec.ModelId}
+		return getModelCmd{modelId: agent.Spec.ModelId}
 	}, func() tea.Msg {
-		return switchAgentCmd{agentId: m.activeAgent.Metadata.Id}
+		return switchAgentCmd{agentId: agent.Metadata.Id}
 	}}
 }
 
+func (m *Session) onAgentUpdated(msg agentUpdatedMsg) tea.Cmd {
+	agent := msg.agent
+	for i, a := range m.agents {
+		if a.Metadata.Id == agent.Metadata.Id {
+			m.agents[i] = agent
+		}
+	}
+	if m.activeAgent != nil && m.activeAgent.Metadata.Id == agent.Metadata.Id {
+		m.activeAgent = agent
+	}
+
+	return tea.Batch(func() tea.Msg {
+		return getModelCmd{modelId: agent.Spec.ModelId}
+	}, infoCmd("Agent %s now uses model %s", agent.Spec.Name, msg.modelName))
+}
+
+func (m *Session) onConversationReloaded(msg conversationReloadedMsg) {
+	// the usage of the last model response no longer describes the context
+	m.lastUsage.InputTokens = 0
+	m.lastUsage.OutputTokens = 0
+	m.lastUsage.CacheWriteTokens = 0
+	m.lastUsage.CacheReadTokens = 0
+
+	if msg.input != "" {
+		m.input.SetValue(msg.input)
+	}
+}
+
 func (m *Session) handleClearOrQuit() tea.Cmd {
 	now := time.Now()
 
@@ -337,21 +474,23 @@ func (m *Session) executeSuspendTask() tea.Cmd {
 	}
 }
 
-func (m *Session) executeSendMessage(userInput string) tea.Cmd {
+func (m *Session) executeSendMessage(userInput string, attachments []mention) tea.Cmd {
 	return func() tea.Msg {
-		_, err := m.apiClient.Message().CreateMessage(context.Background(), &connect.Request[v1.CreateMessageRequest]{
-			Msg: &v1.CreateMessageRequest{
-				TaskId: m.task.Metadata.Id,
-				Content: []*v1.MessagePart{
-					{
-						Data: &v1.MessagePart_Text_{
-							Text: &v1.MessagePart_Text{
-								Content: userInput,
-							},
-						},
+		content := []*v1.MessagePart{
+			{
+				Data: &v1.MessagePart_Text_{
+					Text: &v1.MessagePart_Text{
+						Content: userInput,
 					},
 				},
 			},
+		}
+
+		_, err := m.apiClient.Message().CreateMessage(context.Background(), &connect.Request[v1.CreateMessageRequest]{
+			Msg: &v1.CreateMessageRequest{
+				TaskId:  m.task.Metadata.Id,
+				Content: append(content, mentionAttachmentParts(attachments)...),
+			},
 		})
 
 		return handleAPIError(err)
@@ -372,6 +511,11 @@ func (m *Session) executeGetTask(taskId string) tea.Cmd {
 		m.task = resp.Msg.Task
 		m.lastUsage.Cost = resp.Msg.Task.Status.Usage.Cost
 
+		if m.task.Spec.ReviewEdits {
+			if changes, err := m.listPendingChanges(); err == nil {
+				return pendingChangesMsg{count: len(changes)}
+			}
+		}
 		return taskUpdatedMsg{}
 	}
 }
@@ -435,15 +579,23 @@ func (m *Session) executeSwitchAgent(agentId string) tea.Cmd {
 func (m *Session) onWindowResize(msg tea.WindowSizeMsg) {
 	m.width = msg.Width
 	m.height = msg.Height
+	m.layout()
+}
 
-	appWidth := msg.Width - appStyle.GetHorizontalFrameSize()
+// layout sizes the message feed to the space that the header and the input area, including
+// the command palette and the mention picker, leave free.
+func (m *Session) layout() {
+	appWidth := m.width - appStyle.GetHorizontalFrameSize()
+	m.input.SetWidth(appWidth)
 
 	headerHeight := lipgloss.Height(m.headerView())
+	if m.diffViewer != nil {
+		m.diffViewer.SetSize(appWidth, Max(0, m.height-headerHeight-appStyle.GetVerticalFrameSize()))
+		return
+	}
 	inputHeight := lipgloss.Height(m.inputView())
-	messageFeedHeight := msg.Height - headerHeight - inputHeight - appStyle.GetVerticalFrameSize()
-	m.messageFeed.SetSize(appWidth, messageFeedHeight)
-
-	m.input.SetWidth(appWidth)
+	messageFeedHeight := m.height - headerHeight - inputHeight - appStyle.GetVerticalFrameSize()
+	m.messageFeed.SetSize(appWidth, Max(0, messageFeedHeight))
 }
 
 func (m *Session) View() string {
@@ -451,6 +603,13 @@ func (m *Session) View() string {
 		return m.renderHelp()
 	}
 
+	if m.diffViewer != nil {
+		return appStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+			m.headerView(),
+			m.diffViewer.View(),
+		))
+	}
+
 	result := appStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
 		m.headerView(),
 		m.messageFeed.View(),
@@ -497,6 +656,17 @@ func (m *Session) headerView() string {
 		statusText,
 	)
 
+	if m.task != nil && m.task.Spec.ReviewEdits {
+		review := "Review"
+		if m.pendingChanges > 0 {
+			review = fmt.Sprintf("%d to review", m.pendingChanges)
+		}
+		if statusText != "" {
+			left += bulletSeparatorStyle.Render(" • ")
+		}
+		left += taskStatusStyle.Render(review)
+	}
+
 	// usage section
 	usageText := ""
 	tokenDisplay := fmt.Sprintf("Tokens: %d↑ %d↓", m.lastUsage.InputTokens, m.lastUsage.OutputTokens)
@@ -522,7 +692,17 @@ func (m *Session) headerView() string {
 }
 
 func (m *Session) inputView() string {
-	return inputStyle.Render(m.input.View())
+	input := inputStyle.Render(m.input.View())
+	if palette := m.paletteView(); palette != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, input, palette)
+	}
+	if picker := m.mentionView(); picker != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, input, picker)
+	}
+	if edit := m.editView(); edit != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, input, edit)
+	}
+	return input
 }
 
 func (m *Session) calculateContextUsage() int {
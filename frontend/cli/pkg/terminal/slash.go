@@ -0,0 +1,208 @@
+package terminal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxPaletteItems is the number of commands the palette shows at once.
+const maxPaletteItems = 8
+
+// SlashCommand is a command that is typed into the input area of a session, like /model.
+type SlashCommand struct {
+	// Name is the name of the command without the leading slash.
+	Name string
+	// Usage describes the arguments of the command, e.g. "[name]".
+	Usage string
+	// Description is shown in the command palette.
+	Description string
+	// Run executes the command with the arguments that were typed after its name.
+	Run func(s *Session, args []string) tea.Cmd
+}
+
+type SlashCommandRegistry struct {
+	commands map[string]*SlashCommand
+}
+
+func NewSlashCommandRegistry() *SlashCommandRegistry {
+	return &SlashCommandRegistry{
+		commands: make(map[string]*SlashCommand),
+	}
+}
+
+// Register adds commands to the registry. A command replaces an earlier one with the same name.
+func (r *SlashCommandRegistry) Register(commands ...*SlashCommand) {
+	for _, command := range commands {
+		r.commands[command.Name] = command
+	}
+}
+
+func (r *SlashCommandRegistry) Lookup(name string) (*SlashCommand, bool) {
+	command, ok := r.commands[name]
+	return command, ok
+}
+
+// Complete returns the commands whose names start with prefix, sorted by name.
+func (r *SlashCommandRegistry) Complete(prefix string) []*SlashCommand {
+	var matches []*SlashCommand
+	for name, command := range r.commands {
+		if strings.HasPrefix(name, prefix) {
+			matches = append(matches, command)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Name < matches[j].Name
+	})
+	return matches
+}
+
+// ParseSlashCommand splits input into the name of a slash command and its arguments.
+// Arguments are separated by whitespace and can be quoted with single or double quotes.
+// Input that starts with two slashes is a message, not a command.
+func ParseSlashCommand(input string) (string, []string, bool) {
+	input = strings.TrimSpace(input)
+	if !strings.HasPrefix(input, "/") || strings.HasPrefix(input, "//") {
+		return "", nil, false
+	}
+
+	fields := splitArgs(input[1:])
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+	return fields[0], fields[1:], true
+}
+
+func splitArgs(s string) []string {
+	var (
+		args    []string
+		current strings.Builder
+		quote   rune
+		inArg   bool
+	)
+
+	for _, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args
+}
+
+// RegisterSlashCommands makes additional commands available in the session, e.g. the
+// custom commands of the user.
+func (m *Session) RegisterSlashCommands(commands ...*SlashCommand) {
+	m.slashCommands.Register(commands...)
+}
+
+func (m *Session) runSlashCommand(name string, args []string) tea.Cmd {
+	command, ok := m.slashCommands.Lookup(name)
+	if !ok {
+		return func() tea.Msg {
+			return NewError(fmt.Errorf("unknown command /%s, type / to see the available commands", name))
+		}
+	}
+	return command.Run(m, args)
+}
+
+// paletteMatches returns the commands that complete the input while the name of a command
+// is typed. The palette is hidden as soon as the arguments are typed.
+func (m *Session) paletteMatches() []*SlashCommand {
+	value := m.input.Value()
+	if !strings.HasPrefix(value, "/") || strings.HasPrefix(value, "//") || strings.ContainsAny(value, " \t\n") {
+		return nil
+	}
+	return m.slashCommands.Complete(value[1:])
+}
+
+// onPaletteKeyEvent handles the keys that navigate the command palette while it is open.
+// It reports whether the key was handled.
+func (m *Session) onPaletteKeyEvent(msg tea.KeyMsg) (tea.Cmd, bool) {
+	matches := m.paletteMatches()
+	if len(matches) == 0 {
+		return nil, false
+	}
+	selected := m.paletteSelected % len(matches)
+
+	switch {
+	case key.Matches(msg, m.keyBindings.PaletteUp):
+		m.paletteSelected = (selected - 1 + len(matches)) % len(matches)
+	case key.Matches(msg, m.keyBindings.PaletteDown):
+		m.paletteSelected = (selected + 1) % len(matches)
+	case key.Matches(msg, m.keyBindings.PaletteComplete):
+		m.input.SetValue("/" + matches[selected].Name + " ")
+		m.input.CursorEnd()
+	case key.Matches(msg, m.keyBindings.PaletteClose):
+		m.input.Reset()
+	case key.Matches(msg, m.keyBindings.SendMessage):
+		m.input.Reset()
+		return m.runSlashCommand(matches[selected].Name, nil), true
+	default:
+		return nil, false
+	}
+
+	return nil, true
+}
+
+func (m *Session) paletteView() string {
+	matches := m.paletteMatches()
+	if len(matches) == 0 {
+		return ""
+	}
+	selected := m.paletteSelected % len(matches)
+
+	// scroll the window of visible commands so that the selected one stays visible
+	start := Max(0, selected-maxPaletteItems+1)
+	end := Min(len(matches), start+maxPaletteItems)
+
+	nameWidth := 0
+	for _, command := range matches {
+		nameWidth = Max(nameWidth, lipgloss.Width(paletteCommandName(command)))
+	}
+
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		name := fmt.Sprintf("%-*s", nameWidth, paletteCommandName(matches[i]))
+		if i == selected {
+			name = paletteSelectedStyle.Render(name)
+		} else {
+			name = paletteItemStyle.Render(name)
+		}
+		lines = append(lines, name+"  "+paletteDescriptionStyle.Render(matches[i].Description))
+	}
+
+	return paletteStyle.Render(strings.Join(lines, "\n"))
+}
+
+func paletteCommandName(command *SlashCommand) string {
+	if command.Usage == "" {
+		return "/" + command.Name
+	}
+	return "/" + command.Name + " " + command.Usage
+}
@@ -0,0 +1,427 @@
+package terminal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	tea "github.com/charmbracelet/bubbletea"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+)
+
+func builtinSlashCommands() []*SlashCommand {
+	return []*SlashCommand{
+		{
+			Name:        "help",
+			Description: "List the available commands",
+			Run:         (*Session).slashHelp,
+		},
+		{
+			Name:        "agent",
+			Usage:       "[name]",
+			Description: "List the agents or switch to another agent",
+			Run:         (*Session).slashAgent,
+		},
+		{
+			Name:        "model",
+			Usage:       "[name]",
+			Description: "List the models or change the model of the current agent",
+			Run:         (*Session).slashModel,
+		},
+		{
+			Name:        "cost",
+			Description: "Show the tokens and cost of the task",
+			Run:         (*Session).slashCost,
+		},
+		{
+			Name:        "export",
+			Usage:       "[file]",
+			Description: "Export the transcript as Markdown, HTML or JSON, by file extension",
+			Run:         (*Session).slashExport,
+		},
+		{
+			Name:        "rewind",
+			Usage:       "[n]",
+			Description: "Remove the last n of your messages and everything after them",
+			Run:         (*Session).slashRewind,
+		},
+		{
+			Name:        "compact",
+			Description: "Replace the conversation with a summary to free up context",
+			Run:         (*Session).slashCompact,
+		},
+		{
+			Name:        "files",
+			Description: "List the files the agent created or edited",
+			Run:         (*Session).slashFiles,
+		},
+		{
+			Name:        "diff",
+			Description: "Show the edits of the agent in a diff viewer",
+			Run:         (*Session).slashDiff,
+		},
+		{
+			Name:        "changes",
+			Usage:       "[on|off]",
+			Description: "Review the pending file changes or turn the review of changes on or off",
+			Run:         (*Session).slashChanges,
+		},
+		{
+			Name:        "clear",
+			Description: "Clear the screen, the agent keeps the conversation",
+			Run:         (*Session).slashClear,
+		},
+	}
+}
+
+func (m *Session) slashHelp(args []string) tea.Cmd {
+	commands := m.slashCommands.Complete("")
+
+	var builder strings.Builder
+	builder.WriteString("Commands:")
+	for _, command := range commands {
+		fmt.Fprintf(&builder, "\n  %-20s %s", paletteCommandName(command), command.Description)
+	}
+	builder.WriteString("\n\nStart a message with // to send it with a leading slash.")
+
+	return infoCmd("%s", builder.String())
+}
+
+func (m *Session) slashAgent(args []string) tea.Cmd {
+	if len(args) == 0 {
+		var builder strings.Builder
+		builder.WriteString("Agents:")
+		for _, agent := range m.agents {
+			marker := " "
+			if m.activeAgent != nil && agent.Metadata.Id == m.activeAgent.Metadata.Id {
+				marker = "*"
+			}
+			fmt.Fprintf(&builder, "\n%s %-16s %s", marker, agent.Spec.Name, agent.Spec.Description)
+		}
+		return infoCmd("%s", builder.String())
+	}
+
+	for _, agent := range m.agents {
+		if strings.EqualFold(agent.Spec.Name, args[0]) || agent.Metadata.Id == args[0] {
+			return tea.Batch(append(m.switchAgent(agent), infoCmd("Switched to agent %s", agent.Spec.Name))...)
+		}
+	}
+	return errorCmd(fmt.Errorf("agent %s not found, type /agent to list the agents", args[0]))
+}
+
+func (m *Session) slashModel(args []string) tea.Cmd {
+	agent := m.activeAgent
+	return func() tea.Msg {
+		resp, err := m.apiClient.Model().ListModels(m.ctx, &connect.Request[v1.ListModelsRequest]{
+			Msg: &v1.ListModelsRequest{},
+		})
+		if err != nil {
+			return handleAPIError(err)
+		}
+		models := resp.Msg.Models
+
+		if len(args) == 0 {
+			var builder strings.Builder
+			builder.WriteString("Models:")
+			for _, model := range models {
+				if !model.Spec.Enabled {
+					continue
+				}
+				marker := " "
+				if model.Metadata.Id == agent.Spec.ModelId {
+					marker = "*"
+				}
+				fmt.Fprintf(&builder, "\n%s %s", marker, model.Spec.Name)
+			}
+			return newInfoMessage("%s", builder.String())
+		}
+
+		for _, model := range models {
+			if model.Spec.Name != args[0] && model.Spec.Alias != args[0] {
+				continue
+			}
+
+			updated, err := m.apiClient.Agent().UpdateAgent(m.ctx, &connect.Request[v1.UpdateAgentRequest]{
+				Msg: &v1.UpdateAgentRequest{
+					Id:      agent.Metadata.Id,
+					ModelId: api_client.Ptr(model.Metadata.Id),
+				},
+			})
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return agentUpdatedMsg{agent: updated.Msg.Agent, modelName: model.Spec.Name}
+		}
+
+		return NewError(fmt.Errorf("model %s not found, type /model to list the models", args[0]))
+	}
+}
+
+func (m *Session) slashCost(args []string) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.apiClient.Task().GetTask(m.ctx, &connect.Request[v1.GetTaskRequest]{
+			Msg: &v1.GetTaskRequest{
+				Id: m.task.Metadata.Id,
+			},
+		})
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		usage := resp.Msg.Task.Status.Usage
+		var toolUses int64
+		for _, count := range usage.ToolUses {
+			toolUses += count
+		}
+
+		return newInfoMessage("Input tokens:       %d\nOutput tokens:      %d\nCache write tokens: %d\nCache read tokens:  %d\nTool calls:         %d\nCost:               $%.4f",
+			usage.InputTokens, usage.OutputTokens, usage.CacheWriteTokens, usage.CacheReadTokens, toolUses, usage.Cost)
+	}
+}
+
+func (m *Session) slashExport(args []string) tea.Cmd {
+	file := fmt.Sprintf("construct-%s.md", shortID(m.task.Metadata.Id))
+	if len(args) > 0 {
+		file = args[0]
+	}
+
+	format := v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".html", ".htm":
+		format = v1.TranscriptFormat_TRANSCRIPT_FORMAT_HTML
+	case ".json":
+		format = v1.TranscriptFormat_TRANSCRIPT_FORMAT_JSON
+	}
+
+	return func() tea.Msg {
+		resp, err := m.apiClient.Task().ExportTranscript(m.ctx, &connect.Request[v1.ExportTranscriptRequest]{
+			Msg: &v1.ExportTranscriptRequest{
+				Id:     m.task.Metadata.Id,
+				Format: format,
+			},
+		})
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		if err := os.WriteFile(file, []byte(resp.Msg.Content), 0644); err != nil {
+			return NewError(fmt.Errorf("failed to write transcript to %s: %w", file, err))
+		}
+		return newInfoMessage("Exported the transcript to %s", file)
+	}
+}
+
+func (m *Session) slashRewind(args []string) tea.Cmd {
+	count := 1
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return errorCmd(fmt.Errorf("/rewind expects a positive number of messages, got %s", args[0]))
+		}
+		count = n
+	}
+	if m.taskIsRunning() {
+		return errorCmd(fmt.Errorf("the agent is working, press Esc to stop it before rewinding"))
+	}
+
+	return func() tea.Msg {
+		messages, err := m.listTaskMessages()
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		// find the user message that the conversation is rewound to
+		rewindFrom := -1
+		found := 0
+		for i := len(messages) - 1; i >= 0; i-- {
+			if messages[i].Metadata.Role == v1.MessageRole_MESSAGE_ROLE_USER {
+				found++
+				if found == count {
+					rewindFrom = i
+					break
+				}
+			}
+		}
+		if rewindFrom == -1 {
+			return NewError(fmt.Errorf("cannot rewind %d messages, the conversation has only %d of your messages", count, found))
+		}
+
+		// delete the newest messages first, so that an interrupted rewind leaves a valid conversation
+		for i := len(messages) - 1; i >= rewindFrom; i-- {
+			_, err := m.apiClient.Message().DeleteMessage(m.ctx, &connect.Request[v1.DeleteMessageRequest]{
+				Msg: &v1.DeleteMessageRequest{
+					Id: messages[i].Metadata.Id,
+				},
+			})
+			if err != nil {
+				return handleAPIError(err)
+			}
+		}
+
+		return conversationReloadedMsg{
+			messages: messages[:rewindFrom],
+			notice:   fmt.Sprintf("Rewound %d messages. Your last message was put back into the input.", len(messages)-rewindFrom),
+			input:    messageText(messages[rewindFrom]),
+		}
+	}
+}
+
+func (m *Session) slashCompact(args []string) tea.Cmd {
+	if m.taskIsRunning() {
+		return errorCmd(fmt.Errorf("the agent is working, press Esc to stop it before compacting"))
+	}
+
+	return tea.Batch(
+		infoCmd("Compacting the conversation..."),
+		func() tea.Msg {
+			resp, err := m.apiClient.Task().CompactTask(m.ctx, &connect.Request[v1.CompactTaskRequest]{
+				Msg: &v1.CompactTaskRequest{
+					Id: m.task.Metadata.Id,
+				},
+			})
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			messages, err := m.listTaskMessages()
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			return conversationReloadedMsg{
+				messages: messages,
+				notice:   fmt.Sprintf("Compacted %d messages into a summary.", resp.Msg.CompactedMessages),
+			}
+		},
+	)
+}
+
+func (m *Session) slashFiles(args []string) tea.Cmd {
+	var (
+		paths   []string
+		changes = make(map[string]string)
+	)
+	for _, msg := range m.messageFeed.messages {
+		var path, change string
+		switch msg := msg.(type) {
+		case *createFileToolCall:
+			path, change = msg.Input.Path, "created"
+		case *editFileToolCall:
+			path, change = msg.Input.Path, "edited"
+		default:
+			continue
+		}
+
+		if _, ok := changes[path]; !ok {
+			paths = append(paths, path)
+			changes[path] = change
+		}
+	}
+
+	if len(paths) == 0 {
+		return infoCmd("The agent has not created or edited any files yet.")
+	}
+
+	var builder strings.Builder
+	builder.WriteString("Files:")
+	for _, path := range paths {
+		fmt.Fprintf(&builder, "\n  %-8s %s", changes[path], path)
+	}
+	return infoCmd("%s", builder.String())
+}
+
+func (m *Session) slashDiff(args []string) tea.Cmd {
+	var files []diffFile
+	for _, msg := range m.messageFeed.messages {
+		result, ok := msg.(*editFileResult)
+		if !ok || result.Result.PatchInfo == nil || result.Result.PatchInfo.Patch == "" {
+			continue
+		}
+		files = append(files, newDiffFile(result.Result.Path, result.Result.PatchInfo.Patch))
+	}
+
+	if len(files) == 0 {
+		return infoCmd("The agent has not edited any files yet.")
+	}
+	m.diffViewer = newDiffViewer(files, false)
+	return nil
+}
+
+func (m *Session) slashChanges(args []string) tea.Cmd {
+	if len(args) == 0 {
+		return m.executeListFileChanges()
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "on":
+		return m.executeSetReviewEdits(true)
+	case "off":
+		return m.executeSetReviewEdits(false)
+	default:
+		return errorCmd(fmt.Errorf("/changes expects on or off, got %s", args[0]))
+	}
+}
+
+func (m *Session) slashClear(args []string) tea.Cmd {
+	return func() tea.Msg {
+		return clearFeedMsg{}
+	}
+}
+
+func (m *Session) taskIsRunning() bool {
+	return m.task != nil && m.task.Status != nil && m.task.Status.Phase == v1.TaskPhase_TASK_PHASE_RUNNING
+}
+
+// listTaskMessages returns the messages of the task, oldest first.
+func (m *Session) listTaskMessages() ([]*v1.Message, error) {
+	resp, err := m.apiClient.Message().ListMessages(m.ctx, &connect.Request[v1.ListMessagesRequest]{
+		Msg: &v1.ListMessagesRequest{
+			Filter: &v1.ListMessagesRequest_Filter{
+				TaskIds: api_client.Ptr(m.task.Metadata.Id),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := resp.Msg.Messages
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].Metadata.CreatedAt.AsTime().Before(messages[j].Metadata.CreatedAt.AsTime())
+	})
+	return messages, nil
+}
+
+func messageText(msg *v1.Message) string {
+	var parts []string
+	for _, part := range msg.Spec.Content {
+		if text, ok := part.Data.(*v1.MessagePart_Text_); ok {
+			parts = append(parts, text.Text.Content)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
+
+func infoCmd(format string, args ...any) tea.Cmd {
+	return func() tea.Msg {
+		return newInfoMessage(format, args...)
+	}
+}
+
+func errorCmd(err error) tea.Cmd {
+	return func() tea.Msg {
+		return NewError(err)
+	}
+}
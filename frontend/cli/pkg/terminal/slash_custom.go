@@ -0,0 +1,181 @@
+package terminal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+var customCommandNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// customCommandHeader is the optional YAML front matter of a custom command file.
+type customCommandHeader struct {
+	Description string `yaml:"description"`
+	Usage       string `yaml:"usage"`
+}
+
+// customCommandData is passed to the template of a custom command.
+type customCommandData struct {
+	// Args are the arguments of the command.
+	Args []string
+	// Arguments are all arguments separated by spaces.
+	Arguments string
+	// Workspace is the working directory of the task.
+	Workspace string
+}
+
+// LoadCustomCommands loads user-defined slash commands from the Markdown files in dirs.
+// The name of a file without the .md extension is the name of the command, and its content
+// is a Go template of the prompt that the command sends to the agent. The template can
+// use {{.Arguments}}, {{.Args}}, {{arg 1}} for the first argument and {{.Workspace}}.
+// A file can start with YAML front matter that sets the description and usage shown in
+// the command palette. Directories that do not exist are skipped, and commands in later
+// directories replace commands of the same name in earlier ones. Files that cannot be
+// loaded are reported in the error, all other commands are still returned.
+func LoadCustomCommands(fs afero.Fs, dirs ...string) ([]*SlashCommand, error) {
+	commands := make(map[string]*SlashCommand)
+	var errs []error
+
+	for _, dir := range dirs {
+		entries, err := afero.ReadDir(fs, dir)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, fmt.Errorf("failed to read command directory %s: %w", dir, err))
+			}
+			continue
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			command, err := loadCustomCommand(fs, path)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			commands[command.Name] = command
+		}
+	}
+
+	loaded := make([]*SlashCommand, 0, len(commands))
+	for _, command := range commands {
+		loaded = append(loaded, command)
+	}
+	sort.Slice(loaded, func(i, j int) bool {
+		return loaded[i].Name < loaded[j].Name
+	})
+
+	return loaded, errors.Join(errs...)
+}
+
+func loadCustomCommand(fs afero.Fs, path string) (*SlashCommand, error) {
+	name := strings.TrimSuffix(filepath.Base(path), ".md")
+	if !customCommandNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid command name %q in %s, use letters, digits, - and _", name, path)
+	}
+
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read command %s: %w", path, err)
+	}
+
+	header, body, err := splitFrontMatter(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid front matter in %s: %w", path, err)
+	}
+
+	tmpl, err := template.New(name).Funcs(template.FuncMap{
+		// arg is replaced when the command runs, it is only declared for parsing
+		"arg": func(int) string { return "" },
+	}).Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template in %s: %w", path, err)
+	}
+
+	description := header.Description
+	if description == "" {
+		description = "Custom command from " + path
+	}
+
+	return &SlashCommand{
+		Name:        name,
+		Usage:       header.Usage,
+		Description: description,
+		Run: func(s *Session, args []string) tea.Cmd {
+			prompt, err := renderCustomCommand(tmpl, customCommandData{
+				Args:      args,
+				Arguments: strings.Join(args, " "),
+				Workspace: s.task.Spec.Workspace,
+			})
+			if err != nil {
+				return errorCmd(fmt.Errorf("failed to render /%s: %w", name, err))
+			}
+			if prompt == "" {
+				return errorCmd(fmt.Errorf("/%s rendered an empty prompt", name))
+			}
+
+			s.waitingForAgent = true
+			return func() tea.Msg {
+				return sendMessageCmd{content: prompt}
+			}
+		},
+	}, nil
+}
+
+func renderCustomCommand(tmpl *template.Template, data customCommandData) (string, error) {
+	tmpl, err := tmpl.Clone()
+	if err != nil {
+		return "", err
+	}
+	tmpl = tmpl.Funcs(template.FuncMap{
+		"arg": func(i int) string {
+			if i < 1 || i > len(data.Args) {
+				return ""
+			}
+			return data.Args[i-1]
+		},
+	})
+
+	var prompt bytes.Buffer
+	if err := tmpl.Execute(&prompt, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(prompt.String()), nil
+}
+
+// splitFrontMatter separates YAML front matter delimited by --- lines from the body.
+func splitFrontMatter(content string) (customCommandHeader, string, error) {
+	var header customCommandHeader
+
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(content, "---\n") {
+		return header, content, nil
+	}
+
+	rest := content[len("---\n"):]
+	end := strings.Index(rest, "\n---")
+	if end == -1 {
+		return header, "", errors.New("missing closing ---")
+	}
+
+	if err := yaml.Unmarshal([]byte(rest[:end]), &header); err != nil {
+		return header, "", err
+	}
+
+	body := rest[end+len("\n---"):]
+	body = strings.TrimPrefix(body, "\n")
+	return header, body, nil
+}
@@ -0,0 +1,131 @@
+package terminal
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/spf13/afero"
+)
+
+func TestParseSlashCommand(t *testing.T) {
+	tests := []struct {
+		input string
+		name  string
+		args  []string
+		ok    bool
+	}{
+		{input: "/clear", name: "clear", ok: true},
+		{input: "  /rewind 2 ", name: "rewind", args: []string{"2"}, ok: true},
+		{input: `/review "internal/api server.go" 'fix bugs'`, name: "review", args: []string{"internal/api server.go", "fix bugs"}, ok: true},
+		{input: `/review ""`, name: "review", args: []string{""}, ok: true},
+		{input: "//not a command", ok: false},
+		{input: "/", ok: false},
+		{input: "hello /model", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			name, args, ok := ParseSlashCommand(tt.input)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %v, got %v", tt.ok, ok)
+			}
+			if name != tt.name {
+				t.Errorf("expected name %q, got %q", tt.name, name)
+			}
+			if diff := cmp.Diff(tt.args, args, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("args mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSlashCommandRegistryComplete(t *testing.T) {
+	registry := NewSlashCommandRegistry()
+	registry.Register(builtinSlashCommands()...)
+	registry.Register(&SlashCommand{Name: "compact", Description: "custom"})
+
+	var names []string
+	for _, command := range registry.Complete("c") {
+		names = append(names, command.Name)
+	}
+	if diff := cmp.Diff([]string{"changes", "clear", "compact", "cost"}, names); diff != "" {
+		t.Errorf("completions mismatch (-want +got):\n%s", diff)
+	}
+
+	command, ok := registry.Lookup("compact")
+	if !ok || command.Description != "custom" {
+		t.Errorf("expected the later registration to replace the built-in command, got %+v", command)
+	}
+}
+
+func TestLoadCustomCommands(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	files := map[string]string{
+		"/config/commands/review.md": "Review the code.",
+		"/config/commands/test.md": `---
+description: Write tests
+usage: <file> [framework]
+---
+Write tests for {{arg 1}}{{if arg 2}} with {{arg 2}}{{end}} in {{.Workspace}}.`,
+		"/project/.construct/commands/review.md":   "Review {{.Arguments}} carefully.",
+		"/project/.construct/commands/notes.txt":   "not a command",
+		"/project/.construct/commands/broken.md":   "{{.Arguments",
+		"/project/.construct/commands/bad name.md": "invalid name",
+	}
+	for path, content := range files {
+		if err := afero.WriteFile(fs, path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	commands, err := LoadCustomCommands(fs, "/config/commands", "/project/.construct/commands", "/missing")
+	if err == nil {
+		t.Fatal("expected an error for the broken commands")
+	}
+
+	byName := make(map[string]*SlashCommand)
+	var names []string
+	for _, command := range commands {
+		byName[command.Name] = command
+		names = append(names, command.Name)
+	}
+	if diff := cmp.Diff([]string{"review", "test"}, names); diff != "" {
+		t.Fatalf("commands mismatch (-want +got):\n%s", diff)
+	}
+
+	if byName["test"].Description != "Write tests" || byName["test"].Usage != "<file> [framework]" {
+		t.Errorf("front matter was not applied: %+v", byName["test"])
+	}
+}
+
+func TestRenderCustomCommand(t *testing.T) {
+	tmpl := template.Must(template.New("test").Funcs(template.FuncMap{
+		"arg": func(int) string { return "" },
+	}).Parse(`Write tests for {{arg 1}}{{if arg 2}} with {{arg 2}}{{end}} ({{.Arguments}}) in {{.Workspace}}.`))
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"api.go"}, expected: "Write tests for api.go (api.go) in /project."},
+		{args: []string{"api.go", "testify"}, expected: "Write tests for api.go with testify (api.go testify) in /project."},
+		{args: nil, expected: "Write tests for  () in /project."},
+	}
+
+	for _, tt := range tests {
+		prompt, err := renderCustomCommand(tmpl, customCommandData{
+			Args:      tt.args,
+			Arguments: strings.Join(tt.args, " "),
+			Workspace: "/project",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if prompt != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, prompt)
+		}
+	}
+}
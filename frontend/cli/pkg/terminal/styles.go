@@ -74,6 +74,43 @@ var (
 				Foreground(lipgloss.Color("254")).
 				PaddingLeft(1)
 
+	infoMessageStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("245")).
+				PaddingLeft(1)
+
+	// Command palette styles
+	paletteStyle = lipgloss.NewStyle().
+			PaddingLeft(1)
+
+	paletteItemStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("252"))
+
+	paletteSelectedStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("39")).
+				Bold(true)
+
+	paletteDescriptionStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("241"))
+
+	// Diff viewer styles
+	diffGutterStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240"))
+
+	diffSelectedHunkStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("39"))
+
+	diffHunkHeaderStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("75"))
+
+	diffAddedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("34"))
+
+	diffRemovedStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("160"))
+
+	diffAddedBackground   = lipgloss.Color("22")
+	diffRemovedBackground = lipgloss.Color("52")
+
 	// Separator style
 	separatorStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("240")).
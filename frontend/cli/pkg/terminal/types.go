@@ -2,6 +2,8 @@ package terminal
 
 import (
 	"time"
+
+	v1 "github.com/furisto/construct/api/go/v1"
 )
 
 type appState int
@@ -22,7 +24,8 @@ const (
 
 type suspendTaskCmd struct{}
 type sendMessageCmd struct {
-	content string
+	content     string
+	attachments []mention
 }
 type getTaskCmd struct {
 	taskId string
@@ -38,6 +41,38 @@ type switchAgentCmd struct {
 	agentId string
 }
 
+type agentUpdatedMsg struct {
+	agent     *v1.Agent
+	modelName string
+}
+
+// clearFeedMsg removes all messages from the message feed.
+type clearFeedMsg struct{}
+
+// conversationReloadedMsg replaces the message feed with the conversation as stored by the
+// daemon, after it was rewound or compacted.
+type conversationReloadedMsg struct {
+	messages []*v1.Message
+	notice   string
+	// input is put back into the input area, e.g. the user message that was rewound
+	input string
+}
+
+// fileChangesMsg opens the diff viewer with the pending changes of the task for review.
+type fileChangesMsg struct {
+	changes []*v1.FileChange
+}
+
+// fileChangeReviewedMsg reports that a pending change was accepted or rejected.
+type fileChangeReviewedMsg struct {
+	change *v1.FileChange
+}
+
+// pendingChangesMsg updates the number of changes that wait for review.
+type pendingChangesMsg struct {
+	count int
+}
+
 type Error struct {
 	Error error
 	Time  time.Time
@@ -11,6 +11,15 @@ func SupportedKeys() []string {
 		"secret",
 		"secret.provider",
 		"secret.path",
+		"secret.passphrase-file",
+		"secret.vault",
+		"secret.vault.address",
+		"secret.vault.namespace",
+		"secret.vault.mount",
+		"secret.vault.path",
+		"secret.vault.token-file",
+		"secret.1password",
+		"secret.1password.vault",
 
 		// Command
 		"cmd",
@@ -30,6 +39,37 @@ func SupportedKeys() []string {
 		"log.file",
 		"log.format",
 
+		// Analytics
+		"analytics",
+		"analytics.sink",
+		"analytics.endpoint",
+		"analytics.file",
+
+		// Tracing
+		"tracing",
+		"tracing.endpoint",
+		"tracing.sample-ratio",
+
+		// Redaction
+		"redaction",
+		"redaction.enabled",
+		"redaction.patterns",
+		"redaction.entropy-threshold",
+
+		// Storage
+		"storage",
+		"storage.driver",
+		"storage.dsn",
+
+		// Daemon
+		"daemon",
+		"daemon.tls",
+		"daemon.tls.cert",
+		"daemon.tls.key",
+		"daemon.tls.client-ca",
+		"daemon.web-ui",
+		"daemon.tool-dir",
+
 		// Misc
 		"editor",
 		"output",
@@ -554,6 +554,85 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 							},
 						},
 					})
+				case toolbase.ToolNameRemember:
+					rememberInput := call.Input.Remember
+					if rememberInput == nil {
+						slog.Error("remember input not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_Remember{
+									Remember: &v1.ToolCall_RememberInput{
+										Learning: rememberInput.Learning,
+									},
+								},
+							},
+						},
+					})
+
+					rememberResult := call.Output.Remember
+					if rememberResult == nil {
+						slog.Error("remember result not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_Remember{
+									Remember: &v1.ToolResult_RememberResult{
+										Path:      rememberResult.Path,
+										Learning:  rememberResult.Learning,
+										Duplicate: rememberResult.Duplicate,
+										Entries:   int32(rememberResult.Entries),
+									},
+								},
+							},
+						},
+					})
+				default:
+					customInput := call.Input.Custom
+					if customInput == nil {
+						slog.Error("unknown tool name", "tool_name", call.ToolName)
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_Custom{
+									Custom: &v1.ToolCall_CustomInput{
+										Arguments: string(customInput.Arguments),
+									},
+								},
+							},
+						},
+					})
+
+					customResult := call.Output.Custom
+					if customResult == nil {
+						slog.Error("custom tool result not set", "tool_name", call.ToolName)
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_Custom{
+									Custom: &v1.ToolResult_CustomResult{
+										Output: string(customResult.Output),
+									},
+								},
+							},
+						},
+					})
 				}
 			}
 		}
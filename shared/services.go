@@ -66,11 +66,17 @@ func (m *ContextManager) UpsertContext(contextName string, kind string, address
 		Kind:    kind,
 	}
 
+	existing, exists := endpointContexts.Contexts[contextName]
+	if exists && existing.Kind == kind {
+		// Reinstalling the daemon must not drop the credentials of the context.
+		context.Token = existing.Token
+		context.TLS = existing.TLS
+	}
+
 	if err := context.Validate(); err != nil {
 		return false, err
 	}
 
-	_, exists := endpointContexts.Contexts[contextName]
 	endpointContexts.Contexts[contextName] = context
 
 	if setCurrent {
@@ -83,6 +89,23 @@ func (m *ContextManager) UpsertContext(contextName string, kind string, address
 	return exists, m.saveContext(endpointContexts)
 }
 
+// SetToken stores the API token the client sends to the daemon of the context.
+func (m *ContextManager) SetToken(contextName string, token string) error {
+	endpointContexts, err := m.LoadContext()
+	if err != nil {
+		return err
+	}
+
+	context, ok := endpointContexts.Contexts[contextName]
+	if !ok {
+		return fmt.Errorf("context %s not found", contextName)
+	}
+	context.Token = token
+	endpointContexts.Contexts[contextName] = context
+
+	return m.saveContext(endpointContexts)
+}
+
 func (m *ContextManager) SetCurrentContext(contextName string) error {
 	endpointContexts, err := m.LoadContext()
 	if err != nil {